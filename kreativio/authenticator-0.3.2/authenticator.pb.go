@@ -312,6 +312,8 @@ type AuthReply struct {
 
 	// JSON Web Token
 	Jwt string `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	// The token is only valid for VerifyTOTP, to complete a two-factor login.
+	MfaRequired bool `protobuf:"varint,2,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
 }
 
 func (x *AuthReply) Reset() {
@@ -353,6 +355,13 @@ func (x *AuthReply) GetJwt() string {
 	return ""
 }
 
+func (x *AuthReply) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
 // UserPassword holds the e-mail of the user and its password.
 type UserPassword struct {
 	state         protoimpl.MessageState
@@ -699,6 +708,7 @@ type PublicKey struct {
 	unknownFields protoimpl.UnknownFields
 
 	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Kid int32  `protobuf:"varint,2,opt,name=kid,proto3" json:"kid,omitempty"`
 }
 
 func (x *PublicKey) Reset() {
@@ -740,6 +750,60 @@ func (x *PublicKey) GetKey() []byte {
 	return nil
 }
 
+func (x *PublicKey) GetKid() int32 {
+	if x != nil {
+		return x.Kid
+	}
+	return 0
+}
+
+type PublicKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*PublicKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *PublicKeys) Reset() {
+	*x = PublicKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authenticator_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublicKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicKeys) ProtoMessage() {}
+
+func (x *PublicKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_authenticator_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicKeys.ProtoReflect.Descriptor instead.
+func (*PublicKeys) Descriptor() ([]byte, []int) {
+	return file_authenticator_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PublicKeys) GetKeys() []*PublicKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
 type UserEmail struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -752,7 +816,7 @@ type UserEmail struct {
 func (x *UserEmail) Reset() {
 	*x = UserEmail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_authenticator_proto_msgTypes[13]
+		mi := &file_authenticator_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -765,7 +829,7 @@ func (x *UserEmail) String() string {
 func (*UserEmail) ProtoMessage() {}
 
 func (x *UserEmail) ProtoReflect() protoreflect.Message {
-	mi := &file_authenticator_proto_msgTypes[13]
+	mi := &file_authenticator_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,7 +842,7 @@ func (x *UserEmail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserEmail.ProtoReflect.Descriptor instead.
 func (*UserEmail) Descriptor() ([]byte, []int) {
-	return file_authenticator_proto_rawDescGZIP(), []int{13}
+	return file_authenticator_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UserEmail) GetEmail() string {
@@ -795,255 +859,1223 @@ func (x *UserEmail) GetUrl() *CallBackUrl {
 	return nil
 }
 
-var File_authenticator_proto protoreflect.FileDescriptor
+type TOTPCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_authenticator_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x6f, 0x72, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x26, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x23, 0x0a, 0x0b, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6c, 0x69, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x22, 0xdc,
-	0x01, 0x0a, 0x0b, 0x43, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x19,
-	0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x3e, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x55,
-	0x72, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x55, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x6c, 0x69,
-	0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6a, 0x0a,
-	0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x03, 0x75,
-	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63,
-	0x6b, 0x55, 0x72, 0x6c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x1d, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x77, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6a, 0x77, 0x74, 0x22, 0x46, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0xa6,
-	0x01, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a,
-	0x0b, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x29, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x50, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x22, 0x24, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x20, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x05, 0x4b, 0x65,
-	0x79, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
-	0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x22, 0x4f, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2c, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c,
-	0x52, 0x03, 0x75, 0x72, 0x6c, 0x32, 0xa0, 0x05, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x55, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x50, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4d,
-	0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x77,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x1a, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
-	0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a,
-	0x0c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x77, 0x12, 0x1e, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x65,
-	0x77, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0x1c, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x50, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a,
-	0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
-	0x12, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
-	0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x1a, 0x18, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x1a, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x18, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62,
-	0x4b, 0x65, 0x79, 0x12, 0x14, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x6f, 0x72, 0x2e, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x1a, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x4b, 0x65, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x57, 0x12, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x21, 0x5a, 0x1f, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x6f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x61, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	// JSON Web Token
+	Jwt string `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	// TOTP code or recovery code
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-var (
-	file_authenticator_proto_rawDescOnce sync.Once
-	file_authenticator_proto_rawDescData = file_authenticator_proto_rawDesc
-)
+func (x *TOTPCode) Reset() {
+	*x = TOTPCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authenticator_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_authenticator_proto_rawDescGZIP() []byte {
-	file_authenticator_proto_rawDescOnce.Do(func() {
-		file_authenticator_proto_rawDescData = protoimpl.X.CompressGZIP(file_authenticator_proto_rawDescData)
-	})
-	return file_authenticator_proto_rawDescData
+func (x *TOTPCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_authenticator_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
-var file_authenticator_proto_goTypes = []interface{}{
-	(*UserData)(nil),          // 0: authenticator.UserData
-	(*StringSlice)(nil),       // 1: authenticator.StringSlice
-	(*CallBackUrl)(nil),       // 2: authenticator.CallBackUrl
-	(*RegistrationData)(nil),  // 3: authenticator.RegistrationData
-	(*RegistrationReply)(nil), // 4: authenticator.RegistrationReply
-	(*AuthReply)(nil),         // 5: authenticator.AuthReply
-	(*UserPassword)(nil),      // 6: authenticator.UserPassword
-	(*NewUserPassword)(nil),   // 7: authenticator.NewUserPassword
-	(*ChangePwReply)(nil),     // 8: authenticator.ChangePwReply
-	(*Exists)(nil),            // 9: authenticator.Exists
-	(*PublicUser)(nil),        // 10: authenticator.PublicUser
-	(*KeyID)(nil),             // 11: authenticator.KeyID
-	(*PublicKey)(nil),         // 12: authenticator.PublicKey
-	(*UserEmail)(nil),         // 13: authenticator.UserEmail
-	nil,                       // 14: authenticator.CallBackUrl.ParamsEntry
-	(*empty.Empty)(nil),       // 15: google.protobuf.Empty
+func (*TOTPCode) ProtoMessage() {}
+
+func (x *TOTPCode) ProtoReflect() protoreflect.Message {
+	mi := &file_authenticator_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_authenticator_proto_depIdxs = []int32{
-	14, // 0: authenticator.CallBackUrl.params:type_name -> authenticator.CallBackUrl.ParamsEntry
-	2,  // 1: authenticator.RegistrationData.url:type_name -> authenticator.CallBackUrl
-	2,  // 2: authenticator.UserEmail.url:type_name -> authenticator.CallBackUrl
-	1,  // 3: authenticator.CallBackUrl.ParamsEntry.value:type_name -> authenticator.StringSlice
-	3,  // 4: authenticator.Authenticator.RegisterPwUser:input_type -> authenticator.RegistrationData
-	6,  // 5: authenticator.Authenticator.AuthenticatePwUser:input_type -> authenticator.UserPassword
-	7,  // 6: authenticator.Authenticator.ChangeUserPw:input_type -> authenticator.NewUserPassword
-	0,  // 7: authenticator.Authenticator.CheckUserExists:input_type -> authenticator.UserData
-	5,  // 8: authenticator.Authenticator.VerifyUser:input_type -> authenticator.AuthReply
-	5,  // 9: authenticator.Authenticator.RefreshToken:input_type -> authenticator.AuthReply
-	10, // 10: authenticator.Authenticator.PublicUserToken:input_type -> authenticator.PublicUser
-	11, // 11: authenticator.Authenticator.GetPubKey:input_type -> authenticator.KeyID
-	13, // 12: authenticator.Authenticator.ResetUserPW:input_type -> authenticator.UserEmail
-	4,  // 13: authenticator.Authenticator.RegisterPwUser:output_type -> authenticator.RegistrationReply
-	5,  // 14: authenticator.Authenticator.AuthenticatePwUser:output_type -> authenticator.AuthReply
-	8,  // 15: authenticator.Authenticator.ChangeUserPw:output_type -> authenticator.ChangePwReply
-	9,  // 16: authenticator.Authenticator.CheckUserExists:output_type -> authenticator.Exists
-	5,  // 17: authenticator.Authenticator.VerifyUser:output_type -> authenticator.AuthReply
-	5,  // 18: authenticator.Authenticator.RefreshToken:output_type -> authenticator.AuthReply
-	5,  // 19: authenticator.Authenticator.PublicUserToken:output_type -> authenticator.AuthReply
-	12, // 20: authenticator.Authenticator.GetPubKey:output_type -> authenticator.PublicKey
-	15, // 21: authenticator.Authenticator.ResetUserPW:output_type -> google.protobuf.Empty
-	13, // [13:22] is the sub-list for method output_type
-	4,  // [4:13] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+
+// Deprecated: Use TOTPCode.ProtoReflect.Descriptor instead.
+func (*TOTPCode) Descriptor() ([]byte, []int) {
+	return file_authenticator_proto_rawDescGZIP(), []int{15}
 }
 
-func init() { file_authenticator_proto_init() }
-func file_authenticator_proto_init() {
-	if File_authenticator_proto != nil {
-		return
+func (x *TOTPCode) GetJwt() string {
+	if x != nil {
+		return x.Jwt
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_authenticator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_authenticator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringSlice); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_authenticator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CallBackUrl); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_authenticator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistrationData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *TOTPCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type TOTPSecret struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Base32 encoded secret, for manual entry
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// otpauth:// provisioning URI, for QR codes
+	Uri string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *TOTPSecret) Reset() {
+	*x = TOTPSecret{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authenticator_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TOTPSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TOTPSecret) ProtoMessage() {}
+
+func (x *TOTPSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_authenticator_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_authenticator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistrationReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TOTPSecret.ProtoReflect.Descriptor instead.
+func (*TOTPSecret) Descriptor() ([]byte, []int) {
+	return file_authenticator_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TOTPSecret) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *TOTPSecret) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type RecoveryCodes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
+}
+
+func (x *RecoveryCodes) Reset() {
+	*x = RecoveryCodes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authenticator_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryCodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryCodes) ProtoMessage() {}
+
+func (x *RecoveryCodes) ProtoReflect() protoreflect.Message {
+	mi := &file_authenticator_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_authenticator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryCodes.ProtoReflect.Descriptor instead.
+func (*RecoveryCodes) Descriptor() ([]byte, []int) {
+	return file_authenticator_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RecoveryCodes) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+type WebAuthnLogin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Email is optional
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *WebAuthnLogin) Reset() {
+	*x = WebAuthnLogin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authenticator_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebAuthnLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebAuthnLogin) ProtoMessage() {}
+
+func (x *WebAuthnLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_authenticator_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebAuthnLogin.ProtoReflect.Descriptor instead.
+func (*WebAuthnLogin) Descriptor() ([]byte, []int) {
+	return file_authenticator_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WebAuthnLogin) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type WebAuthnOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// JSON encoded PublicKeyCredentialCreationOptions or PublicKeyCredentialRequestOptions.
+	// Binary members are base64url encoded.
+	Options string `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *WebAuthnOptions) Reset() {
+	*x = WebAuthnOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authenticator_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebAuthnOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebAuthnOptions) ProtoMessage() {}
+
+func (x *WebAuthnOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_authenticator_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebAuthnOptions.ProtoReflect.Descriptor instead.
+func (*WebAuthnOptions) Descriptor() ([]byte, []int) {
+	return file_authenticator_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WebAuthnOptions) GetOptions() string {
+	if x != nil {
+		return x.Options
+	}
+	return ""
+}
+
+type WebAuthnCredential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// JSON Web Token, only for registration
+	Jwt string `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	// JSON encoded PublicKeyCredential, as returned by the browser.
+	// Binary members are base64url encoded.
+	Credential string `protobuf:"bytes,2,opt,name=credential,proto3" json:"credential,omitempty"`
+	// Name of the credential, only for registration
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *WebAuthnCredential) Reset() {
+	*x = WebAuthnCredential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authenticator_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebAuthnCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebAuthnCredential) ProtoMessage() {}
+
+func (x *WebAuthnCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_authenticator_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebAuthnCredential.ProtoReflect.Descriptor instead.
+func (*WebAuthnCredential) Descriptor() ([]byte, []int) {
+	return file_authenticator_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WebAuthnCredential) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *WebAuthnCredential) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
+func (x *WebAuthnCredential) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type OIDCClientRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId    string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	RedirectUri string `protobuf:"bytes,2,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+}
+
+func (x *OIDCClientRequest) Reset() {
+	*x = OIDCClientRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authenticator_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCClientRequest) ProtoMessage() {}
+
+func (x *OIDCClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authenticator_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCClientRequest.ProtoReflect.Descriptor instead.
+func (*OIDCClientRequest) Descriptor() ([]byte, []int) {
+	return file_authenticator_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OIDCClientRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OIDCClientRequest) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+type OIDCClient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *OIDCClient) Reset() {
+	*x = OIDCClient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authenticator_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCClient) ProtoMessage() {}
+
+func (x *OIDCClient) ProtoReflect() protoreflect.Message {
+	mi := &file_authenticator_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCClient.ProtoReflect.Descriptor instead.
+func (*OIDCClient) Descriptor() ([]byte, []int) {
+	return file_authenticator_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *OIDCClient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type OIDCAuthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// JSON Web Token of the logged in user
+	Jwt         string `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	ClientId    string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	RedirectUri string `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	// Space separated scopes, which must include "openid"
+	Scope string `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	// Nonce is optional, and included in the ID token
+	Nonce               string `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	CodeChallenge       string `protobuf:"bytes,6,opt,name=code_challenge,json=codeChallenge,proto3" json:"code_challenge,omitempty"`
+	CodeChallengeMethod string `protobuf:"bytes,7,opt,name=code_challenge_method,json=codeChallengeMethod,proto3" json:"code_challenge_method,omitempty"`
+}
+
+func (x *OIDCAuthRequest) Reset() {
+	*x = OIDCAuthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authenticator_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCAuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCAuthRequest) ProtoMessage() {}
+
+func (x *OIDCAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authenticator_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCAuthRequest.ProtoReflect.Descriptor instead.
+func (*OIDCAuthRequest) Descriptor() ([]byte, []int) {
+	return file_authenticator_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *OIDCAuthRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *OIDCAuthRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OIDCAuthRequest) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+func (x *OIDCAuthRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *OIDCAuthRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *OIDCAuthRequest) GetCodeChallenge() string {
+	if x != nil {
+		return x.CodeChallenge
+	}
+	return ""
+}
+
+func (x *OIDCAuthRequest) GetCodeChallengeMethod() string {
+	if x != nil {
+		return x.CodeChallengeMethod
+	}
+	return ""
+}
+
+type OIDCCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *OIDCCode) Reset() {
+	*x = OIDCCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authenticator_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCCode) ProtoMessage() {}
+
+func (x *OIDCCode) ProtoReflect() protoreflect.Message {
+	mi := &file_authenticator_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCCode.ProtoReflect.Descriptor instead.
+func (*OIDCCode) Descriptor() ([]byte, []int) {
+	return file_authenticator_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OIDCCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type OIDCTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// Client secret, only for confidential clients
+	ClientSecret string `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	RedirectUri  string `protobuf:"bytes,4,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	CodeVerifier string `protobuf:"bytes,5,opt,name=code_verifier,json=codeVerifier,proto3" json:"code_verifier,omitempty"`
+}
+
+func (x *OIDCTokenRequest) Reset() {
+	*x = OIDCTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authenticator_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCTokenRequest) ProtoMessage() {}
+
+func (x *OIDCTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authenticator_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCTokenRequest.ProtoReflect.Descriptor instead.
+func (*OIDCTokenRequest) Descriptor() ([]byte, []int) {
+	return file_authenticator_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *OIDCTokenRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OIDCTokenRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OIDCTokenRequest) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *OIDCTokenRequest) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+func (x *OIDCTokenRequest) GetCodeVerifier() string {
+	if x != nil {
+		return x.CodeVerifier
+	}
+	return ""
+}
+
+type OIDCTokens struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	IdToken     string `protobuf:"bytes,2,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	// Lifetime of the tokens, in seconds
+	ExpiresIn int64  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	Scope     string `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+}
+
+func (x *OIDCTokens) Reset() {
+	*x = OIDCTokens{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authenticator_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCTokens) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCTokens) ProtoMessage() {}
+
+func (x *OIDCTokens) ProtoReflect() protoreflect.Message {
+	mi := &file_authenticator_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCTokens.ProtoReflect.Descriptor instead.
+func (*OIDCTokens) Descriptor() ([]byte, []int) {
+	return file_authenticator_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *OIDCTokens) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *OIDCTokens) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+func (x *OIDCTokens) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *OIDCTokens) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+type OIDCUserInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sub string `protobuf:"bytes,1,opt,name=sub,proto3" json:"sub,omitempty"`
+	// Only with the "email" scope
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// Only with the "profile" scope
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *OIDCUserInfo) Reset() {
+	*x = OIDCUserInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authenticator_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCUserInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCUserInfo) ProtoMessage() {}
+
+func (x *OIDCUserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_authenticator_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCUserInfo.ProtoReflect.Descriptor instead.
+func (*OIDCUserInfo) Descriptor() ([]byte, []int) {
+	return file_authenticator_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OIDCUserInfo) GetSub() string {
+	if x != nil {
+		return x.Sub
+	}
+	return ""
+}
+
+func (x *OIDCUserInfo) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *OIDCUserInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_authenticator_proto protoreflect.FileDescriptor
+
+var file_authenticator_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x26, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x23, 0x0a, 0x0b, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6c, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x22, 0xdc,
+	0x01, 0x0a, 0x0b, 0x43, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x3e, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x55,
+	0x72, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x55, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x6c, 0x69,
+	0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6a, 0x0a,
+	0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63,
+	0x6b, 0x55, 0x72, 0x6c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x77, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6a, 0x77, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x66, 0x61, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x66,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x46, 0x0a, 0x0c, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4a, 0x04, 0x08, 0x02, 0x10,
+	0x03, 0x22, 0xa6, 0x01, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0c, 0x6f,
+	0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x21, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x29, 0x0a, 0x0d, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x50, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x24, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x20, 0x0a, 0x0a, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x19, 0x0a,
+	0x05, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x2f, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x3a, 0x0a, 0x0a, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x4f, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2c, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x55, 0x72,
+	0x6c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x30, 0x0a, 0x08, 0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x77, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6a, 0x77, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x36, 0x0a, 0x0a, 0x54, 0x4f, 0x54, 0x50,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x22, 0x25, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x0d, 0x57, 0x65, 0x62, 0x41, 0x75,
+	0x74, 0x68, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x2b,
+	0x0a, 0x0f, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5a, 0x0a, 0x12, 0x57,
+	0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x77, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6a, 0x77, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x11, 0x4f, 0x49, 0x44, 0x43, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x22, 0x20, 0x0a, 0x0a,
+	0x4f, 0x49, 0x44, 0x43, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xea,
+	0x01, 0x0a, 0x0f, 0x4f, 0x49, 0x44, 0x43, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x77, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6a, 0x77, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72,
+	0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x55, 0x72, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x64, 0x65, 0x5f,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x1e, 0x0a, 0x08, 0x4f,
+	0x49, 0x44, 0x43, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x10,
+	0x4f, 0x49, 0x44, 0x43, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x64,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x7f,
+	0x0a, 0x0a, 0x4f, 0x49, 0x44, 0x43, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22,
+	0x4a, 0x0a, 0x0c, 0x4f, 0x49, 0x44, 0x43, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x75, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x75,
+	0x62, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x92, 0x0d, 0x0a, 0x0d,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x55, 0x0a,
+	0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x50, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x4f, 0x54,
+	0x50, 0x12, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x18, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x77, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x77, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55,
+	0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x1a, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x1a, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x44, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x1a, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x55,
+	0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x55,
+	0x73, 0x65, 0x72, 0x1a, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x3d, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4b, 0x65, 0x79,
+	0x49, 0x44, 0x1a, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x00, 0x12, 0x41,
+	0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x57, 0x12, 0x18, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x43, 0x0a, 0x0a, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x12,
+	0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x1a, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x4f, 0x54, 0x50, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x1c,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x0b, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x17, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x4f,
+	0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x57, 0x0a, 0x19, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68,
+	0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x1a, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x12, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x57, 0x65, 0x62,
+	0x41, 0x75, 0x74, 0x68, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x57, 0x65, 0x62, 0x41, 0x75,
+	0x74, 0x68, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68,
+	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x13, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x12, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x1a, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x42, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x73, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x49, 0x44,
+	0x43, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x4f, 0x49, 0x44, 0x43, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x41, 0x75, 0x74, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f, 0x64, 0x65,
+	0x22, 0x00, 0x12, 0x50, 0x0a, 0x10, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x49,
+	0x44, 0x43, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x49, 0x44, 0x43, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x1a, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00,
+	0x42, 0x21, 0x5a, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d,
+	0x6f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_authenticator_proto_rawDescOnce sync.Once
+	file_authenticator_proto_rawDescData = file_authenticator_proto_rawDesc
+)
+
+func file_authenticator_proto_rawDescGZIP() []byte {
+	file_authenticator_proto_rawDescOnce.Do(func() {
+		file_authenticator_proto_rawDescData = protoimpl.X.CompressGZIP(file_authenticator_proto_rawDescData)
+	})
+	return file_authenticator_proto_rawDescData
+}
+
+var file_authenticator_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_authenticator_proto_goTypes = []interface{}{
+	(*UserData)(nil),           // 0: authenticator.UserData
+	(*StringSlice)(nil),        // 1: authenticator.StringSlice
+	(*CallBackUrl)(nil),        // 2: authenticator.CallBackUrl
+	(*RegistrationData)(nil),   // 3: authenticator.RegistrationData
+	(*RegistrationReply)(nil),  // 4: authenticator.RegistrationReply
+	(*AuthReply)(nil),          // 5: authenticator.AuthReply
+	(*UserPassword)(nil),       // 6: authenticator.UserPassword
+	(*NewUserPassword)(nil),    // 7: authenticator.NewUserPassword
+	(*ChangePwReply)(nil),      // 8: authenticator.ChangePwReply
+	(*Exists)(nil),             // 9: authenticator.Exists
+	(*PublicUser)(nil),         // 10: authenticator.PublicUser
+	(*KeyID)(nil),              // 11: authenticator.KeyID
+	(*PublicKey)(nil),          // 12: authenticator.PublicKey
+	(*PublicKeys)(nil),         // 13: authenticator.PublicKeys
+	(*UserEmail)(nil),          // 14: authenticator.UserEmail
+	(*TOTPCode)(nil),           // 15: authenticator.TOTPCode
+	(*TOTPSecret)(nil),         // 16: authenticator.TOTPSecret
+	(*RecoveryCodes)(nil),      // 17: authenticator.RecoveryCodes
+	(*WebAuthnLogin)(nil),      // 18: authenticator.WebAuthnLogin
+	(*WebAuthnOptions)(nil),    // 19: authenticator.WebAuthnOptions
+	(*WebAuthnCredential)(nil), // 20: authenticator.WebAuthnCredential
+	(*OIDCClientRequest)(nil),  // 21: authenticator.OIDCClientRequest
+	(*OIDCClient)(nil),         // 22: authenticator.OIDCClient
+	(*OIDCAuthRequest)(nil),    // 23: authenticator.OIDCAuthRequest
+	(*OIDCCode)(nil),           // 24: authenticator.OIDCCode
+	(*OIDCTokenRequest)(nil),   // 25: authenticator.OIDCTokenRequest
+	(*OIDCTokens)(nil),         // 26: authenticator.OIDCTokens
+	(*OIDCUserInfo)(nil),       // 27: authenticator.OIDCUserInfo
+	nil,                        // 28: authenticator.CallBackUrl.ParamsEntry
+	(*empty.Empty)(nil),        // 29: google.protobuf.Empty
+}
+var file_authenticator_proto_depIdxs = []int32{
+	28, // 0: authenticator.CallBackUrl.params:type_name -> authenticator.CallBackUrl.ParamsEntry
+	2,  // 1: authenticator.RegistrationData.url:type_name -> authenticator.CallBackUrl
+	12, // 2: authenticator.PublicKeys.keys:type_name -> authenticator.PublicKey
+	2,  // 3: authenticator.UserEmail.url:type_name -> authenticator.CallBackUrl
+	1,  // 4: authenticator.CallBackUrl.ParamsEntry.value:type_name -> authenticator.StringSlice
+	3,  // 5: authenticator.Authenticator.RegisterPwUser:input_type -> authenticator.RegistrationData
+	6,  // 6: authenticator.Authenticator.AuthenticatePwUser:input_type -> authenticator.UserPassword
+	15, // 7: authenticator.Authenticator.VerifyTOTP:input_type -> authenticator.TOTPCode
+	7,  // 8: authenticator.Authenticator.ChangeUserPw:input_type -> authenticator.NewUserPassword
+	0,  // 9: authenticator.Authenticator.CheckUserExists:input_type -> authenticator.UserData
+	5,  // 10: authenticator.Authenticator.VerifyUser:input_type -> authenticator.AuthReply
+	5,  // 11: authenticator.Authenticator.RefreshToken:input_type -> authenticator.AuthReply
+	10, // 12: authenticator.Authenticator.PublicUserToken:input_type -> authenticator.PublicUser
+	11, // 13: authenticator.Authenticator.GetPubKey:input_type -> authenticator.KeyID
+	14, // 14: authenticator.Authenticator.ResetUserPW:input_type -> authenticator.UserEmail
+	5,  // 15: authenticator.Authenticator.EnableTOTP:input_type -> authenticator.AuthReply
+	15, // 16: authenticator.Authenticator.ConfirmTOTP:input_type -> authenticator.TOTPCode
+	15, // 17: authenticator.Authenticator.DisableTOTP:input_type -> authenticator.TOTPCode
+	5,  // 18: authenticator.Authenticator.BeginWebAuthnRegistration:input_type -> authenticator.AuthReply
+	20, // 19: authenticator.Authenticator.FinishWebAuthnRegistration:input_type -> authenticator.WebAuthnCredential
+	18, // 20: authenticator.Authenticator.BeginWebAuthnLogin:input_type -> authenticator.WebAuthnLogin
+	20, // 21: authenticator.Authenticator.FinishWebAuthnLogin:input_type -> authenticator.WebAuthnCredential
+	29, // 22: authenticator.Authenticator.ListPubKeys:input_type -> google.protobuf.Empty
+	21, // 23: authenticator.Authenticator.CheckOIDCClient:input_type -> authenticator.OIDCClientRequest
+	23, // 24: authenticator.Authenticator.AuthorizeOIDC:input_type -> authenticator.OIDCAuthRequest
+	25, // 25: authenticator.Authenticator.ExchangeOIDCCode:input_type -> authenticator.OIDCTokenRequest
+	5,  // 26: authenticator.Authenticator.GetOIDCUserInfo:input_type -> authenticator.AuthReply
+	4,  // 27: authenticator.Authenticator.RegisterPwUser:output_type -> authenticator.RegistrationReply
+	5,  // 28: authenticator.Authenticator.AuthenticatePwUser:output_type -> authenticator.AuthReply
+	5,  // 29: authenticator.Authenticator.VerifyTOTP:output_type -> authenticator.AuthReply
+	8,  // 30: authenticator.Authenticator.ChangeUserPw:output_type -> authenticator.ChangePwReply
+	9,  // 31: authenticator.Authenticator.CheckUserExists:output_type -> authenticator.Exists
+	5,  // 32: authenticator.Authenticator.VerifyUser:output_type -> authenticator.AuthReply
+	5,  // 33: authenticator.Authenticator.RefreshToken:output_type -> authenticator.AuthReply
+	5,  // 34: authenticator.Authenticator.PublicUserToken:output_type -> authenticator.AuthReply
+	12, // 35: authenticator.Authenticator.GetPubKey:output_type -> authenticator.PublicKey
+	29, // 36: authenticator.Authenticator.ResetUserPW:output_type -> google.protobuf.Empty
+	16, // 37: authenticator.Authenticator.EnableTOTP:output_type -> authenticator.TOTPSecret
+	17, // 38: authenticator.Authenticator.ConfirmTOTP:output_type -> authenticator.RecoveryCodes
+	29, // 39: authenticator.Authenticator.DisableTOTP:output_type -> google.protobuf.Empty
+	19, // 40: authenticator.Authenticator.BeginWebAuthnRegistration:output_type -> authenticator.WebAuthnOptions
+	29, // 41: authenticator.Authenticator.FinishWebAuthnRegistration:output_type -> google.protobuf.Empty
+	19, // 42: authenticator.Authenticator.BeginWebAuthnLogin:output_type -> authenticator.WebAuthnOptions
+	5,  // 43: authenticator.Authenticator.FinishWebAuthnLogin:output_type -> authenticator.AuthReply
+	13, // 44: authenticator.Authenticator.ListPubKeys:output_type -> authenticator.PublicKeys
+	22, // 45: authenticator.Authenticator.CheckOIDCClient:output_type -> authenticator.OIDCClient
+	24, // 46: authenticator.Authenticator.AuthorizeOIDC:output_type -> authenticator.OIDCCode
+	26, // 47: authenticator.Authenticator.ExchangeOIDCCode:output_type -> authenticator.OIDCTokens
+	27, // 48: authenticator.Authenticator.GetOIDCUserInfo:output_type -> authenticator.OIDCUserInfo
+	27, // [27:49] is the sub-list for method output_type
+	5,  // [5:27] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_authenticator_proto_init() }
+func file_authenticator_proto_init() {
+	if File_authenticator_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_authenticator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringSlice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallBackUrl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
 			default:
@@ -1135,6 +2167,18 @@ func file_authenticator_proto_init() {
 			}
 		}
 		file_authenticator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublicKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserEmail); i {
 			case 0:
 				return &v.state
@@ -1146,6 +2190,162 @@ func file_authenticator_proto_init() {
 				return nil
 			}
 		}
+		file_authenticator_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TOTPCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TOTPSecret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryCodes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebAuthnLogin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebAuthnOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebAuthnCredential); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCClientRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCClient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCAuthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCTokens); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authenticator_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCUserInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_authenticator_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*NewUserPassword_OldPassword)(nil),
@@ -1157,7 +2357,7 @@ func file_authenticator_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_authenticator_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1188,8 +2388,14 @@ type AuthenticatorClient interface {
 	// Authorization: Public
 	RegisterPwUser(ctx context.Context, in *RegistrationData, opts ...grpc.CallOption) (*RegistrationReply, error)
 	// PasswordAuth authenticates the user by its registered email or username and password.
+	// When the user has two-factor authentication enabled, the reply has mfa_required set
+	// and holds a short-lived token, which needs to be exchanged using VerifyTOTP.
 	// Authorization: Public
 	AuthenticatePwUser(ctx context.Context, in *UserPassword, opts ...grpc.CallOption) (*AuthReply, error)
+	// VerifyTOTP completes a two-factor login, using the token from AuthenticatePwUser
+	// and a TOTP or recovery code.
+	// Authorization: Public
+	VerifyTOTP(ctx context.Context, in *TOTPCode, opts ...grpc.CallOption) (*AuthReply, error)
 	// ChangeUserPw changes the password for the user. It needs either the old password or a password reset token.
 	// Authorization: Public
 	ChangeUserPw(ctx context.Context, in *NewUserPassword, opts ...grpc.CallOption) (*ChangePwReply, error)
@@ -1214,91 +2420,255 @@ type AuthenticatorClient interface {
 	// The e-mail will contain an URL, as per passed CallBackURL.
 	// The URL will contain a token which (only) can be used for setting a new password.
 	ResetUserPW(ctx context.Context, in *UserEmail, opts ...grpc.CallOption) (*empty.Empty, error)
+	// EnableTOTP starts two-factor authentication enrollment for the user of the token.
+	// It returns the TOTP secret and provisioning URI, to be scanned by an authenticator app.
+	// Calling it again before ConfirmTOTP returns the same secret.
+	// Authorization: User token
+	EnableTOTP(ctx context.Context, in *AuthReply, opts ...grpc.CallOption) (*TOTPSecret, error)
+	// ConfirmTOTP completes enrollment with a first TOTP code, enabling two-factor authentication.
+	// It returns single use recovery codes, which are not retrievable afterwards.
+	// Authorization: User token
+	ConfirmTOTP(ctx context.Context, in *TOTPCode, opts ...grpc.CallOption) (*RecoveryCodes, error)
+	// DisableTOTP disables two-factor authentication, using a TOTP or recovery code.
+	// Authorization: User token
+	DisableTOTP(ctx context.Context, in *TOTPCode, opts ...grpc.CallOption) (*empty.Empty, error)
+	// BeginWebAuthnRegistration starts registration of a WebAuthn credential (passkey) for the user of the token.
+	// The returned options are passed to navigator.credentials.create() in the browser.
+	// Authorization: User token
+	BeginWebAuthnRegistration(ctx context.Context, in *AuthReply, opts ...grpc.CallOption) (*WebAuthnOptions, error)
+	// FinishWebAuthnRegistration verifies and stores the credential created by the browser.
+	// Authorization: User token
+	FinishWebAuthnRegistration(ctx context.Context, in *WebAuthnCredential, opts ...grpc.CallOption) (*empty.Empty, error)
+	// BeginWebAuthnLogin starts a WebAuthn login.
+	// The returned options are passed to navigator.credentials.get() in the browser.
+	// Without email, any discoverable credential (passkey) of the relying party can be used.
+	// Authorization: Public
+	BeginWebAuthnLogin(ctx context.Context, in *WebAuthnLogin, opts ...grpc.CallOption) (*WebAuthnOptions, error)
+	// FinishWebAuthnLogin verifies the assertion of the browser and authenticates its user.
+	// Authorization: Public
+	FinishWebAuthnLogin(ctx context.Context, in *WebAuthnCredential, opts ...grpc.CallOption) (*AuthReply, error)
+	// ListPubKeys returns the public keys which may have signed tokens that are still valid.
+	// Authorization: Public
+	ListPubKeys(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PublicKeys, error)
+	// CheckOIDCClient verifies that the OpenID Connect client exists
+	// and that the redirect URI is registered for it.
+	// Authorization: Public
+	CheckOIDCClient(ctx context.Context, in *OIDCClientRequest, opts ...grpc.CallOption) (*OIDCClient, error)
+	// AuthorizeOIDC issues an authorization code to an OpenID Connect client,
+	// for the user of the token.
+	// Only the "S256" PKCE code challenge method is supported.
+	// Authorization: User token
+	AuthorizeOIDC(ctx context.Context, in *OIDCAuthRequest, opts ...grpc.CallOption) (*OIDCCode, error)
+	// ExchangeOIDCCode exchanges an authorization code for an ID and access token.
+	// Confidential clients need to pass their secret.
+	// Authorization: Public
+	ExchangeOIDCCode(ctx context.Context, in *OIDCTokenRequest, opts ...grpc.CallOption) (*OIDCTokens, error)
+	// GetOIDCUserInfo returns the claims of the user of an OpenID Connect access token,
+	// as allowed by its scope.
+	// Authorization: OIDC access token
+	GetOIDCUserInfo(ctx context.Context, in *AuthReply, opts ...grpc.CallOption) (*OIDCUserInfo, error)
 }
 
 type authenticatorClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewAuthenticatorClient(cc grpc.ClientConnInterface) AuthenticatorClient {
-	return &authenticatorClient{cc}
+func NewAuthenticatorClient(cc grpc.ClientConnInterface) AuthenticatorClient {
+	return &authenticatorClient{cc}
+}
+
+func (c *authenticatorClient) RegisterPwUser(ctx context.Context, in *RegistrationData, opts ...grpc.CallOption) (*RegistrationReply, error) {
+	out := new(RegistrationReply)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/RegisterPwUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticatorClient) AuthenticatePwUser(ctx context.Context, in *UserPassword, opts ...grpc.CallOption) (*AuthReply, error) {
+	out := new(AuthReply)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/AuthenticatePwUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticatorClient) VerifyTOTP(ctx context.Context, in *TOTPCode, opts ...grpc.CallOption) (*AuthReply, error) {
+	out := new(AuthReply)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/VerifyTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticatorClient) ChangeUserPw(ctx context.Context, in *NewUserPassword, opts ...grpc.CallOption) (*ChangePwReply, error) {
+	out := new(ChangePwReply)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/ChangeUserPw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticatorClient) CheckUserExists(ctx context.Context, in *UserData, opts ...grpc.CallOption) (*Exists, error) {
+	out := new(Exists)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/CheckUserExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticatorClient) VerifyUser(ctx context.Context, in *AuthReply, opts ...grpc.CallOption) (*AuthReply, error) {
+	out := new(AuthReply)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/VerifyUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticatorClient) RefreshToken(ctx context.Context, in *AuthReply, opts ...grpc.CallOption) (*AuthReply, error) {
+	out := new(AuthReply)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticatorClient) PublicUserToken(ctx context.Context, in *PublicUser, opts ...grpc.CallOption) (*AuthReply, error) {
+	out := new(AuthReply)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/PublicUserToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticatorClient) GetPubKey(ctx context.Context, in *KeyID, opts ...grpc.CallOption) (*PublicKey, error) {
+	out := new(PublicKey)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/GetPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticatorClient) ResetUserPW(ctx context.Context, in *UserEmail, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/ResetUserPW", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticatorClient) EnableTOTP(ctx context.Context, in *AuthReply, opts ...grpc.CallOption) (*TOTPSecret, error) {
+	out := new(TOTPSecret)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/EnableTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticatorClient) ConfirmTOTP(ctx context.Context, in *TOTPCode, opts ...grpc.CallOption) (*RecoveryCodes, error) {
+	out := new(RecoveryCodes)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/ConfirmTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticatorClient) DisableTOTP(ctx context.Context, in *TOTPCode, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/DisableTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *authenticatorClient) RegisterPwUser(ctx context.Context, in *RegistrationData, opts ...grpc.CallOption) (*RegistrationReply, error) {
-	out := new(RegistrationReply)
-	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/RegisterPwUser", in, out, opts...)
+func (c *authenticatorClient) BeginWebAuthnRegistration(ctx context.Context, in *AuthReply, opts ...grpc.CallOption) (*WebAuthnOptions, error) {
+	out := new(WebAuthnOptions)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/BeginWebAuthnRegistration", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *authenticatorClient) AuthenticatePwUser(ctx context.Context, in *UserPassword, opts ...grpc.CallOption) (*AuthReply, error) {
-	out := new(AuthReply)
-	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/AuthenticatePwUser", in, out, opts...)
+func (c *authenticatorClient) FinishWebAuthnRegistration(ctx context.Context, in *WebAuthnCredential, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/FinishWebAuthnRegistration", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *authenticatorClient) ChangeUserPw(ctx context.Context, in *NewUserPassword, opts ...grpc.CallOption) (*ChangePwReply, error) {
-	out := new(ChangePwReply)
-	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/ChangeUserPw", in, out, opts...)
+func (c *authenticatorClient) BeginWebAuthnLogin(ctx context.Context, in *WebAuthnLogin, opts ...grpc.CallOption) (*WebAuthnOptions, error) {
+	out := new(WebAuthnOptions)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/BeginWebAuthnLogin", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *authenticatorClient) CheckUserExists(ctx context.Context, in *UserData, opts ...grpc.CallOption) (*Exists, error) {
-	out := new(Exists)
-	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/CheckUserExists", in, out, opts...)
+func (c *authenticatorClient) FinishWebAuthnLogin(ctx context.Context, in *WebAuthnCredential, opts ...grpc.CallOption) (*AuthReply, error) {
+	out := new(AuthReply)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/FinishWebAuthnLogin", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *authenticatorClient) VerifyUser(ctx context.Context, in *AuthReply, opts ...grpc.CallOption) (*AuthReply, error) {
-	out := new(AuthReply)
-	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/VerifyUser", in, out, opts...)
+func (c *authenticatorClient) ListPubKeys(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PublicKeys, error) {
+	out := new(PublicKeys)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/ListPubKeys", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *authenticatorClient) RefreshToken(ctx context.Context, in *AuthReply, opts ...grpc.CallOption) (*AuthReply, error) {
-	out := new(AuthReply)
-	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/RefreshToken", in, out, opts...)
+func (c *authenticatorClient) CheckOIDCClient(ctx context.Context, in *OIDCClientRequest, opts ...grpc.CallOption) (*OIDCClient, error) {
+	out := new(OIDCClient)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/CheckOIDCClient", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *authenticatorClient) PublicUserToken(ctx context.Context, in *PublicUser, opts ...grpc.CallOption) (*AuthReply, error) {
-	out := new(AuthReply)
-	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/PublicUserToken", in, out, opts...)
+func (c *authenticatorClient) AuthorizeOIDC(ctx context.Context, in *OIDCAuthRequest, opts ...grpc.CallOption) (*OIDCCode, error) {
+	out := new(OIDCCode)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/AuthorizeOIDC", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *authenticatorClient) GetPubKey(ctx context.Context, in *KeyID, opts ...grpc.CallOption) (*PublicKey, error) {
-	out := new(PublicKey)
-	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/GetPubKey", in, out, opts...)
+func (c *authenticatorClient) ExchangeOIDCCode(ctx context.Context, in *OIDCTokenRequest, opts ...grpc.CallOption) (*OIDCTokens, error) {
+	out := new(OIDCTokens)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/ExchangeOIDCCode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *authenticatorClient) ResetUserPW(ctx context.Context, in *UserEmail, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/ResetUserPW", in, out, opts...)
+func (c *authenticatorClient) GetOIDCUserInfo(ctx context.Context, in *AuthReply, opts ...grpc.CallOption) (*OIDCUserInfo, error) {
+	out := new(OIDCUserInfo)
+	err := c.cc.Invoke(ctx, "/authenticator.Authenticator/GetOIDCUserInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1312,8 +2682,14 @@ type AuthenticatorServer interface {
 	// Authorization: Public
 	RegisterPwUser(context.Context, *RegistrationData) (*RegistrationReply, error)
 	// PasswordAuth authenticates the user by its registered email or username and password.
+	// When the user has two-factor authentication enabled, the reply has mfa_required set
+	// and holds a short-lived token, which needs to be exchanged using VerifyTOTP.
 	// Authorization: Public
 	AuthenticatePwUser(context.Context, *UserPassword) (*AuthReply, error)
+	// VerifyTOTP completes a two-factor login, using the token from AuthenticatePwUser
+	// and a TOTP or recovery code.
+	// Authorization: Public
+	VerifyTOTP(context.Context, *TOTPCode) (*AuthReply, error)
 	// ChangeUserPw changes the password for the user. It needs either the old password or a password reset token.
 	// Authorization: Public
 	ChangeUserPw(context.Context, *NewUserPassword) (*ChangePwReply, error)
@@ -1338,6 +2714,53 @@ type AuthenticatorServer interface {
 	// The e-mail will contain an URL, as per passed CallBackURL.
 	// The URL will contain a token which (only) can be used for setting a new password.
 	ResetUserPW(context.Context, *UserEmail) (*empty.Empty, error)
+	// EnableTOTP starts two-factor authentication enrollment for the user of the token.
+	// It returns the TOTP secret and provisioning URI, to be scanned by an authenticator app.
+	// Calling it again before ConfirmTOTP returns the same secret.
+	// Authorization: User token
+	EnableTOTP(context.Context, *AuthReply) (*TOTPSecret, error)
+	// ConfirmTOTP completes enrollment with a first TOTP code, enabling two-factor authentication.
+	// It returns single use recovery codes, which are not retrievable afterwards.
+	// Authorization: User token
+	ConfirmTOTP(context.Context, *TOTPCode) (*RecoveryCodes, error)
+	// DisableTOTP disables two-factor authentication, using a TOTP or recovery code.
+	// Authorization: User token
+	DisableTOTP(context.Context, *TOTPCode) (*empty.Empty, error)
+	// BeginWebAuthnRegistration starts registration of a WebAuthn credential (passkey) for the user of the token.
+	// The returned options are passed to navigator.credentials.create() in the browser.
+	// Authorization: User token
+	BeginWebAuthnRegistration(context.Context, *AuthReply) (*WebAuthnOptions, error)
+	// FinishWebAuthnRegistration verifies and stores the credential created by the browser.
+	// Authorization: User token
+	FinishWebAuthnRegistration(context.Context, *WebAuthnCredential) (*empty.Empty, error)
+	// BeginWebAuthnLogin starts a WebAuthn login.
+	// The returned options are passed to navigator.credentials.get() in the browser.
+	// Without email, any discoverable credential (passkey) of the relying party can be used.
+	// Authorization: Public
+	BeginWebAuthnLogin(context.Context, *WebAuthnLogin) (*WebAuthnOptions, error)
+	// FinishWebAuthnLogin verifies the assertion of the browser and authenticates its user.
+	// Authorization: Public
+	FinishWebAuthnLogin(context.Context, *WebAuthnCredential) (*AuthReply, error)
+	// ListPubKeys returns the public keys which may have signed tokens that are still valid.
+	// Authorization: Public
+	ListPubKeys(context.Context, *empty.Empty) (*PublicKeys, error)
+	// CheckOIDCClient verifies that the OpenID Connect client exists
+	// and that the redirect URI is registered for it.
+	// Authorization: Public
+	CheckOIDCClient(context.Context, *OIDCClientRequest) (*OIDCClient, error)
+	// AuthorizeOIDC issues an authorization code to an OpenID Connect client,
+	// for the user of the token.
+	// Only the "S256" PKCE code challenge method is supported.
+	// Authorization: User token
+	AuthorizeOIDC(context.Context, *OIDCAuthRequest) (*OIDCCode, error)
+	// ExchangeOIDCCode exchanges an authorization code for an ID and access token.
+	// Confidential clients need to pass their secret.
+	// Authorization: Public
+	ExchangeOIDCCode(context.Context, *OIDCTokenRequest) (*OIDCTokens, error)
+	// GetOIDCUserInfo returns the claims of the user of an OpenID Connect access token,
+	// as allowed by its scope.
+	// Authorization: OIDC access token
+	GetOIDCUserInfo(context.Context, *AuthReply) (*OIDCUserInfo, error)
 }
 
 // UnimplementedAuthenticatorServer can be embedded to have forward compatible implementations.
@@ -1350,6 +2773,9 @@ func (*UnimplementedAuthenticatorServer) RegisterPwUser(context.Context, *Regist
 func (*UnimplementedAuthenticatorServer) AuthenticatePwUser(context.Context, *UserPassword) (*AuthReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthenticatePwUser not implemented")
 }
+func (*UnimplementedAuthenticatorServer) VerifyTOTP(context.Context, *TOTPCode) (*AuthReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTOTP not implemented")
+}
 func (*UnimplementedAuthenticatorServer) ChangeUserPw(context.Context, *NewUserPassword) (*ChangePwReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeUserPw not implemented")
 }
@@ -1371,6 +2797,42 @@ func (*UnimplementedAuthenticatorServer) GetPubKey(context.Context, *KeyID) (*Pu
 func (*UnimplementedAuthenticatorServer) ResetUserPW(context.Context, *UserEmail) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetUserPW not implemented")
 }
+func (*UnimplementedAuthenticatorServer) EnableTOTP(context.Context, *AuthReply) (*TOTPSecret, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableTOTP not implemented")
+}
+func (*UnimplementedAuthenticatorServer) ConfirmTOTP(context.Context, *TOTPCode) (*RecoveryCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (*UnimplementedAuthenticatorServer) DisableTOTP(context.Context, *TOTPCode) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (*UnimplementedAuthenticatorServer) BeginWebAuthnRegistration(context.Context, *AuthReply) (*WebAuthnOptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginWebAuthnRegistration not implemented")
+}
+func (*UnimplementedAuthenticatorServer) FinishWebAuthnRegistration(context.Context, *WebAuthnCredential) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishWebAuthnRegistration not implemented")
+}
+func (*UnimplementedAuthenticatorServer) BeginWebAuthnLogin(context.Context, *WebAuthnLogin) (*WebAuthnOptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginWebAuthnLogin not implemented")
+}
+func (*UnimplementedAuthenticatorServer) FinishWebAuthnLogin(context.Context, *WebAuthnCredential) (*AuthReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishWebAuthnLogin not implemented")
+}
+func (*UnimplementedAuthenticatorServer) ListPubKeys(context.Context, *empty.Empty) (*PublicKeys, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPubKeys not implemented")
+}
+func (*UnimplementedAuthenticatorServer) CheckOIDCClient(context.Context, *OIDCClientRequest) (*OIDCClient, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckOIDCClient not implemented")
+}
+func (*UnimplementedAuthenticatorServer) AuthorizeOIDC(context.Context, *OIDCAuthRequest) (*OIDCCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeOIDC not implemented")
+}
+func (*UnimplementedAuthenticatorServer) ExchangeOIDCCode(context.Context, *OIDCTokenRequest) (*OIDCTokens, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeOIDCCode not implemented")
+}
+func (*UnimplementedAuthenticatorServer) GetOIDCUserInfo(context.Context, *AuthReply) (*OIDCUserInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOIDCUserInfo not implemented")
+}
 
 func RegisterAuthenticatorServer(s *grpc.Server, srv AuthenticatorServer) {
 	s.RegisterService(&_Authenticator_serviceDesc, srv)
@@ -1412,6 +2874,24 @@ func _Authenticator_AuthenticatePwUser_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Authenticator_VerifyTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorServer).VerifyTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authenticator.Authenticator/VerifyTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorServer).VerifyTOTP(ctx, req.(*TOTPCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Authenticator_ChangeUserPw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NewUserPassword)
 	if err := dec(in); err != nil {
@@ -1538,6 +3018,222 @@ func _Authenticator_ResetUserPW_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Authenticator_EnableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthReply)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorServer).EnableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authenticator.Authenticator/EnableTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorServer).EnableTOTP(ctx, req.(*AuthReply))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authenticator_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authenticator.Authenticator/ConfirmTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorServer).ConfirmTOTP(ctx, req.(*TOTPCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authenticator_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authenticator.Authenticator/DisableTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorServer).DisableTOTP(ctx, req.(*TOTPCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authenticator_BeginWebAuthnRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthReply)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorServer).BeginWebAuthnRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authenticator.Authenticator/BeginWebAuthnRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorServer).BeginWebAuthnRegistration(ctx, req.(*AuthReply))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authenticator_FinishWebAuthnRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebAuthnCredential)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorServer).FinishWebAuthnRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authenticator.Authenticator/FinishWebAuthnRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorServer).FinishWebAuthnRegistration(ctx, req.(*WebAuthnCredential))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authenticator_BeginWebAuthnLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebAuthnLogin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorServer).BeginWebAuthnLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authenticator.Authenticator/BeginWebAuthnLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorServer).BeginWebAuthnLogin(ctx, req.(*WebAuthnLogin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authenticator_FinishWebAuthnLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebAuthnCredential)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorServer).FinishWebAuthnLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authenticator.Authenticator/FinishWebAuthnLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorServer).FinishWebAuthnLogin(ctx, req.(*WebAuthnCredential))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authenticator_ListPubKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorServer).ListPubKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authenticator.Authenticator/ListPubKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorServer).ListPubKeys(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authenticator_CheckOIDCClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OIDCClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorServer).CheckOIDCClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authenticator.Authenticator/CheckOIDCClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorServer).CheckOIDCClient(ctx, req.(*OIDCClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authenticator_AuthorizeOIDC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OIDCAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorServer).AuthorizeOIDC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authenticator.Authenticator/AuthorizeOIDC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorServer).AuthorizeOIDC(ctx, req.(*OIDCAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authenticator_ExchangeOIDCCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OIDCTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorServer).ExchangeOIDCCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authenticator.Authenticator/ExchangeOIDCCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorServer).ExchangeOIDCCode(ctx, req.(*OIDCTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authenticator_GetOIDCUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthReply)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticatorServer).GetOIDCUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authenticator.Authenticator/GetOIDCUserInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticatorServer).GetOIDCUserInfo(ctx, req.(*AuthReply))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Authenticator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "authenticator.Authenticator",
 	HandlerType: (*AuthenticatorServer)(nil),
@@ -1550,6 +3246,10 @@ var _Authenticator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AuthenticatePwUser",
 			Handler:    _Authenticator_AuthenticatePwUser_Handler,
 		},
+		{
+			MethodName: "VerifyTOTP",
+			Handler:    _Authenticator_VerifyTOTP_Handler,
+		},
 		{
 			MethodName: "ChangeUserPw",
 			Handler:    _Authenticator_ChangeUserPw_Handler,
@@ -1578,6 +3278,54 @@ var _Authenticator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResetUserPW",
 			Handler:    _Authenticator_ResetUserPW_Handler,
 		},
+		{
+			MethodName: "EnableTOTP",
+			Handler:    _Authenticator_EnableTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _Authenticator_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _Authenticator_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "BeginWebAuthnRegistration",
+			Handler:    _Authenticator_BeginWebAuthnRegistration_Handler,
+		},
+		{
+			MethodName: "FinishWebAuthnRegistration",
+			Handler:    _Authenticator_FinishWebAuthnRegistration_Handler,
+		},
+		{
+			MethodName: "BeginWebAuthnLogin",
+			Handler:    _Authenticator_BeginWebAuthnLogin_Handler,
+		},
+		{
+			MethodName: "FinishWebAuthnLogin",
+			Handler:    _Authenticator_FinishWebAuthnLogin_Handler,
+		},
+		{
+			MethodName: "ListPubKeys",
+			Handler:    _Authenticator_ListPubKeys_Handler,
+		},
+		{
+			MethodName: "CheckOIDCClient",
+			Handler:    _Authenticator_CheckOIDCClient_Handler,
+		},
+		{
+			MethodName: "AuthorizeOIDC",
+			Handler:    _Authenticator_AuthorizeOIDC_Handler,
+		},
+		{
+			MethodName: "ExchangeOIDCCode",
+			Handler:    _Authenticator_ExchangeOIDCCode_Handler,
+		},
+		{
+			MethodName: "GetOIDCUserInfo",
+			Handler:    _Authenticator_GetOIDCUserInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "authenticator.proto",
@@ -0,0 +1,248 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moapis/authenticator/models"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+const (
+	// clientIDLen is the amount of random bytes in a generated client ID
+	clientIDLen = 16
+	// clientSecretLen is the amount of random bytes in a generated client secret
+	clientSecretLen = 32
+)
+
+// randomString returns n random bytes, base64url encoded.
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := crand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// newClientSecret returns a random secret and its hash, as checked by the authenticator server.
+func newClientSecret() (string, []byte, error) {
+	secret, err := randomString(clientSecretLen)
+	if err != nil {
+		return "", nil, err
+	}
+	sum := sha256.Sum256([]byte(secret))
+	return secret, sum[:], nil
+}
+
+// parseRedirectURIs returns the absolute URLs from text, one per line.
+func parseRedirectURIs(text string) (types.StringArray, error) {
+	var uris types.StringArray
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		u, err := url.Parse(line)
+		if err != nil {
+			return nil, err
+		}
+		if !u.IsAbs() || u.Host == "" || u.Fragment != "" {
+			return nil, fmt.Errorf("redirect URI %q must be absolute, without fragment", line)
+		}
+		uris = append(uris, line)
+	}
+	if len(uris) == 0 {
+		return nil, fmt.Errorf(errMissingField, "redirect_uris")
+	}
+	return uris, nil
+}
+
+func clientActions(id int) []action {
+	return []action{
+		{"new secret", fmt.Sprintf("/clients/secret/%d", id), http.MethodPut},
+		{"delete", fmt.Sprintf("/clients/delete/%d", id), http.MethodDelete},
+	}
+}
+
+func clientList(ctx context.Context, exec boil.ContextExecutor) (*listContents, error) {
+	clients, err := models.OidcClients(qm.OrderBy(models.OidcClientColumns.ID)).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]listEntry, len(clients))
+	for i, c := range clients {
+		list[i] = listEntry{
+			ID:      c.ID,
+			Name:    c.Name,
+			Created: c.CreatedAt.Format(time.RFC3339),
+			Updated: c.UpdatedAt.Format(time.RFC3339),
+			Actions: clientActions(c.ID),
+		}
+	}
+	return &listContents{"clients", list}, nil
+}
+
+type clientView struct {
+	*models.OidcClient
+	Secret  string // Only set once, after creation
+	Actions []action
+}
+
+func renderClient(w http.ResponseWriter, entry *logrus.Entry, view clientView) {
+	tmpl, err := template.ParseFiles(tmplPaths("client.html", "panel.html", "base.html")...)
+	if isInternalError(entry, w, err) {
+		return
+	}
+
+	if err = tmpl.ExecuteTemplate(w, "base", tmplData{
+		Title: fmt.Sprintf("Client %d", view.ID),
+		Panel: true,
+		BreadCrumbs: []breadCrumb{
+			{"Home", "/"},
+			{"Clients", "/clients/"},
+			{strconv.Itoa(view.ID), ""},
+		},
+		Content: view,
+	}); err != nil {
+		entry.WithError(err).Error("ExecuteTemplate")
+	}
+}
+
+func clientHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	entry := r.Context().Value(logEntry).(*logrus.Entry).WithFields(logrus.Fields{"handler": "clientHandler", "vars": vars})
+	id := aToiMap(entry, vars)["id"]
+
+	tx, err := mdb.MultiTx(r.Context(), nil, conf.SQLRoutines)
+	if isInternalError(entry, w, err) {
+		return
+	}
+	defer tx.Rollback()
+
+	cm, err := models.FindOidcClient(r.Context(), tx, id)
+	if err == sql.ErrNoRows {
+		http.NotFound(w, r)
+		return
+	} else if isInternalError(entry, w, err) {
+		return
+	}
+	entry = entry.WithField("client", cm.ClientID)
+
+	renderClient(w, entry, clientView{cm, "", clientActions(id)})
+	entry.Debug("Served")
+}
+
+// newClientPostHandler registers a new OpenID Connect client.
+// The generated secret of confidential clients is only shown in the response.
+func newClientPostHandler(w http.ResponseWriter, r *http.Request) {
+	entry := r.Context().Value(logEntry).(*logrus.Entry).WithFields(logrus.Fields{"handler": "newClientPostHandler"})
+	data, err := parseForm(w, r, []string{"name", "redirect_uris"})
+	if err != nil {
+		entry.WithError(err).Warn("parseForm")
+		return
+	}
+	entry = entry.WithField("data", data)
+
+	uris, err := parseRedirectURIs(data["redirect_uris"])
+	if err != nil {
+		entry.WithError(err).Warn("parseRedirectURIs")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf("%d Bad request: %v", http.StatusBadRequest, err)))
+		return
+	}
+
+	cm := &models.OidcClient{
+		Name:         data["name"],
+		Description:  strings.TrimSpace(r.PostForm.Get("description")),
+		RedirectUris: uris,
+	}
+	if cm.ClientID, err = randomString(clientIDLen); isInternalError(entry, w, err) {
+		return
+	}
+	var secret string
+	if r.PostForm.Get("confidential") != "" {
+		var hash []byte
+		if secret, hash, err = newClientSecret(); isInternalError(entry, w, err) {
+			return
+		}
+		cm.SecretHash = null.BytesFrom(hash)
+	}
+
+	tx, err := mdb.MasterTx(r.Context(), nil)
+	if isInternalError(entry, w, err) {
+		return
+	}
+	defer tx.Rollback()
+
+	if err = cm.Insert(r.Context(), tx, boil.Infer()); isInternalError(entry, w, err) {
+		return
+	}
+	if err = tx.Commit(); isInternalError(entry, w, err) {
+		return
+	}
+	entry = entry.WithField("client", cm.ClientID)
+	entry.Info("New client")
+
+	renderClient(w, entry, clientView{cm, secret, clientActions(cm.ID)})
+}
+
+// newClientSecretHandler replaces the secret of a client and returns it.
+// Public clients become confidential.
+func newClientSecretHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	entry := r.Context().Value(logEntry).(*logrus.Entry).WithFields(logrus.Fields{"handler": "newClientSecretHandler", "vars": vars})
+	id := aToiMap(entry, vars)["id"]
+
+	secret, hash, err := newClientSecret()
+	if isInternalError(entry, w, err) {
+		return
+	}
+
+	tx, err := mdb.MasterTx(r.Context(), nil)
+	if isInternalError(entry, w, err) {
+		return
+	}
+	defer tx.Rollback()
+
+	ra, err := models.OidcClients(models.OidcClientWhere.ID.EQ(id)).UpdateAll(r.Context(), tx, models.M{
+		models.OidcClientColumns.SecretHash: hash,
+		models.OidcClientColumns.UpdatedAt:  time.Now(),
+	})
+	if isInternalError(entry, w, err) {
+		return
+	}
+	if ra == 0 {
+		http.NotFound(w, r)
+		return
+	}
+	if err = tx.Commit(); isInternalError(entry, w, err) {
+		return
+	}
+	entry.Info("New client secret")
+	if _, err = w.Write([]byte(
+		fmt.Sprintf("New secret for client %d, it will not be shown again: %s", id, secret),
+	)); err != nil {
+		entry.WithError(err).Error("Writing response")
+	}
+	entry.Debug("Served")
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"crypto/sha256"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+func Test_parseRedirectURIs(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    types.StringArray
+		wantErr bool
+	}{
+		{"Empty", " \n ", nil, true},
+		{"Single", "https://client.com/cb", types.StringArray{"https://client.com/cb"}, false},
+		{"Lines", "https://client.com/cb\r\n\r\n  http://localhost:8080/cb  \n", types.StringArray{"https://client.com/cb", "http://localhost:8080/cb"}, false},
+		{"Relative", "/cb", nil, true},
+		{"Fragment", "https://client.com/cb#foo", nil, true},
+		{"Malformed", "https://client.com/%zz", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseRedirectURIs(tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseRedirectURIs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseRedirectURIs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_newClientSecret(t *testing.T) {
+	secret, hash, err := newClientSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum := sha256.Sum256([]byte(secret)); !reflect.DeepEqual(hash, sum[:]) {
+		t.Errorf("newClientSecret() hash = %x, want %x", hash, sum)
+	}
+	other, _, err := newClientSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret == other {
+		t.Errorf("newClientSecret() = %s twice", secret)
+	}
+}
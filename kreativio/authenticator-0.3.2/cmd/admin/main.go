@@ -81,6 +81,7 @@ const (
 func userActions(id int) []action {
 	return []action{
 		{"reset password", fmt.Sprintf("/users/reset/%d", id), http.MethodPut},
+		{"unlock", fmt.Sprintf("/users/unlock/%d", id), http.MethodPut},
 		{"delete", fmt.Sprintf("/users/delete/%d", id), http.MethodDelete},
 	}
 }
@@ -146,6 +147,27 @@ func audienceList(ctx context.Context, exec boil.ContextExecutor) (*listContents
 	return &listContents{"audiences", list}, nil
 }
 
+func permissionList(ctx context.Context, exec boil.ContextExecutor) (*listContents, error) {
+	permissions, err := models.Permissions(qm.OrderBy(models.PermissionColumns.Name)).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]listEntry, len(permissions))
+	for i, p := range permissions {
+		list[i] = listEntry{
+			ID:      p.ID,
+			Name:    p.Name,
+			Created: p.CreatedAt.Format(time.RFC3339),
+			Updated: p.CreatedAt.Format(time.RFC3339),
+			Actions: []action{
+				{"delete", fmt.Sprintf("/permissions/delete/%d", p.ID), http.MethodDelete},
+			},
+		}
+	}
+	return &listContents{"permissions", list}, nil
+}
+
 const (
 	errIntConv      = "Parse %s of value %s: %w"
 	errMissingField = "Missing %s field data in form"
@@ -183,6 +205,10 @@ func deleteHandler(w http.ResponseWriter, r *http.Request) {
 		rows, err = models.Groups(models.GroupWhere.ID.EQ(id)).DeleteAll(r.Context(), tx)
 	case "audiences":
 		rows, err = models.Audiences(models.AudienceWhere.ID.EQ(id)).DeleteAll(r.Context(), tx)
+	case "permissions":
+		rows, err = models.Permissions(models.PermissionWhere.ID.EQ(id)).DeleteAll(r.Context(), tx)
+	case "clients":
+		rows, err = models.OidcClients(models.OidcClientWhere.ID.EQ(id)).DeleteAll(r.Context(), tx)
 	default:
 		entry.Warn("Unknown resource")
 		w.WriteHeader(http.StatusNotFound)
@@ -229,6 +255,10 @@ func listHandler(w http.ResponseWriter, r *http.Request) {
 		content, err = groupList(r.Context(), tx)
 	case "audiences":
 		content, err = audienceList(r.Context(), tx)
+	case "permissions":
+		content, err = permissionList(r.Context(), tx)
+	case "clients":
+		content, err = clientList(r.Context(), tx)
 	default:
 		entry.Warn("Unknown resource")
 		http.NotFound(w, r)
@@ -277,6 +307,7 @@ func userHandler(w http.ResponseWriter, r *http.Request) {
 		qm.Load(models.UserRels.Password),
 		qm.Load(models.UserRels.Groups),
 		qm.Load(models.UserRels.Audiences),
+		qm.Load(models.UserRels.LoginAttempts, qm.OrderBy(models.LoginAttemptColumns.CreatedAt+" desc"), qm.Limit(loginAttemptsLimit)),
 	).One(r.Context(), tx)
 	if err == sql.ErrNoRows {
 		http.NotFound(w, r)
@@ -306,6 +337,43 @@ func userHandler(w http.ResponseWriter, r *http.Request) {
 	entry.Debug("Served")
 }
 
+// loginAttemptsLimit is the amount of recent login attempts shown on the user page.
+const loginAttemptsLimit = 10
+
+func unlockUserHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	entry := r.Context().Value(logEntry).(*logrus.Entry).WithFields(logrus.Fields{"handler": "unlockUserHandler", "vars": vars})
+	id := aToiMap(entry, vars)["id"]
+
+	tx, err := mdb.MasterTx(r.Context(), nil)
+	if isInternalError(entry, w, err) {
+		return
+	}
+	defer tx.Rollback()
+
+	ra, err := models.Users(models.UserWhere.ID.EQ(id)).UpdateAll(r.Context(), tx, models.M{
+		models.UserColumns.FailedLogins: 0,
+		models.UserColumns.LockedUntil:  nil,
+	})
+	if isInternalError(entry, w, err) {
+		return
+	}
+	if ra == 0 {
+		http.NotFound(w, r)
+		return
+	}
+	if err = tx.Commit(); isInternalError(entry, w, err) {
+		return
+	}
+	entry.Info("Unlocked user")
+	if _, err = w.Write([]byte(
+		fmt.Sprintf("user %d successfully unlocked", id),
+	)); err != nil {
+		entry.WithError(err).Error("Writing response")
+	}
+	entry.Debug("Served")
+}
+
 func removeUserRelationHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	entry := r.Context().Value(logEntry).(*logrus.Entry).WithFields(logrus.Fields{"handler": "removeUserRelationHandler", "vars": vars})
@@ -352,10 +420,12 @@ func newEntityFormHandler(w http.ResponseWriter, r *http.Request) {
 		err  error
 	)
 	switch vars["resource"] {
-	case "groups", "audiences":
+	case "groups", "audiences", "permissions":
 		tmpl, err = template.ParseFiles(tmplPaths("new_relation.html", "panel.html", "base.html")...)
 	case "users":
 		tmpl, err = template.ParseFiles(tmplPaths("new_user.html", "panel.html", "base.html")...)
+	case "clients":
+		tmpl, err = template.ParseFiles(tmplPaths("new_client.html", "panel.html", "base.html")...)
 	default:
 		http.NotFound(w, r)
 		log.Info("Resource not found")
@@ -426,6 +496,11 @@ func newRelation(w http.ResponseWriter, r *http.Request, entry *logrus.Entry, re
 		err = audience.Insert(r.Context(), tx, boil.Infer())
 		entry = entry.WithField("audience", audience)
 		id = audience.ID
+	case "permissions":
+		permission := models.Permission{Name: data["name"], Description: data["description"]}
+		err = permission.Insert(r.Context(), tx, boil.Infer())
+		entry = entry.WithField("permission", permission)
+		id = permission.ID
 	}
 	if isInternalError(entry, w, err) {
 		return
@@ -447,6 +522,11 @@ func newAudiencePostHandler(w http.ResponseWriter, r *http.Request) {
 	newRelation(w, r, entry, "audiences")
 }
 
+func newPermissionPostHandler(w http.ResponseWriter, r *http.Request) {
+	entry := r.Context().Value(logEntry).(*logrus.Entry).WithFields(logrus.Fields{"handler": "newPermissionPostHandler"})
+	newRelation(w, r, entry, "permissions")
+}
+
 func newUserPostHandler(w http.ResponseWriter, r *http.Request) {
 	entry := r.Context().Value(logEntry).(*logrus.Entry).WithFields(logrus.Fields{"handler": "newUserPostHandler"})
 	data, err := parseForm(w, r, []string{"email", "name"})
@@ -491,6 +571,14 @@ const (
 		from auth.user_groups
 		where user_id = $1
 	);`
+	availablePermissionsQuery = `
+	select * 
+	from auth.permissions
+	where id not in (
+		select permission_id
+		from auth.group_permissions
+		where group_id = $1
+	);`
 	availableAudiencesQuery = `
 	select * 
 	from auth.audiences
@@ -589,6 +677,149 @@ func setUserRelationHandler(w http.ResponseWriter, r *http.Request) {
 	entry.Debug("Served")
 }
 
+type groupView struct {
+	*models.Group
+	Actions []action
+}
+
+func groupHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	entry := r.Context().Value(logEntry).(*logrus.Entry).WithFields(logrus.Fields{"handler": "groupHandler", "vars": vars})
+	id := aToiMap(entry, vars)["id"]
+
+	tx, err := mdb.MultiTx(r.Context(), nil, conf.SQLRoutines)
+	if isInternalError(entry, w, err) {
+		return
+	}
+	defer tx.Rollback()
+
+	gm, err := models.Groups(
+		models.GroupWhere.ID.EQ(id),
+		qm.Load(models.GroupRels.Permissions, qm.OrderBy(models.PermissionColumns.Name)),
+	).One(r.Context(), tx)
+	if err == sql.ErrNoRows {
+		http.NotFound(w, r)
+		return
+	} else if isInternalError(entry, w, err) {
+		return
+	}
+	entry = entry.WithFields(logrus.Fields{"group": gm, "permissions": gm.R.Permissions})
+
+	tmpl, err := template.ParseFiles(tmplPaths("group.html", "panel.html", "base.html")...)
+	if isInternalError(entry, w, err) {
+		return
+	}
+
+	if err = tmpl.ExecuteTemplate(w, "base", tmplData{
+		Title: fmt.Sprintf("Group %d", id),
+		Panel: true,
+		BreadCrumbs: []breadCrumb{
+			{"Home", "/"},
+			{"Groups", "../"},
+			{strconv.Itoa(id), ""},
+		},
+		Content: groupView{gm, []action{
+			{"delete", fmt.Sprintf("/groups/delete/%d", id), http.MethodDelete},
+		}},
+	}); err != nil {
+		entry.WithError(err).Error("ExecuteTemplate")
+	}
+	entry.Debug("Served")
+}
+
+func listAvailablePermissionsHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	entry := r.Context().Value(logEntry).(*logrus.Entry).WithFields(logrus.Fields{"handler": "listAvailablePermissionsHandler", "vars": vars})
+	id := aToiMap(entry, vars)["id"]
+
+	tx, err := mdb.MultiTx(r.Context(), nil, conf.SQLRoutines)
+	if isInternalError(entry, w, err) {
+		return
+	}
+	defer tx.Rollback()
+
+	var permissions models.PermissionSlice
+	err = queries.Raw(availablePermissionsQuery, id).Bind(r.Context(), tx, &permissions)
+	if isInternalError(entry, w, err) {
+		return
+	}
+	entry = entry.WithField("content", permissions)
+
+	tmpl, err := template.ParseFiles(tmplPaths("available_relations.html", "panel.html", "base.html")...)
+	if isInternalError(entry, w, err) {
+		return
+	}
+
+	if err = tmpl.ExecuteTemplate(w, "base", tmplData{
+		Title: fmt.Sprintf("Available Permissions for Group %d", id),
+		Panel: true,
+		BreadCrumbs: []breadCrumb{
+			{"Home", "/"},
+			{"Groups", "../../"},
+			{strconv.Itoa(id), "../"},
+			{"Available Permissions", ""},
+		},
+		Content: permissions,
+	}); err != nil {
+		entry.WithError(err).Error("ExecuteTemplate")
+	}
+	entry.Debug("Served")
+}
+
+func setGroupPermissionHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	entry := r.Context().Value(logEntry).(*logrus.Entry).WithFields(logrus.Fields{"handler": "setGroupPermissionHandler", "vars": vars})
+	iv := aToiMap(entry, vars)
+
+	tx, err := mdb.MasterTx(r.Context(), nil)
+	if isInternalError(entry, w, err) {
+		return
+	}
+	defer tx.Rollback()
+
+	gm := &models.Group{ID: iv["id"]}
+	if err = gm.AddPermissions(r.Context(), tx, false, &models.Permission{ID: iv["rid"]}); isInternalError(entry, w, err) {
+		return
+	}
+	if err = tx.Commit(); isInternalError(entry, w, err) {
+		return
+	}
+	entry.Info("Set group permission")
+	if _, err = w.Write([]byte(
+		fmt.Sprintf("permission %d successfully granted to group %d", iv["rid"], iv["id"]),
+	)); err != nil {
+		entry.WithError(err).Error("Writing response")
+	}
+	entry.Debug("Served")
+}
+
+func removeGroupPermissionHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	entry := r.Context().Value(logEntry).(*logrus.Entry).WithFields(logrus.Fields{"handler": "removeGroupPermissionHandler", "vars": vars})
+	iv := aToiMap(entry, vars)
+
+	tx, err := mdb.MasterTx(r.Context(), nil)
+	if isInternalError(entry, w, err) {
+		return
+	}
+	defer tx.Rollback()
+
+	gm := &models.Group{ID: iv["id"]}
+	if err = gm.RemovePermissions(r.Context(), tx, &models.Permission{ID: iv["rid"]}); isInternalError(entry, w, err) {
+		return
+	}
+	if err = tx.Commit(); isInternalError(entry, w, err) {
+		return
+	}
+	entry.Info("Removed group permission")
+	if _, err = w.Write([]byte(
+		fmt.Sprintf("permission %d successfully removed from group %d", iv["rid"], iv["id"]),
+	)); err != nil {
+		entry.WithError(err).Error("Writing response")
+	}
+	entry.Debug("Served")
+}
+
 type logEntryType string
 
 var logEntry = logEntryType("entry")
@@ -677,16 +908,27 @@ func main() {
 	r.HandleFunc("/{resource}/", listHandler)
 
 	r.HandleFunc("/users/{id}/", userHandler)
+	r.Path("/users/unlock/{id}").Methods(http.MethodPut).HandlerFunc(unlockUserHandler)
 	r.Path("/users/{id}/{relation}/").Methods(http.MethodGet).HandlerFunc(listAvailableRelationsHandler)
 	r.Path("/users/{id}/{relation}/{rid}").Methods(http.MethodPut).HandlerFunc(setUserRelationHandler)
 	r.Path("/users/{id}/remove/{relation}/{rid}").Methods(http.MethodPut).HandlerFunc(removeUserRelationHandler)
 
+	r.HandleFunc("/groups/{id}/", groupHandler)
+	r.Path("/groups/{id}/permissions/").Methods(http.MethodGet).HandlerFunc(listAvailablePermissionsHandler)
+	r.Path("/groups/{id}/permissions/{rid}").Methods(http.MethodPut).HandlerFunc(setGroupPermissionHandler)
+	r.Path("/groups/{id}/remove/permissions/{rid}").Methods(http.MethodPut).HandlerFunc(removeGroupPermissionHandler)
+
+	r.HandleFunc("/clients/{id}/", clientHandler)
+	r.Path("/clients/secret/{id}").Methods(http.MethodPut).HandlerFunc(newClientSecretHandler)
+
 	r.Path("/{resource}/delete/{id}").Methods(http.MethodDelete).HandlerFunc(deleteHandler)
 
 	r.Path("/new/{resource}").Methods(http.MethodGet).HandlerFunc(newEntityFormHandler)
 	r.Path("/new/audiences").Methods(http.MethodPost).HandlerFunc(newAudiencePostHandler)
 	r.Path("/new/groups").Methods(http.MethodPost).HandlerFunc(newGroupPostHandler)
+	r.Path("/new/permissions").Methods(http.MethodPost).HandlerFunc(newPermissionPostHandler)
 	r.Path("/new/users").Methods(http.MethodPost).HandlerFunc(newUserPostHandler)
+	r.Path("/new/clients").Methods(http.MethodPost).HandlerFunc(newClientPostHandler)
 
 	srv := &http.Server{
 		Handler:      r,
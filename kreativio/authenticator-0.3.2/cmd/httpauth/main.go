@@ -10,6 +10,7 @@ import (
 	"flag"
 	"fmt"
 	"html/template"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -20,8 +21,10 @@ import (
 	"github.com/inconshreveable/log15/ext"
 	auth "github.com/moapis/authenticator"
 	"github.com/moapis/authenticator/forms"
+	"github.com/moapis/authenticator/oidc"
 	"github.com/moapis/ehtml"
 	clog "github.com/usrpro/clog15"
+	"google.golang.org/grpc/metadata"
 )
 
 func (c *ServerConfig) listen(sc chan os.Signal, h http.Handler) error {
@@ -72,6 +75,19 @@ type reqIDKey struct{}
 
 var reqID reqIDKey
 
+// forwardedFor returns the x-forwarded-for chain of r, with the remote address appended.
+// The authenticator server uses it to rate limit on the client address, instead of ours.
+func forwardedFor(r *http.Request) string {
+	addr, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		addr = r.RemoteAddr
+	}
+	if fwd := r.Header.Get("X-Forwarded-For"); fwd != "" {
+		return fwd + ", " + addr
+	}
+	return addr
+}
+
 func (c *ServerConfig) middleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx, cancel := context.WithTimeout(r.Context(), c.Timeout)
@@ -81,6 +97,7 @@ func (c *ServerConfig) middleware(next http.Handler) http.Handler {
 		id := ext.RandId(5)
 
 		ctx = clog.NewLogger(ctx, "id", id, "uri", r.URL.Path)
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", forwardedFor(r))
 		r = r.WithContext(context.WithValue(ctx, reqID, id))
 
 		next.ServeHTTP(w, r)
@@ -111,21 +128,37 @@ func run(dc *ServerConfig) int {
 	}
 	defer cc.Close()
 
+	client := auth.NewAuthenticatorClient(cc)
+
 	f := &forms.Forms{
 		Tmpl:   tmpl,
 		EP:     ehtml.Pages{Tmpl: tmpl},
 		Data:   conf.Data,
-		Client: auth.NewAuthenticatorClient(cc),
+		Client: client,
 		Paths: &forms.Paths{
 			ServerAddress: conf.ServerAddress,
 		},
 	}
+	p := &oidc.Provider{
+		Issuer: conf.ServerAddress,
+		EP:     ehtml.Pages{Tmpl: tmpl},
+		Client: client,
+	}
 
 	mux := http.NewServeMux()
 	mux.Handle("/static/", http.StripPrefix("/static", http.FileServer(http.Dir(conf.Static))))
 	mux.Handle(forms.DefaultSetPWPath, f.SetPWHandler())
 	mux.Handle(forms.DefaultResetPWPath, f.ResetPWHandler())
 	mux.Handle(forms.DefaultLoginPath, f.LoginHander())
+	mux.Handle(forms.DefaultMFAPath, f.MFAHandler())
+	mux.Handle(forms.DefaultTOTPPath, f.TOTPHandler())
+	mux.Handle(forms.DefaultPasskeyPath, f.PasskeyHandler())
+	mux.Handle(forms.DefaultPasskeysPath, f.PasskeysHandler())
+	mux.Handle(oidc.DefaultDiscoveryPath, p.DiscoveryHandler())
+	mux.Handle(oidc.DefaultJWKSPath, p.JWKSHandler())
+	mux.Handle(oidc.DefaultAuthorizePath, p.AuthorizeHandler())
+	mux.Handle(oidc.DefaultTokenPath, p.TokenHandler())
+	mux.Handle(oidc.DefaultUserInfoPath, p.UserInfoHandler())
 
 	if err = conf.listen(make(chan os.Signal, 1), conf.middleware(mux)); !errors.Is(err, http.ErrServerClosed) {
 		return fatalRun(err)
@@ -14,6 +14,7 @@ import (
 
 	"github.com/inconshreveable/log15"
 	clog "github.com/usrpro/clog15"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestServerConfig_listen(t *testing.T) {
@@ -163,6 +164,18 @@ func Test_run(t *testing.T) {
 	}
 }
 
+func Test_forwardedFor(t *testing.T) {
+	r := httptest.NewRequest("GET", "http://example.com/login", nil)
+	r.RemoteAddr = "10.0.0.1:1234"
+	if got := forwardedFor(r); got != "10.0.0.1" {
+		t.Errorf("forwardedFor() = %v, want %v", got, "10.0.0.1")
+	}
+	r.Header.Set("X-Forwarded-For", "192.0.2.1")
+	if got := forwardedFor(r); got != "192.0.2.1, 10.0.0.1" {
+		t.Errorf("forwardedFor() = %v, want %v", got, "192.0.2.1, 10.0.0.1")
+	}
+}
+
 func TestServerConfig_middleware(t *testing.T) {
 	c := ServerConfig{Timeout: 5 * time.Second}
 	n := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -179,6 +192,11 @@ func TestServerConfig_middleware(t *testing.T) {
 		if _, ok := ctx.Value(clog.CtxLogger).(log15.Logger); !ok {
 			t.Error("Logger not set in context")
 		}
+
+		md, _ := metadata.FromOutgoingContext(ctx)
+		if got := md.Get("x-forwarded-for"); len(got) != 1 || got[0] != "192.0.2.1" {
+			t.Errorf("x-forwarded-for metadata = %v, want %v", got, "192.0.2.1")
+		}
 	})
 
 	h := c.middleware(n)
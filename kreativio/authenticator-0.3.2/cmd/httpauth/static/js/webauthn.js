@@ -0,0 +1,37 @@
+// webauthnForm decodes the WebAuthn options created by the authenticator server,
+// and on submit of the form with id "webauthn" calls navigator.credentials[ceremony].
+// The resulting credential is posted JSON encoded in the "credential" field.
+function webauthnForm(ceremony, options) {
+    const dec = s => Uint8Array.from(atob(s.replace(/-/g, "+").replace(/_/g, "/")), c => c.charCodeAt(0));
+    const enc = b => b ? btoa(String.fromCharCode(...new Uint8Array(b))).replace(/\+/g, "-").replace(/\//g, "_").replace(/=+$/, "") : null;
+    const pk = JSON.parse(options);
+    pk.challenge = dec(pk.challenge);
+    if (pk.user) {
+        pk.user.id = dec(pk.user.id);
+    }
+    (pk.excludeCredentials || pk.allowCredentials || []).forEach(c => c.id = dec(c.id));
+
+    const form = document.getElementById("webauthn");
+    form.addEventListener("submit", async e => {
+        e.preventDefault();
+        try {
+            const cred = await navigator.credentials[ceremony]({ publicKey: pk });
+            const r = cred.response;
+            form.credential.value = JSON.stringify({
+                id: cred.id,
+                rawId: enc(cred.rawId),
+                type: cred.type,
+                response: {
+                    clientDataJSON: enc(r.clientDataJSON),
+                    attestationObject: enc(r.attestationObject),
+                    authenticatorData: enc(r.authenticatorData),
+                    signature: enc(r.signature),
+                    userHandle: enc(r.userHandle),
+                },
+            });
+            form.submit();
+        } catch (err) {
+            alert(err.message);
+        }
+    });
+}
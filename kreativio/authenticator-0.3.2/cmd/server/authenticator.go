@@ -23,6 +23,7 @@ import (
 	"github.com/moapis/multidb"
 	"github.com/sirupsen/logrus"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -170,6 +171,59 @@ func (s *authServer) AuthenticatePwUser(ctx context.Context, up *auth.UserPasswo
 	if err != nil {
 		return nil, err
 	}
+	secret, err := rt.enabledTOTP(user)
+	if err != nil {
+		return nil, err
+	}
+	if secret != nil {
+		return rt.mfaPendingReply(user, time.Now())
+	}
+
+	return rt.userAuthReply(user, time.Now())
+}
+
+func (s *authServer) VerifyTOTP(ctx context.Context, tc *auth.TOTPCode) (*auth.AuthReply, error) {
+	rt, err := s.newTx(ctx, "VerifyTOTP", false)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.done()
+
+	claims, err := rt.checkJWT(tc.GetJwt(), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !s.hasMFAAudience(claims.Audiences) {
+		rt.log.Warn(errNotMFAToken)
+		return nil, status.Error(codes.Unauthenticated, errNotMFAToken)
+	}
+	user, err := rt.findUserByEmail(claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkLocked(user, time.Now()); err != nil {
+		rt.log.WithError(err).WithField("locked_until", user.LockedUntil.Time).Warn("checkLocked")
+		return nil, err
+	}
+	secret, err := rt.enabledTOTP(user, qm.For("update"))
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		rt.log.Warn(errTOTPNotEnabled)
+		return nil, status.Error(codes.FailedPrecondition, errTOTPNotEnabled)
+	}
+
+	err = rt.checkMFACode(user, secret, tc.GetCode())
+	if status.Code(err) == codes.InvalidArgument {
+		return nil, err
+	}
+	if rerr := s.recordLogin(rt.ctx, user.ID, err == nil); rerr != nil {
+		rt.log.WithError(rerr).Error("recordLogin")
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	return rt.userAuthReply(user, time.Now())
 }
@@ -214,6 +268,9 @@ func (s *authServer) ChangeUserPw(ctx context.Context, up *auth.NewUserPassword)
 	if err = rt.setUserPassword(user, up.GetNewPassword(), rand.Read); err != nil {
 		return nil, err
 	}
+	if err = rt.unlockUser(user); err != nil {
+		return nil, err
+	}
 	if err = rt.commit(); err != nil {
 		return nil, err
 	}
@@ -235,11 +292,7 @@ func (s *authServer) RefreshToken(ctx context.Context, old *auth.AuthReply) (*au
 		return nil, err
 	}
 	defer rt.done()
-	claims, err := rt.checkJWT(old.GetJwt(), time.Now())
-	if err != nil {
-		return nil, err
-	}
-	user, err := rt.findUserByEmail(claims.Subject)
+	user, err := rt.tokenUser(old.GetJwt())
 	if err != nil {
 		return nil, err
 	}
@@ -303,3 +356,250 @@ func (s *authServer) ResetUserPW(ctx context.Context, ue *auth.UserEmail) (*empt
 
 	return &empty.Empty{}, nil
 }
+
+// tokenUser returns the user of a valid user token.
+func (rt *requestTx) tokenUser(token string) (*models.User, error) {
+	claims, err := rt.checkUserJWT(token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return rt.findUserByEmail(claims.Subject)
+}
+
+func (s *authServer) EnableTOTP(ctx context.Context, ar *auth.AuthReply) (*auth.TOTPSecret, error) {
+	rt, err := s.newTx(ctx, "EnableTOTP", false)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.done()
+
+	user, err := rt.tokenUser(ar.GetJwt())
+	if err != nil {
+		return nil, err
+	}
+	secret, err := rt.enrollTOTP(user, rand.Read)
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.commit(); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+func (s *authServer) ConfirmTOTP(ctx context.Context, tc *auth.TOTPCode) (*auth.RecoveryCodes, error) {
+	rt, err := s.newTx(ctx, "ConfirmTOTP", false)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.done()
+
+	user, err := rt.tokenUser(tc.GetJwt())
+	if err != nil {
+		return nil, err
+	}
+	rc, err := rt.confirmTOTP(user, tc.GetCode(), rand.Read)
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.commit(); err != nil {
+		return nil, err
+	}
+	return rc, nil
+}
+
+func (s *authServer) DisableTOTP(ctx context.Context, tc *auth.TOTPCode) (*empty.Empty, error) {
+	rt, err := s.newTx(ctx, "DisableTOTP", false)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.done()
+
+	user, err := rt.tokenUser(tc.GetJwt())
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.disableTOTP(user, tc.GetCode()); err != nil {
+		return nil, err
+	}
+	if err = rt.commit(); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *authServer) BeginWebAuthnRegistration(ctx context.Context, ar *auth.AuthReply) (*auth.WebAuthnOptions, error) {
+	rt, err := s.newTx(ctx, "BeginWebAuthnRegistration", false)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.done()
+
+	user, err := rt.tokenUser(ar.GetJwt())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := rt.beginRegistration(user, rand.Read)
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.commit(); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+func (s *authServer) FinishWebAuthnRegistration(ctx context.Context, wc *auth.WebAuthnCredential) (*empty.Empty, error) {
+	rt, err := s.newTx(ctx, "FinishWebAuthnRegistration", false)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.done()
+
+	user, err := rt.tokenUser(wc.GetJwt())
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.finishRegistration(user, wc.GetCredential(), wc.GetName()); err != nil {
+		return nil, err
+	}
+	if err = rt.commit(); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *authServer) BeginWebAuthnLogin(ctx context.Context, wl *auth.WebAuthnLogin) (*auth.WebAuthnOptions, error) {
+	rt, err := s.newTx(ctx, "BeginWebAuthnLogin", false)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.done()
+
+	opts, err := rt.beginLogin(wl.GetEmail(), rand.Read)
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.commit(); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// FinishWebAuthnLogin verifies the assertion of a registered credential.
+// User verification is required, so a passkey counts as multiple factors by itself and no TOTP code is required.
+func (s *authServer) FinishWebAuthnLogin(ctx context.Context, wc *auth.WebAuthnCredential) (*auth.AuthReply, error) {
+	rt, err := s.newTx(ctx, "FinishWebAuthnLogin", false)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.done()
+
+	user, err := rt.finishLogin(wc.GetCredential())
+	if err != nil {
+		return nil, err
+	}
+	if err = checkLocked(user, time.Now()); err != nil {
+		rt.log.WithError(err).WithField("locked_until", user.LockedUntil.Time).Warn("checkLocked")
+		return nil, err
+	}
+	if err = s.recordLogin(rt.ctx, user.ID, true); err != nil {
+		rt.log.WithError(err).Error("recordLogin")
+	}
+
+	return rt.userAuthReply(user, time.Now())
+}
+
+func (s *authServer) ListPubKeys(ctx context.Context, _ *empty.Empty) (*auth.PublicKeys, error) {
+	rt, err := s.newTx(ctx, "ListPubKeys", true)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.done()
+	return rt.listPubKeys(time.Now())
+}
+
+func (s *authServer) CheckOIDCClient(ctx context.Context, cr *auth.OIDCClientRequest) (*auth.OIDCClient, error) {
+	rt, err := s.newTx(ctx, "CheckOIDCClient", true)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.done()
+
+	client, err := rt.findOIDCClient(cr.GetClientId(), cr.GetRedirectUri())
+	if err != nil {
+		return nil, err
+	}
+	return &auth.OIDCClient{Name: client.Name}, nil
+}
+
+func (s *authServer) AuthorizeOIDC(ctx context.Context, ar *auth.OIDCAuthRequest) (*auth.OIDCCode, error) {
+	rt, err := s.newTx(ctx, "AuthorizeOIDC", false)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.done()
+
+	user, err := rt.tokenUser(ar.GetJwt())
+	if err != nil {
+		return nil, err
+	}
+	code, err := rt.authorizeOIDC(user, ar, rand.Read)
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.commit(); err != nil {
+		return nil, err
+	}
+	return code, nil
+}
+
+// ExchangeOIDCCode uses the authorization code, also when the exchange fails,
+// so that a leaked code can't be retried.
+func (s *authServer) ExchangeOIDCCode(ctx context.Context, tr *auth.OIDCTokenRequest) (*auth.OIDCTokens, error) {
+	rt, err := s.newTx(ctx, "ExchangeOIDCCode", false)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.done()
+
+	client, err := rt.authenticateOIDCClient(tr.GetClientId(), tr.GetClientSecret())
+	if err != nil {
+		return nil, err
+	}
+	code, err := rt.useOIDCCode(tr.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err = checkOIDCCode(code, client, tr.GetRedirectUri(), tr.GetCodeVerifier(), now); err != nil {
+		rt.log.WithError(err).Warn("checkOIDCCode")
+		if err = rt.commit(); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.PermissionDenied, errOIDCGrant)
+	}
+
+	user := code.R.User
+	if err = checkLocked(user, now); err != nil {
+		rt.log.WithError(err).WithField("locked_until", user.LockedUntil.Time).Warn("checkLocked")
+		return nil, err
+	}
+	tokens, err := rt.oidcTokens(code, client, user, now)
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.commit(); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (s *authServer) GetOIDCUserInfo(ctx context.Context, ar *auth.AuthReply) (*auth.OIDCUserInfo, error) {
+	rt, err := s.newTx(ctx, "GetOIDCUserInfo", true)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.done()
+	return rt.oidcUserInfo(ar.GetJwt())
+}
@@ -698,7 +698,7 @@ func Test_authServer_GetPubKey(t *testing.T) {
 				testCtx,
 				&auth.KeyID{Kid: 10},
 			},
-			&auth.PublicKey{Key: []byte(testPubKey)},
+			&auth.PublicKey{Key: []byte(testPubKey), Kid: 10},
 			false,
 		},
 	}
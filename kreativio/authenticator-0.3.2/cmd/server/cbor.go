@@ -0,0 +1,158 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// CBOR (RFC 7049) major types
+const (
+	cborUint = iota
+	cborNegInt
+	cborBytes
+	cborText
+	cborArray
+	cborMap
+	cborTag
+	cborSimple
+)
+
+// cborMaxDepth limits nesting, as input comes from clients.
+const cborMaxDepth = 16
+
+var errCBORTruncated = errors.New("cbor: unexpected end of data")
+
+// cborDecode decodes the first CBOR data item from b, returning it and the remaining bytes.
+// It only supports the definite length encoding used by WebAuthn authenticators.
+// Integers are returned as int64, byte strings as []byte, text as string,
+// arrays as []interface{} and maps as map[interface{}]interface{}.
+// Tags are ignored and floats are not supported.
+func cborDecode(b []byte) (interface{}, []byte, error) {
+	return cborDecodeDepth(b, 0)
+}
+
+func cborDecodeDepth(b []byte, depth int) (interface{}, []byte, error) {
+	if depth > cborMaxDepth {
+		return nil, nil, errors.New("cbor: nesting too deep")
+	}
+	if len(b) == 0 {
+		return nil, nil, errCBORTruncated
+	}
+
+	major, info := b[0]>>5, b[0]&0x1f
+	arg, b, err := cborArgument(info, b[1:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch major {
+	case cborUint:
+		if arg > math.MaxInt64 {
+			return nil, nil, errors.New("cbor: integer overflow")
+		}
+		return int64(arg), b, nil
+
+	case cborNegInt:
+		if arg > math.MaxInt64 {
+			return nil, nil, errors.New("cbor: integer overflow")
+		}
+		return -1 - int64(arg), b, nil
+
+	case cborBytes, cborText:
+		if uint64(len(b)) < arg {
+			return nil, nil, errCBORTruncated
+		}
+		if major == cborText {
+			return string(b[:arg]), b[arg:], nil
+		}
+		return append([]byte(nil), b[:arg]...), b[arg:], nil
+
+	case cborArray:
+		// Every item takes at least one byte
+		if uint64(len(b)) < arg {
+			return nil, nil, errCBORTruncated
+		}
+		a := make([]interface{}, arg)
+		for i := range a {
+			if a[i], b, err = cborDecodeDepth(b, depth+1); err != nil {
+				return nil, nil, err
+			}
+		}
+		return a, b, nil
+
+	case cborMap:
+		if uint64(len(b)) < 2*arg {
+			return nil, nil, errCBORTruncated
+		}
+		m := make(map[interface{}]interface{}, arg)
+		for i := uint64(0); i < arg; i++ {
+			var k, v interface{}
+			if k, b, err = cborDecodeDepth(b, depth+1); err != nil {
+				return nil, nil, err
+			}
+			switch k.(type) {
+			case int64, string:
+			default:
+				return nil, nil, fmt.Errorf("cbor: unsupported map key type %T", k)
+			}
+			if v, b, err = cborDecodeDepth(b, depth+1); err != nil {
+				return nil, nil, err
+			}
+			m[k] = v
+		}
+		return m, b, nil
+
+	case cborTag:
+		return cborDecodeDepth(b, depth+1)
+
+	default: // cborSimple
+		switch info {
+		case 20:
+			return false, b, nil
+		case 21:
+			return true, b, nil
+		case 22, 23:
+			return nil, b, nil
+		}
+		return nil, nil, fmt.Errorf("cbor: unsupported simple value %d", info)
+	}
+}
+
+// cborArgument reads the argument of a data item, as indicated by info.
+func cborArgument(info byte, b []byte) (uint64, []byte, error) {
+	var n int
+	switch {
+	case info < 24:
+		return uint64(info), b, nil
+	case info == 24:
+		n = 1
+	case info == 25:
+		n = 2
+	case info == 26:
+		n = 4
+	case info == 27:
+		n = 8
+	default:
+		return 0, nil, errors.New("cbor: indefinite length not supported")
+	}
+	if len(b) < n {
+		return 0, nil, errCBORTruncated
+	}
+
+	switch n {
+	case 1:
+		return uint64(b[0]), b[1:], nil
+	case 2:
+		return uint64(binary.BigEndian.Uint16(b)), b[2:], nil
+	case 4:
+		return uint64(binary.BigEndian.Uint32(b)), b[4:], nil
+	default:
+		return binary.BigEndian.Uint64(b), b[8:], nil
+	}
+}
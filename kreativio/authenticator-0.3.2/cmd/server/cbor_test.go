@@ -0,0 +1,141 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// cborHead encodes the major type and argument of a data item.
+func cborHead(major byte, arg uint64) []byte {
+	switch {
+	case arg < 24:
+		return []byte{major<<5 | byte(arg)}
+	case arg <= 0xff:
+		return []byte{major<<5 | 24, byte(arg)}
+	case arg <= 0xffff:
+		b := []byte{major<<5 | 25, 0, 0}
+		binary.BigEndian.PutUint16(b[1:], uint16(arg))
+		return b
+	case arg <= 0xffffffff:
+		b := []byte{major<<5 | 26, 0, 0, 0, 0}
+		binary.BigEndian.PutUint32(b[1:], uint32(arg))
+		return b
+	default:
+		b := []byte{major<<5 | 27, 0, 0, 0, 0, 0, 0, 0, 0}
+		binary.BigEndian.PutUint64(b[1:], arg)
+		return b
+	}
+}
+
+// cborEncode is a minimal encoder, for creating authenticator output in tests.
+func cborEncode(v interface{}) []byte {
+	switch v := v.(type) {
+	case int:
+		return cborEncode(int64(v))
+	case int64:
+		if v < 0 {
+			return cborHead(cborNegInt, uint64(-1-v))
+		}
+		return cborHead(cborUint, uint64(v))
+	case []byte:
+		return append(cborHead(cborBytes, uint64(len(v))), v...)
+	case string:
+		return append(cborHead(cborText, uint64(len(v))), v...)
+	case []interface{}:
+		b := cborHead(cborArray, uint64(len(v)))
+		for _, e := range v {
+			b = append(b, cborEncode(e)...)
+		}
+		return b
+	case map[interface{}]interface{}:
+		// Canonical key order, so that output is reproducible
+		keys := make([][]byte, 0, len(v))
+		values := make(map[string][]byte, len(v))
+		for k, e := range v {
+			kb := cborEncode(k)
+			keys = append(keys, kb)
+			values[string(kb)] = cborEncode(e)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if len(keys[i]) != len(keys[j]) {
+				return len(keys[i]) < len(keys[j])
+			}
+			return bytes.Compare(keys[i], keys[j]) < 0
+		})
+		b := cborHead(cborMap, uint64(len(v)))
+		for _, kb := range keys {
+			b = append(b, kb...)
+			b = append(b, values[string(kb)]...)
+		}
+		return b
+	case bool:
+		if v {
+			return []byte{0xf5}
+		}
+		return []byte{0xf4}
+	case nil:
+		return []byte{0xf6}
+	}
+	panic(fmt.Sprintf("cborEncode: unsupported type %T", v))
+}
+
+func Test_cborDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []byte
+		want    interface{}
+		wantErr bool
+	}{
+		{"Small uint", []byte{0x17}, int64(23), false},
+		{"Uint8", []byte{0x18, 0x64}, int64(100), false},
+		{"Uint16", []byte{0x19, 0x03, 0xe8}, int64(1000), false},
+		{"Uint32", []byte{0x1a, 0x00, 0x0f, 0x42, 0x40}, int64(1000000), false},
+		{"Uint64 overflow", []byte{0x1b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, nil, true},
+		{"Negative", []byte{0x26}, int64(-7), false},
+		{"Negative16", []byte{0x39, 0x01, 0x00}, int64(-257), false},
+		{"Bytes", []byte{0x43, 1, 2, 3}, []byte{1, 2, 3}, false},
+		{"Text", []byte{0x63, 'f', 'o', 'o'}, "foo", false},
+		{"Array", []byte{0x82, 0x01, 0x20}, []interface{}{int64(1), int64(-1)}, false},
+		{"Map", []byte{0xa2, 0x01, 0x02, 0x61, 'a', 0xf5}, map[interface{}]interface{}{int64(1): int64(2), "a": true}, false},
+		{"Tag", []byte{0xc2, 0x41, 0x01}, []byte{1}, false},
+		{"Null", []byte{0xf6}, nil, false},
+		{"Float", []byte{0xfa, 0, 0, 0, 0}, nil, true},
+		{"Indefinite", []byte{0x5f}, nil, true},
+		{"Empty", nil, nil, true},
+		{"Truncated bytes", []byte{0x43, 1, 2}, nil, true},
+		{"Truncated argument", []byte{0x19, 0x03}, nil, true},
+		{"Truncated array", []byte{0x82, 0x01}, nil, true},
+		{"Map key type", []byte{0xa1, 0x41, 0x01, 0x01}, nil, true},
+		{"Too deep", bytes.Repeat([]byte{0x81}, cborMaxDepth+2), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := cborDecode(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("cborDecode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cborDecode() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_cborDecode_rest(t *testing.T) {
+	in := append(cborEncode(map[interface{}]interface{}{int64(1): []byte("spanac")}), 0xff, 0xfe)
+	_, rest, err := cborDecode(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(rest, []byte{0xff, 0xfe}) {
+		t.Errorf("cborDecode() rest = %v, want %v", rest, []byte{0xff, 0xfe})
+	}
+}
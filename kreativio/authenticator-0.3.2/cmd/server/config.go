@@ -8,6 +8,7 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/tls"
+	"database/sql"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -26,6 +27,7 @@ import (
 	pg "github.com/moapis/multidb/drivers/postgresql"
 	"github.com/sirupsen/logrus"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"golang.org/x/crypto/argon2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -54,6 +56,10 @@ type BootstrapUser struct {
 	Audiences []string
 }
 
+// Roles maps group names to the permissions granted to their members.
+// Permissions are emitted in the "permissions" claim of user tokens.
+type Roles map[string][]string
+
 // MailConfig for outgoing mail server
 type MailConfig struct {
 	Host         string
@@ -75,8 +81,16 @@ type ServerConfig struct {
 	PG          *pg.Config      `json:"pg"`          // PG is later embedded in multidb
 	SQLRoutines int             `json:"sqlroutines"` // Amount of Go-routines for non-master queries
 	Users       []BootstrapUser `json:"bootsrap"`    // Users which will be upserted at start
+	Roles       Roles           `json:"roles"`       // Permissions which will be granted to groups at start
 	JWT         JWTConfig       `json:"jwt"`
 	Mail        MailConfig      `json:"smtp"`
+	GRPCWeb     GRPCWebConfig   `json:"grpcweb"`         // Serve grpc-web and CORS, without a proxy
+	Proxies     int             `json:"trusted_proxies"` // Reverse proxies in front of the server, which append to x-forwarded-for. Zero ignores the header
+	RateLimits  RateLimitConfig `json:"ratelimits"`      // Token buckets for unauthenticated methods
+	Lockout     LockoutConfig   `json:"lockout"`         // Delays and lockout after failed logins
+	TOTP        TOTPConfig      `json:"totp"`            // Two-factor authentication
+	WebAuthn    WebAuthnConfig  `json:"webauthn"`        // Passkey registration and login
+	OIDC        OIDCConfig      `json:"oidc"`            // OpenID Connect provider
 }
 
 func (c *ServerConfig) writeOut(filename string) error {
@@ -123,6 +137,26 @@ var Default = ServerConfig{
 			Audiences: []string{"authenticator"},
 		},
 	},
+	Roles: Roles{
+		"primary": {
+			"catalog:read",
+			"catalog:write",
+			"prices:write",
+			"orders:read",
+			"orders:write",
+			"reports:read",
+			"messages:read",
+			"messages:write",
+			"webhooks:read",
+			"webhooks:write",
+			"mails:read",
+			"mails:write",
+			"newsletter:read",
+			"privacy:read",
+			"privacy:write",
+			"images:write",
+		},
+	},
 	JWT: JWTConfig{
 		Issuer: "localhost",
 		Expiry: 24 * time.Hour,
@@ -136,6 +170,84 @@ var Default = ServerConfig{
 		From:         "admin@test.mailu.io",
 		TemplateGlob: "templates/*.mail.html",
 	},
+	RateLimits: RateLimitConfig{
+		Methods: map[string]MethodRateLimits{
+			"RegisterPwUser": {
+				Peer:    TokenBucket{Burst: 5, Interval: 10 * time.Minute},
+				Subject: TokenBucket{Burst: 3, Interval: time.Hour},
+			},
+			"AuthenticatePwUser": {
+				Peer:    TokenBucket{Burst: 20, Interval: 30 * time.Second},
+				Subject: TokenBucket{Burst: 5, Interval: time.Minute},
+			},
+			"ChangeUserPw": {
+				Peer:    TokenBucket{Burst: 10, Interval: time.Minute},
+				Subject: TokenBucket{Burst: 5, Interval: 5 * time.Minute},
+			},
+			"ResetUserPW": {
+				Peer:    TokenBucket{Burst: 5, Interval: 10 * time.Minute},
+				Subject: TokenBucket{Burst: 3, Interval: time.Hour},
+			},
+			"VerifyTOTP": {
+				Peer: TokenBucket{Burst: 20, Interval: 30 * time.Second},
+			},
+			"ConfirmTOTP": {
+				Peer: TokenBucket{Burst: 10, Interval: time.Minute},
+			},
+			"DisableTOTP": {
+				Peer: TokenBucket{Burst: 10, Interval: time.Minute},
+			},
+			"BeginWebAuthnRegistration": {
+				Peer: TokenBucket{Burst: 10, Interval: time.Minute},
+			},
+			"FinishWebAuthnRegistration": {
+				Peer: TokenBucket{Burst: 10, Interval: time.Minute},
+			},
+			"BeginWebAuthnLogin": {
+				Peer: TokenBucket{Burst: 20, Interval: 30 * time.Second},
+			},
+			"FinishWebAuthnLogin": {
+				Peer: TokenBucket{Burst: 20, Interval: 30 * time.Second},
+			},
+			"AuthorizeOIDC": {
+				Peer: TokenBucket{Burst: 20, Interval: 30 * time.Second},
+			},
+			"ExchangeOIDCCode": {
+				Peer: TokenBucket{Burst: 30, Interval: time.Minute},
+			},
+			"PublicUserToken": {
+				Peer:    TokenBucket{Burst: 30, Interval: 2 * time.Second},
+				Subject: TokenBucket{Burst: 10, Interval: 6 * time.Second},
+			},
+		},
+		Purge: 10 * time.Minute,
+	},
+	Lockout: LockoutConfig{
+		Threshold: 10,
+		Delay:     time.Second,
+		MaxDelay:  time.Minute,
+		Duration:  30 * time.Minute,
+		Retention: 90 * 24 * time.Hour,
+		Purge:     time.Hour,
+	},
+	TOTP: TOTPConfig{
+		Skew:          1,
+		RecoveryCodes: 10,
+		PendingExpiry: 5 * time.Minute,
+	},
+	WebAuthn: WebAuthnConfig{
+		RPID:    "localhost",
+		RPName:  "Authenticator",
+		Origins: []string{"http://localhost:1235"},
+		Timeout: 5 * time.Minute,
+		Purge:   10 * time.Minute,
+	},
+	OIDC: OIDCConfig{
+		Issuer:      "http://localhost:1235",
+		CodeExpiry:  time.Minute,
+		TokenExpiry: time.Hour,
+		Purge:       10 * time.Minute,
+	},
 }
 
 var configFiles = flag.String("config", "", "Comma separated list of JSON config files")
@@ -264,6 +376,85 @@ func (c ServerConfig) bootStrapUsers(ctx context.Context, s *authServer) error {
 	return nil
 }
 
+// bootstrapRoles creates the configured groups and permissions, if they do not exist yet,
+// and grants the permissions to the groups.
+// Permissions granted through the admin interface are never revoked.
+func (c ServerConfig) bootstrapRoles(ctx context.Context, s *authServer) error {
+	tx, err := s.mdb.MasterTx(ctx, nil)
+	if err != nil {
+		log.WithError(err).Error("bootstrapRoles")
+		return err
+	}
+	defer tx.Rollback()
+
+	for group, permissions := range c.Roles {
+		log := s.log.WithFields(logrus.Fields{"group": group, "permissions": permissions})
+
+		gm, err := models.Groups(
+			models.GroupWhere.Name.EQ(group),
+			qm.Load(models.GroupRels.Permissions),
+		).One(ctx, tx)
+		switch err {
+		case nil:
+		case sql.ErrNoRows:
+			gm = &models.Group{Name: group, Description: group}
+			if err = gm.Insert(ctx, tx, boil.Infer()); err != nil {
+				log.WithError(err).Error("bootstrap Group insert")
+				return err
+			}
+			log.Debug("bootstrap Group insert")
+		default:
+			log.WithError(err).Error("bootstrap Group")
+			return err
+		}
+
+		granted := make(map[string]bool)
+		if gm.R != nil {
+			for _, pm := range gm.R.Permissions {
+				granted[pm.Name] = true
+			}
+		}
+
+		var pms []*models.Permission
+		for _, name := range permissions {
+			if granted[name] {
+				continue
+			}
+			granted[name] = true
+
+			pm, err := models.Permissions(models.PermissionWhere.Name.EQ(name)).One(ctx, tx)
+			switch err {
+			case nil:
+			case sql.ErrNoRows:
+				pm = &models.Permission{Name: name, Description: name}
+				if err = pm.Insert(ctx, tx, boil.Infer()); err != nil {
+					log.WithError(err).Error("bootstrap Permission insert")
+					return err
+				}
+				log.WithField("permission", name).Debug("bootstrap Permission insert")
+			default:
+				log.WithError(err).Error("bootstrap Permission")
+				return err
+			}
+			pms = append(pms, pm)
+		}
+		if len(pms) == 0 {
+			continue
+		}
+		if err = gm.AddPermissions(ctx, tx, false, pms...); err != nil {
+			log.WithError(err).Error("bootstrap AddPermissions")
+			return err
+		}
+		log.Debug("bootstrap AddPermissions")
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.WithError(err).Error("bootstrap commit")
+		return err
+	}
+	return nil
+}
+
 func (c ServerConfig) newAuthServer(ctx context.Context, r io.Reader) (*authServer, error) {
 	s := &authServer{
 		log:  log.WithField("server", "Authenticator"),
@@ -278,6 +469,10 @@ func (c ServerConfig) newAuthServer(ctx context.Context, r io.Reader) (*authServ
 		return nil, err
 	}
 
+	if err = c.bootstrapRoles(ctx, s); err != nil {
+		return nil, err
+	}
+
 	if err = s.updateKeyPair(ctx, r); err != nil {
 		return nil, err
 	}
@@ -295,12 +490,13 @@ func (c ServerConfig) newAuthServer(ctx context.Context, r io.Reader) (*authServ
 	return s, nil
 }
 
-func (c ServerConfig) listenAndServe(s *authServer, opts ...grpc.ServerOption) (*grpc.Server, <-chan error) {
-	gs := grpc.NewServer(opts...)
+func (c ServerConfig) listenAndServe(s *authServer, opts ...grpc.ServerOption) (*grpcServer, <-chan error) {
+	opts = append(opts, grpc.ChainUnaryInterceptor(s.unaryRateLimitInterceptor))
+	gs := c.newGRPCServer(opts...)
 	ec := make(chan error)
-	auth.RegisterAuthenticatorServer(gs, s)
+	auth.RegisterAuthenticatorServer(gs.Server, s)
 
-	log := log.WithFields(logrus.Fields{"address": c.Addres, "port": c.Port})
+	log := log.WithFields(logrus.Fields{"address": c.Addres, "port": c.Port, "grpcweb": c.GRPCWeb.Enabled})
 	log.WithField("grpc", gs.GetServiceInfo()).Debug("Registered services")
 	log.Info("Starting server")
 
@@ -14,8 +14,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/moapis/authenticator/models"
 	"github.com/moapis/multidb"
 	pg "github.com/moapis/multidb/drivers/postgresql"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 func TestServerConfig_writeOut(t *testing.T) {
@@ -210,6 +212,86 @@ func TestServerConfig_newAuthServer(t *testing.T) {
 	}
 }
 
+func TestServerConfig_bootstrapRoles(t *testing.T) {
+	ectx, cancel := context.WithTimeout(context.Background(), -1)
+	defer cancel()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		roles   Roles
+		group   string
+		want    []string
+		wantErr bool
+	}{
+		{
+			"New group",
+			testCtx,
+			Roles{"editors": {"catalog:write", "prices:write"}},
+			"editors",
+			[]string{"catalog:write", "prices:write"},
+			false,
+		},
+		{
+			"Existing group",
+			testCtx,
+			Roles{"editors": {"prices:write", "orders:read"}},
+			"editors",
+			[]string{"catalog:write", "orders:read", "prices:write"},
+			false,
+		},
+		{
+			"Duplicate permission",
+			testCtx,
+			Roles{"admin": {"catalog:write", "catalog:write"}},
+			"admin",
+			[]string{"catalog:read", "catalog:write"},
+			false,
+		},
+		{
+			"Expired context",
+			ectx,
+			Roles{"editors": {"orders:write"}},
+			"editors",
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := ServerConfig{Roles: tt.roles}
+			err := c.bootstrapRoles(tt.ctx, tas)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServerConfig.bootstrapRoles() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			tx, err := mdb.MasterTx(testCtx, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer tx.Rollback()
+
+			gm, err := models.Groups(
+				models.GroupWhere.Name.EQ(tt.group),
+				qm.Load(models.GroupRels.Permissions, qm.OrderBy(models.PermissionColumns.Name)),
+			).One(testCtx, tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make([]string, len(gm.R.Permissions))
+			for i, p := range gm.R.Permissions {
+				got[i] = p.Name
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ServerConfig.bootstrapRoles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestServerConfig_listenAndServe(t *testing.T) {
 	type fields struct {
 		Addres string
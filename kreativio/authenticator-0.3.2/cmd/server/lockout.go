@@ -0,0 +1,173 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/moapis/authenticator/models"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LockoutConfig sets the brute-force protection of password logins.
+// Login attempts are always recorded. A zero Threshold disables delays and lockout.
+type LockoutConfig struct {
+	Threshold int           `json:"threshold"` // Consecutive failed logins after which the account is locked
+	Delay     time.Duration `json:"delay"`     // Wait after the first failed login, doubled on every next failure
+	MaxDelay  time.Duration `json:"max_delay"` // Maximum wait before Threshold is reached
+	Duration  time.Duration `json:"duration"`  // Time an account stays locked
+	Retention time.Duration `json:"retention"` // Time login attempts are kept
+	Purge     time.Duration `json:"purge"`     // Interval to purge login attempts past Retention. Zero disables
+}
+
+// loginDelay returns the time a user has to wait for the next login,
+// after failures consecutive failed logins.
+func (c LockoutConfig) loginDelay(failures int) time.Duration {
+	if c.Threshold <= 0 || failures <= 0 {
+		return 0
+	}
+	if failures >= c.Threshold {
+		return c.Duration
+	}
+	d := c.Delay
+	for i := 1; i < failures && d < c.MaxDelay; i++ {
+		d *= 2
+	}
+	if d > c.MaxDelay {
+		return c.MaxDelay
+	}
+	return d
+}
+
+const (
+	errLocked = "Too many failed logins, try again later"
+
+	// LockoutMailTmpl is the template name for the suspicious activity mail.
+	LockoutMailTmpl = "lockout"
+	lockoutSubject  = "Suspicious login activity on your account"
+)
+
+// checkLocked returns an error if the user can't log in at now,
+// because of previous failed logins or an active lockout.
+func checkLocked(user *models.User, now time.Time) error {
+	if !user.LockedUntil.Valid || !user.LockedUntil.Time.After(now) {
+		return nil
+	}
+	return retryError(errLocked, user.LockedUntil.Time.Sub(now))
+}
+
+type lockoutMailData struct {
+	*models.User
+	Attempts models.LoginAttemptSlice
+}
+
+// recordLogin records a login attempt of the user in its own transaction,
+// so that it is kept regardless of the outcome of the request.
+// A failed attempt delays the next login of the user, or locks the account.
+// The user is mailed about suspicious activity when the account gets locked.
+// A successful attempt resets the failure count.
+func (s *authServer) recordLogin(ctx context.Context, userID int, success bool) error {
+	rt, err := s.newTx(ctx, "recordLogin", false)
+	if err != nil {
+		return err
+	}
+	defer rt.done()
+
+	user, err := models.Users(
+		models.UserWhere.ID.EQ(userID),
+		qm.For("update"),
+	).One(rt.ctx, rt.tx)
+	if err != nil {
+		return rt.dbAuthError("Lock user", "user", err)
+	}
+	rt.log = rt.log.WithFields(logrus.Fields{"user": user.ID, "success": success})
+
+	attempt := &models.LoginAttempt{
+		RemoteAddr: clientAddr(ctx, s.conf.Proxies),
+		Success:    success,
+	}
+	if err = user.AddLoginAttempts(rt.ctx, rt.tx, true, attempt); err != nil {
+		rt.log.WithError(err).Error("AddLoginAttempts")
+		return status.Error(codes.Internal, errDB)
+	}
+
+	conf := s.conf.Lockout
+	if success {
+		if user.FailedLogins == 0 && !user.LockedUntil.Valid {
+			return rt.commit()
+		}
+		user.FailedLogins, user.LockedUntil = 0, null.Time{}
+	} else {
+		user.FailedLogins++
+		if d := conf.loginDelay(user.FailedLogins); d > 0 {
+			user.LockedUntil = null.TimeFrom(time.Now().Add(d))
+		}
+	}
+	if _, err = user.Update(rt.ctx, rt.tx, boil.Whitelist(models.UserColumns.FailedLogins, models.UserColumns.LockedUntil)); err != nil {
+		rt.log.WithError(err).Error("Update user lock")
+		return status.Error(codes.Internal, errDB)
+	}
+
+	if !success && user.FailedLogins == conf.Threshold {
+		rt.log.WithFields(logrus.Fields{"event": "user.locked", "until": user.LockedUntil.Time}).Warn("User locked")
+		rt.sendLockoutMail(user)
+	}
+	return rt.commit()
+}
+
+// sendLockoutMail informs the user about the failed logins which locked the account.
+// Errors are only logged, as they should not prevent the lockout.
+func (rt *requestTx) sendLockoutMail(user *models.User) {
+	attempts, err := user.LoginAttempts(
+		models.LoginAttemptWhere.Success.EQ(false),
+		qm.OrderBy(models.LoginAttemptColumns.CreatedAt+" desc"),
+		qm.Limit(user.FailedLogins),
+	).All(rt.ctx, rt.tx)
+	if err != nil {
+		rt.log.WithError(err).Error("sendLockoutMail: LoginAttempts")
+		return
+	}
+	if err = rt.mailUser(LockoutMailTmpl, user, lockoutSubject, lockoutMailData{user, attempts}); err != nil {
+		rt.log.WithError(err).Error("sendLockoutMail")
+	}
+}
+
+// unlockUser resets the failure count and lockout of user.
+func (rt *requestTx) unlockUser(user *models.User) error {
+	if user.FailedLogins == 0 && !user.LockedUntil.Valid {
+		return nil
+	}
+	user.FailedLogins, user.LockedUntil = 0, null.Time{}
+	if _, err := user.Update(rt.ctx, rt.tx, boil.Whitelist(models.UserColumns.FailedLogins, models.UserColumns.LockedUntil)); err != nil {
+		rt.log.WithError(err).Error("unlockUser")
+		return status.Error(codes.Internal, errDB)
+	}
+	return nil
+}
+
+// purgeLoginAttempts deletes login attempts older than the retention time.
+func (s *authServer) purgeLoginAttempts(ctx context.Context) error {
+	rt, err := s.newTx(ctx, "purgeLoginAttempts", false)
+	if err != nil {
+		return err
+	}
+	defer rt.done()
+
+	ra, err := models.LoginAttempts(
+		models.LoginAttemptWhere.CreatedAt.LT(time.Now().Add(-s.conf.Lockout.Retention)),
+	).DeleteAll(rt.ctx, rt.tx)
+	if err != nil {
+		rt.log.WithError(err).Error("purgeLoginAttempts")
+		return status.Error(codes.Internal, errDB)
+	}
+	rt.log.WithField("ra", ra).Debug("purgeLoginAttempts")
+	return rt.commit()
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"html/template"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moapis/authenticator/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLockoutConfig_loginDelay(t *testing.T) {
+	c := LockoutConfig{
+		Threshold: 5,
+		Delay:     time.Second,
+		MaxDelay:  3 * time.Second,
+		Duration:  time.Hour,
+	}
+	tests := []struct {
+		name     string
+		conf     LockoutConfig
+		failures int
+		want     time.Duration
+	}{
+		{"Disabled", LockoutConfig{Delay: time.Second}, 3, 0},
+		{"No failures", c, 0, 0},
+		{"First", c, 1, time.Second},
+		{"Second", c, 2, 2 * time.Second},
+		{"Capped", c, 4, 3 * time.Second},
+		{"Threshold", c, 5, time.Hour},
+		{"Past threshold", c, 6, time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.conf.loginDelay(tt.failures); got != tt.want {
+				t.Errorf("LockoutConfig.loginDelay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_checkLocked(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		user     *models.User
+		wantCode codes.Code
+	}{
+		{"Never locked", &models.User{}, codes.OK},
+		{"Lock expired", &models.User{LockedUntil: null.TimeFrom(now.Add(-time.Second))}, codes.OK},
+		{"Locked", &models.User{LockedUntil: null.TimeFrom(now.Add(time.Minute))}, codes.ResourceExhausted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkLocked(tt.user, now); status.Code(err) != tt.wantCode {
+				t.Errorf("checkLocked() error = %v, wantCode %v", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func Test_lockoutMailTemplate(t *testing.T) {
+	tmpl := template.Must(template.ParseGlob(Default.Mail.TemplateGlob))
+	user := &models.User{
+		Name:         "Mickey Mouse",
+		Email:        "mickey@mouse.com",
+		FailedLogins: 3,
+		LockedUntil:  null.TimeFrom(time.Now().Add(time.Hour)),
+	}
+	data := lockoutMailData{user, models.LoginAttemptSlice{
+		{CreatedAt: time.Now(), RemoteAddr: "192.0.2.1"},
+		{CreatedAt: time.Now()},
+	}}
+
+	var b strings.Builder
+	if err := tmpl.ExecuteTemplate(&b, LockoutMailTmpl, data); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"Mickey Mouse", "3 failed login attempts", "192.0.2.1", "an unknown address"} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("lockout template = %s, missing %q", b.String(), want)
+		}
+	}
+}
+
+func Test_authServer_recordLogin(t *testing.T) {
+	m, err := mdb.Master(testCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := &models.User{Email: "lock@out.com", Name: "lockOut"}
+	if err = u.Insert(testCtx, m, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	cc := *tas.conf
+	cc.Lockout = LockoutConfig{
+		Threshold: 2,
+		Delay:     time.Minute,
+		MaxDelay:  time.Minute,
+		Duration:  time.Hour,
+	}
+	conf := tas.conf
+	tas.conf = &cc
+	defer func() { tas.conf = conf }()
+
+	tests := []struct {
+		name         string
+		success      bool
+		wantFailures int
+		wantLocked   time.Duration
+	}{
+		{"Failure", false, 1, time.Minute},
+		{"Lockout", false, 2, time.Hour},
+		{"Success", true, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tas.recordLogin(testCtx, u.ID, tt.success); err != nil {
+				t.Fatalf("authServer.recordLogin() error = %v", err)
+			}
+			if err = u.Reload(testCtx, m); err != nil {
+				t.Fatal(err)
+			}
+			if u.FailedLogins != tt.wantFailures {
+				t.Errorf("authServer.recordLogin() failures = %v, want %v", u.FailedLogins, tt.wantFailures)
+			}
+			if tt.wantLocked == 0 {
+				if u.LockedUntil.Valid {
+					t.Errorf("authServer.recordLogin() locked until %v, want unlocked", u.LockedUntil.Time)
+				}
+				return
+			}
+			if d := time.Until(u.LockedUntil.Time); d <= tt.wantLocked-time.Minute/2 || d > tt.wantLocked {
+				t.Errorf("authServer.recordLogin() locked for %v, want %v", d, tt.wantLocked)
+			}
+		})
+	}
+
+	n, err := u.LoginAttempts().Count(testCtx, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("authServer.recordLogin() attempts = %v, want %v", n, 3)
+	}
+
+	if err = tas.recordLogin(testCtx, 99999, false); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("authServer.recordLogin() error = %v, wantCode %v", err, codes.Unauthenticated)
+	}
+}
+
+func Test_requestTx_authenticatePwUser_locked(t *testing.T) {
+	m, err := mdb.Master(testCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := &models.User{Email: "locked@user.com", Name: "lockedUser", LockedUntil: null.TimeFrom(time.Now().Add(time.Hour))}
+	if err = u.Insert(testCtx, m, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	rt, err := tas.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.done()
+
+	if _, err = rt.authenticatePwUser(u.Email, "something"); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("requestTx.authenticatePwUser() error = %v, wantCode %v", err, codes.ResourceExhausted)
+	}
+
+	if err = rt.unlockUser(u); err != nil {
+		t.Fatalf("requestTx.unlockUser() error = %v", err)
+	}
+	if err = u.Reload(rt.ctx, rt.tx); err != nil {
+		t.Fatal(err)
+	}
+	if u.FailedLogins != 0 || u.LockedUntil.Valid {
+		t.Errorf("requestTx.unlockUser() user = %v, %v, want unlocked", u.FailedLogins, u.LockedUntil)
+	}
+}
+
+func Test_authServer_purgeLoginAttempts(t *testing.T) {
+	m, err := mdb.Master(testCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := &models.LoginAttempt{UserID: testUsers["noGroup"].ID, CreatedAt: time.Now().Add(-48 * time.Hour)}
+	if err = old.Insert(testCtx, m, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	recent := &models.LoginAttempt{UserID: testUsers["noGroup"].ID, CreatedAt: time.Now()}
+	if err = recent.Insert(testCtx, m, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	cc := *tas.conf
+	cc.Lockout.Retention = 24 * time.Hour
+	conf := tas.conf
+	tas.conf = &cc
+	defer func() { tas.conf = conf }()
+
+	if err = tas.purgeLoginAttempts(testCtx); err != nil {
+		t.Errorf("authServer.purgeLoginAttempts() error = %v", err)
+	}
+	if ok, _ := models.LoginAttemptExists(testCtx, m, old.ID); ok {
+		t.Errorf("authServer.purgeLoginAttempts() kept old attempt")
+	}
+	if ok, _ := models.LoginAttemptExists(testCtx, m, recent.ID); !ok {
+		t.Errorf("authServer.purgeLoginAttempts() deleted recent attempt")
+	}
+
+	ectx, cancel := context.WithCancel(testCtx)
+	cancel()
+	if err = tas.purgeLoginAttempts(ectx); err == nil {
+		t.Errorf("authServer.purgeLoginAttempts() expected error")
+	}
+}
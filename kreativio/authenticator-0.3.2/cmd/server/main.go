@@ -62,6 +62,13 @@ func main() {
 		log.WithError(err).Fatal("newAuthServer")
 	}
 
+	jobCtx, cancelJobs := context.WithCancel(context.Background())
+	defer cancelJobs()
+	s.runJob(jobCtx, "purgeRateLimits", c.RateLimits.Purge, s.purgeRateLimits)
+	s.runJob(jobCtx, "purgeLoginAttempts", c.Lockout.Purge, s.purgeLoginAttempts)
+	s.runJob(jobCtx, "purgeWebAuthnChallenges", c.WebAuthn.Purge, s.purgeWebAuthnChallenges)
+	s.runJob(jobCtx, "purgeOIDCCodes", c.OIDC.Purge, s.purgeOIDCCodes)
+
 	sc := make(chan os.Signal, 1)
 	signal.Notify(sc, os.Interrupt)
 
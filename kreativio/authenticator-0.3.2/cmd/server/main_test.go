@@ -59,6 +59,10 @@ var (
 		{Name: "user"},
 		{Name: "admin"},
 	}
+	testPermissions = []*models.Permission{
+		{Name: "catalog:read", Description: "Read the catalog"},
+		{Name: "catalog:write", Description: "Write the catalog"},
+	}
 	testAudiences = []*models.Audience{
 		{Name: "aud1"},
 		{Name: "aud2"},
@@ -136,6 +140,16 @@ func userTestData() error {
 		}
 		ul.Debug("Set password")
 	}
+	if err = testGroups[1].AddPermissions(testCtx, tx, true, testPermissions[0]); err != nil {
+		log.WithError(err).Error("AddPermissions user")
+	}
+	// Overlaps with user, as permissions are collected from all groups
+	if err = testGroups[2].AddPermissions(testCtx, tx, true, testPermissions[1]); err != nil {
+		log.WithError(err).Error("AddPermissions admin")
+	}
+	if err = testGroups[2].AddPermissions(testCtx, tx, false, testPermissions[0]); err != nil {
+		log.WithError(err).Error("AddPermissions admin")
+	}
 	if err = testUsers["oneGroup"].AddGroups(testCtx, tx, false, testGroups[0]); err != nil {
 		log.WithError(err).Error("AddGroups one")
 	}
@@ -191,6 +205,9 @@ func TestMain(m *testing.M) {
 
 	testConfig.Port = 9999 // Avoid conflict with running instance
 
+	// Failed login tests should not lock the test users
+	testConfig.Lockout.Threshold = 0
+
 	var cancel context.CancelFunc
 	testCtx, cancel = context.WithTimeout(context.Background(), 30*time.Second)
 
@@ -0,0 +1,427 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	auth "github.com/moapis/authenticator"
+	"github.com/moapis/authenticator/models"
+	"github.com/pascaldekloe/jwt"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OIDCConfig sets the OpenID Connect provider properties.
+type OIDCConfig struct {
+	Issuer      string        `json:"issuer"`       // Public base URL of the provider, as in the discovery document
+	CodeExpiry  time.Duration `json:"code_expiry"`  // Time to exchange an authorization code
+	TokenExpiry time.Duration `json:"token_expiry"` // Validity of ID and access tokens
+	Purge       time.Duration `json:"purge"`        // Interval to purge expired authorization codes. Zero disables
+}
+
+const (
+	// OIDCCodeLen is the amount of random bytes in an authorization code
+	OIDCCodeLen = 32
+	// OIDCChallengeMaxLen is the maximum length of a PKCE code challenge
+	OIDCChallengeMaxLen = 128
+
+	// pkceS256 is the only supported code challenge method
+	pkceS256 = "S256"
+
+	oidcScopeOpenID  = "openid"
+	oidcScopeEmail   = "email"
+	oidcScopeProfile = "profile"
+
+	jwtClientID = "client_id"
+	jwtScope    = "scope"
+	jwtNonce    = "nonce"
+	jwtEmail    = "email"
+	jwtName     = "name"
+)
+
+const (
+	errOIDCClient      = "Unknown OIDC client"
+	errOIDCRedirect    = "Redirect URI not registered for client"
+	errOIDCScope       = "Scope must include openid"
+	errOIDCChallenge   = "Invalid code challenge"
+	errOIDCMethod      = "Unsupported code challenge method"
+	errOIDCClientAuth  = "Client authentication failed"
+	errOIDCGrant       = "Invalid authorization code"
+	errOIDCAccessToken = "Not an OIDC access token"
+	errOIDCToken       = "OIDC access token can't be used as user token"
+)
+
+// oidcScopes are the supported scopes, in the order of the discovery document.
+var oidcScopes = []string{oidcScopeOpenID, oidcScopeEmail, oidcScopeProfile}
+
+// parseOIDCScope returns the supported scopes from the space separated scope,
+// without duplicates.
+// An error is returned if the scope does not include "openid".
+func parseOIDCScope(scope string) ([]string, error) {
+	requested := make(map[string]bool)
+	for _, s := range strings.Fields(scope) {
+		requested[s] = true
+	}
+	if !requested[oidcScopeOpenID] {
+		return nil, errors.New(errOIDCScope)
+	}
+	var scopes []string
+	for _, s := range oidcScopes {
+		if requested[s] {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes, nil
+}
+
+func hasOIDCScope(scope, want string) bool {
+	for _, s := range strings.Fields(scope) {
+		if s == want {
+			return true
+		}
+	}
+	return false
+}
+
+// checkCodeChallenge validates a PKCE code challenge and its method.
+func checkCodeChallenge(challenge, method string) error {
+	if method != pkceS256 {
+		return errors.New(errOIDCMethod)
+	}
+	// A base64url encoded SHA-256 hash
+	if len(challenge) < b64url.EncodedLen(sha256.Size) || len(challenge) > OIDCChallengeMaxLen {
+		return errors.New(errOIDCChallenge)
+	}
+	return nil
+}
+
+// verifyCodeChallenge checks the PKCE code verifier against the S256 challenge.
+func verifyCodeChallenge(challenge, verifier string) bool {
+	sum := sha256.Sum256([]byte(verifier))
+	return subtle.ConstantTimeCompare([]byte(b64url.EncodeToString(sum[:])), []byte(challenge)) == 1
+}
+
+// hashSecret is used for storing authorization codes and client secrets.
+func hashSecret(secret string) []byte {
+	sum := sha256.Sum256([]byte(secret))
+	return sum[:]
+}
+
+// checkClientSecret authenticates a client.
+// Public clients, without a stored secret, always pass.
+func checkClientSecret(client *models.OidcClient, secret string) bool {
+	if !client.SecretHash.Valid {
+		return true
+	}
+	return subtle.ConstantTimeCompare(client.SecretHash.Bytes, hashSecret(secret)) == 1
+}
+
+func hasRedirectURI(client *models.OidcClient, uri string) bool {
+	for _, u := range client.RedirectUris {
+		if u == uri {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *authServer) oidcAudience() string {
+	return fmt.Sprintf("oidc@%s", s.conf.JWT.Issuer)
+}
+
+func (s *authServer) hasOIDCAudience(audiences []string) bool {
+	b := s.oidcAudience()
+	for _, a := range audiences {
+		if a == b {
+			return true
+		}
+	}
+	return false
+}
+
+// findOIDCClient returns the client with clientID,
+// which must have uri as a registered redirect URI.
+func (rt *requestTx) findOIDCClient(clientID, uri string) (*models.OidcClient, error) {
+	rt.log = rt.log.WithFields(logrus.Fields{"client_id": clientID, "redirect_uri": uri})
+
+	client, err := models.OidcClients(models.OidcClientWhere.ClientID.EQ(clientID)).One(rt.ctx, rt.tx)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		rt.log.WithError(err).Warn("findOIDCClient")
+		return nil, status.Error(codes.NotFound, errOIDCClient)
+	default:
+		rt.log.WithError(err).Error("findOIDCClient")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	if !hasRedirectURI(client, uri) {
+		rt.log.Warn(errOIDCRedirect)
+		return nil, status.Error(codes.InvalidArgument, errOIDCRedirect)
+	}
+	return client, nil
+}
+
+// authorizeOIDC stores a new authorization code for user and returns it.
+// Only a hash of the code is stored.
+func (rt *requestTx) authorizeOIDC(user *models.User, req *auth.OIDCAuthRequest, read func([]byte) (int, error)) (*auth.OIDCCode, error) {
+	client, err := rt.findOIDCClient(req.GetClientId(), req.GetRedirectUri())
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := parseOIDCScope(req.GetScope())
+	if err != nil {
+		rt.log.WithError(err).Warn("authorizeOIDC")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err = checkCodeChallenge(req.GetCodeChallenge(), req.GetCodeChallengeMethod()); err != nil {
+		rt.log.WithError(err).Warn("authorizeOIDC")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	code := make([]byte, OIDCCodeLen)
+	if _, err = read(code); err != nil {
+		rt.log.WithError(err).Error("Code generation")
+		return nil, status.Error(codes.Internal, errFatal)
+	}
+	reply := &auth.OIDCCode{Code: b64url.EncodeToString(code)}
+
+	m := &models.OidcCode{
+		ExpiresAt:     time.Now().Add(rt.s.conf.OIDC.CodeExpiry),
+		CodeHash:      hashSecret(reply.Code),
+		OidcClientID:  client.ID,
+		UserID:        user.ID,
+		RedirectURI:   req.GetRedirectUri(),
+		Scope:         strings.Join(scopes, " "),
+		Nonce:         req.GetNonce(),
+		CodeChallenge: req.GetCodeChallenge(),
+	}
+	if err = m.Insert(rt.ctx, rt.tx, boil.Infer()); err != nil {
+		rt.log.WithError(err).Error("Insert code")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	rt.log.WithFields(logrus.Fields{"event": "oidc.authorized", "user": user.ID, "client": client.ID}).Info("OIDC code issued")
+	return reply, nil
+}
+
+// authenticateOIDCClient finds the client and checks its secret.
+func (rt *requestTx) authenticateOIDCClient(clientID, secret string) (*models.OidcClient, error) {
+	rt.log = rt.log.WithField("client_id", clientID)
+
+	client, err := models.OidcClients(models.OidcClientWhere.ClientID.EQ(clientID)).One(rt.ctx, rt.tx)
+	if err != nil && err != sql.ErrNoRows {
+		rt.log.WithError(err).Error("authenticateOIDCClient")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	if err == sql.ErrNoRows || !checkClientSecret(client, secret) {
+		rt.log.WithError(errors.New(errOIDCClientAuth)).Warn("authenticateOIDCClient")
+		return nil, status.Error(codes.Unauthenticated, errOIDCClientAuth)
+	}
+	return client, nil
+}
+
+// useOIDCCode finds and deletes the authorization code,
+// so that it can't be used again.
+func (rt *requestTx) useOIDCCode(code string) (*models.OidcCode, error) {
+	if code == "" {
+		rt.log.Warn(errOIDCGrant)
+		return nil, status.Error(codes.InvalidArgument, errOIDCGrant)
+	}
+	m, err := models.OidcCodes(
+		models.OidcCodeWhere.CodeHash.EQ(hashSecret(code)),
+		qm.Load(models.OidcCodeRels.User),
+		qm.For("update"),
+	).One(rt.ctx, rt.tx)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		rt.log.WithError(err).Warn("useOIDCCode")
+		return nil, status.Error(codes.PermissionDenied, errOIDCGrant)
+	default:
+		rt.log.WithError(err).Error("useOIDCCode")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	rt.log = rt.log.WithFields(logrus.Fields{"code": m.ID, "user": m.UserID})
+
+	if _, err = m.Delete(rt.ctx, rt.tx); err != nil {
+		rt.log.WithError(err).Error("Delete code")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	return m, nil
+}
+
+// checkOIDCCode verifies that the code was issued to client, for the same redirect URI,
+// is not expired and matches the PKCE code verifier.
+func checkOIDCCode(code *models.OidcCode, client *models.OidcClient, redirectURI, verifier string, now time.Time) error {
+	switch {
+	case code.OidcClientID != client.ID:
+		return errors.New("code issued to another client")
+	case !code.ExpiresAt.After(now):
+		return errors.New("code expired")
+	case code.RedirectURI != redirectURI:
+		return errors.New("redirect URI mismatch")
+	case !verifyCodeChallenge(code.CodeChallenge, verifier):
+		return errors.New("code verifier mismatch")
+	}
+	return nil
+}
+
+// oidcTokens signs the ID and access token for the user of code.
+// The ID token is issued by the OIDC issuer, with the client as audience.
+// The access token can only be used for GetOIDCUserInfo.
+func (rt *requestTx) oidcTokens(code *models.OidcCode, client *models.OidcClient, user *models.User, issued time.Time) (*auth.OIDCTokens, error) {
+	conf := rt.s.conf
+	expires := issued.Add(conf.OIDC.TokenExpiry)
+
+	idSet := map[string]interface{}{}
+	if code.Nonce != "" {
+		idSet[jwtNonce] = code.Nonce
+	}
+	if hasOIDCScope(code.Scope, oidcScopeEmail) {
+		idSet[jwtEmail] = user.Email
+	}
+	if hasOIDCScope(code.Scope, oidcScopeProfile) {
+		idSet[jwtName] = user.Name
+	}
+
+	idToken, err := rt.signClaims(jwt.Claims{
+		Registered: jwt.Registered{
+			Issuer:    conf.OIDC.Issuer,
+			Subject:   strconv.Itoa(user.ID),
+			Expires:   jwt.NewNumericTime(expires),
+			Audiences: []string{client.ClientID},
+			Issued:    jwt.NewNumericTime(issued),
+		},
+		Set: idSet,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := rt.signClaims(jwt.Claims{
+		Registered: jwt.Registered{
+			Issuer:    conf.JWT.Issuer,
+			Subject:   user.Email,
+			Expires:   jwt.NewNumericTime(expires),
+			Audiences: []string{rt.s.oidcAudience()},
+			Issued:    jwt.NewNumericTime(issued),
+		},
+		Set: map[string]interface{}{
+			jwtUserID:   user.ID,
+			jwtClientID: client.ClientID,
+			jwtScope:    code.Scope,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.OIDCTokens{
+		AccessToken: accessToken,
+		IdToken:     idToken,
+		ExpiresIn:   int64(conf.OIDC.TokenExpiry / time.Second),
+		Scope:       code.Scope,
+	}, nil
+}
+
+// oidcUserInfo returns the claims of the user of an OIDC access token.
+func (rt *requestTx) oidcUserInfo(token string) (*auth.OIDCUserInfo, error) {
+	claims, err := rt.checkJWT(token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !rt.s.hasOIDCAudience(claims.Audiences) {
+		rt.log.WithField("audiences", claims.Audiences).Warn(errOIDCAccessToken)
+		return nil, status.Error(codes.PermissionDenied, errOIDCAccessToken)
+	}
+	user, err := rt.findUserByEmail(claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	scope, _ := claims.Set[jwtScope].(string)
+	info := &auth.OIDCUserInfo{Sub: strconv.Itoa(user.ID)}
+	if hasOIDCScope(scope, oidcScopeEmail) {
+		info.Email = user.Email
+	}
+	if hasOIDCScope(scope, oidcScopeProfile) {
+		info.Name = user.Name
+	}
+	return info, nil
+}
+
+// listPubKeys returns the keys which may have signed tokens that did not expire yet.
+// Those are the keys created since the longest token expiry,
+// the newest key created before that and the current key of this server.
+func (rt *requestTx) listPubKeys(now time.Time) (*auth.PublicKeys, error) {
+	expiry := rt.s.conf.JWT.Expiry
+	if e := rt.s.conf.OIDC.TokenExpiry; e > expiry {
+		expiry = e
+	}
+	since := now.Add(-expiry)
+
+	last, err := models.JWTKeys(
+		qm.Select(models.JWTKeyColumns.CreatedAt),
+		models.JWTKeyWhere.CreatedAt.LT(since),
+		qm.OrderBy(models.JWTKeyColumns.CreatedAt+" desc"),
+	).One(rt.ctx, rt.tx)
+	switch err {
+	case nil:
+		since = last.CreatedAt
+	case sql.ErrNoRows:
+	default:
+		rt.log.WithError(err).Error("listPubKeys")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	mods := []qm.QueryMod{
+		models.JWTKeyWhere.CreatedAt.GTE(since),
+		qm.OrderBy(models.JWTKeyColumns.ID),
+	}
+	if id, err := strconv.Atoi(rt.s.privateKey().id); err == nil {
+		mods = append(mods, qm.Or2(models.JWTKeyWhere.ID.EQ(id)))
+	}
+	keys, err := models.JWTKeys(mods...).All(rt.ctx, rt.tx)
+	if err != nil {
+		rt.log.WithError(err).Error("listPubKeys")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	rt.log.WithField("keys", len(keys)).Debug("listPubKeys")
+
+	reply := &auth.PublicKeys{Keys: make([]*auth.PublicKey, len(keys))}
+	for i, k := range keys {
+		reply.Keys[i] = &auth.PublicKey{Key: k.PublicKey, Kid: int32(k.ID)}
+	}
+	return reply, nil
+}
+
+func (s *authServer) purgeOIDCCodes(ctx context.Context) error {
+	rt, err := s.newTx(ctx, "purgeOIDCCodes", false)
+	if err != nil {
+		return err
+	}
+	defer rt.done()
+
+	ra, err := models.OidcCodes(
+		models.OidcCodeWhere.ExpiresAt.LT(time.Now()),
+	).DeleteAll(rt.ctx, rt.tx)
+	if err != nil {
+		rt.log.WithError(err).Error("purgeOIDCCodes")
+		return status.Error(codes.Internal, errDB)
+	}
+	rt.log.WithField("ra", ra).Debug("purgeOIDCCodes")
+	return rt.commit()
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"crypto/ed25519"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	auth "github.com/moapis/authenticator"
+	"github.com/moapis/authenticator/models"
+	"github.com/pascaldekloe/jwt"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Verifier and challenge from RFC 7636, appendix B
+const (
+	testCodeVerifier  = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	testCodeChallenge = "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+)
+
+func Test_parseOIDCScope(t *testing.T) {
+	tests := []struct {
+		name    string
+		scope   string
+		want    []string
+		wantErr bool
+	}{
+		{"Empty", "", nil, true},
+		{"Missing openid", "email profile", nil, true},
+		{"Openid", "openid", []string{"openid"}, false},
+		{"Ordered and unique", "profile openid  email openid", []string{"openid", "email", "profile"}, false},
+		{"Unsupported", "openid offline_access", []string{"openid"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseOIDCScope(tt.scope)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseOIDCScope() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseOIDCScope() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_checkCodeChallenge(t *testing.T) {
+	tests := []struct {
+		name      string
+		challenge string
+		method    string
+		wantErr   bool
+	}{
+		{"S256", testCodeChallenge, "S256", false},
+		{"Plain", testCodeChallenge, "plain", true},
+		{"Missing method", testCodeChallenge, "", true},
+		{"Short", "spanac", "S256", true},
+		{"Long", strings.Repeat("x", OIDCChallengeMaxLen+1), "S256", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkCodeChallenge(tt.challenge, tt.method); (err != nil) != tt.wantErr {
+				t.Errorf("checkCodeChallenge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_verifyCodeChallenge(t *testing.T) {
+	if !verifyCodeChallenge(testCodeChallenge, testCodeVerifier) {
+		t.Error("verifyCodeChallenge() = false, want true")
+	}
+	if verifyCodeChallenge(testCodeChallenge, "spanac") {
+		t.Error("verifyCodeChallenge() = true, want false")
+	}
+}
+
+func Test_checkClientSecret(t *testing.T) {
+	confidential := &models.OidcClient{SecretHash: null.BytesFrom(hashSecret("secret"))}
+
+	tests := []struct {
+		name   string
+		client *models.OidcClient
+		secret string
+		want   bool
+	}{
+		{"Public", &models.OidcClient{}, "", true},
+		{"Confidential", confidential, "secret", true},
+		{"Wrong secret", confidential, "spanac", false},
+		{"Missing secret", confidential, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := checkClientSecret(tt.client, tt.secret); got != tt.want {
+				t.Errorf("checkClientSecret() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_checkOIDCCode(t *testing.T) {
+	now := time.Now()
+	client := &models.OidcClient{ID: 1}
+	code := &models.OidcCode{
+		OidcClientID:  1,
+		ExpiresAt:     now.Add(time.Minute),
+		RedirectURI:   "https://client.com/cb",
+		CodeChallenge: testCodeChallenge,
+	}
+
+	tests := []struct {
+		name     string
+		client   *models.OidcClient
+		redirect string
+		verifier string
+		now      time.Time
+		wantErr  bool
+	}{
+		{"Valid", client, "https://client.com/cb", testCodeVerifier, now, false},
+		{"Other client", &models.OidcClient{ID: 2}, "https://client.com/cb", testCodeVerifier, now, true},
+		{"Expired", client, "https://client.com/cb", testCodeVerifier, now.Add(time.Hour), true},
+		{"Redirect mismatch", client, "https://evil.com/cb", testCodeVerifier, now, true},
+		{"Wrong verifier", client, "https://client.com/cb", "spanac", now, true},
+		{"Missing verifier", client, "https://client.com/cb", "", now, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkOIDCCode(code, tt.client, tt.redirect, tt.verifier, tt.now); (err != nil) != tt.wantErr {
+				t.Errorf("checkOIDCCode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_authServer_OIDC(t *testing.T) {
+	const redirect = "https://client.com/cb"
+
+	rt, err := tas.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := &models.User{Email: "oidc@user.com", Name: "oidcUser"}
+	if err = u.Insert(testCtx, rt.tx, boil.Infer()); err != nil {
+		rt.done()
+		t.Fatal(err)
+	}
+	public := &models.OidcClient{ClientID: "public", Name: "Public", RedirectUris: types.StringArray{redirect}}
+	confidential := &models.OidcClient{ClientID: "confidential", Name: "Confidential", SecretHash: null.BytesFrom(hashSecret("secret")), RedirectUris: types.StringArray{redirect}}
+	for _, c := range []*models.OidcClient{public, confidential} {
+		if err = c.Insert(testCtx, rt.tx, boil.Infer()); err != nil {
+			rt.done()
+			t.Fatal(err)
+		}
+	}
+	if err = rt.commit(); err != nil {
+		t.Fatal(err)
+	}
+	rt.done()
+
+	rt, err = tas.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply, err := rt.userAuthReply(u, time.Now())
+	rt.done()
+	if err != nil {
+		t.Fatal(err)
+	}
+	userToken := reply.GetJwt()
+
+	checkTests := []struct {
+		name     string
+		cr       *auth.OIDCClientRequest
+		want     string
+		wantCode codes.Code
+	}{
+		{"Unknown client", &auth.OIDCClientRequest{ClientId: "spanac", RedirectUri: redirect}, "", codes.NotFound},
+		{"Unknown redirect", &auth.OIDCClientRequest{ClientId: "public", RedirectUri: "https://evil.com/cb"}, "", codes.InvalidArgument},
+		{"Success", &auth.OIDCClientRequest{ClientId: "public", RedirectUri: redirect}, "Public", codes.OK},
+	}
+	for _, tt := range checkTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tas.CheckOIDCClient(testCtx, tt.cr)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("authServer.CheckOIDCClient() error = %v, wantCode %v", err, tt.wantCode)
+			}
+			if got.GetName() != tt.want {
+				t.Errorf("authServer.CheckOIDCClient() = %v, want %v", got.GetName(), tt.want)
+			}
+		})
+	}
+
+	authRequest := func(clientID string) *auth.OIDCAuthRequest {
+		return &auth.OIDCAuthRequest{
+			Jwt:                 userToken,
+			ClientId:            clientID,
+			RedirectUri:         redirect,
+			Scope:               "openid email",
+			Nonce:               "n-0S6_WzA2Mj",
+			CodeChallenge:       testCodeChallenge,
+			CodeChallengeMethod: pkceS256,
+		}
+	}
+	authTests := []struct {
+		name     string
+		modify   func(*auth.OIDCAuthRequest)
+		wantCode codes.Code
+	}{
+		{"Bad token", func(ar *auth.OIDCAuthRequest) { ar.Jwt = "spanac" }, codes.Unauthenticated},
+		{"Unknown client", func(ar *auth.OIDCAuthRequest) { ar.ClientId = "spanac" }, codes.NotFound},
+		{"Missing openid", func(ar *auth.OIDCAuthRequest) { ar.Scope = "email" }, codes.InvalidArgument},
+		{"Plain challenge", func(ar *auth.OIDCAuthRequest) { ar.CodeChallengeMethod = "plain" }, codes.InvalidArgument},
+		{"Success", func(ar *auth.OIDCAuthRequest) {}, codes.OK},
+	}
+	for _, tt := range authTests {
+		t.Run(tt.name, func(t *testing.T) {
+			ar := authRequest("public")
+			tt.modify(ar)
+			if _, err := tas.AuthorizeOIDC(testCtx, ar); status.Code(err) != tt.wantCode {
+				t.Errorf("authServer.AuthorizeOIDC() error = %v, wantCode %v", err, tt.wantCode)
+			}
+		})
+	}
+
+	code, err := tas.AuthorizeOIDC(testCtx, authRequest("public"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	exchangeTests := []struct {
+		name     string
+		tr       *auth.OIDCTokenRequest
+		wantCode codes.Code
+	}{
+		{"Unknown client", &auth.OIDCTokenRequest{Code: code.GetCode(), ClientId: "spanac", RedirectUri: redirect, CodeVerifier: testCodeVerifier}, codes.Unauthenticated},
+		{"Unknown code", &auth.OIDCTokenRequest{Code: "spanac", ClientId: "public", RedirectUri: redirect, CodeVerifier: testCodeVerifier}, codes.PermissionDenied},
+		{"Success", &auth.OIDCTokenRequest{Code: code.GetCode(), ClientId: "public", RedirectUri: redirect, CodeVerifier: testCodeVerifier}, codes.OK},
+		{"Reused code", &auth.OIDCTokenRequest{Code: code.GetCode(), ClientId: "public", RedirectUri: redirect, CodeVerifier: testCodeVerifier}, codes.PermissionDenied},
+	}
+	var tokens *auth.OIDCTokens
+	for _, tt := range exchangeTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tas.ExchangeOIDCCode(testCtx, tt.tr)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("authServer.ExchangeOIDCCode() error = %v, wantCode %v", err, tt.wantCode)
+			}
+			if err == nil {
+				tokens = got
+			}
+		})
+	}
+	if tokens == nil {
+		t.Fatal("authServer.ExchangeOIDCCode() no tokens")
+	}
+
+	claims, err := jwt.EdDSACheck([]byte(tokens.GetIdToken()), ed25519.PrivateKey(testPrivKey).Public().(ed25519.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.Issuer != tas.conf.OIDC.Issuer || claims.Subject != strconv.Itoa(u.ID) || !claims.AcceptAudience("public") ||
+		claims.Set[jwtNonce] != "n-0S6_WzA2Mj" || claims.Set[jwtEmail] != u.Email || claims.Set[jwtName] != nil {
+		t.Errorf("authServer.ExchangeOIDCCode() ID token claims = %v", claims)
+	}
+
+	info, err := tas.GetOIDCUserInfo(testCtx, &auth.AuthReply{Jwt: tokens.GetAccessToken()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&auth.OIDCUserInfo{Sub: strconv.Itoa(u.ID), Email: u.Email}); info.GetSub() != want.Sub || info.GetEmail() != want.Email || info.GetName() != "" {
+		t.Errorf("authServer.GetOIDCUserInfo() = %v, want %v", info, want)
+	}
+	if _, err = tas.GetOIDCUserInfo(testCtx, &auth.AuthReply{Jwt: userToken}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("authServer.GetOIDCUserInfo() error = %v, wantCode %v", err, codes.PermissionDenied)
+	}
+	// Access tokens are handed to third parties and should not act as user tokens.
+	if _, err = tas.RefreshToken(testCtx, &auth.AuthReply{Jwt: tokens.GetAccessToken()}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("authServer.RefreshToken() error = %v, wantCode %v", err, codes.Unauthenticated)
+	}
+
+	// A failed exchange uses the code.
+	code, err = tas.AuthorizeOIDC(testCtx, authRequest("confidential"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := &auth.OIDCTokenRequest{Code: code.GetCode(), ClientId: "confidential", RedirectUri: redirect, CodeVerifier: testCodeVerifier}
+	if _, err = tas.ExchangeOIDCCode(testCtx, tr); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("authServer.ExchangeOIDCCode() error = %v, wantCode %v", err, codes.Unauthenticated)
+	}
+	tr.ClientSecret = "secret"
+	tr.CodeVerifier = "spanac"
+	if _, err = tas.ExchangeOIDCCode(testCtx, tr); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("authServer.ExchangeOIDCCode() error = %v, wantCode %v", err, codes.PermissionDenied)
+	}
+	tr.CodeVerifier = testCodeVerifier
+	if _, err = tas.ExchangeOIDCCode(testCtx, tr); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("authServer.ExchangeOIDCCode() error = %v, wantCode %v", err, codes.PermissionDenied)
+	}
+
+	if err = tas.purgeOIDCCodes(testCtx); err != nil {
+		t.Errorf("authServer.purgeOIDCCodes() error = %v", err)
+	}
+}
+
+func Test_authServer_ListPubKeys(t *testing.T) {
+	got, err := tas.ListPubKeys(testCtx, &empty.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range got.GetKeys() {
+		if k.GetKid() == 10 {
+			return
+		}
+	}
+	t.Errorf("authServer.ListPubKeys() = %v, missing current key", got)
+}
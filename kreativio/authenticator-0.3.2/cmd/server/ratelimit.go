@@ -0,0 +1,248 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// TokenBucket allows Burst requests at once, refilled with one token every Interval.
+// A zero Burst or Interval disables the bucket.
+type TokenBucket struct {
+	Burst    int           `json:"burst"`    // Maximum amount of tokens
+	Interval time.Duration `json:"interval"` // Time to add one token
+}
+
+func (b TokenBucket) enabled() bool {
+	return b.Burst > 0 && b.Interval > 0
+}
+
+// MethodRateLimits holds the token buckets of a method.
+type MethodRateLimits struct {
+	Peer    TokenBucket `json:"peer"`    // Bucket per client address
+	Subject TokenBucket `json:"subject"` // Bucket per e-mail or UUID in the request
+}
+
+// RateLimitConfig sets the rate limits of unauthenticated methods.
+// The buckets are kept in the database, so they are shared by all replicas.
+type RateLimitConfig struct {
+	Methods map[string]MethodRateLimits `json:"methods"` // Map of method names and their limits, unlisted methods are not limited
+	Purge   time.Duration               `json:"purge"`   // Interval to purge buckets which are full again. Zero disables
+}
+
+const (
+	errRateLimited = "Too many requests, try again later"
+
+	takeRateToken   = "select auth.take_rate_token($1, $2, $3);"
+	purgeRateLimits = "delete from auth.rate_limits where full_at < now();"
+)
+
+// forwardedAddr returns the x-forwarded-for entry appended by the first of trustedProxies,
+// counted from the right. Entries further left are set by the client and can't be trusted.
+// If the header has less entries, the first one is returned.
+func forwardedAddr(ctx context.Context, trustedProxies int) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	var addrs []string
+	for _, fwd := range md.Get("x-forwarded-for") {
+		for _, addr := range strings.Split(fwd, ",") {
+			if addr = strings.TrimSpace(addr); addr != "" {
+				addrs = append(addrs, addr)
+			}
+		}
+	}
+	if len(addrs) == 0 {
+		return ""
+	}
+	if trustedProxies > len(addrs) {
+		trustedProxies = len(addrs)
+	}
+	return addrs[len(addrs)-trustedProxies]
+}
+
+// clientAddr returns the address of the client, without port.
+// Behind trustedProxies, the address is taken from the x-forwarded-for metadata.
+func clientAddr(ctx context.Context, trustedProxies int) string {
+	if trustedProxies > 0 {
+		if addr := forwardedAddr(ctx, trustedProxies); addr != "" {
+			return addr
+		}
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// rateSubject returns the e-mail or UUID from req, if it has any.
+func rateSubject(req interface{}) string {
+	switch r := req.(type) {
+	case interface{ GetEmail() string }:
+		return strings.ToLower(strings.TrimSpace(r.GetEmail()))
+	case interface{ GetUuid() string }:
+		return r.GetUuid()
+	}
+	return ""
+}
+
+// rateKey identifies the bucket of value for method.
+// The value is hashed, so that no addresses or e-mails are stored.
+func rateKey(method, kind, value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return strings.Join([]string{method, kind, hex.EncodeToString(sum[:])}, ":")
+}
+
+// retryError returns a ResourceExhausted error with msg, and the wait time as RetryInfo.
+func retryError(msg string, wait time.Duration) error {
+	st := status.New(codes.ResourceExhausted, msg)
+	if ds, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(wait)}); err == nil {
+		st = ds
+	}
+	return st.Err()
+}
+
+// takeRateToken takes a token from the bucket of key.
+// It returns the time to wait for the next token, when the bucket is empty.
+func (rt *requestTx) takeRateToken(key string, bucket TokenBucket) (time.Duration, error) {
+	var wait float64
+	if err := queries.Raw(takeRateToken, key, 1/bucket.Interval.Seconds(), float64(bucket.Burst)).QueryRowContext(rt.ctx, rt.tx).Scan(&wait); err != nil {
+		rt.log.WithError(err).Error("takeRateToken")
+		return 0, status.Error(codes.Internal, errDB)
+	}
+	return time.Duration(wait * float64(time.Second)), nil
+}
+
+// checkRateLimit takes a token from each bucket of method that applies to the request.
+// A ResourceExhausted error is returned when any of them is empty.
+// Database errors are logged and let the request pass,
+// so that a database outage does not block authentication.
+func (s *authServer) checkRateLimit(ctx context.Context, method string, req interface{}) error {
+	limits, ok := s.conf.RateLimits.Methods[method]
+	if !ok {
+		return nil
+	}
+
+	type bucket struct {
+		key string
+		TokenBucket
+	}
+	var buckets []bucket
+	if limits.Peer.enabled() {
+		if addr := clientAddr(ctx, s.conf.Proxies); addr != "" {
+			buckets = append(buckets, bucket{rateKey(method, "peer", addr), limits.Peer})
+		}
+	}
+	if limits.Subject.enabled() {
+		if subject := rateSubject(req); subject != "" {
+			buckets = append(buckets, bucket{rateKey(method, "subject", subject), limits.Subject})
+		}
+	}
+	if len(buckets) == 0 {
+		return nil
+	}
+
+	rt, err := s.newTx(ctx, method, false)
+	if err != nil {
+		return nil
+	}
+	defer rt.done()
+
+	var wait time.Duration
+	for _, b := range buckets {
+		w, err := rt.takeRateToken(b.key, b.TokenBucket)
+		if err != nil {
+			return nil
+		}
+		if w > wait {
+			wait = w
+		}
+	}
+	if err = rt.commit(); err != nil {
+		return nil
+	}
+
+	if wait > 0 {
+		rt.log.WithFields(logrus.Fields{"event": "rate.limited", "wait": wait}).Warn(errRateLimited)
+		return retryError(errRateLimited, wait)
+	}
+	return nil
+}
+
+func (s *authServer) unaryRateLimitInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := s.checkRateLimit(ctx, path.Base(info.FullMethod), req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// purgeRateLimits deletes buckets which are full again.
+func (s *authServer) purgeRateLimits(ctx context.Context) error {
+	rt, err := s.newTx(ctx, "purgeRateLimits", false)
+	if err != nil {
+		return err
+	}
+	defer rt.done()
+
+	res, err := queries.Raw(purgeRateLimits).ExecContext(rt.ctx, rt.tx)
+	if err != nil {
+		rt.log.WithError(err).Error("purgeRateLimits")
+		return status.Error(codes.Internal, errDB)
+	}
+	ra, _ := res.RowsAffected()
+	rt.log.WithField("ra", ra).Debug("purgeRateLimits")
+	return rt.commit()
+}
+
+// runJob calls fn every interval, until ctx is done.
+// A zero interval disables the job.
+func (s *authServer) runJob(ctx context.Context, name string, interval time.Duration, fn func(context.Context) error) {
+	log := s.log.WithFields(logrus.Fields{"job": name, "interval": interval})
+	if interval <= 0 {
+		log.Info("Job disabled")
+		return
+	}
+	log.Info("Starting job")
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.WithError(ctx.Err()).Info("Stopping job")
+				return
+			case <-ticker.C:
+			}
+
+			if err := fn(ctx); err != nil {
+				log.WithError(err).Error(name)
+			}
+		}
+	}()
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	auth "github.com/moapis/authenticator"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func Test_clientAddr(t *testing.T) {
+	pctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234},
+	})
+	fctx := metadata.NewIncomingContext(pctx, metadata.Pairs("x-forwarded-for", "192.0.2.1"))
+	// The client sent its own header, which the proxies appended to
+	sctx := metadata.NewIncomingContext(pctx, metadata.Pairs("x-forwarded-for", "203.0.113.66, 192.0.2.1, 10.0.0.2"))
+	mctx := metadata.NewIncomingContext(pctx, metadata.Pairs("x-forwarded-for", "203.0.113.66", "x-forwarded-for", "192.0.2.1, 10.0.0.2"))
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		trustedProxies int
+		want           string
+	}{
+		{"No peer", context.Background(), 0, ""},
+		{"Peer", pctx, 0, "10.0.0.1"},
+		{"Untrusted forward", fctx, 0, "10.0.0.1"},
+		{"Trusted forward", fctx, 1, "192.0.2.1"},
+		{"Trusted without forward", pctx, 1, "10.0.0.1"},
+		{"Spoofed forward", sctx, 2, "192.0.2.1"},
+		{"Spoofed forward, one proxy", sctx, 1, "10.0.0.2"},
+		{"Spoofed multiple headers", mctx, 2, "192.0.2.1"},
+		{"More proxies than entries", fctx, 3, "192.0.2.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clientAddr(tt.ctx, tt.trustedProxies); got != tt.want {
+				t.Errorf("clientAddr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_rateSubject(t *testing.T) {
+	tests := []struct {
+		name string
+		req  interface{}
+		want string
+	}{
+		{"UserPassword", &auth.UserPassword{Email: " Foo@Bar.com"}, "foo@bar.com"},
+		{"UserEmail", &auth.UserEmail{Email: "foo@bar.com"}, "foo@bar.com"},
+		{"PublicUser", &auth.PublicUser{Uuid: "abc"}, "abc"},
+		{"No subject", &auth.KeyID{Kid: 1}, ""},
+		{"Nil", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rateSubject(tt.req); got != tt.want {
+				t.Errorf("rateSubject() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_rateKey(t *testing.T) {
+	got := rateKey("AuthenticatePwUser", "subject", "foo@bar.com")
+	if !strings.HasPrefix(got, "AuthenticatePwUser:subject:") {
+		t.Errorf("rateKey() = %v, want prefix %v", got, "AuthenticatePwUser:subject:")
+	}
+	if strings.Contains(got, "foo@bar.com") {
+		t.Errorf("rateKey() = %v, contains the plain value", got)
+	}
+}
+
+func Test_retryError(t *testing.T) {
+	st := status.Convert(retryError(errRateLimited, time.Minute))
+	if st.Code() != codes.ResourceExhausted {
+		t.Fatalf("retryError() code = %v, want %v", st.Code(), codes.ResourceExhausted)
+	}
+	details := st.Details()
+	if len(details) != 1 {
+		t.Fatalf("retryError() details = %v, want 1 RetryInfo", details)
+	}
+	ri, ok := details[0].(*errdetails.RetryInfo)
+	if !ok {
+		t.Fatalf("retryError() details = %T, want %T", details[0], ri)
+	}
+	if d, _ := ptypes.Duration(ri.GetRetryDelay()); d != time.Minute {
+		t.Errorf("retryError() RetryDelay = %v, want %v", d, time.Minute)
+	}
+}
+
+func Test_authServer_checkRateLimit(t *testing.T) {
+	cc := *tas.conf
+	cc.RateLimits = RateLimitConfig{
+		Methods: map[string]MethodRateLimits{
+			"AuthenticatePwUser": {
+				Peer:    TokenBucket{Burst: 4, Interval: time.Hour},
+				Subject: TokenBucket{Burst: 2, Interval: time.Hour},
+			},
+			"ResetUserPW": {
+				Peer: TokenBucket{Burst: 1, Interval: time.Hour},
+			},
+		},
+	}
+	conf := tas.conf
+	tas.conf = &cc
+	defer func() { tas.conf = conf }()
+
+	pctx := peer.NewContext(testCtx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234}})
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		method   string
+		req      interface{}
+		wantCode codes.Code
+	}{
+		{"Unlisted method", pctx, "GetPubKey", &auth.KeyID{}, codes.OK},
+		{"First", pctx, "AuthenticatePwUser", &auth.UserPassword{Email: "foo@bar.com"}, codes.OK},
+		{"Second", pctx, "AuthenticatePwUser", &auth.UserPassword{Email: "Foo@Bar.com"}, codes.OK},
+		{"Subject exhausted", pctx, "AuthenticatePwUser", &auth.UserPassword{Email: "foo@bar.com"}, codes.ResourceExhausted},
+		{"Other subject", pctx, "AuthenticatePwUser", &auth.UserPassword{Email: "bar@foo.com"}, codes.OK},
+		{"Peer exhausted", pctx, "AuthenticatePwUser", &auth.UserPassword{Email: "spanac@foo.com"}, codes.ResourceExhausted},
+		{"Other method", pctx, "ResetUserPW", &auth.UserEmail{Email: "foo@bar.com"}, codes.OK},
+		{"Other method exhausted", pctx, "ResetUserPW", &auth.UserEmail{Email: "foo@bar.com"}, codes.ResourceExhausted},
+		{"No peer", testCtx, "ResetUserPW", &auth.UserEmail{Email: "foo@bar.com"}, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tas.checkRateLimit(tt.ctx, tt.method, tt.req)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("authServer.checkRateLimit() error = %v, wantCode %v", err, tt.wantCode)
+			}
+		})
+	}
+
+	t.Run("Interceptor", func(t *testing.T) {
+		info := &grpc.UnaryServerInfo{FullMethod: "/authenticator.Authenticator/ResetUserPW"}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) { return req, nil }
+		if _, err := tas.unaryRateLimitInterceptor(pctx, &auth.UserEmail{}, info, handler); status.Code(err) != codes.ResourceExhausted {
+			t.Errorf("authServer.unaryRateLimitInterceptor() error = %v, wantCode %v", err, codes.ResourceExhausted)
+		}
+	})
+
+	t.Run("DB error", func(t *testing.T) {
+		ectx, cancel := context.WithCancel(pctx)
+		cancel()
+		if err := tas.checkRateLimit(ectx, "ResetUserPW", &auth.UserEmail{}); err != nil {
+			t.Errorf("authServer.checkRateLimit() error = %v, want fail open", err)
+		}
+	})
+}
+
+func Test_authServer_purgeRateLimits(t *testing.T) {
+	rt, err := tas.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = rt.tx.ExecContext(rt.ctx, "insert into auth.rate_limits (key, tokens, full_at) values ('purge', 1, now() - interval '1 second');"); err != nil {
+		rt.done()
+		t.Fatal(err)
+	}
+	if err = rt.commit(); err != nil {
+		t.Fatal(err)
+	}
+	rt.done()
+
+	if err = tas.purgeRateLimits(testCtx); err != nil {
+		t.Errorf("authServer.purgeRateLimits() error = %v", err)
+	}
+
+	m, err := mdb.Master(testCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var n int
+	if err = m.QueryRowContext(testCtx, "select count(*) from auth.rate_limits where key = 'purge';").Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Errorf("authServer.purgeRateLimits() left %d rows", n)
+	}
+
+	ectx, cancel := context.WithCancel(testCtx)
+	cancel()
+	if err = tas.purgeRateLimits(ectx); err == nil {
+		t.Errorf("authServer.purgeRateLimits() expected error")
+	}
+}
@@ -0,0 +1,393 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	auth "github.com/moapis/authenticator"
+	"github.com/moapis/authenticator/models"
+	"github.com/pascaldekloe/jwt"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TOTPConfig sets two-factor authentication using time-based one-time passwords (RFC 6238).
+type TOTPConfig struct {
+	Issuer        string        `json:"issuer"`         // Account issuer shown in authenticator apps. Defaults to the JWT issuer
+	Skew          int           `json:"skew"`           // Accepted time steps before and after the current one
+	RecoveryCodes int           `json:"recovery_codes"` // Amount of recovery codes generated on enrollment
+	PendingExpiry time.Duration `json:"pending_expiry"` // Validity of the token returned by AuthenticatePwUser, to complete the login
+}
+
+const (
+	// TOTPSecretLen is the amount of random bytes in a TOTP secret
+	TOTPSecretLen = 20
+	// TOTPPeriod is the duration of a TOTP time step
+	TOTPPeriod = 30 * time.Second
+	// TOTPDigits is the length of a TOTP code
+	TOTPDigits = 6
+	// RecoveryCodeLen is the amount of random bytes in a recovery code
+	RecoveryCodeLen = 5
+)
+
+const (
+	errMissingCode    = "Missing code"
+	errInvalidCode    = "Invalid code"
+	errMFAPending     = "Two-factor authentication pending"
+	errNotMFAToken    = "Not a two-factor authentication token"
+	errTOTPEnabled    = "Two-factor authentication already enabled"
+	errTOTPNotEnabled = "Two-factor authentication not enabled"
+	errPasswordToken  = "Password token can't be used as user token"
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// totpStep returns the time step number of t.
+func totpStep(t time.Time) int64 {
+	return t.Unix() / int64(TOTPPeriod/time.Second)
+}
+
+// totpCode returns the HOTP value (RFC 4226) of secret for step,
+// as a zero padded decimal string.
+func totpCode(secret []byte, step int64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(step))
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0xf
+	value := binary.BigEndian.Uint32(sum[offset:]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < TOTPDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", TOTPDigits, value%mod)
+}
+
+// validateTOTP returns the time step at which code is valid for secret,
+// within skew steps around t. Steps up to last are rejected, so that a code can't be reused.
+func validateTOTP(secret []byte, code string, t time.Time, skew int, last int64) (int64, bool) {
+	if len(code) != TOTPDigits {
+		return 0, false
+	}
+	now := totpStep(t)
+	for i := -int64(skew); i <= int64(skew); i++ {
+		step := now + i
+		if step <= last {
+			continue
+		}
+		if hmac.Equal([]byte(totpCode(secret, step)), []byte(code)) {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// totpURI returns the otpauth:// provisioning URI of secret, as understood by authenticator apps.
+func totpURI(issuer, account string, secret []byte) string {
+	v := url.Values{}
+	v.Set("secret", totpEncoding.EncodeToString(secret))
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(TOTPDigits))
+	v.Set("period", fmt.Sprint(int(TOTPPeriod/time.Second)))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+// normalizeRecoveryCode removes separators and case, as users might enter codes differently.
+func normalizeRecoveryCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', ' ':
+			return -1
+		}
+		return r
+	}, strings.ToUpper(code))
+}
+
+// recoveryCodeHash returns the stored hash of a recovery code.
+// The codes are random, so a fast hash suffices.
+func recoveryCodeHash(code string) []byte {
+	sum := sha256.Sum256([]byte(normalizeRecoveryCode(code)))
+	return sum[:]
+}
+
+// newRecoveryCode formats random bytes read as a recovery code, like "ABCD-EFGH".
+func newRecoveryCode(read func([]byte) (int, error)) (string, error) {
+	b := make([]byte, RecoveryCodeLen)
+	if _, err := read(b); err != nil {
+		return "", err
+	}
+	s := totpEncoding.EncodeToString(b)
+	return s[:len(s)/2] + "-" + s[len(s)/2:], nil
+}
+
+func (s *authServer) totpIssuer() string {
+	if s.conf.TOTP.Issuer != "" {
+		return s.conf.TOTP.Issuer
+	}
+	return s.conf.JWT.Issuer
+}
+
+func (s *authServer) mfaAudience() string {
+	return fmt.Sprintf("mfa@%s", s.conf.JWT.Issuer)
+}
+
+func (s *authServer) hasMFAAudience(audiences []string) bool {
+	b := s.mfaAudience()
+	for _, a := range audiences {
+		if a == b {
+			return true
+		}
+	}
+	return false
+}
+
+// checkUserJWT checks token like checkJWT,
+// but rejects tokens which are still pending two-factor authentication,
+// password registration and reset tokens, which skip two-factor authentication,
+// and OIDC access tokens, which were handed to third parties.
+func (rt *requestTx) checkUserJWT(token string, valid time.Time) (*jwt.Claims, error) {
+	claims, err := rt.checkJWT(token, valid)
+	if err != nil {
+		return nil, err
+	}
+	if rt.s.hasMFAAudience(claims.Audiences) {
+		rt.log.WithField("subject", claims.Subject).Warn(errMFAPending)
+		return nil, status.Error(codes.Unauthenticated, errMFAPending)
+	}
+	if rt.s.hasPasswordAudience(claims.Audiences) == nil {
+		rt.log.WithField("subject", claims.Subject).Warn(errPasswordToken)
+		return nil, status.Error(codes.Unauthenticated, errPasswordToken)
+	}
+	if rt.s.hasOIDCAudience(claims.Audiences) {
+		rt.log.WithField("subject", claims.Subject).Warn(errOIDCToken)
+		return nil, status.Error(codes.Unauthenticated, errOIDCToken)
+	}
+	return claims, nil
+}
+
+// mfaPendingReply returns a short-lived token, which can only be used for VerifyTOTP.
+func (rt *requestTx) mfaPendingReply(user *models.User, issued time.Time) (*auth.AuthReply, error) {
+	reply, err := rt.tokenReply(
+		user.Email,
+		issued,
+		issued.Add(rt.s.conf.TOTP.PendingExpiry),
+		map[string]interface{}{jwtUserID: user.ID},
+		rt.s.mfaAudience(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	reply.MfaRequired = true
+	return reply, nil
+}
+
+// findTOTP returns the TOTP secret of user, or nil if there is none.
+func (rt *requestTx) findTOTP(user *models.User, mods ...qm.QueryMod) (*models.TotpSecret, error) {
+	secret, err := user.TotpSecret(mods...).One(rt.ctx, rt.tx)
+	switch err {
+	case nil:
+		return secret, nil
+	case sql.ErrNoRows:
+		return nil, nil
+	default:
+		rt.log.WithError(err).Error("findTOTP")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+}
+
+// enabledTOTP returns the TOTP secret of user, or nil if two-factor authentication is not enabled.
+func (rt *requestTx) enabledTOTP(user *models.User, mods ...qm.QueryMod) (*models.TotpSecret, error) {
+	secret, err := rt.findTOTP(user, mods...)
+	if err != nil || secret == nil || !secret.EnabledAt.Valid {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// useTOTPCode checks code against secret and marks its time step as used.
+func (rt *requestTx) useTOTPCode(secret *models.TotpSecret, code string) (bool, error) {
+	step, ok := validateTOTP(secret.Secret, code, time.Now(), rt.s.conf.TOTP.Skew, secret.LastStep)
+	if !ok {
+		return false, nil
+	}
+	secret.LastStep = step
+	if _, err := secret.Update(rt.ctx, rt.tx, boil.Whitelist(models.TotpSecretColumns.LastStep, models.TotpSecretColumns.UpdatedAt)); err != nil {
+		rt.log.WithError(err).Error("useTOTPCode")
+		return false, status.Error(codes.Internal, errDB)
+	}
+	return true, nil
+}
+
+// useRecoveryCode deletes the recovery code of user, if it exists.
+func (rt *requestTx) useRecoveryCode(user *models.User, code string) (bool, error) {
+	ra, err := user.RecoveryCodes(
+		models.RecoveryCodeWhere.Hash.EQ(recoveryCodeHash(code)),
+	).DeleteAll(rt.ctx, rt.tx)
+	if err != nil {
+		rt.log.WithError(err).Error("useRecoveryCode")
+		return false, status.Error(codes.Internal, errDB)
+	}
+	if ra > 0 {
+		rt.log.WithField("event", "recovery_code.used").Warn("Recovery code used")
+	}
+	return ra > 0, nil
+}
+
+// checkMFACode accepts a TOTP code or a recovery code of user.
+// It returns an Unauthenticated error when neither matches.
+func (rt *requestTx) checkMFACode(user *models.User, secret *models.TotpSecret, code string) error {
+	if code == "" {
+		rt.log.Warn(errMissingCode)
+		return status.Error(codes.InvalidArgument, errMissingCode)
+	}
+
+	ok, err := rt.useTOTPCode(secret, code)
+	if err == nil && !ok {
+		ok, err = rt.useRecoveryCode(user, code)
+	}
+	if err != nil {
+		return err
+	}
+	if !ok {
+		rt.log.Warn(errInvalidCode)
+		return status.Error(codes.Unauthenticated, errInvalidCode)
+	}
+	return nil
+}
+
+// enrollTOTP returns the pending TOTP secret of user, or creates one.
+func (rt *requestTx) enrollTOTP(user *models.User, read func([]byte) (int, error)) (*auth.TOTPSecret, error) {
+	secret, err := rt.findTOTP(user)
+	if err != nil {
+		return nil, err
+	}
+	if secret != nil && secret.EnabledAt.Valid {
+		rt.log.Warn(errTOTPEnabled)
+		return nil, status.Error(codes.FailedPrecondition, errTOTPEnabled)
+	}
+
+	if secret == nil {
+		secret = &models.TotpSecret{Secret: make([]byte, TOTPSecretLen)}
+		if _, err = read(secret.Secret); err != nil {
+			rt.log.WithError(err).Error("Secret generation")
+			return nil, status.Error(codes.Internal, errFatal)
+		}
+		if err = user.SetTotpSecret(rt.ctx, rt.tx, true, secret); err != nil {
+			rt.log.WithError(err).Error("SetTotpSecret")
+			return nil, status.Error(codes.Internal, errDB)
+		}
+		rt.log.Debug("SetTotpSecret")
+	}
+
+	return &auth.TOTPSecret{
+		Secret: totpEncoding.EncodeToString(secret.Secret),
+		Uri:    totpURI(rt.s.totpIssuer(), user.Email, secret.Secret),
+	}, nil
+}
+
+// confirmTOTP enables the pending TOTP secret of user, if code is valid for it.
+// Previous recovery codes are replaced by new ones.
+func (rt *requestTx) confirmTOTP(user *models.User, code string, read func([]byte) (int, error)) (*auth.RecoveryCodes, error) {
+	secret, err := rt.findTOTP(user, qm.For("update"))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case secret == nil:
+		rt.log.Warn(errTOTPNotEnabled)
+		return nil, status.Error(codes.FailedPrecondition, errTOTPNotEnabled)
+	case secret.EnabledAt.Valid:
+		rt.log.Warn(errTOTPEnabled)
+		return nil, status.Error(codes.FailedPrecondition, errTOTPEnabled)
+	case code == "":
+		rt.log.Warn(errMissingCode)
+		return nil, status.Error(codes.InvalidArgument, errMissingCode)
+	}
+
+	step, ok := validateTOTP(secret.Secret, code, time.Now(), rt.s.conf.TOTP.Skew, secret.LastStep)
+	if !ok {
+		rt.log.Warn(errInvalidCode)
+		return nil, status.Error(codes.Unauthenticated, errInvalidCode)
+	}
+	secret.EnabledAt, secret.LastStep = null.TimeFrom(time.Now()), step
+	if _, err = secret.Update(rt.ctx, rt.tx, boil.Infer()); err != nil {
+		rt.log.WithError(err).Error("Enable TOTP")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	if _, err = user.RecoveryCodes().DeleteAll(rt.ctx, rt.tx); err != nil {
+		rt.log.WithError(err).Error("Delete recovery codes")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	rc := &auth.RecoveryCodes{Codes: make([]string, rt.s.conf.TOTP.RecoveryCodes)}
+	mcs := make([]*models.RecoveryCode, len(rc.Codes))
+	for i := range rc.Codes {
+		if rc.Codes[i], err = newRecoveryCode(read); err != nil {
+			rt.log.WithError(err).Error("Recovery code generation")
+			return nil, status.Error(codes.Internal, errFatal)
+		}
+		mcs[i] = &models.RecoveryCode{Hash: recoveryCodeHash(rc.Codes[i])}
+	}
+	if err = user.AddRecoveryCodes(rt.ctx, rt.tx, true, mcs...); err != nil {
+		rt.log.WithError(err).Error("AddRecoveryCodes")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	rt.log.WithFields(logrus.Fields{"event": "totp.enabled", "user": user.ID}).Info("TOTP enabled")
+	return rc, nil
+}
+
+// disableTOTP removes the TOTP secret and recovery codes of user, if code is valid.
+func (rt *requestTx) disableTOTP(user *models.User, code string) error {
+	secret, err := rt.enabledTOTP(user, qm.For("update"))
+	if err != nil {
+		return err
+	}
+	if secret == nil {
+		rt.log.Warn(errTOTPNotEnabled)
+		return status.Error(codes.FailedPrecondition, errTOTPNotEnabled)
+	}
+	if err = rt.checkMFACode(user, secret, code); err != nil {
+		return err
+	}
+
+	if _, err = secret.Delete(rt.ctx, rt.tx); err != nil {
+		rt.log.WithError(err).Error("Delete TOTP secret")
+		return status.Error(codes.Internal, errDB)
+	}
+	if _, err = user.RecoveryCodes().DeleteAll(rt.ctx, rt.tx); err != nil {
+		rt.log.WithError(err).Error("Delete recovery codes")
+		return status.Error(codes.Internal, errDB)
+	}
+
+	rt.log.WithFields(logrus.Fields{"event": "totp.disabled", "user": user.ID}).Warn("TOTP disabled")
+	return nil
+}
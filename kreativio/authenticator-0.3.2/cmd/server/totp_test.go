@@ -0,0 +1,227 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	auth "github.com/moapis/authenticator"
+	"github.com/moapis/authenticator/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RFC 6238, Appendix B. SHA1 test vectors, truncated to 6 digits.
+func Test_totpCode(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		if got := totpCode(secret, totpStep(time.Unix(tt.unix, 0))); got != tt.want {
+			t.Errorf("totpCode(%d) = %v, want %v", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func Test_validateTOTP(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	now := time.Unix(1111111111, 0)
+	step := totpStep(now)
+
+	tests := []struct {
+		name     string
+		code     string
+		skew     int
+		last     int64
+		wantStep int64
+		wantOK   bool
+	}{
+		{"Current", totpCode(secret, step), 0, 0, step, true},
+		{"Previous without skew", totpCode(secret, step-1), 0, 0, 0, false},
+		{"Previous with skew", totpCode(secret, step-1), 1, 0, step - 1, true},
+		{"Next with skew", totpCode(secret, step+1), 1, 0, step + 1, true},
+		{"Out of skew", totpCode(secret, step+2), 1, 0, 0, false},
+		{"Reused", totpCode(secret, step), 1, step, 0, false},
+		{"Wrong", "000000", 1, 0, 0, false},
+		{"Wrong length", "12345", 1, 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStep, gotOK := validateTOTP(secret, tt.code, now, tt.skew, tt.last)
+			if gotStep != tt.wantStep || gotOK != tt.wantOK {
+				t.Errorf("validateTOTP() = %v, %v, want %v, %v", gotStep, gotOK, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+func Test_totpURI(t *testing.T) {
+	got := totpURI("Example Shop", "foo@bar.com", []byte("12345678901234567890"))
+	u, err := url.Parse(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/Example Shop:foo@bar.com" {
+		t.Errorf("totpURI() = %v, wrong label", got)
+	}
+	q := u.Query()
+	if s := q.Get("secret"); s != "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" {
+		t.Errorf("totpURI() secret = %v", s)
+	}
+	if s := q.Get("issuer"); s != "Example Shop" {
+		t.Errorf("totpURI() issuer = %v", s)
+	}
+}
+
+func Test_recoveryCode(t *testing.T) {
+	code, err := newRecoveryCode(rand.Read)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(code) != 9 || code[4] != '-' {
+		t.Errorf("newRecoveryCode() = %v, want format XXXX-XXXX", code)
+	}
+	if !bytes.Equal(recoveryCodeHash(code), recoveryCodeHash(" "+code[:4]+code[5:])) {
+		t.Errorf("recoveryCodeHash() differs for unformatted code")
+	}
+
+	if _, err = newRecoveryCode(func([]byte) (int, error) { return 0, errors.New("spanac") }); err == nil {
+		t.Errorf("newRecoveryCode() expected error")
+	}
+}
+
+func Test_authServer_TOTP(t *testing.T) {
+	rt, err := tas.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := &models.User{Email: "totp@user.com", Name: "totpUser"}
+	if err = u.Insert(testCtx, rt.tx, boil.Infer()); err != nil {
+		rt.done()
+		t.Fatal(err)
+	}
+	if err = rt.setUserPassword(u, "totpUser", rand.Read); err != nil {
+		rt.done()
+		t.Fatal(err)
+	}
+	if err = rt.commit(); err != nil {
+		t.Fatal(err)
+	}
+	rt.done()
+
+	up := &auth.UserPassword{Email: u.Email, Password: "totpUser"}
+	reply, err := tas.AuthenticatePwUser(testCtx, up)
+	if err != nil || reply.GetMfaRequired() {
+		t.Fatalf("authServer.AuthenticatePwUser() = %v, %v, want user token", reply, err)
+	}
+	userToken := reply.GetJwt()
+
+	ts, err := tas.EnableTOTP(testCtx, &auth.AuthReply{Jwt: userToken})
+	if err != nil {
+		t.Fatalf("authServer.EnableTOTP() error = %v", err)
+	}
+	again, err := tas.EnableTOTP(testCtx, &auth.AuthReply{Jwt: userToken})
+	if err != nil || again.GetSecret() != ts.GetSecret() {
+		t.Errorf("authServer.EnableTOTP() = %v, %v, want %v", again, err, ts)
+	}
+	secret, err := totpEncoding.DecodeString(ts.GetSecret())
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := totpCode(secret, totpStep(time.Now()))
+
+	if _, err = tas.ConfirmTOTP(testCtx, &auth.TOTPCode{Jwt: userToken, Code: "spanac"}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("authServer.ConfirmTOTP() error = %v, wantCode %v", err, codes.Unauthenticated)
+	}
+	rc, err := tas.ConfirmTOTP(testCtx, &auth.TOTPCode{Jwt: userToken, Code: code})
+	if err != nil {
+		t.Fatalf("authServer.ConfirmTOTP() error = %v", err)
+	}
+	if len(rc.GetCodes()) != tas.conf.TOTP.RecoveryCodes {
+		t.Errorf("authServer.ConfirmTOTP() = %v, want %d codes", rc, tas.conf.TOTP.RecoveryCodes)
+	}
+	if _, err = tas.EnableTOTP(testCtx, &auth.AuthReply{Jwt: userToken}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("authServer.EnableTOTP() error = %v, wantCode %v", err, codes.FailedPrecondition)
+	}
+
+	reply, err = tas.AuthenticatePwUser(testCtx, up)
+	if err != nil || !reply.GetMfaRequired() {
+		t.Fatalf("authServer.AuthenticatePwUser() = %v, %v, want mfa token", reply, err)
+	}
+	mfaToken := reply.GetJwt()
+
+	if _, err = tas.RefreshToken(testCtx, &auth.AuthReply{Jwt: mfaToken}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("authServer.RefreshToken() error = %v, wantCode %v", err, codes.Unauthenticated)
+	}
+	if _, err = tas.EnableTOTP(testCtx, &auth.AuthReply{Jwt: mfaToken}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("authServer.EnableTOTP() error = %v, wantCode %v", err, codes.Unauthenticated)
+	}
+
+	rt, err = tas.newTx(testCtx, "testing", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resetReply, err := rt.authReply(u.Email, time.Now(), nil, tas.passwordAudience())
+	rt.done()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = tas.RefreshToken(testCtx, resetReply); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("authServer.RefreshToken() error = %v, wantCode %v", err, codes.Unauthenticated)
+	}
+
+	tests := []struct {
+		name     string
+		tc       *auth.TOTPCode
+		wantCode codes.Code
+	}{
+		{"User token", &auth.TOTPCode{Jwt: userToken, Code: rc.Codes[0]}, codes.Unauthenticated},
+		{"Missing code", &auth.TOTPCode{Jwt: mfaToken}, codes.InvalidArgument},
+		{"Reused TOTP code", &auth.TOTPCode{Jwt: mfaToken, Code: code}, codes.Unauthenticated},
+		{"Recovery code", &auth.TOTPCode{Jwt: mfaToken, Code: rc.Codes[0]}, codes.OK},
+		{"Reused recovery code", &auth.TOTPCode{Jwt: mfaToken, Code: rc.Codes[0]}, codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tas.VerifyTOTP(testCtx, tt.tc)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("authServer.VerifyTOTP() error = %v, wantCode %v", err, tt.wantCode)
+			}
+			if err == nil && (got.GetJwt() == "" || got.GetMfaRequired()) {
+				t.Errorf("authServer.VerifyTOTP() = %v, want user token", got)
+			}
+		})
+	}
+
+	if _, err = tas.DisableTOTP(testCtx, &auth.TOTPCode{Jwt: userToken, Code: "spanac"}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("authServer.DisableTOTP() error = %v, wantCode %v", err, codes.Unauthenticated)
+	}
+	if _, err = tas.DisableTOTP(testCtx, &auth.TOTPCode{Jwt: userToken, Code: rc.Codes[1]}); err != nil {
+		t.Errorf("authServer.DisableTOTP() error = %v", err)
+	}
+	if _, err = tas.DisableTOTP(testCtx, &auth.TOTPCode{Jwt: userToken, Code: rc.Codes[2]}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("authServer.DisableTOTP() error = %v, wantCode %v", err, codes.FailedPrecondition)
+	}
+
+	reply, err = tas.AuthenticatePwUser(testCtx, up)
+	if err != nil || reply.GetMfaRequired() {
+		t.Errorf("authServer.AuthenticatePwUser() = %v, %v, want user token", reply, err)
+	}
+}
@@ -101,32 +101,29 @@ const (
 	errToken       = "JWT error"
 	errCredentials = "Invalid credentials"
 
-	jwtUserID = "user_id"
-	jwtGroups = "groups"
+	jwtUserID      = "user_id"
+	jwtGroups      = "groups"
+	jwtPermissions = "permissions"
 )
 
 func (rt *requestTx) authReply(subject string, issued time.Time, set map[string]interface{}, audiences ...string) (*auth.AuthReply, error) {
-	prKey := rt.s.privateKey()
-	c := jwt.Claims{
-		KeyID: prKey.id,
+	return rt.tokenReply(subject, issued, issued.Add(rt.s.conf.JWT.Expiry), set, audiences...)
+}
+
+func (rt *requestTx) tokenReply(subject string, issued, expires time.Time, set map[string]interface{}, audiences ...string) (*auth.AuthReply, error) {
+	st, err := rt.signClaims(jwt.Claims{
 		Registered: jwt.Registered{
 			Issuer:    rt.s.conf.JWT.Issuer,
 			Subject:   subject,
-			Expires:   jwt.NewNumericTime(issued.Add(rt.s.conf.JWT.Expiry)),
+			Expires:   jwt.NewNumericTime(expires),
 			Audiences: audiences,
 			Issued:    jwt.NewNumericTime(issued),
 		},
 		Set: set,
-	}
-	rt.log = rt.log.WithField("claims", c)
-
-	token, err := c.EdDSASign(prKey.key)
+	})
 	if err != nil {
-		rt.log.WithError(err).Error("authReply")
-		return nil, status.Error(codes.Internal, errToken)
+		return nil, err
 	}
-	st := string(token)
-	rt.log.WithField("token", st).Debug("authReply")
 
 	if !rt.readOnly {
 		if err = rt.commit(); err != nil {
@@ -137,6 +134,22 @@ func (rt *requestTx) authReply(subject string, issued time.Time, set map[string]
 	return &auth.AuthReply{Jwt: st}, nil
 }
 
+// signClaims signs c with the current private key, which is set as KeyID.
+func (rt *requestTx) signClaims(c jwt.Claims) (string, error) {
+	prKey := rt.s.privateKey()
+	c.KeyID = prKey.id
+	rt.log = rt.log.WithField("claims", c)
+
+	token, err := c.EdDSASign(prKey.key)
+	if err != nil {
+		rt.log.WithError(err).Error("authReply")
+		return "", status.Error(codes.Internal, errToken)
+	}
+	st := string(token)
+	rt.log.WithField("token", st).Debug("authReply")
+	return st, nil
+}
+
 func (rt *requestTx) userAuthReply(user *models.User, issued time.Time) (*auth.AuthReply, error) {
 	rt.log = rt.log.WithField("user", user)
 	audiences, err := user.Audiences(qm.Select(models.AudienceColumns.Name)).All(rt.ctx, rt.tx)
@@ -146,7 +159,10 @@ func (rt *requestTx) userAuthReply(user *models.User, issued time.Time) (*auth.A
 	}
 	rt.log.WithField("audiences", audiences).Debug("userAuthReply")
 
-	groups, err := user.Groups(qm.Select(models.GroupColumns.Name)).All(rt.ctx, rt.tx)
+	groups, err := user.Groups(
+		qm.Select(models.GroupColumns.ID, models.GroupColumns.Name),
+		qm.Load(models.GroupRels.Permissions, qm.OrderBy(models.PermissionColumns.Name)),
+	).All(rt.ctx, rt.tx)
 	if err != nil {
 		rt.log.WithError(err).Error("userAuthReply")
 		return nil, status.Error(codes.Internal, errDB)
@@ -154,8 +170,20 @@ func (rt *requestTx) userAuthReply(user *models.User, issued time.Time) (*auth.A
 	rt.log.WithField("groups", groups).Debug("userAuthReply")
 
 	gns := make([]string, len(groups))
+	pns := []string{}
+	seen := make(map[string]bool)
 	for i, g := range groups {
 		gns[i] = g.Name
+		if g.R == nil {
+			continue
+		}
+		// Permissions of all the user's groups, without duplicates
+		for _, p := range g.R.Permissions {
+			if !seen[p.Name] {
+				seen[p.Name] = true
+				pns = append(pns, p.Name)
+			}
+		}
 	}
 
 	ans := make([]string, len(audiences))
@@ -167,8 +195,9 @@ func (rt *requestTx) userAuthReply(user *models.User, issued time.Time) (*auth.A
 		user.Email,
 		issued,
 		map[string]interface{}{
-			jwtUserID: user.ID,
-			jwtGroups: gns,
+			jwtUserID:      user.ID,
+			jwtGroups:      gns,
+			jwtPermissions: pns,
 		},
 		ans...,
 	)
@@ -331,6 +360,10 @@ func (rt *requestTx) authenticatePwUser(email, password string) (*models.User, e
 	if err != nil {
 		return nil, err
 	}
+	if err = checkLocked(user, time.Now()); err != nil {
+		rt.log.WithError(err).WithField("locked_until", user.LockedUntil.Time).Warn("checkLocked")
+		return nil, err
+	}
 	pwm, err := user.Password().One(rt.ctx, rt.tx)
 	if err != nil {
 		return nil, rt.dbAuthError("Get user password", "password", err)
@@ -339,7 +372,23 @@ func (rt *requestTx) authenticatePwUser(email, password string) (*models.User, e
 		return nil, err
 	}
 
-	if string(pwm.Hash) != string(argon2.IDKey([]byte(password), pwm.Salt, Argon2Time, Argon2Memory, Argon2Threads, Argon2KeyLen)) {
+	success := string(pwm.Hash) == string(argon2.IDKey([]byte(password), pwm.Salt, Argon2Time, Argon2Memory, Argon2Threads, Argon2KeyLen))
+	pending := false
+	if success {
+		// With two-factor authentication, the login is recorded by VerifyTOTP.
+		// Otherwise a known password would reset the failure count between code guesses.
+		secret, err := rt.enabledTOTP(user)
+		if err != nil {
+			return nil, err
+		}
+		pending = secret != nil
+	}
+	if !pending {
+		if err = rt.s.recordLogin(rt.ctx, user.ID, success); err != nil {
+			rt.log.WithError(err).Error("recordLogin")
+		}
+	}
+	if !success {
 		log.WithError(errors.New(errCredentials)).Warn("Password missmatch")
 		return nil, status.Error(codes.Unauthenticated, errCredentials)
 	}
@@ -387,7 +436,7 @@ func (rt *requestTx) getPubKey(kid int) (*auth.PublicKey, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &auth.PublicKey{Key: key}, nil
+	return &auth.PublicKey{Key: key, Kid: int32(kid)}, nil
 }
 
 type mailData struct {
@@ -397,14 +446,19 @@ type mailData struct {
 }
 
 func (rt *requestTx) sendMail(template string, data mailData) error {
+	return rt.mailUser(template, data.User, data.Subject, data)
+}
+
+// mailUser sends template, rendered with data, to user.
+func (rt *requestTx) mailUser(template string, user *models.User, subject string, data interface{}) error {
 	headers := []mailer.Header{
 		{Key: "from", Values: []string{rt.s.conf.Mail.From}},
-		{Key: "subject", Values: []string{data.Subject}},
-		{Key: "to", Values: []string{data.Email}},
+		{Key: "subject", Values: []string{subject}},
+		{Key: "to", Values: []string{user.Email}},
 	}
 	log := rt.log.WithFields(logrus.Fields{"headers": headers, "data": data})
 
-	if err := rt.s.mail.Send(headers, template, data, data.Email); err != nil {
+	if err := rt.s.mail.Send(headers, template, data, user.Email); err != nil {
 		log.WithError(err).Error("sendMail")
 		return status.Error(codes.Internal, "Mailer error")
 	}
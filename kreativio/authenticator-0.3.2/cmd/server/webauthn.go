@@ -0,0 +1,666 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	auth "github.com/moapis/authenticator"
+	"github.com/moapis/authenticator/models"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WebAuthnConfig sets the relying party for WebAuthn credentials (passkeys).
+type WebAuthnConfig struct {
+	RPID    string        `json:"rp_id"`   // Domain of the login pages, or a registrable suffix of it
+	RPName  string        `json:"rp_name"` // Name shown by browsers during registration
+	Origins []string      `json:"origins"` // Allowed origins of the login pages, like "https://login.example.com"
+	Timeout time.Duration `json:"timeout"` // Time to complete a registration or login
+	Purge   time.Duration `json:"purge"`   // Interval to purge expired challenges. Zero disables
+}
+
+const (
+	// WebAuthnChallengeLen is the amount of random bytes in a WebAuthn challenge
+	WebAuthnChallengeLen = 32
+	// WebAuthnNameLen is the maximum length of a credential name, in characters
+	WebAuthnNameLen = 64
+
+	// Ceremonies, as in the client data type
+	webauthnCreate = "webauthn.create"
+	webauthnGet    = "webauthn.get"
+
+	publicKeyType = "public-key"
+)
+
+// COSE algorithm identifiers
+const (
+	coseES256 = -7
+	coseEdDSA = -8
+	coseRS256 = -257
+)
+
+// COSE key types and curves
+const (
+	coseKeyOKP = 1
+	coseKeyEC2 = 2
+	coseKeyRSA = 3
+
+	coseCrvP256    = 1
+	coseCrvEd25519 = 6
+)
+
+// Authenticator data flags
+const (
+	authDataUserPresent  = 0x01
+	authDataUserVerified = 0x04
+	authDataAttested     = 0x40
+)
+
+const (
+	errMissingCredential = "Missing credential"
+	errWebAuthn          = "WebAuthn verification failed"
+	errWebAuthnChallenge = "WebAuthn challenge invalid or expired"
+	errCredentialExists  = "Credential already registered"
+	errCredentialName    = "Credential name too long"
+)
+
+var b64url = base64.RawURLEncoding
+
+// b64urlBytes unmarshals from a base64url JSON string, with or without padding.
+type b64urlBytes []byte
+
+func (b *b64urlBytes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	dec, err := b64url.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return err
+	}
+	*b = dec
+	return nil
+}
+
+// publicKeyCredential is the JSON encoding of a browser PublicKeyCredential.
+// Response holds the members of AuthenticatorAttestationResponse after registration,
+// and of AuthenticatorAssertionResponse after login.
+type publicKeyCredential struct {
+	RawID    b64urlBytes `json:"rawId"`
+	Type     string      `json:"type"`
+	Response struct {
+		ClientDataJSON    b64urlBytes `json:"clientDataJSON"`
+		AttestationObject b64urlBytes `json:"attestationObject"`
+		AuthenticatorData b64urlBytes `json:"authenticatorData"`
+		Signature         b64urlBytes `json:"signature"`
+		UserHandle        b64urlBytes `json:"userHandle"`
+	} `json:"response"`
+}
+
+func parseCredential(s string) (*publicKeyCredential, error) {
+	pkc := new(publicKeyCredential)
+	if err := json.Unmarshal([]byte(s), pkc); err != nil {
+		return nil, fmt.Errorf("credential: %w", err)
+	}
+	if pkc.Type != publicKeyType {
+		return nil, fmt.Errorf("credential: type %q", pkc.Type)
+	}
+	if len(pkc.RawID) == 0 || len(pkc.Response.ClientDataJSON) == 0 {
+		return nil, errors.New("credential: incomplete")
+	}
+	return pkc, nil
+}
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// checkClientData verifies the ceremony and origin of the client data,
+// and returns its challenge.
+func (c WebAuthnConfig) checkClientData(raw []byte, ceremony string) ([]byte, error) {
+	var cd clientData
+	if err := json.Unmarshal(raw, &cd); err != nil {
+		return nil, fmt.Errorf("client data: %w", err)
+	}
+	if cd.Type != ceremony {
+		return nil, fmt.Errorf("client data: type %q, want %q", cd.Type, ceremony)
+	}
+	allowed := false
+	for _, o := range c.Origins {
+		if o == cd.Origin {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return nil, fmt.Errorf("client data: origin %q not allowed", cd.Origin)
+	}
+	challenge, err := b64url.DecodeString(strings.TrimRight(cd.Challenge, "="))
+	if err != nil {
+		return nil, fmt.Errorf("client data challenge: %w", err)
+	}
+	return challenge, nil
+}
+
+type authenticatorData struct {
+	flags        byte
+	signCount    uint32
+	credentialID []byte
+	publicKey    []byte // COSE encoded, only with attested credential data
+}
+
+// checkAuthenticatorData parses raw authenticator data,
+// and verifies its relying party, user presence and user verification.
+func (c WebAuthnConfig) checkAuthenticatorData(raw []byte) (*authenticatorData, error) {
+	if len(raw) < 37 {
+		return nil, errors.New("authenticator data: too short")
+	}
+	rpIDHash := sha256.Sum256([]byte(c.RPID))
+	if !bytes.Equal(raw[:32], rpIDHash[:]) {
+		return nil, errors.New("authenticator data: relying party ID mismatch")
+	}
+	ad := &authenticatorData{
+		flags:     raw[32],
+		signCount: binary.BigEndian.Uint32(raw[33:37]),
+	}
+	if ad.flags&authDataUserPresent == 0 {
+		return nil, errors.New("authenticator data: user not present")
+	}
+	if ad.flags&authDataUserVerified == 0 {
+		return nil, errors.New("authenticator data: user not verified")
+	}
+	if ad.flags&authDataAttested == 0 {
+		return ad, nil
+	}
+
+	// AAGUID (16), credential ID length (2), credential ID, COSE key
+	rest := raw[37:]
+	if len(rest) < 18 {
+		return nil, errors.New("authenticator data: attested credential data too short")
+	}
+	n := int(binary.BigEndian.Uint16(rest[16:18]))
+	rest = rest[18:]
+	if len(rest) < n {
+		return nil, errors.New("authenticator data: credential ID too short")
+	}
+	ad.credentialID, rest = rest[:n], rest[n:]
+
+	_, ext, err := cborDecode(rest)
+	if err != nil {
+		return nil, fmt.Errorf("authenticator data public key: %w", err)
+	}
+	ad.publicKey = rest[:len(rest)-len(ext)]
+	return ad, nil
+}
+
+// attestedAuthData returns the authenticator data from a CBOR attestation object.
+// The attestation statement is not verified, as "none" attestation is requested.
+func attestedAuthData(raw []byte) ([]byte, error) {
+	v, _, err := cborDecode(raw)
+	if err != nil {
+		return nil, fmt.Errorf("attestation object: %w", err)
+	}
+	m, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil, errors.New("attestation object: not a map")
+	}
+	ad, ok := m["authData"].([]byte)
+	if !ok {
+		return nil, errors.New("attestation object: missing authData")
+	}
+	return ad, nil
+}
+
+// attestedCredential verifies the attestation response of pkc,
+// and returns the credential to be stored.
+func (c WebAuthnConfig) attestedCredential(pkc *publicKeyCredential) (*models.WebauthnCredential, error) {
+	raw, err := attestedAuthData(pkc.Response.AttestationObject)
+	if err != nil {
+		return nil, err
+	}
+	ad, err := c.checkAuthenticatorData(raw)
+	if err != nil {
+		return nil, err
+	}
+	if ad.publicKey == nil {
+		return nil, errors.New("authenticator data: missing attested credential data")
+	}
+	if !bytes.Equal(ad.credentialID, pkc.RawID) {
+		return nil, errors.New("authenticator data: credential ID mismatch")
+	}
+	if _, err = parseCOSEKey(ad.publicKey); err != nil {
+		return nil, err
+	}
+	return &models.WebauthnCredential{
+		CredentialID: ad.credentialID,
+		PublicKey:    ad.publicKey,
+		SignCount:    int64(ad.signCount),
+	}, nil
+}
+
+// parseCOSEKey returns the public key of a COSE encoded ES256, EdDSA or RS256 key.
+func parseCOSEKey(raw []byte) (crypto.PublicKey, error) {
+	v, _, err := cborDecode(raw)
+	if err != nil {
+		return nil, fmt.Errorf("COSE key: %w", err)
+	}
+	m, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil, errors.New("COSE key: not a map")
+	}
+	kty, _ := m[int64(1)].(int64)
+	alg, _ := m[int64(3)].(int64)
+	crv, _ := m[int64(-1)].(int64)
+	p1, _ := m[int64(-1)].([]byte) // RSA modulus
+	p2, _ := m[int64(-2)].([]byte) // EC2 or OKP x, RSA exponent
+	p3, _ := m[int64(-3)].([]byte) // EC2 y
+
+	switch {
+	case kty == coseKeyEC2 && alg == coseES256 && crv == coseCrvP256:
+		pub := &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(p2),
+			Y:     new(big.Int).SetBytes(p3),
+		}
+		if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, errors.New("COSE key: point not on curve")
+		}
+		return pub, nil
+
+	case kty == coseKeyOKP && alg == coseEdDSA && crv == coseCrvEd25519:
+		if len(p2) != ed25519.PublicKeySize {
+			return nil, errors.New("COSE key: invalid Ed25519 key size")
+		}
+		return ed25519.PublicKey(p2), nil
+
+	case kty == coseKeyRSA && alg == coseRS256:
+		e := new(big.Int).SetBytes(p2)
+		if len(p1) < 256 || !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return nil, errors.New("COSE key: invalid RSA key")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(p1), E: int(e.Int64())}, nil
+	}
+	return nil, fmt.Errorf("COSE key: unsupported kty %d alg %d", kty, alg)
+}
+
+// verifyAssertion verifies sig over the authenticator data and client data hash,
+// using a COSE encoded public key.
+func verifyAssertion(coseKey, authData, clientDataJSON, sig []byte) error {
+	pub, err := parseCOSEKey(coseKey)
+	if err != nil {
+		return err
+	}
+	cdHash := sha256.Sum256(clientDataJSON)
+	signed := append(append([]byte(nil), authData...), cdHash[:]...)
+
+	var ok bool
+	switch key := pub.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(signed)
+		ok = ecdsa.VerifyASN1(key, digest[:], sig)
+	case ed25519.PublicKey:
+		ok = ed25519.Verify(key, signed, sig)
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(signed)
+		ok = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig) == nil
+	}
+	if !ok {
+		return errors.New("assertion: invalid signature")
+	}
+	return nil
+}
+
+// webauthnUserHandle is the WebAuthn user ID of a user.
+func webauthnUserHandle(userID int) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(userID))
+	return b
+}
+
+type credentialDescriptor struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+type creationOptions struct {
+	Challenge string `json:"challenge"`
+	RP        struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"rp"`
+	User struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	} `json:"user"`
+	PubKeyCredParams []struct {
+		Type string `json:"type"`
+		Alg  int    `json:"alg"`
+	} `json:"pubKeyCredParams"`
+	Timeout                int64                  `json:"timeout"`
+	ExcludeCredentials     []credentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection struct {
+		ResidentKey      string `json:"residentKey"`
+		UserVerification string `json:"userVerification"`
+	} `json:"authenticatorSelection"`
+	Attestation string `json:"attestation"`
+}
+
+type requestOptions struct {
+	Challenge        string                 `json:"challenge"`
+	Timeout          int64                  `json:"timeout"`
+	RPID             string                 `json:"rpId"`
+	AllowCredentials []credentialDescriptor `json:"allowCredentials,omitempty"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+func credentialDescriptors(creds models.WebauthnCredentialSlice) []credentialDescriptor {
+	cds := make([]credentialDescriptor, len(creds))
+	for i, c := range creds {
+		cds[i] = credentialDescriptor{publicKeyType, b64url.EncodeToString(c.CredentialID)}
+	}
+	return cds
+}
+
+func (rt *requestTx) webauthnOptions(options interface{}) (*auth.WebAuthnOptions, error) {
+	js, err := json.Marshal(options)
+	if err != nil {
+		rt.log.WithError(err).Error("webauthnOptions")
+		return nil, status.Error(codes.Internal, errFatal)
+	}
+	return &auth.WebAuthnOptions{Options: string(js)}, nil
+}
+
+// newChallenge stores a random challenge for ceremony,
+// optionally bound to a user.
+func (rt *requestTx) newChallenge(ceremony string, userID null.Int, read func([]byte) (int, error)) ([]byte, error) {
+	ch := &models.WebauthnChallenge{
+		ExpiresAt: time.Now().Add(rt.s.conf.WebAuthn.Timeout),
+		Challenge: make([]byte, WebAuthnChallengeLen),
+		Ceremony:  ceremony,
+		UserID:    userID,
+	}
+	if _, err := read(ch.Challenge); err != nil {
+		rt.log.WithError(err).Error("Challenge generation")
+		return nil, status.Error(codes.Internal, errFatal)
+	}
+	if err := ch.Insert(rt.ctx, rt.tx, boil.Infer()); err != nil {
+		rt.log.WithError(err).Error("Insert challenge")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	return ch.Challenge, nil
+}
+
+// useChallenge verifies the client data of ceremony and deletes its challenge,
+// so that it can't be used again.
+func (rt *requestTx) useChallenge(clientDataJSON []byte, ceremony string) (*models.WebauthnChallenge, error) {
+	challenge, err := rt.s.conf.WebAuthn.checkClientData(clientDataJSON, ceremony)
+	if err != nil {
+		rt.log.WithError(err).Warn("useChallenge")
+		return nil, status.Error(codes.Unauthenticated, errWebAuthn)
+	}
+
+	ch, err := models.WebauthnChallenges(
+		models.WebauthnChallengeWhere.Challenge.EQ(challenge),
+		models.WebauthnChallengeWhere.Ceremony.EQ(ceremony),
+		models.WebauthnChallengeWhere.ExpiresAt.GT(time.Now()),
+		qm.For("update"),
+	).One(rt.ctx, rt.tx)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		rt.log.WithError(err).Warn("useChallenge")
+		return nil, status.Error(codes.Unauthenticated, errWebAuthnChallenge)
+	default:
+		rt.log.WithError(err).Error("useChallenge")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	if _, err = ch.Delete(rt.ctx, rt.tx); err != nil {
+		rt.log.WithError(err).Error("Delete challenge")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	return ch, nil
+}
+
+// beginRegistration returns the options to create a new credential for user.
+// Credentials already registered by the user are excluded.
+func (rt *requestTx) beginRegistration(user *models.User, read func([]byte) (int, error)) (*auth.WebAuthnOptions, error) {
+	creds, err := user.WebauthnCredentials(qm.Select(models.WebauthnCredentialColumns.CredentialID)).All(rt.ctx, rt.tx)
+	if err != nil {
+		rt.log.WithError(err).Error("beginRegistration")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	challenge, err := rt.newChallenge(webauthnCreate, null.IntFrom(user.ID), read)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := rt.s.conf.WebAuthn
+	opts := new(creationOptions)
+	opts.Challenge = b64url.EncodeToString(challenge)
+	opts.RP.ID, opts.RP.Name = conf.RPID, conf.RPName
+	opts.User.ID = b64url.EncodeToString(webauthnUserHandle(user.ID))
+	opts.User.Name, opts.User.DisplayName = user.Email, user.Name
+	if opts.User.DisplayName == "" {
+		opts.User.DisplayName = user.Email
+	}
+	for _, alg := range []int{coseES256, coseEdDSA, coseRS256} {
+		opts.PubKeyCredParams = append(opts.PubKeyCredParams, struct {
+			Type string `json:"type"`
+			Alg  int    `json:"alg"`
+		}{publicKeyType, alg})
+	}
+	opts.Timeout = conf.Timeout.Milliseconds()
+	opts.ExcludeCredentials = credentialDescriptors(creds)
+	opts.AuthenticatorSelection.ResidentKey = "preferred"
+	opts.AuthenticatorSelection.UserVerification = "required"
+	opts.Attestation = "none"
+
+	return rt.webauthnOptions(opts)
+}
+
+// finishRegistration verifies a credential created for user, and stores it.
+func (rt *requestTx) finishRegistration(user *models.User, credential, name string) error {
+	if credential == "" {
+		rt.log.Warn(errMissingCredential)
+		return status.Error(codes.InvalidArgument, errMissingCredential)
+	}
+	if utf8.RuneCountInString(name) > WebAuthnNameLen {
+		rt.log.WithField("name", name).Warn(errCredentialName)
+		return status.Error(codes.InvalidArgument, errCredentialName)
+	}
+	pkc, err := parseCredential(credential)
+	if err != nil {
+		rt.log.WithError(err).Warn("finishRegistration")
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ch, err := rt.useChallenge(pkc.Response.ClientDataJSON, webauthnCreate)
+	if err != nil {
+		return err
+	}
+	if ch.UserID.Int != user.ID {
+		rt.log.WithField("challenge_user", ch.UserID.Int).Warn("Challenge of another user")
+		return status.Error(codes.Unauthenticated, errWebAuthnChallenge)
+	}
+
+	cred, err := rt.s.conf.WebAuthn.attestedCredential(pkc)
+	if err != nil {
+		rt.log.WithError(err).Warn("finishRegistration")
+		return status.Error(codes.Unauthenticated, errWebAuthn)
+	}
+	cred.Name = name
+	return rt.insertCredential(user, cred)
+}
+
+func (rt *requestTx) insertCredential(user *models.User, cred *models.WebauthnCredential) error {
+	exists, err := models.WebauthnCredentials(
+		models.WebauthnCredentialWhere.CredentialID.EQ(cred.CredentialID),
+	).Exists(rt.ctx, rt.tx)
+	if err != nil {
+		rt.log.WithError(err).Error("insertCredential")
+		return status.Error(codes.Internal, errDB)
+	}
+	if exists {
+		rt.log.Warn(errCredentialExists)
+		return status.Error(codes.AlreadyExists, errCredentialExists)
+	}
+
+	if err = user.AddWebauthnCredentials(rt.ctx, rt.tx, true, cred); err != nil {
+		rt.log.WithError(err).Error("AddWebauthnCredentials")
+		return status.Error(codes.Internal, errDB)
+	}
+	rt.log.WithFields(logrus.Fields{"event": "webauthn.registered", "user": user.ID, "credential": cred.ID}).Info("WebAuthn credential registered")
+	return nil
+}
+
+// beginLogin returns the options to get an assertion.
+// If email belongs to a user, the login is bound to the credentials of that user.
+// Otherwise any discoverable credential can be used, without revealing whether the user exists.
+func (rt *requestTx) beginLogin(email string, read func([]byte) (int, error)) (*auth.WebAuthnOptions, error) {
+	var (
+		userID null.Int
+		creds  models.WebauthnCredentialSlice
+	)
+	if email != "" {
+		user, err := models.Users(models.UserWhere.Email.EQ(email)).One(rt.ctx, rt.tx)
+		switch err {
+		case nil:
+			userID = null.IntFrom(user.ID)
+			if creds, err = user.WebauthnCredentials(qm.Select(models.WebauthnCredentialColumns.CredentialID)).All(rt.ctx, rt.tx); err != nil {
+				rt.log.WithError(err).Error("beginLogin")
+				return nil, status.Error(codes.Internal, errDB)
+			}
+		case sql.ErrNoRows:
+			rt.log.WithField("email", email).Debug("beginLogin: user not found")
+		default:
+			rt.log.WithError(err).Error("beginLogin")
+			return nil, status.Error(codes.Internal, errDB)
+		}
+	}
+
+	challenge, err := rt.newChallenge(webauthnGet, userID, read)
+	if err != nil {
+		return nil, err
+	}
+
+	return rt.webauthnOptions(&requestOptions{
+		Challenge:        b64url.EncodeToString(challenge),
+		Timeout:          rt.s.conf.WebAuthn.Timeout.Milliseconds(),
+		RPID:             rt.s.conf.WebAuthn.RPID,
+		AllowCredentials: credentialDescriptors(creds),
+		UserVerification: "required",
+	})
+}
+
+// finishLogin verifies an assertion and returns the user of the credential.
+func (rt *requestTx) finishLogin(credential string) (*models.User, error) {
+	if credential == "" {
+		rt.log.Warn(errMissingCredential)
+		return nil, status.Error(codes.InvalidArgument, errMissingCredential)
+	}
+	pkc, err := parseCredential(credential)
+	if err != nil {
+		rt.log.WithError(err).Warn("finishLogin")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ch, err := rt.useChallenge(pkc.Response.ClientDataJSON, webauthnGet)
+	if err != nil {
+		return nil, err
+	}
+
+	cred, err := models.WebauthnCredentials(
+		models.WebauthnCredentialWhere.CredentialID.EQ([]byte(pkc.RawID)),
+		qm.Load(models.WebauthnCredentialRels.User),
+		qm.For("update"),
+	).One(rt.ctx, rt.tx)
+	if err != nil {
+		return nil, rt.dbAuthError("Find credential", "credential", err)
+	}
+	rt.log = rt.log.WithFields(logrus.Fields{"credential": cred.ID, "user": cred.UserID})
+
+	if err = rt.checkAssertion(pkc, ch, cred); err != nil {
+		rt.log.WithError(err).Warn("finishLogin")
+		return nil, status.Error(codes.Unauthenticated, errWebAuthn)
+	}
+	if _, err = cred.Update(rt.ctx, rt.tx, boil.Whitelist(
+		models.WebauthnCredentialColumns.SignCount,
+		models.WebauthnCredentialColumns.LastUsedAt,
+		models.WebauthnCredentialColumns.UpdatedAt,
+	)); err != nil {
+		rt.log.WithError(err).Error("Update credential")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	return cred.R.User, nil
+}
+
+// checkAssertion verifies the assertion in pkc for cred, and updates its sign count.
+func (rt *requestTx) checkAssertion(pkc *publicKeyCredential, ch *models.WebauthnChallenge, cred *models.WebauthnCredential) error {
+	if ch.UserID.Valid && ch.UserID.Int != cred.UserID {
+		return errors.New("credential of another user")
+	}
+	if uh := pkc.Response.UserHandle; len(uh) > 0 && !bytes.Equal(uh, webauthnUserHandle(cred.UserID)) {
+		return errors.New("user handle mismatch")
+	}
+	ad, err := rt.s.conf.WebAuthn.checkAuthenticatorData(pkc.Response.AuthenticatorData)
+	if err != nil {
+		return err
+	}
+	if err = verifyAssertion(cred.PublicKey, pkc.Response.AuthenticatorData, pkc.Response.ClientDataJSON, pkc.Response.Signature); err != nil {
+		return err
+	}
+	// A sign count which doesn't increase indicates a cloned authenticator.
+	// Authenticators without counter always report zero.
+	if (ad.signCount != 0 || cred.SignCount != 0) && int64(ad.signCount) <= cred.SignCount {
+		return fmt.Errorf("sign count %d not above %d", ad.signCount, cred.SignCount)
+	}
+	cred.SignCount, cred.LastUsedAt = int64(ad.signCount), null.TimeFrom(time.Now())
+	return nil
+}
+
+// purgeWebAuthnChallenges deletes expired challenges.
+func (s *authServer) purgeWebAuthnChallenges(ctx context.Context) error {
+	rt, err := s.newTx(ctx, "purgeWebAuthnChallenges", false)
+	if err != nil {
+		return err
+	}
+	defer rt.done()
+
+	ra, err := models.WebauthnChallenges(
+		models.WebauthnChallengeWhere.ExpiresAt.LT(time.Now()),
+	).DeleteAll(rt.ctx, rt.tx)
+	if err != nil {
+		rt.log.WithError(err).Error("purgeWebAuthnChallenges")
+		return status.Error(codes.Internal, errDB)
+	}
+	rt.log.WithField("ra", ra).Debug("purgeWebAuthnChallenges")
+	return rt.commit()
+}
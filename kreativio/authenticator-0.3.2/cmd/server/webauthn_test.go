@@ -0,0 +1,430 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	auth "github.com/moapis/authenticator"
+	"github.com/moapis/authenticator/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testWebAuthn = WebAuthnConfig{
+	RPID:    "localhost",
+	RPName:  "Testing",
+	Origins: []string{"http://localhost:1235"},
+	Timeout: time.Minute,
+}
+
+// testAuthenticator is a software authenticator with an ES256 key.
+type testAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	userHandle   []byte
+	signCount    uint32
+}
+
+func newTestAuthenticator(t *testing.T, userHandle []byte) *testAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := make([]byte, 16)
+	if _, err = rand.Read(id); err != nil {
+		t.Fatal(err)
+	}
+	return &testAuthenticator{key: key, credentialID: id, userHandle: userHandle}
+}
+
+func (a *testAuthenticator) coseKey() []byte {
+	return cborEncode(map[interface{}]interface{}{
+		int64(1):  int64(coseKeyEC2),
+		int64(3):  int64(coseES256),
+		int64(-1): int64(coseCrvP256),
+		int64(-2): a.key.X.FillBytes(make([]byte, 32)),
+		int64(-3): a.key.Y.FillBytes(make([]byte, 32)),
+	})
+}
+
+func (a *testAuthenticator) authData(rpID string, flags byte, attested bool) []byte {
+	h := sha256.Sum256([]byte(rpID))
+	b := append(h[:], flags, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(b[33:], a.signCount)
+	if attested {
+		b = append(b, make([]byte, 16)...) // AAGUID
+		b = append(b, byte(len(a.credentialID)>>8), byte(len(a.credentialID)))
+		b = append(b, a.credentialID...)
+		b = append(b, a.coseKey()...)
+	}
+	return b
+}
+
+func testClientData(ceremony string, challenge []byte, origin string) []byte {
+	cd, _ := json.Marshal(clientData{
+		Type:      ceremony,
+		Challenge: b64url.EncodeToString(challenge),
+		Origin:    origin,
+	})
+	return cd
+}
+
+func (a *testAuthenticator) credential(response map[string][]byte) string {
+	r := make(map[string]string, len(response))
+	for k, v := range response {
+		r[k] = b64url.EncodeToString(v)
+	}
+	js, _ := json.Marshal(map[string]interface{}{
+		"id":       b64url.EncodeToString(a.credentialID),
+		"rawId":    b64url.EncodeToString(a.credentialID),
+		"type":     publicKeyType,
+		"response": r,
+	})
+	return string(js)
+}
+
+// create returns the JSON encoded credential of a registration.
+func (a *testAuthenticator) create(challenge []byte, origin string) string {
+	return a.credential(map[string][]byte{
+		"clientDataJSON": testClientData(webauthnCreate, challenge, origin),
+		"attestationObject": cborEncode(map[interface{}]interface{}{
+			"fmt":      "none",
+			"attStmt":  map[interface{}]interface{}{},
+			"authData": a.authData(testWebAuthn.RPID, authDataUserPresent|authDataUserVerified|authDataAttested, true),
+		}),
+	})
+}
+
+// get returns the JSON encoded credential of a login, with an incremented sign count.
+func (a *testAuthenticator) get(t *testing.T, challenge []byte, origin string) string {
+	a.signCount++
+	cd := testClientData(webauthnGet, challenge, origin)
+	ad := a.authData(testWebAuthn.RPID, authDataUserPresent|authDataUserVerified, false)
+	cdHash := sha256.Sum256(cd)
+	digest := sha256.Sum256(append(append([]byte(nil), ad...), cdHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a.credential(map[string][]byte{
+		"clientDataJSON":    cd,
+		"authenticatorData": ad,
+		"signature":         sig,
+		"userHandle":        a.userHandle,
+	})
+}
+
+func Test_parseCredential(t *testing.T) {
+	a := newTestAuthenticator(t, nil)
+	tests := []struct {
+		name    string
+		in      string
+		wantErr bool
+	}{
+		{"Valid", a.create([]byte("challenge"), "http://localhost:1235"), false},
+		{"Malformed", "spanac", true},
+		{"Bad base64", `{"rawId":"!!","type":"public-key"}`, true},
+		{"Wrong type", `{"rawId":"AQ","type":"password","response":{"clientDataJSON":"AQ"}}`, true},
+		{"Incomplete", `{"rawId":"AQ","type":"public-key"}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseCredential(tt.in); (err != nil) != tt.wantErr {
+				t.Errorf("parseCredential() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebAuthnConfig_checkClientData(t *testing.T) {
+	challenge := []byte("challenge")
+	tests := []struct {
+		name    string
+		in      []byte
+		wantErr bool
+	}{
+		{"Valid", testClientData(webauthnGet, challenge, "http://localhost:1235"), false},
+		{"Malformed", []byte("spanac"), true},
+		{"Ceremony", testClientData(webauthnCreate, challenge, "http://localhost:1235"), true},
+		{"Origin", testClientData(webauthnGet, challenge, "https://evil.com"), true},
+		{"Challenge", []byte(`{"type":"webauthn.get","challenge":"!!","origin":"http://localhost:1235"}`), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testWebAuthn.checkClientData(tt.in, webauthnGet)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WebAuthnConfig.checkClientData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && string(got) != string(challenge) {
+				t.Errorf("WebAuthnConfig.checkClientData() = %s, want %s", got, challenge)
+			}
+		})
+	}
+}
+
+func TestWebAuthnConfig_checkAuthenticatorData(t *testing.T) {
+	a := newTestAuthenticator(t, nil)
+	a.signCount = 3
+	valid := a.authData(testWebAuthn.RPID, authDataUserPresent|authDataUserVerified|authDataAttested, true)
+
+	got, err := testWebAuthn.checkAuthenticatorData(valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.signCount != 3 || string(got.credentialID) != string(a.credentialID) || string(got.publicKey) != string(a.coseKey()) {
+		t.Errorf("WebAuthnConfig.checkAuthenticatorData() = %v", got)
+	}
+
+	tests := []struct {
+		name string
+		in   []byte
+	}{
+		{"Too short", valid[:36]},
+		{"RP ID", a.authData("evil.com", authDataUserPresent|authDataUserVerified, false)},
+		{"User not present", a.authData(testWebAuthn.RPID, authDataUserVerified, false)},
+		{"User not verified", a.authData(testWebAuthn.RPID, authDataUserPresent, false)},
+		{"Truncated attestation", valid[:50]},
+		{"Truncated credential ID", valid[:60]},
+		{"Truncated key", valid[:len(valid)-1]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := testWebAuthn.checkAuthenticatorData(tt.in); err == nil {
+				t.Errorf("WebAuthnConfig.checkAuthenticatorData() expected error")
+			}
+		})
+	}
+}
+
+func Test_parseCOSEKey(t *testing.T) {
+	a := newTestAuthenticator(t, nil)
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		in      []byte
+		wantErr bool
+	}{
+		{"ES256", a.coseKey(), false},
+		{"EdDSA", cborEncode(map[interface{}]interface{}{
+			int64(1): int64(coseKeyOKP), int64(3): int64(coseEdDSA), int64(-1): int64(coseCrvEd25519), int64(-2): []byte(edPub),
+		}), false},
+		{"Point not on curve", cborEncode(map[interface{}]interface{}{
+			int64(1): int64(coseKeyEC2), int64(3): int64(coseES256), int64(-1): int64(coseCrvP256), int64(-2): []byte{1}, int64(-3): []byte{2},
+		}), true},
+		{"Ed25519 size", cborEncode(map[interface{}]interface{}{
+			int64(1): int64(coseKeyOKP), int64(3): int64(coseEdDSA), int64(-1): int64(coseCrvEd25519), int64(-2): []byte{1},
+		}), true},
+		{"Small RSA", cborEncode(map[interface{}]interface{}{
+			int64(1): int64(coseKeyRSA), int64(3): int64(coseRS256), int64(-1): []byte{1}, int64(-2): []byte{1, 0, 1},
+		}), true},
+		{"Unsupported", cborEncode(map[interface{}]interface{}{int64(1): int64(4)}), true},
+		{"Not a map", cborEncode("spanac"), true},
+		{"Malformed", []byte{0xff}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseCOSEKey(tt.in); (err != nil) != tt.wantErr {
+				t.Errorf("parseCOSEKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_verifyAssertion(t *testing.T) {
+	a := newTestAuthenticator(t, nil)
+	var pkc publicKeyCredential
+	if err := json.Unmarshal([]byte(a.get(t, []byte("challenge"), "http://localhost:1235")), &pkc); err != nil {
+		t.Fatal(err)
+	}
+	r := pkc.Response
+
+	if err := verifyAssertion(a.coseKey(), r.AuthenticatorData, r.ClientDataJSON, r.Signature); err != nil {
+		t.Errorf("verifyAssertion() error = %v", err)
+	}
+	if err := verifyAssertion(a.coseKey(), r.AuthenticatorData, []byte("spanac"), r.Signature); err == nil {
+		t.Errorf("verifyAssertion() expected error")
+	}
+
+	edPub, edPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edKey := cborEncode(map[interface{}]interface{}{
+		int64(1): int64(coseKeyOKP), int64(3): int64(coseEdDSA), int64(-1): int64(coseCrvEd25519), int64(-2): []byte(edPub),
+	})
+	cdHash := sha256.Sum256(r.ClientDataJSON)
+	sig := ed25519.Sign(edPriv, append(append([]byte(nil), r.AuthenticatorData...), cdHash[:]...))
+	if err := verifyAssertion(edKey, r.AuthenticatorData, r.ClientDataJSON, sig); err != nil {
+		t.Errorf("verifyAssertion() error = %v", err)
+	}
+}
+
+func Test_attestedAuthData(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []byte
+		wantErr bool
+	}{
+		{"Valid", cborEncode(map[interface{}]interface{}{"fmt": "none", "authData": []byte{1}}), false},
+		{"Missing", cborEncode(map[interface{}]interface{}{"fmt": "none"}), true},
+		{"Not a map", cborEncode([]interface{}{}), true},
+		{"Malformed", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := attestedAuthData(tt.in); (err != nil) != tt.wantErr {
+				t.Errorf("attestedAuthData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// optionsChallenge returns the challenge from WebAuthn options
+func optionsChallenge(t *testing.T, opts *auth.WebAuthnOptions) []byte {
+	var o struct {
+		Challenge        string                 `json:"challenge"`
+		AllowCredentials []credentialDescriptor `json:"allowCredentials"`
+	}
+	if err := json.Unmarshal([]byte(opts.GetOptions()), &o); err != nil {
+		t.Fatal(err)
+	}
+	challenge, err := b64url.DecodeString(o.Challenge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return challenge
+}
+
+func Test_authServer_WebAuthn(t *testing.T) {
+	rt, err := tas.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := &models.User{Email: "webauthn@user.com", Name: "webauthnUser"}
+	if err = u.Insert(testCtx, rt.tx, boil.Infer()); err != nil {
+		rt.done()
+		t.Fatal(err)
+	}
+	if err = rt.commit(); err != nil {
+		t.Fatal(err)
+	}
+	rt.done()
+
+	reply, err := tas.PublicUserToken(testCtx, &auth.PublicUser{Uuid: "spanac"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = tas.BeginWebAuthnRegistration(testCtx, &auth.AuthReply{Jwt: reply.GetJwt()}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("authServer.BeginWebAuthnRegistration() error = %v, wantCode %v", err, codes.Unauthenticated)
+	}
+
+	rt, err = tas.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply, err = rt.userAuthReply(u, time.Now())
+	rt.done()
+	if err != nil {
+		t.Fatal(err)
+	}
+	userToken := reply.GetJwt()
+
+	origin := tas.conf.WebAuthn.Origins[0]
+	a := newTestAuthenticator(t, webauthnUserHandle(u.ID))
+
+	opts, err := tas.BeginWebAuthnRegistration(testCtx, &auth.AuthReply{Jwt: userToken})
+	if err != nil {
+		t.Fatalf("authServer.BeginWebAuthnRegistration() error = %v", err)
+	}
+	challenge := optionsChallenge(t, opts)
+
+	regTests := []struct {
+		name     string
+		wc       *auth.WebAuthnCredential
+		wantCode codes.Code
+	}{
+		{"Missing credential", &auth.WebAuthnCredential{Jwt: userToken}, codes.InvalidArgument},
+		{"Long name", &auth.WebAuthnCredential{Jwt: userToken, Credential: a.create(challenge, origin), Name: strings.Repeat("x", WebAuthnNameLen+1)}, codes.InvalidArgument},
+		{"Wrong origin", &auth.WebAuthnCredential{Jwt: userToken, Credential: a.create(challenge, "https://evil.com")}, codes.Unauthenticated},
+		{"Unknown challenge", &auth.WebAuthnCredential{Jwt: userToken, Credential: a.create([]byte("spanac"), origin)}, codes.Unauthenticated},
+		{"Success", &auth.WebAuthnCredential{Jwt: userToken, Credential: a.create(challenge, origin), Name: "Test key"}, codes.OK},
+		{"Reused challenge", &auth.WebAuthnCredential{Jwt: userToken, Credential: a.create(challenge, origin)}, codes.Unauthenticated},
+	}
+	for _, tt := range regTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tas.FinishWebAuthnRegistration(testCtx, tt.wc); status.Code(err) != tt.wantCode {
+				t.Errorf("authServer.FinishWebAuthnRegistration() error = %v, wantCode %v", err, tt.wantCode)
+			}
+		})
+	}
+
+	opts, err = tas.BeginWebAuthnRegistration(testCtx, &auth.AuthReply{Jwt: userToken})
+	if err != nil {
+		t.Fatalf("authServer.BeginWebAuthnRegistration() error = %v", err)
+	}
+	if _, err = tas.FinishWebAuthnRegistration(testCtx, &auth.WebAuthnCredential{
+		Jwt: userToken, Credential: a.create(optionsChallenge(t, opts), origin),
+	}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("authServer.FinishWebAuthnRegistration() error = %v, wantCode %v", err, codes.AlreadyExists)
+	}
+
+	// Unknown emails get options without allowed credentials.
+	for _, email := range []string{u.Email, "nobody@user.com"} {
+		opts, err = tas.BeginWebAuthnLogin(testCtx, &auth.WebAuthnLogin{Email: email})
+		if err != nil {
+			t.Fatalf("authServer.BeginWebAuthnLogin() error = %v", err)
+		}
+		var o requestOptions
+		if err = json.Unmarshal([]byte(opts.GetOptions()), &o); err != nil {
+			t.Fatal(err)
+		}
+		if want := email == u.Email; (len(o.AllowCredentials) == 1) != want {
+			t.Errorf("authServer.BeginWebAuthnLogin(%s) = %v, want credentials %v", email, o, want)
+		}
+	}
+
+	opts, err = tas.BeginWebAuthnLogin(testCtx, &auth.WebAuthnLogin{})
+	if err != nil {
+		t.Fatalf("authServer.BeginWebAuthnLogin() error = %v", err)
+	}
+	challenge = optionsChallenge(t, opts)
+	cred := a.get(t, challenge, origin)
+
+	got, err := tas.FinishWebAuthnLogin(testCtx, &auth.WebAuthnCredential{Credential: cred})
+	if err != nil || got.GetJwt() == "" {
+		t.Fatalf("authServer.FinishWebAuthnLogin() = %v, %v, want token", got, err)
+	}
+	if _, err = tas.FinishWebAuthnLogin(testCtx, &auth.WebAuthnCredential{Credential: cred}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("authServer.FinishWebAuthnLogin() error = %v, wantCode %v", err, codes.Unauthenticated)
+	}
+
+	opts, err = tas.BeginWebAuthnLogin(testCtx, &auth.WebAuthnLogin{})
+	if err != nil {
+		t.Fatalf("authServer.BeginWebAuthnLogin() error = %v", err)
+	}
+	a.signCount-- // Cloned authenticator
+	if _, err = tas.FinishWebAuthnLogin(testCtx, &auth.WebAuthnCredential{Credential: a.get(t, optionsChallenge(t, opts), origin)}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("authServer.FinishWebAuthnLogin() error = %v, wantCode %v", err, codes.Unauthenticated)
+	}
+
+	if err = tas.purgeWebAuthnChallenges(testCtx); err != nil {
+		t.Errorf("authServer.purgeWebAuthnChallenges() error = %v", err)
+	}
+}
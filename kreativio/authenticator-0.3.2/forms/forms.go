@@ -20,9 +20,13 @@ const (
 
 // Titles passed to templates
 var (
-	LoginTitle   = "Please login"
-	ResetPWTitle = "Reset password"
-	SetPWTitle   = "Set new password"
+	LoginTitle    = "Please login"
+	ResetPWTitle  = "Reset password"
+	SetPWTitle    = "Set new password"
+	MFATitle      = "Two-factor authentication"
+	TOTPTitle     = "Two-factor authentication setup"
+	PasskeyTitle  = "Sign in with a passkey"
+	PasskeysTitle = "Passkeys"
 )
 
 // Flash message targets the user with info, warning or error message
@@ -43,31 +47,49 @@ const (
 
 // Navigation links to other forms
 type Navigation struct {
-	Login, Reset, Set template.URL
+	Login, Reset, Set, Passkey template.URL
 }
 
 func navigation(r *http.Request, p *Paths) Navigation {
 	if r.URL.RawQuery == "" {
 		return Navigation{
-			Login: template.URL(p.login()),
-			Reset: template.URL(p.resetPW()),
-			Set:   template.URL(p.setPW()),
+			Login:   template.URL(p.login()),
+			Reset:   template.URL(p.resetPW()),
+			Set:     template.URL(p.setPW()),
+			Passkey: template.URL(p.passkey()),
 		}
 	}
 
 	return Navigation{
-		Login: template.URL(fmt.Sprintf("%s?%s", p.login(), r.URL.RawQuery)),
-		Reset: template.URL(fmt.Sprintf("%s?%s", p.resetPW(), r.URL.RawQuery)),
-		Set:   template.URL(fmt.Sprintf("%s?%s", p.setPW(), r.URL.RawQuery)),
+		Login:   template.URL(fmt.Sprintf("%s?%s", p.login(), r.URL.RawQuery)),
+		Reset:   template.URL(fmt.Sprintf("%s?%s", p.resetPW(), r.URL.RawQuery)),
+		Set:     template.URL(fmt.Sprintf("%s?%s", p.setPW(), r.URL.RawQuery)),
+		Passkey: template.URL(fmt.Sprintf("%s?%s", p.passkey(), r.URL.RawQuery)),
 	}
 }
 
+// TOTPData is passed to the "mfa" and "totp" templates.
+type TOTPData struct {
+	Token         string       // Pending login token, to be posted back by the "mfa" form
+	Enabled       bool         // Two-factor authentication is enabled for the user
+	Secret        string       // Base32 secret, for manual entry during enrollment
+	URI           template.URL // otpauth:// provisioning URI, for a link or QR code during enrollment
+	RecoveryCodes []string     // Shown once, after enrollment is confirmed
+}
+
+// WebAuthnData is passed to the "passkey" and "passkeys" templates.
+type WebAuthnData struct {
+	Options string // JSON encoded options for navigator.credentials, as created by the server
+}
+
 // FormData is passed to the form templates
 type FormData struct {
 	Title     string
 	Flash     *Flash
 	Nav       Navigation
 	SubmitURL string
+	TOTP      *TOTPData
+	WebAuthn  *WebAuthnData
 	Data      interface{} // As set on the Forms object
 }
 
@@ -96,15 +118,23 @@ type TemplateName string
 
 // Predefined Template Names.
 const (
-	LoginTmpl   TemplateName = "login"
-	ResetPWTmpl TemplateName = "reset"
-	SetPWTmpl   TemplateName = "setpw"
+	LoginTmpl    TemplateName = "login"
+	ResetPWTmpl  TemplateName = "reset"
+	SetPWTmpl    TemplateName = "setpw"
+	MFATmpl      TemplateName = "mfa"
+	TOTPTmpl     TemplateName = "totp"
+	PasskeyTmpl  TemplateName = "passkey"
+	PasskeysTmpl TemplateName = "passkeys"
 )
 
 var defaultTmpl = map[TemplateName]*template.Template{
-	LoginTmpl:   template.Must(template.New(string(LoginTmpl)).Parse(DefaultLoginTmpl)),
-	ResetPWTmpl: template.Must(template.New(string(ResetPWTmpl)).Parse(DefaultResetPWTmpl)),
-	SetPWTmpl:   template.Must(template.New(string(SetPWTmpl)).Parse(DefaultSetPWTmpl)),
+	LoginTmpl:    template.Must(template.New(string(LoginTmpl)).Parse(DefaultLoginTmpl)),
+	ResetPWTmpl:  template.Must(template.New(string(ResetPWTmpl)).Parse(DefaultResetPWTmpl)),
+	SetPWTmpl:    template.Must(template.New(string(SetPWTmpl)).Parse(DefaultSetPWTmpl)),
+	MFATmpl:      template.Must(template.New(string(MFATmpl)).Parse(DefaultMFATmpl)),
+	TOTPTmpl:     template.Must(template.New(string(TOTPTmpl)).Parse(DefaultTOTPTmpl)),
+	PasskeyTmpl:  template.Must(template.New(string(PasskeyTmpl)).Parse(DefaultPasskeyTmpl)),
+	PasskeysTmpl: template.Must(template.New(string(PasskeysTmpl)).Parse(DefaultPasskeysTmpl)),
 }
 
 // Forms implements http.Forms.
@@ -132,17 +162,23 @@ func (f *Forms) template(tn TemplateName) *template.Template {
 	return defaultTmpl[tn]
 }
 
-func (f *Forms) renderForm(w http.ResponseWriter, r *http.Request, tn TemplateName, title string, flash *Flash, status ...int) {
-	buf := resPool.Get()
-	defer resPool.Put(buf)
-
-	data := &FormData{
+func (f *Forms) formData(r *http.Request, title string, flash *Flash) *FormData {
+	return &FormData{
 		Title:     title,
 		Flash:     flash,
 		Nav:       navigation(r, f.Paths),
 		SubmitURL: r.URL.String(),
 		Data:      f.Data,
 	}
+}
+
+func (f *Forms) renderForm(w http.ResponseWriter, r *http.Request, tn TemplateName, title string, flash *Flash, status ...int) {
+	f.renderData(w, r, tn, f.formData(r, title, flash), status...)
+}
+
+func (f *Forms) renderData(w http.ResponseWriter, r *http.Request, tn TemplateName, data *FormData, status ...int) {
+	buf := resPool.Get()
+	defer resPool.Put(buf)
 
 	ctx := clog.AddArgs(r.Context(), "method", "renderForm", "data", data)
 
@@ -192,6 +228,8 @@ type Paths struct {
 	SetPW         string `json:"set_pw,omitempty"`
 	ResetPW       string `json:"reset_pw,omitempty"`
 	Login         string `json:"login,omitempty"`
+	MFA           string `json:"mfa,omitempty"`
+	Passkey       string `json:"passkey,omitempty"`
 	// RedirectKey for redirect URL in request Query.
 	// Upon successfull authentication, the client is redirected to the URL under this key.
 	// Login request: https://example.com/login?redirect=https://secured.com/admin?key=value
@@ -208,6 +246,10 @@ const (
 	DefaultSetPWPath     = "/set-password"
 	DefaultResetPWPath   = "/reset-password"
 	DefaultLoginPath     = "/login"
+	DefaultMFAPath       = "/mfa"
+	DefaultTOTPPath      = "/totp"
+	DefaultPasskeyPath   = "/passkey"
+	DefaultPasskeysPath  = "/passkeys"
 	DefaultRedirectKey   = "redirect"
 	DefaultTokenKey      = "jwt"
 )
@@ -240,6 +282,20 @@ func (p *Paths) login() string {
 	return p.Login
 }
 
+func (p *Paths) mfa() string {
+	if p == nil || p.MFA == "" {
+		return DefaultMFAPath
+	}
+	return p.MFA
+}
+
+func (p *Paths) passkey() string {
+	if p == nil || p.Passkey == "" {
+		return DefaultPasskeyPath
+	}
+	return p.Passkey
+}
+
 func (p *Paths) redirectKey() string {
 	if p == nil || p.RedirectKey == "" {
 		return DefaultRedirectKey
@@ -28,18 +28,20 @@ func Test_navigation(t *testing.T) {
 			"Without query vars",
 			httptest.NewRequest("GET", "/login", nil),
 			Navigation{
-				Login: DefaultLoginPath,
-				Reset: DefaultResetPWPath,
-				Set:   DefaultSetPWPath,
+				Login:   DefaultLoginPath,
+				Reset:   DefaultResetPWPath,
+				Set:     DefaultSetPWPath,
+				Passkey: DefaultPasskeyPath,
 			},
 		},
 		{
 			"With query vars",
 			httptest.NewRequest("GET", "/login"+query, nil),
 			Navigation{
-				Login: DefaultLoginPath + query,
-				Reset: DefaultResetPWPath + query,
-				Set:   DefaultSetPWPath + query,
+				Login:   DefaultLoginPath + query,
+				Reset:   DefaultResetPWPath + query,
+				Set:     DefaultSetPWPath + query,
+				Passkey: DefaultPasskeyPath + query,
 			},
 		},
 	}
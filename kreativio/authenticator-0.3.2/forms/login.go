@@ -115,6 +115,10 @@ func (f *Forms) loginPost(w http.ResponseWriter, r *http.Request) {
 		Password: password,
 	})
 	if err == nil {
+		if reply.GetMfaRequired() {
+			f.renderMFA(w, r, reply.GetJwt(), nil)
+			return
+		}
 		f.loginRedirect(w, r, rURL, reply.GetJwt())
 		return
 	}
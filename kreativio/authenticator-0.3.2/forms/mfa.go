@@ -0,0 +1,141 @@
+package forms
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	auth "github.com/moapis/authenticator"
+	"github.com/moapis/ehtml"
+	clog "github.com/usrpro/clog15"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultMFATmpl is a placeholder template for `MFA`
+const DefaultMFATmpl = `{{ define "mfa" -}}
+<!DOCTYPE html>
+<html lang="en">
+<head>
+	<meta charset="utf-8">
+	<title>{{ .Title }}</title>
+</head>
+<body>
+	<h1>{{ .Title }}</h1>
+	<form method="post" action="{{ .SubmitURL }}">
+		<input type="hidden" name="token" value="{{ .TOTP.Token }}">
+		<input type="text" placeholder="Code" name="code" autocomplete="one-time-code" required>
+		<button type="submit">Verify</button>
+	</form>
+	{{- if .Flash }}
+	<p>{{ .Flash.Lvl }}: {{ .Flash.Msg }}</p>
+	{{- end }}
+	<p>Enter the code from your authenticator app, or one of your recovery codes.</p>
+</body>
+</html>
+{{- end -}}
+`
+
+// renderMFA serves the "mfa" form, which posts the pending login token and a code to the MFA path.
+func (f *Forms) renderMFA(w http.ResponseWriter, r *http.Request, token string, flash *Flash, status ...int) {
+	data := f.formData(r, MFATitle, flash)
+	data.SubmitURL = f.Paths.mfa()
+	if r.URL.RawQuery != "" {
+		data.SubmitURL = fmt.Sprintf("%s?%s", f.Paths.mfa(), r.URL.RawQuery)
+	}
+	data.TOTP = &TOTPData{Token: token, Enabled: true}
+
+	f.renderData(w, r, MFATmpl, data, status...)
+}
+
+// mfaGet redirects to the login form, as a code can only be entered after password login.
+func (f *Forms) mfaGet(w http.ResponseWriter, r *http.Request) {
+	u := f.Paths.login()
+	if r.URL.RawQuery != "" {
+		u = fmt.Sprintf("%s?%s", u, r.URL.RawQuery)
+	}
+	http.Redirect(w, r, u, http.StatusSeeOther)
+}
+
+func (f *Forms) mfaPost(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	ctx = clog.AddArgs(ctx, "method", "mfaPost")
+
+	if err := r.ParseForm(); err != nil {
+		clog.Warn(ctx, "Parseform", "err", err)
+		if err := f.EP.Render(w, &ehtml.Data{Req: r, Code: http.StatusBadRequest, Msg: "Malformed form data"}); err != nil {
+			clog.Error(ctx, "During handling error", "err", err)
+		}
+		return
+	}
+
+	rURL, err := f.getRedirect(r)
+	if err != nil {
+		if err := f.EP.Render(w, &ehtml.Data{Req: r, Code: http.StatusBadRequest, Msg: err.Error()}); err != nil {
+			clog.Error(ctx, "During handling error", "err", err)
+		}
+		return
+	}
+
+	tkn, code := r.PostForm.Get("token"), r.PostForm.Get("code")
+	if tkn == "" {
+		clog.Warn(ctx, "Missing token in form")
+		f.mfaGet(w, r)
+		return
+	}
+	if code == "" {
+		clog.Warn(ctx, "Missing code in form")
+		f.renderMFA(w, r, tkn, &Flash{ErrFlashLvl, "Missing form data: Code"}, http.StatusBadRequest)
+		return
+	}
+
+	reply, err := f.Client.VerifyTOTP(ctx, &auth.TOTPCode{
+		Jwt:  tkn,
+		Code: code,
+	})
+	if err == nil {
+		f.loginRedirect(w, r, rURL, reply.GetJwt())
+		return
+	}
+
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		clog.Info(ctx, "VerifyTOTP gRPC call", "err", err)
+		f.renderMFA(w, r, tkn, &Flash{ErrFlashLvl, "Invalid or expired code"}, http.StatusUnauthorized)
+	case codes.ResourceExhausted:
+		clog.Warn(ctx, "VerifyTOTP gRPC call", "err", err)
+		f.renderMFA(w, r, tkn, &Flash{ErrFlashLvl, "Too many attempts, try again later"}, http.StatusTooManyRequests)
+	default:
+		clog.Error(ctx, "VerifyTOTP gRPC call", "err", err)
+		f.renderMFA(w, r, tkn, &Flash{ErrFlashLvl, "Internal server error"}, http.StatusInternalServerError)
+	}
+}
+
+// MFAHandler returns the handler taking care of the second login step,
+// for users with two-factor authentication.
+// GET redirects to the login form.
+// POST checks the pending login token and code over gRPC.
+func (f *Forms) MFAHandler() http.Handler {
+	return &mfaHandler{f}
+}
+
+type mfaHandler struct {
+	*Forms
+}
+
+func (h *mfaHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(clog.AddArgs(r.Context(), "pkg", "authenticator.forms", "handler", "MFA"))
+
+	switch r.Method {
+	case http.MethodGet:
+		h.mfaGet(w, r)
+	case http.MethodPost:
+		h.mfaPost(w, r)
+	default:
+		w.Header().Add("Allow", AllowedMethods)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,238 @@
+package forms
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	auth "github.com/moapis/authenticator"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// totpClient fakes the two-factor methods of the authenticator server.
+// Code "123456" is valid, "999999" is rate limited and "666666" fails internally.
+// Token "enabled" has two-factor authentication enabled and "bad" fails verification.
+type totpClient struct {
+	auth.AuthenticatorClient
+}
+
+func (totpClient) codeErr(code string) error {
+	switch code {
+	case "123456":
+		return nil
+	case "999999":
+		return status.Error(codes.ResourceExhausted, "Too many requests")
+	case "666666":
+		return status.Error(codes.Internal, "Database error")
+	}
+	return status.Error(codes.Unauthenticated, "Invalid code")
+}
+
+func (c totpClient) VerifyTOTP(ctx context.Context, in *auth.TOTPCode, opts ...grpc.CallOption) (*auth.AuthReply, error) {
+	if err := c.codeErr(in.GetCode()); err != nil {
+		return nil, err
+	}
+	return &auth.AuthReply{Jwt: "spanac"}, nil
+}
+
+func (totpClient) EnableTOTP(ctx context.Context, in *auth.AuthReply, opts ...grpc.CallOption) (*auth.TOTPSecret, error) {
+	switch in.GetJwt() {
+	case "enabled":
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication already enabled")
+	case "bad":
+		return nil, status.Error(codes.Unauthenticated, "EdDSA verification failed")
+	}
+	return &auth.TOTPSecret{Secret: "GEZDGNBV", Uri: "otpauth://totp/localhost:foo@bar.com?secret=GEZDGNBV"}, nil
+}
+
+func (c totpClient) ConfirmTOTP(ctx context.Context, in *auth.TOTPCode, opts ...grpc.CallOption) (*auth.RecoveryCodes, error) {
+	if in.GetJwt() == "enabled" {
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication already enabled")
+	}
+	if err := c.codeErr(in.GetCode()); err != nil {
+		return nil, err
+	}
+	return &auth.RecoveryCodes{Codes: []string{"ABCD-EFGH", "IJKL-MNOP"}}, nil
+}
+
+func (c totpClient) DisableTOTP(ctx context.Context, in *auth.TOTPCode, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if in.GetJwt() != "enabled" {
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication not enabled")
+	}
+	if err := c.codeErr(in.GetCode()); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+const mfaFlashOut = `<!DOCTYPE html>
+<html lang="en">
+<head>
+	<meta charset="utf-8">
+	<title>Two-factor authentication</title>
+</head>
+<body>
+	<h1>Two-factor authentication</h1>
+	<form method="post" action="/mfa?redirect=http://example.com/foo?hello=world">
+		<input type="hidden" name="token" value="pending">
+		<input type="text" placeholder="Code" name="code" autocomplete="one-time-code" required>
+		<button type="submit">Verify</button>
+	</form>%s
+	<p>Enter the code from your authenticator app, or one of your recovery codes.</p>
+</body>
+</html>`
+
+func TestForms_renderMFA(t *testing.T) {
+	f := &Forms{}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "/login?redirect=http://example.com/foo?hello=world", nil)
+
+	f.renderMFA(w, r, "pending", nil)
+
+	resp := w.Result()
+	body, _ := ioutil.ReadAll(resp.Body)
+
+	if want := fmt.Sprintf(mfaFlashOut, ""); string(body) != want {
+		t.Errorf("Forms.renderMFA() = \n%s\nwant\n%v", body, want)
+	}
+}
+
+func TestForms_mfaGet(t *testing.T) {
+	f := &Forms{}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "/mfa?redirect=http://example.com/foo?hello=world", nil)
+
+	f.mfaGet(w, r)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusSeeOther {
+		t.Errorf("Forms.mfaGet() status = %v, want: %v", resp.StatusCode, http.StatusSeeOther)
+	}
+	want := "/login?redirect=http://example.com/foo?hello=world"
+	if got := resp.Header.Get("Location"); got != want {
+		t.Errorf("Forms.mfaGet() Location = %v, want: %v", got, want)
+	}
+}
+
+func TestForms_mfaPost(t *testing.T) {
+	const target = "/mfa?redirect=http://example.com/foo?hello=world"
+	flash := func(msg string) string {
+		return fmt.Sprintf(mfaFlashOut, "\n\t<p>error: "+msg+"</p>")
+	}
+
+	tests := []struct {
+		name     string
+		r        *http.Request
+		wantCode int
+		wantBody string
+		wantLoc  string
+	}{
+		{
+			"Missing redirect",
+			httptest.NewRequest("POST", "/mfa", strings.NewReader("token=pending&code=123456")),
+			http.StatusBadRequest,
+			loginBadRequestOut,
+			"",
+		},
+		{
+			"Missing token",
+			httptest.NewRequest("POST", target, strings.NewReader("code=123456")),
+			http.StatusSeeOther,
+			"",
+			"/login?redirect=http://example.com/foo?hello=world",
+		},
+		{
+			"Missing code",
+			httptest.NewRequest("POST", target, strings.NewReader("token=pending")),
+			http.StatusBadRequest,
+			flash("Missing form data: Code"),
+			"",
+		},
+		{
+			"Success and redirect",
+			httptest.NewRequest("POST", target, strings.NewReader("token=pending&code=123456")),
+			http.StatusSeeOther,
+			"",
+			"http://example.com/foo?hello=world&jwt=spanac",
+		},
+		{
+			"Invalid code",
+			httptest.NewRequest("POST", target, strings.NewReader("token=pending&code=000000")),
+			http.StatusUnauthorized,
+			flash("Invalid or expired code"),
+			"",
+		},
+		{
+			"Rate limited",
+			httptest.NewRequest("POST", target, strings.NewReader("token=pending&code=999999")),
+			http.StatusTooManyRequests,
+			flash("Too many attempts, try again later"),
+			"",
+		},
+		{
+			"Internal server error",
+			httptest.NewRequest("POST", target, strings.NewReader("token=pending&code=666666")),
+			http.StatusInternalServerError,
+			flash("Internal server error"),
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &Forms{Client: totpClient{}}
+			w := httptest.NewRecorder()
+			tt.r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+			f.mfaPost(w, tt.r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+
+			if resp.StatusCode != tt.wantCode {
+				t.Errorf("Forms.mfaPost() status = %v, want: %v", resp.StatusCode, tt.wantCode)
+			}
+			if tt.wantLoc == "" {
+				if got := string(body); got != tt.wantBody {
+					t.Errorf("Forms.mfaPost() = \n%v\nwant\n%v", got, tt.wantBody)
+				}
+			}
+			if got := resp.Header.Get("Location"); got != tt.wantLoc {
+				t.Errorf("Forms.mfaPost() Location = %v, want: %v", got, tt.wantLoc)
+			}
+		})
+	}
+}
+
+func Test_mfaHandler_ServeHTTP(t *testing.T) {
+	h := (&Forms{Client: totpClient{}}).MFAHandler()
+
+	tests := []struct {
+		method   string
+		body     string
+		wantCode int
+	}{
+		{"GET", "", http.StatusSeeOther},
+		{"POST", "token=pending&code=123456", http.StatusSeeOther},
+		{"PUT", "", http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(tt.method, "/mfa?redirect=http://example.com/foo?hello=world", strings.NewReader(tt.body))
+			r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+			h.ServeHTTP(w, r)
+
+			if got := w.Result().StatusCode; got != tt.wantCode {
+				t.Errorf("mfaHandler.ServeHTTP() status = %v, want: %v", got, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package forms
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	auth "github.com/moapis/authenticator"
+	"github.com/moapis/ehtml"
+	clog "github.com/usrpro/clog15"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// webauthnScript is used by the default passkey templates.
+// webauthnForm(ceremony, options) decodes the options,
+// and on submit of the form with id "webauthn" calls navigator.credentials[ceremony].
+// The resulting credential is posted JSON encoded in the "credential" field,
+// with binary members base64url encoded.
+const webauthnScript = `function webauthnForm(ceremony, options) {
+	const dec = s => Uint8Array.from(atob(s.replace(/-/g, "+").replace(/_/g, "/")), c => c.charCodeAt(0));
+	const enc = b => b ? btoa(String.fromCharCode(...new Uint8Array(b))).replace(/\+/g, "-").replace(/\//g, "_").replace(/=+$/, "") : null;
+	const pk = JSON.parse(options);
+	pk.challenge = dec(pk.challenge);
+	if (pk.user) {
+		pk.user.id = dec(pk.user.id);
+	}
+	(pk.excludeCredentials || pk.allowCredentials || []).forEach(c => c.id = dec(c.id));
+
+	const form = document.getElementById("webauthn");
+	form.addEventListener("submit", async e => {
+		e.preventDefault();
+		try {
+			const cred = await navigator.credentials[ceremony]({ publicKey: pk });
+			const r = cred.response;
+			form.credential.value = JSON.stringify({
+				id: cred.id,
+				rawId: enc(cred.rawId),
+				type: cred.type,
+				response: {
+					clientDataJSON: enc(r.clientDataJSON),
+					attestationObject: enc(r.attestationObject),
+					authenticatorData: enc(r.authenticatorData),
+					signature: enc(r.signature),
+					userHandle: enc(r.userHandle),
+				},
+			});
+			form.submit();
+		} catch (err) {
+			alert(err.message);
+		}
+	});
+}`
+
+// DefaultPasskeyTmpl is a placeholder template for `Passkey`
+const DefaultPasskeyTmpl = `{{ define "passkey" -}}
+<!DOCTYPE html>
+<html lang="en">
+<head>
+	<meta charset="utf-8">
+	<title>{{ .Title }}</title>
+</head>
+<body>
+	<h1>{{ .Title }}</h1>
+	<form method="post" action="{{ .SubmitURL }}" id="webauthn">
+		<input type="hidden" name="credential">
+		<button type="submit">Use passkey</button>
+	</form>
+	{{- if .Flash }}
+	<p>{{ .Flash.Lvl }}: {{ .Flash.Msg }}</p>
+	{{- end }}
+	<p><a href="{{ .Nav.Login }}">Sign in with password</a></p>
+	<script>
+	` + webauthnScript + `
+	webauthnForm("get", {{ .WebAuthn.Options }});
+	</script>
+</body>
+</html>
+{{- end -}}
+`
+
+// DefaultPasskeysTmpl is a placeholder template for `Passkeys`
+const DefaultPasskeysTmpl = `{{ define "passkeys" -}}
+<!DOCTYPE html>
+<html lang="en">
+<head>
+	<meta charset="utf-8">
+	<title>{{ .Title }}</title>
+</head>
+<body>
+	<h1>{{ .Title }}</h1>
+	<form method="post" action="{{ .SubmitURL }}" id="webauthn">
+		<input type="hidden" name="credential">
+		<input type="text" placeholder="Name" name="name" maxlength="64">
+		<button type="submit">Add passkey</button>
+	</form>
+	{{- if .Flash }}
+	<p>{{ .Flash.Lvl }}: {{ .Flash.Msg }}</p>
+	{{- end }}
+	<script>
+	` + webauthnScript + `
+	webauthnForm("create", {{ .WebAuthn.Options }});
+	</script>
+</body>
+</html>
+{{- end -}}
+`
+
+// renderPasskey serves the "passkey" form, with options for a new login ceremony.
+func (f *Forms) renderPasskey(ctx context.Context, w http.ResponseWriter, r *http.Request, flash *Flash, sc ...int) {
+	opts, err := f.Client.BeginWebAuthnLogin(ctx, &auth.WebAuthnLogin{})
+	if err != nil {
+		f.rpcError(ctx, w, r, "BeginWebAuthnLogin", err)
+		return
+	}
+
+	data := f.formData(r, PasskeyTitle, flash)
+	data.WebAuthn = &WebAuthnData{Options: opts.GetOptions()}
+
+	f.renderData(w, r, PasskeyTmpl, data, sc...)
+}
+
+func (f *Forms) passkeyGet(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	ctx = clog.AddArgs(ctx, "method", "passkeyGet")
+
+	if _, err := f.getRedirect(r); err != nil {
+		if err := f.EP.Render(w, &ehtml.Data{Req: r, Code: http.StatusBadRequest, Msg: err.Error()}); err != nil {
+			clog.Error(ctx, "During handling error", "err", err)
+		}
+		return
+	}
+
+	f.renderPasskey(ctx, w, r, nil)
+}
+
+func (f *Forms) passkeyPost(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	ctx = clog.AddArgs(ctx, "method", "passkeyPost")
+
+	rURL, err := f.getRedirect(r)
+	if err != nil {
+		if err := f.EP.Render(w, &ehtml.Data{Req: r, Code: http.StatusBadRequest, Msg: err.Error()}); err != nil {
+			clog.Error(ctx, "During handling error", "err", err)
+		}
+		return
+	}
+
+	if err = r.ParseForm(); err != nil {
+		clog.Warn(ctx, "Parseform", "err", err)
+		f.renderPasskey(ctx, w, r, &Flash{ErrFlashLvl, "Malformed form data"}, http.StatusBadRequest)
+		return
+	}
+
+	credential := r.PostForm.Get("credential")
+	if credential == "" {
+		clog.Warn(ctx, "Missing credential in form")
+		f.renderPasskey(ctx, w, r, &Flash{ErrFlashLvl, "Missing form data: Credential"}, http.StatusBadRequest)
+		return
+	}
+
+	reply, err := f.Client.FinishWebAuthnLogin(ctx, &auth.WebAuthnCredential{Credential: credential})
+	if err == nil {
+		f.loginRedirect(w, r, rURL, reply.GetJwt())
+		return
+	}
+
+	switch status.Code(err) {
+	case codes.Unauthenticated, codes.InvalidArgument:
+		clog.Info(ctx, "FinishWebAuthnLogin gRPC call", "err", err)
+		f.renderPasskey(ctx, w, r, &Flash{ErrFlashLvl, "Passkey verification failed"}, http.StatusUnauthorized)
+	case codes.ResourceExhausted:
+		clog.Warn(ctx, "FinishWebAuthnLogin gRPC call", "err", err)
+		f.renderPasskey(ctx, w, r, &Flash{ErrFlashLvl, "Too many attempts, try again later"}, http.StatusTooManyRequests)
+	default:
+		f.rpcError(ctx, w, r, "FinishWebAuthnLogin", err)
+	}
+}
+
+// PasskeyHandler returns the handler taking care of login with a passkey.
+// GET serves the "passkey" form template, with WebAuthn options from gRPC.
+// POST checks the credential over gRPC and redirects like the login form.
+func (f *Forms) PasskeyHandler() http.Handler {
+	return &passkeyHandler{f}
+}
+
+type passkeyHandler struct {
+	*Forms
+}
+
+func (h *passkeyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(clog.AddArgs(r.Context(), "pkg", "authenticator.forms", "handler", "Passkey"))
+
+	switch r.Method {
+	case http.MethodGet:
+		h.passkeyGet(w, r)
+	case http.MethodPost:
+		h.passkeyPost(w, r)
+	default:
+		w.Header().Add("Allow", AllowedMethods)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// renderPasskeys serves the "passkeys" form for the user of tkn,
+// with options for a new registration ceremony.
+func (f *Forms) renderPasskeys(ctx context.Context, w http.ResponseWriter, r *http.Request, tkn string, flash *Flash, sc ...int) {
+	opts, err := f.Client.BeginWebAuthnRegistration(ctx, &auth.AuthReply{Jwt: tkn})
+	if err != nil {
+		f.rpcError(ctx, w, r, "BeginWebAuthnRegistration", err)
+		return
+	}
+
+	data := f.formData(r, PasskeysTitle, flash)
+	data.WebAuthn = &WebAuthnData{Options: opts.GetOptions()}
+
+	f.renderData(w, r, PasskeysTmpl, data, sc...)
+}
+
+func (f *Forms) passkeysGet(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	ctx = clog.AddArgs(ctx, "method", "passkeysGet")
+
+	tkn := r.URL.Query().Get("jwt")
+	if tkn == "" {
+		if err := f.EP.Render(w, &ehtml.Data{Req: r, Code: http.StatusBadRequest, Msg: "Missing token in URL"}); err != nil {
+			clog.Error(ctx, "During handling error", "err", err)
+		}
+		return
+	}
+
+	f.renderPasskeys(ctx, w, r, tkn, nil)
+}
+
+func (f *Forms) passkeysPost(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	ctx = clog.AddArgs(ctx, "method", "passkeysPost")
+
+	tkn := r.URL.Query().Get("jwt")
+	if tkn == "" {
+		if err := f.EP.Render(w, &ehtml.Data{Req: r, Code: http.StatusBadRequest, Msg: "Missing token in URL"}); err != nil {
+			clog.Error(ctx, "During handling error", "err", err)
+		}
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		clog.Warn(ctx, "Parseform", "err", err)
+		f.renderPasskeys(ctx, w, r, tkn, &Flash{ErrFlashLvl, "Malformed form data"}, http.StatusBadRequest)
+		return
+	}
+
+	credential := r.PostForm.Get("credential")
+	if credential == "" {
+		clog.Warn(ctx, "Missing credential in form")
+		f.renderPasskeys(ctx, w, r, tkn, &Flash{ErrFlashLvl, "Missing form data: Credential"}, http.StatusBadRequest)
+		return
+	}
+
+	_, err := f.Client.FinishWebAuthnRegistration(ctx, &auth.WebAuthnCredential{
+		Jwt:        tkn,
+		Credential: credential,
+		Name:       r.PostForm.Get("name"),
+	})
+	switch status.Code(err) {
+	case codes.OK:
+		f.renderPasskeys(ctx, w, r, tkn, &Flash{InfoFlashLvl, "Passkey registered"})
+	case codes.Unauthenticated, codes.InvalidArgument:
+		// Either the credential or the token is invalid.
+		// In the latter case, renderPasskeys renders the error page.
+		clog.Info(ctx, "FinishWebAuthnRegistration gRPC call", "err", err)
+		f.renderPasskeys(ctx, w, r, tkn, &Flash{ErrFlashLvl, "Passkey verification failed"}, http.StatusUnauthorized)
+	case codes.AlreadyExists:
+		clog.Info(ctx, "FinishWebAuthnRegistration gRPC call", "err", err)
+		f.renderPasskeys(ctx, w, r, tkn, &Flash{WarnFlashLvl, "Passkey already registered"}, http.StatusConflict)
+	default:
+		f.rpcError(ctx, w, r, "FinishWebAuthnRegistration", err)
+	}
+}
+
+// PasskeysHandler returns the handler taking care of passkey registration,
+// for the user of the token in the URL.
+// GET serves the "passkeys" form template, with WebAuthn options from gRPC.
+// POST stores the new credential over gRPC.
+func (f *Forms) PasskeysHandler() http.Handler {
+	return &passkeysHandler{f}
+}
+
+type passkeysHandler struct {
+	*Forms
+}
+
+func (h *passkeysHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(clog.AddArgs(r.Context(), "pkg", "authenticator.forms", "handler", "Passkeys"))
+
+	switch r.Method {
+	case http.MethodGet:
+		h.passkeysGet(w, r)
+	case http.MethodPost:
+		h.passkeysPost(w, r)
+	default:
+		w.Header().Add("Allow", AllowedMethods)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
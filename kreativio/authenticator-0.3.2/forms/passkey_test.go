@@ -0,0 +1,272 @@
+package forms
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	auth "github.com/moapis/authenticator"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// webauthnClient fakes the WebAuthn methods of the authenticator server.
+// Credential "valid" verifies, "exists" is already registered, "limited" is rate limited
+// and "internal" fails internally. Token "bad" fails verification.
+type webauthnClient struct {
+	auth.AuthenticatorClient
+}
+
+const testWebAuthnOptions = `{"challenge":"AQID"}`
+
+func (webauthnClient) credentialErr(credential string) error {
+	switch credential {
+	case "valid":
+		return nil
+	case "exists":
+		return status.Error(codes.AlreadyExists, "Credential already registered")
+	case "limited":
+		return status.Error(codes.ResourceExhausted, "Too many requests")
+	case "internal":
+		return status.Error(codes.Internal, "Database error")
+	}
+	return status.Error(codes.Unauthenticated, "WebAuthn verification failed")
+}
+
+func (webauthnClient) BeginWebAuthnRegistration(ctx context.Context, in *auth.AuthReply, opts ...grpc.CallOption) (*auth.WebAuthnOptions, error) {
+	if in.GetJwt() == "bad" {
+		return nil, status.Error(codes.Unauthenticated, "EdDSA verification failed")
+	}
+	return &auth.WebAuthnOptions{Options: testWebAuthnOptions}, nil
+}
+
+func (c webauthnClient) FinishWebAuthnRegistration(ctx context.Context, in *auth.WebAuthnCredential, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if in.GetJwt() == "bad" {
+		return nil, status.Error(codes.Unauthenticated, "EdDSA verification failed")
+	}
+	if err := c.credentialErr(in.GetCredential()); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (webauthnClient) BeginWebAuthnLogin(ctx context.Context, in *auth.WebAuthnLogin, opts ...grpc.CallOption) (*auth.WebAuthnOptions, error) {
+	return &auth.WebAuthnOptions{Options: testWebAuthnOptions}, nil
+}
+
+func (c webauthnClient) FinishWebAuthnLogin(ctx context.Context, in *auth.WebAuthnCredential, opts ...grpc.CallOption) (*auth.AuthReply, error) {
+	if err := c.credentialErr(in.GetCredential()); err != nil {
+		return nil, err
+	}
+	return &auth.AuthReply{Jwt: "spanac"}, nil
+}
+
+func TestForms_passkeyGet(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		wantCode int
+		want     []string
+	}{
+		{"Missing redirect", "/passkey", http.StatusBadRequest, []string{redirectMissing}},
+		{"Options", "/passkey?redirect=http://example.com/foo", http.StatusOK, []string{
+			`webauthnForm("get", "{\"challenge\":\"AQID\"}");`,
+			`<a href="/login?redirect=http://example.com/foo">`,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &Forms{Client: webauthnClient{}}
+			w := httptest.NewRecorder()
+
+			f.passkeyGet(w, httptest.NewRequest("GET", tt.target, nil))
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+
+			if resp.StatusCode != tt.wantCode {
+				t.Errorf("Forms.passkeyGet() status = %v, want: %v", resp.StatusCode, tt.wantCode)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(body), want) {
+					t.Errorf("Forms.passkeyGet() = \n%s\nmissing %q", body, want)
+				}
+			}
+		})
+	}
+}
+
+func TestForms_passkeyPost(t *testing.T) {
+	const target = "/passkey?redirect=http://example.com/foo"
+
+	tests := []struct {
+		name     string
+		target   string
+		body     string
+		wantCode int
+		want     []string
+	}{
+		{"Missing redirect", "/passkey", "credential=valid", http.StatusBadRequest, []string{redirectMissing}},
+		{"Malformed body", target, "%sssssssss", http.StatusBadRequest, []string{"error: Malformed form data"}},
+		{"Missing credential", target, "", http.StatusBadRequest, []string{"error: Missing form data: Credential"}},
+		{"Invalid credential", target, "credential=spanac", http.StatusUnauthorized, []string{"error: Passkey verification failed"}},
+		{"Rate limited", target, "credential=limited", http.StatusTooManyRequests, []string{"Too many attempts"}},
+		{"Internal error", target, "credential=internal", http.StatusInternalServerError, []string{"Internal server error"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &Forms{Client: webauthnClient{}}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", tt.target, strings.NewReader(tt.body))
+			r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+			f.passkeyPost(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+
+			if resp.StatusCode != tt.wantCode {
+				t.Errorf("Forms.passkeyPost() status = %v, want: %v", resp.StatusCode, tt.wantCode)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(body), want) {
+					t.Errorf("Forms.passkeyPost() = \n%s\nmissing %q", body, want)
+				}
+			}
+		})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		f := &Forms{Client: webauthnClient{}}
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", target, strings.NewReader("credential=valid"))
+		r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+		f.passkeyPost(w, r)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusSeeOther {
+			t.Fatalf("Forms.passkeyPost() status = %v, want: %v", resp.StatusCode, http.StatusSeeOther)
+		}
+		u, err := url.Parse(resp.Header.Get("Location"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.Host != "example.com" || u.Query().Get(DefaultTokenKey) != "spanac" {
+			t.Errorf("Forms.passkeyPost() Location = %v", u)
+		}
+	})
+}
+
+func TestForms_passkeysGet(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		wantCode int
+		want     []string
+	}{
+		{"Missing token", "/passkeys", http.StatusBadRequest, []string{"Missing token in URL"}},
+		{"Bad token", "/passkeys?jwt=bad", http.StatusUnauthorized, []string{"Token verification failed"}},
+		{"Options", "/passkeys?jwt=user", http.StatusOK, []string{`webauthnForm("create", "{\"challenge\":\"AQID\"}");`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &Forms{Client: webauthnClient{}}
+			w := httptest.NewRecorder()
+
+			f.passkeysGet(w, httptest.NewRequest("GET", tt.target, nil))
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+
+			if resp.StatusCode != tt.wantCode {
+				t.Errorf("Forms.passkeysGet() status = %v, want: %v", resp.StatusCode, tt.wantCode)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(body), want) {
+					t.Errorf("Forms.passkeysGet() = \n%s\nmissing %q", body, want)
+				}
+			}
+		})
+	}
+}
+
+func TestForms_passkeysPost(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		body     string
+		wantCode int
+		want     []string
+	}{
+		{"Missing token", "/passkeys", "credential=valid", http.StatusBadRequest, []string{"Missing token in URL"}},
+		{"Malformed body", "/passkeys?jwt=user", "%sssssssss", http.StatusBadRequest, []string{"error: Malformed form data"}},
+		{"Missing credential", "/passkeys?jwt=user", "name=foo", http.StatusBadRequest, []string{"error: Missing form data: Credential"}},
+		{"Registered", "/passkeys?jwt=user", "credential=valid&name=foo", http.StatusOK, []string{"info: Passkey registered"}},
+		{"Invalid credential", "/passkeys?jwt=user", "credential=spanac", http.StatusUnauthorized, []string{"error: Passkey verification failed"}},
+		{"Bad token", "/passkeys?jwt=bad", "credential=valid", http.StatusUnauthorized, []string{"Token verification failed"}},
+		{"Already registered", "/passkeys?jwt=user", "credential=exists", http.StatusConflict, []string{"warning: Passkey already registered"}},
+		{"Rate limited", "/passkeys?jwt=user", "credential=limited", http.StatusTooManyRequests, []string{"Too many attempts"}},
+		{"Internal error", "/passkeys?jwt=user", "credential=internal", http.StatusInternalServerError, []string{"Internal server error"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &Forms{Client: webauthnClient{}}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", tt.target, strings.NewReader(tt.body))
+			r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+			f.passkeysPost(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+
+			if resp.StatusCode != tt.wantCode {
+				t.Errorf("Forms.passkeysPost() status = %v, want: %v", resp.StatusCode, tt.wantCode)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(body), want) {
+					t.Errorf("Forms.passkeysPost() = \n%s\nmissing %q", body, want)
+				}
+			}
+		})
+	}
+}
+
+func Test_passkeyHandlers_ServeHTTP(t *testing.T) {
+	f := &Forms{Client: webauthnClient{}}
+
+	tests := []struct {
+		name     string
+		h        http.Handler
+		target   string
+		method   string
+		wantCode int
+	}{
+		{"Passkey GET", f.PasskeyHandler(), "/passkey?redirect=http://example.com", "GET", http.StatusOK},
+		{"Passkey POST", f.PasskeyHandler(), "/passkey?redirect=http://example.com", "POST", http.StatusSeeOther},
+		{"Passkey PUT", f.PasskeyHandler(), "/passkey?redirect=http://example.com", "PUT", http.StatusMethodNotAllowed},
+		{"Passkeys GET", f.PasskeysHandler(), "/passkeys?jwt=user", "GET", http.StatusOK},
+		{"Passkeys POST", f.PasskeysHandler(), "/passkeys?jwt=user", "POST", http.StatusOK},
+		{"Passkeys PUT", f.PasskeysHandler(), "/passkeys?jwt=user", "PUT", http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(tt.method, tt.target, strings.NewReader("credential=valid"))
+			r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+			tt.h.ServeHTTP(w, r)
+
+			if got := w.Result().StatusCode; got != tt.wantCode {
+				t.Errorf("ServeHTTP() status = %v, want: %v", got, tt.wantCode)
+			}
+		})
+	}
+}
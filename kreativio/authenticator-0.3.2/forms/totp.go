@@ -0,0 +1,214 @@
+package forms
+
+import (
+	"context"
+	"html/template"
+	"net/http"
+	"time"
+
+	auth "github.com/moapis/authenticator"
+	"github.com/moapis/ehtml"
+	clog "github.com/usrpro/clog15"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultTOTPTmpl is a placeholder template for `TOTP`
+const DefaultTOTPTmpl = `{{ define "totp" -}}
+<!DOCTYPE html>
+<html lang="en">
+<head>
+	<meta charset="utf-8">
+	<title>{{ .Title }}</title>
+</head>
+<body>
+	<h1>{{ .Title }}</h1>
+	{{- if .Flash }}
+	<p>{{ .Flash.Lvl }}: {{ .Flash.Msg }}</p>
+	{{- end }}
+	{{- if .TOTP.RecoveryCodes }}
+	<p>Store these recovery codes in a safe place. Each of them can be used once, instead of a code from your authenticator app.</p>
+	<ul>
+		{{- range .TOTP.RecoveryCodes }}
+		<li><code>{{ . }}</code></li>
+		{{- end }}
+	</ul>
+	{{- else if .TOTP.Enabled }}
+	<form method="post" action="{{ .SubmitURL }}">
+		<input type="hidden" name="action" value="disable">
+		<input type="text" placeholder="Code" name="code" autocomplete="one-time-code" required>
+		<button type="submit">Disable</button>
+	</form>
+	{{- else if .TOTP.Secret }}
+	<p>Scan or open <a href="{{ .TOTP.URI }}">this link</a> with your authenticator app, or enter the secret <code>{{ .TOTP.Secret }}</code>.</p>
+	<form method="post" action="{{ .SubmitURL }}">
+		<input type="hidden" name="action" value="enable">
+		<input type="text" placeholder="Code" name="code" autocomplete="one-time-code" required>
+		<button type="submit">Enable</button>
+	</form>
+	{{- else }}
+	<p><a href="{{ .SubmitURL }}">Enable two-factor authentication</a></p>
+	{{- end }}
+</body>
+</html>
+{{- end -}}
+`
+
+// Actions posted by the "totp" form
+const (
+	totpEnable  = "enable"
+	totpDisable = "disable"
+)
+
+func (f *Forms) renderTOTP(w http.ResponseWriter, r *http.Request, td *TOTPData, flash *Flash, status ...int) {
+	data := f.formData(r, TOTPTitle, flash)
+	data.TOTP = td
+
+	f.renderData(w, r, TOTPTmpl, data, status...)
+}
+
+// rpcError renders an error page for err, resulting from a gRPC call of method.
+func (f *Forms) rpcError(ctx context.Context, w http.ResponseWriter, r *http.Request, method string, err error) {
+	data := &ehtml.Data{Req: r, Code: http.StatusInternalServerError, Msg: "Internal server error"}
+
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		clog.Info(ctx, method+" gRPC call", "err", err)
+		data.Code, data.Msg = http.StatusUnauthorized, "Token verification failed, please login again."
+	case codes.ResourceExhausted:
+		clog.Warn(ctx, method+" gRPC call", "err", err)
+		data.Code, data.Msg = http.StatusTooManyRequests, "Too many attempts, try again later"
+	default:
+		clog.Error(ctx, method+" gRPC call", "err", err)
+	}
+
+	if err := f.EP.Render(w, data); err != nil {
+		clog.Error(ctx, "During handling error", "err", err)
+	}
+}
+
+// totpEnroll renders the enrollment form of the user of tkn,
+// or the disable form if two-factor authentication is already enabled.
+func (f *Forms) totpEnroll(ctx context.Context, w http.ResponseWriter, r *http.Request, tkn string, flash *Flash, sc ...int) {
+	secret, err := f.Client.EnableTOTP(ctx, &auth.AuthReply{Jwt: tkn})
+	switch status.Code(err) {
+	case codes.OK:
+		f.renderTOTP(w, r, &TOTPData{
+			Secret: secret.GetSecret(),
+			URI:    template.URL(secret.GetUri()),
+		}, flash, sc...)
+	case codes.FailedPrecondition:
+		f.renderTOTP(w, r, &TOTPData{Enabled: true}, flash, sc...)
+	default:
+		f.rpcError(ctx, w, r, "EnableTOTP", err)
+	}
+}
+
+func (f *Forms) totpGet(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	ctx = clog.AddArgs(ctx, "method", "totpGet")
+
+	tkn := r.URL.Query().Get("jwt")
+	if tkn == "" {
+		if err := f.EP.Render(w, &ehtml.Data{Req: r, Code: http.StatusBadRequest, Msg: "Missing token in URL"}); err != nil {
+			clog.Error(ctx, "During handling error", "err", err)
+		}
+		return
+	}
+
+	f.totpEnroll(ctx, w, r, tkn, nil)
+}
+
+func (f *Forms) totpPost(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	ctx = clog.AddArgs(ctx, "method", "totpPost")
+
+	tkn := r.URL.Query().Get("jwt")
+	if tkn == "" {
+		if err := f.EP.Render(w, &ehtml.Data{Req: r, Code: http.StatusBadRequest, Msg: "Missing token in URL"}); err != nil {
+			clog.Error(ctx, "During handling error", "err", err)
+		}
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		clog.Warn(ctx, "Parseform", "err", err)
+		f.totpEnroll(ctx, w, r, tkn, &Flash{ErrFlashLvl, "Malformed form data"}, http.StatusBadRequest)
+		return
+	}
+
+	action, code := r.PostForm.Get("action"), r.PostForm.Get("code")
+	if code == "" {
+		clog.Warn(ctx, "Missing code in form")
+		f.totpEnroll(ctx, w, r, tkn, &Flash{ErrFlashLvl, "Missing form data: Code"}, http.StatusBadRequest)
+		return
+	}
+
+	var (
+		tc     = &auth.TOTPCode{Jwt: tkn, Code: code}
+		rc     *auth.RecoveryCodes
+		method string
+		err    error
+	)
+	switch action {
+	case totpEnable:
+		method = "ConfirmTOTP"
+		rc, err = f.Client.ConfirmTOTP(ctx, tc)
+	case totpDisable:
+		method = "DisableTOTP"
+		_, err = f.Client.DisableTOTP(ctx, tc)
+	default:
+		clog.Warn(ctx, "Unknown action in form", "action", action)
+		f.totpEnroll(ctx, w, r, tkn, &Flash{ErrFlashLvl, "Malformed form data"}, http.StatusBadRequest)
+		return
+	}
+
+	switch status.Code(err) {
+	case codes.OK:
+		if action == totpEnable {
+			f.renderTOTP(w, r, &TOTPData{Enabled: true, RecoveryCodes: rc.GetCodes()}, &Flash{InfoFlashLvl, "Two-factor authentication enabled"})
+		} else {
+			f.renderTOTP(w, r, &TOTPData{}, &Flash{InfoFlashLvl, "Two-factor authentication disabled"})
+		}
+	case codes.Unauthenticated:
+		// Either the code or the token is invalid.
+		// In the latter case, totpEnroll renders the error page.
+		clog.Info(ctx, method+" gRPC call", "err", err)
+		f.totpEnroll(ctx, w, r, tkn, &Flash{ErrFlashLvl, "Invalid code"}, http.StatusUnauthorized)
+	case codes.FailedPrecondition:
+		clog.Info(ctx, method+" gRPC call", "err", err)
+		f.totpEnroll(ctx, w, r, tkn, &Flash{WarnFlashLvl, status.Convert(err).Message()}, http.StatusConflict)
+	default:
+		f.rpcError(ctx, w, r, method, err)
+	}
+}
+
+// TOTPHandler returns the handler taking care of two-factor authentication setup,
+// for the user of the token in the URL.
+// GET serves the "totp" form template, for enrollment or disabling.
+// POST confirms enrollment, showing recovery codes, or disables two-factor authentication over gRPC.
+func (f *Forms) TOTPHandler() http.Handler {
+	return &totpHandler{f}
+}
+
+type totpHandler struct {
+	*Forms
+}
+
+func (h *totpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(clog.AddArgs(r.Context(), "pkg", "authenticator.forms", "handler", "TOTP"))
+
+	switch r.Method {
+	case http.MethodGet:
+		h.totpGet(w, r)
+	case http.MethodPost:
+		h.totpPost(w, r)
+	default:
+		w.Header().Add("Allow", AllowedMethods)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,139 @@
+package forms
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestForms_totpGet(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		wantCode int
+		want     []string
+	}{
+		{"Missing token", "/totp", http.StatusBadRequest, []string{"Missing token in URL"}},
+		{"Bad token", "/totp?jwt=bad", http.StatusUnauthorized, []string{"Token verification failed"}},
+		{"Enroll", "/totp?jwt=user", http.StatusOK, []string{
+			`<a href="otpauth://totp/localhost:foo@bar.com?secret=GEZDGNBV">`,
+			"<code>GEZDGNBV</code>",
+			`value="enable"`,
+		}},
+		{"Enabled", "/totp?jwt=enabled", http.StatusOK, []string{`value="disable"`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &Forms{Client: totpClient{}}
+			w := httptest.NewRecorder()
+
+			f.totpGet(w, httptest.NewRequest("GET", tt.target, nil))
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+
+			if resp.StatusCode != tt.wantCode {
+				t.Errorf("Forms.totpGet() status = %v, want: %v", resp.StatusCode, tt.wantCode)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(body), want) {
+					t.Errorf("Forms.totpGet() = \n%s\nmissing %q", body, want)
+				}
+			}
+		})
+	}
+}
+
+func TestForms_totpPost(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		body     string
+		wantCode int
+		want     []string
+	}{
+		{"Missing token", "/totp", "action=enable&code=123456", http.StatusBadRequest, []string{"Missing token in URL"}},
+		{"Malformed body", "/totp?jwt=user", "%sssssssss", http.StatusBadRequest, []string{"error: Malformed form data", `value="enable"`}},
+		{"Missing code", "/totp?jwt=user", "action=enable", http.StatusBadRequest, []string{"error: Missing form data: Code"}},
+		{"Unknown action", "/totp?jwt=user", "action=spanac&code=123456", http.StatusBadRequest, []string{"error: Malformed form data"}},
+		{"Enable", "/totp?jwt=user", "action=enable&code=123456", http.StatusOK, []string{
+			"info: Two-factor authentication enabled",
+			"<li><code>ABCD-EFGH</code></li>",
+			"<li><code>IJKL-MNOP</code></li>",
+		}},
+		{"Enable invalid code", "/totp?jwt=user", "action=enable&code=000000", http.StatusUnauthorized, []string{
+			"error: Invalid code",
+			"<code>GEZDGNBV</code>",
+		}},
+		{"Enable bad token", "/totp?jwt=bad", "action=enable&code=000000", http.StatusUnauthorized, []string{"Token verification failed"}},
+		{"Already enabled", "/totp?jwt=enabled", "action=enable&code=123456", http.StatusConflict, []string{
+			"warning: Two-factor authentication already enabled",
+			`value="disable"`,
+		}},
+		{"Rate limited", "/totp?jwt=user", "action=enable&code=999999", http.StatusTooManyRequests, []string{"Too many attempts"}},
+		{"Internal error", "/totp?jwt=user", "action=enable&code=666666", http.StatusInternalServerError, []string{"Internal server error"}},
+		{"Disable", "/totp?jwt=enabled", "action=disable&code=123456", http.StatusOK, []string{
+			"info: Two-factor authentication disabled",
+			`<a href="/totp?jwt=enabled">`,
+		}},
+		{"Disable invalid code", "/totp?jwt=enabled", "action=disable&code=000000", http.StatusUnauthorized, []string{
+			"error: Invalid code",
+			`value="disable"`,
+		}},
+		{"Not enabled", "/totp?jwt=user", "action=disable&code=123456", http.StatusConflict, []string{
+			"warning: Two-factor authentication not enabled",
+			`value="enable"`,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &Forms{Client: totpClient{}}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", tt.target, strings.NewReader(tt.body))
+			r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+			f.totpPost(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+
+			if resp.StatusCode != tt.wantCode {
+				t.Errorf("Forms.totpPost() status = %v, want: %v", resp.StatusCode, tt.wantCode)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(body), want) {
+					t.Errorf("Forms.totpPost() = \n%s\nmissing %q", body, want)
+				}
+			}
+		})
+	}
+}
+
+func Test_totpHandler_ServeHTTP(t *testing.T) {
+	h := (&Forms{Client: totpClient{}}).TOTPHandler()
+
+	tests := []struct {
+		method   string
+		body     string
+		wantCode int
+	}{
+		{"GET", "", http.StatusOK},
+		{"POST", "action=enable&code=123456", http.StatusOK},
+		{"PUT", "", http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(tt.method, "/totp?jwt=user", strings.NewReader(tt.body))
+			r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+			h.ServeHTTP(w, r)
+
+			if got := w.Result().StatusCode; got != tt.wantCode {
+				t.Errorf("totpHandler.ServeHTTP() status = %v, want: %v", got, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+-- Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+create table auth.permissions (
+	id serial not null primary key,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	name character varying (48) not null,
+	description character varying (120) not null,
+	unique(name)
+);
+
+create table auth.group_permissions (
+	group_id integer not null references auth.groups (id) on delete cascade,
+	permission_id integer not null references auth.permissions (id) on delete cascade,
+	primary key (group_id, permission_id)
+);
+
+-- +migrate Down
+
+drop table auth.group_permissions;
+drop table auth.permissions;
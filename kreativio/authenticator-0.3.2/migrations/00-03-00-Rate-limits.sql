@@ -0,0 +1,48 @@
+-- Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+-- Token buckets of the rate limiter, shared by all replicas.
+-- A bucket is full again at full_at, after which the row can be purged.
+create table auth.rate_limits (
+	key text not null primary key,
+	tokens double precision not null,
+	updated_at timestamp with time zone not null default now(),
+	full_at timestamp with time zone not null default now()
+);
+
+create index rate_limits_full_at_index on auth.rate_limits (full_at);
+
+-- Refill the bucket of k with rate tokens per second, up to burst, and take one token.
+-- Returns the seconds to wait for the next token when the bucket is empty, 0 otherwise.
+
+-- +migrate StatementBegin
+create function auth.take_rate_token(k text, rate double precision, burst double precision) returns double precision as $$
+declare
+	t double precision;
+begin
+	insert into auth.rate_limits as rl (key, tokens) values (k, burst)
+		on conflict (key) do update
+		set tokens = least(burst, rl.tokens + extract(epoch from now() - rl.updated_at) * rate),
+			updated_at = now()
+		returning tokens into t;
+
+	if t < 1 then
+		return (1 - t) / rate;
+	end if;
+
+	update auth.rate_limits
+		set tokens = t - 1,
+			full_at = now() + make_interval(secs => (burst - t + 1) / rate)
+		where key = k;
+	return 0;
+end;
+$$ language plpgsql;
+-- +migrate StatementEnd
+
+-- +migrate Down
+
+drop function auth.take_rate_token(text, double precision, double precision);
+drop table auth.rate_limits;
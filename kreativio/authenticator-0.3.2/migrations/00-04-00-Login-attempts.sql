@@ -0,0 +1,28 @@
+-- Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+alter table auth.users
+	add column failed_logins integer not null default 0,
+	add column locked_until timestamp with time zone null;
+
+create table auth.login_attempts (
+	id serial not null primary key,
+	created_at timestamp with time zone not null,
+	user_id integer not null references auth.users (id) on delete cascade,
+	remote_addr text not null default '',
+	success boolean not null
+);
+
+create index login_attempts_user_index on auth.login_attempts (user_id, created_at);
+create index login_attempts_created_index on auth.login_attempts (created_at);
+
+-- +migrate Down
+
+drop table auth.login_attempts;
+
+alter table auth.users
+	drop column locked_until,
+	drop column failed_logins;
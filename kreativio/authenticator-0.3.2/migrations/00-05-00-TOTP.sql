@@ -0,0 +1,29 @@
+-- Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+create table auth.totp_secrets (
+	id serial not null primary key,
+	user_id integer not null references auth.users (id) on delete cascade,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	secret bytea not null,
+	enabled_at timestamp with time zone null,
+	last_step bigint not null default 0,
+	unique(user_id)
+);
+
+create table auth.recovery_codes (
+	id serial not null primary key,
+	user_id integer not null references auth.users (id) on delete cascade,
+	created_at timestamp with time zone not null,
+	hash bytea not null,
+	unique(user_id, hash)
+);
+
+-- +migrate Down
+
+drop table auth.recovery_codes;
+drop table auth.totp_secrets;
@@ -0,0 +1,37 @@
+-- Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+create table auth.webauthn_credentials (
+	id serial not null primary key,
+	user_id integer not null references auth.users (id) on delete cascade,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	credential_id bytea not null,
+	public_key bytea not null,
+	sign_count bigint not null default 0,
+	name character varying (64) not null default '',
+	last_used_at timestamp with time zone null,
+	unique(credential_id)
+);
+
+create index webauthn_credentials_user_index on auth.webauthn_credentials (user_id);
+
+create table auth.webauthn_challenges (
+	id serial not null primary key,
+	created_at timestamp with time zone not null,
+	expires_at timestamp with time zone not null,
+	challenge bytea not null,
+	ceremony character varying (16) not null,
+	user_id integer null references auth.users (id) on delete cascade,
+	unique(challenge)
+);
+
+create index webauthn_challenges_expires_index on auth.webauthn_challenges (expires_at);
+
+-- +migrate Down
+
+drop table auth.webauthn_challenges;
+drop table auth.webauthn_credentials;
@@ -0,0 +1,38 @@
+-- Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+create table auth.oidc_clients (
+	id serial not null primary key,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	client_id character varying (64) not null,
+	name character varying (64) not null,
+	description text not null default '',
+	secret_hash bytea null,
+	redirect_uris text[] not null default '{}',
+	unique(client_id)
+);
+
+create table auth.oidc_codes (
+	id serial not null primary key,
+	created_at timestamp with time zone not null,
+	expires_at timestamp with time zone not null,
+	code_hash bytea not null,
+	oidc_client_id integer not null references auth.oidc_clients (id) on delete cascade,
+	user_id integer not null references auth.users (id) on delete cascade,
+	redirect_uri text not null,
+	scope text not null,
+	nonce text not null default '',
+	code_challenge character varying (128) not null,
+	unique(code_hash)
+);
+
+create index oidc_codes_expires_index on auth.oidc_codes (expires_at);
+
+-- +migrate Down
+
+drop table auth.oidc_codes;
+drop table auth.oidc_clients;
@@ -1,4 +1,4 @@
-// Code generated by SQLBoiler 4.1.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package models
@@ -15,88 +15,176 @@ func TestParent(t *testing.T) {
 	t.Run("Audiences", testAudiences)
 	t.Run("Groups", testGroups)
 	t.Run("JWTKeys", testJWTKeys)
+	t.Run("LoginAttempts", testLoginAttempts)
+	t.Run("OidcClients", testOidcClients)
+	t.Run("OidcCodes", testOidcCodes)
 	t.Run("Passwords", testPasswords)
+	t.Run("Permissions", testPermissions)
+	t.Run("RecoveryCodes", testRecoveryCodes)
+	t.Run("TotpSecrets", testTotpSecrets)
 	t.Run("Users", testUsers)
+	t.Run("WebauthnChallenges", testWebauthnChallenges)
+	t.Run("WebauthnCredentials", testWebauthnCredentials)
 }
 
 func TestDelete(t *testing.T) {
 	t.Run("Audiences", testAudiencesDelete)
 	t.Run("Groups", testGroupsDelete)
 	t.Run("JWTKeys", testJWTKeysDelete)
+	t.Run("LoginAttempts", testLoginAttemptsDelete)
+	t.Run("OidcClients", testOidcClientsDelete)
+	t.Run("OidcCodes", testOidcCodesDelete)
 	t.Run("Passwords", testPasswordsDelete)
+	t.Run("Permissions", testPermissionsDelete)
+	t.Run("RecoveryCodes", testRecoveryCodesDelete)
+	t.Run("TotpSecrets", testTotpSecretsDelete)
 	t.Run("Users", testUsersDelete)
+	t.Run("WebauthnChallenges", testWebauthnChallengesDelete)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("Audiences", testAudiencesQueryDeleteAll)
 	t.Run("Groups", testGroupsQueryDeleteAll)
 	t.Run("JWTKeys", testJWTKeysQueryDeleteAll)
+	t.Run("LoginAttempts", testLoginAttemptsQueryDeleteAll)
+	t.Run("OidcClients", testOidcClientsQueryDeleteAll)
+	t.Run("OidcCodes", testOidcCodesQueryDeleteAll)
 	t.Run("Passwords", testPasswordsQueryDeleteAll)
+	t.Run("Permissions", testPermissionsQueryDeleteAll)
+	t.Run("RecoveryCodes", testRecoveryCodesQueryDeleteAll)
+	t.Run("TotpSecrets", testTotpSecretsQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
+	t.Run("WebauthnChallenges", testWebauthnChallengesQueryDeleteAll)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("Audiences", testAudiencesSliceDeleteAll)
 	t.Run("Groups", testGroupsSliceDeleteAll)
 	t.Run("JWTKeys", testJWTKeysSliceDeleteAll)
+	t.Run("LoginAttempts", testLoginAttemptsSliceDeleteAll)
+	t.Run("OidcClients", testOidcClientsSliceDeleteAll)
+	t.Run("OidcCodes", testOidcCodesSliceDeleteAll)
 	t.Run("Passwords", testPasswordsSliceDeleteAll)
+	t.Run("Permissions", testPermissionsSliceDeleteAll)
+	t.Run("RecoveryCodes", testRecoveryCodesSliceDeleteAll)
+	t.Run("TotpSecrets", testTotpSecretsSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
+	t.Run("WebauthnChallenges", testWebauthnChallengesSliceDeleteAll)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
 	t.Run("Audiences", testAudiencesExists)
 	t.Run("Groups", testGroupsExists)
 	t.Run("JWTKeys", testJWTKeysExists)
+	t.Run("LoginAttempts", testLoginAttemptsExists)
+	t.Run("OidcClients", testOidcClientsExists)
+	t.Run("OidcCodes", testOidcCodesExists)
 	t.Run("Passwords", testPasswordsExists)
+	t.Run("Permissions", testPermissionsExists)
+	t.Run("RecoveryCodes", testRecoveryCodesExists)
+	t.Run("TotpSecrets", testTotpSecretsExists)
 	t.Run("Users", testUsersExists)
+	t.Run("WebauthnChallenges", testWebauthnChallengesExists)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsExists)
 }
 
 func TestFind(t *testing.T) {
 	t.Run("Audiences", testAudiencesFind)
 	t.Run("Groups", testGroupsFind)
 	t.Run("JWTKeys", testJWTKeysFind)
+	t.Run("LoginAttempts", testLoginAttemptsFind)
+	t.Run("OidcClients", testOidcClientsFind)
+	t.Run("OidcCodes", testOidcCodesFind)
 	t.Run("Passwords", testPasswordsFind)
+	t.Run("Permissions", testPermissionsFind)
+	t.Run("RecoveryCodes", testRecoveryCodesFind)
+	t.Run("TotpSecrets", testTotpSecretsFind)
 	t.Run("Users", testUsersFind)
+	t.Run("WebauthnChallenges", testWebauthnChallengesFind)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsFind)
 }
 
 func TestBind(t *testing.T) {
 	t.Run("Audiences", testAudiencesBind)
 	t.Run("Groups", testGroupsBind)
 	t.Run("JWTKeys", testJWTKeysBind)
+	t.Run("LoginAttempts", testLoginAttemptsBind)
+	t.Run("OidcClients", testOidcClientsBind)
+	t.Run("OidcCodes", testOidcCodesBind)
 	t.Run("Passwords", testPasswordsBind)
+	t.Run("Permissions", testPermissionsBind)
+	t.Run("RecoveryCodes", testRecoveryCodesBind)
+	t.Run("TotpSecrets", testTotpSecretsBind)
 	t.Run("Users", testUsersBind)
+	t.Run("WebauthnChallenges", testWebauthnChallengesBind)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsBind)
 }
 
 func TestOne(t *testing.T) {
 	t.Run("Audiences", testAudiencesOne)
 	t.Run("Groups", testGroupsOne)
 	t.Run("JWTKeys", testJWTKeysOne)
+	t.Run("LoginAttempts", testLoginAttemptsOne)
+	t.Run("OidcClients", testOidcClientsOne)
+	t.Run("OidcCodes", testOidcCodesOne)
 	t.Run("Passwords", testPasswordsOne)
+	t.Run("Permissions", testPermissionsOne)
+	t.Run("RecoveryCodes", testRecoveryCodesOne)
+	t.Run("TotpSecrets", testTotpSecretsOne)
 	t.Run("Users", testUsersOne)
+	t.Run("WebauthnChallenges", testWebauthnChallengesOne)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsOne)
 }
 
 func TestAll(t *testing.T) {
 	t.Run("Audiences", testAudiencesAll)
 	t.Run("Groups", testGroupsAll)
 	t.Run("JWTKeys", testJWTKeysAll)
+	t.Run("LoginAttempts", testLoginAttemptsAll)
+	t.Run("OidcClients", testOidcClientsAll)
+	t.Run("OidcCodes", testOidcCodesAll)
 	t.Run("Passwords", testPasswordsAll)
+	t.Run("Permissions", testPermissionsAll)
+	t.Run("RecoveryCodes", testRecoveryCodesAll)
+	t.Run("TotpSecrets", testTotpSecretsAll)
 	t.Run("Users", testUsersAll)
+	t.Run("WebauthnChallenges", testWebauthnChallengesAll)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsAll)
 }
 
 func TestCount(t *testing.T) {
 	t.Run("Audiences", testAudiencesCount)
 	t.Run("Groups", testGroupsCount)
 	t.Run("JWTKeys", testJWTKeysCount)
+	t.Run("LoginAttempts", testLoginAttemptsCount)
+	t.Run("OidcClients", testOidcClientsCount)
+	t.Run("OidcCodes", testOidcCodesCount)
 	t.Run("Passwords", testPasswordsCount)
+	t.Run("Permissions", testPermissionsCount)
+	t.Run("RecoveryCodes", testRecoveryCodesCount)
+	t.Run("TotpSecrets", testTotpSecretsCount)
 	t.Run("Users", testUsersCount)
+	t.Run("WebauthnChallenges", testWebauthnChallengesCount)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsCount)
 }
 
 func TestHooks(t *testing.T) {
 	t.Run("Audiences", testAudiencesHooks)
 	t.Run("Groups", testGroupsHooks)
 	t.Run("JWTKeys", testJWTKeysHooks)
+	t.Run("LoginAttempts", testLoginAttemptsHooks)
+	t.Run("OidcClients", testOidcClientsHooks)
+	t.Run("OidcCodes", testOidcCodesHooks)
 	t.Run("Passwords", testPasswordsHooks)
+	t.Run("Permissions", testPermissionsHooks)
+	t.Run("RecoveryCodes", testRecoveryCodesHooks)
+	t.Run("TotpSecrets", testTotpSecretsHooks)
 	t.Run("Users", testUsersHooks)
+	t.Run("WebauthnChallenges", testWebauthnChallengesHooks)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsHooks)
 }
 
 func TestInsert(t *testing.T) {
@@ -106,47 +194,89 @@ func TestInsert(t *testing.T) {
 	t.Run("Groups", testGroupsInsertWhitelist)
 	t.Run("JWTKeys", testJWTKeysInsert)
 	t.Run("JWTKeys", testJWTKeysInsertWhitelist)
+	t.Run("LoginAttempts", testLoginAttemptsInsert)
+	t.Run("LoginAttempts", testLoginAttemptsInsertWhitelist)
+	t.Run("OidcClients", testOidcClientsInsert)
+	t.Run("OidcClients", testOidcClientsInsertWhitelist)
+	t.Run("OidcCodes", testOidcCodesInsert)
+	t.Run("OidcCodes", testOidcCodesInsertWhitelist)
 	t.Run("Passwords", testPasswordsInsert)
 	t.Run("Passwords", testPasswordsInsertWhitelist)
+	t.Run("Permissions", testPermissionsInsert)
+	t.Run("Permissions", testPermissionsInsertWhitelist)
+	t.Run("RecoveryCodes", testRecoveryCodesInsert)
+	t.Run("RecoveryCodes", testRecoveryCodesInsertWhitelist)
+	t.Run("TotpSecrets", testTotpSecretsInsert)
+	t.Run("TotpSecrets", testTotpSecretsInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
+	t.Run("WebauthnChallenges", testWebauthnChallengesInsert)
+	t.Run("WebauthnChallenges", testWebauthnChallengesInsertWhitelist)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsInsert)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsInsertWhitelist)
 }
 
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("LoginAttemptToUserUsingUser", testLoginAttemptToOneUserUsingUser)
+	t.Run("OidcCodeToOidcClientUsingOidcClient", testOidcCodeToOneOidcClientUsingOidcClient)
+	t.Run("OidcCodeToUserUsingUser", testOidcCodeToOneUserUsingUser)
 	t.Run("PasswordToUserUsingUser", testPasswordToOneUserUsingUser)
+	t.Run("RecoveryCodeToUserUsingUser", testRecoveryCodeToOneUserUsingUser)
+	t.Run("TotpSecretToUserUsingUser", testTotpSecretToOneUserUsingUser)
+	t.Run("WebauthnChallengeToUserUsingUser", testWebauthnChallengeToOneUserUsingUser)
+	t.Run("WebauthnCredentialToUserUsingUser", testWebauthnCredentialToOneUserUsingUser)
 }
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestOneToOne(t *testing.T) {
 	t.Run("UserToPasswordUsingPassword", testUserOneToOnePasswordUsingPassword)
+	t.Run("UserToTotpSecretUsingTotpSecret", testUserOneToOneTotpSecretUsingTotpSecret)
 }
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
 	t.Run("AudienceToUsers", testAudienceToManyUsers)
+	t.Run("GroupToPermissions", testGroupToManyPermissions)
 	t.Run("GroupToUsers", testGroupToManyUsers)
+	t.Run("OidcClientToOidcCodes", testOidcClientToManyOidcCodes)
+	t.Run("PermissionToGroups", testPermissionToManyGroups)
+	t.Run("UserToLoginAttempts", testUserToManyLoginAttempts)
+	t.Run("UserToOidcCodes", testUserToManyOidcCodes)
+	t.Run("UserToRecoveryCodes", testUserToManyRecoveryCodes)
 	t.Run("UserToAudiences", testUserToManyAudiences)
 	t.Run("UserToGroups", testUserToManyGroups)
+	t.Run("UserToWebauthnChallenges", testUserToManyWebauthnChallenges)
+	t.Run("UserToWebauthnCredentials", testUserToManyWebauthnCredentials)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("LoginAttemptToUserUsingLoginAttempts", testLoginAttemptToOneSetOpUserUsingUser)
+	t.Run("OidcCodeToOidcClientUsingOidcCodes", testOidcCodeToOneSetOpOidcClientUsingOidcClient)
+	t.Run("OidcCodeToUserUsingOidcCodes", testOidcCodeToOneSetOpUserUsingUser)
 	t.Run("PasswordToUserUsingPassword", testPasswordToOneSetOpUserUsingUser)
+	t.Run("RecoveryCodeToUserUsingRecoveryCodes", testRecoveryCodeToOneSetOpUserUsingUser)
+	t.Run("TotpSecretToUserUsingTotpSecret", testTotpSecretToOneSetOpUserUsingUser)
+	t.Run("WebauthnChallengeToUserUsingWebauthnChallenges", testWebauthnChallengeToOneSetOpUserUsingUser)
+	t.Run("WebauthnCredentialToUserUsingWebauthnCredentials", testWebauthnCredentialToOneSetOpUserUsingUser)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("WebauthnChallengeToUserUsingWebauthnChallenges", testWebauthnChallengeToOneRemoveOpUserUsingUser)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestOneToOneSet(t *testing.T) {
 	t.Run("UserToPasswordUsingPassword", testUserOneToOneSetOpPasswordUsingPassword)
+	t.Run("UserToTotpSecretUsingTotpSecret", testUserOneToOneSetOpTotpSecretUsingTotpSecret)
 }
 
 // TestOneToOneRemove tests cannot be run in parallel
@@ -157,65 +287,119 @@ func TestOneToOneRemove(t *testing.T) {}
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
 	t.Run("AudienceToUsers", testAudienceToManyAddOpUsers)
+	t.Run("GroupToPermissions", testGroupToManyAddOpPermissions)
 	t.Run("GroupToUsers", testGroupToManyAddOpUsers)
+	t.Run("OidcClientToOidcCodes", testOidcClientToManyAddOpOidcCodes)
+	t.Run("PermissionToGroups", testPermissionToManyAddOpGroups)
+	t.Run("UserToLoginAttempts", testUserToManyAddOpLoginAttempts)
+	t.Run("UserToOidcCodes", testUserToManyAddOpOidcCodes)
+	t.Run("UserToRecoveryCodes", testUserToManyAddOpRecoveryCodes)
 	t.Run("UserToAudiences", testUserToManyAddOpAudiences)
 	t.Run("UserToGroups", testUserToManyAddOpGroups)
+	t.Run("UserToWebauthnChallenges", testUserToManyAddOpWebauthnChallenges)
+	t.Run("UserToWebauthnCredentials", testUserToManyAddOpWebauthnCredentials)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManySet(t *testing.T) {
 	t.Run("AudienceToUsers", testAudienceToManySetOpUsers)
+	t.Run("GroupToPermissions", testGroupToManySetOpPermissions)
 	t.Run("GroupToUsers", testGroupToManySetOpUsers)
+	t.Run("PermissionToGroups", testPermissionToManySetOpGroups)
 	t.Run("UserToAudiences", testUserToManySetOpAudiences)
 	t.Run("UserToGroups", testUserToManySetOpGroups)
+	t.Run("UserToWebauthnChallenges", testUserToManySetOpWebauthnChallenges)
 }
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyRemove(t *testing.T) {
 	t.Run("AudienceToUsers", testAudienceToManyRemoveOpUsers)
+	t.Run("GroupToPermissions", testGroupToManyRemoveOpPermissions)
 	t.Run("GroupToUsers", testGroupToManyRemoveOpUsers)
+	t.Run("PermissionToGroups", testPermissionToManyRemoveOpGroups)
 	t.Run("UserToAudiences", testUserToManyRemoveOpAudiences)
 	t.Run("UserToGroups", testUserToManyRemoveOpGroups)
+	t.Run("UserToWebauthnChallenges", testUserToManyRemoveOpWebauthnChallenges)
 }
 
 func TestReload(t *testing.T) {
 	t.Run("Audiences", testAudiencesReload)
 	t.Run("Groups", testGroupsReload)
 	t.Run("JWTKeys", testJWTKeysReload)
+	t.Run("LoginAttempts", testLoginAttemptsReload)
+	t.Run("OidcClients", testOidcClientsReload)
+	t.Run("OidcCodes", testOidcCodesReload)
 	t.Run("Passwords", testPasswordsReload)
+	t.Run("Permissions", testPermissionsReload)
+	t.Run("RecoveryCodes", testRecoveryCodesReload)
+	t.Run("TotpSecrets", testTotpSecretsReload)
 	t.Run("Users", testUsersReload)
+	t.Run("WebauthnChallenges", testWebauthnChallengesReload)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsReload)
 }
 
 func TestReloadAll(t *testing.T) {
 	t.Run("Audiences", testAudiencesReloadAll)
 	t.Run("Groups", testGroupsReloadAll)
 	t.Run("JWTKeys", testJWTKeysReloadAll)
+	t.Run("LoginAttempts", testLoginAttemptsReloadAll)
+	t.Run("OidcClients", testOidcClientsReloadAll)
+	t.Run("OidcCodes", testOidcCodesReloadAll)
 	t.Run("Passwords", testPasswordsReloadAll)
+	t.Run("Permissions", testPermissionsReloadAll)
+	t.Run("RecoveryCodes", testRecoveryCodesReloadAll)
+	t.Run("TotpSecrets", testTotpSecretsReloadAll)
 	t.Run("Users", testUsersReloadAll)
+	t.Run("WebauthnChallenges", testWebauthnChallengesReloadAll)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsReloadAll)
 }
 
 func TestSelect(t *testing.T) {
 	t.Run("Audiences", testAudiencesSelect)
 	t.Run("Groups", testGroupsSelect)
 	t.Run("JWTKeys", testJWTKeysSelect)
+	t.Run("LoginAttempts", testLoginAttemptsSelect)
+	t.Run("OidcClients", testOidcClientsSelect)
+	t.Run("OidcCodes", testOidcCodesSelect)
 	t.Run("Passwords", testPasswordsSelect)
+	t.Run("Permissions", testPermissionsSelect)
+	t.Run("RecoveryCodes", testRecoveryCodesSelect)
+	t.Run("TotpSecrets", testTotpSecretsSelect)
 	t.Run("Users", testUsersSelect)
+	t.Run("WebauthnChallenges", testWebauthnChallengesSelect)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsSelect)
 }
 
 func TestUpdate(t *testing.T) {
 	t.Run("Audiences", testAudiencesUpdate)
 	t.Run("Groups", testGroupsUpdate)
 	t.Run("JWTKeys", testJWTKeysUpdate)
+	t.Run("LoginAttempts", testLoginAttemptsUpdate)
+	t.Run("OidcClients", testOidcClientsUpdate)
+	t.Run("OidcCodes", testOidcCodesUpdate)
 	t.Run("Passwords", testPasswordsUpdate)
+	t.Run("Permissions", testPermissionsUpdate)
+	t.Run("RecoveryCodes", testRecoveryCodesUpdate)
+	t.Run("TotpSecrets", testTotpSecretsUpdate)
 	t.Run("Users", testUsersUpdate)
+	t.Run("WebauthnChallenges", testWebauthnChallengesUpdate)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("Audiences", testAudiencesSliceUpdateAll)
 	t.Run("Groups", testGroupsSliceUpdateAll)
 	t.Run("JWTKeys", testJWTKeysSliceUpdateAll)
+	t.Run("LoginAttempts", testLoginAttemptsSliceUpdateAll)
+	t.Run("OidcClients", testOidcClientsSliceUpdateAll)
+	t.Run("OidcCodes", testOidcCodesSliceUpdateAll)
 	t.Run("Passwords", testPasswordsSliceUpdateAll)
+	t.Run("Permissions", testPermissionsSliceUpdateAll)
+	t.Run("RecoveryCodes", testRecoveryCodesSliceUpdateAll)
+	t.Run("TotpSecrets", testTotpSecretsSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
+	t.Run("WebauthnChallenges", testWebauthnChallengesSliceUpdateAll)
+	t.Run("WebauthnCredentials", testWebauthnCredentialsSliceUpdateAll)
 }
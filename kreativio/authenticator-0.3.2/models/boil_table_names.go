@@ -1,22 +1,40 @@
-// Code generated by SQLBoiler 4.1.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package models
 
 var TableNames = struct {
-	Audiences     string
-	Groups        string
-	JWTKeys       string
-	Passwords     string
-	UserAudiences string
-	UserGroups    string
-	Users         string
+	Audiences           string
+	GroupPermissions    string
+	Groups              string
+	JWTKeys             string
+	LoginAttempts       string
+	OidcClients         string
+	OidcCodes           string
+	Passwords           string
+	Permissions         string
+	RecoveryCodes       string
+	TotpSecrets         string
+	UserAudiences       string
+	UserGroups          string
+	Users               string
+	WebauthnChallenges  string
+	WebauthnCredentials string
 }{
-	Audiences:     "audiences",
-	Groups:        "groups",
-	JWTKeys:       "jwt_keys",
-	Passwords:     "passwords",
-	UserAudiences: "user_audiences",
-	UserGroups:    "user_groups",
-	Users:         "users",
+	Audiences:           "audiences",
+	GroupPermissions:    "group_permissions",
+	Groups:              "groups",
+	JWTKeys:             "jwt_keys",
+	LoginAttempts:       "login_attempts",
+	OidcClients:         "oidc_clients",
+	OidcCodes:           "oidc_codes",
+	Passwords:           "passwords",
+	Permissions:         "permissions",
+	RecoveryCodes:       "recovery_codes",
+	TotpSecrets:         "totp_secrets",
+	UserAudiences:       "user_audiences",
+	UserGroups:          "user_groups",
+	Users:               "users",
+	WebauthnChallenges:  "webauthn_challenges",
+	WebauthnCredentials: "webauthn_credentials",
 }
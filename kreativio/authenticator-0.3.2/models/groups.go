@@ -1,4 +1,4 @@
-// Code generated by SQLBoiler 4.1.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package models
@@ -65,14 +65,17 @@ var GroupWhere = struct {
 
 // GroupRels is where relationship names are stored.
 var GroupRels = struct {
-	Users string
+	Permissions string
+	Users       string
 }{
-	Users: "Users",
+	Permissions: "Permissions",
+	Users:       "Users",
 }
 
 // groupR is where relationships are stored.
 type groupR struct {
-	Users UserSlice `boil:"Users" json:"Users" toml:"Users" yaml:"Users"`
+	Permissions PermissionSlice `boil:"Permissions" json:"Permissions" toml:"Permissions" yaml:"Permissions"`
+	Users       UserSlice       `boil:"Users" json:"Users" toml:"Users" yaml:"Users"`
 }
 
 // NewStruct creates a new relationship struct
@@ -365,6 +368,28 @@ func (q groupQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool
 	return count > 0, nil
 }
 
+// Permissions retrieves all the permission's Permissions with an executor.
+func (o *Group) Permissions(mods ...qm.QueryMod) permissionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"auth\".\"group_permissions\" on \"auth\".\"permissions\".\"id\" = \"auth\".\"group_permissions\".\"permission_id\""),
+		qm.Where("\"auth\".\"group_permissions\".\"group_id\"=?", o.ID),
+	)
+
+	query := Permissions(queryMods...)
+	queries.SetFrom(query.Query, "\"auth\".\"permissions\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"auth\".\"permissions\".*"})
+	}
+
+	return query
+}
+
 // Users retrieves all the user's Users with an executor.
 func (o *Group) Users(mods ...qm.QueryMod) userQuery {
 	var queryMods []qm.QueryMod
@@ -387,6 +412,121 @@ func (o *Group) Users(mods ...qm.QueryMod) userQuery {
 	return query
 }
 
+// LoadPermissions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupL) LoadPermissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+	var slice []*Group
+	var object *Group
+
+	if singular {
+		object = maybeGroup.(*Group)
+	} else {
+		slice = *maybeGroup.(*[]*Group)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &groupR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("\"auth\".\"permissions\".*, \"a\".\"group_id\""),
+		qm.From("\"auth\".\"permissions\""),
+		qm.InnerJoin("\"auth\".\"group_permissions\" as \"a\" on \"auth\".\"permissions\".\"id\" = \"a\".\"permission_id\""),
+		qm.WhereIn("\"a\".\"group_id\" in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load permissions")
+	}
+
+	var resultSlice []*Permission
+
+	var localJoinCols []int
+	for results.Next() {
+		one := new(Permission)
+		var localJoinCol int
+
+		err = results.Scan(&one.ID, &one.CreatedAt, &one.UpdatedAt, &one.Name, &one.Description, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for permissions")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice permissions")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on permissions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for permissions")
+	}
+
+	if len(permissionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Permissions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &permissionR{}
+			}
+			foreign.R.Groups = append(foreign.R.Groups, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Permissions = append(local.R.Permissions, foreign)
+				if foreign.R == nil {
+					foreign.R = &permissionR{}
+				}
+				foreign.R.Groups = append(foreign.R.Groups, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUsers allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (groupL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
@@ -448,7 +588,7 @@ func (groupL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular bo
 		one := new(User)
 		var localJoinCol int
 
-		err = results.Scan(&one.ID, &one.Email, &one.Name, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
+		err = results.Scan(&one.ID, &one.Email, &one.Name, &one.CreatedAt, &one.UpdatedAt, &one.FailedLogins, &one.LockedUntil, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for users")
 		}
@@ -502,6 +642,146 @@ func (groupL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
+// AddPermissions adds the given related objects to the existing relationships
+// of the group, optionally inserting them as new records.
+// Appends related to o.R.Permissions.
+// Sets related.R.Groups appropriately.
+func (o *Group) AddPermissions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Permission) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"auth\".\"group_permissions\" (\"group_id\", \"permission_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &groupR{
+			Permissions: related,
+		}
+	} else {
+		o.R.Permissions = append(o.R.Permissions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &permissionR{
+				Groups: GroupSlice{o},
+			}
+		} else {
+			rel.R.Groups = append(rel.R.Groups, o)
+		}
+	}
+	return nil
+}
+
+// SetPermissions removes all previously related items of the
+// group replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Groups's Permissions accordingly.
+// Replaces o.R.Permissions with related.
+// Sets related.R.Groups's Permissions accordingly.
+func (o *Group) SetPermissions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Permission) error {
+	query := "delete from \"auth\".\"group_permissions\" where \"group_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removePermissionsFromGroupsSlice(o, related)
+	if o.R != nil {
+		o.R.Permissions = nil
+	}
+	return o.AddPermissions(ctx, exec, insert, related...)
+}
+
+// RemovePermissions relationships from objects passed in.
+// Removes related items from R.Permissions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Groups.
+func (o *Group) RemovePermissions(ctx context.Context, exec boil.ContextExecutor, related ...*Permission) error {
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"auth\".\"group_permissions\" where \"group_id\" = $1 and \"permission_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removePermissionsFromGroupsSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Permissions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Permissions)
+			if ln > 1 && i < ln-1 {
+				o.R.Permissions[i] = o.R.Permissions[ln-1]
+			}
+			o.R.Permissions = o.R.Permissions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removePermissionsFromGroupsSlice(o *Group, related []*Permission) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Groups {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Groups)
+			if ln > 1 && i < ln-1 {
+				rel.R.Groups[i] = rel.R.Groups[ln-1]
+			}
+			rel.R.Groups = rel.R.Groups[:ln-1]
+			break
+		}
+	}
+}
+
 // AddUsers adds the given related objects to the existing relationships
 // of the group, optionally inserting them as new records.
 // Appends related to o.R.Users.
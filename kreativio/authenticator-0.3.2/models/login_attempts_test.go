@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testLoginAttempts(t *testing.T) {
+	t.Parallel()
+
+	query := LoginAttempts()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testLoginAttemptsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LoginAttempt{}
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, true, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LoginAttempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLoginAttemptsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LoginAttempt{}
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, true, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := LoginAttempts().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LoginAttempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLoginAttemptsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LoginAttempt{}
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, true, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LoginAttemptSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LoginAttempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLoginAttemptsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LoginAttempt{}
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, true, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := LoginAttemptExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if LoginAttempt exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected LoginAttemptExists to return true, but got false.")
+	}
+}
+
+func testLoginAttemptsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LoginAttempt{}
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, true, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	loginAttemptFound, err := FindLoginAttempt(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if loginAttemptFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testLoginAttemptsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LoginAttempt{}
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, true, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = LoginAttempts().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLoginAttemptsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LoginAttempt{}
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, true, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := LoginAttempts().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testLoginAttemptsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	loginAttemptOne := &LoginAttempt{}
+	loginAttemptTwo := &LoginAttempt{}
+	if err = randomize.Struct(seed, loginAttemptOne, loginAttemptDBTypes, false, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+	if err = randomize.Struct(seed, loginAttemptTwo, loginAttemptDBTypes, false, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = loginAttemptOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = loginAttemptTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LoginAttempts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testLoginAttemptsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	loginAttemptOne := &LoginAttempt{}
+	loginAttemptTwo := &LoginAttempt{}
+	if err = randomize.Struct(seed, loginAttemptOne, loginAttemptDBTypes, false, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+	if err = randomize.Struct(seed, loginAttemptTwo, loginAttemptDBTypes, false, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = loginAttemptOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = loginAttemptTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LoginAttempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func loginAttemptBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *LoginAttempt) error {
+	*o = LoginAttempt{}
+	return nil
+}
+
+func loginAttemptAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *LoginAttempt) error {
+	*o = LoginAttempt{}
+	return nil
+}
+
+func loginAttemptAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *LoginAttempt) error {
+	*o = LoginAttempt{}
+	return nil
+}
+
+func loginAttemptBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *LoginAttempt) error {
+	*o = LoginAttempt{}
+	return nil
+}
+
+func loginAttemptAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *LoginAttempt) error {
+	*o = LoginAttempt{}
+	return nil
+}
+
+func loginAttemptBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *LoginAttempt) error {
+	*o = LoginAttempt{}
+	return nil
+}
+
+func loginAttemptAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *LoginAttempt) error {
+	*o = LoginAttempt{}
+	return nil
+}
+
+func loginAttemptBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *LoginAttempt) error {
+	*o = LoginAttempt{}
+	return nil
+}
+
+func loginAttemptAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *LoginAttempt) error {
+	*o = LoginAttempt{}
+	return nil
+}
+
+func testLoginAttemptsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &LoginAttempt{}
+	o := &LoginAttempt{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt object: %s", err)
+	}
+
+	AddLoginAttemptHook(boil.BeforeInsertHook, loginAttemptBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	loginAttemptBeforeInsertHooks = []LoginAttemptHook{}
+
+	AddLoginAttemptHook(boil.AfterInsertHook, loginAttemptAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	loginAttemptAfterInsertHooks = []LoginAttemptHook{}
+
+	AddLoginAttemptHook(boil.AfterSelectHook, loginAttemptAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	loginAttemptAfterSelectHooks = []LoginAttemptHook{}
+
+	AddLoginAttemptHook(boil.BeforeUpdateHook, loginAttemptBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	loginAttemptBeforeUpdateHooks = []LoginAttemptHook{}
+
+	AddLoginAttemptHook(boil.AfterUpdateHook, loginAttemptAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	loginAttemptAfterUpdateHooks = []LoginAttemptHook{}
+
+	AddLoginAttemptHook(boil.BeforeDeleteHook, loginAttemptBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	loginAttemptBeforeDeleteHooks = []LoginAttemptHook{}
+
+	AddLoginAttemptHook(boil.AfterDeleteHook, loginAttemptAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	loginAttemptAfterDeleteHooks = []LoginAttemptHook{}
+
+	AddLoginAttemptHook(boil.BeforeUpsertHook, loginAttemptBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	loginAttemptBeforeUpsertHooks = []LoginAttemptHook{}
+
+	AddLoginAttemptHook(boil.AfterUpsertHook, loginAttemptAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	loginAttemptAfterUpsertHooks = []LoginAttemptHook{}
+}
+
+func testLoginAttemptsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LoginAttempt{}
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, true, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LoginAttempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLoginAttemptsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LoginAttempt{}
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(loginAttemptColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LoginAttempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLoginAttemptToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local LoginAttempt
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, loginAttemptDBTypes, false, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := LoginAttemptSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*LoginAttempt)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testLoginAttemptToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a LoginAttempt
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, loginAttemptDBTypes, false, strmangle.SetComplement(loginAttemptPrimaryKeyColumns, loginAttemptColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.LoginAttempts[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testLoginAttemptsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LoginAttempt{}
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, true, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLoginAttemptsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LoginAttempt{}
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, true, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LoginAttemptSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLoginAttemptsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LoginAttempt{}
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, true, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LoginAttempts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	loginAttemptDBTypes = map[string]string{`ID`: `integer`, `CreatedAt`: `timestamp with time zone`, `UserID`: `integer`, `RemoteAddr`: `text`, `Success`: `boolean`}
+	_                   = bytes.MinRead
+)
+
+func testLoginAttemptsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(loginAttemptPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(loginAttemptAllColumns) == len(loginAttemptPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LoginAttempt{}
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, true, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LoginAttempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, true, loginAttemptPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testLoginAttemptsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(loginAttemptAllColumns) == len(loginAttemptPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LoginAttempt{}
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, true, loginAttemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LoginAttempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, loginAttemptDBTypes, true, loginAttemptPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(loginAttemptAllColumns, loginAttemptPrimaryKeyColumns) {
+		fields = loginAttemptAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			loginAttemptAllColumns,
+			loginAttemptPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := LoginAttemptSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testLoginAttemptsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(loginAttemptAllColumns) == len(loginAttemptPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := LoginAttempt{}
+	if err = randomize.Struct(seed, &o, loginAttemptDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LoginAttempt: %s", err)
+	}
+
+	count, err := LoginAttempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, loginAttemptDBTypes, false, loginAttemptPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LoginAttempt struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LoginAttempt: %s", err)
+	}
+
+	count, err = LoginAttempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
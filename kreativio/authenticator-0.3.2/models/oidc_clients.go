@@ -0,0 +1,1144 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// OidcClient is an object representing the database table.
+type OidcClient struct {
+	ID           int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt    time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ClientID     string            `boil:"client_id" json:"client_id" toml:"client_id" yaml:"client_id"`
+	Name         string            `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Description  string            `boil:"description" json:"description" toml:"description" yaml:"description"`
+	SecretHash   null.Bytes        `boil:"secret_hash" json:"secret_hash,omitempty" toml:"secret_hash" yaml:"secret_hash,omitempty"`
+	RedirectUris types.StringArray `boil:"redirect_uris" json:"redirect_uris" toml:"redirect_uris" yaml:"redirect_uris"`
+
+	R *oidcClientR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L oidcClientL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OidcClientColumns = struct {
+	ID           string
+	CreatedAt    string
+	UpdatedAt    string
+	ClientID     string
+	Name         string
+	Description  string
+	SecretHash   string
+	RedirectUris string
+}{
+	ID:           "id",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+	ClientID:     "client_id",
+	Name:         "name",
+	Description:  "description",
+	SecretHash:   "secret_hash",
+	RedirectUris: "redirect_uris",
+}
+
+// Generated where
+
+type whereHelpernull_Bytes struct{ field string }
+
+func (w whereHelpernull_Bytes) EQ(x null.Bytes) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Bytes) NEQ(x null.Bytes) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Bytes) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Bytes) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Bytes) LT(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Bytes) LTE(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Bytes) GT(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Bytes) GTE(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpertypes_StringArray struct{ field string }
+
+func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var OidcClientWhere = struct {
+	ID           whereHelperint
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpertime_Time
+	ClientID     whereHelperstring
+	Name         whereHelperstring
+	Description  whereHelperstring
+	SecretHash   whereHelpernull_Bytes
+	RedirectUris whereHelpertypes_StringArray
+}{
+	ID:           whereHelperint{field: "\"auth\".\"oidc_clients\".\"id\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"auth\".\"oidc_clients\".\"created_at\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"auth\".\"oidc_clients\".\"updated_at\""},
+	ClientID:     whereHelperstring{field: "\"auth\".\"oidc_clients\".\"client_id\""},
+	Name:         whereHelperstring{field: "\"auth\".\"oidc_clients\".\"name\""},
+	Description:  whereHelperstring{field: "\"auth\".\"oidc_clients\".\"description\""},
+	SecretHash:   whereHelpernull_Bytes{field: "\"auth\".\"oidc_clients\".\"secret_hash\""},
+	RedirectUris: whereHelpertypes_StringArray{field: "\"auth\".\"oidc_clients\".\"redirect_uris\""},
+}
+
+// OidcClientRels is where relationship names are stored.
+var OidcClientRels = struct {
+	OidcCodes string
+}{
+	OidcCodes: "OidcCodes",
+}
+
+// oidcClientR is where relationships are stored.
+type oidcClientR struct {
+	OidcCodes OidcCodeSlice `boil:"OidcCodes" json:"OidcCodes" toml:"OidcCodes" yaml:"OidcCodes"`
+}
+
+// NewStruct creates a new relationship struct
+func (*oidcClientR) NewStruct() *oidcClientR {
+	return &oidcClientR{}
+}
+
+// oidcClientL is where Load methods for each relationship are stored.
+type oidcClientL struct{}
+
+var (
+	oidcClientAllColumns            = []string{"id", "created_at", "updated_at", "client_id", "name", "description", "secret_hash", "redirect_uris"}
+	oidcClientColumnsWithoutDefault = []string{"created_at", "updated_at", "client_id", "name", "secret_hash"}
+	oidcClientColumnsWithDefault    = []string{"id", "description", "redirect_uris"}
+	oidcClientPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// OidcClientSlice is an alias for a slice of pointers to OidcClient.
+	// This should generally be used opposed to []OidcClient.
+	OidcClientSlice []*OidcClient
+	// OidcClientHook is the signature for custom OidcClient hook methods
+	OidcClientHook func(context.Context, boil.ContextExecutor, *OidcClient) error
+
+	oidcClientQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	oidcClientType                 = reflect.TypeOf(&OidcClient{})
+	oidcClientMapping              = queries.MakeStructMapping(oidcClientType)
+	oidcClientPrimaryKeyMapping, _ = queries.BindMapping(oidcClientType, oidcClientMapping, oidcClientPrimaryKeyColumns)
+	oidcClientInsertCacheMut       sync.RWMutex
+	oidcClientInsertCache          = make(map[string]insertCache)
+	oidcClientUpdateCacheMut       sync.RWMutex
+	oidcClientUpdateCache          = make(map[string]updateCache)
+	oidcClientUpsertCacheMut       sync.RWMutex
+	oidcClientUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var oidcClientBeforeInsertHooks []OidcClientHook
+var oidcClientBeforeUpdateHooks []OidcClientHook
+var oidcClientBeforeDeleteHooks []OidcClientHook
+var oidcClientBeforeUpsertHooks []OidcClientHook
+
+var oidcClientAfterInsertHooks []OidcClientHook
+var oidcClientAfterSelectHooks []OidcClientHook
+var oidcClientAfterUpdateHooks []OidcClientHook
+var oidcClientAfterDeleteHooks []OidcClientHook
+var oidcClientAfterUpsertHooks []OidcClientHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OidcClient) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcClientBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OidcClient) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcClientBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OidcClient) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcClientBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OidcClient) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcClientBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OidcClient) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcClientAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OidcClient) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcClientAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OidcClient) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcClientAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OidcClient) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcClientAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OidcClient) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcClientAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOidcClientHook registers your hook function for all future operations.
+func AddOidcClientHook(hookPoint boil.HookPoint, oidcClientHook OidcClientHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		oidcClientBeforeInsertHooks = append(oidcClientBeforeInsertHooks, oidcClientHook)
+	case boil.BeforeUpdateHook:
+		oidcClientBeforeUpdateHooks = append(oidcClientBeforeUpdateHooks, oidcClientHook)
+	case boil.BeforeDeleteHook:
+		oidcClientBeforeDeleteHooks = append(oidcClientBeforeDeleteHooks, oidcClientHook)
+	case boil.BeforeUpsertHook:
+		oidcClientBeforeUpsertHooks = append(oidcClientBeforeUpsertHooks, oidcClientHook)
+	case boil.AfterInsertHook:
+		oidcClientAfterInsertHooks = append(oidcClientAfterInsertHooks, oidcClientHook)
+	case boil.AfterSelectHook:
+		oidcClientAfterSelectHooks = append(oidcClientAfterSelectHooks, oidcClientHook)
+	case boil.AfterUpdateHook:
+		oidcClientAfterUpdateHooks = append(oidcClientAfterUpdateHooks, oidcClientHook)
+	case boil.AfterDeleteHook:
+		oidcClientAfterDeleteHooks = append(oidcClientAfterDeleteHooks, oidcClientHook)
+	case boil.AfterUpsertHook:
+		oidcClientAfterUpsertHooks = append(oidcClientAfterUpsertHooks, oidcClientHook)
+	}
+}
+
+// One returns a single oidcClient record from the query.
+func (q oidcClientQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OidcClient, error) {
+	o := &OidcClient{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for oidc_clients")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OidcClient records from the query.
+func (q oidcClientQuery) All(ctx context.Context, exec boil.ContextExecutor) (OidcClientSlice, error) {
+	var o []*OidcClient
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to OidcClient slice")
+	}
+
+	if len(oidcClientAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OidcClient records in the query.
+func (q oidcClientQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count oidc_clients rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q oidcClientQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if oidc_clients exists")
+	}
+
+	return count > 0, nil
+}
+
+// OidcCodes retrieves all the oidc_code's OidcCodes with an executor.
+func (o *OidcClient) OidcCodes(mods ...qm.QueryMod) oidcCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"auth\".\"oidc_codes\".\"oidc_client_id\"=?", o.ID),
+	)
+
+	query := OidcCodes(queryMods...)
+	queries.SetFrom(query.Query, "\"auth\".\"oidc_codes\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"auth\".\"oidc_codes\".*"})
+	}
+
+	return query
+}
+
+// LoadOidcCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (oidcClientL) LoadOidcCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOidcClient interface{}, mods queries.Applicator) error {
+	var slice []*OidcClient
+	var object *OidcClient
+
+	if singular {
+		object = maybeOidcClient.(*OidcClient)
+	} else {
+		slice = *maybeOidcClient.(*[]*OidcClient)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oidcClientR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oidcClientR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`auth.oidc_codes`),
+		qm.WhereIn(`auth.oidc_codes.oidc_client_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load oidc_codes")
+	}
+
+	var resultSlice []*OidcCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice oidc_codes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on oidc_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oidc_codes")
+	}
+
+	if len(oidcCodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OidcCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &oidcCodeR{}
+			}
+			foreign.R.OidcClient = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OidcClientID {
+				local.R.OidcCodes = append(local.R.OidcCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &oidcCodeR{}
+				}
+				foreign.R.OidcClient = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddOidcCodes adds the given related objects to the existing relationships
+// of the oidc_client, optionally inserting them as new records.
+// Appends related to o.R.OidcCodes.
+// Sets related.R.OidcClient appropriately.
+func (o *OidcClient) AddOidcCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OidcCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OidcClientID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"auth\".\"oidc_codes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"oidc_client_id"}),
+				strmangle.WhereClause("\"", "\"", 2, oidcCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OidcClientID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &oidcClientR{
+			OidcCodes: related,
+		}
+	} else {
+		o.R.OidcCodes = append(o.R.OidcCodes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oidcCodeR{
+				OidcClient: o,
+			}
+		} else {
+			rel.R.OidcClient = o
+		}
+	}
+	return nil
+}
+
+// OidcClients retrieves all the records using an executor.
+func OidcClients(mods ...qm.QueryMod) oidcClientQuery {
+	mods = append(mods, qm.From("\"auth\".\"oidc_clients\""))
+	return oidcClientQuery{NewQuery(mods...)}
+}
+
+// FindOidcClient retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOidcClient(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*OidcClient, error) {
+	oidcClientObj := &OidcClient{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"auth\".\"oidc_clients\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, oidcClientObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from oidc_clients")
+	}
+
+	return oidcClientObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OidcClient) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no oidc_clients provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oidcClientColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	oidcClientInsertCacheMut.RLock()
+	cache, cached := oidcClientInsertCache[key]
+	oidcClientInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			oidcClientAllColumns,
+			oidcClientColumnsWithDefault,
+			oidcClientColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(oidcClientType, oidcClientMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(oidcClientType, oidcClientMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"auth\".\"oidc_clients\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"auth\".\"oidc_clients\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into oidc_clients")
+	}
+
+	if !cached {
+		oidcClientInsertCacheMut.Lock()
+		oidcClientInsertCache[key] = cache
+		oidcClientInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OidcClient.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OidcClient) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	oidcClientUpdateCacheMut.RLock()
+	cache, cached := oidcClientUpdateCache[key]
+	oidcClientUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			oidcClientAllColumns,
+			oidcClientPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update oidc_clients, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"auth\".\"oidc_clients\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, oidcClientPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(oidcClientType, oidcClientMapping, append(wl, oidcClientPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update oidc_clients row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for oidc_clients")
+	}
+
+	if !cached {
+		oidcClientUpdateCacheMut.Lock()
+		oidcClientUpdateCache[key] = cache
+		oidcClientUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q oidcClientQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for oidc_clients")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for oidc_clients")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OidcClientSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcClientPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"auth\".\"oidc_clients\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, oidcClientPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in oidcClient slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all oidcClient")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OidcClient) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no oidc_clients provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oidcClientColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	oidcClientUpsertCacheMut.RLock()
+	cache, cached := oidcClientUpsertCache[key]
+	oidcClientUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			oidcClientAllColumns,
+			oidcClientColumnsWithDefault,
+			oidcClientColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			oidcClientAllColumns,
+			oidcClientPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert oidc_clients, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(oidcClientPrimaryKeyColumns))
+			copy(conflict, oidcClientPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"auth\".\"oidc_clients\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(oidcClientType, oidcClientMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(oidcClientType, oidcClientMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert oidc_clients")
+	}
+
+	if !cached {
+		oidcClientUpsertCacheMut.Lock()
+		oidcClientUpsertCache[key] = cache
+		oidcClientUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single OidcClient record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OidcClient) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no OidcClient provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), oidcClientPrimaryKeyMapping)
+	sql := "DELETE FROM \"auth\".\"oidc_clients\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from oidc_clients")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for oidc_clients")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q oidcClientQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no oidcClientQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oidc_clients")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oidc_clients")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OidcClientSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(oidcClientBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcClientPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"auth\".\"oidc_clients\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oidcClientPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oidcClient slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oidc_clients")
+	}
+
+	if len(oidcClientAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OidcClient) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOidcClient(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OidcClientSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OidcClientSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcClientPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"auth\".\"oidc_clients\".* FROM \"auth\".\"oidc_clients\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oidcClientPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in OidcClientSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OidcClientExists checks if the OidcClient row exists.
+func OidcClientExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"auth\".\"oidc_clients\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if oidc_clients exists")
+	}
+
+	return exists, nil
+}
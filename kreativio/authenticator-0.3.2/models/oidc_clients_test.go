@@ -0,0 +1,886 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOidcClients(t *testing.T) {
+	t.Parallel()
+
+	query := OidcClients()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOidcClientsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcClient{}
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, true, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OidcClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOidcClientsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcClient{}
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, true, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OidcClients().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OidcClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOidcClientsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcClient{}
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, true, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OidcClientSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OidcClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOidcClientsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcClient{}
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, true, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OidcClientExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if OidcClient exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OidcClientExists to return true, but got false.")
+	}
+}
+
+func testOidcClientsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcClient{}
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, true, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	oidcClientFound, err := FindOidcClient(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if oidcClientFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOidcClientsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcClient{}
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, true, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OidcClients().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOidcClientsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcClient{}
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, true, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OidcClients().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOidcClientsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	oidcClientOne := &OidcClient{}
+	oidcClientTwo := &OidcClient{}
+	if err = randomize.Struct(seed, oidcClientOne, oidcClientDBTypes, false, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oidcClientTwo, oidcClientDBTypes, false, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oidcClientOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oidcClientTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OidcClients().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOidcClientsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	oidcClientOne := &OidcClient{}
+	oidcClientTwo := &OidcClient{}
+	if err = randomize.Struct(seed, oidcClientOne, oidcClientDBTypes, false, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oidcClientTwo, oidcClientDBTypes, false, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oidcClientOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oidcClientTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func oidcClientBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *OidcClient) error {
+	*o = OidcClient{}
+	return nil
+}
+
+func oidcClientAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *OidcClient) error {
+	*o = OidcClient{}
+	return nil
+}
+
+func oidcClientAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *OidcClient) error {
+	*o = OidcClient{}
+	return nil
+}
+
+func oidcClientBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OidcClient) error {
+	*o = OidcClient{}
+	return nil
+}
+
+func oidcClientAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OidcClient) error {
+	*o = OidcClient{}
+	return nil
+}
+
+func oidcClientBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OidcClient) error {
+	*o = OidcClient{}
+	return nil
+}
+
+func oidcClientAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OidcClient) error {
+	*o = OidcClient{}
+	return nil
+}
+
+func oidcClientBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OidcClient) error {
+	*o = OidcClient{}
+	return nil
+}
+
+func oidcClientAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OidcClient) error {
+	*o = OidcClient{}
+	return nil
+}
+
+func testOidcClientsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &OidcClient{}
+	o := &OidcClient{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize OidcClient object: %s", err)
+	}
+
+	AddOidcClientHook(boil.BeforeInsertHook, oidcClientBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	oidcClientBeforeInsertHooks = []OidcClientHook{}
+
+	AddOidcClientHook(boil.AfterInsertHook, oidcClientAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	oidcClientAfterInsertHooks = []OidcClientHook{}
+
+	AddOidcClientHook(boil.AfterSelectHook, oidcClientAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	oidcClientAfterSelectHooks = []OidcClientHook{}
+
+	AddOidcClientHook(boil.BeforeUpdateHook, oidcClientBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	oidcClientBeforeUpdateHooks = []OidcClientHook{}
+
+	AddOidcClientHook(boil.AfterUpdateHook, oidcClientAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	oidcClientAfterUpdateHooks = []OidcClientHook{}
+
+	AddOidcClientHook(boil.BeforeDeleteHook, oidcClientBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	oidcClientBeforeDeleteHooks = []OidcClientHook{}
+
+	AddOidcClientHook(boil.AfterDeleteHook, oidcClientAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	oidcClientAfterDeleteHooks = []OidcClientHook{}
+
+	AddOidcClientHook(boil.BeforeUpsertHook, oidcClientBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	oidcClientBeforeUpsertHooks = []OidcClientHook{}
+
+	AddOidcClientHook(boil.AfterUpsertHook, oidcClientAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	oidcClientAfterUpsertHooks = []OidcClientHook{}
+}
+
+func testOidcClientsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcClient{}
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, true, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOidcClientsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcClient{}
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(oidcClientColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOidcClientToManyOidcCodes(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OidcClient
+	var b, c OidcCode
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oidcClientDBTypes, true, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, oidcCodeDBTypes, false, oidcCodeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, oidcCodeDBTypes, false, oidcCodeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.OidcClientID = a.ID
+	c.OidcClientID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.OidcCodes().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.OidcClientID == b.OidcClientID {
+			bFound = true
+		}
+		if v.OidcClientID == c.OidcClientID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OidcClientSlice{&a}
+	if err = a.L.LoadOidcCodes(ctx, tx, false, (*[]*OidcClient)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OidcCodes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.OidcCodes = nil
+	if err = a.L.LoadOidcCodes(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OidcCodes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOidcClientToManyAddOpOidcCodes(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OidcClient
+	var b, c, d, e OidcCode
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oidcClientDBTypes, false, strmangle.SetComplement(oidcClientPrimaryKeyColumns, oidcClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*OidcCode{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, oidcCodeDBTypes, false, strmangle.SetComplement(oidcCodePrimaryKeyColumns, oidcCodeColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*OidcCode{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddOidcCodes(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.OidcClientID {
+			t.Error("foreign key was wrong value", a.ID, first.OidcClientID)
+		}
+		if a.ID != second.OidcClientID {
+			t.Error("foreign key was wrong value", a.ID, second.OidcClientID)
+		}
+
+		if first.R.OidcClient != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.OidcClient != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.OidcCodes[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.OidcCodes[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.OidcCodes().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testOidcClientsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcClient{}
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, true, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOidcClientsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcClient{}
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, true, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OidcClientSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOidcClientsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcClient{}
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, true, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OidcClients().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	oidcClientDBTypes = map[string]string{`ID`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `ClientID`: `character varying`, `Name`: `character varying`, `Description`: `text`, `SecretHash`: `bytea`, `RedirectUris`: `ARRAYtext`}
+	_                 = bytes.MinRead
+)
+
+func testOidcClientsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(oidcClientPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(oidcClientAllColumns) == len(oidcClientPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcClient{}
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, true, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, true, oidcClientPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOidcClientsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(oidcClientAllColumns) == len(oidcClientPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcClient{}
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, true, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oidcClientDBTypes, true, oidcClientPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(oidcClientAllColumns, oidcClientPrimaryKeyColumns) {
+		fields = oidcClientAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			oidcClientAllColumns,
+			oidcClientPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OidcClientSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOidcClientsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(oidcClientAllColumns) == len(oidcClientPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OidcClient{}
+	if err = randomize.Struct(seed, &o, oidcClientDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OidcClient: %s", err)
+	}
+
+	count, err := OidcClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, oidcClientDBTypes, false, oidcClientPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OidcClient: %s", err)
+	}
+
+	count, err = OidcClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1259 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// OidcCode is an object representing the database table.
+type OidcCode struct {
+	ID            int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt     time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ExpiresAt     time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CodeHash      []byte    `boil:"code_hash" json:"code_hash" toml:"code_hash" yaml:"code_hash"`
+	OidcClientID  int       `boil:"oidc_client_id" json:"oidc_client_id" toml:"oidc_client_id" yaml:"oidc_client_id"`
+	UserID        int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	RedirectURI   string    `boil:"redirect_uri" json:"redirect_uri" toml:"redirect_uri" yaml:"redirect_uri"`
+	Scope         string    `boil:"scope" json:"scope" toml:"scope" yaml:"scope"`
+	Nonce         string    `boil:"nonce" json:"nonce" toml:"nonce" yaml:"nonce"`
+	CodeChallenge string    `boil:"code_challenge" json:"code_challenge" toml:"code_challenge" yaml:"code_challenge"`
+
+	R *oidcCodeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L oidcCodeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OidcCodeColumns = struct {
+	ID            string
+	CreatedAt     string
+	ExpiresAt     string
+	CodeHash      string
+	OidcClientID  string
+	UserID        string
+	RedirectURI   string
+	Scope         string
+	Nonce         string
+	CodeChallenge string
+}{
+	ID:            "id",
+	CreatedAt:     "created_at",
+	ExpiresAt:     "expires_at",
+	CodeHash:      "code_hash",
+	OidcClientID:  "oidc_client_id",
+	UserID:        "user_id",
+	RedirectURI:   "redirect_uri",
+	Scope:         "scope",
+	Nonce:         "nonce",
+	CodeChallenge: "code_challenge",
+}
+
+// Generated where
+
+var OidcCodeWhere = struct {
+	ID            whereHelperint
+	CreatedAt     whereHelpertime_Time
+	ExpiresAt     whereHelpertime_Time
+	CodeHash      whereHelper__byte
+	OidcClientID  whereHelperint
+	UserID        whereHelperint
+	RedirectURI   whereHelperstring
+	Scope         whereHelperstring
+	Nonce         whereHelperstring
+	CodeChallenge whereHelperstring
+}{
+	ID:            whereHelperint{field: "\"auth\".\"oidc_codes\".\"id\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"auth\".\"oidc_codes\".\"created_at\""},
+	ExpiresAt:     whereHelpertime_Time{field: "\"auth\".\"oidc_codes\".\"expires_at\""},
+	CodeHash:      whereHelper__byte{field: "\"auth\".\"oidc_codes\".\"code_hash\""},
+	OidcClientID:  whereHelperint{field: "\"auth\".\"oidc_codes\".\"oidc_client_id\""},
+	UserID:        whereHelperint{field: "\"auth\".\"oidc_codes\".\"user_id\""},
+	RedirectURI:   whereHelperstring{field: "\"auth\".\"oidc_codes\".\"redirect_uri\""},
+	Scope:         whereHelperstring{field: "\"auth\".\"oidc_codes\".\"scope\""},
+	Nonce:         whereHelperstring{field: "\"auth\".\"oidc_codes\".\"nonce\""},
+	CodeChallenge: whereHelperstring{field: "\"auth\".\"oidc_codes\".\"code_challenge\""},
+}
+
+// OidcCodeRels is where relationship names are stored.
+var OidcCodeRels = struct {
+	OidcClient string
+	User       string
+}{
+	OidcClient: "OidcClient",
+	User:       "User",
+}
+
+// oidcCodeR is where relationships are stored.
+type oidcCodeR struct {
+	OidcClient *OidcClient `boil:"OidcClient" json:"OidcClient" toml:"OidcClient" yaml:"OidcClient"`
+	User       *User       `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*oidcCodeR) NewStruct() *oidcCodeR {
+	return &oidcCodeR{}
+}
+
+// oidcCodeL is where Load methods for each relationship are stored.
+type oidcCodeL struct{}
+
+var (
+	oidcCodeAllColumns            = []string{"id", "created_at", "expires_at", "code_hash", "oidc_client_id", "user_id", "redirect_uri", "scope", "nonce", "code_challenge"}
+	oidcCodeColumnsWithoutDefault = []string{"created_at", "expires_at", "code_hash", "oidc_client_id", "user_id", "redirect_uri", "scope", "code_challenge"}
+	oidcCodeColumnsWithDefault    = []string{"id", "nonce"}
+	oidcCodePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// OidcCodeSlice is an alias for a slice of pointers to OidcCode.
+	// This should generally be used opposed to []OidcCode.
+	OidcCodeSlice []*OidcCode
+	// OidcCodeHook is the signature for custom OidcCode hook methods
+	OidcCodeHook func(context.Context, boil.ContextExecutor, *OidcCode) error
+
+	oidcCodeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	oidcCodeType                 = reflect.TypeOf(&OidcCode{})
+	oidcCodeMapping              = queries.MakeStructMapping(oidcCodeType)
+	oidcCodePrimaryKeyMapping, _ = queries.BindMapping(oidcCodeType, oidcCodeMapping, oidcCodePrimaryKeyColumns)
+	oidcCodeInsertCacheMut       sync.RWMutex
+	oidcCodeInsertCache          = make(map[string]insertCache)
+	oidcCodeUpdateCacheMut       sync.RWMutex
+	oidcCodeUpdateCache          = make(map[string]updateCache)
+	oidcCodeUpsertCacheMut       sync.RWMutex
+	oidcCodeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var oidcCodeBeforeInsertHooks []OidcCodeHook
+var oidcCodeBeforeUpdateHooks []OidcCodeHook
+var oidcCodeBeforeDeleteHooks []OidcCodeHook
+var oidcCodeBeforeUpsertHooks []OidcCodeHook
+
+var oidcCodeAfterInsertHooks []OidcCodeHook
+var oidcCodeAfterSelectHooks []OidcCodeHook
+var oidcCodeAfterUpdateHooks []OidcCodeHook
+var oidcCodeAfterDeleteHooks []OidcCodeHook
+var oidcCodeAfterUpsertHooks []OidcCodeHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OidcCode) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcCodeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OidcCode) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcCodeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OidcCode) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcCodeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OidcCode) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcCodeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OidcCode) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcCodeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OidcCode) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcCodeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OidcCode) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcCodeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OidcCode) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcCodeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OidcCode) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcCodeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOidcCodeHook registers your hook function for all future operations.
+func AddOidcCodeHook(hookPoint boil.HookPoint, oidcCodeHook OidcCodeHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		oidcCodeBeforeInsertHooks = append(oidcCodeBeforeInsertHooks, oidcCodeHook)
+	case boil.BeforeUpdateHook:
+		oidcCodeBeforeUpdateHooks = append(oidcCodeBeforeUpdateHooks, oidcCodeHook)
+	case boil.BeforeDeleteHook:
+		oidcCodeBeforeDeleteHooks = append(oidcCodeBeforeDeleteHooks, oidcCodeHook)
+	case boil.BeforeUpsertHook:
+		oidcCodeBeforeUpsertHooks = append(oidcCodeBeforeUpsertHooks, oidcCodeHook)
+	case boil.AfterInsertHook:
+		oidcCodeAfterInsertHooks = append(oidcCodeAfterInsertHooks, oidcCodeHook)
+	case boil.AfterSelectHook:
+		oidcCodeAfterSelectHooks = append(oidcCodeAfterSelectHooks, oidcCodeHook)
+	case boil.AfterUpdateHook:
+		oidcCodeAfterUpdateHooks = append(oidcCodeAfterUpdateHooks, oidcCodeHook)
+	case boil.AfterDeleteHook:
+		oidcCodeAfterDeleteHooks = append(oidcCodeAfterDeleteHooks, oidcCodeHook)
+	case boil.AfterUpsertHook:
+		oidcCodeAfterUpsertHooks = append(oidcCodeAfterUpsertHooks, oidcCodeHook)
+	}
+}
+
+// One returns a single oidcCode record from the query.
+func (q oidcCodeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OidcCode, error) {
+	o := &OidcCode{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for oidc_codes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OidcCode records from the query.
+func (q oidcCodeQuery) All(ctx context.Context, exec boil.ContextExecutor) (OidcCodeSlice, error) {
+	var o []*OidcCode
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to OidcCode slice")
+	}
+
+	if len(oidcCodeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OidcCode records in the query.
+func (q oidcCodeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count oidc_codes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q oidcCodeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if oidc_codes exists")
+	}
+
+	return count > 0, nil
+}
+
+// OidcClient pointed to by the foreign key.
+func (o *OidcCode) OidcClient(mods ...qm.QueryMod) oidcClientQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OidcClientID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := OidcClients(queryMods...)
+	queries.SetFrom(query.Query, "\"auth\".\"oidc_clients\"")
+
+	return query
+}
+
+// User pointed to by the foreign key.
+func (o *OidcCode) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"auth\".\"users\"")
+
+	return query
+}
+
+// LoadOidcClient allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oidcCodeL) LoadOidcClient(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOidcCode interface{}, mods queries.Applicator) error {
+	var slice []*OidcCode
+	var object *OidcCode
+
+	if singular {
+		object = maybeOidcCode.(*OidcCode)
+	} else {
+		slice = *maybeOidcCode.(*[]*OidcCode)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oidcCodeR{}
+		}
+		args = append(args, object.OidcClientID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oidcCodeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.OidcClientID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.OidcClientID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`auth.oidc_clients`),
+		qm.WhereIn(`auth.oidc_clients.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load OidcClient")
+	}
+
+	var resultSlice []*OidcClient
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice OidcClient")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for oidc_clients")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oidc_clients")
+	}
+
+	if len(oidcCodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.OidcClient = foreign
+		if foreign.R == nil {
+			foreign.R = &oidcClientR{}
+		}
+		foreign.R.OidcCodes = append(foreign.R.OidcCodes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OidcClientID == foreign.ID {
+				local.R.OidcClient = foreign
+				if foreign.R == nil {
+					foreign.R = &oidcClientR{}
+				}
+				foreign.R.OidcCodes = append(foreign.R.OidcCodes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oidcCodeL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOidcCode interface{}, mods queries.Applicator) error {
+	var slice []*OidcCode
+	var object *OidcCode
+
+	if singular {
+		object = maybeOidcCode.(*OidcCode)
+	} else {
+		slice = *maybeOidcCode.(*[]*OidcCode)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oidcCodeR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oidcCodeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`auth.users`),
+		qm.WhereIn(`auth.users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(oidcCodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.OidcCodes = append(foreign.R.OidcCodes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.OidcCodes = append(foreign.R.OidcCodes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOidcClient of the oidcCode to the related item.
+// Sets o.R.OidcClient to related.
+// Adds o to related.R.OidcCodes.
+func (o *OidcCode) SetOidcClient(ctx context.Context, exec boil.ContextExecutor, insert bool, related *OidcClient) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"auth\".\"oidc_codes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"oidc_client_id"}),
+		strmangle.WhereClause("\"", "\"", 2, oidcCodePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OidcClientID = related.ID
+	if o.R == nil {
+		o.R = &oidcCodeR{
+			OidcClient: related,
+		}
+	} else {
+		o.R.OidcClient = related
+	}
+
+	if related.R == nil {
+		related.R = &oidcClientR{
+			OidcCodes: OidcCodeSlice{o},
+		}
+	} else {
+		related.R.OidcCodes = append(related.R.OidcCodes, o)
+	}
+
+	return nil
+}
+
+// SetUser of the oidcCode to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.OidcCodes.
+func (o *OidcCode) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"auth\".\"oidc_codes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, oidcCodePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &oidcCodeR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OidcCodes: OidcCodeSlice{o},
+		}
+	} else {
+		related.R.OidcCodes = append(related.R.OidcCodes, o)
+	}
+
+	return nil
+}
+
+// OidcCodes retrieves all the records using an executor.
+func OidcCodes(mods ...qm.QueryMod) oidcCodeQuery {
+	mods = append(mods, qm.From("\"auth\".\"oidc_codes\""))
+	return oidcCodeQuery{NewQuery(mods...)}
+}
+
+// FindOidcCode retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOidcCode(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*OidcCode, error) {
+	oidcCodeObj := &OidcCode{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"auth\".\"oidc_codes\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, oidcCodeObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from oidc_codes")
+	}
+
+	return oidcCodeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OidcCode) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no oidc_codes provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oidcCodeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	oidcCodeInsertCacheMut.RLock()
+	cache, cached := oidcCodeInsertCache[key]
+	oidcCodeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			oidcCodeAllColumns,
+			oidcCodeColumnsWithDefault,
+			oidcCodeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(oidcCodeType, oidcCodeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(oidcCodeType, oidcCodeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"auth\".\"oidc_codes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"auth\".\"oidc_codes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into oidc_codes")
+	}
+
+	if !cached {
+		oidcCodeInsertCacheMut.Lock()
+		oidcCodeInsertCache[key] = cache
+		oidcCodeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OidcCode.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OidcCode) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	oidcCodeUpdateCacheMut.RLock()
+	cache, cached := oidcCodeUpdateCache[key]
+	oidcCodeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			oidcCodeAllColumns,
+			oidcCodePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update oidc_codes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"auth\".\"oidc_codes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, oidcCodePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(oidcCodeType, oidcCodeMapping, append(wl, oidcCodePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update oidc_codes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for oidc_codes")
+	}
+
+	if !cached {
+		oidcCodeUpdateCacheMut.Lock()
+		oidcCodeUpdateCache[key] = cache
+		oidcCodeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q oidcCodeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for oidc_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for oidc_codes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OidcCodeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"auth\".\"oidc_codes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, oidcCodePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in oidcCode slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all oidcCode")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OidcCode) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no oidc_codes provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oidcCodeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	oidcCodeUpsertCacheMut.RLock()
+	cache, cached := oidcCodeUpsertCache[key]
+	oidcCodeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			oidcCodeAllColumns,
+			oidcCodeColumnsWithDefault,
+			oidcCodeColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			oidcCodeAllColumns,
+			oidcCodePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert oidc_codes, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(oidcCodePrimaryKeyColumns))
+			copy(conflict, oidcCodePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"auth\".\"oidc_codes\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(oidcCodeType, oidcCodeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(oidcCodeType, oidcCodeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert oidc_codes")
+	}
+
+	if !cached {
+		oidcCodeUpsertCacheMut.Lock()
+		oidcCodeUpsertCache[key] = cache
+		oidcCodeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single OidcCode record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OidcCode) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no OidcCode provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), oidcCodePrimaryKeyMapping)
+	sql := "DELETE FROM \"auth\".\"oidc_codes\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from oidc_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for oidc_codes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q oidcCodeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no oidcCodeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oidc_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oidc_codes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OidcCodeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(oidcCodeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"auth\".\"oidc_codes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oidcCodePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oidcCode slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oidc_codes")
+	}
+
+	if len(oidcCodeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OidcCode) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOidcCode(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OidcCodeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OidcCodeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"auth\".\"oidc_codes\".* FROM \"auth\".\"oidc_codes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oidcCodePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in OidcCodeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OidcCodeExists checks if the OidcCode row exists.
+func OidcCodeExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"auth\".\"oidc_codes\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if oidc_codes exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,949 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOidcCodes(t *testing.T) {
+	t.Parallel()
+
+	query := OidcCodes()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOidcCodesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcCode{}
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, true, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OidcCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOidcCodesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcCode{}
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, true, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OidcCodes().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OidcCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOidcCodesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcCode{}
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, true, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OidcCodeSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OidcCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOidcCodesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcCode{}
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, true, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OidcCodeExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if OidcCode exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OidcCodeExists to return true, but got false.")
+	}
+}
+
+func testOidcCodesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcCode{}
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, true, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	oidcCodeFound, err := FindOidcCode(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if oidcCodeFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOidcCodesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcCode{}
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, true, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OidcCodes().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOidcCodesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcCode{}
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, true, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OidcCodes().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOidcCodesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	oidcCodeOne := &OidcCode{}
+	oidcCodeTwo := &OidcCode{}
+	if err = randomize.Struct(seed, oidcCodeOne, oidcCodeDBTypes, false, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oidcCodeTwo, oidcCodeDBTypes, false, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oidcCodeOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oidcCodeTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OidcCodes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOidcCodesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	oidcCodeOne := &OidcCode{}
+	oidcCodeTwo := &OidcCode{}
+	if err = randomize.Struct(seed, oidcCodeOne, oidcCodeDBTypes, false, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oidcCodeTwo, oidcCodeDBTypes, false, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oidcCodeOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oidcCodeTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func oidcCodeBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *OidcCode) error {
+	*o = OidcCode{}
+	return nil
+}
+
+func oidcCodeAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *OidcCode) error {
+	*o = OidcCode{}
+	return nil
+}
+
+func oidcCodeAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *OidcCode) error {
+	*o = OidcCode{}
+	return nil
+}
+
+func oidcCodeBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OidcCode) error {
+	*o = OidcCode{}
+	return nil
+}
+
+func oidcCodeAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OidcCode) error {
+	*o = OidcCode{}
+	return nil
+}
+
+func oidcCodeBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OidcCode) error {
+	*o = OidcCode{}
+	return nil
+}
+
+func oidcCodeAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OidcCode) error {
+	*o = OidcCode{}
+	return nil
+}
+
+func oidcCodeBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OidcCode) error {
+	*o = OidcCode{}
+	return nil
+}
+
+func oidcCodeAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OidcCode) error {
+	*o = OidcCode{}
+	return nil
+}
+
+func testOidcCodesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &OidcCode{}
+	o := &OidcCode{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize OidcCode object: %s", err)
+	}
+
+	AddOidcCodeHook(boil.BeforeInsertHook, oidcCodeBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	oidcCodeBeforeInsertHooks = []OidcCodeHook{}
+
+	AddOidcCodeHook(boil.AfterInsertHook, oidcCodeAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	oidcCodeAfterInsertHooks = []OidcCodeHook{}
+
+	AddOidcCodeHook(boil.AfterSelectHook, oidcCodeAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	oidcCodeAfterSelectHooks = []OidcCodeHook{}
+
+	AddOidcCodeHook(boil.BeforeUpdateHook, oidcCodeBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	oidcCodeBeforeUpdateHooks = []OidcCodeHook{}
+
+	AddOidcCodeHook(boil.AfterUpdateHook, oidcCodeAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	oidcCodeAfterUpdateHooks = []OidcCodeHook{}
+
+	AddOidcCodeHook(boil.BeforeDeleteHook, oidcCodeBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	oidcCodeBeforeDeleteHooks = []OidcCodeHook{}
+
+	AddOidcCodeHook(boil.AfterDeleteHook, oidcCodeAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	oidcCodeAfterDeleteHooks = []OidcCodeHook{}
+
+	AddOidcCodeHook(boil.BeforeUpsertHook, oidcCodeBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	oidcCodeBeforeUpsertHooks = []OidcCodeHook{}
+
+	AddOidcCodeHook(boil.AfterUpsertHook, oidcCodeAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	oidcCodeAfterUpsertHooks = []OidcCodeHook{}
+}
+
+func testOidcCodesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcCode{}
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, true, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOidcCodesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcCode{}
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(oidcCodeColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOidcCodeToOneOidcClientUsingOidcClient(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OidcCode
+	var foreign OidcClient
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, oidcCodeDBTypes, false, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, oidcClientDBTypes, false, oidcClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcClient struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.OidcClientID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.OidcClient().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := OidcCodeSlice{&local}
+	if err = local.L.LoadOidcClient(ctx, tx, false, (*[]*OidcCode)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OidcClient == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.OidcClient = nil
+	if err = local.L.LoadOidcClient(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OidcClient == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testOidcCodeToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OidcCode
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, oidcCodeDBTypes, false, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := OidcCodeSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*OidcCode)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testOidcCodeToOneSetOpOidcClientUsingOidcClient(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OidcCode
+	var b, c OidcClient
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oidcCodeDBTypes, false, strmangle.SetComplement(oidcCodePrimaryKeyColumns, oidcCodeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, oidcClientDBTypes, false, strmangle.SetComplement(oidcClientPrimaryKeyColumns, oidcClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, oidcClientDBTypes, false, strmangle.SetComplement(oidcClientPrimaryKeyColumns, oidcClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*OidcClient{&b, &c} {
+		err = a.SetOidcClient(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.OidcClient != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OidcCodes[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.OidcClientID != x.ID {
+			t.Error("foreign key was wrong value", a.OidcClientID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.OidcClientID))
+		reflect.Indirect(reflect.ValueOf(&a.OidcClientID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.OidcClientID != x.ID {
+			t.Error("foreign key was wrong value", a.OidcClientID, x.ID)
+		}
+	}
+}
+func testOidcCodeToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OidcCode
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oidcCodeDBTypes, false, strmangle.SetComplement(oidcCodePrimaryKeyColumns, oidcCodeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OidcCodes[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testOidcCodesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcCode{}
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, true, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOidcCodesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcCode{}
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, true, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OidcCodeSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOidcCodesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcCode{}
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, true, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OidcCodes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	oidcCodeDBTypes = map[string]string{`ID`: `integer`, `CreatedAt`: `timestamp with time zone`, `ExpiresAt`: `timestamp with time zone`, `CodeHash`: `bytea`, `OidcClientID`: `integer`, `UserID`: `integer`, `RedirectURI`: `text`, `Scope`: `text`, `Nonce`: `text`, `CodeChallenge`: `character varying`}
+	_               = bytes.MinRead
+)
+
+func testOidcCodesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(oidcCodePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(oidcCodeAllColumns) == len(oidcCodePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcCode{}
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, true, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, true, oidcCodePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOidcCodesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(oidcCodeAllColumns) == len(oidcCodePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcCode{}
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, true, oidcCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oidcCodeDBTypes, true, oidcCodePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(oidcCodeAllColumns, oidcCodePrimaryKeyColumns) {
+		fields = oidcCodeAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			oidcCodeAllColumns,
+			oidcCodePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OidcCodeSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOidcCodesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(oidcCodeAllColumns) == len(oidcCodePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OidcCode{}
+	if err = randomize.Struct(seed, &o, oidcCodeDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OidcCode: %s", err)
+	}
+
+	count, err := OidcCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, oidcCodeDBTypes, false, oidcCodePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OidcCode struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OidcCode: %s", err)
+	}
+
+	count, err = OidcCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -1,4 +1,4 @@
-// Code generated by SQLBoiler 4.1.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package models
@@ -12,7 +12,23 @@ func TestUpsert(t *testing.T) {
 
 	t.Run("JWTKeys", testJWTKeysUpsert)
 
+	t.Run("LoginAttempts", testLoginAttemptsUpsert)
+
+	t.Run("OidcClients", testOidcClientsUpsert)
+
+	t.Run("OidcCodes", testOidcCodesUpsert)
+
 	t.Run("Passwords", testPasswordsUpsert)
 
+	t.Run("Permissions", testPermissionsUpsert)
+
+	t.Run("RecoveryCodes", testRecoveryCodesUpsert)
+
+	t.Run("TotpSecrets", testTotpSecretsUpsert)
+
 	t.Run("Users", testUsersUpsert)
+
+	t.Run("WebauthnChallenges", testWebauthnChallengesUpsert)
+
+	t.Run("WebauthnCredentials", testWebauthnCredentialsUpsert)
 }
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,62 +24,90 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Email     string    `boil:"email" json:"email" toml:"email" yaml:"email"`
-	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID           int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Email        string    `boil:"email" json:"email" toml:"email" yaml:"email"`
+	Name         string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	FailedLogins int       `boil:"failed_logins" json:"failed_logins" toml:"failed_logins" yaml:"failed_logins"`
+	LockedUntil  null.Time `boil:"locked_until" json:"locked_until,omitempty" toml:"locked_until" yaml:"locked_until,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	ID        string
-	Email     string
-	Name      string
-	CreatedAt string
-	UpdatedAt string
+	ID           string
+	Email        string
+	Name         string
+	CreatedAt    string
+	UpdatedAt    string
+	FailedLogins string
+	LockedUntil  string
 }{
-	ID:        "id",
-	Email:     "email",
-	Name:      "name",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
+	ID:           "id",
+	Email:        "email",
+	Name:         "name",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+	FailedLogins: "failed_logins",
+	LockedUntil:  "locked_until",
 }
 
 // Generated where
 
 var UserWhere = struct {
-	ID        whereHelperint
-	Email     whereHelperstring
-	Name      whereHelperstring
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpertime_Time
+	ID           whereHelperint
+	Email        whereHelperstring
+	Name         whereHelperstring
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpertime_Time
+	FailedLogins whereHelperint
+	LockedUntil  whereHelpernull_Time
 }{
-	ID:        whereHelperint{field: "\"auth\".\"users\".\"id\""},
-	Email:     whereHelperstring{field: "\"auth\".\"users\".\"email\""},
-	Name:      whereHelperstring{field: "\"auth\".\"users\".\"name\""},
-	CreatedAt: whereHelpertime_Time{field: "\"auth\".\"users\".\"created_at\""},
-	UpdatedAt: whereHelpertime_Time{field: "\"auth\".\"users\".\"updated_at\""},
+	ID:           whereHelperint{field: "\"auth\".\"users\".\"id\""},
+	Email:        whereHelperstring{field: "\"auth\".\"users\".\"email\""},
+	Name:         whereHelperstring{field: "\"auth\".\"users\".\"name\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"auth\".\"users\".\"created_at\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"auth\".\"users\".\"updated_at\""},
+	FailedLogins: whereHelperint{field: "\"auth\".\"users\".\"failed_logins\""},
+	LockedUntil:  whereHelpernull_Time{field: "\"auth\".\"users\".\"locked_until\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	Password  string
-	Audiences string
-	Groups    string
+	Password            string
+	TotpSecret          string
+	LoginAttempts       string
+	OidcCodes           string
+	RecoveryCodes       string
+	Audiences           string
+	Groups              string
+	WebauthnChallenges  string
+	WebauthnCredentials string
 }{
-	Password:  "Password",
-	Audiences: "Audiences",
-	Groups:    "Groups",
+	Password:            "Password",
+	TotpSecret:          "TotpSecret",
+	LoginAttempts:       "LoginAttempts",
+	OidcCodes:           "OidcCodes",
+	RecoveryCodes:       "RecoveryCodes",
+	Audiences:           "Audiences",
+	Groups:              "Groups",
+	WebauthnChallenges:  "WebauthnChallenges",
+	WebauthnCredentials: "WebauthnCredentials",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	Password  *Password     `boil:"Password" json:"Password" toml:"Password" yaml:"Password"`
-	Audiences AudienceSlice `boil:"Audiences" json:"Audiences" toml:"Audiences" yaml:"Audiences"`
-	Groups    GroupSlice    `boil:"Groups" json:"Groups" toml:"Groups" yaml:"Groups"`
+	Password            *Password               `boil:"Password" json:"Password" toml:"Password" yaml:"Password"`
+	TotpSecret          *TotpSecret             `boil:"TotpSecret" json:"TotpSecret" toml:"TotpSecret" yaml:"TotpSecret"`
+	LoginAttempts       LoginAttemptSlice       `boil:"LoginAttempts" json:"LoginAttempts" toml:"LoginAttempts" yaml:"LoginAttempts"`
+	OidcCodes           OidcCodeSlice           `boil:"OidcCodes" json:"OidcCodes" toml:"OidcCodes" yaml:"OidcCodes"`
+	RecoveryCodes       RecoveryCodeSlice       `boil:"RecoveryCodes" json:"RecoveryCodes" toml:"RecoveryCodes" yaml:"RecoveryCodes"`
+	Audiences           AudienceSlice           `boil:"Audiences" json:"Audiences" toml:"Audiences" yaml:"Audiences"`
+	Groups              GroupSlice              `boil:"Groups" json:"Groups" toml:"Groups" yaml:"Groups"`
+	WebauthnChallenges  WebauthnChallengeSlice  `boil:"WebauthnChallenges" json:"WebauthnChallenges" toml:"WebauthnChallenges" yaml:"WebauthnChallenges"`
+	WebauthnCredentials WebauthnCredentialSlice `boil:"WebauthnCredentials" json:"WebauthnCredentials" toml:"WebauthnCredentials" yaml:"WebauthnCredentials"`
 }
 
 // NewStruct creates a new relationship struct
@@ -90,9 +119,9 @@ func (*userR) NewStruct() *userR {
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "email", "name", "created_at", "updated_at"}
-	userColumnsWithoutDefault = []string{"email", "name", "created_at", "updated_at"}
-	userColumnsWithDefault    = []string{"id"}
+	userAllColumns            = []string{"id", "email", "name", "created_at", "updated_at", "failed_logins", "locked_until"}
+	userColumnsWithoutDefault = []string{"email", "name", "created_at", "updated_at", "locked_until"}
+	userColumnsWithDefault    = []string{"id", "failed_logins"}
 	userPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -385,6 +414,83 @@ func (o *User) Password(mods ...qm.QueryMod) passwordQuery {
 	return query
 }
 
+// TotpSecret pointed to by the foreign key.
+func (o *User) TotpSecret(mods ...qm.QueryMod) totpSecretQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"user_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := TotpSecrets(queryMods...)
+	queries.SetFrom(query.Query, "\"auth\".\"totp_secrets\"")
+
+	return query
+}
+
+// LoginAttempts retrieves all the login_attempt's LoginAttempts with an executor.
+func (o *User) LoginAttempts(mods ...qm.QueryMod) loginAttemptQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"auth\".\"login_attempts\".\"user_id\"=?", o.ID),
+	)
+
+	query := LoginAttempts(queryMods...)
+	queries.SetFrom(query.Query, "\"auth\".\"login_attempts\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"auth\".\"login_attempts\".*"})
+	}
+
+	return query
+}
+
+// OidcCodes retrieves all the oidc_code's OidcCodes with an executor.
+func (o *User) OidcCodes(mods ...qm.QueryMod) oidcCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"auth\".\"oidc_codes\".\"user_id\"=?", o.ID),
+	)
+
+	query := OidcCodes(queryMods...)
+	queries.SetFrom(query.Query, "\"auth\".\"oidc_codes\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"auth\".\"oidc_codes\".*"})
+	}
+
+	return query
+}
+
+// RecoveryCodes retrieves all the recovery_code's RecoveryCodes with an executor.
+func (o *User) RecoveryCodes(mods ...qm.QueryMod) recoveryCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"auth\".\"recovery_codes\".\"user_id\"=?", o.ID),
+	)
+
+	query := RecoveryCodes(queryMods...)
+	queries.SetFrom(query.Query, "\"auth\".\"recovery_codes\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"auth\".\"recovery_codes\".*"})
+	}
+
+	return query
+}
+
 // Audiences retrieves all the audience's Audiences with an executor.
 func (o *User) Audiences(mods ...qm.QueryMod) audienceQuery {
 	var queryMods []qm.QueryMod
@@ -429,6 +535,48 @@ func (o *User) Groups(mods ...qm.QueryMod) groupQuery {
 	return query
 }
 
+// WebauthnChallenges retrieves all the webauthn_challenge's WebauthnChallenges with an executor.
+func (o *User) WebauthnChallenges(mods ...qm.QueryMod) webauthnChallengeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"auth\".\"webauthn_challenges\".\"user_id\"=?", o.ID),
+	)
+
+	query := WebauthnChallenges(queryMods...)
+	queries.SetFrom(query.Query, "\"auth\".\"webauthn_challenges\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"auth\".\"webauthn_challenges\".*"})
+	}
+
+	return query
+}
+
+// WebauthnCredentials retrieves all the webauthn_credential's WebauthnCredentials with an executor.
+func (o *User) WebauthnCredentials(mods ...qm.QueryMod) webauthnCredentialQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"auth\".\"webauthn_credentials\".\"user_id\"=?", o.ID),
+	)
+
+	query := WebauthnCredentials(queryMods...)
+	queries.SetFrom(query.Query, "\"auth\".\"webauthn_credentials\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"auth\".\"webauthn_credentials\".*"})
+	}
+
+	return query
+}
+
 // LoadPassword allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-1 relationship.
 func (userL) LoadPassword(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
@@ -530,9 +678,9 @@ func (userL) LoadPassword(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadAudiences allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadAudiences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// LoadTotpSecret allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadTotpSecret(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -570,10 +718,8 @@ func (userL) LoadAudiences(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.Select("\"auth\".\"audiences\".*, \"a\".\"user_id\""),
-		qm.From("\"auth\".\"audiences\""),
-		qm.InnerJoin("\"auth\".\"user_audiences\" as \"a\" on \"auth\".\"audiences\".\"id\" = \"a\".\"audience_id\""),
-		qm.WhereIn("\"a\".\"user_id\" in ?", args...),
+		qm.From(`auth.totp_secrets`),
+		qm.WhereIn(`auth.totp_secrets.user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -581,36 +727,123 @@ func (userL) LoadAudiences(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load audiences")
+		return errors.Wrap(err, "failed to eager load TotpSecret")
 	}
 
-	var resultSlice []*Audience
+	var resultSlice []*TotpSecret
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TotpSecret")
+	}
 
-	var localJoinCols []int
-	for results.Next() {
-		one := new(Audience)
-		var localJoinCol int
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for totp_secrets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for totp_secrets")
+	}
 
-		err = results.Scan(&one.ID, &one.CreatedAt, &one.UpdatedAt, &one.Name, &one.Description, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for audiences")
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice audiences")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TotpSecret = foreign
+		if foreign.R == nil {
+			foreign.R = &totpSecretR{}
 		}
+		foreign.R.User = object
+	}
 
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.UserID {
+				local.R.TotpSecret = foreign
+				if foreign.R == nil {
+					foreign.R = &totpSecretR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLoginAttempts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadLoginAttempts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`auth.login_attempts`),
+		qm.WhereIn(`auth.login_attempts.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load login_attempts")
+	}
+
+	var resultSlice []*LoginAttempt
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice login_attempts")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on audiences")
+		return errors.Wrap(err, "failed to close results in eager load on login_attempts")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audiences")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for login_attempts")
 	}
 
-	if len(audienceAfterSelectHooks) != 0 {
+	if len(loginAttemptAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -618,25 +851,24 @@ func (userL) LoadAudiences(ctx context.Context, e boil.ContextExecutor, singular
 		}
 	}
 	if singular {
-		object.R.Audiences = resultSlice
+		object.R.LoginAttempts = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &audienceR{}
+				foreign.R = &loginAttemptR{}
 			}
-			foreign.R.Users = append(foreign.R.Users, object)
+			foreign.R.User = object
 		}
 		return nil
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
+	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == localJoinCol {
-				local.R.Audiences = append(local.R.Audiences, foreign)
+			if local.ID == foreign.UserID {
+				local.R.LoginAttempts = append(local.R.LoginAttempts, foreign)
 				if foreign.R == nil {
-					foreign.R = &audienceR{}
+					foreign.R = &loginAttemptR{}
 				}
-				foreign.R.Users = append(foreign.R.Users, local)
+				foreign.R.User = local
 				break
 			}
 		}
@@ -645,9 +877,9 @@ func (userL) LoadAudiences(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadGroups allows an eager lookup of values, cached into the
+// LoadOidcCodes allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadGroups(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadOidcCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -685,10 +917,8 @@ func (userL) LoadGroups(ctx context.Context, e boil.ContextExecutor, singular bo
 	}
 
 	query := NewQuery(
-		qm.Select("\"auth\".\"groups\".*, \"a\".\"user_id\""),
-		qm.From("\"auth\".\"groups\""),
-		qm.InnerJoin("\"auth\".\"user_groups\" as \"a\" on \"auth\".\"groups\".\"id\" = \"a\".\"group_id\""),
-		qm.WhereIn("\"a\".\"user_id\" in ?", args...),
+		qm.From(`auth.oidc_codes`),
+		qm.WhereIn(`auth.oidc_codes.user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -696,36 +926,546 @@ func (userL) LoadGroups(ctx context.Context, e boil.ContextExecutor, singular bo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load groups")
+		return errors.Wrap(err, "failed to eager load oidc_codes")
 	}
 
-	var resultSlice []*Group
+	var resultSlice []*OidcCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice oidc_codes")
+	}
 
-	var localJoinCols []int
-	for results.Next() {
-		one := new(Group)
-		var localJoinCol int
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on oidc_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oidc_codes")
+	}
 
-		err = results.Scan(&one.ID, &one.CreatedAt, &one.UpdatedAt, &one.Name, &one.Description, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for groups")
+	if len(oidcCodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice groups")
+	}
+	if singular {
+		object.R.OidcCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &oidcCodeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.OidcCodes = append(local.R.OidcCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &oidcCodeR{}
+				}
+				foreign.R.User = local
+				break
+			}
 		}
+	}
+
+	return nil
+}
+
+// LoadRecoveryCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRecoveryCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`auth.recovery_codes`),
+		qm.WhereIn(`auth.recovery_codes.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load recovery_codes")
+	}
+
+	var resultSlice []*RecoveryCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice recovery_codes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on recovery_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for recovery_codes")
+	}
+
+	if len(recoveryCodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RecoveryCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &recoveryCodeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.RecoveryCodes = append(local.R.RecoveryCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &recoveryCodeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAudiences allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAudiences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("\"auth\".\"audiences\".*, \"a\".\"user_id\""),
+		qm.From("\"auth\".\"audiences\""),
+		qm.InnerJoin("\"auth\".\"user_audiences\" as \"a\" on \"auth\".\"audiences\".\"id\" = \"a\".\"audience_id\""),
+		qm.WhereIn("\"a\".\"user_id\" in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load audiences")
+	}
+
+	var resultSlice []*Audience
+
+	var localJoinCols []int
+	for results.Next() {
+		one := new(Audience)
+		var localJoinCol int
+
+		err = results.Scan(&one.ID, &one.CreatedAt, &one.UpdatedAt, &one.Name, &one.Description, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for audiences")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice audiences")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on audiences")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audiences")
+	}
+
+	if len(audienceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Audiences = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &audienceR{}
+			}
+			foreign.R.Users = append(foreign.R.Users, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Audiences = append(local.R.Audiences, foreign)
+				if foreign.R == nil {
+					foreign.R = &audienceR{}
+				}
+				foreign.R.Users = append(foreign.R.Users, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGroups allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadGroups(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("\"auth\".\"groups\".*, \"a\".\"user_id\""),
+		qm.From("\"auth\".\"groups\""),
+		qm.InnerJoin("\"auth\".\"user_groups\" as \"a\" on \"auth\".\"groups\".\"id\" = \"a\".\"group_id\""),
+		qm.WhereIn("\"a\".\"user_id\" in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load groups")
+	}
+
+	var resultSlice []*Group
+
+	var localJoinCols []int
+	for results.Next() {
+		one := new(Group)
+		var localJoinCol int
+
+		err = results.Scan(&one.ID, &one.CreatedAt, &one.UpdatedAt, &one.Name, &one.Description, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for groups")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice groups")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on groups")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for groups")
+	}
+
+	if len(groupAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Groups = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupR{}
+			}
+			foreign.R.Users = append(foreign.R.Users, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Groups = append(local.R.Groups, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupR{}
+				}
+				foreign.R.Users = append(foreign.R.Users, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebauthnChallenges allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWebauthnChallenges(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`auth.webauthn_challenges`),
+		qm.WhereIn(`auth.webauthn_challenges.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webauthn_challenges")
+	}
+
+	var resultSlice []*WebauthnChallenge
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webauthn_challenges")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webauthn_challenges")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webauthn_challenges")
+	}
+
+	if len(webauthnChallengeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebauthnChallenges = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webauthnChallengeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.WebauthnChallenges = append(local.R.WebauthnChallenges, foreign)
+				if foreign.R == nil {
+					foreign.R = &webauthnChallengeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebauthnCredentials allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWebauthnCredentials(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`auth.webauthn_credentials`),
+		qm.WhereIn(`auth.webauthn_credentials.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webauthn_credentials")
+	}
+
+	var resultSlice []*WebauthnCredential
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webauthn_credentials")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on groups")
+		return errors.Wrap(err, "failed to close results in eager load on webauthn_credentials")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for groups")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webauthn_credentials")
 	}
 
-	if len(groupAfterSelectHooks) != 0 {
+	if len(webauthnCredentialAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -733,25 +1473,24 @@ func (userL) LoadGroups(ctx context.Context, e boil.ContextExecutor, singular bo
 		}
 	}
 	if singular {
-		object.R.Groups = resultSlice
+		object.R.WebauthnCredentials = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &groupR{}
+				foreign.R = &webauthnCredentialR{}
 			}
-			foreign.R.Users = append(foreign.R.Users, object)
+			foreign.R.User = object
 		}
 		return nil
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
+	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == localJoinCol {
-				local.R.Groups = append(local.R.Groups, foreign)
+			if local.ID == foreign.UserID {
+				local.R.WebauthnCredentials = append(local.R.WebauthnCredentials, foreign)
 				if foreign.R == nil {
-					foreign.R = &groupR{}
+					foreign.R = &webauthnCredentialR{}
 				}
-				foreign.R.Users = append(foreign.R.Users, local)
+				foreign.R.User = local
 				break
 			}
 		}
@@ -811,6 +1550,216 @@ func (o *User) SetPassword(ctx context.Context, exec boil.ContextExecutor, inser
 	return nil
 }
 
+// SetTotpSecret of the user to the related item.
+// Sets o.R.TotpSecret to related.
+// Adds o to related.R.User.
+func (o *User) SetTotpSecret(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TotpSecret) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"auth\".\"totp_secrets\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, totpSecretPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			TotpSecret: related,
+		}
+	} else {
+		o.R.TotpSecret = related
+	}
+
+	if related.R == nil {
+		related.R = &totpSecretR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// AddLoginAttempts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.LoginAttempts.
+// Sets related.R.User appropriately.
+func (o *User) AddLoginAttempts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LoginAttempt) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"auth\".\"login_attempts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, loginAttemptPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			LoginAttempts: related,
+		}
+	} else {
+		o.R.LoginAttempts = append(o.R.LoginAttempts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &loginAttemptR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddOidcCodes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OidcCodes.
+// Sets related.R.User appropriately.
+func (o *User) AddOidcCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OidcCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"auth\".\"oidc_codes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, oidcCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OidcCodes: related,
+		}
+	} else {
+		o.R.OidcCodes = append(o.R.OidcCodes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oidcCodeR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddRecoveryCodes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RecoveryCodes.
+// Sets related.R.User appropriately.
+func (o *User) AddRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RecoveryCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"auth\".\"recovery_codes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, recoveryCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			RecoveryCodes: related,
+		}
+	} else {
+		o.R.RecoveryCodes = append(o.R.RecoveryCodes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &recoveryCodeR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // AddAudiences adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.Audiences.
@@ -1091,6 +2040,182 @@ func removeGroupsFromUsersSlice(o *User, related []*Group) {
 	}
 }
 
+// AddWebauthnChallenges adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.WebauthnChallenges.
+// Sets related.R.User appropriately.
+func (o *User) AddWebauthnChallenges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebauthnChallenge) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"auth\".\"webauthn_challenges\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webauthnChallengePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			WebauthnChallenges: related,
+		}
+	} else {
+		o.R.WebauthnChallenges = append(o.R.WebauthnChallenges, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webauthnChallengeR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// SetWebauthnChallenges removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's WebauthnChallenges accordingly.
+// Replaces o.R.WebauthnChallenges with related.
+// Sets related.R.User's WebauthnChallenges accordingly.
+func (o *User) SetWebauthnChallenges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebauthnChallenge) error {
+	query := "update \"auth\".\"webauthn_challenges\" set \"user_id\" = null where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.WebauthnChallenges {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+
+		o.R.WebauthnChallenges = nil
+	}
+	return o.AddWebauthnChallenges(ctx, exec, insert, related...)
+}
+
+// RemoveWebauthnChallenges relationships from objects passed in.
+// Removes related items from R.WebauthnChallenges (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveWebauthnChallenges(ctx context.Context, exec boil.ContextExecutor, related ...*WebauthnChallenge) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.WebauthnChallenges {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.WebauthnChallenges)
+			if ln > 1 && i < ln-1 {
+				o.R.WebauthnChallenges[i] = o.R.WebauthnChallenges[ln-1]
+			}
+			o.R.WebauthnChallenges = o.R.WebauthnChallenges[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddWebauthnCredentials adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.WebauthnCredentials.
+// Sets related.R.User appropriately.
+func (o *User) AddWebauthnCredentials(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebauthnCredential) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"auth\".\"webauthn_credentials\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webauthnCredentialPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			WebauthnCredentials: related,
+		}
+	} else {
+		o.R.WebauthnCredentials = append(o.R.WebauthnCredentials, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webauthnCredentialR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"auth\".\"users\""))
@@ -0,0 +1,1097 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WebauthnCredential is an object representing the database table.
+type WebauthnCredential struct {
+	ID           int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID       int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CredentialID []byte    `boil:"credential_id" json:"credential_id" toml:"credential_id" yaml:"credential_id"`
+	PublicKey    []byte    `boil:"public_key" json:"public_key" toml:"public_key" yaml:"public_key"`
+	SignCount    int64     `boil:"sign_count" json:"sign_count" toml:"sign_count" yaml:"sign_count"`
+	Name         string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	LastUsedAt   null.Time `boil:"last_used_at" json:"last_used_at,omitempty" toml:"last_used_at" yaml:"last_used_at,omitempty"`
+
+	R *webauthnCredentialR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L webauthnCredentialL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebauthnCredentialColumns = struct {
+	ID           string
+	UserID       string
+	CreatedAt    string
+	UpdatedAt    string
+	CredentialID string
+	PublicKey    string
+	SignCount    string
+	Name         string
+	LastUsedAt   string
+}{
+	ID:           "id",
+	UserID:       "user_id",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+	CredentialID: "credential_id",
+	PublicKey:    "public_key",
+	SignCount:    "sign_count",
+	Name:         "name",
+	LastUsedAt:   "last_used_at",
+}
+
+// Generated where
+
+var WebauthnCredentialWhere = struct {
+	ID           whereHelperint
+	UserID       whereHelperint
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpertime_Time
+	CredentialID whereHelper__byte
+	PublicKey    whereHelper__byte
+	SignCount    whereHelperint64
+	Name         whereHelperstring
+	LastUsedAt   whereHelpernull_Time
+}{
+	ID:           whereHelperint{field: "\"auth\".\"webauthn_credentials\".\"id\""},
+	UserID:       whereHelperint{field: "\"auth\".\"webauthn_credentials\".\"user_id\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"auth\".\"webauthn_credentials\".\"created_at\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"auth\".\"webauthn_credentials\".\"updated_at\""},
+	CredentialID: whereHelper__byte{field: "\"auth\".\"webauthn_credentials\".\"credential_id\""},
+	PublicKey:    whereHelper__byte{field: "\"auth\".\"webauthn_credentials\".\"public_key\""},
+	SignCount:    whereHelperint64{field: "\"auth\".\"webauthn_credentials\".\"sign_count\""},
+	Name:         whereHelperstring{field: "\"auth\".\"webauthn_credentials\".\"name\""},
+	LastUsedAt:   whereHelpernull_Time{field: "\"auth\".\"webauthn_credentials\".\"last_used_at\""},
+}
+
+// WebauthnCredentialRels is where relationship names are stored.
+var WebauthnCredentialRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// webauthnCredentialR is where relationships are stored.
+type webauthnCredentialR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*webauthnCredentialR) NewStruct() *webauthnCredentialR {
+	return &webauthnCredentialR{}
+}
+
+// webauthnCredentialL is where Load methods for each relationship are stored.
+type webauthnCredentialL struct{}
+
+var (
+	webauthnCredentialAllColumns            = []string{"id", "user_id", "created_at", "updated_at", "credential_id", "public_key", "sign_count", "name", "last_used_at"}
+	webauthnCredentialColumnsWithoutDefault = []string{"user_id", "created_at", "updated_at", "credential_id", "public_key", "last_used_at"}
+	webauthnCredentialColumnsWithDefault    = []string{"id", "sign_count", "name"}
+	webauthnCredentialPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// WebauthnCredentialSlice is an alias for a slice of pointers to WebauthnCredential.
+	// This should generally be used opposed to []WebauthnCredential.
+	WebauthnCredentialSlice []*WebauthnCredential
+	// WebauthnCredentialHook is the signature for custom WebauthnCredential hook methods
+	WebauthnCredentialHook func(context.Context, boil.ContextExecutor, *WebauthnCredential) error
+
+	webauthnCredentialQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	webauthnCredentialType                 = reflect.TypeOf(&WebauthnCredential{})
+	webauthnCredentialMapping              = queries.MakeStructMapping(webauthnCredentialType)
+	webauthnCredentialPrimaryKeyMapping, _ = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, webauthnCredentialPrimaryKeyColumns)
+	webauthnCredentialInsertCacheMut       sync.RWMutex
+	webauthnCredentialInsertCache          = make(map[string]insertCache)
+	webauthnCredentialUpdateCacheMut       sync.RWMutex
+	webauthnCredentialUpdateCache          = make(map[string]updateCache)
+	webauthnCredentialUpsertCacheMut       sync.RWMutex
+	webauthnCredentialUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var webauthnCredentialBeforeInsertHooks []WebauthnCredentialHook
+var webauthnCredentialBeforeUpdateHooks []WebauthnCredentialHook
+var webauthnCredentialBeforeDeleteHooks []WebauthnCredentialHook
+var webauthnCredentialBeforeUpsertHooks []WebauthnCredentialHook
+
+var webauthnCredentialAfterInsertHooks []WebauthnCredentialHook
+var webauthnCredentialAfterSelectHooks []WebauthnCredentialHook
+var webauthnCredentialAfterUpdateHooks []WebauthnCredentialHook
+var webauthnCredentialAfterDeleteHooks []WebauthnCredentialHook
+var webauthnCredentialAfterUpsertHooks []WebauthnCredentialHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebauthnCredential) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebauthnCredential) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebauthnCredential) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebauthnCredential) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebauthnCredential) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebauthnCredential) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebauthnCredential) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebauthnCredential) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebauthnCredential) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCredentialAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebauthnCredentialHook registers your hook function for all future operations.
+func AddWebauthnCredentialHook(hookPoint boil.HookPoint, webauthnCredentialHook WebauthnCredentialHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		webauthnCredentialBeforeInsertHooks = append(webauthnCredentialBeforeInsertHooks, webauthnCredentialHook)
+	case boil.BeforeUpdateHook:
+		webauthnCredentialBeforeUpdateHooks = append(webauthnCredentialBeforeUpdateHooks, webauthnCredentialHook)
+	case boil.BeforeDeleteHook:
+		webauthnCredentialBeforeDeleteHooks = append(webauthnCredentialBeforeDeleteHooks, webauthnCredentialHook)
+	case boil.BeforeUpsertHook:
+		webauthnCredentialBeforeUpsertHooks = append(webauthnCredentialBeforeUpsertHooks, webauthnCredentialHook)
+	case boil.AfterInsertHook:
+		webauthnCredentialAfterInsertHooks = append(webauthnCredentialAfterInsertHooks, webauthnCredentialHook)
+	case boil.AfterSelectHook:
+		webauthnCredentialAfterSelectHooks = append(webauthnCredentialAfterSelectHooks, webauthnCredentialHook)
+	case boil.AfterUpdateHook:
+		webauthnCredentialAfterUpdateHooks = append(webauthnCredentialAfterUpdateHooks, webauthnCredentialHook)
+	case boil.AfterDeleteHook:
+		webauthnCredentialAfterDeleteHooks = append(webauthnCredentialAfterDeleteHooks, webauthnCredentialHook)
+	case boil.AfterUpsertHook:
+		webauthnCredentialAfterUpsertHooks = append(webauthnCredentialAfterUpsertHooks, webauthnCredentialHook)
+	}
+}
+
+// One returns a single webauthnCredential record from the query.
+func (q webauthnCredentialQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebauthnCredential, error) {
+	o := &WebauthnCredential{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for webauthn_credentials")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebauthnCredential records from the query.
+func (q webauthnCredentialQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebauthnCredentialSlice, error) {
+	var o []*WebauthnCredential
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebauthnCredential slice")
+	}
+
+	if len(webauthnCredentialAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebauthnCredential records in the query.
+func (q webauthnCredentialQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count webauthn_credentials rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q webauthnCredentialQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if webauthn_credentials exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *WebauthnCredential) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"auth\".\"users\"")
+
+	return query
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (webauthnCredentialL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebauthnCredential interface{}, mods queries.Applicator) error {
+	var slice []*WebauthnCredential
+	var object *WebauthnCredential
+
+	if singular {
+		object = maybeWebauthnCredential.(*WebauthnCredential)
+	} else {
+		slice = *maybeWebauthnCredential.(*[]*WebauthnCredential)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &webauthnCredentialR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &webauthnCredentialR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`auth.users`),
+		qm.WhereIn(`auth.users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(webauthnCredentialAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.WebauthnCredentials = append(foreign.R.WebauthnCredentials, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.WebauthnCredentials = append(foreign.R.WebauthnCredentials, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the webauthnCredential to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.WebauthnCredentials.
+func (o *WebauthnCredential) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"auth\".\"webauthn_credentials\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, webauthnCredentialPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &webauthnCredentialR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			WebauthnCredentials: WebauthnCredentialSlice{o},
+		}
+	} else {
+		related.R.WebauthnCredentials = append(related.R.WebauthnCredentials, o)
+	}
+
+	return nil
+}
+
+// WebauthnCredentials retrieves all the records using an executor.
+func WebauthnCredentials(mods ...qm.QueryMod) webauthnCredentialQuery {
+	mods = append(mods, qm.From("\"auth\".\"webauthn_credentials\""))
+	return webauthnCredentialQuery{NewQuery(mods...)}
+}
+
+// FindWebauthnCredential retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebauthnCredential(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*WebauthnCredential, error) {
+	webauthnCredentialObj := &WebauthnCredential{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"auth\".\"webauthn_credentials\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, webauthnCredentialObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from webauthn_credentials")
+	}
+
+	return webauthnCredentialObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebauthnCredential) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no webauthn_credentials provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webauthnCredentialColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	webauthnCredentialInsertCacheMut.RLock()
+	cache, cached := webauthnCredentialInsertCache[key]
+	webauthnCredentialInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialColumnsWithDefault,
+			webauthnCredentialColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"auth\".\"webauthn_credentials\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"auth\".\"webauthn_credentials\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into webauthn_credentials")
+	}
+
+	if !cached {
+		webauthnCredentialInsertCacheMut.Lock()
+		webauthnCredentialInsertCache[key] = cache
+		webauthnCredentialInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebauthnCredential.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebauthnCredential) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	webauthnCredentialUpdateCacheMut.RLock()
+	cache, cached := webauthnCredentialUpdateCache[key]
+	webauthnCredentialUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update webauthn_credentials, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"auth\".\"webauthn_credentials\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, webauthnCredentialPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, append(wl, webauthnCredentialPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update webauthn_credentials row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for webauthn_credentials")
+	}
+
+	if !cached {
+		webauthnCredentialUpdateCacheMut.Lock()
+		webauthnCredentialUpdateCache[key] = cache
+		webauthnCredentialUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q webauthnCredentialQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for webauthn_credentials")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for webauthn_credentials")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebauthnCredentialSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnCredentialPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"auth\".\"webauthn_credentials\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, webauthnCredentialPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in webauthnCredential slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all webauthnCredential")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebauthnCredential) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no webauthn_credentials provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webauthnCredentialColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	webauthnCredentialUpsertCacheMut.RLock()
+	cache, cached := webauthnCredentialUpsertCache[key]
+	webauthnCredentialUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialColumnsWithDefault,
+			webauthnCredentialColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert webauthn_credentials, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(webauthnCredentialPrimaryKeyColumns))
+			copy(conflict, webauthnCredentialPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"auth\".\"webauthn_credentials\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert webauthn_credentials")
+	}
+
+	if !cached {
+		webauthnCredentialUpsertCacheMut.Lock()
+		webauthnCredentialUpsertCache[key] = cache
+		webauthnCredentialUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebauthnCredential record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebauthnCredential) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebauthnCredential provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), webauthnCredentialPrimaryKeyMapping)
+	sql := "DELETE FROM \"auth\".\"webauthn_credentials\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from webauthn_credentials")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for webauthn_credentials")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q webauthnCredentialQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no webauthnCredentialQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from webauthn_credentials")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for webauthn_credentials")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebauthnCredentialSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(webauthnCredentialBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnCredentialPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"auth\".\"webauthn_credentials\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webauthnCredentialPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from webauthnCredential slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for webauthn_credentials")
+	}
+
+	if len(webauthnCredentialAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebauthnCredential) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebauthnCredential(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebauthnCredentialSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebauthnCredentialSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnCredentialPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"auth\".\"webauthn_credentials\".* FROM \"auth\".\"webauthn_credentials\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webauthnCredentialPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebauthnCredentialSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebauthnCredentialExists checks if the WebauthnCredential row exists.
+func WebauthnCredentialExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"auth\".\"webauthn_credentials\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if webauthn_credentials exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testWebauthnCredentials(t *testing.T) {
+	t.Parallel()
+
+	query := WebauthnCredentials()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testWebauthnCredentialsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnCredential{}
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, true, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebauthnCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebauthnCredentialsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnCredential{}
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, true, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := WebauthnCredentials().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebauthnCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebauthnCredentialsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnCredential{}
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, true, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WebauthnCredentialSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebauthnCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebauthnCredentialsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnCredential{}
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, true, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := WebauthnCredentialExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if WebauthnCredential exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected WebauthnCredentialExists to return true, but got false.")
+	}
+}
+
+func testWebauthnCredentialsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnCredential{}
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, true, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	webauthnCredentialFound, err := FindWebauthnCredential(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if webauthnCredentialFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testWebauthnCredentialsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnCredential{}
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, true, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = WebauthnCredentials().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebauthnCredentialsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnCredential{}
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, true, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := WebauthnCredentials().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testWebauthnCredentialsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	webauthnCredentialOne := &WebauthnCredential{}
+	webauthnCredentialTwo := &WebauthnCredential{}
+	if err = randomize.Struct(seed, webauthnCredentialOne, webauthnCredentialDBTypes, false, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+	if err = randomize.Struct(seed, webauthnCredentialTwo, webauthnCredentialDBTypes, false, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = webauthnCredentialOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = webauthnCredentialTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WebauthnCredentials().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testWebauthnCredentialsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	webauthnCredentialOne := &WebauthnCredential{}
+	webauthnCredentialTwo := &WebauthnCredential{}
+	if err = randomize.Struct(seed, webauthnCredentialOne, webauthnCredentialDBTypes, false, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+	if err = randomize.Struct(seed, webauthnCredentialTwo, webauthnCredentialDBTypes, false, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = webauthnCredentialOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = webauthnCredentialTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebauthnCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func webauthnCredentialBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *WebauthnCredential) error {
+	*o = WebauthnCredential{}
+	return nil
+}
+
+func webauthnCredentialAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *WebauthnCredential) error {
+	*o = WebauthnCredential{}
+	return nil
+}
+
+func webauthnCredentialAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *WebauthnCredential) error {
+	*o = WebauthnCredential{}
+	return nil
+}
+
+func webauthnCredentialBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WebauthnCredential) error {
+	*o = WebauthnCredential{}
+	return nil
+}
+
+func webauthnCredentialAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WebauthnCredential) error {
+	*o = WebauthnCredential{}
+	return nil
+}
+
+func webauthnCredentialBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WebauthnCredential) error {
+	*o = WebauthnCredential{}
+	return nil
+}
+
+func webauthnCredentialAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WebauthnCredential) error {
+	*o = WebauthnCredential{}
+	return nil
+}
+
+func webauthnCredentialBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WebauthnCredential) error {
+	*o = WebauthnCredential{}
+	return nil
+}
+
+func webauthnCredentialAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WebauthnCredential) error {
+	*o = WebauthnCredential{}
+	return nil
+}
+
+func testWebauthnCredentialsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &WebauthnCredential{}
+	o := &WebauthnCredential{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential object: %s", err)
+	}
+
+	AddWebauthnCredentialHook(boil.BeforeInsertHook, webauthnCredentialBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	webauthnCredentialBeforeInsertHooks = []WebauthnCredentialHook{}
+
+	AddWebauthnCredentialHook(boil.AfterInsertHook, webauthnCredentialAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	webauthnCredentialAfterInsertHooks = []WebauthnCredentialHook{}
+
+	AddWebauthnCredentialHook(boil.AfterSelectHook, webauthnCredentialAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	webauthnCredentialAfterSelectHooks = []WebauthnCredentialHook{}
+
+	AddWebauthnCredentialHook(boil.BeforeUpdateHook, webauthnCredentialBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	webauthnCredentialBeforeUpdateHooks = []WebauthnCredentialHook{}
+
+	AddWebauthnCredentialHook(boil.AfterUpdateHook, webauthnCredentialAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	webauthnCredentialAfterUpdateHooks = []WebauthnCredentialHook{}
+
+	AddWebauthnCredentialHook(boil.BeforeDeleteHook, webauthnCredentialBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	webauthnCredentialBeforeDeleteHooks = []WebauthnCredentialHook{}
+
+	AddWebauthnCredentialHook(boil.AfterDeleteHook, webauthnCredentialAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	webauthnCredentialAfterDeleteHooks = []WebauthnCredentialHook{}
+
+	AddWebauthnCredentialHook(boil.BeforeUpsertHook, webauthnCredentialBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	webauthnCredentialBeforeUpsertHooks = []WebauthnCredentialHook{}
+
+	AddWebauthnCredentialHook(boil.AfterUpsertHook, webauthnCredentialAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	webauthnCredentialAfterUpsertHooks = []WebauthnCredentialHook{}
+}
+
+func testWebauthnCredentialsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnCredential{}
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, true, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebauthnCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWebauthnCredentialsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnCredential{}
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(webauthnCredentialColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebauthnCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWebauthnCredentialToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local WebauthnCredential
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, webauthnCredentialDBTypes, false, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := WebauthnCredentialSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*WebauthnCredential)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testWebauthnCredentialToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WebauthnCredential
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, webauthnCredentialDBTypes, false, strmangle.SetComplement(webauthnCredentialPrimaryKeyColumns, webauthnCredentialColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.WebauthnCredentials[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testWebauthnCredentialsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnCredential{}
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, true, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebauthnCredentialsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnCredential{}
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, true, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WebauthnCredentialSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebauthnCredentialsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnCredential{}
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, true, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WebauthnCredentials().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	webauthnCredentialDBTypes = map[string]string{`ID`: `integer`, `UserID`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `CredentialID`: `bytea`, `PublicKey`: `bytea`, `SignCount`: `bigint`, `Name`: `character varying`, `LastUsedAt`: `timestamp with time zone`}
+	_                         = bytes.MinRead
+)
+
+func testWebauthnCredentialsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(webauthnCredentialPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(webauthnCredentialAllColumns) == len(webauthnCredentialPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnCredential{}
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, true, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebauthnCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, true, webauthnCredentialPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testWebauthnCredentialsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(webauthnCredentialAllColumns) == len(webauthnCredentialPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebauthnCredential{}
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, true, webauthnCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebauthnCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, webauthnCredentialDBTypes, true, webauthnCredentialPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(webauthnCredentialAllColumns, webauthnCredentialPrimaryKeyColumns) {
+		fields = webauthnCredentialAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			webauthnCredentialAllColumns,
+			webauthnCredentialPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := WebauthnCredentialSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testWebauthnCredentialsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(webauthnCredentialAllColumns) == len(webauthnCredentialPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := WebauthnCredential{}
+	if err = randomize.Struct(seed, &o, webauthnCredentialDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WebauthnCredential: %s", err)
+	}
+
+	count, err := WebauthnCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, webauthnCredentialDBTypes, false, webauthnCredentialPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebauthnCredential struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WebauthnCredential: %s", err)
+	}
+
+	count, err = WebauthnCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	auth "github.com/moapis/authenticator"
+	"github.com/moapis/authenticator/forms"
+	"github.com/moapis/ehtml"
+	clog "github.com/usrpro/clog15"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	responseTypeCode = "code"
+	pkceS256         = "S256"
+	scopeOpenID      = "openid"
+
+	// AllowedMethods for the authorization endpoint
+	AllowedMethods = http.MethodGet + " " + http.MethodPost
+)
+
+// Error codes, as defined by RFC 6749 and RFC 6750
+const (
+	errInvalidRequest          = "invalid_request"
+	errInvalidClient           = "invalid_client"
+	errInvalidGrant            = "invalid_grant"
+	errInvalidScope            = "invalid_scope"
+	errInvalidToken            = "invalid_token"
+	errUnsupportedResponseType = "unsupported_response_type"
+	errUnsupportedGrantType    = "unsupported_grant_type"
+	errServer                  = "server_error"
+	errUnavailable             = "temporarily_unavailable"
+)
+
+// Error is the JSON response of the token and userinfo endpoints on failure.
+type Error struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func hasScope(scope, want string) bool {
+	for _, s := range strings.Fields(scope) {
+		if s == want {
+			return true
+		}
+	}
+	return false
+}
+
+// redirect sends the user agent back to the client with params.
+// The redirect URI must have been verified by CheckOIDCClient.
+func (p *Provider) redirect(ctx context.Context, w http.ResponseWriter, r *http.Request, redirectURI string, params url.Values) {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		clog.Error(ctx, "Parse redirect URI", "err", err)
+		if err := p.EP.Render(w, &ehtml.Data{Req: r, Code: http.StatusInternalServerError, Msg: "Internal server error"}); err != nil {
+			clog.Error(ctx, "During handling error", "err", err)
+		}
+		return
+	}
+
+	q := u.Query()
+	for k, v := range params {
+		q[k] = v
+	}
+	u.RawQuery = q.Encode()
+
+	http.Redirect(w, r, u.String(), http.StatusFound)
+}
+
+// redirectError sends the error code back to the client.
+func (p *Provider) redirectError(ctx context.Context, w http.ResponseWriter, r *http.Request, code, description string) {
+	clog.Info(ctx, "Authorization error", "error", code, "description", description)
+
+	params := url.Values{"error": {code}}
+	if description != "" {
+		params.Set("error_description", description)
+	}
+	if state := r.Form.Get("state"); state != "" {
+		params.Set("state", state)
+	}
+	p.redirect(ctx, w, r, r.Form.Get("redirect_uri"), params)
+}
+
+// loginRedirect sends the user agent to the login form,
+// which redirects back to the authorization endpoint with a fresh token.
+func (p *Provider) loginRedirect(w http.ResponseWriter, r *http.Request) {
+	params := make(url.Values, len(r.Form))
+	for k, v := range r.Form {
+		if k != forms.DefaultTokenKey {
+			params[k] = v
+		}
+	}
+	back := p.Issuer + r.URL.Path + "?" + params.Encode()
+
+	http.Redirect(w, r, p.Issuer+p.login()+"?"+url.Values{forms.DefaultRedirectKey: {back}}.Encode(), http.StatusFound)
+}
+
+func (p *Provider) authorize(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	ctx = clog.AddArgs(ctx, "method", "authorize")
+
+	if err := r.ParseForm(); err != nil {
+		clog.Warn(ctx, "Parseform", "err", err)
+		if err := p.EP.Render(w, &ehtml.Data{Req: r, Code: http.StatusBadRequest, Msg: "Malformed request"}); err != nil {
+			clog.Error(ctx, "During handling error", "err", err)
+		}
+		return
+	}
+
+	// Errors about the client or redirect URI are shown to the user,
+	// as redirecting could leak the response to an attacker.
+	_, err := p.Client.CheckOIDCClient(ctx, &auth.OIDCClientRequest{
+		ClientId:    r.Form.Get("client_id"),
+		RedirectUri: r.Form.Get("redirect_uri"),
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound, codes.InvalidArgument:
+		clog.Warn(ctx, "CheckOIDCClient gRPC call", "err", err)
+		if err := p.EP.Render(w, &ehtml.Data{Req: r, Code: http.StatusBadRequest, Msg: "Unknown client or redirect URI"}); err != nil {
+			clog.Error(ctx, "During handling error", "err", err)
+		}
+		return
+	default:
+		clog.Error(ctx, "CheckOIDCClient gRPC call", "err", err)
+		if err := p.EP.Render(w, &ehtml.Data{Req: r, Code: http.StatusInternalServerError, Msg: "Internal server error"}); err != nil {
+			clog.Error(ctx, "During handling error", "err", err)
+		}
+		return
+	}
+
+	switch {
+	case r.Form.Get("response_type") != responseTypeCode:
+		p.redirectError(ctx, w, r, errUnsupportedResponseType, "Only the code response type is supported")
+		return
+	case !hasScope(r.Form.Get("scope"), scopeOpenID):
+		p.redirectError(ctx, w, r, errInvalidScope, "Scope must include openid")
+		return
+	case r.Form.Get("code_challenge") == "" || r.Form.Get("code_challenge_method") != pkceS256:
+		p.redirectError(ctx, w, r, errInvalidRequest, "PKCE with the S256 method is required")
+		return
+	}
+
+	tkn := r.Form.Get(forms.DefaultTokenKey)
+	if tkn == "" {
+		p.loginRedirect(w, r)
+		return
+	}
+
+	code, err := p.Client.AuthorizeOIDC(ctx, &auth.OIDCAuthRequest{
+		Jwt:                 tkn,
+		ClientId:            r.Form.Get("client_id"),
+		RedirectUri:         r.Form.Get("redirect_uri"),
+		Scope:               r.Form.Get("scope"),
+		Nonce:               r.Form.Get("nonce"),
+		CodeChallenge:       r.Form.Get("code_challenge"),
+		CodeChallengeMethod: r.Form.Get("code_challenge_method"),
+	})
+	switch status.Code(err) {
+	case codes.OK:
+		params := url.Values{"code": {code.GetCode()}}
+		if state := r.Form.Get("state"); state != "" {
+			params.Set("state", state)
+		}
+		p.redirect(ctx, w, r, r.Form.Get("redirect_uri"), params)
+	case codes.Unauthenticated:
+		// Expired or otherwise invalid user token
+		clog.Info(ctx, "AuthorizeOIDC gRPC call", "err", err)
+		p.loginRedirect(w, r)
+	case codes.InvalidArgument:
+		p.redirectError(ctx, w, r, errInvalidRequest, status.Convert(err).Message())
+	case codes.ResourceExhausted:
+		p.redirectError(ctx, w, r, errUnavailable, "Too many requests")
+	default:
+		clog.Error(ctx, "AuthorizeOIDC gRPC call", "err", err)
+		p.redirectError(ctx, w, r, errServer, "")
+	}
+}
+
+// AuthorizeHandler returns the handler of the authorization endpoint.
+// Users without a token in the request are redirected to the login form first.
+func (p *Provider) AuthorizeHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r = r.WithContext(clog.AddArgs(r.Context(), "pkg", "authenticator.oidc", "handler", "Authorize"))
+
+		switch r.Method {
+		case http.MethodGet, http.MethodPost:
+			p.authorize(w, r)
+		default:
+			w.Header().Add("Allow", AllowedMethods)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
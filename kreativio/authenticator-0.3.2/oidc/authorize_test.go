@@ -0,0 +1,157 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package oidc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func authorizeQuery(modify func(url.Values)) string {
+	q := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {"public"},
+		"redirect_uri":          {testRedirect},
+		"scope":                 {"openid email"},
+		"state":                 {"xyz"},
+		"code_challenge":        {"E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"},
+		"code_challenge_method": {"S256"},
+	}
+	if modify != nil {
+		modify(q)
+	}
+	return DefaultAuthorizePath + "?" + q.Encode()
+}
+
+func TestProvider_AuthorizeHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		target   string
+		wantCode int
+		wantBody string
+		// Expected Location, with its query
+		wantPath  string
+		wantQuery url.Values
+	}{
+		{
+			"Unknown client", "GET",
+			authorizeQuery(func(q url.Values) { q.Set("client_id", "spanac") }),
+			http.StatusBadRequest, "Unknown client or redirect URI", "", nil,
+		},
+		{
+			"Unknown redirect", "GET",
+			authorizeQuery(func(q url.Values) { q.Set("redirect_uri", "https://evil.com/cb") }),
+			http.StatusBadRequest, "Unknown client or redirect URI", "", nil,
+		},
+		{
+			"Client error", "GET",
+			authorizeQuery(func(q url.Values) { q.Set("client_id", "internal") }),
+			http.StatusInternalServerError, "Internal server error", "", nil,
+		},
+		{
+			"Response type", "GET",
+			authorizeQuery(func(q url.Values) { q.Set("response_type", "token") }),
+			http.StatusFound, "", testRedirect, url.Values{"error": {errUnsupportedResponseType}, "state": {"xyz"}},
+		},
+		{
+			"Missing openid", "GET",
+			authorizeQuery(func(q url.Values) { q.Set("scope", "email") }),
+			http.StatusFound, "", testRedirect, url.Values{"error": {errInvalidScope}, "state": {"xyz"}},
+		},
+		{
+			"Missing PKCE", "GET",
+			authorizeQuery(func(q url.Values) { q.Del("code_challenge") }),
+			http.StatusFound, "", testRedirect, url.Values{"error": {errInvalidRequest}, "state": {"xyz"}},
+		},
+		{
+			"Plain PKCE", "GET",
+			authorizeQuery(func(q url.Values) { q.Set("code_challenge_method", "plain") }),
+			http.StatusFound, "", testRedirect, url.Values{"error": {errInvalidRequest}, "state": {"xyz"}},
+		},
+		{
+			"Login", "GET",
+			authorizeQuery(nil),
+			http.StatusFound, "", testIssuer + "/login", url.Values{"redirect": {testIssuer + authorizeQuery(nil)}},
+		},
+		{
+			"Expired token", "GET",
+			authorizeQuery(func(q url.Values) { q.Set("jwt", "expired") }),
+			http.StatusFound, "", testIssuer + "/login", url.Values{"redirect": {testIssuer + authorizeQuery(nil)}},
+		},
+		{
+			"Code", "GET",
+			authorizeQuery(func(q url.Values) { q.Set("jwt", "user") }),
+			http.StatusFound, "", testRedirect, url.Values{"code": {"valid"}, "state": {"xyz"}},
+		},
+		{
+			"Code without state", "GET",
+			authorizeQuery(func(q url.Values) { q.Set("jwt", "user"); q.Del("state") }),
+			http.StatusFound, "", testRedirect, url.Values{"code": {"valid"}},
+		},
+		{
+			"Rate limited", "GET",
+			authorizeQuery(func(q url.Values) { q.Set("jwt", "limited") }),
+			http.StatusFound, "", testRedirect, url.Values{"error": {errUnavailable}, "state": {"xyz"}},
+		},
+		{
+			"Internal error", "GET",
+			authorizeQuery(func(q url.Values) { q.Set("jwt", "internal") }),
+			http.StatusFound, "", testRedirect, url.Values{"error": {errServer}, "state": {"xyz"}},
+		},
+		{
+			"Method", "PUT",
+			authorizeQuery(nil),
+			http.StatusMethodNotAllowed, "", "", nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Provider{Issuer: testIssuer, Client: oidcClient{}}
+			w := httptest.NewRecorder()
+
+			p.AuthorizeHandler().ServeHTTP(w, httptest.NewRequest(tt.method, tt.target, nil))
+
+			if w.Code != tt.wantCode {
+				t.Fatalf("AuthorizeHandler() status = %v, want %v", w.Code, tt.wantCode)
+			}
+			if !strings.Contains(w.Body.String(), tt.wantBody) {
+				t.Errorf("AuthorizeHandler() = %s, missing %q", w.Body, tt.wantBody)
+			}
+			if tt.wantPath == "" {
+				return
+			}
+
+			u, err := url.Parse(w.Header().Get("Location"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			q := u.Query()
+			q.Del("error_description")
+			u.RawQuery = ""
+			if u.String() != tt.wantPath || q.Encode() != tt.wantQuery.Encode() {
+				t.Errorf("AuthorizeHandler() Location = %s?%s, want %s?%s", u, q.Encode(), tt.wantPath, tt.wantQuery.Encode())
+			}
+		})
+	}
+}
+
+func TestProvider_AuthorizeHandler_post(t *testing.T) {
+	p := &Provider{Issuer: testIssuer, Client: oidcClient{}}
+	w := httptest.NewRecorder()
+
+	r := httptest.NewRequest("POST", DefaultAuthorizePath, strings.NewReader(strings.SplitN(authorizeQuery(nil), "?", 2)[1]))
+	r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	p.AuthorizeHandler().ServeHTTP(w, r)
+
+	// The login form redirects back with GET
+	want := testIssuer + "/login?" + url.Values{"redirect": {testIssuer + authorizeQuery(nil)}}.Encode()
+	if got := w.Header().Get("Location"); got != want {
+		t.Errorf("AuthorizeHandler() Location = %s, want %s", got, want)
+	}
+}
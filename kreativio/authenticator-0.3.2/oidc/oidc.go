@@ -0,0 +1,174 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+/*
+Package oidc serves the OpenID Connect provider endpoints of the authenticator.
+
+Only the authorization code flow with PKCE (S256) is supported.
+Users log in through the login form of the forms package,
+which redirects back to the authorization endpoint with a user token.
+Clients are registered by administrators and there is no consent screen.
+
+ID tokens are signed with the same Ed25519 keys as all other tokens ("EdDSA"),
+which are published by the JWKS endpoint.
+The issuer configured on the authenticator server must be equal to Provider.Issuer.
+*/
+package oidc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	auth "github.com/moapis/authenticator"
+	"github.com/moapis/authenticator/forms"
+	"github.com/moapis/ehtml"
+	clog "github.com/usrpro/clog15"
+)
+
+// Default paths of the endpoints
+const (
+	DefaultDiscoveryPath = "/.well-known/openid-configuration"
+	DefaultAuthorizePath = "/oauth2/authorize"
+	DefaultTokenPath     = "/oauth2/token"
+	DefaultUserInfoPath  = "/oauth2/userinfo"
+	DefaultJWKSPath      = "/oauth2/jwks"
+)
+
+// Provider serves the OpenID Connect endpoints,
+// using the authenticator gRPC server.
+type Provider struct {
+	// Public base URL, as in the "iss" claim of ID tokens.
+	// Endpoint URLs in the discovery document are relative to it.
+	Issuer string
+	// Login form path, relative to Issuer.
+	// Defaults to forms.DefaultLoginPath.
+	Login  string
+	EP     ehtml.Pages
+	Client auth.AuthenticatorClient
+}
+
+func (p *Provider) login() string {
+	if p.Login == "" {
+		return forms.DefaultLoginPath
+	}
+	return p.Login
+}
+
+// Discovery is the OpenID Provider Metadata document.
+type Discovery struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+}
+
+// Discovery returns the metadata of p.
+func (p *Provider) Discovery() *Discovery {
+	return &Discovery{
+		Issuer:                            p.Issuer,
+		AuthorizationEndpoint:             p.Issuer + DefaultAuthorizePath,
+		TokenEndpoint:                     p.Issuer + DefaultTokenPath,
+		UserInfoEndpoint:                  p.Issuer + DefaultUserInfoPath,
+		JWKSURI:                           p.Issuer + DefaultJWKSPath,
+		ResponseTypesSupported:            []string{responseTypeCode},
+		GrantTypesSupported:               []string{grantTypeCode},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{algEdDSA},
+		ScopesSupported:                   []string{"openid", "email", "profile"},
+		ClaimsSupported:                   []string{"iss", "sub", "aud", "exp", "iat", "nonce", "email", "name"},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{pkceS256},
+	}
+}
+
+// JWK is an Ed25519 public key in JSON Web Key format, as defined by RFC 8037.
+type JWK struct {
+	KeyType string `json:"kty"`
+	Curve   string `json:"crv"`
+	X       string `json:"x"`
+	KeyID   string `json:"kid"`
+	Alg     string `json:"alg"`
+	Use     string `json:"use"`
+}
+
+// JWKS is a JSON Web Key Set.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+const algEdDSA = "EdDSA"
+
+func newJWKS(keys []*auth.PublicKey) *JWKS {
+	set := &JWKS{Keys: make([]JWK, len(keys))}
+	for i, k := range keys {
+		set.Keys[i] = JWK{
+			KeyType: "OKP",
+			Curve:   "Ed25519",
+			X:       base64.RawURLEncoding.EncodeToString(k.GetKey()),
+			KeyID:   strconv.Itoa(int(k.GetKid())),
+			Alg:     algEdDSA,
+			Use:     "sig",
+		}
+	}
+	return set
+}
+
+// writeJSON writes v as JSON response with code.
+func writeJSON(w http.ResponseWriter, r *http.Request, v interface{}, code int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		clog.Error(r.Context(), "Write JSON", "err", err)
+	}
+}
+
+// getOnly returns false and responds with MethodNotAllowed, if r is not a GET request.
+func getOnly(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method == http.MethodGet {
+		return true
+	}
+	w.Header().Add("Allow", http.MethodGet)
+	w.WriteHeader(http.StatusMethodNotAllowed)
+	return false
+}
+
+// DiscoveryHandler returns the handler serving the discovery document.
+func (p *Provider) DiscoveryHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !getOnly(w, r) {
+			return
+		}
+		writeJSON(w, r, p.Discovery(), http.StatusOK)
+	})
+}
+
+// JWKSHandler returns the handler serving the public keys, which may have signed valid tokens.
+func (p *Provider) JWKSHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !getOnly(w, r) {
+			return
+		}
+		ctx := clog.AddArgs(r.Context(), "pkg", "authenticator.oidc", "handler", "JWKS")
+
+		keys, err := p.Client.ListPubKeys(ctx, &empty.Empty{})
+		if err != nil {
+			clog.Error(ctx, "ListPubKeys gRPC call", "err", err)
+			writeJSON(w, r, &Error{Code: errServer}, http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, r, newJWKS(keys.GetKeys()), http.StatusOK)
+	})
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	auth "github.com/moapis/authenticator"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// oidcClient fakes the OIDC methods of the authenticator server.
+// Client "public" with redirect URI testRedirect is registered.
+// Token "user" is valid, "limited" is rate limited and "internal" fails internally.
+// Code "valid" is exchanged for tokens with client secret "secret".
+type oidcClient struct {
+	auth.AuthenticatorClient
+	keysErr error
+}
+
+const (
+	testIssuer   = "https://login.example.com"
+	testRedirect = "https://client.com/cb"
+)
+
+func (c oidcClient) ListPubKeys(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*auth.PublicKeys, error) {
+	if c.keysErr != nil {
+		return nil, c.keysErr
+	}
+	return &auth.PublicKeys{Keys: []*auth.PublicKey{{Key: []byte{1, 2, 3}, Kid: 10}}}, nil
+}
+
+func (oidcClient) CheckOIDCClient(ctx context.Context, in *auth.OIDCClientRequest, opts ...grpc.CallOption) (*auth.OIDCClient, error) {
+	switch {
+	case in.GetClientId() == "internal":
+		return nil, status.Error(codes.Internal, "Database error")
+	case in.GetClientId() != "public":
+		return nil, status.Error(codes.NotFound, "Unknown OIDC client")
+	case in.GetRedirectUri() != testRedirect:
+		return nil, status.Error(codes.InvalidArgument, "Redirect URI not registered for client")
+	}
+	return &auth.OIDCClient{Name: "Public"}, nil
+}
+
+func (oidcClient) AuthorizeOIDC(ctx context.Context, in *auth.OIDCAuthRequest, opts ...grpc.CallOption) (*auth.OIDCCode, error) {
+	switch in.GetJwt() {
+	case "user":
+		return &auth.OIDCCode{Code: "valid"}, nil
+	case "limited":
+		return nil, status.Error(codes.ResourceExhausted, "Too many requests")
+	case "internal":
+		return nil, status.Error(codes.Internal, "Database error")
+	}
+	return nil, status.Error(codes.Unauthenticated, "EdDSA verification failed")
+}
+
+func (oidcClient) ExchangeOIDCCode(ctx context.Context, in *auth.OIDCTokenRequest, opts ...grpc.CallOption) (*auth.OIDCTokens, error) {
+	switch {
+	case in.GetClientId() == "internal":
+		return nil, status.Error(codes.Internal, "Database error")
+	case in.GetClientId() != "public" || in.GetClientSecret() != "secret":
+		return nil, status.Error(codes.Unauthenticated, "Client authentication failed")
+	case in.GetCode() == "":
+		return nil, status.Error(codes.InvalidArgument, "Invalid authorization code")
+	case in.GetCode() != "valid":
+		return nil, status.Error(codes.PermissionDenied, "Invalid authorization code")
+	}
+	return &auth.OIDCTokens{AccessToken: "access", IdToken: "id", ExpiresIn: 3600, Scope: "openid"}, nil
+}
+
+func (oidcClient) GetOIDCUserInfo(ctx context.Context, in *auth.AuthReply, opts ...grpc.CallOption) (*auth.OIDCUserInfo, error) {
+	switch in.GetJwt() {
+	case "access":
+		return &auth.OIDCUserInfo{Sub: "1", Email: "user@example.com"}, nil
+	case "internal":
+		return nil, status.Error(codes.Internal, "Database error")
+	}
+	return nil, status.Error(codes.PermissionDenied, "Not an OIDC access token")
+}
+
+func TestProvider_DiscoveryHandler(t *testing.T) {
+	p := &Provider{Issuer: testIssuer}
+
+	w := httptest.NewRecorder()
+	p.DiscoveryHandler().ServeHTTP(w, httptest.NewRequest("GET", DefaultDiscoveryPath, nil))
+
+	var got Discovery
+	if err := json.NewDecoder(w.Result().Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, p.Discovery()) {
+		t.Errorf("DiscoveryHandler() = %v, want %v", got, p.Discovery())
+	}
+	if got.TokenEndpoint != testIssuer+DefaultTokenPath || got.JWKSURI != testIssuer+DefaultJWKSPath {
+		t.Errorf("DiscoveryHandler() = %v", got)
+	}
+
+	w = httptest.NewRecorder()
+	p.DiscoveryHandler().ServeHTTP(w, httptest.NewRequest("POST", DefaultDiscoveryPath, nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("DiscoveryHandler() status = %v, want %v", w.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestProvider_JWKSHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		client   oidcClient
+		wantCode int
+		want     string
+	}{
+		{"Keys", oidcClient{}, http.StatusOK, `{"keys":[{"kty":"OKP","crv":"Ed25519","x":"AQID","kid":"10","alg":"EdDSA","use":"sig"}]}` + "\n"},
+		{"Internal error", oidcClient{keysErr: status.Error(codes.Internal, "Database error")}, http.StatusInternalServerError, `{"error":"server_error"}` + "\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Provider{Issuer: testIssuer, Client: tt.client}
+			w := httptest.NewRecorder()
+
+			p.JWKSHandler().ServeHTTP(w, httptest.NewRequest("GET", DefaultJWKSPath, nil))
+
+			if w.Code != tt.wantCode {
+				t.Errorf("JWKSHandler() status = %v, want %v", w.Code, tt.wantCode)
+			}
+			if got := w.Body.String(); got != tt.want {
+				t.Errorf("JWKSHandler() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
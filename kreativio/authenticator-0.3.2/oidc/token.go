@@ -0,0 +1,195 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	auth "github.com/moapis/authenticator"
+	clog "github.com/usrpro/clog15"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	grantTypeCode = "authorization_code"
+	tokenType     = "Bearer"
+)
+
+// TokenResponse is the successful response of the token endpoint.
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	IDToken     string `json:"id_token"`
+	Scope       string `json:"scope,omitempty"`
+}
+
+// UserInfo is the response of the userinfo endpoint.
+type UserInfo struct {
+	Subject string `json:"sub"`
+	Email   string `json:"email,omitempty"`
+	Name    string `json:"name,omitempty"`
+}
+
+// writeTokenJSON writes v, without allowing it to be cached.
+func writeTokenJSON(w http.ResponseWriter, r *http.Request, v interface{}, code int) {
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	writeJSON(w, r, v, code)
+}
+
+// clientCredentials returns the client ID and secret from HTTP basic authentication,
+// or else from the form.
+func clientCredentials(r *http.Request) (id, secret string, basic bool, err error) {
+	id, secret, basic = r.BasicAuth()
+	if !basic {
+		return r.PostForm.Get("client_id"), r.PostForm.Get("client_secret"), false, nil
+	}
+	// Form encoded, as per RFC 6749, section 2.3.1
+	if id, err = url.QueryUnescape(id); err != nil {
+		return "", "", true, err
+	}
+	if secret, err = url.QueryUnescape(secret); err != nil {
+		return "", "", true, err
+	}
+	return id, secret, true, nil
+}
+
+func (p *Provider) token(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	ctx = clog.AddArgs(ctx, "method", "token")
+
+	if err := r.ParseForm(); err != nil {
+		clog.Warn(ctx, "Parseform", "err", err)
+		writeTokenJSON(w, r, &Error{errInvalidRequest, "Malformed form data"}, http.StatusBadRequest)
+		return
+	}
+	if gt := r.PostForm.Get("grant_type"); gt != grantTypeCode {
+		clog.Warn(ctx, "Unsupported grant type", "grant_type", gt)
+		writeTokenJSON(w, r, &Error{Code: errUnsupportedGrantType}, http.StatusBadRequest)
+		return
+	}
+	id, secret, basic, err := clientCredentials(r)
+	if err != nil {
+		clog.Warn(ctx, "Client credentials", "err", err)
+		writeTokenJSON(w, r, &Error{errInvalidRequest, "Malformed client credentials"}, http.StatusBadRequest)
+		return
+	}
+
+	tokens, err := p.Client.ExchangeOIDCCode(ctx, &auth.OIDCTokenRequest{
+		Code:         r.PostForm.Get("code"),
+		ClientId:     id,
+		ClientSecret: secret,
+		RedirectUri:  r.PostForm.Get("redirect_uri"),
+		CodeVerifier: r.PostForm.Get("code_verifier"),
+	})
+	switch status.Code(err) {
+	case codes.OK:
+		writeTokenJSON(w, r, &TokenResponse{
+			AccessToken: tokens.GetAccessToken(),
+			TokenType:   tokenType,
+			ExpiresIn:   tokens.GetExpiresIn(),
+			IDToken:     tokens.GetIdToken(),
+			Scope:       tokens.GetScope(),
+		}, http.StatusOK)
+	case codes.Unauthenticated:
+		clog.Warn(ctx, "ExchangeOIDCCode gRPC call", "err", err)
+		if basic {
+			w.Header().Set("WWW-Authenticate", `Basic realm="token"`)
+		}
+		writeTokenJSON(w, r, &Error{Code: errInvalidClient}, http.StatusUnauthorized)
+	case codes.PermissionDenied:
+		clog.Warn(ctx, "ExchangeOIDCCode gRPC call", "err", err)
+		writeTokenJSON(w, r, &Error{Code: errInvalidGrant}, http.StatusBadRequest)
+	case codes.InvalidArgument:
+		clog.Warn(ctx, "ExchangeOIDCCode gRPC call", "err", err)
+		writeTokenJSON(w, r, &Error{errInvalidRequest, status.Convert(err).Message()}, http.StatusBadRequest)
+	case codes.ResourceExhausted:
+		clog.Warn(ctx, "ExchangeOIDCCode gRPC call", "err", err)
+		writeTokenJSON(w, r, &Error{errUnavailable, "Too many requests"}, http.StatusTooManyRequests)
+	default:
+		clog.Error(ctx, "ExchangeOIDCCode gRPC call", "err", err)
+		writeTokenJSON(w, r, &Error{Code: errServer}, http.StatusInternalServerError)
+	}
+}
+
+// TokenHandler returns the handler of the token endpoint.
+// Confidential clients may authenticate with HTTP basic authentication or form parameters.
+func (p *Provider) TokenHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r = r.WithContext(clog.AddArgs(r.Context(), "pkg", "authenticator.oidc", "handler", "Token"))
+
+		if r.Method != http.MethodPost {
+			w.Header().Add("Allow", http.MethodPost)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		p.token(w, r)
+	})
+}
+
+// bearerToken returns the token from the Authorization header.
+func bearerToken(r *http.Request) string {
+	h := r.Header.Get("Authorization")
+	if len(h) > len(tokenType) && strings.EqualFold(h[:len(tokenType)+1], tokenType+" ") {
+		return strings.TrimSpace(h[len(tokenType)+1:])
+	}
+	return ""
+}
+
+func (p *Provider) userInfo(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	ctx = clog.AddArgs(ctx, "method", "userInfo")
+
+	tkn := bearerToken(r)
+	if tkn == "" {
+		clog.Info(ctx, "Missing bearer token")
+		w.Header().Set("WWW-Authenticate", tokenType)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	info, err := p.Client.GetOIDCUserInfo(ctx, &auth.AuthReply{Jwt: tkn})
+	switch status.Code(err) {
+	case codes.OK:
+		writeTokenJSON(w, r, &UserInfo{
+			Subject: info.GetSub(),
+			Email:   info.GetEmail(),
+			Name:    info.GetName(),
+		}, http.StatusOK)
+	case codes.Unauthenticated, codes.PermissionDenied, codes.InvalidArgument, codes.NotFound:
+		clog.Info(ctx, "GetOIDCUserInfo gRPC call", "err", err)
+		w.Header().Set("WWW-Authenticate", tokenType+` error="`+errInvalidToken+`"`)
+		writeTokenJSON(w, r, &Error{Code: errInvalidToken}, http.StatusUnauthorized)
+	default:
+		clog.Error(ctx, "GetOIDCUserInfo gRPC call", "err", err)
+		writeTokenJSON(w, r, &Error{Code: errServer}, http.StatusInternalServerError)
+	}
+}
+
+// UserInfoHandler returns the handler of the userinfo endpoint.
+// The access token is read from the Authorization header.
+func (p *Provider) UserInfoHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r = r.WithContext(clog.AddArgs(r.Context(), "pkg", "authenticator.oidc", "handler", "UserInfo"))
+
+		switch r.Method {
+		case http.MethodGet, http.MethodPost:
+			p.userInfo(w, r)
+		default:
+			w.Header().Add("Allow", AllowedMethods)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
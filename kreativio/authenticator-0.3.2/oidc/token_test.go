@@ -0,0 +1,101 @@
+// Copyright (c) 2020, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package oidc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestProvider_TokenHandler(t *testing.T) {
+	const body = "grant_type=authorization_code&code=valid&redirect_uri=https%3A%2F%2Fclient.com%2Fcb&code_verifier=verifier"
+
+	tests := []struct {
+		name     string
+		method   string
+		body     string
+		basic    []string
+		wantCode int
+		want     string
+	}{
+		{"Basic auth", "POST", body, []string{"public", "secret"}, http.StatusOK, `{"access_token":"access","token_type":"Bearer","expires_in":3600,"id_token":"id","scope":"openid"}`},
+		{"Basic auth encoded", "POST", body, []string{"public", "s%65cret"}, http.StatusOK, `"access_token":"access"`},
+		{"Basic auth malformed", "POST", body, []string{"public", "%zz"}, http.StatusBadRequest, `"error":"invalid_request"`},
+		{"Form auth", "POST", body + "&client_id=public&client_secret=secret", nil, http.StatusOK, `"id_token":"id"`},
+		{"Wrong secret", "POST", body, []string{"public", "spanac"}, http.StatusUnauthorized, `{"error":"invalid_client"}`},
+		{"Unknown code", "POST", strings.Replace(body, "code=valid", "code=spanac", 1) + "&client_id=public&client_secret=secret", nil, http.StatusBadRequest, `{"error":"invalid_grant"}`},
+		{"Missing code", "POST", strings.Replace(body, "code=valid", "", 1) + "&client_id=public&client_secret=secret", nil, http.StatusBadRequest, `"error":"invalid_request"`},
+		{"Grant type", "POST", strings.Replace(body, "authorization_code", "password", 1), []string{"public", "secret"}, http.StatusBadRequest, `{"error":"unsupported_grant_type"}`},
+		{"Malformed body", "POST", "%sssssssss", nil, http.StatusBadRequest, `"error":"invalid_request"`},
+		{"Internal error", "POST", body + "&client_id=internal", nil, http.StatusInternalServerError, `{"error":"server_error"}`},
+		{"Method", "GET", "", nil, http.StatusMethodNotAllowed, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Provider{Issuer: testIssuer, Client: oidcClient{}}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(tt.method, DefaultTokenPath, strings.NewReader(tt.body))
+			r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+			if tt.basic != nil {
+				r.SetBasicAuth(tt.basic[0], tt.basic[1])
+			}
+
+			p.TokenHandler().ServeHTTP(w, r)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("TokenHandler() status = %v, want %v", w.Code, tt.wantCode)
+			}
+			if !strings.Contains(w.Body.String(), tt.want) {
+				t.Errorf("TokenHandler() = %s, missing %s", w.Body, tt.want)
+			}
+			if tt.method == "POST" && w.Header().Get("Cache-Control") != "no-store" {
+				t.Errorf("TokenHandler() Cache-Control = %q, want no-store", w.Header().Get("Cache-Control"))
+			}
+		})
+	}
+}
+
+func TestProvider_UserInfoHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		auth       string
+		wantCode   int
+		want       string
+		wantHeader string
+	}{
+		{"Info", "GET", "Bearer access", http.StatusOK, `{"sub":"1","email":"user@example.com"}`, ""},
+		{"Lower case scheme", "POST", "bearer access", http.StatusOK, `"sub":"1"`, ""},
+		{"Missing token", "GET", "", http.StatusUnauthorized, "", "Bearer"},
+		{"Basic scheme", "GET", "Basic access", http.StatusUnauthorized, "", "Bearer"},
+		{"User token", "GET", "Bearer user", http.StatusUnauthorized, `{"error":"invalid_token"}`, `Bearer error="invalid_token"`},
+		{"Internal error", "GET", "Bearer internal", http.StatusInternalServerError, `{"error":"server_error"}`, ""},
+		{"Method", "PUT", "Bearer access", http.StatusMethodNotAllowed, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Provider{Issuer: testIssuer, Client: oidcClient{}}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(tt.method, DefaultUserInfoPath, nil)
+			if tt.auth != "" {
+				r.Header.Set("Authorization", tt.auth)
+			}
+
+			p.UserInfoHandler().ServeHTTP(w, r)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("UserInfoHandler() status = %v, want %v", w.Code, tt.wantCode)
+			}
+			if !strings.Contains(w.Body.String(), tt.want) {
+				t.Errorf("UserInfoHandler() = %s, missing %s", w.Body, tt.want)
+			}
+			if got := w.Header().Get("WWW-Authenticate"); got != tt.wantHeader {
+				t.Errorf("UserInfoHandler() WWW-Authenticate = %q, want %q", got, tt.wantHeader)
+			}
+		})
+	}
+}
@@ -82,7 +82,7 @@ func (m *ImageDimensions) GetAlpha() uint32 {
 type NewImageRequest struct {
 	Image                [][]byte         `protobuf:"bytes,1,rep,name=image,proto3" json:"image,omitempty"`
 	Dimensions           *ImageDimensions `protobuf:"bytes,2,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
-	Tkn                  string           `protobuf:"bytes,3,opt,name=tkn,proto3" json:"tkn,omitempty"`
+	Tkn                  string           `protobuf:"bytes,3,opt,name=tkn,proto3" json:"tkn,omitempty"` // Deprecated: Do not use.
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -127,6 +127,7 @@ func (m *NewImageRequest) GetDimensions() *ImageDimensions {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *NewImageRequest) GetTkn() string {
 	if m != nil {
 		return m.Tkn
@@ -183,7 +184,7 @@ func (m *NewImageResponse) GetStructure() []*NewImageResponseStruct {
 
 type RemoveImageRequest struct {
 	Link                 []string `protobuf:"bytes,1,rep,name=link,proto3" json:"link,omitempty"`
-	Tkn                  string   `protobuf:"bytes,2,opt,name=tkn,proto3" json:"tkn,omitempty"`
+	Tkn                  string   `protobuf:"bytes,2,opt,name=tkn,proto3" json:"tkn,omitempty"` // Deprecated: Do not use.
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -221,6 +222,7 @@ func (m *RemoveImageRequest) GetLink() []string {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *RemoveImageRequest) GetTkn() string {
 	if m != nil {
 		return m.Tkn
@@ -336,7 +338,7 @@ type OverlayRequest struct {
 	Position             string   `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
 	ResizeX              int32    `protobuf:"varint,4,opt,name=resizeX,proto3" json:"resizeX,omitempty"`
 	ResizeY              int32    `protobuf:"varint,5,opt,name=resizeY,proto3" json:"resizeY,omitempty"`
-	Tkn                  string   `protobuf:"bytes,6,opt,name=tkn,proto3" json:"tkn,omitempty"`
+	Tkn                  string   `protobuf:"bytes,6,opt,name=tkn,proto3" json:"tkn,omitempty"` // Deprecated: Do not use.
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -402,6 +404,7 @@ func (m *OverlayRequest) GetResizeY() int32 {
 	return 0
 }
 
+// Deprecated: Do not use.
 func (m *OverlayRequest) GetTkn() string {
 	if m != nil {
 		return m.Tkn
@@ -462,41 +465,41 @@ func init() {
 func init() { proto.RegisterFile("image_api.proto", fileDescriptor_5487c576977b4a3c) }
 
 var fileDescriptor_5487c576977b4a3c = []byte{
-	// 529 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x95, 0xf3, 0xd7, 0x2f, 0x37, 0xf9, 0xea, 0xea, 0x82, 0x22, 0x13, 0x21, 0x30, 0x06, 0x44,
-	0x36, 0x64, 0x11, 0x76, 0xac, 0x40, 0x0a, 0x8b, 0x48, 0x15, 0xa0, 0xa9, 0x2a, 0xda, 0x15, 0x4c,
-	0x93, 0x21, 0x19, 0x25, 0xf1, 0x98, 0x99, 0x71, 0x2a, 0xca, 0xab, 0xf0, 0x06, 0xbc, 0x04, 0x8f,
-	0x86, 0x3c, 0x63, 0xc7, 0x7f, 0xa5, 0x6c, 0xb2, 0xf3, 0x3d, 0x73, 0xee, 0x3d, 0xc7, 0x3e, 0xd7,
-	0x03, 0x2e, 0xdf, 0xd2, 0x25, 0xfb, 0x4c, 0x23, 0x3e, 0x8e, 0xa4, 0xd0, 0x02, 0xdd, 0xad, 0xa0,
-	0x11, 0x57, 0xe3, 0xaf, 0x7c, 0x63, 0xe0, 0xe0, 0x1c, 0xdc, 0x59, 0xc2, 0x99, 0xf2, 0x2d, 0x0b,
-	0x15, 0x17, 0xa1, 0xc2, 0xfb, 0xd0, 0xbe, 0xe6, 0x0b, 0xbd, 0xf2, 0x1c, 0xdf, 0x19, 0xfd, 0x4f,
-	0x6c, 0x81, 0x03, 0xe8, 0xac, 0x18, 0x5f, 0xae, 0xb4, 0xd7, 0x30, 0x70, 0x5a, 0x25, 0x6c, 0xba,
-	0x89, 0x56, 0xd4, 0x6b, 0x5a, 0xb6, 0x29, 0x82, 0x1f, 0xe0, 0xbe, 0x67, 0xd7, 0x66, 0x32, 0x61,
-	0xdf, 0x62, 0xa6, 0x0c, 0xd1, 0xb8, 0xf1, 0x1c, 0xbf, 0x39, 0xea, 0x13, 0x5b, 0xe0, 0x1b, 0x80,
-	0xc5, 0x5e, 0xda, 0x8c, 0xee, 0x4d, 0xfc, 0x71, 0xc5, 0xe5, 0xb8, 0x62, 0x91, 0x14, 0x7a, 0xf0,
-	0x04, 0x9a, 0x7a, 0x1d, 0x1a, 0xf9, 0x2e, 0x49, 0x1e, 0x83, 0x2d, 0x9c, 0xe4, 0xe2, 0x2a, 0x12,
-	0xa1, 0x62, 0x88, 0xd0, 0xda, 0xf0, 0x70, 0x6d, 0xc4, 0xbb, 0xc4, 0x3c, 0xe3, 0x3b, 0xe8, 0x9e,
-	0x69, 0x19, 0xcf, 0x75, 0x2c, 0x99, 0xd7, 0xf0, 0x9b, 0xa3, 0xde, 0xe4, 0x45, 0x4d, 0xba, 0x3a,
-	0xc9, 0x76, 0x90, 0xbc, 0x33, 0x78, 0x0d, 0x48, 0xd8, 0x56, 0xec, 0x58, 0xe9, 0x75, 0x6f, 0x13,
-	0x4c, 0xad, 0x36, 0x72, 0xab, 0x2f, 0xe1, 0x5e, 0xa9, 0x37, 0x75, 0x3b, 0x80, 0x8e, 0xd2, 0x54,
-	0xc7, 0xca, 0x64, 0xd0, 0x25, 0x69, 0x15, 0xfc, 0x74, 0x60, 0x70, 0xbb, 0x21, 0x0c, 0xa0, 0x2f,
-	0x24, 0x5f, 0xf2, 0x90, 0x6e, 0x4e, 0xad, 0x6e, 0xd2, 0x58, 0xc2, 0xd0, 0x87, 0x9e, 0x64, 0x8a,
-	0xdf, 0xb0, 0x85, 0xa1, 0x58, 0x1f, 0x45, 0x08, 0x1f, 0x01, 0x64, 0x1d, 0xb3, 0x69, 0x1a, 0x69,
-	0x01, 0xc1, 0x87, 0xd0, 0x4d, 0xe9, 0xb3, 0xa9, 0xd7, 0x32, 0xc7, 0x39, 0x10, 0xfc, 0x76, 0xe0,
-	0xf8, 0xc3, 0x8e, 0xc9, 0x0d, 0xfd, 0x9e, 0x7d, 0x86, 0xc4, 0x96, 0x45, 0x66, 0x69, 0xf8, 0xce,
-	0xa8, 0x4f, 0x4a, 0x18, 0x8e, 0xc0, 0xbd, 0xa2, 0xf3, 0xf5, 0x52, 0x8a, 0x38, 0x5c, 0x58, 0x5a,
-	0xc3, 0xd0, 0xaa, 0x30, 0x0e, 0xe1, 0xbf, 0x48, 0x28, 0xae, 0xb9, 0xc8, 0x02, 0xdf, 0xd7, 0xe8,
-	0xc1, 0x91, 0x75, 0x72, 0x61, 0x8c, 0xb5, 0x49, 0x56, 0xe6, 0x27, 0x97, 0x5e, 0xbb, 0x78, 0x72,
-	0x99, 0x05, 0xd2, 0xc9, 0x03, 0x79, 0x0e, 0xee, 0xfe, 0x0d, 0x6a, 0xab, 0xe3, 0x64, 0x49, 0x4e,
-	0x7e, 0xb5, 0xa0, 0x6f, 0x2c, 0x9d, 0x31, 0xb9, 0xe3, 0x73, 0x86, 0xe7, 0x70, 0x5c, 0x08, 0x86,
-	0xdf, 0x30, 0xf4, 0xef, 0x58, 0x25, 0xf3, 0x6d, 0x86, 0x4f, 0xfe, 0xb9, 0x6c, 0xf8, 0x29, 0x5f,
-	0xe5, 0x8f, 0x92, 0x29, 0x26, 0x77, 0x07, 0x1a, 0xfc, 0x05, 0x1e, 0x94, 0xfd, 0xbe, 0x0d, 0x17,
-	0x87, 0x55, 0xa0, 0x30, 0xac, 0x28, 0xe8, 0xc2, 0x25, 0x73, 0x10, 0x89, 0x0b, 0xe8, 0x15, 0xfe,
-	0x1e, 0x7c, 0x5a, 0xeb, 0xa8, 0xff, 0x97, 0xc3, 0x67, 0x77, 0x93, 0xd2, 0xc9, 0xa7, 0x70, 0x94,
-	0xae, 0x01, 0x3e, 0xae, 0x35, 0x94, 0x57, 0x7c, 0xe8, 0xff, 0x9d, 0x60, 0xa7, 0x5d, 0x75, 0xcc,
-	0xe5, 0xfb, 0xea, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x44, 0x70, 0x16, 0xea, 0x8f, 0x05, 0x00,
-	0x00,
+	// 530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x95, 0x93, 0x26, 0x25, 0x93, 0x50, 0xa3, 0xa1, 0x8a, 0x4c, 0x84, 0xc0, 0x18, 0x10, 0xb9,
+	0x90, 0x43, 0xb8, 0x23, 0x40, 0xe1, 0x10, 0xa9, 0x02, 0xb4, 0x55, 0x45, 0x7b, 0x82, 0x6d, 0xb2,
+	0x24, 0xab, 0x24, 0x5e, 0xb3, 0xbb, 0x4e, 0x45, 0x2f, 0xfc, 0x11, 0xfe, 0x01, 0x7f, 0x83, 0x1f,
+	0x86, 0xbc, 0x5e, 0xc7, 0x5f, 0xa5, 0x5c, 0x72, 0xf3, 0xbc, 0x7d, 0x6f, 0xde, 0xb3, 0x67, 0xbc,
+	0xe0, 0xf2, 0x0d, 0x5d, 0xb0, 0x2f, 0x34, 0xe2, 0xa3, 0x48, 0x0a, 0x2d, 0xd0, 0xdd, 0x08, 0x1a,
+	0x71, 0x35, 0xfa, 0xc6, 0xd7, 0x06, 0x0e, 0xce, 0xc0, 0x9d, 0x26, 0x9c, 0x09, 0xdf, 0xb0, 0x50,
+	0x71, 0x11, 0x2a, 0x3c, 0x86, 0xd6, 0x15, 0x9f, 0xeb, 0xa5, 0xe7, 0xf8, 0xce, 0xf0, 0x2e, 0x49,
+	0x0b, 0xec, 0x43, 0x7b, 0xc9, 0xf8, 0x62, 0xa9, 0xbd, 0x86, 0x81, 0x6d, 0x95, 0xb0, 0xe9, 0x3a,
+	0x5a, 0x52, 0xaf, 0x99, 0xb2, 0x4d, 0x11, 0xfc, 0x04, 0xf7, 0x03, 0xbb, 0x32, 0x9d, 0x09, 0xfb,
+	0x1e, 0x33, 0x65, 0x88, 0x26, 0x8d, 0xe7, 0xf8, 0xcd, 0x61, 0x8f, 0xa4, 0x05, 0xbe, 0x01, 0x98,
+	0xef, 0xac, 0x4d, 0xeb, 0xee, 0xd8, 0x1f, 0x55, 0x52, 0x8e, 0x2a, 0x11, 0x49, 0x41, 0x83, 0xc7,
+	0xd0, 0xd4, 0xab, 0xd0, 0xd8, 0x77, 0xde, 0x35, 0x3c, 0x87, 0x24, 0x65, 0xb0, 0x81, 0x7b, 0x79,
+	0x00, 0x15, 0x89, 0x50, 0x31, 0x44, 0x38, 0x58, 0xf3, 0x70, 0x65, 0x02, 0x74, 0x88, 0x79, 0xc6,
+	0xf7, 0xd0, 0x39, 0xd5, 0x32, 0x9e, 0xe9, 0x58, 0x32, 0xaf, 0xe1, 0x37, 0x87, 0xdd, 0xf1, 0x8b,
+	0x9a, 0x7d, 0xb5, 0x53, 0xaa, 0x20, 0xb9, 0x32, 0x78, 0x0d, 0x48, 0xd8, 0x46, 0x6c, 0x59, 0xe9,
+	0x95, 0x6f, 0x32, 0xb4, 0x71, 0x1b, 0xe5, 0xb8, 0x2f, 0xe1, 0x7e, 0x49, 0x6f, 0x13, 0xf7, 0xa1,
+	0xad, 0x34, 0xd5, 0xb1, 0x32, 0xb3, 0xe8, 0x10, 0x5b, 0x05, 0xbf, 0x1c, 0xe8, 0xdf, 0x1c, 0x0a,
+	0x03, 0xe8, 0x09, 0xc9, 0x17, 0x3c, 0xa4, 0xeb, 0x93, 0xd4, 0x3b, 0x11, 0x96, 0x30, 0xf4, 0xa1,
+	0x2b, 0x99, 0xe2, 0xd7, 0x6c, 0x6e, 0x28, 0x26, 0x0b, 0x29, 0x42, 0xf8, 0x08, 0x20, 0x53, 0x4c,
+	0x27, 0x76, 0xb4, 0x05, 0x04, 0x1f, 0x42, 0xc7, 0xd2, 0xa7, 0x13, 0xef, 0xc0, 0x1c, 0xe7, 0x40,
+	0xf0, 0xc7, 0x81, 0xa3, 0x8f, 0x5b, 0x26, 0xd7, 0xf4, 0x47, 0xf6, 0x29, 0x92, 0x58, 0x29, 0x32,
+	0xb5, 0x4b, 0xe0, 0x0c, 0x7b, 0xa4, 0x84, 0xe1, 0x10, 0xdc, 0x4b, 0x3a, 0x5b, 0x2d, 0xa4, 0x88,
+	0xc3, 0x79, 0x4a, 0x6b, 0x18, 0x5a, 0x15, 0xc6, 0x01, 0xdc, 0x89, 0x84, 0xe2, 0x9a, 0x0b, 0x3b,
+	0x78, 0xb2, 0xab, 0xd1, 0x83, 0xc3, 0x34, 0xc9, 0xb9, 0x09, 0xd6, 0x22, 0x59, 0x99, 0x9f, 0x5c,
+	0x78, 0xad, 0xe2, 0xc9, 0x45, 0x36, 0x94, 0x76, 0x79, 0x28, 0xcf, 0xc1, 0xdd, 0xbd, 0x45, 0x6d,
+	0x85, 0x9c, 0x6c, 0xa2, 0xe3, 0xdf, 0x07, 0xd0, 0x33, 0xb1, 0x4e, 0x99, 0xdc, 0xf2, 0x19, 0xc3,
+	0x33, 0x38, 0x2a, 0x0c, 0x87, 0x5f, 0x33, 0xf4, 0x6f, 0x59, 0x29, 0xf3, 0x7d, 0x06, 0x4f, 0xfe,
+	0xbb, 0x74, 0xf8, 0x39, 0x5f, 0xe9, 0x4f, 0x92, 0x29, 0x26, 0xb7, 0x7b, 0x6a, 0xfc, 0x15, 0x1e,
+	0x94, 0xf3, 0xbe, 0x0d, 0xe7, 0xfb, 0x75, 0xa0, 0x30, 0xa8, 0x38, 0xe8, 0xc2, 0x85, 0xb3, 0x17,
+	0x8b, 0x73, 0xe8, 0x16, 0xfe, 0x20, 0x7c, 0x5a, 0x53, 0xd4, 0xff, 0xcf, 0xc1, 0xb3, 0xdb, 0x49,
+	0xb6, 0xf3, 0x09, 0x1c, 0xda, 0x35, 0xc0, 0xc7, 0x35, 0x41, 0x79, 0xcd, 0x07, 0xfe, 0xbf, 0x09,
+	0x69, 0xb7, 0xcb, 0xb6, 0xb9, 0x88, 0x5f, 0xfd, 0x1d, 0x00, 0xf7, 0x03, 0x10, 0x49, 0x9b, 0x05,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -28,6 +28,7 @@ import (
 	"github.com/volatiletech/sqlboiler/queries/qm"
 	"google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	status "google.golang.org/grpc/status"
 )
 
@@ -54,9 +55,24 @@ const (
 	errorStringInternal          = "Internal server error, check server logs for aditional information."
 	errorInvalidContentFound     = "Invalid content type found at indexes: %+v"
 	errorPermissionDenied        = "Token validation failed."
+	errorMethodDenied            = "Method not permitted."
+	errorPermissionMissing       = "Missing permission: %s"
 	invalidDimensionsErrorString = "Invalid resize dimensions supplied."
+	bearerPrefix                 = "Bearer "
+	permissionPublic             = "public"
 )
 
+// methodPermissions maps every method to the permission it requires.
+// Methods which are not listed are denied.
+var methodPermissions = map[string]string{
+	"/moapis.file_api.ImageService/NewImageResize":             "images:write",
+	"/moapis.file_api.ImageService/NewImagePreserve":           "images:write",
+	"/moapis.file_api.ImageService/NewImageResizeAndPreserve":  "images:write",
+	"/moapis.file_api.ImageService/NewImageResizeAtDimensions": "images:write",
+	"/moapis.file_api.ImageService/RemoveImage":                "images:write",
+	"/moapis.file_api.ImageService/Overlay":                    permissionPublic,
+}
+
 var clientTokenChecker verify.Verificator
 
 type imageServiceServer struct {
@@ -377,22 +393,90 @@ func verifyTkn(ctx context.Context, tkn string) (*jwt.Claims, error) {
 	return jwtClaims, nil
 }
 
+// bearerToken returns the token from the "authorization: Bearer" metadata.
+// A malformed authorization value results in an empty token, which fails verification.
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	vals := md.Get("authorization")
+	if len(vals) == 0 {
+		return "", false
+	}
+	if len(vals[0]) <= len(bearerPrefix) || !strings.EqualFold(vals[0][:len(bearerPrefix)], bearerPrefix) {
+		return "", true
+	}
+	return strings.TrimSpace(vals[0][len(bearerPrefix):]), true
+}
+
+// requestTkn returns the deprecated tkn field of requests which require a token.
+func requestTkn(rq interface{}) (string, bool) {
+	if request, ok := isNewImageRq(rq); ok {
+		return request.GetTkn(), true
+	}
+	if request, ok := isRemoveImageRq(rq); ok {
+		return request.GetTkn(), true
+	}
+	return "", false
+}
+
+type claimsKey struct{}
+
+// claimsFromContext returns the claims of the verified token of the request.
+func claimsFromContext(ctx context.Context) (*jwt.Claims, bool) {
+	c, ok := ctx.Value(claimsKey{}).(*jwt.Claims)
+	return c, ok
+}
+
+// hasPermission reports whether the "permissions" claim holds permission.
+func hasPermission(c *jwt.Claims, permission string) bool {
+	if c == nil {
+		return false
+	}
+	ps, _ := c.Set["permissions"].([]interface{})
+	for _, p := range ps {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
 // Applicable for all gRPC service methods.
+// A token in the authorization metadata is verified for every method.
+// Without it, the tkn field of the request is used.
+// Methods are denied, unless the token holds the permission from methodPermissions.
 func (is *imageServiceServer) tokenCheckInterceptor(ctx context.Context, rq interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	var jwtc *jwt.Claims
 	var e error
-	if request, ok := isNewImageRq(rq); ok {
-		jwtc, e = verifyTkn(ctx, request.GetTkn())
+	if info == nil {
+		return nil, status.Error(codes.PermissionDenied, errorMethodDenied)
 	}
-	if request, ok := isRemoveImageRq(rq); ok {
-		jwtc, e = verifyTkn(ctx, request.GetTkn())
+	permission, ok := methodPermissions[info.FullMethod]
+	if !ok {
+		log.Printf("Method: [%s] not permitted\n", info.FullMethod)
+		return nil, status.Error(codes.PermissionDenied, errorMethodDenied)
+	}
+	tkn, ok := bearerToken(ctx)
+	if !ok {
+		tkn, ok = requestTkn(rq)
 	}
-	if (info != nil) && (jwtc != nil) {
+	if ok {
+		jwtc, e = verifyTkn(ctx, tkn)
+	}
+	if jwtc != nil {
 		log.Printf("Method: [%s]\n Issued: [%s] Subject: [%s]  \nerror: [%v]\n", info.FullMethod, jwtc.Issued.String(), jwtc.Subject, e)
 	}
 	if e != nil {
 		return nil, status.Error(codes.PermissionDenied, errorPermissionDenied)
 	}
+	if permission != permissionPublic && !hasPermission(jwtc, permission) {
+		return nil, status.Errorf(codes.PermissionDenied, errorPermissionMissing, permission)
+	}
+	if jwtc != nil {
+		ctx = context.WithValue(ctx, claimsKey{}, jwtc)
+	}
 	return handler(ctx, rq)
 }
 
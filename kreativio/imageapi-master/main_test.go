@@ -16,9 +16,11 @@ import (
 	pb "github.com/moapis/imageapi/imageapi"
 	"github.com/moapis/imageapi/models"
 	s3 "github.com/moapis/imageapi/s3"
+	"github.com/pascaldekloe/jwt"
 	"github.com/volatiletech/null"
 	"github.com/volatiletech/sqlboiler/boil"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	fakesock "google.golang.org/grpc/test/bufconn"
 )
@@ -586,7 +588,7 @@ func Test_imageServiceServer_tokenCheckInterceptor(t *testing.T) {
 			args: args{
 				ctx:  context.TODO(),
 				rq:   &pb.NewImageRequest{},
-				info: &grpc.UnaryServerInfo{},
+				info: &grpc.UnaryServerInfo{FullMethod: "/moapis.file_api.ImageService/NewImageResize"},
 				handler: func(ctx context.Context, rq interface{}) (interface{}, error) {
 					return nil, nil
 				},
@@ -601,7 +603,67 @@ func Test_imageServiceServer_tokenCheckInterceptor(t *testing.T) {
 			args: args{
 				ctx:  context.TODO(),
 				rq:   &pb.RemoveImageRequest{},
-				info: &grpc.UnaryServerInfo{},
+				info: &grpc.UnaryServerInfo{FullMethod: "/moapis.file_api.ImageService/RemoveImage"},
+				handler: func(ctx context.Context, rq interface{}) (interface{}, error) {
+					return nil, nil
+				},
+			},
+		},
+		{
+			name:    "malformed authorization",
+			wantErr: true,
+			fields: fields{
+				ccTokenChecker: new(grpc.ClientConn),
+			},
+			args: args{
+				ctx:  metadata.NewIncomingContext(context.TODO(), metadata.Pairs("authorization", "Basic Zm9vOmJhcg==")),
+				rq:   &pb.OverlayRequest{},
+				info: &grpc.UnaryServerInfo{FullMethod: "/moapis.file_api.ImageService/Overlay"},
+				handler: func(ctx context.Context, rq interface{}) (interface{}, error) {
+					return nil, nil
+				},
+			},
+		},
+		{
+			name:    "invalid bearer token",
+			wantErr: true,
+			fields: fields{
+				ccTokenChecker: new(grpc.ClientConn),
+			},
+			args: args{
+				ctx:  metadata.NewIncomingContext(context.TODO(), metadata.Pairs("authorization", "Bearer foo")),
+				rq:   &pb.NewImageRequest{Tkn: "..."},
+				info: &grpc.UnaryServerInfo{FullMethod: "/moapis.file_api.ImageService/NewImagePreserve"},
+				handler: func(ctx context.Context, rq interface{}) (interface{}, error) {
+					return nil, nil
+				},
+			},
+		},
+		{
+			name:    "unknown method",
+			wantErr: true,
+			fields: fields{
+				ccTokenChecker: new(grpc.ClientConn),
+			},
+			args: args{
+				ctx:  context.TODO(),
+				rq:   &pb.OverlayRequest{},
+				info: &grpc.UnaryServerInfo{FullMethod: "/moapis.file_api.ImageService/Unknown"},
+				handler: func(ctx context.Context, rq interface{}) (interface{}, error) {
+					return nil, nil
+				},
+			},
+		},
+		{
+			name:    "public method",
+			wantErr: false,
+			fields: fields{
+				ccTokenChecker: new(grpc.ClientConn),
+			},
+			args: args{
+				ctx:  context.TODO(),
+				rq:   &pb.OverlayRequest{},
+				info: &grpc.UnaryServerInfo{FullMethod: "/moapis.file_api.ImageService/Overlay"},
 				handler: func(ctx context.Context, rq interface{}) (interface{}, error) {
 					return nil, nil
 				},
@@ -619,13 +681,63 @@ func Test_imageServiceServer_tokenCheckInterceptor(t *testing.T) {
 				t.Errorf("imageServiceServer.tokenCheckInterceptor() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if status.Code(err) != 7 {
+			if tt.wantErr && status.Code(err) != 7 {
 				t.Errorf("%T, %v", err, err)
 			}
 		})
 	}
 }
 
+func Test_bearerToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		want  string
+		want1 bool
+	}{
+		{"no metadata", context.TODO(), "", false},
+		{"no authorization", metadata.NewIncomingContext(context.TODO(), metadata.Pairs("foo", "bar")), "", false},
+		{"bearer", metadata.NewIncomingContext(context.TODO(), metadata.Pairs("authorization", "Bearer foo.bar.baz")), "foo.bar.baz", true},
+		{"lower case", metadata.NewIncomingContext(context.TODO(), metadata.Pairs("authorization", "bearer foo.bar.baz")), "foo.bar.baz", true},
+		{"malformed", metadata.NewIncomingContext(context.TODO(), metadata.Pairs("authorization", "foo.bar.baz")), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := bearerToken(tt.ctx)
+			if got != tt.want {
+				t.Errorf("bearerToken() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("bearerToken() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func Test_requestTkn(t *testing.T) {
+	tests := []struct {
+		name  string
+		rq    interface{}
+		want  string
+		want1 bool
+	}{
+		{"NewImageRequest", &pb.NewImageRequest{Tkn: "foo"}, "foo", true},
+		{"RemoveImageRequest", &pb.RemoveImageRequest{Tkn: "bar"}, "bar", true},
+		{"OverlayRequest", &pb.OverlayRequest{Tkn: "baz"}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := requestTkn(tt.rq)
+			if got != tt.want {
+				t.Errorf("requestTkn() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("requestTkn() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
 func Test_imageServiceServer_Overlay(t *testing.T) {
 	var e error
 	db, e = sql.Open("postgres", psqlConnectionURL)
@@ -680,3 +792,40 @@ func Test_imageServiceServer_Overlay(t *testing.T) {
 		})
 	}
 }
+
+func Test_hasPermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		c          *jwt.Claims
+		permission string
+		want       bool
+	}{
+		{"no claims", nil, "images:write", false},
+		{"no permissions", &jwt.Claims{}, "images:write", false},
+		{"other permission", &jwt.Claims{Set: map[string]interface{}{"permissions": []interface{}{"catalog:write"}}}, "images:write", false},
+		{"permission", &jwt.Claims{Set: map[string]interface{}{"permissions": []interface{}{"catalog:write", "images:write"}}}, "images:write", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasPermission(tt.c, tt.permission); got != tt.want {
+				t.Errorf("hasPermission() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_methodPermissions(t *testing.T) {
+	s := grpc.NewServer()
+	pb.RegisterImageServiceServer(s, imageServiceServer{})
+	for name, info := range s.GetServiceInfo() {
+		for _, m := range info.Methods {
+			method := fmt.Sprintf("/%s/%s", name, m.Name)
+			if _, ok := methodPermissions[method]; !ok {
+				t.Errorf("methodPermissions misses %s", method)
+			}
+		}
+	}
+	if got, want := len(methodPermissions), len(s.GetServiceInfo()["moapis.file_api.ImageService"].Methods); got != want {
+		t.Errorf("methodPermissions has %d methods, want %d", got, want)
+	}
+}
@@ -9,8 +9,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ericlagergren/decimal"
+	"github.com/lib/pq"
 	"github.com/moapis/shop"
 	"github.com/moapis/shop/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -61,13 +65,19 @@ type relation struct {
 	columns []string
 	id      string // Relation's id column to join against
 
-	joinTable string    // Intermediate join table to use
-	joinIDs   [2]string // Left and right join columns
+	joinTable   string    // Intermediate join table to use
+	joinIDs     [2]string // Left and right join columns
+	joinColumns []string  // Columns to select from the join table
+	filter      string    // Additional join condition on the relation table, aliased as r
 }
 
 func (r relation) joins(schema string) string {
 	if r.joinTable == "" {
-		return leftJoin(schema, r.name, "r", r.id, "arts", "id")
+		join := leftJoin(schema, r.name, "r", r.id, "arts", "id")
+		if r.filter != "" {
+			join = fmt.Sprintf("%s and %s", join, r.filter)
+		}
+		return join
 	}
 
 	return strings.Join([]string{
@@ -78,7 +88,7 @@ func (r relation) joins(schema string) string {
 
 func jboCast(alias, col string) string {
 	switch col {
-	case "price", "multiplier":
+	case "price", "multiplier", "weight", "sale_price":
 		return fmt.Sprintf("%s.%s::text", alias, col)
 	default:
 		return fmt.Sprintf("%s.%s", alias, col)
@@ -86,10 +96,13 @@ func jboCast(alias, col string) string {
 }
 
 func (r relation) build(schema, alias string) (string, []string) {
-	jbo := make([]string, 0, len(r.columns)*2)
+	jbo := make([]string, 0, (len(r.columns)+len(r.joinColumns))*2)
 	for _, c := range r.columns {
 		jbo = append(jbo, fmt.Sprintf("'%s'", c), jboCast("r", c))
 	}
+	for _, c := range r.joinColumns {
+		jbo = append(jbo, fmt.Sprintf("'%s'", c), jboCast("j", c))
+	}
 
 	return fmt.Sprintf(
 			relationCTE,
@@ -189,14 +202,65 @@ func defaults(cond *shop.ListConditions) ([]shop.ArticleFields, *shop.ArticleRel
 const (
 	joinArticlesCategory = "join %s.category_articles ac on ac.article_id = m.id"
 	joinCategories       = "join %s.categories c on c.id = ac.category_id"
+	joinAttribute        = "join %s.article_attributes %s on %s.article_id = m.id and %s.attribute_id = $%d"
 )
 
-func filters(cond *shop.ListConditions, schema string) (filters string, args []interface{}) {
+// attributeFilters appends joins and where clauses for each AttributeFilter.
+// Every filter gets its own join alias, so that multiple attributes can be matched.
+// In case Min or Max is not a decimal, codes.InvalidArgument error is returned.
+func attributeFilters(afs []*shop.AttributeFilter, schema string, joins, wheres []string, args []interface{}) ([]string, []string, []interface{}, error) {
+	for i, af := range afs {
+		aid := af.GetAttributeId()
+		if aid == 0 {
+			continue
+		}
+		alias := fmt.Sprintf("aa%d", i)
+
+		args = append(args, aid)
+		joins = append(joins, fmt.Sprintf(joinAttribute, schema, alias, alias, alias, len(args)))
+
+		if v := af.GetValue(); v != "" {
+			args = append(args, v)
+			wheres = append(wheres, fmt.Sprintf("%s.value = $%d", alias, len(args)))
+		}
+		if oo := af.GetOneOf(); len(oo) > 0 {
+			args = append(args, pq.StringArray(oo))
+			wheres = append(wheres, fmt.Sprintf("%s.value = any($%d)", alias, len(args)))
+		}
+		for _, r := range []struct {
+			field, op, value string
+		}{
+			{"min", ">=", af.GetMin()},
+			{"max", "<=", af.GetMax()},
+		} {
+			if r.value == "" {
+				continue
+			}
+			num, ok := new(decimal.Big).SetString(r.value)
+			if !ok || !num.IsFinite() {
+				return nil, nil, nil, status.Errorf(codes.InvalidArgument, rangeErr, r.field, aid, r.value)
+			}
+			args = append(args, num.String())
+			wheres = append(wheres, fmt.Sprintf("%s.number %s $%d", alias, r.op, len(args)))
+		}
+	}
+	return joins, wheres, args, nil
+}
+
+// liveWheres select published articles within their publishing schedule.
+var liveWheres = []string{
+	"m.published",
+	"(m.publish_at is null or m.publish_at <= now())",
+	"(m.unpublish_at is null or m.unpublish_at > now())",
+}
+
+func filters(cond *shop.ListConditions, schema string) (filters string, args []interface{}, err error) {
 	var (
-		joins, wheres []string
+		joins  []string
+		wheres = []string{"m.deleted_at is null"} // Soft deleted articles are never listed
 	)
 	if cond.GetOnlyPublished() {
-		wheres = append(wheres, "m.published")
+		wheres = append(wheres, liveWheres...)
 	}
 	if cond.GetOnlyPromoted() {
 		wheres = append(wheres, "m.promoted")
@@ -213,14 +277,17 @@ func filters(cond *shop.ListConditions, schema string) (filters string, args []i
 		wheres = append(wheres, fmt.Sprintf("c.label = $%d", len(args)))
 	}
 
-	if len(wheres) == 0 {
-		return "", nil
+	joins, wheres, args, err = attributeFilters(cond.GetAttributes(), schema, joins, wheres, args)
+	if err != nil {
+		return "", nil, err
 	}
 
-	return strings.Join([]string{
+	parts := []string{
 		strings.Join(joins, "\n\t"),
 		fmt.Sprintf("where %s", strings.Join(wheres, "\n\tand ")),
-	}, "\n\t"), args
+	}
+
+	return strings.Join(parts, "\n\t"), args, nil
 }
 
 // DefaultLimit is used when ListConditions does not specify any.
@@ -251,7 +318,10 @@ func ArticleListQuery(cond *shop.ListConditions, schema string) (string, []inter
 		offset:    limits.GetOffset(),
 	}
 
-	f, args := filters(cond, schema)
+	f, args, err := filters(cond, schema)
+	if err != nil {
+		return "", nil, err
+	}
 	query := lq.query(schema, f)
 	if Debug {
 		fmt.Println(query)
@@ -5,10 +5,14 @@
 package builder
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
+	"github.com/lib/pq"
 	"github.com/moapis/shop"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -33,6 +37,17 @@ const (
 	left join shop.category_articles j on j.article_id = arts.id
 	left join shop.categories r on r.id = j.category_id
 	group by arts.id
+)`
+	attributesCTEOut = `r1 as (
+	select arts.id, coalesce(json_agg(
+		json_build_object(
+			'label', r.label, 'attribute_id', j.attribute_id, 'value', j.value
+		)
+	) filter (where r.id is not null), null::JSON) as js
+	from arts
+	left join shop.article_attributes j on j.article_id = arts.id
+	left join shop.attributes r on r.id = j.attribute_id
+	group by arts.id
 )`
 )
 
@@ -42,11 +57,12 @@ func init() {
 
 func TestRelation_build(t *testing.T) {
 	type fields struct {
-		name      string
-		columns   []string
-		id        string
-		joinTable string
-		joinIDs   [2]string
+		name        string
+		columns     []string
+		id          string
+		joinTable   string
+		joinIDs     [2]string
+		joinColumns []string
 	}
 	type args struct {
 		schema string
@@ -89,15 +105,33 @@ func TestRelation_build(t *testing.T) {
 			categoriesCTEOut,
 			[]string{"'categories'", "r1.js"},
 		},
+		{
+			"Attributes",
+			fields{
+				name:        "attributes",
+				columns:     []string{"label"},
+				id:          "id",
+				joinTable:   "article_attributes",
+				joinIDs:     [2]string{"article_id", "attribute_id"},
+				joinColumns: []string{"attribute_id", "value"},
+			},
+			args{
+				"shop",
+				"r1",
+			},
+			attributesCTEOut,
+			[]string{"'attributes'", "r1.js"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			r := relation{
-				name:      tt.fields.name,
-				columns:   tt.fields.columns,
-				id:        tt.fields.id,
-				joinTable: tt.fields.joinTable,
-				joinIDs:   tt.fields.joinIDs,
+				name:        tt.fields.name,
+				columns:     tt.fields.columns,
+				id:          tt.fields.id,
+				joinTable:   tt.fields.joinTable,
+				joinIDs:     tt.fields.joinIDs,
+				joinColumns: tt.fields.joinColumns,
 			}
 			got, got1 := r.build(tt.args.schema, tt.args.alias)
 			if got != tt.want {
@@ -115,6 +149,7 @@ const (
 	select m.id
 	from shop.articles m
 	
+	where m.deleted_at is null
 ),
 arts as (
 	select a.id, a.title, a.price
@@ -134,6 +169,7 @@ from arts a
 	select m.id
 	from shop.articles m
 	
+	where m.deleted_at is null
 ),
 arts as (
 	select a.id, a.title, a.price
@@ -164,6 +200,7 @@ join r0 on a.id = r0.id;`
 	select m.id
 	from shop.articles m
 	
+	where m.deleted_at is null
 ),
 arts as (
 	select a.id, a.title, a.price
@@ -206,6 +243,7 @@ join r1 on a.id = r1.id;`
 	select m.id
 	from shop.articles m
 	
+	where m.deleted_at is null
 ),
 arts as (
 	select a.id, a.title, a.price
@@ -256,7 +294,7 @@ func TestList_query(t *testing.T) {
 				0,
 				0,
 			},
-			"",
+			"\n\twhere m.deleted_at is null",
 			allArtsNoLimits,
 		},
 		{
@@ -272,7 +310,7 @@ func TestList_query(t *testing.T) {
 				25,
 				0,
 			},
-			"",
+			"\n\twhere m.deleted_at is null",
 			allArtsWithImages,
 		},
 		{
@@ -297,7 +335,7 @@ func TestList_query(t *testing.T) {
 				25,
 				0,
 			},
-			"",
+			"\n\twhere m.deleted_at is null",
 			allArtsWithImagesAndCategories,
 		},
 		{
@@ -313,7 +351,7 @@ func TestList_query(t *testing.T) {
 				100,
 				200,
 			},
-			"",
+			"\n\twhere m.deleted_at is null",
 			allArtsWithVariantsAndOffset,
 		},
 	}
@@ -500,6 +538,18 @@ func TestArticleListQuery(t *testing.T) {
 			nil,
 			true,
 		},
+		{
+			"Invalid attribute range error",
+			args{
+				&shop.ListConditions{
+					Attributes: []*shop.AttributeFilter{{AttributeId: 6, Min: "abc"}},
+				},
+				"shop",
+			},
+			"",
+			nil,
+			true,
+		},
 		{
 			"All articles, all relations and all fields",
 			args{
@@ -644,6 +694,7 @@ const (
 	select m.id
 	from shop.articles m
 	
+	where m.deleted_at is null
 ),
 arts as (
 	select a.id, a.title, a.price, a.promoted
@@ -674,9 +725,10 @@ join r0 on a.id = r0.id;`
 	select m.id
 	from shop.articles m
 	
+	where m.deleted_at is null
 ),
 arts as (
-	select a.id, a.created_at, a.updated_at, a.published, a.title, a.description, a.price, a.promoted
+	select a.id, a.created_at, a.updated_at, a.published, a.title, a.description, a.price, a.promoted, a.publish_at, a.unpublish_at, a.sale_price, a.sale_start, a.sale_end
 	from filters f
 	join shop.articles a on a.id = f.id
 	limit 25
@@ -727,16 +779,16 @@ r3 as (
 r4 as (
 	select arts.id, coalesce(json_agg(
 		json_build_object(
-			'id', r.id, 'created_at', r.created_at, 'updated_at', r.updated_at, 'labels', r.labels, 'multiplier', r.multiplier::text
+			'id', r.id, 'created_at', r.created_at, 'updated_at', r.updated_at, 'labels', r.labels, 'multiplier', r.multiplier::text, 'sku', r.sku, 'price', r.price::text, 'stock', r.stock, 'weight', r.weight::text, 'images', r.images
 		)
 	) filter (where r.id is not null), null::JSON) as js
 	from arts
-	left join shop.variants r on r.article_id = arts.id
+	left join shop.variants r on r.article_id = arts.id and not r.archived
 	group by arts.id
 )
 select json_agg(
 	json_build_object(
-		'id', a.id, 'created_at', a.created_at, 'updated_at', a.updated_at, 'published', a.published, 'title', a.title, 'description', a.description, 'price', a.price::text, 'promoted', a.promoted, 'images', r0.js, 'videos', r1.js, 'categories', r2.js, 'base_prices', r3.js, 'variants', r4.js
+		'id', a.id, 'created_at', a.created_at, 'updated_at', a.updated_at, 'published', a.published, 'title', a.title, 'description', a.description, 'price', a.price::text, 'promoted', a.promoted, 'publish_at', a.publish_at, 'unpublish_at', a.unpublish_at, 'sale_price', a.sale_price::text, 'sale_start', a.sale_start, 'sale_end', a.sale_end, 'images', r0.js, 'videos', r1.js, 'categories', r2.js, 'base_prices', r3.js, 'variants', r4.js
 	)
 )
 from arts a
@@ -751,7 +803,10 @@ join r4 on a.id = r4.id;`
 	from shop.articles m
 	join shop.category_articles ac on ac.article_id = m.id
 	join shop.categories c on c.id = ac.category_id
-	where m.published
+	where m.deleted_at is null
+	and m.published
+	and (m.publish_at is null or m.publish_at <= now())
+	and (m.unpublish_at is null or m.unpublish_at > now())
 	and c.label = $1
 ),
 arts as (
@@ -810,6 +865,7 @@ func Test_filters(t *testing.T) {
 		args        args
 		wantFilters string
 		wantArgs    []interface{}
+		wantErr     error
 	}{
 		{
 			"Empty",
@@ -817,7 +873,8 @@ func Test_filters(t *testing.T) {
 				&shop.ListConditions{},
 				"shop",
 			},
-			"",
+			"\n\twhere m.deleted_at is null",
+			nil,
 			nil,
 		},
 		{
@@ -828,7 +885,8 @@ func Test_filters(t *testing.T) {
 				},
 				"shop",
 			},
-			"\n\twhere m.published",
+			"\n\twhere m.deleted_at is null\n\tand m.published\n\tand (m.publish_at is null or m.publish_at <= now())\n\tand (m.unpublish_at is null or m.unpublish_at > now())",
+			nil,
 			nil,
 		},
 		{
@@ -839,7 +897,8 @@ func Test_filters(t *testing.T) {
 				},
 				"shop",
 			},
-			"\n\twhere m.promoted",
+			"\n\twhere m.deleted_at is null\n\tand m.promoted",
+			nil,
 			nil,
 		},
 		{
@@ -851,8 +910,10 @@ func Test_filters(t *testing.T) {
 				"shop",
 			},
 			`join shop.category_articles ac on ac.article_id = m.id
-	where ac.category_id = $1`,
+	where m.deleted_at is null
+	and ac.category_id = $1`,
 			[]interface{}{int32(5)},
+			nil,
 		},
 		{
 			"Category id",
@@ -864,8 +925,10 @@ func Test_filters(t *testing.T) {
 			},
 			`join shop.category_articles ac on ac.article_id = m.id
 	join shop.categories c on c.id = ac.category_id
-	where c.label = $1`,
+	where m.deleted_at is null
+	and c.label = $1`,
 			[]interface{}{"spanac"},
+			nil,
 		},
 		{
 			"Published and category id",
@@ -878,14 +941,115 @@ func Test_filters(t *testing.T) {
 			},
 			`join shop.category_articles ac on ac.article_id = m.id
 	join shop.categories c on c.id = ac.category_id
-	where m.published
+	where m.deleted_at is null
+	and m.published
+	and (m.publish_at is null or m.publish_at <= now())
+	and (m.unpublish_at is null or m.unpublish_at > now())
 	and c.label = $1`,
 			[]interface{}{"spanac"},
+			nil,
+		},
+		{
+			"Attribute without ID",
+			args{
+				&shop.ListConditions{
+					Attributes: []*shop.AttributeFilter{{Value: "lemn"}},
+				},
+				"shop",
+			},
+			"\n\twhere m.deleted_at is null",
+			nil,
+			nil,
+		},
+		{
+			"Attribute only",
+			args{
+				&shop.ListConditions{
+					Attributes: []*shop.AttributeFilter{{AttributeId: 3}},
+				},
+				"shop",
+			},
+			"join shop.article_attributes aa0 on aa0.article_id = m.id and aa0.attribute_id = $1\n\twhere m.deleted_at is null",
+			[]interface{}{int32(3)},
+			nil,
+		},
+		{
+			"Category and attributes",
+			args{
+				&shop.ListConditions{
+					OnlyCategoryId: 5,
+					Attributes: []*shop.AttributeFilter{
+						{AttributeId: 3, Value: "lemn"},
+						{AttributeId: 4, OneOf: []string{"rosu", "verde"}},
+						{AttributeId: 6, Min: "1.5", Max: "10"},
+					},
+				},
+				"shop",
+			},
+			`join shop.category_articles ac on ac.article_id = m.id
+	join shop.article_attributes aa0 on aa0.article_id = m.id and aa0.attribute_id = $2
+	join shop.article_attributes aa1 on aa1.article_id = m.id and aa1.attribute_id = $4
+	join shop.article_attributes aa2 on aa2.article_id = m.id and aa2.attribute_id = $6
+	where m.deleted_at is null
+	and ac.category_id = $1
+	and aa0.value = $3
+	and aa1.value = any($5)
+	and aa2.number >= $7
+	and aa2.number <= $8`,
+			[]interface{}{
+				int32(5),
+				int32(3), "lemn",
+				int32(4), pq.StringArray{"rosu", "verde"},
+				int32(6), "1.5", "10",
+			},
+			nil,
+		},
+		{
+			"Normalized range",
+			args{
+				&shop.ListConditions{
+					Attributes: []*shop.AttributeFilter{{AttributeId: 6, Min: "01.50", Max: "1e1"}},
+				},
+				"shop",
+			},
+			`join shop.article_attributes aa0 on aa0.article_id = m.id and aa0.attribute_id = $1
+	where m.deleted_at is null
+	and aa0.number >= $2
+	and aa0.number <= $3`,
+			[]interface{}{int32(6), "1.50", "1E+1"},
+			nil,
+		},
+		{
+			"Invalid min",
+			args{
+				&shop.ListConditions{
+					Attributes: []*shop.AttributeFilter{{AttributeId: 6, Min: "abc"}},
+				},
+				"shop",
+			},
+			"",
+			nil,
+			status.Errorf(codes.InvalidArgument, rangeErr, "min", 6, "abc"),
+		},
+		{
+			"Infinite max",
+			args{
+				&shop.ListConditions{
+					Attributes: []*shop.AttributeFilter{{AttributeId: 6, Max: "Inf"}},
+				},
+				"shop",
+			},
+			"",
+			nil,
+			status.Errorf(codes.InvalidArgument, rangeErr, "max", 6, "Inf"),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotFilters, gotArgs := filters(tt.args.cond, tt.args.schema)
+			gotFilters, gotArgs, err := filters(tt.args.cond, tt.args.schema)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("filters() error = %v, wantErr %v", err, tt.wantErr)
+			}
 			if gotFilters != tt.wantFilters {
 				t.Errorf("filters() gotFilters = %v, want %v", gotFilters, tt.wantFilters)
 			}
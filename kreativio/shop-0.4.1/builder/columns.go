@@ -15,6 +15,7 @@ import (
 
 const (
 	fieldErr = "Field %s not defined"
+	rangeErr = "Can't convert %s of attribute %d string %s to decimal" // Field name, attribute ID and value
 )
 
 type fieldColumns struct {
@@ -42,14 +43,19 @@ var (
 	// ArticleFieldColumns maps requested article fields to columns
 	ArticleFieldColumns = fieldColumns{
 		M: map[int]string{
-			int(shop.ArticleFields_ID):          models.ArticleColumns.ID,
-			int(shop.ArticleFields_CREATED):     models.ArticleColumns.CreatedAt,
-			int(shop.ArticleFields_UPDATED):     models.ArticleColumns.UpdatedAt,
-			int(shop.ArticleFields_PUBLISHED):   models.ArticleColumns.Published,
-			int(shop.ArticleFields_TITLE):       models.ArticleColumns.Title,
-			int(shop.ArticleFields_DESCRIPTION): models.ArticleColumns.Description,
-			int(shop.ArticleFields_PRICE):       models.ArticleColumns.Price,
-			int(shop.ArticleFields_PROMOTED):    models.ArticleColumns.Promoted,
+			int(shop.ArticleFields_ID):           models.ArticleColumns.ID,
+			int(shop.ArticleFields_CREATED):      models.ArticleColumns.CreatedAt,
+			int(shop.ArticleFields_UPDATED):      models.ArticleColumns.UpdatedAt,
+			int(shop.ArticleFields_PUBLISHED):    models.ArticleColumns.Published,
+			int(shop.ArticleFields_TITLE):        models.ArticleColumns.Title,
+			int(shop.ArticleFields_DESCRIPTION):  models.ArticleColumns.Description,
+			int(shop.ArticleFields_PRICE):        models.ArticleColumns.Price,
+			int(shop.ArticleFields_PROMOTED):     models.ArticleColumns.Promoted,
+			int(shop.ArticleFields_PUBLISH_AT):   models.ArticleColumns.PublishAt,
+			int(shop.ArticleFields_UNPUBLISH_AT): models.ArticleColumns.UnpublishAt,
+			int(shop.ArticleFields_SALE_PRICE):   models.ArticleColumns.SalePrice,
+			int(shop.ArticleFields_SALE_START):   models.ArticleColumns.SaleStart,
+			int(shop.ArticleFields_SALE_END):     models.ArticleColumns.SaleEnd,
 		},
 	}
 
@@ -99,6 +105,24 @@ var (
 			int(shop.VariantFields_VRT_UPDATED):    models.VariantColumns.UpdatedAt,
 			int(shop.VariantFields_VRT_LABELS):     models.VariantColumns.Labels,
 			int(shop.VariantFields_VRT_MULTIPLIER): models.VariantColumns.Multiplier,
+			int(shop.VariantFields_VRT_SKU):        models.VariantColumns.Sku,
+			int(shop.VariantFields_VRT_PRICE):      models.VariantColumns.Price,
+			int(shop.VariantFields_VRT_STOCK):      models.VariantColumns.Stock,
+			int(shop.VariantFields_VRT_WEIGHT):     models.VariantColumns.Weight,
+			int(shop.VariantFields_VRT_IMAGES):     models.VariantColumns.Images,
+		},
+	}
+
+	// AttributeValueFieldColumns maps requested attribute value fields to columns.
+	// Attribute ID and value are selected from the article_attributes join table,
+	// the other columns from the attribute definition.
+	AttributeValueFieldColumns = fieldColumns{
+		M: map[int]string{
+			int(shop.AttributeValueFields_ATV_ATTRIBUTE_ID): models.ArticleAttributeColumns.AttributeID,
+			int(shop.AttributeValueFields_ATV_LABEL):        models.AttributeColumns.Label,
+			int(shop.AttributeValueFields_ATV_TYPE):         models.AttributeColumns.Type,
+			int(shop.AttributeValueFields_ATV_UNIT):         models.AttributeColumns.Unit,
+			int(shop.AttributeValueFields_ATV_VALUE):        models.ArticleAttributeColumns.Value,
 		},
 	}
 )
@@ -112,6 +136,7 @@ func init() {
 		&CategoryFieldColumns,
 		&BasePriceFieldColumns,
 		&VariantFieldColumns,
+		&AttributeValueFieldColumns,
 	}
 
 	for _, fc := range fcs {
@@ -232,13 +257,49 @@ func VariantColumns(fields []shop.VariantFields) ([]string, error) {
 	return cols, nil
 }
 
+// AttributeValueColumns returns a list of columns, reflecting the requested fields.
+// When shop.AttributeValueFields_ATV_ALL is passed, all columns are returned
+// In case of an unmapped field, codes.Unimplemented error is returned.
+func AttributeValueColumns(fields []shop.AttributeValueFields) ([]string, error) {
+	fids := make([]int, len(fields))
+	for i, f := range fields {
+		fids[i] = int(f)
+	}
+
+	cols, id := AttributeValueFieldColumns.columns(fids)
+	if id != 0 {
+		return nil, status.Errorf(codes.Unimplemented, fieldErr, shop.AttributeValueFields(id))
+	}
+
+	return cols, nil
+}
+
+// splitJoinColumns separates the columns which are selected from the join table.
+func splitJoinColumns(cols []string, joinCols ...string) (rel, join []string) {
+	for _, c := range cols {
+		isJoin := false
+		for _, jc := range joinCols {
+			if c == jc {
+				isJoin = true
+				break
+			}
+		}
+		if isJoin {
+			join = append(join, c)
+		} else {
+			rel = append(rel, c)
+		}
+	}
+	return rel, join
+}
+
 const schema = "shop"
 
 func articleRelations(rel *shop.ArticleRelations) ([]relation, error) {
 	var (
-		br   = make([]relation, 0, 5)
+		br   = make([]relation, 0, 6)
 		cols []string
-		errs [5]error
+		errs [6]error
 	)
 
 	cols, errs[0] = ImageColumns(rel.GetImages())
@@ -287,6 +348,23 @@ func articleRelations(rel *shop.ArticleRelations) ([]relation, error) {
 			name:    models.TableNames.Variants,
 			columns: cols,
 			id:      models.VariantColumns.ArticleID,
+			filter:  "not r.archived",
+		})
+	}
+
+	cols, errs[5] = AttributeValueColumns(rel.GetAttributes())
+	if len(cols) > 0 {
+		rc, jc := splitJoinColumns(cols,
+			models.ArticleAttributeColumns.AttributeID,
+			models.ArticleAttributeColumns.Value,
+		)
+		br = append(br, relation{
+			name:        models.TableNames.Attributes,
+			columns:     rc,
+			id:          models.AttributeColumns.ID,
+			joinTable:   models.TableNames.ArticleAttributes,
+			joinIDs:     [2]string{"article_id", "attribute_id"},
+			joinColumns: jc,
 		})
 	}
 
@@ -409,10 +409,10 @@ func TestVariantColumns(t *testing.T) {
 			"Unknown ID error",
 			[]shop.VariantFields{
 				shop.VariantFields_VRT_ID,
-				shop.VariantFields(9),
+				shop.VariantFields(99),
 			},
 			nil,
-			status.Errorf(codes.Unimplemented, fieldErr, shop.MediaFields(9)),
+			status.Errorf(codes.Unimplemented, fieldErr, shop.VariantFields(99)),
 		},
 	}
 	for _, tt := range tests {
@@ -429,6 +429,64 @@ func TestVariantColumns(t *testing.T) {
 	}
 }
 
+func TestAttributeValueColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  []shop.AttributeValueFields
+		want    []string
+		wantErr error
+	}{
+		{
+			"Nil returns emtpy",
+			nil,
+			[]string{},
+			nil,
+		},
+		{
+			"Some columns",
+			[]shop.AttributeValueFields{
+				shop.AttributeValueFields_ATV_LABEL,
+				shop.AttributeValueFields_ATV_VALUE,
+			},
+			[]string{
+				models.AttributeColumns.Label,
+				models.ArticleAttributeColumns.Value,
+			},
+			nil,
+		},
+		{
+			"With all returns all",
+			[]shop.AttributeValueFields{
+				shop.AttributeValueFields_ATV_LABEL,
+				shop.AttributeValueFields_ATV_ALL,
+			},
+			AttributeValueFieldColumns.all,
+			nil,
+		},
+		{
+			"Unknown ID error",
+			[]shop.AttributeValueFields{
+				shop.AttributeValueFields_ATV_LABEL,
+				shop.AttributeValueFields(9),
+			},
+			nil,
+			status.Errorf(codes.Unimplemented, fieldErr, shop.AttributeValueFields(9)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AttributeValueColumns(tt.fields)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("AttributeValueColumns() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AttributeValueColumns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_articleRelations(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -497,6 +555,25 @@ func Test_articleRelations(t *testing.T) {
 				name:    "variants",
 				columns: []string{models.VariantColumns.Labels},
 				id:      models.VariantColumns.ArticleID,
+				filter:  "not r.archived",
+			}},
+			false,
+		},
+		{
+			"Attribute label and value",
+			&shop.ArticleRelations{
+				Attributes: []shop.AttributeValueFields{
+					shop.AttributeValueFields_ATV_LABEL,
+					shop.AttributeValueFields_ATV_VALUE,
+				},
+			},
+			[]relation{{
+				name:        "attributes",
+				columns:     []string{models.AttributeColumns.Label},
+				id:          models.AttributeColumns.ID,
+				joinTable:   models.TableNames.ArticleAttributes,
+				joinIDs:     [2]string{"article_id", "attribute_id"},
+				joinColumns: []string{models.ArticleAttributeColumns.Value},
 			}},
 			false,
 		},
@@ -0,0 +1,465 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/moapis/shop"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// articleReader reads articles from an import file.
+// Read returns io.EOF after the last article.
+// Errors wrapping errSyntax end reading.
+// Any other error concerns a single row, and reading may continue.
+type articleReader interface {
+	Read() (*shop.Article, error)
+	// Row number of the last read article in the input.
+	Row() int
+}
+
+// articleWriter writes articles to an export file.
+// Flush must be called after the last article.
+type articleWriter interface {
+	Write(*shop.Article) error
+	Flush() error
+}
+
+// Supported file formats
+const (
+	formatJSON = "json"
+	formatCSV  = "csv"
+)
+
+var (
+	errFormat = errors.New("Unknown format, use json or csv")
+	// errSyntax is returned by an articleReader which can not continue reading.
+	errSyntax = errors.New("Input syntax error")
+)
+
+func newArticleReader(format string, r io.Reader) (articleReader, error) {
+	switch format {
+	case formatJSON:
+		return newJSONReader(r)
+	case formatCSV:
+		return newCSVReader(r)
+	default:
+		return nil, errFormat
+	}
+}
+
+func newArticleWriter(format string, w io.Writer) (articleWriter, error) {
+	switch format {
+	case formatJSON:
+		return &jsonWriter{w: w}, nil
+	case formatCSV:
+		return newCSVWriter(w)
+	default:
+		return nil, errFormat
+	}
+}
+
+// jsonReader reads an array of articles, in the protobuf JSON mapping.
+type jsonReader struct {
+	dec *json.Decoder
+	row int
+}
+
+func newJSONReader(r io.Reader) (*jsonReader, error) {
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if tok != json.Delim('[') {
+		return nil, fmt.Errorf("JSON input must be an array of articles, got %v", tok)
+	}
+	return &jsonReader{dec: dec}, nil
+}
+
+func (jr *jsonReader) Read() (*shop.Article, error) {
+	if !jr.dec.More() {
+		return nil, io.EOF
+	}
+	jr.row++
+
+	var raw json.RawMessage
+	if err := jr.dec.Decode(&raw); err != nil {
+		// The decoder can not recover from syntax errors.
+		return nil, fmt.Errorf("%w: %v", errSyntax, err)
+	}
+	sa := new(shop.Article)
+	if err := protojson.Unmarshal(raw, sa); err != nil {
+		return nil, err
+	}
+	return sa, nil
+}
+
+func (jr *jsonReader) Row() int { return jr.row }
+
+// jsonWriter writes an array of articles, in the protobuf JSON mapping.
+type jsonWriter struct {
+	w io.Writer
+	n int
+}
+
+func (jw *jsonWriter) Write(sa *shop.Article) error {
+	b, err := protojson.MarshalOptions{Multiline: true}.Marshal(sa)
+	if err != nil {
+		return err
+	}
+
+	sep := ",\n"
+	if jw.n == 0 {
+		sep = "[\n"
+	}
+	jw.n++
+
+	if _, err = io.WriteString(jw.w, sep); err != nil {
+		return err
+	}
+	_, err = jw.w.Write(b)
+	return err
+}
+
+func (jw *jsonWriter) Flush() error {
+	end := "\n]\n"
+	if jw.n == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(jw.w, end)
+	return err
+}
+
+// CSV columns.
+// List columns hold one item per line:
+//   - categories and baseprices: IDs;
+//   - images and videos: the URL, optionally followed by a space and the label;
+//   - attributes: attribute ID, "=" and the value;
+//   - variants: a Variant in the protobuf JSON mapping.
+//
+// Timestamps are in RFC 3339 format.
+const (
+	colID          = "id"
+	colPublished   = "published"
+	colTitle       = "title"
+	colDescription = "description"
+	colPrice       = "price"
+	colPromoted    = "promoted"
+	colPublishAt   = "publish_at"
+	colUnpublishAt = "unpublish_at"
+	colSalePrice   = "sale_price"
+	colSaleStart   = "sale_start"
+	colSaleEnd     = "sale_end"
+	colCategories  = "categories"
+	colBasePrices  = "baseprices"
+	colImages      = "images"
+	colVideos      = "videos"
+	colAttributes  = "attributes"
+	colVariants    = "variants"
+)
+
+var csvColumns = []string{
+	colID, colPublished, colTitle, colDescription, colPrice, colPromoted,
+	colPublishAt, colUnpublishAt, colSalePrice, colSaleStart, colSaleEnd,
+	colCategories, colBasePrices, colImages, colVideos, colAttributes, colVariants,
+}
+
+// csvReader reads articles from CSV with a header row.
+// Columns may appear in any order and may be omitted.
+type csvReader struct {
+	r      *csv.Reader
+	header []string
+	row    int
+}
+
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("CSV header: %w", err)
+	}
+	known := make(map[string]bool, len(csvColumns))
+	for _, c := range csvColumns {
+		known[c] = true
+	}
+	for i, h := range header {
+		header[i] = strings.TrimSpace(h)
+		if !known[header[i]] {
+			return nil, fmt.Errorf("Unknown CSV column %q", h)
+		}
+	}
+	return &csvReader{r: cr, header: header, row: 1}, nil
+}
+
+func (cr *csvReader) Read() (*shop.Article, error) {
+	rec, err := cr.r.Read()
+	if err == io.EOF {
+		return nil, err
+	}
+	cr.row++
+	if err != nil {
+		return nil, err
+	}
+	if len(rec) != len(cr.header) {
+		return nil, fmt.Errorf("Row has %d fields, header has %d", len(rec), len(cr.header))
+	}
+
+	sa := new(shop.Article)
+	for i, col := range cr.header {
+		if err = setCSVField(sa, col, rec[i]); err != nil {
+			return nil, fmt.Errorf("Column %s: %w", col, err)
+		}
+	}
+	return sa, nil
+}
+
+func (cr *csvReader) Row() int { return cr.row }
+
+func csvLines(v string) []string {
+	var lines []string
+	for _, l := range strings.Split(v, "\n") {
+		if l = strings.TrimSpace(l); l != "" {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
+
+func parseBool(v string) (bool, error) {
+	if v == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(v)
+}
+
+func parseTimestamp(v string) (*timestamp.Timestamp, error) {
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, err
+	}
+	return ptypes.TimestampProto(t)
+}
+
+func parseIDs(v string) ([]int32, error) {
+	lines := csvLines(v)
+	ids := make([]int32, len(lines))
+	for i, l := range lines {
+		id, err := strconv.ParseInt(l, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = int32(id)
+	}
+	return ids, nil
+}
+
+func parseMedia(v string) []*shop.Media {
+	var list []*shop.Media
+	for _, l := range csvLines(v) {
+		md := &shop.Media{Url: l}
+		if i := strings.IndexByte(l, ' '); i > 0 {
+			md.Url, md.Label = l[:i], strings.TrimSpace(l[i+1:])
+		}
+		list = append(list, md)
+	}
+	return list
+}
+
+func setCSVField(sa *shop.Article, col, v string) (err error) {
+	switch col {
+	case colID:
+		if v == "" {
+			return nil
+		}
+		id, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return err
+		}
+		sa.Id = int32(id)
+	case colPublished:
+		sa.Published, err = parseBool(v)
+	case colTitle:
+		sa.Title = v
+	case colDescription:
+		sa.Description = v
+	case colPrice:
+		sa.Price = v
+	case colPromoted:
+		sa.Promoted, err = parseBool(v)
+	case colPublishAt:
+		sa.PublishAt, err = parseTimestamp(v)
+	case colUnpublishAt:
+		sa.UnpublishAt, err = parseTimestamp(v)
+	case colSalePrice:
+		sa.SalePrice = v
+	case colSaleStart:
+		sa.SaleStart, err = parseTimestamp(v)
+	case colSaleEnd:
+		sa.SaleEnd, err = parseTimestamp(v)
+	case colCategories:
+		ids, err := parseIDs(v)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			sa.Categories = append(sa.Categories, &shop.Category{Id: id})
+		}
+	case colBasePrices:
+		ids, err := parseIDs(v)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			sa.Baseprices = append(sa.Baseprices, &shop.BasePrice{Id: id})
+		}
+	case colImages:
+		sa.Images = parseMedia(v)
+	case colVideos:
+		sa.Videos = parseMedia(v)
+	case colAttributes:
+		for _, l := range csvLines(v) {
+			i := strings.IndexByte(l, '=')
+			if i < 0 {
+				return fmt.Errorf("Attribute %q missing \"=\"", l)
+			}
+			id, err := strconv.ParseInt(strings.TrimSpace(l[:i]), 10, 32)
+			if err != nil {
+				return err
+			}
+			sa.Attributes = append(sa.Attributes, &shop.AttributeValue{AttributeId: int32(id), Value: strings.TrimSpace(l[i+1:])})
+		}
+	case colVariants:
+		for _, l := range csvLines(v) {
+			vrt := new(shop.Variant)
+			if err = protojson.Unmarshal([]byte(l), vrt); err != nil {
+				return err
+			}
+			sa.Variants = append(sa.Variants, vrt)
+		}
+	}
+	return err
+}
+
+// csvWriter writes articles as CSV, with a header row of all columns.
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvColumns); err != nil {
+		return nil, err
+	}
+	return &csvWriter{cw}, nil
+}
+
+func formatTimestamp(ts *timestamp.Timestamp) (string, error) {
+	if ts == nil {
+		return "", nil
+	}
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return "", err
+	}
+	return t.Format(time.RFC3339), nil
+}
+
+func formatMedia(list []*shop.Media) string {
+	lines := make([]string, len(list))
+	for i, md := range list {
+		lines[i] = strings.TrimSpace(md.GetUrl() + " " + md.GetLabel())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (cw *csvWriter) Write(sa *shop.Article) error {
+	var (
+		rec  = make([]string, len(csvColumns))
+		errs = make([]error, 5)
+	)
+
+	rec[0] = strconv.Itoa(int(sa.GetId()))
+	rec[1] = strconv.FormatBool(sa.GetPublished())
+	rec[2] = sa.GetTitle()
+	rec[3] = sa.GetDescription()
+	rec[4] = sa.GetPrice()
+	rec[5] = strconv.FormatBool(sa.GetPromoted())
+	rec[6], errs[0] = formatTimestamp(sa.GetPublishAt())
+	rec[7], errs[1] = formatTimestamp(sa.GetUnpublishAt())
+	rec[8] = sa.GetSalePrice()
+	rec[9], errs[2] = formatTimestamp(sa.GetSaleStart())
+	rec[10], errs[3] = formatTimestamp(sa.GetSaleEnd())
+
+	var lines []string
+	for _, c := range sa.GetCategories() {
+		lines = append(lines, strconv.Itoa(int(c.GetId())))
+	}
+	rec[11] = strings.Join(lines, "\n")
+
+	lines = lines[:0]
+	for _, bp := range sa.GetBaseprices() {
+		lines = append(lines, strconv.Itoa(int(bp.GetId())))
+	}
+	rec[12] = strings.Join(lines, "\n")
+
+	rec[13] = formatMedia(sa.GetImages())
+	rec[14] = formatMedia(sa.GetVideos())
+
+	lines = lines[:0]
+	for _, av := range sa.GetAttributes() {
+		lines = append(lines, fmt.Sprintf("%d=%s", av.GetAttributeId(), av.GetValue()))
+	}
+	rec[15] = strings.Join(lines, "\n")
+
+	lines = lines[:0]
+	for _, v := range sa.GetVariants() {
+		// Read only fields are of no use for import.
+		vrt := &shop.Variant{
+			Id:         v.GetId(),
+			Labels:     v.GetLabels(),
+			Multiplier: v.GetMultiplier(),
+			Sku:        v.GetSku(),
+			Price:      v.GetPrice(),
+			TrackStock: v.GetTrackStock(),
+			Stock:      v.GetStock(),
+			Weight:     v.GetWeight(),
+			Images:     v.GetImages(),
+		}
+		var b []byte
+		if b, errs[4] = protojson.Marshal(vrt); errs[4] != nil {
+			break
+		}
+		lines = append(lines, string(b))
+	}
+	rec[16] = strings.Join(lines, "\n")
+
+	for _, err := range errs {
+		if err != nil {
+			return fmt.Errorf("Article %d: %w", sa.GetId(), err)
+		}
+	}
+	return cw.w.Write(rec)
+}
+
+func (cw *csvWriter) Flush() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
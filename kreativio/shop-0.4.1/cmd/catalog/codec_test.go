@@ -0,0 +1,216 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/moapis/shop"
+	"google.golang.org/protobuf/proto"
+)
+
+var testArticles = []*shop.Article{
+	{
+		Id:          11,
+		Published:   true,
+		Title:       "First",
+		Description: "The first article,\nwith \"quotes\"",
+		Price:       "12.34",
+		Promoted:    true,
+		PublishAt:   &timestamp.Timestamp{Seconds: 1600000000},
+		SalePrice:   "10",
+		SaleEnd:     &timestamp.Timestamp{Seconds: 1700000000},
+		Categories:  []*shop.Category{{Id: 21}, {Id: 22}},
+		Baseprices:  []*shop.BasePrice{{Id: 31}},
+		Images: []*shop.Media{
+			{Url: "one.jpg", Label: "one image"},
+			{Url: "two.jpg"},
+		},
+		Videos:     []*shop.Media{{Url: "one.mp4", Label: "one video"}},
+		Attributes: []*shop.AttributeValue{{AttributeId: 1, Value: "a=b"}},
+		Variants: []*shop.Variant{
+			{
+				Id:         41,
+				Labels:     []string{"red", "XL"},
+				Multiplier: "1.5",
+				Sku:        "RED-XL",
+				TrackStock: true,
+				Stock:      3,
+				Images:     []string{"one.jpg"},
+			},
+			{
+				Labels: []string{"blue"},
+				Price:  "99",
+			},
+		},
+	},
+	{
+		Title:       "Second",
+		Description: "The second article",
+		Price:       "1",
+	},
+}
+
+func Test_roundTrip(t *testing.T) {
+	for _, format := range []string{formatJSON, formatCSV} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			aw, err := newArticleWriter(format, &buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, sa := range testArticles {
+				if err = aw.Write(sa); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err = aw.Flush(); err != nil {
+				t.Fatal(err)
+			}
+
+			ar, err := newArticleReader(format, &buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []*shop.Article
+			for {
+				sa, err := ar.Read()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, sa)
+			}
+
+			if len(got) != len(testArticles) {
+				t.Fatalf("round trip got %d articles, want %d", len(got), len(testArticles))
+			}
+			for i, want := range testArticles {
+				if !proto.Equal(got[i], want) {
+					t.Errorf("round trip =\n%v\nwant\n%v", got[i], want)
+				}
+			}
+		})
+	}
+}
+
+func Test_emptyExport(t *testing.T) {
+	var buf bytes.Buffer
+	aw, _ := newArticleWriter(formatJSON, &buf)
+	if err := aw.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "[]\n" {
+		t.Errorf("jsonWriter.Flush() = %q, want %q", got, "[]\n")
+	}
+}
+
+func Test_newArticleReader(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		input  string
+	}{
+		{"Unknown format", "xml", "<articles/>"},
+		{"Empty JSON", formatJSON, ""},
+		{"JSON object", formatJSON, "{}"},
+		{"Empty CSV", formatCSV, ""},
+		{"Unknown column", formatCSV, "id,title,foo\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newArticleReader(tt.format, strings.NewReader(tt.input)); err == nil {
+				t.Error("newArticleReader() expected error")
+			}
+		})
+	}
+}
+
+func Test_articleReader_rowErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		format     string
+		input      string
+		wantTitles []string
+		wantRows   []int // Rows with errors
+		wantSyntax bool
+	}{
+		{
+			"CSV",
+			formatCSV,
+			"title,published,categories,attributes,variants,publish_at\n" +
+				"one,true,21,,,\n" +
+				"two,maybe,,,,\n" +
+				"three,,x,,,\n" +
+				"four,,,1,,\n" +
+				"five,,,,{,\n" +
+				"six,,,,,yesterday\n" +
+				"seven\n" +
+				"eight,false,,1=2,\"{\"\"labels\"\":[\"\"a\"\"]}\",2020-01-01T00:00:00Z\n",
+			[]string{"one", "eight"},
+			[]int{3, 4, 5, 6, 7, 8},
+			false,
+		},
+		{
+			"JSON",
+			formatJSON,
+			`[{"title":"one"},{"title":1},{"title":"three"},{"title":`,
+			[]string{"one", "three"},
+			[]int{2},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ar, err := newArticleReader(tt.format, strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var (
+				titles []string
+				rows   []int
+				syntax bool
+			)
+			for {
+				sa, err := ar.Read()
+				if err == io.EOF {
+					break
+				}
+				if errors.Is(err, errSyntax) {
+					syntax = true
+					break
+				}
+				if err != nil {
+					rows = append(rows, ar.Row())
+					continue
+				}
+				titles = append(titles, sa.GetTitle())
+			}
+
+			if strings.Join(titles, ",") != strings.Join(tt.wantTitles, ",") {
+				t.Errorf("articleReader titles = %v, want %v", titles, tt.wantTitles)
+			}
+			if len(rows) != len(tt.wantRows) {
+				t.Fatalf("articleReader error rows = %v, want %v", rows, tt.wantRows)
+			}
+			for i := range rows {
+				if rows[i] != tt.wantRows[i] {
+					t.Errorf("articleReader error rows = %v, want %v", rows, tt.wantRows)
+				}
+			}
+			if syntax != tt.wantSyntax {
+				t.Errorf("articleReader syntax error = %v, want %v", syntax, tt.wantSyntax)
+			}
+		})
+	}
+}
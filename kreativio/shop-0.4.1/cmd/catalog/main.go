@@ -0,0 +1,255 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Command catalog imports and exports shop articles in bulk,
+// using the ImportArticles and ExportArticles RPCs.
+//
+// Usage:
+//
+//	catalog [flags] import|export
+//
+// Import reads from -file, or stdin, and prints a line per failed row.
+// Export writes to -file, or stdout.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/moapis/shop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	addr      = flag.String("addr", "127.0.0.1:8766", "Shop server address")
+	useTLS    = flag.Bool("tls", false, "Connect using TLS, with the system's root certificates")
+	token     = flag.String("token", os.Getenv("SHOP_TOKEN"), "JWT for authentication. Defaults to $SHOP_TOKEN")
+	format    = flag.String("format", "", "File format: json or csv. Defaults to the -file extension, or json")
+	file      = flag.String("file", "-", "Input or output file, - for stdin or stdout")
+	dryRun    = flag.Bool("dry-run", false, "Import: validate and save all articles, then roll back")
+	published = flag.Bool("published", false, "Export: only published articles")
+	category  = flag.Int("category", 0, "Export: only articles in this category ID")
+)
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] import|export\n", os.Args[0])
+	flag.PrintDefaults()
+}
+
+func fileFormat() string {
+	if *format != "" {
+		return *format
+	}
+	if ext := strings.TrimPrefix(filepath.Ext(*file), "."); ext == formatCSV {
+		return formatCSV
+	}
+	return formatJSON
+}
+
+func dial(ctx context.Context) (*grpc.ClientConn, error) {
+	opt := grpc.WithInsecure()
+	if *useTLS {
+		opt = grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, ""))
+	}
+	return grpc.DialContext(ctx, *addr, opt, grpc.WithBlock())
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 1 {
+		usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	if *token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*token)
+	}
+	cc, err := dial(ctx)
+	if err != nil {
+		log.Fatalf("dial %s: %v", *addr, err)
+	}
+	defer cc.Close()
+	client := shop.NewShopClient(cc)
+
+	switch flag.Arg(0) {
+	case "import":
+		in := os.Stdin
+		if *file != "-" {
+			if in, err = os.Open(*file); err != nil {
+				log.Fatal(err)
+			}
+			defer in.Close()
+		}
+		var failed int
+		if failed, err = importArticles(ctx, client, fileFormat(), in, os.Stderr); err != nil {
+			log.Fatal(err)
+		}
+		if failed > 0 {
+			log.Fatalf("%d row(s) failed", failed)
+		}
+	case "export":
+		out := os.Stdout
+		if *file != "-" {
+			if out, err = os.Create(*file); err != nil {
+				log.Fatal(err)
+			}
+			defer out.Close()
+		}
+		cond := &shop.ExportConditions{
+			OnlyPublished:  *published,
+			OnlyCategoryId: int32(*category),
+		}
+		if err = exportArticles(ctx, client, fileFormat(), cond, out); err != nil {
+			log.Fatal(err)
+		}
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+// importArticles streams all articles from r to the server.
+// Rows which can not be read or saved are reported on report,
+// without aborting the import.
+// The amount of failed rows is returned.
+func importArticles(ctx context.Context, client shop.ShopClient, format string, r io.Reader, report io.Writer) (int, error) {
+	ar, err := newArticleReader(format, r)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.ImportArticles(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Results arrive in the order the articles were sent.
+	// rows maps them back to the row numbers of the input.
+	var (
+		rep     = &reporter{w: report}
+		rows    = make(chan int, 100)
+		results = make(chan error, 1)
+		sent    int
+	)
+	go func() {
+		for row := range rows {
+			res, err := stream.Recv()
+			if err != nil {
+				cancel() // Unblock Send
+				results <- err
+				return
+			}
+			if msg := res.GetError(); msg != "" {
+				rep.fail(row, msg)
+			}
+		}
+		results <- nil
+	}()
+
+	for {
+		sa, err := ar.Read()
+		if err == io.EOF {
+			break
+		}
+		if errors.Is(err, errSyntax) {
+			close(rows)
+			cancel()
+			return rep.count(), fmt.Errorf("row %d: %w", ar.Row(), err)
+		}
+		if err != nil {
+			rep.fail(ar.Row(), err.Error())
+			continue
+		}
+
+		req := &shop.ImportRequest{Article: sa}
+		if sent == 0 {
+			req.DryRun = *dryRun
+		}
+		if err = stream.Send(req); err != nil {
+			break // Actual error is returned by Recv
+		}
+		sent++
+		rows <- ar.Row()
+	}
+	close(rows)
+
+	if sent == 0 {
+		return rep.count(), nil
+	}
+	if err = stream.CloseSend(); err != nil {
+		return rep.count(), err
+	}
+	if err = <-results; err != nil {
+		return rep.count(), fmt.Errorf("import: %s", status.Convert(err).Message())
+	}
+	// Receive the final status, which reports commit errors.
+	if _, err = stream.Recv(); err != io.EOF {
+		return rep.count(), fmt.Errorf("import: %s", status.Convert(err).Message())
+	}
+	return rep.count(), nil
+}
+
+// reporter writes failed rows and counts them.
+// It is safe for concurrent use.
+type reporter struct {
+	mu     sync.Mutex
+	w      io.Writer
+	failed int
+}
+
+func (r *reporter) fail(row int, msg string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	fmt.Fprintf(r.w, "row %d: %s\n", row, msg)
+	r.failed++
+}
+
+func (r *reporter) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.failed
+}
+
+// exportArticles writes all articles matching cond to w.
+func exportArticles(ctx context.Context, client shop.ShopClient, format string, cond *shop.ExportConditions, w io.Writer) error {
+	aw, err := newArticleWriter(format, w)
+	if err != nil {
+		return err
+	}
+
+	stream, err := client.ExportArticles(ctx, cond)
+	if err != nil {
+		return err
+	}
+	for {
+		sa, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("export: %s", status.Convert(err).Message())
+		}
+		if err = aw.Write(sa); err != nil {
+			return err
+		}
+	}
+	return aw.Flush()
+}
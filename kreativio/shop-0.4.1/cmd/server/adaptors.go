@@ -15,6 +15,7 @@ import (
 	"github.com/moapis/shop"
 	"github.com/moapis/shop/models"
 	fj "github.com/valyala/fastjson"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -26,6 +27,10 @@ const (
 	errNegAmount = "Negative amount on order article %d: %d"
 	errEnum      = "ENUM mismatch: %v"
 	errDecimal   = "Can't convert %s string %s to decimal" // Field name and value
+	errAttrUnkn  = "Unknown attribute ID %d"
+	errAttrBool  = "Can't convert attribute %s value %s to boolean" // Label and value
+	errAttrEnum  = "Attribute %s value %s not in options %v"        // Label, value and options
+	errAttrRange = "Range filter on non NUMBER attribute %s"        // Label
 )
 
 func checkRequired(vals map[string]interface{}) error {
@@ -51,9 +56,71 @@ func checkRequired(vals map[string]interface{}) error {
 }
 
 const (
-	artDecimal = "Price"
+	artDecimal  = "Price"
+	saleDecimal = "SalePrice"
+	errSchedule = "%s must be before %s" // Field names
+	errSaleCalc = "SalePrice can't be combined with base prices or variant prices"
 )
 
+// timeMsgToModel converts an optional timestamp.
+func timeMsgToModel(ts *timestamp.Timestamp) (null.Time, error) {
+	if ts == nil {
+		return null.Time{}, nil
+	}
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return null.Time{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return null.TimeFrom(t), nil
+}
+
+// nullTimeToMsg converts an optional time.
+func nullTimeToMsg(t null.Time) (*timestamp.Timestamp, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	ts, err := ptypes.TimestampProto(t.Time)
+	if err != nil {
+		return nil, status.Error(codes.OutOfRange, err.Error())
+	}
+	return ts, nil
+}
+
+// checkSchedule returns an error if both times are set and start is not before end.
+func checkSchedule(start, end null.Time, startName, endName string) error {
+	if start.Valid && end.Valid && !start.Time.Before(end.Time) {
+		return status.Errorf(codes.InvalidArgument, errSchedule, startName, endName)
+	}
+	return nil
+}
+
+// saleActive reports whether a sale is active at t.
+// A missing start or end leaves the sale open at that side.
+func saleActive(start, end null.Time, t time.Time) bool {
+	return (!start.Valid || !t.Before(start.Time)) && (!end.Valid || t.Before(end.Time))
+}
+
+// hasCalculatedPrice reports whether the price of sa is calculated from base prices or variant prices.
+func hasCalculatedPrice(sa *shop.Article) bool {
+	if len(sa.GetBaseprices()) > 0 {
+		return true
+	}
+	for _, v := range sa.GetVariants() {
+		if v.GetPrice() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// currentPrice returns the sale price when active at t, otherwise the regular price.
+func currentPrice(art *models.Article, t time.Time) types.Decimal {
+	if art.SalePrice.Big != nil && saleActive(art.SaleStart, art.SaleEnd, t) {
+		return types.NewDecimal(art.SalePrice.Big)
+	}
+	return art.Price
+}
+
 func articleMsgToModel(sa *shop.Article) (*models.Article, error) {
 	vals := map[string]interface{}{
 		"Title":       sa.GetTitle(),
@@ -69,18 +136,53 @@ func articleMsgToModel(sa *shop.Article) (*models.Article, error) {
 		return nil, status.Errorf(codes.InvalidArgument, errDecimal, artDecimal, vals[artDecimal])
 	}
 
-	return &models.Article{
+	art := &models.Article{
 		ID:          int(sa.GetId()),
 		Published:   sa.GetPublished(),
 		Title:       vals["Title"].(string),
 		Description: vals["Description"].(string),
 		Price:       types.NewDecimal(price),
 		Promoted:    sa.GetPromoted(),
-	}, nil
+	}
+
+	if sp := sa.GetSalePrice(); sp != "" {
+		sale, ok := new(decimal.Big).SetString(sp)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, errDecimal, saleDecimal, sp)
+		}
+		// Calculated prices don't apply the sale, only multiplied article prices do.
+		if hasCalculatedPrice(sa) {
+			return nil, status.Error(codes.InvalidArgument, errSaleCalc)
+		}
+		art.SalePrice = types.NewNullDecimal(sale)
+	}
+
+	var errs [4]error
+	art.PublishAt, errs[0] = timeMsgToModel(sa.GetPublishAt())
+	art.UnpublishAt, errs[1] = timeMsgToModel(sa.GetUnpublishAt())
+	art.SaleStart, errs[2] = timeMsgToModel(sa.GetSaleStart())
+	art.SaleEnd, errs[3] = timeMsgToModel(sa.GetSaleEnd())
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkSchedule(art.PublishAt, art.UnpublishAt, "PublishAt", "UnpublishAt"); err != nil {
+		return nil, err
+	}
+	if err := checkSchedule(art.SaleStart, art.SaleEnd, "SaleStart", "SaleEnd"); err != nil {
+		return nil, err
+	}
+
+	return art, nil
 }
 
 const (
 	varDecimal = "Multiplier"
+	varPrice   = "Variant price"
+	varWeight  = "Weight"
+	errStock   = "Negative stock on variant %v: %d" // Labels and stock
 )
 
 func variantsMsgToModel(aid int, sv []*shop.Variant) ([]*models.Variant, error) {
@@ -89,15 +191,21 @@ func variantsMsgToModel(aid int, sv []*shop.Variant) ([]*models.Variant, error)
 		vals := map[string]interface{}{
 			"ArticleID": aid,
 			"Labels":    v.GetLabels(),
-			varDecimal:  v.GetMultiplier(),
+		}
+		// Multiplier is optional when an absolute price is set.
+		if v.GetPrice() == "" {
+			vals[varDecimal] = v.GetMultiplier()
 		}
 		if err := checkRequired(vals); err != nil {
 			return nil, err
 		}
 
-		mpl, ok := new(decimal.Big).SetString(vals[varDecimal].(string))
-		if !ok {
-			return nil, status.Errorf(codes.InvalidArgument, errDecimal, varDecimal, vals[varDecimal])
+		mpl := decimal.New(1, 0)
+		if m := v.GetMultiplier(); m != "" {
+			var ok bool
+			if mpl, ok = new(decimal.Big).SetString(m); !ok {
+				return nil, status.Errorf(codes.InvalidArgument, errDecimal, varDecimal, m)
+			}
 		}
 
 		vars[i] = &models.Variant{
@@ -105,12 +213,63 @@ func variantsMsgToModel(aid int, sv []*shop.Variant) ([]*models.Variant, error)
 			ArticleID:  vals["ArticleID"].(int),
 			Labels:     vals["Labels"].([]string),
 			Multiplier: types.NewDecimal(mpl),
+			Sku:        null.NewString(v.GetSku(), v.GetSku() != ""),
+			Images:     v.GetImages(),
+			Position:   i + 1,
+		}
+		if vars[i].Images == nil {
+			vars[i].Images = []string{}
+		}
+
+		if p := v.GetPrice(); p != "" {
+			price, ok := new(decimal.Big).SetString(p)
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, errDecimal, varPrice, p)
+			}
+			vars[i].Price = types.NewNullDecimal(price)
+		}
+		if w := v.GetWeight(); w != "" {
+			weight, ok := new(decimal.Big).SetString(w)
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, errDecimal, varWeight, w)
+			}
+			vars[i].Weight = types.NewNullDecimal(weight)
+		}
+		if v.GetTrackStock() {
+			if v.GetStock() < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, errStock, v.GetLabels(), v.GetStock())
+			}
+			vars[i].Stock = null.IntFrom(int(v.GetStock()))
 		}
 	}
 
 	return vars, nil
 }
 
+func nullDecimalString(d types.NullDecimal) string {
+	if d.Big == nil {
+		return ""
+	}
+	return d.String()
+}
+
+func variantModelToMsg(vrt *models.Variant) *shop.Variant {
+	sv := &shop.Variant{
+		Id:         vrt.ID,
+		Labels:     vrt.Labels,
+		Multiplier: vrt.Multiplier.String(),
+		Sku:        vrt.Sku.String,
+		Price:      nullDecimalString(vrt.Price),
+		TrackStock: vrt.Stock.Valid,
+		Stock:      int32(vrt.Stock.Int),
+		Weight:     nullDecimalString(vrt.Weight),
+	}
+	if len(vrt.Images) > 0 {
+		sv.Images = vrt.Images
+	}
+	return sv
+}
+
 func imagesMsgToModel(aid int, sm []*shop.Media) ([]*models.Image, error) {
 	imgs := make([]*models.Image, len(sm))
 	for i, m := range sm {
@@ -179,14 +338,28 @@ func articleModelToMsg(art *models.Article) (*shop.Article, error) {
 	}
 
 	sa := &shop.Article{
-		Id:          int32(art.ID),
-		Created:     created,
-		Updated:     updated,
-		Published:   art.Published,
-		Title:       art.Title,
-		Description: art.Description,
-		Price:       art.Price.String(),
-		Promoted:    art.Promoted,
+		Id:           int32(art.ID),
+		Created:      created,
+		Updated:      updated,
+		Published:    art.Published,
+		Title:        art.Title,
+		Description:  art.Description,
+		Price:        art.Price.String(),
+		Promoted:     art.Promoted,
+		SalePrice:    nullDecimalString(art.SalePrice),
+		CurrentPrice: currentPrice(art, time.Now()).String(),
+	}
+
+	var errs [5]error
+	sa.PublishAt, errs[0] = nullTimeToMsg(art.PublishAt)
+	sa.UnpublishAt, errs[1] = nullTimeToMsg(art.UnpublishAt)
+	sa.SaleStart, errs[2] = nullTimeToMsg(art.SaleStart)
+	sa.SaleEnd, errs[3] = nullTimeToMsg(art.SaleEnd)
+	sa.Deleted, errs[4] = nullTimeToMsg(art.DeletedAt)
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if art.R != nil {
@@ -236,10 +409,21 @@ func articleModelToMsg(art *models.Article) (*shop.Article, error) {
 		if art.R.Variants != nil {
 			sa.Variants = make([]*shop.Variant, len(art.R.Variants))
 			for i, vrt := range art.R.Variants {
-				sa.Variants[i] = &shop.Variant{
-					Id:         vrt.ID,
-					Labels:     vrt.Labels,
-					Multiplier: vrt.Multiplier.String(),
+				sa.Variants[i] = variantModelToMsg(vrt)
+			}
+		}
+
+		if art.R.ArticleAttributes != nil {
+			sa.Attributes = make([]*shop.AttributeValue, len(art.R.ArticleAttributes))
+			for i, aa := range art.R.ArticleAttributes {
+				sa.Attributes[i] = &shop.AttributeValue{
+					AttributeId: int32(aa.AttributeID),
+					Value:       aa.Value,
+				}
+				if aa.R != nil && aa.R.Attribute != nil {
+					sa.Attributes[i].Label = aa.R.Attribute.Label
+					sa.Attributes[i].Type = shop.Attribute_Type(shop.Attribute_Type_value[aa.R.Attribute.Type])
+					sa.Attributes[i].Unit = aa.R.Attribute.Unit
 				}
 			}
 		}
@@ -265,6 +449,7 @@ func orderMsgToModel(so *shop.Order) (*models.Order, error) {
 		FullAddress:   vals["FullAddress"].(string),
 		Message:       so.GetMessage(),
 		PaymentMethod: so.GetPaymentMethod().String(),
+		Locale:        so.GetLocale(),
 	}, nil
 }
 
@@ -301,6 +486,7 @@ func orderArticlesModelsToMsg(arts []*models.OrderArticle) ([]*shop.Order_Articl
 			Title:     a.Title,
 			Price:     a.Price.String(),
 			Total:     total.String(),
+			VariantId: a.VariantID.Int64,
 		}
 		sum.Add(sum, total)
 
@@ -330,29 +516,34 @@ func orderModelToMsg(order *models.Order) (*shop.Order, error) {
 	}
 
 	return &shop.Order{
-		Id:            int32(order.ID),
-		Created:       created,
-		Updated:       updated,
-		FullName:      order.FullName,
-		Email:         order.Email,
-		Phone:         order.Phone,
-		FullAddress:   order.FullAddress,
-		Message:       order.Message,
-		PaymentMethod: shop.Order_PaymentMethod(pm),
-		Status:        shop.Order_Status(os),
+		Id:             int32(order.ID),
+		Created:        created,
+		Updated:        updated,
+		FullName:       order.FullName,
+		Email:          order.Email,
+		Phone:          order.Phone,
+		FullAddress:    order.FullAddress,
+		Message:        order.Message,
+		PaymentMethod:  shop.Order_PaymentMethod(pm),
+		Status:         shop.Order_Status(os),
+		Locale:         order.Locale,
+		TrackingNumber: order.TrackingNumber,
+		TrackingUrl:    order.TrackingURL,
 	}, nil
 }
 
 func orderUpdateMsgToModel(so *shop.Order) (*models.Order, error) {
 	order := models.Order{
-		ID:            int(so.GetId()),
-		FullName:      so.GetFullName(),
-		Email:         so.GetEmail(),
-		Phone:         so.GetPhone(),
-		FullAddress:   so.GetFullAddress(),
-		Message:       so.GetMessage(),
-		PaymentMethod: so.GetPaymentMethod().String(),
-		Status:        so.GetStatus().String(),
+		ID:             int(so.GetId()),
+		FullName:       so.GetFullName(),
+		Email:          so.GetEmail(),
+		Phone:          so.GetPhone(),
+		FullAddress:    so.GetFullAddress(),
+		Message:        so.GetMessage(),
+		PaymentMethod:  so.GetPaymentMethod().String(),
+		Status:         so.GetStatus().String(),
+		TrackingNumber: so.GetTrackingNumber(),
+		TrackingURL:    so.GetTrackingUrl(),
 	}
 	if order.ID <= 0 {
 		return nil, status.Errorf(codes.InvalidArgument, errMissing, "ID")
@@ -395,6 +586,122 @@ func categoriesModeltoMsg(cats []*models.Category) ([]*shop.Category, error) {
 	return scs, nil
 }
 
+func attributesMsgToModel(list []*shop.Attribute) ([]*models.Attribute, error) {
+	attrs := make([]*models.Attribute, len(list))
+	for i, a := range list {
+		attrs[i] = &models.Attribute{
+			ID:       int(a.GetId()),
+			Label:    a.GetLabel(),
+			Type:     a.GetType().String(),
+			Unit:     a.GetUnit(),
+			Options:  a.GetOptions(),
+			Position: i + 1,
+		}
+		if attrs[i].Label == "" {
+			return nil, status.Errorf(codes.InvalidArgument, errMissing, "Label")
+		}
+		if a.GetType() == shop.Attribute_ENUM && len(attrs[i].Options) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, errMissing, "Options")
+		}
+		if attrs[i].Options == nil {
+			attrs[i].Options = []string{}
+		}
+	}
+	return attrs, nil
+}
+
+func attributesModelToMsg(attrs []*models.Attribute) ([]*shop.Attribute, error) {
+	sas := make([]*shop.Attribute, len(attrs))
+
+	for i, a := range attrs {
+		created, updated, err := timeModelToMsg(a.CreatedAt, a.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		at, ok := shop.Attribute_Type_value[a.Type]
+		if !ok {
+			return nil, status.Errorf(codes.Unimplemented, errEnum, a.Type)
+		}
+
+		sas[i] = &shop.Attribute{
+			Id:      int32(a.ID),
+			Created: created,
+			Updated: updated,
+			Label:   a.Label,
+			Type:    shop.Attribute_Type(at),
+			Unit:    a.Unit,
+			Options: a.Options,
+		}
+
+		if a.R != nil && a.R.Categories != nil {
+			sas[i].Categories = make([]*shop.Category, len(a.R.Categories))
+			for j, c := range a.R.Categories {
+				sas[i].Categories[j] = &shop.Category{
+					Id:    int32(c.ID),
+					Label: c.Label,
+				}
+			}
+		}
+	}
+
+	return sas, nil
+}
+
+// attributeValuesMsgToModel validates the values against the attribute definitions,
+// indexed by ID. NUMBER values are also stored as decimal, for range filtering.
+func attributeValuesMsgToModel(aid int, sv []*shop.AttributeValue, defs map[int]*models.Attribute) ([]*models.ArticleAttribute, error) {
+	vals := make([]*models.ArticleAttribute, len(sv))
+	for i, v := range sv {
+		req := map[string]interface{}{
+			"ArticleID":   aid,
+			"AttributeID": int(v.GetAttributeId()),
+			"Value":       v.GetValue(),
+		}
+		if err := checkRequired(req); err != nil {
+			return nil, err
+		}
+
+		def, ok := defs[req["AttributeID"].(int)]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, errAttrUnkn, req["AttributeID"])
+		}
+
+		vals[i] = &models.ArticleAttribute{
+			ArticleID:   req["ArticleID"].(int),
+			AttributeID: req["AttributeID"].(int),
+			Value:       req["Value"].(string),
+		}
+
+		switch def.Type {
+		case models.AttributeTypeNUMBER:
+			num, ok := new(decimal.Big).SetString(vals[i].Value)
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, errDecimal, def.Label, vals[i].Value)
+			}
+			vals[i].Value = num.String()
+			vals[i].Number = types.NewNullDecimal(num)
+		case models.AttributeTypeBOOLEAN:
+			b, err := strconv.ParseBool(vals[i].Value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, errAttrBool, def.Label, vals[i].Value)
+			}
+			vals[i].Value = strconv.FormatBool(b)
+		case models.AttributeTypeENUM:
+			var found bool
+			for _, o := range def.Options {
+				if o == vals[i].Value {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, status.Errorf(codes.InvalidArgument, errAttrEnum, def.Label, vals[i].Value, []string(def.Options))
+			}
+		}
+	}
+	return vals, nil
+}
+
 // TODO: modify query to return EPOCH seconds directly
 func timeBytesToMsg(ca, ua []byte) (created, updated *timestamp.Timestamp, err error) {
 	var (
@@ -415,6 +722,17 @@ func timeBytesToMsg(ca, ua []byte) (created, updated *timestamp.Timestamp, err e
 	return timeModelToMsg(ct, ut)
 }
 
+func timeBytesToNull(b []byte) (null.Time, error) {
+	if len(b) == 0 {
+		return null.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, string(b))
+	if err != nil {
+		return null.Time{}, status.Error(codes.OutOfRange, err.Error())
+	}
+	return null.TimeFrom(t), nil
+}
+
 func mediaValuesToMsg(mds []*fj.Value) []*shop.Media {
 	if len(mds) == 0 {
 		return nil
@@ -473,15 +791,44 @@ func variantValuesToMsg(vts []*fj.Value) []*shop.Variant {
 		sv[i] = &shop.Variant{
 			Id:         v.GetInt64("id"),
 			Multiplier: string(v.GetStringBytes("multiplier")),
+			Sku:        string(v.GetStringBytes("sku")),
+			Price:      string(v.GetStringBytes("price")),
+			Weight:     string(v.GetStringBytes("weight")),
+		}
+		if st := v.Get("stock"); st != nil && st.Type() == fj.TypeNumber {
+			sv[i].TrackStock = true
+			sv[i].Stock = int32(st.GetInt())
 		}
 		for _, l := range v.GetArray("labels") {
 			s, _ := strconv.Unquote(l.String())
 			sv[i].Labels = append(sv[i].Labels, s)
 		}
+		for _, img := range v.GetArray("images") {
+			s, _ := strconv.Unquote(img.String())
+			sv[i].Images = append(sv[i].Images, s)
+		}
 	}
 	return sv
 }
 
+func attributeValueValuesToMsg(avs []*fj.Value) []*shop.AttributeValue {
+	if len(avs) == 0 {
+		return nil
+	}
+	sav := make([]*shop.AttributeValue, len(avs))
+
+	for i, a := range avs {
+		sav[i] = &shop.AttributeValue{
+			AttributeId: int32(a.GetInt("attribute_id")),
+			Label:       string(a.GetStringBytes("label")),
+			Type:        shop.Attribute_Type(shop.Attribute_Type_value[string(a.GetStringBytes("type"))]),
+			Unit:        string(a.GetStringBytes("unit")),
+			Value:       string(a.GetStringBytes("value")),
+		}
+	}
+	return sav
+}
+
 func articleValueToMsg(art *fj.Value) (sa *shop.Article, err error) {
 	sa = &shop.Article{
 		Id:          int32(art.GetInt("id")),
@@ -490,17 +837,41 @@ func articleValueToMsg(art *fj.Value) (sa *shop.Article, err error) {
 		Description: string(art.GetStringBytes("description")),
 		Price:       string(art.GetStringBytes("price")),
 		Promoted:    art.GetBool("promoted"),
+		SalePrice:   string(art.GetStringBytes("sale_price")),
 		Images:      mediaValuesToMsg(art.GetArray("images")),
 		Videos:      mediaValuesToMsg(art.GetArray("videos")),
 		Categories:  categoryValuesToMsg(art.GetArray("categories")),
 		Baseprices:  basePriceValuesToMsg(art.GetArray("baseprices")),
 		Variants:    variantValuesToMsg(art.GetArray("variants")),
+		Attributes:  attributeValueValuesToMsg(art.GetArray("attributes")),
 	}
 
 	if sa.Created, sa.Updated, err = timeBytesToMsg(art.GetStringBytes("created_at"), art.GetStringBytes("updated_at")); err != nil {
 		return nil, err
 	}
 
+	var schedule [4]null.Time
+	for i, k := range [4]string{"publish_at", "unpublish_at", "sale_start", "sale_end"} {
+		if schedule[i], err = timeBytesToNull(art.GetStringBytes(k)); err != nil {
+			return nil, err
+		}
+	}
+	var errs [4]error
+	sa.PublishAt, errs[0] = nullTimeToMsg(schedule[0])
+	sa.UnpublishAt, errs[1] = nullTimeToMsg(schedule[1])
+	sa.SaleStart, errs[2] = nullTimeToMsg(schedule[2])
+	sa.SaleEnd, errs[3] = nullTimeToMsg(schedule[3])
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sa.CurrentPrice = sa.Price
+	if sa.SalePrice != "" && saleActive(schedule[2], schedule[3], time.Now()) {
+		sa.CurrentPrice = sa.SalePrice
+	}
+
 	return sa, nil
 }
 
@@ -613,3 +984,24 @@ func messageMsgToModel(sm *shop.Message) (*models.Message, error) {
 		Subject: sm.GetSubject(),
 	}, nil
 }
+
+// revisionModelToMsg converts a revision, including its article snapshot.
+func revisionModelToMsg(rev *models.ArticleRevision) (*shop.ArticleRevision, error) {
+	created, _, err := timeModelToMsg(rev.CreatedAt, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	sa := new(shop.Article)
+	if err = json.Unmarshal(rev.Snapshot, sa); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &shop.ArticleRevision{
+		Id:        int32(rev.ID),
+		ArticleId: int32(rev.ArticleID),
+		Created:   created,
+		Subject:   rev.Subject,
+		Article:   sa,
+	}, nil
+}
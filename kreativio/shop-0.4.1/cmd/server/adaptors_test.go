@@ -86,6 +86,115 @@ func Test_articleMsgToModel(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"Schedule and sale",
+			&shop.Article{
+				Title:       "Ain't it good?",
+				Description: "Best procuct to buy!",
+				Price:       "20000.01",
+				PublishAt:   &timestamp.Timestamp{Seconds: 100},
+				UnpublishAt: &timestamp.Timestamp{Seconds: 200},
+				SalePrice:   "15000",
+				SaleStart:   &timestamp.Timestamp{Seconds: 150},
+			},
+			&models.Article{
+				Title:       "Ain't it good?",
+				Description: "Best procuct to buy!",
+				Price:       types.NewDecimal(decimal.New(2000001, 2)), // 20000.01
+				PublishAt:   null.TimeFrom(time.Unix(100, 0).UTC()),
+				UnpublishAt: null.TimeFrom(time.Unix(200, 0).UTC()),
+				SalePrice:   types.NewNullDecimal(decimal.New(15000, 0)),
+				SaleStart:   null.TimeFrom(time.Unix(150, 0).UTC()),
+			},
+			nil,
+		},
+		{
+			"Sale price error",
+			&shop.Article{
+				Title:       "Ain't it good?",
+				Description: "Best procuct to buy!",
+				Price:       "20000.01",
+				SalePrice:   "spanac",
+			},
+			nil,
+			status.Errorf(codes.InvalidArgument, errDecimal, saleDecimal, "spanac"),
+		},
+		{
+			"Sale with base prices",
+			&shop.Article{
+				Title:       "Ain't it good?",
+				Description: "Best procuct to buy!",
+				Price:       "20000.01",
+				SalePrice:   "15000",
+				Baseprices:  []*shop.BasePrice{{Id: 1}},
+			},
+			nil,
+			status.Error(codes.InvalidArgument, errSaleCalc),
+		},
+		{
+			"Sale with variant price",
+			&shop.Article{
+				Title:       "Ain't it good?",
+				Description: "Best procuct to buy!",
+				Price:       "20000.01",
+				SalePrice:   "15000",
+				Variants:    []*shop.Variant{{Labels: []string{"small"}, Multiplier: "1"}, {Labels: []string{"fixed"}, Price: "19.99"}},
+			},
+			nil,
+			status.Error(codes.InvalidArgument, errSaleCalc),
+		},
+		{
+			"Sale with multiplied variants",
+			&shop.Article{
+				Title:       "Ain't it good?",
+				Description: "Best procuct to buy!",
+				Price:       "20000.01",
+				SalePrice:   "15000",
+				Variants:    []*shop.Variant{{Labels: []string{"double"}, Multiplier: "2"}},
+			},
+			&models.Article{
+				Title:       "Ain't it good?",
+				Description: "Best procuct to buy!",
+				Price:       types.NewDecimal(decimal.New(2000001, 2)), // 20000.01
+				SalePrice:   types.NewNullDecimal(decimal.New(15000, 0)),
+			},
+			nil,
+		},
+		{
+			"Schedule error",
+			&shop.Article{
+				Title:       "Ain't it good?",
+				Description: "Best procuct to buy!",
+				Price:       "20000.01",
+				PublishAt:   &timestamp.Timestamp{Seconds: 200},
+				UnpublishAt: &timestamp.Timestamp{Seconds: 100},
+			},
+			nil,
+			status.Errorf(codes.InvalidArgument, errSchedule, "PublishAt", "UnpublishAt"),
+		},
+		{
+			"Sale schedule error",
+			&shop.Article{
+				Title:       "Ain't it good?",
+				Description: "Best procuct to buy!",
+				Price:       "20000.01",
+				SaleStart:   &timestamp.Timestamp{Seconds: 100},
+				SaleEnd:     &timestamp.Timestamp{Seconds: 100},
+			},
+			nil,
+			status.Errorf(codes.InvalidArgument, errSchedule, "SaleStart", "SaleEnd"),
+		},
+		{
+			"Timestamp error",
+			&shop.Article{
+				Title:       "Ain't it good?",
+				Description: "Best procuct to buy!",
+				Price:       "20000.01",
+				SaleEnd:     &timestamp.Timestamp{Seconds: -62135596801},
+			},
+			nil,
+			status.Error(codes.InvalidArgument, "timestamp: seconds:-62135596801 before 0001-01-01"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -101,6 +210,76 @@ func Test_articleMsgToModel(t *testing.T) {
 	}
 }
 
+func Test_saleActive(t *testing.T) {
+	start := null.TimeFrom(time.Unix(100, 0))
+	end := null.TimeFrom(time.Unix(200, 0))
+
+	tests := []struct {
+		name  string
+		start null.Time
+		end   null.Time
+		t     time.Time
+		want  bool
+	}{
+		{"Open", null.Time{}, null.Time{}, time.Unix(50, 0), true},
+		{"Before start", start, end, time.Unix(50, 0), false},
+		{"At start", start, end, time.Unix(100, 0), true},
+		{"Between", start, end, time.Unix(150, 0), true},
+		{"At end", start, end, time.Unix(200, 0), false},
+		{"No start", null.Time{}, end, time.Unix(50, 0), true},
+		{"No end", start, null.Time{}, time.Unix(500, 0), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := saleActive(tt.start, tt.end, tt.t); got != tt.want {
+				t.Errorf("saleActive() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_currentPrice(t *testing.T) {
+	price := types.NewDecimal(decimal.New(2000, 2))
+	sale := decimal.New(1500, 2)
+
+	tests := []struct {
+		name string
+		art  *models.Article
+		want string
+	}{
+		{
+			"No sale",
+			&models.Article{Price: price},
+			"20.00",
+		},
+		{
+			"Active sale",
+			&models.Article{
+				Price:     price,
+				SalePrice: types.NewNullDecimal(sale),
+				SaleStart: null.TimeFrom(time.Unix(100, 0)),
+			},
+			"15.00",
+		},
+		{
+			"Ended sale",
+			&models.Article{
+				Price:     price,
+				SalePrice: types.NewNullDecimal(sale),
+				SaleEnd:   null.TimeFrom(time.Unix(100, 0)),
+			},
+			"20.00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := currentPrice(tt.art, time.Unix(150, 0)); got.String() != tt.want {
+				t.Errorf("currentPrice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_variantsMsgToModel(t *testing.T) {
 	type args struct {
 		aid int
@@ -144,15 +323,80 @@ func Test_variantsMsgToModel(t *testing.T) {
 					ArticleID:  999,
 					Labels:     []string{"hello", "world"},
 					Multiplier: types.NewDecimal(decimal.New(4455, 2)),
+					Images:     []string{},
+					Position:   1,
 				},
 				{
 					ID:         1002,
 					ArticleID:  999,
 					Labels:     []string{"foo", "bar"},
 					Multiplier: types.NewDecimal(decimal.New(9999, 2)),
+					Images:     []string{},
+					Position:   2,
+				},
+			},
+			nil,
+		},
+		{
+			"Absolute price and stock",
+			args{
+				999,
+				[]*shop.Variant{
+					{
+						Labels:     []string{"hello", "world"},
+						Sku:        "HW-1",
+						Price:      "12.50",
+						TrackStock: true,
+						Stock:      3,
+						Weight:     "0.75",
+						Images:     []string{"https://bucket.s3.com/hw.jpg"},
+					},
+				},
+			},
+			[]*models.Variant{
+				{
+					ArticleID:  999,
+					Labels:     []string{"hello", "world"},
+					Multiplier: types.NewDecimal(decimal.New(1, 0)),
+					Sku:        null.StringFrom("HW-1"),
+					Price:      types.NewNullDecimal(decimal.New(1250, 2)),
+					Stock:      null.IntFrom(3),
+					Weight:     types.NewNullDecimal(decimal.New(75, 2)),
+					Images:     []string{"https://bucket.s3.com/hw.jpg"},
+					Position:   1,
+				},
+			},
+			nil,
+		},
+		{
+			"Negative stock",
+			args{
+				999,
+				[]*shop.Variant{
+					{
+						Labels:     []string{"hello", "world"},
+						Multiplier: "1",
+						TrackStock: true,
+						Stock:      -1,
+					},
 				},
 			},
 			nil,
+			status.Errorf(codes.InvalidArgument, errStock, []string{"hello", "world"}, -1),
+		},
+		{
+			"Illigal price",
+			args{
+				999,
+				[]*shop.Variant{
+					{
+						Labels: []string{"hello", "world"},
+						Price:  "spanac",
+					},
+				},
+			},
+			nil,
+			status.Errorf(codes.InvalidArgument, errDecimal, varPrice, "spanac"),
 		},
 		{
 			"Illigal multiplier",
@@ -830,6 +1074,252 @@ func Test_categoriesModeltoMsg(t *testing.T) {
 	}
 }
 
+func Test_attributesMsgToModel(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    []*shop.Attribute
+		want    []*models.Attribute
+		wantErr error
+	}{
+		{
+			"Empty attribute",
+			[]*shop.Attribute{{}},
+			nil,
+			status.Errorf(codes.InvalidArgument, errMissing, "Label"),
+		},
+		{
+			"Enum without options",
+			[]*shop.Attribute{{Label: "Culoare", Type: shop.Attribute_ENUM}},
+			nil,
+			status.Errorf(codes.InvalidArgument, errMissing, "Options"),
+		},
+		{
+			"Success",
+			[]*shop.Attribute{
+				{
+					Id:    1,
+					Label: "Material",
+				},
+				{
+					Label: "Lungime",
+					Type:  shop.Attribute_NUMBER,
+					Unit:  "cm",
+				},
+				{
+					Id:      3,
+					Label:   "Culoare",
+					Type:    shop.Attribute_ENUM,
+					Options: []string{"rosu", "verde"},
+				},
+			},
+			[]*models.Attribute{
+				{
+					ID:       1,
+					Label:    "Material",
+					Type:     models.AttributeTypeTEXT,
+					Options:  []string{},
+					Position: 1,
+				},
+				{
+					Label:    "Lungime",
+					Type:     models.AttributeTypeNUMBER,
+					Unit:     "cm",
+					Options:  []string{},
+					Position: 2,
+				},
+				{
+					ID:       3,
+					Label:    "Culoare",
+					Type:     models.AttributeTypeENUM,
+					Options:  []string{"rosu", "verde"},
+					Position: 3,
+				},
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := attributesMsgToModel(tt.list)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("attributesMsgToModel() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("attributesMsgToModel() = \n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_attributesModelToMsg(t *testing.T) {
+	withCats := &models.Attribute{
+		ID:      3,
+		Label:   "Culoare",
+		Type:    models.AttributeTypeENUM,
+		Options: []string{"rosu", "verde"},
+	}
+	withCats.R = withCats.R.NewStruct()
+	withCats.R.Categories = models.CategorySlice{
+		{ID: 20, Label: "Empty category"},
+	}
+
+	tests := []struct {
+		name    string
+		attrs   []*models.Attribute
+		want    []*shop.Attribute
+		wantErr bool
+	}{
+		{
+			"Invalid time",
+			[]*models.Attribute{
+				{
+					ID:        345,
+					UpdatedAt: time.Unix(-62135596801, 0),
+				},
+			},
+			nil,
+			true,
+		},
+		{
+			"Invalid type",
+			[]*models.Attribute{
+				{
+					ID:   345,
+					Type: "spanac",
+				},
+			},
+			nil,
+			true,
+		},
+		{
+			"Success",
+			[]*models.Attribute{withCats},
+			[]*shop.Attribute{
+				{
+					Id:      3,
+					Label:   "Culoare",
+					Type:    shop.Attribute_ENUM,
+					Options: []string{"rosu", "verde"},
+					Categories: []*shop.Category{
+						{Id: 20, Label: "Empty category"},
+					},
+				},
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := attributesModelToMsg(tt.attrs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("attributesModelToMsg() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("attributesModelToMsg() = \n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_attributeValuesMsgToModel(t *testing.T) {
+	defs := map[int]*models.Attribute{
+		1: {ID: 1, Label: "Material", Type: models.AttributeTypeTEXT},
+		2: {ID: 2, Label: "Lungime", Type: models.AttributeTypeNUMBER},
+		3: {ID: 3, Label: "Culoare", Type: models.AttributeTypeENUM, Options: []string{"rosu", "verde"}},
+		4: {ID: 4, Label: "Lavabil", Type: models.AttributeTypeBOOLEAN},
+	}
+
+	type args struct {
+		aid int
+		sv  []*shop.AttributeValue
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []*models.ArticleAttribute
+		wantErr error
+	}{
+		{
+			"Missing fields",
+			args{
+				0,
+				[]*shop.AttributeValue{{}},
+			},
+			nil,
+			status.Error(codes.InvalidArgument, "Missing required fields: ArticleID, AttributeID, Value"),
+		},
+		{
+			"Unknown attribute",
+			args{
+				999,
+				[]*shop.AttributeValue{{AttributeId: 9, Value: "foo"}},
+			},
+			nil,
+			status.Errorf(codes.InvalidArgument, errAttrUnkn, 9),
+		},
+		{
+			"Illegal number",
+			args{
+				999,
+				[]*shop.AttributeValue{{AttributeId: 2, Value: "spanac"}},
+			},
+			nil,
+			status.Errorf(codes.InvalidArgument, errDecimal, "Lungime", "spanac"),
+		},
+		{
+			"Illegal boolean",
+			args{
+				999,
+				[]*shop.AttributeValue{{AttributeId: 4, Value: "spanac"}},
+			},
+			nil,
+			status.Errorf(codes.InvalidArgument, errAttrBool, "Lavabil", "spanac"),
+		},
+		{
+			"Illegal option",
+			args{
+				999,
+				[]*shop.AttributeValue{{AttributeId: 3, Value: "albastru"}},
+			},
+			nil,
+			status.Errorf(codes.InvalidArgument, errAttrEnum, "Culoare", "albastru", []string{"rosu", "verde"}),
+		},
+		{
+			"All types",
+			args{
+				999,
+				[]*shop.AttributeValue{
+					{AttributeId: 1, Value: "lemn"},
+					{AttributeId: 2, Value: "44.55"},
+					{AttributeId: 3, Value: "verde"},
+					{AttributeId: 4, Value: "1"},
+				},
+			},
+			[]*models.ArticleAttribute{
+				{ArticleID: 999, AttributeID: 1, Value: "lemn"},
+				{ArticleID: 999, AttributeID: 2, Value: "44.55", Number: types.NewNullDecimal(decimal.New(4455, 2))},
+				{ArticleID: 999, AttributeID: 3, Value: "verde"},
+				{ArticleID: 999, AttributeID: 4, Value: "true"},
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := attributeValuesMsgToModel(tt.args.aid, tt.args.sv, defs)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("attributeValuesMsgToModel() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("attributeValuesMsgToModel() = \n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_timeBytesToMsg(t *testing.T) {
 	type args struct {
 		ca []byte
@@ -1037,7 +1527,7 @@ func Test_basePriceValuesToMsg(t *testing.T) {
 }
 
 func Test_variantValuesToMsg(t *testing.T) {
-	js := `[{"id" : 21, "labels" : ["foo", "bar"], "multiplier" : "2.2"}, {"id" : 22, "labels" : ["hello", "world"], "multiplier" : "3.3"}]`
+	js := `[{"id" : 21, "labels" : ["foo", "bar"], "multiplier" : "2.2"}, {"id" : 22, "labels" : ["hello", "world"], "multiplier" : "3.3", "sku" : "HW-1", "price" : "12.5", "stock" : 3, "weight" : "0.75", "images" : ["https://bucket.s3.com/hw.jpg"]}]`
 	v, err := fj.Parse(js)
 	if err != nil {
 		t.Fatal(err)
@@ -1070,6 +1560,12 @@ func Test_variantValuesToMsg(t *testing.T) {
 					Id:         22,
 					Labels:     []string{"hello", "world"},
 					Multiplier: "3.3",
+					Sku:        "HW-1",
+					Price:      "12.5",
+					TrackStock: true,
+					Stock:      3,
+					Weight:     "0.75",
+					Images:     []string{"https://bucket.s3.com/hw.jpg"},
 				},
 			},
 		},
@@ -1083,6 +1579,56 @@ func Test_variantValuesToMsg(t *testing.T) {
 	}
 }
 
+func Test_attributeValueValuesToMsg(t *testing.T) {
+	js := `[{"attribute_id" : 1, "label" : "Material", "type" : "TEXT", "unit" : "", "value" : "lemn"}, {"attribute_id" : 2, "label" : "Lungime", "type" : "NUMBER", "unit" : "cm", "value" : "44.55"}]`
+	v, err := fj.Parse(js)
+	if err != nil {
+		t.Fatal(err)
+	}
+	va, err := v.Array()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		fv   []*fj.Value
+		want []*shop.AttributeValue
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"attributes",
+			va,
+			[]*shop.AttributeValue{
+				{
+					AttributeId: 1,
+					Label:       "Material",
+					Type:        shop.Attribute_TEXT,
+					Value:       "lemn",
+				},
+				{
+					AttributeId: 2,
+					Label:       "Lungime",
+					Type:        shop.Attribute_NUMBER,
+					Unit:        "cm",
+					Value:       "44.55",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := attributeValueValuesToMsg(tt.fv); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("attributeValueValuesToMsg() = \n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_articleValueToMsg(t *testing.T) {
 	js := `{"id" : 1, "created_at" : "2020-02-01T09:51:55+02:00", "updated_at" : "2020-02-10T15:03:33+02:00", "published" : true, "title" : "Some title", "description" : "Some description", "price" : "7993.60", "promoted" : true, "images" : [{"id" : 21, "url" : "https://ex.com/i1", "label" : "foo"}, {"id" : 22, "url" : "https://ex.com/i2", "label" : "bar"}]}`
 	v, err := fj.Parse(js)
@@ -1096,6 +1642,18 @@ func Test_articleValueToMsg(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	sjs := `{"id" : 2, "created_at" : "2020-02-01T09:51:55+02:00", "updated_at" : "2020-02-10T15:03:33+02:00", "published" : true, "title" : "On sale", "description" : "Some description", "price" : "10.00", "publish_at" : "2020-02-01T09:51:55+02:00", "sale_price" : "7.50", "sale_start" : "2020-02-10T15:03:33+02:00"}`
+	vs, err := fj.Parse(sjs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	iss := `{"created_at" : "2020-02-01T09:51:55+02:00", "updated_at" : "2020-02-10T15:03:33+02:00", "sale_end" : "~"}`
+	vis, err := fj.Parse(iss)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	tests := []struct {
 		name    string
 		art     *fj.Value
@@ -1106,14 +1664,15 @@ func Test_articleValueToMsg(t *testing.T) {
 			"Success",
 			v,
 			&shop.Article{
-				Id:          1,
-				Created:     &timestamp.Timestamp{Seconds: 1580543515},
-				Updated:     &timestamp.Timestamp{Seconds: 1581339813},
-				Published:   true,
-				Title:       "Some title",
-				Description: "Some description",
-				Price:       "7993.60",
-				Promoted:    true,
+				Id:           1,
+				Created:      &timestamp.Timestamp{Seconds: 1580543515},
+				Updated:      &timestamp.Timestamp{Seconds: 1581339813},
+				Published:    true,
+				Title:        "Some title",
+				Description:  "Some description",
+				Price:        "7993.60",
+				Promoted:     true,
+				CurrentPrice: "7993.60",
 				Images: []*shop.Media{
 					{
 						Id:    21,
@@ -1129,12 +1688,36 @@ func Test_articleValueToMsg(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"Active sale",
+			vs,
+			&shop.Article{
+				Id:           2,
+				Created:      &timestamp.Timestamp{Seconds: 1580543515},
+				Updated:      &timestamp.Timestamp{Seconds: 1581339813},
+				Published:    true,
+				Title:        "On sale",
+				Description:  "Some description",
+				Price:        "10.00",
+				PublishAt:    &timestamp.Timestamp{Seconds: 1580543515},
+				SalePrice:    "7.50",
+				SaleStart:    &timestamp.Timestamp{Seconds: 1581339813},
+				CurrentPrice: "7.50",
+			},
+			false,
+		},
 		{
 			"Invalid created_at",
 			vi,
 			nil,
 			true,
 		},
+		{
+			"Invalid sale_end",
+			vis,
+			nil,
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1208,14 +1791,15 @@ func Test_jsonScanner_articlesJSONtoMsg(t *testing.T) {
 			`[{"id" : 1, "created_at" : "2020-02-01T09:51:55+02:00", "updated_at" : "2020-02-10T15:03:33+02:00", "published" : true, "title" : "Some title", "description" : "Some description", "price" : "7993.60", "promoted" : true, "images" : [{"id" : 21, "url" : "https://ex.com/i1", "label" : "foo"}, {"id" : 22, "url" : "https://ex.com/i2", "label" : "bar"}]}]`,
 			[]*shop.Article{
 				{
-					Id:          1,
-					Created:     &timestamp.Timestamp{Seconds: 1580543515},
-					Updated:     &timestamp.Timestamp{Seconds: 1581339813},
-					Published:   true,
-					Title:       "Some title",
-					Description: "Some description",
-					Price:       "7993.60",
-					Promoted:    true,
+					Id:           1,
+					Created:      &timestamp.Timestamp{Seconds: 1580543515},
+					Updated:      &timestamp.Timestamp{Seconds: 1581339813},
+					Published:    true,
+					Title:        "Some title",
+					Description:  "Some description",
+					Price:        "7993.60",
+					Promoted:     true,
+					CurrentPrice: "7993.60",
 					Images: []*shop.Media{
 						{
 							Id:    21,
@@ -1394,3 +1978,69 @@ func Test_messageMsgToModel(t *testing.T) {
 		})
 	}
 }
+
+func Test_revisionModelToMsg(t *testing.T) {
+	tests := []struct {
+		name    string
+		rev     *models.ArticleRevision
+		want    *shop.ArticleRevision
+		wantErr bool
+	}{
+		{
+			"Success",
+			&models.ArticleRevision{
+				ID:        1,
+				CreatedAt: time.Unix(12, 0),
+				ArticleID: 13,
+				Subject:   "1",
+				Snapshot:  types.JSON(`{"id":13,"created":{"seconds":3000},"title":"ID 13","price":"22.99","variants":[{"id":41,"labels":["hello","world"]}]}`),
+			},
+			&shop.ArticleRevision{
+				Id:        1,
+				ArticleId: 13,
+				Created:   &timestamp.Timestamp{Seconds: 12},
+				Subject:   "1",
+				Article: &shop.Article{
+					Id:      13,
+					Created: &timestamp.Timestamp{Seconds: 3000},
+					Title:   "ID 13",
+					Price:   "22.99",
+					Variants: []*shop.Variant{
+						{Id: 41, Labels: []string{"hello", "world"}},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"Invalid time",
+			&models.ArticleRevision{
+				CreatedAt: time.Unix(-62135596801, 0),
+				Snapshot:  types.JSON(`{}`),
+			},
+			nil,
+			true,
+		},
+		{
+			"Invalid snapshot",
+			&models.ArticleRevision{
+				CreatedAt: time.Unix(12, 0),
+				Snapshot:  types.JSON(`{"id":"foo"}`),
+			},
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := revisionModelToMsg(tt.rev)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("revisionModelToMsg() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("revisionModelToMsg() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
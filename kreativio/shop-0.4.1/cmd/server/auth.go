@@ -0,0 +1,168 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+
+	"github.com/moapis/authenticator/verify"
+	"github.com/moapis/transaction"
+	"github.com/pascaldekloe/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	errAuthMetadata = "Malformed authorization metadata, expected a Bearer token"
+	errUnauthorized = "Unauthorized"
+	errMethodDenied = "Method not permitted"
+	errPermission   = "Missing permission: %s"
+	msgTokenField   = "Token in message field is deprecated, send authorization metadata"
+	bearerPrefix    = "Bearer "
+
+	// PermissionPublic allows a method without a token.
+	PermissionPublic = "public"
+)
+
+// bearerToken returns the token from the "authorization: Bearer" metadata in ctx.
+// An empty string is returned when there is no authorization metadata.
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	vals := md.Get("authorization")
+	if len(vals) == 0 {
+		return "", nil
+	}
+	if len(vals[0]) <= len(bearerPrefix) || !strings.EqualFold(vals[0][:len(bearerPrefix)], bearerPrefix) {
+		return "", status.Error(codes.Unauthenticated, errAuthMetadata)
+	}
+	return strings.TrimSpace(vals[0][len(bearerPrefix):]), nil
+}
+
+type claimsKey struct{}
+
+// claimsFromContext returns the claims of the bearer token, as verified by the auth interceptors.
+func claimsFromContext(ctx context.Context) (*jwt.Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*jwt.Claims)
+	return claims, ok
+}
+
+// checkGroups returns an error if the claims do not hold any of the groups.
+// Any claims pass when groups is empty.
+func checkGroups(claims *jwt.Claims, groups []string) error {
+	if len(groups) == 0 {
+		return nil
+	}
+	cgi, ok := claims.Set["groups"].([]interface{})
+	claimGroups := make([]string, len(cgi))
+	for i, g := range cgi {
+		if claimGroups[i], ok = g.(string); !ok {
+			return status.Error(codes.InvalidArgument, transaction.ErrGroup)
+		}
+	}
+	if !ok || !verify.HasAnyEntry(groups, claimGroups) {
+		return status.Error(codes.Unauthenticated, transaction.ErrGroup)
+	}
+	return nil
+}
+
+// checkPermission returns an error if the claims are not allowed to call method.
+// Methods missing from the permissions config are always denied.
+func (s *shopServer) checkPermission(claims *jwt.Claims, method string) error {
+	permission, ok := s.conf.Permissions[method]
+	if !ok {
+		return status.Error(codes.PermissionDenied, errMethodDenied)
+	}
+	if permission == PermissionPublic {
+		return nil
+	}
+	if claims != nil {
+		permissions, _ := claims.Set["permissions"].([]interface{})
+		for _, p := range permissions {
+			if p == permission {
+				return nil
+			}
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, errPermission, permission)
+}
+
+// authenticate verifies the bearer token in the metadata, if any,
+// and checks its permission for the method.
+// The returned context holds its claims, for use by newAuthTx.
+//
+// Methods which are not public, called without bearer token,
+// are left to newAuthTx for the deprecated token field.
+func (s *shopServer) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	method := path.Base(fullMethod)
+	log := s.log.WithField("method", method)
+
+	permission, ok := s.conf.Permissions[method]
+	if !ok {
+		log.Warn(errMethodDenied)
+		return nil, status.Error(codes.PermissionDenied, errMethodDenied)
+	}
+
+	token, err := bearerToken(ctx)
+	if err != nil {
+		log.WithError(err).Warn("bearerToken")
+		return nil, err
+	}
+	if token == "" {
+		return ctx, nil
+	}
+
+	claims, err := s.tv.Token(ctx, token)
+	if err != nil {
+		log.WithError(err).Warn("Check bearer token")
+		var ve *verify.VerificationErr
+		if errors.As(err, &ve) {
+			return nil, status.Error(codes.Unauthenticated, errUnauthorized)
+		}
+		return nil, status.Error(codes.Internal, transaction.ErrAuth)
+	}
+	log = log.WithField("user", claims.Subject)
+	log.Debug("Bearer token verified")
+
+	if err = s.checkPermission(claims, method); err != nil {
+		log.WithError(err).WithField("permission", permission).Warn("checkPermission")
+		return nil, err
+	}
+
+	return context.WithValue(ctx, claimsKey{}, claims), nil
+}
+
+func (s *shopServer) unaryAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := s.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// authServerStream overrides the context of a grpc.ServerStream.
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *authServerStream) Context() context.Context {
+	return ss.ctx
+}
+
+func (s *shopServer) streamAuthInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := s.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authServerStream{ss, ctx})
+}
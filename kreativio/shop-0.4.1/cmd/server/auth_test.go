@@ -0,0 +1,339 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/moapis/shop"
+	"github.com/pascaldekloe/jwt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func Test_bearerToken(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		want     string
+		wantCode codes.Code
+	}{
+		{
+			"No metadata",
+			context.Background(),
+			"",
+			codes.OK,
+		},
+		{
+			"No authorization",
+			metadata.NewIncomingContext(context.Background(), metadata.Pairs("foo", "bar")),
+			"",
+			codes.OK,
+		},
+		{
+			"Bearer",
+			metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer foo.bar.baz")),
+			"foo.bar.baz",
+			codes.OK,
+		},
+		{
+			"Lower case",
+			metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer foo.bar.baz")),
+			"foo.bar.baz",
+			codes.OK,
+		},
+		{
+			"Basic",
+			metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic Zm9vOmJhcg==")),
+			"",
+			codes.Unauthenticated,
+		},
+		{
+			"Empty bearer",
+			metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer ")),
+			"",
+			codes.Unauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := bearerToken(tt.ctx)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("bearerToken() error = %v, wantCode %v", err, tt.wantCode)
+			}
+			if got != tt.want {
+				t.Errorf("bearerToken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_checkGroups(t *testing.T) {
+	claims := func(groups interface{}) *jwt.Claims {
+		c := &jwt.Claims{Set: map[string]interface{}{}}
+		if groups != nil {
+			c.Set["groups"] = groups
+		}
+		return c
+	}
+
+	tests := []struct {
+		name     string
+		claims   *jwt.Claims
+		groups   []string
+		wantCode codes.Code
+	}{
+		{
+			"No groups required",
+			claims(nil),
+			nil,
+			codes.OK,
+		},
+		{
+			"Member",
+			claims([]interface{}{"user", "admin"}),
+			[]string{"admin"},
+			codes.OK,
+		},
+		{
+			"Not a member",
+			claims([]interface{}{"user"}),
+			[]string{"admin"},
+			codes.Unauthenticated,
+		},
+		{
+			"No groups claim",
+			claims(nil),
+			[]string{"admin"},
+			codes.Unauthenticated,
+		},
+		{
+			"Invalid group",
+			claims([]interface{}{1}),
+			[]string{"admin"},
+			codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkGroups(tt.claims, tt.groups)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("checkGroups() error = %v, wantCode %v", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestDefault_Permissions(t *testing.T) {
+	methods := shop.File_shop_proto.Services().ByName("Shop").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		name := string(methods.Get(i).Name())
+		if _, ok := Default.Permissions[name]; !ok {
+			t.Errorf("Default.Permissions misses %s", name)
+		}
+	}
+	for name := range Default.Permissions {
+		if methods.ByName(protoreflect.Name(name)) == nil {
+			t.Errorf("Default.Permissions has unknown method %s", name)
+		}
+	}
+}
+
+func Test_shopServer_checkPermission(t *testing.T) {
+	s := &shopServer{conf: &ServerConfig{
+		Permissions: map[string]string{
+			"ListArticles": PermissionPublic,
+			"SaveArticle":  "catalog:write",
+		},
+	}}
+	claims := func(permissions ...interface{}) *jwt.Claims {
+		return &jwt.Claims{Set: map[string]interface{}{"permissions": permissions}}
+	}
+
+	tests := []struct {
+		name     string
+		claims   *jwt.Claims
+		method   string
+		wantCode codes.Code
+	}{
+		{
+			"Public",
+			nil,
+			"ListArticles",
+			codes.OK,
+		},
+		{
+			"Permitted",
+			claims("catalog:read", "catalog:write"),
+			"SaveArticle",
+			codes.OK,
+		},
+		{
+			"Missing permission",
+			claims("catalog:read"),
+			"SaveArticle",
+			codes.PermissionDenied,
+		},
+		{
+			"No permissions claim",
+			&jwt.Claims{},
+			"SaveArticle",
+			codes.PermissionDenied,
+		},
+		{
+			"No claims",
+			nil,
+			"SaveArticle",
+			codes.PermissionDenied,
+		},
+		{
+			"Unlisted method",
+			claims("catalog:write"),
+			"SaveCategories",
+			codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.checkPermission(tt.claims, tt.method)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("shopServer.checkPermission() error = %v, wantCode %v", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func bearerContext(token string) context.Context {
+	return metadata.NewIncomingContext(testCtx, metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func Test_shopServer_authenticate(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		method     string
+		wantClaims bool
+		wantCode   codes.Code
+	}{
+		{
+			"No metadata",
+			testCtx,
+			"/shop.Shop/SaveArticle",
+			false,
+			codes.OK,
+		},
+		{
+			"Valid token",
+			bearerContext(testToken),
+			"/shop.Shop/SaveArticle",
+			true,
+			codes.OK,
+		},
+		{
+			"Public method",
+			bearerContext(testToken),
+			"/shop.Shop/ListArticles",
+			true,
+			codes.OK,
+		},
+		{
+			"Invalid token",
+			bearerContext("foo.bar.baz"),
+			"/shop.Shop/SaveArticle",
+			false,
+			codes.Unauthenticated,
+		},
+		{
+			"Unlisted method",
+			bearerContext(testToken),
+			"/shop.Shop/Unknown",
+			false,
+			codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := tss.authenticate(tt.ctx, tt.method)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("shopServer.authenticate() error = %v, wantCode %v", err, tt.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			if _, ok := claimsFromContext(ctx); ok != tt.wantClaims {
+				t.Errorf("shopServer.authenticate() claims = %v, want %v", ok, tt.wantClaims)
+			}
+		})
+	}
+}
+
+func Test_shopServer_newAuthTx(t *testing.T) {
+	authCtx, err := tss.authenticate(bearerContext(testToken), "/shop.Shop/SaveArticle")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		method   string
+		token    string
+		wantCode codes.Code
+	}{
+		{
+			"Metadata",
+			authCtx,
+			"SaveArticle",
+			"",
+			codes.OK,
+		},
+		{
+			"Deprecated token field",
+			testCtx,
+			"SaveArticle",
+			testToken,
+			codes.OK,
+		},
+		{
+			"Missing token",
+			testCtx,
+			"SaveArticle",
+			"",
+			codes.Unauthenticated,
+		},
+		{
+			"No groups configured",
+			authCtx,
+			"not configured",
+			"",
+			codes.OK,
+		},
+		{
+			"Unlisted method with token field",
+			testCtx,
+			"not configured",
+			testToken,
+			codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newAuthTx(tt.ctx, tt.method, true, tt.token)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("shopServer.newAuthTx() error = %v, wantCode %v", err, tt.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			defer rt.Done()
+			if rt.Claims == nil {
+				t.Errorf("shopServer.newAuthTx() claims = %v, want %v", rt.Claims, "something")
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"net/http"
 	"net/smtp"
 	"strings"
+	texttemplate "text/template"
 	"time"
 
 	"github.com/moapis/mailer"
@@ -86,8 +87,15 @@ type MailConfig struct {
 	From         string
 	To           []string
 	TemplateGlob string
+	TextGlob     string // Plain-text alternatives, named like their HTML template
 	ShopName     string
 	Currency     string
+	Locale       string            // Locale of admin mails, and customer mails for orders without one
+	Subjects     map[string]string // Subject templates, keyed by mail template name
+	Batch        int               // Maximum mails sent per outbox run
+	MaxAttempts  int               // Attempts before a mail is marked failed
+	Backoff      time.Duration     // Base delay between attempts, doubled on each failure
+	Lease        time.Duration     // Claimed mails are not sent again before this time, unless their result was recorded
 }
 type httpServer struct {
 	Address          string
@@ -110,13 +118,25 @@ type ServerConfig struct {
 	AuthServer  AuthServerConfig    `json:"authserver"`  // Config for the gRPC client connection
 	Audiences   []string            `json:"audiences"`   // Accepted audiences from JWT
 	Groups      map[string][]string `json:"groups"`      // Map of method names and allowed user groups
+	Permissions map[string]string   `json:"permissions"` // Map of method names and required token permission, unlisted methods are denied
 	MultiDB     multidb.Config      `json:"multidb"`     // Imported from multidb
 	PG          *pg.Config          `json:"pg"`          // PG is later embedded in multidb
 	SQLRoutines int                 `json:"sqlroutines"` // Amount of Go-routines for non-master queries
 	Mail        MailConfig          `json:"smtp"`
 	HTTPServer  httpServer          `json:"http"`
 	Mobilpay    mobilpayCfg         `json:"mobilpay"`
-	ListLimit   int32               `json:"list_limit"` // Default limit for List Queries, when ommited in the ListConditions
+	ListLimit   int32               `json:"list_limit"`      // Default limit for List Queries, when ommited in the ListConditions
+	Jobs        JobsConfig          `json:"jobs"`            // Background job intervals and parameters
+	Feed        FeedConfig          `json:"feed"`            // Product feeds on the HTTP server
+	Webhooks    WebhooksConfig      `json:"webhooks"`        // Webhook delivery parameters
+	Messages    MessagesConfig      `json:"messages"`        // Spam protection of SendMessage
+	Newsletter  NewsletterConfig    `json:"newsletter"`      // Subscriptions with double opt-in
+	Recovery    RecoveryConfig      `json:"recovery"`        // Reminders and cancellation of unpaid online orders
+	Privacy     PrivacyConfig       `json:"privacy"`         // Personal data export, erasure and retention
+	Gateway     GatewayConfig       `json:"gateway"`         // REST/JSON API on the HTTP server
+	GRPCWeb     GRPCWebConfig       `json:"grpcweb"`         // Serve grpc-web and CORS, without a proxy
+	Proxies     int                 `json:"trusted_proxies"` // Reverse proxies in front of the servers, which append to x-forwarded-for. Zero ignores the header
+	RateLimits  RateLimitConfig     `json:"ratelimits"`      // Token buckets for unauthenticated methods
 }
 
 func (c *ServerConfig) writeOut(filename string) error {
@@ -134,10 +154,79 @@ var Default = ServerConfig{
 	LogLevel: WarnLevel,
 	TLS:      nil,
 	Groups: map[string][]string{
-		"SaveArticle":   {"primary"},
-		"DeleteArticle": {"primary"},
-		"ListOrders":    {"primary"},
-		"SaveOrder":     {"primary"},
+		"SaveArticle":          {"primary"},
+		"DeleteArticle":        {"primary"},
+		"RestoreArticle":       {"primary"},
+		"ListDeletedArticles":  {"primary"},
+		"ListArticleRevisions": {"primary"},
+		"RevertArticle":        {"primary"},
+		"ImportArticles":       {"primary"},
+		"ExportArticles":       {"primary"},
+		"ListOrders":           {"primary"},
+		"SaveOrder":            {"primary"},
+		"WatchOrders":          {"primary"},
+		"RevenueReport":        {"primary"},
+		"TopArticlesReport":    {"primary"},
+		"OrdersReport":         {"primary"},
+		"ExportReport":         {"primary"},
+		"ExportSubscribers":    {"primary"},
+		"ExportPersonalData":   {"primary"},
+		"ErasePersonalData":    {"primary"},
+		"SaveAttributes":       {"primary"},
+		"SaveWebhook":          {"primary"},
+		"DeleteWebhook":        {"primary"},
+		"ListWebhooks":         {"primary"},
+		"ListMails":            {"primary"},
+		"ResendMail":           {"primary"},
+		"ListMessages":         {"primary"},
+		"GetMessage":           {"primary"},
+		"MarkMessage":          {"primary"},
+		"ReplyMessage":         {"primary"},
+	},
+	Permissions: map[string]string{
+		"ViewArticle":          PermissionPublic,
+		"ListArticles":         PermissionPublic,
+		"SearchArticles":       PermissionPublic,
+		"Suggest":              PermissionPublic,
+		"ListCategories":       PermissionPublic,
+		"ListBasesPrices":      PermissionPublic,
+		"ListAttributes":       PermissionPublic,
+		"Checkout":             PermissionPublic,
+		"SendMessage":          PermissionPublic,
+		"Subscribe":            PermissionPublic,
+		"ConfirmSubscription":  PermissionPublic,
+		"Unsubscribe":          PermissionPublic,
+		"SaveArticle":          "catalog:write",
+		"DeleteArticle":        "catalog:write",
+		"RestoreArticle":       "catalog:write",
+		"RevertArticle":        "catalog:write",
+		"ImportArticles":       "catalog:write",
+		"SaveCategories":       "catalog:write",
+		"SaveAttributes":       "catalog:write",
+		"ListDeletedArticles":  "catalog:read",
+		"ListArticleRevisions": "catalog:read",
+		"ExportArticles":       "catalog:read",
+		"SaveBasePrice":        "prices:write",
+		"DeleteBasePrice":      "prices:write",
+		"ListOrders":           "orders:read",
+		"WatchOrders":          "orders:read",
+		"SaveOrder":            "orders:write",
+		"RevenueReport":        "reports:read",
+		"TopArticlesReport":    "reports:read",
+		"OrdersReport":         "reports:read",
+		"ExportReport":         "reports:read",
+		"ListMessages":         "messages:read",
+		"GetMessage":           "messages:read",
+		"MarkMessage":          "messages:write",
+		"ReplyMessage":         "messages:write",
+		"ListWebhooks":         "webhooks:read",
+		"SaveWebhook":          "webhooks:write",
+		"DeleteWebhook":        "webhooks:write",
+		"ListMails":            "mails:read",
+		"ResendMail":           "mails:write",
+		"ExportSubscribers":    "newsletter:read",
+		"ExportPersonalData":   "privacy:read",
+		"ErasePersonalData":    "privacy:write",
 	},
 	AuthServer: AuthServerConfig{"127.0.0.1", 8765},
 	MultiDB: multidb.Config{
@@ -169,9 +258,37 @@ var Default = ServerConfig{
 		Password:     "letmein",
 		From:         "admin@test.mailu.io",
 		TemplateGlob: "templates/*.mail.html",
+		TextGlob:     "templates/*.mail.txt",
 		To:           []string{"admin@test.mailu.io"},
 		ShopName:     "moapis/shop unit tests",
 		Currency:     "EUR",
+		Locale:       "en",
+		Subjects: map[string]string{
+			"order_received.customer.en":     "Your order #{{ .Id }} at {{ .ShopName }}",
+			"order_received.admin.en":        "New order #{{ .Id }} at {{ .ShopName }}",
+			"payment_confirmed.customer.en":  "Payment received for order #{{ .Id }} at {{ .ShopName }}",
+			"payment_confirmed.admin.en":     "Payment confirmed for order #{{ .Id }}",
+			"order_shipped.customer.en":      "Your order #{{ .Id }} at {{ .ShopName }} has been shipped",
+			"order_shipped.admin.en":         "Order #{{ .Id }} shipped",
+			"order_cancelled.customer.en":    "Your order #{{ .Id }} at {{ .ShopName }} has been cancelled",
+			"order_cancelled.admin.en":       "Order #{{ .Id }} cancelled",
+			"order_received.customer.ro":     "Comanda nr. {{ .Id }} la {{ .ShopName }}",
+			"order_received.admin.ro":        "Comandă nouă nr. {{ .Id }} la {{ .ShopName }}",
+			"payment_confirmed.customer.ro":  "Plata pentru comanda nr. {{ .Id }} la {{ .ShopName }} a fost primită",
+			"payment_confirmed.admin.ro":     "Plată confirmată pentru comanda nr. {{ .Id }}",
+			"order_shipped.customer.ro":      "Comanda nr. {{ .Id }} la {{ .ShopName }} a fost expediată",
+			"order_shipped.admin.ro":         "Comanda nr. {{ .Id }} a fost expediată",
+			"order_cancelled.customer.ro":    "Comanda nr. {{ .Id }} la {{ .ShopName }} a fost anulată",
+			"order_cancelled.admin.ro":       "Comanda nr. {{ .Id }} a fost anulată",
+			"newsletter_confirm.customer.en": "Please confirm your subscription to {{ .ShopName }}",
+			"newsletter_confirm.customer.ro": "Vă rugăm să confirmați abonarea la {{ .ShopName }}",
+			"payment_reminder.customer.en":   "Complete the payment of your order #{{ .Id }} at {{ .ShopName }}",
+			"payment_reminder.customer.ro":   "Finalizați plata comenzii nr. {{ .Id }} la {{ .ShopName }}",
+		},
+		Batch:       50,
+		MaxAttempts: 10,
+		Backoff:     30 * time.Second,
+		Lease:       10 * time.Minute,
 	},
 	HTTPServer: httpServer{
 		Address:          "0.0.0.0:8080",
@@ -185,6 +302,68 @@ var Default = ServerConfig{
 		Signature:       "LK1F-GMV1-YWRD-7J6T-QD55",
 	},
 	ListLimit: builder.DefaultLimit,
+	Jobs: JobsConfig{
+		Publish:   time.Minute,
+		Purge:     time.Hour,
+		Retention: 30 * 24 * time.Hour,
+		Webhooks:  10 * time.Second,
+		Mail:      10 * time.Second,
+		Recovery:  5 * time.Minute,
+		Privacy:   24 * time.Hour,
+		RateLimit: 10 * time.Minute,
+	},
+	Feed: FeedConfig{
+		Title:       "moapis/shop",
+		Description: "Product feed of moapis/shop",
+		Link:        "https://kreativio.ro",
+		ArticleURL:  "https://kreativio.ro/article/%d",
+		MaxAge:      time.Hour,
+	},
+	Webhooks: WebhooksConfig{
+		Timeout:     10 * time.Second,
+		Batch:       50,
+		MaxAttempts: 10,
+		Backoff:     30 * time.Second,
+	},
+	Messages: MessagesConfig{
+		CaptchaTimeout: 10 * time.Second,
+	},
+	Newsletter: NewsletterConfig{
+		ConfirmURL:     "https://kreativio.ro/newsletter/confirm",
+		UnsubscribeURL: "https://kreativio.ro/newsletter/unsubscribe",
+		ConfirmTTL:     48 * time.Hour,
+		ConsentText:    "I want to receive the newsletter and can unsubscribe at any time.",
+	},
+	Recovery: RecoveryConfig{
+		PaymentURL:    "https://pay.kreativio.ro/pay/order",
+		ReminderAfter: time.Hour,
+		CancelAfter:   48 * time.Hour,
+	},
+	Privacy: PrivacyConfig{
+		OrderRetention:   10 * 365 * 24 * time.Hour,
+		MessageRetention: 2 * 365 * 24 * time.Hour,
+		OutboxRetention:  90 * 24 * time.Hour,
+	},
+	Gateway: GatewayConfig{
+		Enabled: true,
+		OpenAPI: "shop.swagger.json",
+	},
+	RateLimits: RateLimitConfig{
+		Methods: map[string]MethodRateLimits{
+			"Checkout": {
+				Peer:    TokenBucket{Burst: 10, Interval: time.Minute},
+				Subject: TokenBucket{Burst: 5, Interval: 2 * time.Minute},
+			},
+			"SendMessage": {
+				Peer:    TokenBucket{Burst: 5, Interval: 5 * time.Minute},
+				Subject: TokenBucket{Burst: 5, Interval: 10 * time.Minute},
+			},
+			"Subscribe": {
+				Peer:    TokenBucket{Burst: 10, Interval: time.Minute},
+				Subject: TokenBucket{Burst: 3, Interval: time.Hour},
+			},
+		},
+	},
 }
 
 var configFiles = flag.String("config", "", "Comma separated list of JSON config files")
@@ -255,8 +434,8 @@ func (c ServerConfig) grpcOpts() ([]grpc.ServerOption, error) {
 
 // Outgoing mail template names
 const (
-	OrderMailTmpl   = "checkout"
 	MessageMailTmpl = "message"
+	OutboxMailTmpl  = "outbox" // Pass-through for bodies rendered into the outbox
 )
 
 func (c ServerConfig) newShopServer() (*shopServer, error) {
@@ -269,6 +448,20 @@ func (c ServerConfig) newShopServer() (*shopServer, error) {
 	if err != nil {
 		return nil, err
 	}
+	if _, err = tmpl.New(OutboxMailTmpl).Parse("{{ . }}"); err != nil {
+		return nil, err
+	}
+	s.tmpl = tmpl
+
+	if s.text, err = texttemplate.ParseGlob(c.Mail.TextGlob); err != nil {
+		return nil, err
+	}
+	for name, subject := range c.Mail.Subjects {
+		if _, err = s.text.New(subjectTmpl(name)).Parse(subject); err != nil {
+			return nil, err
+		}
+	}
+
 	s.mail = mailer.New(
 		tmpl,
 		fmt.Sprintf("%s:%d", c.Mail.Host, c.Mail.Port),
@@ -283,15 +476,26 @@ func (c ServerConfig) newShopServer() (*shopServer, error) {
 	if s.mdb, err = c.MultiDB.Open(); err != nil {
 		return nil, err
 	}
+	if c.Privacy.AuthPG != nil {
+		adb := c.MultiDB
+		adb.DBConf = c.Privacy.AuthPG
+		if s.authDB, err = adb.Open(); err != nil {
+			return nil, err
+		}
+	}
 	return s, nil
 }
 
-func (c ServerConfig) listenAndServe(s *shopServer, opts ...grpc.ServerOption) (*grpc.Server, <-chan error) {
-	gs := grpc.NewServer(opts...)
+func (c ServerConfig) listenAndServe(s *shopServer, opts ...grpc.ServerOption) (*grpcServer, <-chan error) {
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(s.unaryRateLimitInterceptor, s.unaryAuthInterceptor),
+		grpc.ChainStreamInterceptor(s.streamAuthInterceptor),
+	)
+	gs := c.newGRPCServer(opts...)
 	ec := make(chan error)
-	shop.RegisterShopServer(gs, s)
+	shop.RegisterShopServer(gs.Server, s)
 
-	log := s.log.WithFields(logrus.Fields{"address": c.Addres, "port": c.Port})
+	log := s.log.WithFields(logrus.Fields{"address": c.Addres, "port": c.Port, "grpcweb": c.GRPCWeb.Enabled})
 	log.WithField("grpc", gs.GetServiceInfo()).Debug("Registered services")
 	log.Info("Starting server")
 
@@ -312,14 +516,27 @@ func (c ServerConfig) listenAndServe(s *shopServer, opts ...grpc.ServerOption) (
 	return gs, ec
 }
 
-func (c ServerConfig) httpServerStart() (*http.Server, error) {
-	mpObj := mobilpay.CB{}
+func (c ServerConfig) httpServerStart(ss *shopServer) (*http.Server, error) {
+	mpObj := mobilpay.CB{OnStatus: ss.onPaymentStatus}
 	var e error
 	if mpObj.DBh, e = c.MultiDB.Open(); e != nil {
 		return nil, e
 	}
 	http.HandleFunc("/pay/mobilpayConfirm", mpObj.MobilpayConfirm)
+	http.HandleFunc(GoogleFeedPath, ss.feedHandler("application/xml; charset=utf-8", c.Feed.writeGoogle))
+	http.HandleFunc(CSVFeedPath, ss.feedHandler("text/csv; charset=utf-8", writeFeedCSV))
+	http.HandleFunc(PaymentPath, ss.paymentHandler)
 	s := &http.Server{Addr: c.HTTPServer.Address}
+	if c.Gateway.Enabled {
+		ctx, cancel := context.WithCancel(context.Background())
+		gw, err := c.newGateway(ctx)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		http.Handle(GatewayPath, gw)
+		s.RegisterOnShutdown(cancel)
+	}
 	log.Println("Http server started on ", c.HTTPServer.Address)
 	go func() { s.ListenAndServe() }()
 	return s, nil
@@ -265,7 +265,7 @@ func TestServerConfig_httpServerStart(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, _ := c.httpServerStart()
+			got, _ := c.httpServerStart(tss)
 			if got.Shutdown(context.Background()) != nil {
 				t.Error("Failed while attempting to shut down.")
 			}
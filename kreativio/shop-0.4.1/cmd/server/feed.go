@@ -0,0 +1,331 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/moapis/shop/models"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FeedConfig for the product feeds on the HTTP server.
+type FeedConfig struct {
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	Link        string        `json:"link"`        // Shop home page
+	ArticleURL  string        `json:"article_url"` // Format for article links, with %d for the article ID
+	MaxAge      time.Duration `json:"max_age"`     // Feeds are regenerated at least this often, to follow scheduled publishing and sales.
+}
+
+// Feed HTTP paths
+const (
+	GoogleFeedPath = "/feed/google.xml"
+	CSVFeedPath    = "/feed/products.csv"
+)
+
+// feedItem is a single product in a feed.
+// Every combination of base price and variant of an article is a separate item.
+type feedItem struct {
+	ID           string
+	ItemGroupID  string // Article ID, empty for articles without variants
+	Title        string
+	Description  string
+	Link         string
+	ImageLink    string
+	Availability string
+	Price        string
+	ProductType  string // Category path
+	SKU          string
+}
+
+// Google Merchant availability values
+const (
+	inStock    = "in stock"
+	outOfStock = "out of stock"
+)
+
+// whereScheduled selects articles within their publish_at and unpublish_at schedule.
+var whereScheduled = qm.Expr(
+	qm.Where("(publish_at is null or publish_at <= now())"),
+	qm.Where("(unpublish_at is null or unpublish_at > now())"),
+)
+
+// feedArticles returns all live articles, with the relations needed for the feed.
+func (rt *requestTx) feedArticles() (models.ArticleSlice, error) {
+	arts, err := models.Articles(
+		models.ArticleWhere.Published.EQ(true),
+		models.ArticleWhere.DeletedAt.IsNull(),
+		whereScheduled,
+		qm.OrderBy(models.ArticleColumns.ID),
+		qm.Load(models.ArticleRels.Images, qm.OrderBy(models.ImageColumns.Position)),
+		qm.Load(models.ArticleRels.Categories, qm.OrderBy(models.CategoryColumns.Position)),
+		qm.Load(models.ArticleRels.BasePrices, qm.OrderBy(models.BasePriceColumns.ID)),
+		qm.Load(models.ArticleRels.Variants,
+			models.VariantWhere.Archived.EQ(false),
+			qm.OrderBy(models.VariantColumns.Position),
+		),
+	).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("feedArticles")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	return arts, nil
+}
+
+func feedPrice(price *decimal.Big, currency string) string {
+	return fmt.Sprintf("%.2f %s", price, currency)
+}
+
+// feedItems expands the articles into items, one per base price and variant combination.
+// Prices are computed by calcPrice.
+func (rt *requestTx) feedItems(arts models.ArticleSlice) ([]feedItem, error) {
+	conf := rt.s.conf
+	now := time.Now()
+
+	var items []feedItem
+	for _, art := range arts {
+		base := feedItem{
+			ID:           fmt.Sprint(art.ID),
+			Title:        art.Title,
+			Description:  art.Description,
+			Link:         fmt.Sprintf(conf.Feed.ArticleURL, art.ID),
+			Availability: inStock,
+			Price:        feedPrice(currentPrice(art, now).Big, conf.Mail.Currency),
+		}
+		if imgs := art.R.Images; len(imgs) > 0 {
+			base.ImageLink = imgs[0].URL
+		}
+		cats := make([]string, len(art.R.Categories))
+		for i, c := range art.R.Categories {
+			cats[i] = c.Label
+		}
+		base.ProductType = strings.Join(cats, " > ")
+
+		if len(art.R.Variants) == 0 {
+			items = append(items, base)
+			continue
+		}
+		base.ItemGroupID = base.ID
+
+		// Articles without base prices use the article price.
+		bps := art.R.BasePrices
+		if len(bps) == 0 {
+			bps = models.BasePriceSlice{{}}
+		}
+
+		for _, bp := range bps {
+			for _, vrt := range art.R.Variants {
+				calc, err := rt.calcPrice(art, bp.ID, vrt.ID)
+				if err != nil {
+					return nil, err
+				}
+
+				item := base
+				item.ID = fmt.Sprintf("%d-%d", art.ID, vrt.ID)
+				item.Price = feedPrice(calc.Price, conf.Mail.Currency)
+				item.SKU = vrt.Sku.String
+
+				labels := vrt.Labels
+				if bp.ID != 0 {
+					item.ID = fmt.Sprintf("%d-%d-%d", art.ID, bp.ID, vrt.ID)
+					labels = append([]string{bp.Label}, labels...)
+				}
+				if len(labels) > 0 {
+					item.Title = fmt.Sprintf("%s - %s", art.Title, strings.Join(labels, " "))
+				}
+				if len(vrt.Images) > 0 {
+					item.ImageLink = vrt.Images[0]
+				}
+				if vrt.Stock.Valid && vrt.Stock.Int <= 0 {
+					item.Availability = outOfStock
+				}
+				items = append(items, item)
+			}
+		}
+	}
+
+	rt.Log.WithField("items", len(items)).Debug("feedItems")
+	return items, nil
+}
+
+// feedCache holds the generated feed items.
+// It is emptied on catalog changes, and regenerated on the next request.
+type feedCache struct {
+	mu      sync.Mutex // Guards the fields below
+	items   []feedItem
+	expires time.Time
+	version int // Incremented on invalidate
+
+	gen sync.Mutex // Serializes generation
+}
+
+// invalidate the cache after catalog changes.
+func (fc *feedCache) invalidate() {
+	fc.mu.Lock()
+	fc.items, fc.expires = nil, time.Time{}
+	fc.version++
+	fc.mu.Unlock()
+}
+
+func (fc *feedCache) get() ([]feedItem, int, bool) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return fc.items, fc.version, fc.items != nil && time.Now().Before(fc.expires)
+}
+
+// feedItems returns the cached feed items, or generates them when the cache is stale.
+func (s *shopServer) feedItems(ctx context.Context) ([]feedItem, error) {
+	if items, _, ok := s.feed.get(); ok {
+		return items, nil
+	}
+
+	s.feed.gen.Lock()
+	defer s.feed.gen.Unlock()
+
+	// Might have been generated while waiting for the lock
+	items, version, ok := s.feed.get()
+	if ok {
+		return items, nil
+	}
+
+	rt, err := s.newTx(ctx, "feedItems", true)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	arts, err := rt.feedArticles()
+	if err != nil {
+		return nil, err
+	}
+	if items, err = rt.feedItems(arts); err != nil {
+		return nil, err
+	}
+	if items == nil {
+		items = []feedItem{}
+	}
+
+	s.feed.mu.Lock()
+	// Catalog changes during generation leave the cache empty.
+	if s.feed.version == version {
+		s.feed.items, s.feed.expires = items, time.Now().Add(s.conf.Feed.MaxAge)
+	}
+	s.feed.mu.Unlock()
+
+	rt.Log.WithFields(logrus.Fields{"event": "feed.generated", "items": len(items)}).Info("Feed generated")
+	return items, nil
+}
+
+type googleFeed struct {
+	XMLName xml.Name          `xml:"rss"`
+	Version string            `xml:"version,attr"`
+	NS      string            `xml:"xmlns:g,attr"`
+	Channel googleFeedChannel `xml:"channel"`
+}
+
+type googleFeedChannel struct {
+	Title       string           `xml:"title"`
+	Link        string           `xml:"link"`
+	Description string           `xml:"description"`
+	Items       []googleFeedItem `xml:"item"`
+}
+
+type googleFeedItem struct {
+	ID           string `xml:"g:id"`
+	ItemGroupID  string `xml:"g:item_group_id,omitempty"`
+	Title        string `xml:"g:title"`
+	Description  string `xml:"g:description"`
+	Link         string `xml:"g:link"`
+	ImageLink    string `xml:"g:image_link,omitempty"`
+	Availability string `xml:"g:availability"`
+	Price        string `xml:"g:price"`
+	ProductType  string `xml:"g:product_type,omitempty"`
+	MPN          string `xml:"g:mpn,omitempty"`
+}
+
+func (c FeedConfig) writeGoogle(w io.Writer, items []feedItem) error {
+	feed := googleFeed{
+		Version: "2.0",
+		NS:      "http://base.google.com/ns/1.0",
+		Channel: googleFeedChannel{
+			Title:       c.Title,
+			Link:        c.Link,
+			Description: c.Description,
+			Items:       make([]googleFeedItem, len(items)),
+		},
+	}
+	for i, it := range items {
+		feed.Channel.Items[i] = googleFeedItem{
+			ID:           it.ID,
+			ItemGroupID:  it.ItemGroupID,
+			Title:        it.Title,
+			Description:  it.Description,
+			Link:         it.Link,
+			ImageLink:    it.ImageLink,
+			Availability: it.Availability,
+			Price:        it.Price,
+			ProductType:  it.ProductType,
+			MPN:          it.SKU,
+		}
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(feed)
+}
+
+var csvFeedHeader = []string{"id", "item_group_id", "title", "description", "link", "image_link", "availability", "price", "product_type", "sku"}
+
+func writeFeedCSV(w io.Writer, items []feedItem) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvFeedHeader); err != nil {
+		return err
+	}
+	for _, it := range items {
+		if err := cw.Write([]string{
+			it.ID, it.ItemGroupID, it.Title, it.Description, it.Link,
+			it.ImageLink, it.Availability, it.Price, it.ProductType, it.SKU,
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// feedHandler serves the product feed written by write.
+func (s *shopServer) feedHandler(contentType string, write func(io.Writer, []feedItem) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := s.log.WithFields(logrus.Fields{"path": r.URL.Path, "remote": r.RemoteAddr})
+
+		items, err := s.feedItems(r.Context())
+		if err != nil {
+			log.WithError(err).Error("feedItems")
+			http.Error(w, errInternal, http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		if err = write(w, items); err != nil {
+			log.WithError(err).Warn("Write feed")
+		}
+	}
+}
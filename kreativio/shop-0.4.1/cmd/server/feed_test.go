@@ -0,0 +1,230 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/moapis/shop/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+var testFeedItems = []feedItem{
+	{
+		ID:           "12",
+		Title:        "ID 12",
+		Description:  "This is the second article",
+		Link:         "https://kreativio.ro/article/12",
+		ImageLink:    "https://bucket.s3.com/firstonsecond.jpg",
+		Availability: inStock,
+		Price:        "12.12 EUR",
+		ProductType:  "Full category",
+	},
+}
+
+func Test_requestTx_feedItems(t *testing.T) {
+	tests := []struct {
+		name     string
+		variants bool
+		want     func(vid int64) []feedItem
+		wantErr  bool
+	}{
+		{
+			"DB Error",
+			false,
+			func(int64) []feedItem { return nil },
+			true,
+		},
+		{
+			"No variants",
+			false,
+			func(int64) []feedItem { return testFeedItems },
+			false,
+		},
+		{
+			"Variants and base prices",
+			true,
+			func(vid int64) []feedItem {
+				return []feedItem{
+					{
+						ID:           fmt.Sprintf("12-31-%d", vid),
+						ItemGroupID:  "12",
+						Title:        "ID 12 - Cheap material XL",
+						Description:  "This is the second article",
+						Link:         "https://kreativio.ro/article/12",
+						ImageLink:    "xl.jpg",
+						Availability: outOfStock,
+						Price:        "89.10 EUR",
+						ProductType:  "Full category",
+						SKU:          "FEED-XL",
+					},
+				}
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			var vid int64
+			if tt.variants {
+				art := &models.Article{ID: 12}
+				if err = art.SetBasePrices(rt.Ctx, rt.Tx, false, testBasePrices[0]); err != nil {
+					t.Fatal(err)
+				}
+				vrt := &models.Variant{
+					ArticleID:  12,
+					Labels:     types.StringArray{"XL"},
+					Multiplier: types.NewDecimal(decimal.New(2, 0)),
+					Sku:        null.StringFrom("FEED-XL"),
+					Stock:      null.IntFrom(0),
+					Images:     types.StringArray{"xl.jpg"},
+				}
+				if err = vrt.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+					t.Fatal(err)
+				}
+				vid = vrt.ID
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			arts, err := rt.feedArticles()
+			if err != nil {
+				if !tt.wantErr {
+					t.Errorf("requestTx.feedArticles() error = %v", err)
+				}
+				return
+			}
+			got, err := rt.feedItems(arts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.feedItems() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if want := tt.want(vid); !reflect.DeepEqual(got, want) {
+				t.Errorf("requestTx.feedItems() =\n%v\nwant\n%v", got, want)
+			}
+		})
+	}
+}
+
+func Test_feedCache(t *testing.T) {
+	fc := new(feedCache)
+	if _, _, ok := fc.get(); ok {
+		t.Error("feedCache.get() ok on empty cache")
+	}
+
+	fc.items, fc.expires = testFeedItems, time.Now().Add(time.Hour)
+	if items, _, ok := fc.get(); !ok || !reflect.DeepEqual(items, testFeedItems) {
+		t.Errorf("feedCache.get() = %v, %v", items, ok)
+	}
+
+	fc.invalidate()
+	if items, version, ok := fc.get(); ok || items != nil || version != 1 {
+		t.Errorf("feedCache.get() after invalidate = %v, %v, %v", items, version, ok)
+	}
+
+	fc.items, fc.expires = testFeedItems, time.Now().Add(-time.Second)
+	if _, _, ok := fc.get(); ok {
+		t.Error("feedCache.get() ok on expired cache")
+	}
+}
+
+func TestFeedConfig_writeGoogle(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Default.Feed.writeGoogle(&buf, testFeedItems); err != nil {
+		t.Fatal(err)
+	}
+
+	var got struct {
+		Channel struct {
+			Title string `xml:"title"`
+			Items []struct {
+				ID    string `xml:"http://base.google.com/ns/1.0 id"`
+				Price string `xml:"http://base.google.com/ns/1.0 price"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("writeGoogle() invalid XML: %v\n%s", err, buf.String())
+	}
+	if got.Channel.Title != Default.Feed.Title {
+		t.Errorf("writeGoogle() title = %q, want %q", got.Channel.Title, Default.Feed.Title)
+	}
+	if len(got.Channel.Items) != 1 || got.Channel.Items[0].ID != "12" || got.Channel.Items[0].Price != "12.12 EUR" {
+		t.Errorf("writeGoogle() items = %v", got.Channel.Items)
+	}
+}
+
+func Test_writeFeedCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeFeedCSV(&buf, testFeedItems); err != nil {
+		t.Fatal(err)
+	}
+	want := "id,item_group_id,title,description,link,image_link,availability,price,product_type,sku\n" +
+		"12,,ID 12,This is the second article,https://kreativio.ro/article/12,https://bucket.s3.com/firstonsecond.jpg,in stock,12.12 EUR,Full category,\n"
+	if got := buf.String(); got != want {
+		t.Errorf("writeFeedCSV() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func Test_shopServer_feedHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		handler    http.HandlerFunc
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			"Google",
+			GoogleFeedPath,
+			tss.feedHandler("application/xml; charset=utf-8", tss.conf.Feed.writeGoogle),
+			http.StatusOK,
+			"<g:id>12</g:id>",
+		},
+		{
+			"CSV",
+			CSVFeedPath,
+			tss.feedHandler("text/csv; charset=utf-8", writeFeedCSV),
+			http.StatusOK,
+			"12,,ID 12,",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tss.feed.invalidate()
+
+			rec := httptest.NewRecorder()
+			tt.handler(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("feedHandler() status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if body := rec.Body.String(); !strings.Contains(body, tt.wantBody) {
+				t.Errorf("feedHandler() body = %s, want %s", body, tt.wantBody)
+			}
+			if _, _, ok := tss.feed.get(); !ok {
+				t.Error("feedHandler() did not cache the feed")
+			}
+		})
+	}
+}
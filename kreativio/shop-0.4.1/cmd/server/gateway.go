@@ -0,0 +1,78 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/moapis/shop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// GatewayConfig for the REST/JSON API on the HTTP server.
+type GatewayConfig struct {
+	Enabled bool   `json:"enabled"`
+	OpenAPI string `json:"openapi"` // OpenAPI spec file, served at OpenAPIPath. Empty disables
+}
+
+// Gateway HTTP paths
+const (
+	GatewayPath = "/v1/"
+	OpenAPIPath = "/v1/openapi.json"
+)
+
+// gatewayEndpoint returns the address of the gRPC server, as dialed by the gateway.
+// Unspecified listen addresses are reached over loopback.
+func (c ServerConfig) gatewayEndpoint() string {
+	host := c.Addres
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, fmt.Sprint(c.Port))
+}
+
+func (c ServerConfig) gatewayDialOpts() ([]grpc.DialOption, error) {
+	if c.TLS == nil {
+		return []grpc.DialOption{grpc.WithInsecure()}, nil
+	}
+	creds, err := credentials.NewClientTLSFromFile(c.TLS.CertFile, "")
+	if err != nil {
+		return nil, err
+	}
+	return []grpc.DialOption{grpc.WithTransportCredentials(creds)}, nil
+}
+
+// newGateway returns a handler which translates REST/JSON requests to the Shop gRPC server,
+// following the HTTP annotations in shop.proto.
+// gRPC status codes are mapped to their HTTP equivalents, with the status as JSON body.
+// The client connection is closed when ctx is done.
+func (c ServerConfig) newGateway(ctx context.Context) (http.Handler, error) {
+	opts, err := c.gatewayDialOpts()
+	if err != nil {
+		return nil, err
+	}
+	gw := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}),
+	)
+	if err = shop.RegisterShopHandlerFromEndpoint(ctx, gw, c.gatewayEndpoint(), opts); err != nil {
+		return nil, err
+	}
+	if c.Gateway.OpenAPI == "" {
+		return gw, nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(GatewayPath, gw)
+	mux.HandleFunc(OpenAPIPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		http.ServeFile(w, r, c.Gateway.OpenAPI)
+	})
+	return mux, nil
+}
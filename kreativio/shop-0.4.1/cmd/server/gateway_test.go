@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/moapis/shop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServerConfig_gatewayEndpoint(t *testing.T) {
+	tests := []struct {
+		addres string
+		want   string
+	}{
+		{"", "localhost:8766"},
+		{"0.0.0.0", "localhost:8766"},
+		{"::", "localhost:8766"},
+		{"127.0.0.1", "127.0.0.1:8766"},
+		{"::1", "[::1]:8766"},
+		{"shop.example.com", "shop.example.com:8766"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addres, func(t *testing.T) {
+			c := ServerConfig{Addres: tt.addres, Port: 8766}
+			if got := c.gatewayEndpoint(); got != tt.want {
+				t.Errorf("ServerConfig.gatewayEndpoint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type gatewayTestServer struct {
+	shop.UnimplementedShopServer
+}
+
+func (*gatewayTestServer) ViewArticle(ctx context.Context, req *shop.ArticleID) (*shop.Article, error) {
+	if req.GetId() != 1 {
+		return nil, status.Errorf(codes.NotFound, errNotFound, "Article", "id", req.GetId())
+	}
+	return &shop.Article{Id: 1, Title: "Foo"}, nil
+}
+
+func (*gatewayTestServer) Checkout(ctx context.Context, req *shop.Order) (*shop.OrderID, error) {
+	if req.GetEmail() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, errMissing, "email")
+	}
+	return &shop.OrderID{Id: 2}, nil
+}
+
+func TestServerConfig_newGateway(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gs := grpc.NewServer()
+	shop.RegisterShopServer(gs, &gatewayTestServer{})
+	go gs.Serve(lis)
+	defer gs.Stop()
+
+	c := ServerConfig{
+		Addres:  "127.0.0.1",
+		Port:    uint16(lis.Addr().(*net.TCPAddr).Port),
+		Gateway: GatewayConfig{Enabled: true, OpenAPI: "../../shop.swagger.json"},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	gw, err := c.newGateway(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		target   string
+		body     string
+		wantCode int
+		wantBody string
+	}{
+		{"ViewArticle", http.MethodGet, "/v1/articles/1", "", http.StatusOK, `"title":"Foo"`},
+		{"Not found", http.MethodGet, "/v1/articles/3", "", http.StatusNotFound, "Article with id 3 not found"},
+		{"Checkout", http.MethodPost, "/v1/orders", `{"email":"foo@bar.com"}`, http.StatusOK, `"id":2`},
+		{"Invalid argument", http.MethodPost, "/v1/orders", `{}`, http.StatusBadRequest, "Missing required fields: email"},
+		{"Bad JSON", http.MethodPost, "/v1/orders", `{`, http.StatusBadRequest, ""},
+		{"Unimplemented", http.MethodDelete, "/v1/articles/1", "", http.StatusNotImplemented, ""},
+		{"Unknown path", http.MethodGet, "/v1/foo", "", http.StatusNotFound, ""},
+		{"OpenAPI", http.MethodGet, OpenAPIPath, "", http.StatusOK, `"swagger"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			gw.ServeHTTP(w, httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body)))
+
+			res := w.Result()
+			if res.StatusCode != tt.wantCode {
+				t.Errorf("newGateway() status = %v, want %v", res.StatusCode, tt.wantCode)
+			}
+			body, _ := ioutil.ReadAll(res.Body)
+			if !strings.Contains(string(body), tt.wantBody) {
+				t.Errorf("newGateway() body = %s, want %s", body, tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+)
+
+// GRPCWebConfig for serving grpc-web clients, like browsers, on the gRPC port.
+type GRPCWebConfig struct {
+	Enabled        bool     `json:"enabled"`
+	AllowedOrigins []string `json:"allowed_origins"` // CORS origins of grpc-web clients, "*" allows any origin
+}
+
+func (c GRPCWebConfig) allowOrigin(origin string) bool {
+	for _, o := range c.AllowedOrigins {
+		if o == "*" || o == origin {
+			return true
+		}
+	}
+	return false
+}
+
+// grpcServer is a gRPC server, which is served through a HTTP server when grpc-web is enabled.
+// The HTTP server passes native gRPC requests on to the gRPC server.
+type grpcServer struct {
+	*grpc.Server
+	http *http.Server
+	tls  *TLSConfig
+}
+
+func (c ServerConfig) newGRPCServer(opts ...grpc.ServerOption) *grpcServer {
+	gs := &grpcServer{Server: grpc.NewServer(opts...)}
+	if !c.GRPCWeb.Enabled {
+		return gs
+	}
+	var h http.Handler = grpcweb.WrapServer(gs.Server, grpcweb.WithOriginFunc(c.GRPCWeb.allowOrigin))
+	if c.TLS == nil {
+		h = h2c.NewHandler(h, &http2.Server{})
+	}
+	gs.http = &http.Server{Handler: h}
+	gs.tls = c.TLS
+	return gs
+}
+
+// Serve accepts connections on lis, until the server is stopped.
+func (s *grpcServer) Serve(lis net.Listener) error {
+	if s.http == nil {
+		return s.Server.Serve(lis)
+	}
+	var err error
+	if s.tls != nil {
+		err = s.http.ServeTLS(lis, s.tls.CertFile, s.tls.KeyFile)
+	} else {
+		err = s.http.Serve(lis)
+	}
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+// GracefulStop stops accepting connections and waits for pending requests.
+// The gRPC server can not drain connections it does not own,
+// so requests still running after the HTTP server is shut down are cancelled.
+func (s *grpcServer) GracefulStop() {
+	if s.http == nil {
+		s.Server.GracefulStop()
+		return
+	}
+	s.http.Shutdown(context.Background())
+	s.Server.Stop()
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestGRPCWebConfig_allowOrigin(t *testing.T) {
+	tests := []struct {
+		name    string
+		origins []string
+		origin  string
+		want    bool
+	}{
+		{"None", nil, "https://kreativio.ro", false},
+		{"Any", []string{"*"}, "https://kreativio.ro", true},
+		{"Listed", []string{"https://foo.bar", "https://kreativio.ro"}, "https://kreativio.ro", true},
+		{"Not listed", []string{"https://foo.bar"}, "https://kreativio.ro", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := GRPCWebConfig{Enabled: true, AllowedOrigins: tt.origins}
+			if got := c.allowOrigin(tt.origin); got != tt.want {
+				t.Errorf("GRPCWebConfig.allowOrigin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServerConfig_newGRPCServer(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := ServerConfig{
+		GRPCWeb: GRPCWebConfig{Enabled: true, AllowedOrigins: []string{"https://kreativio.ro"}},
+	}
+	gs := c.newGRPCServer()
+	grpc_health_v1.RegisterHealthServer(gs.Server, health.NewServer())
+
+	ec := make(chan error)
+	go func() { ec <- gs.Serve(lis) }()
+	addr := lis.Addr().String()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	cc, err := grpc.DialContext(ctx, addr, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cc.Close()
+	if _, err = grpc_health_v1.NewHealthClient(cc).Check(ctx, &grpc_health_v1.HealthCheckRequest{}); err != nil {
+		t.Errorf("native gRPC error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		origin     string
+		wantCode   int
+		wantOrigin string
+	}{
+		{"grpc-web", http.MethodPost, "https://kreativio.ro", http.StatusOK, "https://kreativio.ro"},
+		{"Preflight", http.MethodOptions, "https://kreativio.ro", http.StatusOK, "https://kreativio.ro"},
+		{"Preflight other origin", http.MethodOptions, "https://foo.bar", http.StatusOK, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Empty HealthCheckRequest in a single uncompressed frame
+			req, _ := http.NewRequest(tt.method, "http://"+addr+"/grpc.health.v1.Health/Check", bytes.NewReader([]byte{0, 0, 0, 0, 0}))
+			req.Header.Set("Origin", tt.origin)
+			if tt.method == http.MethodOptions {
+				req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+				req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+			} else {
+				req.Header.Set("Content-Type", "application/grpc-web+proto")
+				req.Header.Set("X-Grpc-Web", "1")
+			}
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+			if res.StatusCode != tt.wantCode {
+				t.Errorf("grpc-web status = %v, want %v", res.StatusCode, tt.wantCode)
+			}
+			if got := res.Header.Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+				t.Errorf("grpc-web Access-Control-Allow-Origin = %v, want %v", got, tt.wantOrigin)
+			}
+		})
+	}
+
+	gs.GracefulStop()
+	if err = <-ec; err != nil {
+		t.Errorf("grpcServer.Serve() error = %v", err)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/moapis/shop/models"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// JobsConfig sets the intervals and parameters of background jobs.
+// A zero interval disables the job.
+type JobsConfig struct {
+	Publish   time.Duration `json:"publish"`   // Check for articles going live
+	Purge     time.Duration `json:"purge"`     // Check for deleted articles past retention
+	Retention time.Duration `json:"retention"` // Time deleted articles can be restored, before they are purged
+	Webhooks  time.Duration `json:"webhooks"`  // Check for pending webhook deliveries
+	Mail      time.Duration `json:"mail"`      // Check for pending mails in the outbox
+	Recovery  time.Duration `json:"recovery"`  // Check for unpaid online orders to remind or cancel
+	Privacy   time.Duration `json:"privacy"`   // Check for personal data past retention
+	RateLimit time.Duration `json:"ratelimit"` // Purge rate limit buckets which are full again
+}
+
+// jobFunc is executed inside a transaction, which is committed on success.
+type jobFunc func(rt *requestTx) error
+
+// execJob runs fn once, in its own transaction.
+func (s *shopServer) execJob(ctx context.Context, name string, fn jobFunc) error {
+	rt, err := s.newTx(ctx, name, false)
+	if err != nil {
+		return err
+	}
+	defer rt.Done()
+
+	if err = fn(rt); err != nil {
+		return err
+	}
+	return rt.Commit()
+}
+
+// txJob returns a job run, which executes fn in its own transaction.
+func (s *shopServer) txJob(name string, fn jobFunc) func(context.Context) error {
+	return func(ctx context.Context) error {
+		return s.execJob(ctx, name, fn)
+	}
+}
+
+// runJob calls run every interval, until ctx is done.
+func (s *shopServer) runJob(ctx context.Context, name string, interval time.Duration, run func(context.Context) error) {
+	log := s.log.WithFields(logrus.Fields{"job": name, "interval": interval})
+	if interval <= 0 {
+		log.Info("Job disabled")
+		return
+	}
+	log.Info("Starting job")
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.WithError(ctx.Err()).Info("Stopping job")
+				return
+			case <-ticker.C:
+			}
+
+			if err := run(ctx); err != nil {
+				log.WithError(err).Error("runJob")
+			}
+		}
+	}()
+}
+
+// maxRetryBackoff caps retryBackoff.
+const maxRetryBackoff = 24 * time.Hour
+
+// retryBackoff returns the wait time before the next attempt of an outbox job,
+// after attempts failures. The wait doubles after every failure.
+func retryBackoff(base time.Duration, attempts int) time.Duration {
+	d := base
+	for i := 1; i < attempts && d < maxRetryBackoff; i++ {
+		d *= 2
+	}
+	if d > maxRetryBackoff {
+		return maxRetryBackoff
+	}
+	return d
+}
+
+// startJobs starts all configured background jobs.
+// They stop when ctx is done.
+// The outbox jobs manage their own transactions,
+// so that no transaction is held open during network I/O.
+func (s *shopServer) startJobs(ctx context.Context) {
+	s.runJob(ctx, "notifyLive", s.conf.Jobs.Publish, s.txJob("notifyLive", (*requestTx).notifyLive))
+	s.runJob(ctx, "purgeDeleted", s.conf.Jobs.Purge, s.txJob("purgeDeleted", (*requestTx).purgeDeleted))
+	s.runJob(ctx, "deliverWebhooks", s.conf.Jobs.Webhooks, s.deliverWebhooks)
+	s.runJob(ctx, "sendMails", s.conf.Jobs.Mail, s.sendMails)
+	s.runJob(ctx, "recoverCheckouts", s.conf.Jobs.Recovery, s.txJob("recoverCheckouts", (*requestTx).recoverCheckouts))
+	s.runJob(ctx, "applyRetention", s.conf.Jobs.Privacy, s.txJob("applyRetention", (*requestTx).applyRetention))
+	s.runJob(ctx, "purgeRateLimits", s.conf.Jobs.RateLimit, s.txJob("purgeRateLimits", (*requestTx).purgeRateLimits))
+}
+
+// LiveMailTmpl is the template name for the articles going live mail.
+const LiveMailTmpl = "live"
+
+// notifyLive sends a mail for published articles which reached their publish_at time,
+// and marks them as notified.
+func (rt *requestTx) notifyLive() error {
+	arts, err := models.Articles(
+		models.ArticleWhere.Published.EQ(true),
+		models.ArticleWhere.DeletedAt.IsNull(),
+		qm.Where("publish_at <= now()"),
+		qm.Where("(unpublish_at is null or unpublish_at > now())"),
+		qm.Where("(live_notified_at is null or live_notified_at < publish_at)"),
+		qm.OrderBy(models.ArticleColumns.PublishAt),
+		qm.For("update skip locked"),
+	).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("notifyLive: models.Articles")
+		return status.Error(codes.Internal, errDB)
+	}
+	if len(arts) == 0 {
+		rt.Log.Debug("notifyLive: nothing to do")
+		return nil
+	}
+	rt.Log = rt.Log.WithField("articles", len(arts))
+
+	if err = rt.sendLiveMail(arts); err != nil {
+		return err
+	}
+
+	if _, err = arts.UpdateAll(rt.Ctx, rt.Tx, models.M{
+		models.ArticleColumns.LiveNotifiedAt: time.Now(),
+	}); err != nil {
+		rt.Log.WithError(err).Error("notifyLive: UpdateAll")
+		return status.Error(codes.Internal, errDB)
+	}
+
+	for _, a := range arts {
+		rt.Log.WithFields(logrus.Fields{"event": "article.live", "aid": a.ID, "publish_at": a.PublishAt.Time}).Info("Article live")
+	}
+	return nil
+}
+
+// sendLiveMail queues the articles going live mail in the outbox.
+func (rt *requestTx) sendLiveMail(arts models.ArticleSlice) error {
+	subject := fmt.Sprintf("%s: %d article(s) went live", rt.s.conf.Mail.ShopName, len(arts))
+
+	data := struct {
+		Articles models.ArticleSlice
+		Currency string
+	}{
+		arts,
+		rt.s.conf.Mail.Currency,
+	}
+
+	return rt.queueMail(LiveMailTmpl, subject, rt.s.conf.Mail.To, data)
+}
+
+// purgeDeleted permanently deletes articles which have been deleted longer than the retention time.
+// Articles referenced by orders are kept, so that order_articles stays intact.
+func (rt *requestTx) purgeDeleted() error {
+	arts, err := models.Articles(
+		qm.Select(models.ArticleColumns.ID),
+		models.ArticleWhere.DeletedAt.LT(null.TimeFrom(time.Now().Add(-rt.s.conf.Jobs.Retention))),
+		qm.Where("not exists (select 1 from shop.order_articles oa where oa.article_id = articles.id)"),
+		qm.For("update skip locked"),
+	).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("purgeDeleted: models.Articles")
+		return status.Error(codes.Internal, errDB)
+	}
+	if len(arts) == 0 {
+		rt.Log.Debug("purgeDeleted: nothing to do")
+		return nil
+	}
+
+	for _, a := range arts {
+		ra, err := rt.purgeArticle(a.ID)
+		if err != nil {
+			return err
+		}
+		rt.Log.WithFields(logrus.Fields{"event": "article.purged", "aid": a.ID, "ra": ra}).Info("Article purged")
+	}
+	return nil
+}
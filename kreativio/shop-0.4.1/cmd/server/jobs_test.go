@@ -0,0 +1,239 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/moapis/shop/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func Test_shopServer_execJob(t *testing.T) {
+	ectx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		fn      jobFunc
+		wantErr bool
+	}{
+		{
+			"Context error",
+			ectx,
+			func(*requestTx) error { return nil },
+			true,
+		},
+		{
+			"Job error",
+			testCtx,
+			func(*requestTx) error { return errors.New("job error") },
+			true,
+		},
+		{
+			"Success",
+			testCtx,
+			func(*requestTx) error { return nil },
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tss.execJob(tt.ctx, "testing", tt.fn); (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.execJob() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_requestTx_notifyLive(t *testing.T) {
+	cc := *testConfig
+	cc.Mail.Host = "foobar"
+
+	ets, err := cc.newShopServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		server    *shopServer
+		publishAt null.Time
+		wantLive  bool
+		wantErr   bool
+	}{
+		{
+			"Nothing to do",
+			tss,
+			null.Time{},
+			false,
+			false,
+		},
+		{
+			"Scheduled in future",
+			tss,
+			null.TimeFrom(time.Now().Add(time.Hour)),
+			false,
+			false,
+		},
+		{
+			"Went live",
+			tss,
+			null.TimeFrom(time.Now().Add(-time.Hour)),
+			true,
+			false,
+		},
+		{
+			"SMTP error",
+			ets,
+			null.TimeFrom(time.Now().Add(-time.Hour)),
+			true,
+			false,
+		},
+		{
+			"DB error",
+			tss,
+			null.TimeFrom(time.Now().Add(-time.Hour)),
+			false,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tt.server.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			// Article 12 is published
+			art := &models.Article{ID: 12, PublishAt: tt.publishAt}
+			if _, err = art.Update(rt.Ctx, rt.Tx, boil.Whitelist(models.ArticleColumns.PublishAt)); err != nil {
+				t.Fatal(err)
+			}
+			if tt.name == "DB error" {
+				rt.Done()
+			}
+
+			if err = rt.notifyLive(); (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.notifyLive() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if err = art.Reload(rt.Ctx, rt.Tx); err != nil {
+				t.Fatal(err)
+			}
+			if art.LiveNotifiedAt.Valid != tt.wantLive {
+				t.Errorf("requestTx.notifyLive() LiveNotifiedAt = %v, want %v", art.LiveNotifiedAt, tt.wantLive)
+			}
+
+			if tt.wantLive {
+				// Second run should not notify again
+				notified := art.LiveNotifiedAt
+				if err = rt.notifyLive(); err != nil {
+					t.Fatal(err)
+				}
+				if err = art.Reload(rt.Ctx, rt.Tx); err != nil {
+					t.Fatal(err)
+				}
+				if !art.LiveNotifiedAt.Time.Equal(notified.Time) {
+					t.Errorf("requestTx.notifyLive() notified twice: %v, %v", notified, art.LiveNotifiedAt)
+				}
+			}
+		})
+	}
+}
+
+func Test_requestTx_purgeDeleted(t *testing.T) {
+	tests := []struct {
+		name       string
+		deletedAt  time.Time
+		wantPurged []int
+		wantKept   []int
+		wantErr    bool
+	}{
+		{
+			"Within retention",
+			time.Now(),
+			nil,
+			[]int{11, 13},
+			false,
+		},
+		{
+			"Past retention",
+			time.Now().Add(-testConfig.Jobs.Retention - time.Hour),
+			[]int{13},
+			[]int{11}, // Referenced by an order
+			false,
+		},
+		{
+			"DB error",
+			time.Now().Add(-testConfig.Jobs.Retention - time.Hour),
+			nil,
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			if _, err = models.Articles(
+				models.ArticleWhere.ID.IN([]int{11, 13}),
+			).UpdateAll(rt.Ctx, rt.Tx, models.M{models.ArticleColumns.DeletedAt: tt.deletedAt}); err != nil {
+				t.Fatal(err)
+			}
+			if tt.name == "DB error" {
+				rt.Done()
+			}
+
+			if err = rt.purgeDeleted(); (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.purgeDeleted() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			for _, aid := range tt.wantPurged {
+				if exists, err := models.ArticleExists(rt.Ctx, rt.Tx, aid); err != nil || exists {
+					t.Errorf("requestTx.purgeDeleted() article %d exists = %v, err %v", aid, exists, err)
+				}
+			}
+			for _, aid := range tt.wantKept {
+				if exists, err := models.ArticleExists(rt.Ctx, rt.Tx, aid); err != nil || !exists {
+					t.Errorf("requestTx.purgeDeleted() article %d exists = %v, err %v", aid, exists, err)
+				}
+			}
+		})
+	}
+}
+
+func Test_retryBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{4, 8 * time.Minute},
+		{100, maxRetryBackoff},
+	}
+	for _, tt := range tests {
+		if got := retryBackoff(time.Minute, tt.attempts); got != tt.want {
+			t.Errorf("retryBackoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,444 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"html/template"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/moapis/mailer"
+	"github.com/moapis/shop"
+	"github.com/moapis/shop/models"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const errMailTmpl = "Mail template error"
+
+// Order mail events, used as template name prefix.
+const (
+	mailOrderReceived    = "order_received"
+	mailPaymentConfirmed = "payment_confirmed"
+	mailOrderShipped     = "order_shipped"
+	mailOrderCancelled   = "order_cancelled"
+)
+
+// Order mail audiences
+const (
+	mailCustomer = "customer"
+	mailAdmin    = "admin"
+)
+
+// subjectTmpl returns the text template name holding the subject for a mail template.
+func subjectTmpl(name string) string {
+	return "subject:" + name
+}
+
+// localTmpl returns the template name for event and audience in locale.
+// If no such template exists, the template in the default locale is returned.
+func (s *shopServer) localTmpl(event, audience, locale string) string {
+	name := fmt.Sprintf("%s.%s.%s", event, audience, locale)
+	if s.tmpl.Lookup(name) != nil {
+		return name
+	}
+	return fmt.Sprintf("%s.%s.%s", event, audience, s.conf.Mail.Locale)
+}
+
+// orderMailData is passed to the order mail and subject templates.
+type orderMailData struct {
+	*shop.Order
+	Created  time.Time
+	Currency string
+	ShopName string
+}
+
+// newOrderMailData returns the order with its articles, for use in mail templates.
+func (rt *requestTx) newOrderMailData(order *models.Order) (orderMailData, error) {
+	msg, err := orderModelToMsg(order)
+	if err != nil {
+		return orderMailData{}, err
+	}
+	if msg.Articles, msg.Sum, err = rt.getOrderArticles(order); err != nil {
+		return orderMailData{}, err
+	}
+	conf := rt.s.conf.Mail
+	return orderMailData{msg, order.CreatedAt, conf.Currency, conf.ShopName}, nil
+}
+
+// queueOrderMails queues the customer and admin mails for an order event.
+// The customer mail uses the locale of the order.
+func (rt *requestTx) queueOrderMails(event string, order *models.Order) error {
+	data, err := rt.newOrderMailData(order)
+	if err != nil {
+		return err
+	}
+	conf := rt.s.conf.Mail
+
+	if order.Email != "" { // Anonymized orders have no email
+		if err = rt.queueLocalMail(rt.s.localTmpl(event, mailCustomer, order.Locale), []string{order.Email}, data); err != nil {
+			return err
+		}
+	}
+	if len(conf.To) == 0 {
+		return nil
+	}
+	return rt.queueLocalMail(rt.s.localTmpl(event, mailAdmin, conf.Locale), conf.To, data)
+}
+
+// queueStatusMails queues the shipped or cancelled mails, after the status of order changed.
+// Other status changes do not notify the customer.
+func (rt *requestTx) queueStatusMails(order *models.Order) error {
+	switch order.Status {
+	case models.StatusSENT:
+		return rt.queueOrderMails(mailOrderShipped, order)
+	case models.StatusCANCELLED:
+		return rt.queueOrderMails(mailOrderCancelled, order)
+	default:
+		return nil
+	}
+}
+
+// customerTmpl reports whether tmpl is a mail to customers, which may carry an unsubscribe footer.
+// Customer templates are named by localTmpl with the mailCustomer audience.
+func customerTmpl(tmpl string) bool {
+	return tmpl == MessageReplyTmpl || strings.Contains(tmpl, "."+mailCustomer+".")
+}
+
+// queueLocalMail renders the configured subject for tmpl and queues the mail.
+func (rt *requestTx) queueLocalMail(tmpl string, to []string, data interface{}) error {
+	var subject strings.Builder
+	if err := rt.s.text.ExecuteTemplate(&subject, subjectTmpl(tmpl), data); err != nil {
+		rt.Log.WithError(err).WithField("template", tmpl).Error("queueLocalMail: subject")
+		return status.Error(codes.Internal, errMailTmpl)
+	}
+	return rt.queueMail(tmpl, subject.String(), to, data)
+}
+
+// queueMail renders the named template with data and writes the mail to the outbox.
+// A plain-text alternative is rendered if a text template with the same name exists.
+// Customer mails to a single recipient get an unsubscribe footer, when the newsletter is configured.
+// The mail is only sent if the transaction is committed.
+func (rt *requestTx) queueMail(tmpl, subject string, to []string, data interface{}) error {
+	log := rt.Log.WithFields(logrus.Fields{"template": tmpl, "subject": subject, "to": to})
+
+	var body, text bytes.Buffer
+	if err := rt.s.tmpl.ExecuteTemplate(&body, tmpl, data); err != nil {
+		log.WithError(err).Error("queueMail: ExecuteTemplate")
+		return status.Error(codes.Internal, errMailTmpl)
+	}
+	if t := rt.s.text.Lookup(tmpl); t != nil {
+		if err := t.Execute(&text, data); err != nil {
+			log.WithError(err).Error("queueMail: text Execute")
+			return status.Error(codes.Internal, errMailTmpl)
+		}
+	}
+
+	mail := &models.Mail{
+		Template:      tmpl,
+		Subject:       subject,
+		Recipients:    types.StringArray(to),
+		Body:          body.String(),
+		TextBody:      text.String(),
+		NextAttemptAt: null.TimeFrom(time.Now()),
+	}
+	if len(to) == 1 && customerTmpl(tmpl) {
+		link, html, text, err := rt.unsubscribeFooter(to[0])
+		if err != nil {
+			return err
+		}
+		mail.UnsubscribeURL, mail.Body = link, appendFooter(mail.Body, html)
+		if mail.TextBody != "" {
+			mail.TextBody += text
+		}
+	}
+	if err := mail.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+		log.WithError(err).Error("queueMail: Insert")
+		return status.Error(codes.Internal, errDB)
+	}
+
+	log.WithFields(logrus.Fields{"event": "mail.queued", "mail_id": mail.ID}).Info("Mail queued")
+	return nil
+}
+
+// claimMails returns the pending mails from the outbox which are due.
+// Their next_attempt_at is moved by the configured lease,
+// so that the mails are not claimed again while they are sent outside of this transaction.
+// Mails of which no result is recorded, are retried after the lease.
+func (rt *requestTx) claimMails() (models.MailSlice, error) {
+	conf := rt.s.conf.Mail
+
+	mails, err := models.Mails(
+		models.MailWhere.SentAt.IsNull(),
+		models.MailWhere.FailedAt.IsNull(),
+		models.MailWhere.NextAttemptAt.LTE(null.TimeFrom(time.Now())),
+		qm.OrderBy(models.MailColumns.NextAttemptAt),
+		qm.Limit(conf.Batch),
+		qm.For("update skip locked"),
+	).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("claimMails: models.Mails")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	if len(mails) == 0 {
+		return nil, nil
+	}
+
+	if _, err = mails.UpdateAll(rt.Ctx, rt.Tx, models.M{
+		models.MailColumns.NextAttemptAt: time.Now().Add(conf.Lease),
+	}); err != nil {
+		rt.Log.WithError(err).Error("claimMails: UpdateAll")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	return mails, nil
+}
+
+// sendMail sends a single mail from the outbox.
+func (s *shopServer) sendMail(mail *models.Mail) error {
+	headers := []mailer.Header{
+		{Key: "from", Values: []string{s.conf.Mail.From}},
+		{Key: "subject", Values: []string{mime.QEncoding.Encode("utf-8", mail.Subject)}},
+		{Key: "to", Values: mail.Recipients},
+	}
+	if mail.UnsubscribeURL != "" {
+		headers = append(headers, mailer.Header{Key: "list-unsubscribe", Values: []string{"<" + mail.UnsubscribeURL + ">"}})
+	}
+	if mail.TextBody == "" {
+		return s.mail.Send(headers, OutboxMailTmpl, template.HTML(mail.Body), mail.Recipients...)
+	}
+	return s.sendAlternative(headers, mail.Body, mail.TextBody, mail.Recipients...)
+}
+
+// recordMail stores the result of sending mail.
+// Failed mails are rescheduled with a back-off,
+// until the maximum attempts are reached and the mail is dead-lettered.
+func (rt *requestTx) recordMail(mail *models.Mail, err error) error {
+	conf := rt.s.conf.Mail
+	log := rt.Log.WithFields(logrus.Fields{"mail_id": mail.ID, "template": mail.Template, "to": mail.Recipients})
+
+	mail.Attempts++
+
+	switch {
+	case err == nil:
+		mail.SentAt, mail.NextAttemptAt, mail.LastError = null.TimeFrom(time.Now()), null.Time{}, null.String{}
+		log.WithFields(logrus.Fields{"event": "mail.sent", "attempts": mail.Attempts}).Info("Mail sent")
+	case mail.Attempts >= conf.MaxAttempts:
+		mail.FailedAt, mail.NextAttemptAt, mail.LastError = null.TimeFrom(time.Now()), null.Time{}, null.StringFrom(err.Error())
+		log.WithError(err).WithFields(logrus.Fields{"event": "mail.failed", "attempts": mail.Attempts}).Error("Mail dead-lettered")
+	default:
+		next := time.Now().Add(retryBackoff(conf.Backoff, mail.Attempts))
+		mail.NextAttemptAt, mail.LastError = null.TimeFrom(next), null.StringFrom(err.Error())
+		log.WithError(err).WithFields(logrus.Fields{"attempts": mail.Attempts, "next_attempt_at": next}).Warn("Mail send failed")
+	}
+
+	if _, err = mail.Update(rt.Ctx, rt.Tx, boil.Whitelist(
+		models.MailColumns.UpdatedAt,
+		models.MailColumns.Attempts,
+		models.MailColumns.NextAttemptAt,
+		models.MailColumns.SentAt,
+		models.MailColumns.FailedAt,
+		models.MailColumns.LastError,
+	)); err != nil {
+		log.WithError(err).Error("recordMail: Update")
+		return status.Error(codes.Internal, errDB)
+	}
+	return nil
+}
+
+// sendMails sends the pending mails from the outbox which are due.
+// The mails are claimed in a short transaction and sent outside of it,
+// so that a slow SMTP server does not hold a transaction open.
+// The result of every mail is recorded in its own transaction.
+func (s *shopServer) sendMails(ctx context.Context) error {
+	var mails models.MailSlice
+	if err := s.execJob(ctx, "claimMails", func(rt *requestTx) (err error) {
+		mails, err = rt.claimMails()
+		return err
+	}); err != nil {
+		return err
+	}
+	if len(mails) == 0 {
+		s.log.WithField("job", "sendMails").Debug("sendMails: nothing to do")
+		return nil
+	}
+
+	for _, mail := range mails {
+		serr := s.sendMail(mail)
+
+		if err := s.execJob(ctx, "recordMail", func(rt *requestTx) error {
+			return rt.recordMail(mail, serr)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// alternativeMessage composes a multipart/alternative message with a plain-text and HTML part.
+func alternativeMessage(headers []mailer.Header, html, text string) ([]byte, error) {
+	var msg bytes.Buffer
+	for _, h := range headers {
+		msg.WriteString(h.String())
+	}
+
+	mw := multipart.NewWriter(&msg)
+	fmt.Fprintf(&msg, "Date: %s\r\nMIME-Version: 1.0\r\nContent-Type: multipart/alternative; boundary=%q\r\n\r\n",
+		time.Now().UTC().Format(time.RFC1123Z), mw.Boundary())
+
+	for _, part := range []struct{ ctype, body string }{
+		{"text/plain", text},
+		{"text/html", html},
+	} {
+		pw, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.ctype + `; charset="UTF-8"`},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qw := quotedprintable.NewWriter(pw)
+		if _, err = qw.Write([]byte(part.body)); err != nil {
+			return nil, err
+		}
+		if err = qw.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+	return msg.Bytes(), nil
+}
+
+// sendAlternative sends a mail with a plain-text alternative over the configured SMTP server.
+// The mailer only supports HTML bodies, so the message is composed by alternativeMessage.
+func (s *shopServer) sendAlternative(headers []mailer.Header, html, text string, to ...string) error {
+	msg, err := alternativeMessage(headers, html, text)
+	if err != nil {
+		return err
+	}
+	if mailer.Debug {
+		s.log.Debugf("sendAlternative:\n------------\n%s", msg)
+	}
+	conf := s.conf.Mail
+	return smtp.SendMail(
+		fmt.Sprintf("%s:%d", conf.Host, conf.Port),
+		smtp.PlainAuth(conf.Identity, conf.Username, conf.Password, conf.Host),
+		conf.From, to, msg,
+	)
+}
+
+func mailModelToMsg(mail *models.Mail) (*shop.Mail, error) {
+	sm := &shop.Mail{
+		Id:         mail.ID,
+		Template:   mail.Template,
+		Subject:    mail.Subject,
+		Recipients: mail.Recipients,
+		Body:       mail.Body,
+		TextBody:   mail.TextBody,
+		Attempts:   int32(mail.Attempts),
+		LastError:  mail.LastError.String,
+	}
+	switch {
+	case mail.SentAt.Valid:
+		sm.Status = shop.Mail_SENT
+	case mail.FailedAt.Valid:
+		sm.Status = shop.Mail_FAILED
+	}
+
+	var err error
+	if sm.Created, sm.Updated, err = timeModelToMsg(mail.CreatedAt, mail.UpdatedAt); err != nil {
+		return nil, err
+	}
+	errs := make([]error, 2)
+	sm.NextAttempt, errs[0] = nullTimeToMsg(mail.NextAttemptAt)
+	sm.Sent, errs[1] = nullTimeToMsg(mail.SentAt)
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sm, nil
+}
+
+func (rt *requestTx) listMails(cond *shop.MailListConditions) (*shop.MailList, error) {
+	limit := int(cond.GetLimit())
+	if limit <= 0 {
+		limit = int(rt.s.conf.ListLimit)
+	}
+	qms := []qm.QueryMod{
+		qm.OrderBy(models.MailColumns.ID + " desc"),
+		qm.Limit(limit),
+	}
+
+	switch cond.GetStatus() {
+	case shop.MailListConditions_ANY:
+	case shop.MailListConditions_PENDING:
+		qms = append(qms, models.MailWhere.SentAt.IsNull(), models.MailWhere.FailedAt.IsNull())
+	case shop.MailListConditions_SENT:
+		qms = append(qms, models.MailWhere.SentAt.IsNotNull())
+	case shop.MailListConditions_FAILED:
+		qms = append(qms, models.MailWhere.FailedAt.IsNotNull())
+	default:
+		return nil, status.Errorf(codes.Unimplemented, errEnum, cond.GetStatus())
+	}
+
+	mails, err := models.Mails(qms...).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("listMails")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	list := make([]*shop.Mail, len(mails))
+	for i, m := range mails {
+		if list[i], err = mailModelToMsg(m); err != nil {
+			return nil, err
+		}
+	}
+	return &shop.MailList{List: list}, nil
+}
+
+// resendMail resets the attempts of the mail and schedules it for immediate delivery.
+func (rt *requestTx) resendMail(id int64) (*shop.Mail, error) {
+	if id == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, errMissing, "ID")
+	}
+	rt.Log = rt.Log.WithField("mail_id", id)
+
+	mail, err := models.FindMail(rt.Ctx, rt.Tx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			rt.Log.Warnf(errNotFound, "Mail", "ID", id)
+			return nil, status.Errorf(codes.NotFound, errNotFound, "Mail", "ID", id)
+		}
+		rt.Log.WithError(err).Error("resendMail: FindMail")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	mail.Attempts, mail.NextAttemptAt = 0, null.TimeFrom(time.Now())
+	mail.SentAt, mail.FailedAt, mail.LastError = null.Time{}, null.Time{}, null.String{}
+	if _, err = mail.Update(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+		rt.Log.WithError(err).Error("resendMail: Update")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	rt.Log.WithField("event", "mail.resend").Info("Mail scheduled for resend")
+	return mailModelToMsg(mail)
+}
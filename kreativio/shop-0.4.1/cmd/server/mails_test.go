@@ -0,0 +1,661 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moapis/mailer"
+	"github.com/moapis/shop"
+	"github.com/moapis/shop/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_mailModelToMsg(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		mail       *models.Mail
+		wantStatus shop.Mail_Status
+		wantErr    bool
+	}{
+		{
+			"Pending",
+			&models.Mail{ID: 1, CreatedAt: now, UpdatedAt: now, NextAttemptAt: null.TimeFrom(now)},
+			shop.Mail_PENDING,
+			false,
+		},
+		{
+			"Sent",
+			&models.Mail{ID: 2, CreatedAt: now, UpdatedAt: now, SentAt: null.TimeFrom(now)},
+			shop.Mail_SENT,
+			false,
+		},
+		{
+			"Failed",
+			&models.Mail{ID: 3, CreatedAt: now, UpdatedAt: now, FailedAt: null.TimeFrom(now), LastError: null.StringFrom("foo")},
+			shop.Mail_FAILED,
+			false,
+		},
+		{
+			"Time error",
+			&models.Mail{ID: 4, CreatedAt: now, UpdatedAt: now, SentAt: null.TimeFrom(time.Unix(-62135596801, 0))},
+			shop.Mail_PENDING,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mailModelToMsg(tt.mail)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("mailModelToMsg() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.GetId() != tt.mail.ID || got.GetStatus() != tt.wantStatus || got.GetLastError() != tt.mail.LastError.String {
+				t.Errorf("mailModelToMsg() = %v", got)
+			}
+			if (got.GetSent() != nil) != tt.mail.SentAt.Valid || (got.GetNextAttempt() != nil) != tt.mail.NextAttemptAt.Valid {
+				t.Errorf("mailModelToMsg() times = %v, %v", got.GetSent(), got.GetNextAttempt())
+			}
+		})
+	}
+}
+
+func Test_requestTx_queueMail(t *testing.T) {
+	tests := []struct {
+		name    string
+		tmpl    string
+		wantErr bool
+	}{
+		{"Success", MessageMailTmpl, false},
+		{"Template error", "foo", true},
+		{"DB Error", MessageMailTmpl, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			msg := &models.Message{ID: 1, Name: "Muhlemmer", Subject: "Hello world!", Message: "Spanac!"}
+			err = rt.queueMail(tt.tmpl, "Subject", []string{"foo@bar.com"}, msg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("requestTx.queueMail() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			mail, err := models.Mails().One(rt.Ctx, rt.Tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if mail.Template != tt.tmpl || mail.Subject != "Subject" || !mail.NextAttemptAt.Valid || !strings.Contains(mail.Body, "Spanac!") {
+				t.Errorf("requestTx.queueMail() mail = %+v", mail)
+			}
+		})
+	}
+}
+
+func Test_requestTx_claimMails(t *testing.T) {
+	rt, err := tss.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Done()
+
+	if _, err = insertTestMails(rt); err != nil {
+		t.Fatal(err)
+	}
+
+	mails, err := rt.claimMails()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mails) != 1 || mails[0].Subject != "Pending" {
+		t.Fatalf("requestTx.claimMails() = %v, want the pending mail", mails)
+	}
+	if err = mails[0].Reload(rt.Ctx, rt.Tx); err != nil {
+		t.Fatal(err)
+	}
+	if !mails[0].NextAttemptAt.Time.After(time.Now().Add(Default.Mail.Lease-time.Minute)) || mails[0].Attempts != 0 {
+		t.Errorf("requestTx.claimMails() mail = %+v, want claimed", mails[0])
+	}
+
+	// Claimed mails are not due
+	if mails, err = rt.claimMails(); err != nil || len(mails) != 0 {
+		t.Errorf("requestTx.claimMails() = %v, %v, want none", mails, err)
+	}
+}
+
+func Test_shopServer_sendMails(t *testing.T) {
+	cc := *testConfig
+	cc.Mail.Host = "foobar"
+
+	ets, err := cc.newShopServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		server   *shopServer
+		attempts int
+		wantSent bool
+		wantNext bool
+		wantFail bool
+	}{
+		{"Sent", tss, 0, true, false, false},
+		{"Retry", ets, 0, false, true, false},
+		{"Dead letter", ets, Default.Mail.MaxAttempts - 1, false, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tt.server.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			msg := &models.Message{ID: 1, Name: "Muhlemmer", Subject: "Hello world!", Message: "Spanac!"}
+			if err = rt.queueMail(MessageMailTmpl, "Subject", rt.s.conf.Mail.To, msg); err != nil {
+				t.Fatal(err)
+			}
+			mail, err := models.Mails(qm.OrderBy(models.MailColumns.ID+" desc")).One(rt.Ctx, rt.Tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			mail.Attempts = tt.attempts
+			if _, err = mail.Update(rt.Ctx, rt.Tx, boil.Whitelist(models.MailColumns.Attempts)); err != nil {
+				t.Fatal(err)
+			}
+			if err = rt.Commit(); err != nil {
+				t.Fatal(err)
+			}
+			defer deleteTestMail(t, mail.ID)
+
+			if err = tt.server.sendMails(testCtx); err != nil {
+				t.Fatal(err)
+			}
+
+			rt, err = tt.server.newTx(testCtx, "testing", true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			if err = mail.Reload(rt.Ctx, rt.Tx); err != nil {
+				t.Fatal(err)
+			}
+			if mail.Attempts != tt.attempts+1 || mail.SentAt.Valid != tt.wantSent || mail.NextAttemptAt.Valid != tt.wantNext || mail.FailedAt.Valid != tt.wantFail {
+				t.Errorf("shopServer.sendMails() mail = %+v", mail)
+			}
+			if mail.LastError.Valid == tt.wantSent {
+				t.Errorf("shopServer.sendMails() last error = %v", mail.LastError)
+			}
+
+			// Retried and failed mails are not due
+			if err = tt.server.sendMails(testCtx); err != nil {
+				t.Fatal(err)
+			}
+			if err = mail.Reload(rt.Ctx, rt.Tx); err != nil {
+				t.Fatal(err)
+			}
+			if mail.Attempts != tt.attempts+1 {
+				t.Errorf("shopServer.sendMails() attempts = %d, want %d", mail.Attempts, tt.attempts+1)
+			}
+		})
+	}
+}
+
+// deleteTestMail removes a committed test mail from the outbox.
+func deleteTestMail(t *testing.T, id int64) {
+	rt, err := tss.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Done()
+	if _, err = models.Mails(models.MailWhere.ID.EQ(id)).DeleteAll(rt.Ctx, rt.Tx); err != nil {
+		t.Fatal(err)
+	}
+	if err = rt.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func insertTestMails(rt *requestTx) ([]*models.Mail, error) {
+	now := time.Now()
+	mails := []*models.Mail{
+		{Template: "checkout", Subject: "Pending", Recipients: []string{"foo@bar.com"}, Body: "pending", NextAttemptAt: null.TimeFrom(now)},
+		{Template: "checkout", Subject: "Sent", Recipients: []string{"foo@bar.com"}, Body: "sent", Attempts: 1, SentAt: null.TimeFrom(now)},
+		{Template: "checkout", Subject: "Failed", Recipients: []string{"foo@bar.com"}, Body: "failed", Attempts: 10, FailedAt: null.TimeFrom(now), LastError: null.StringFrom("foo")},
+	}
+	for _, m := range mails {
+		if err := m.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+			return nil, err
+		}
+	}
+	return mails, nil
+}
+
+func Test_requestTx_listMails(t *testing.T) {
+	tests := []struct {
+		name     string
+		cond     *shop.MailListConditions
+		wantSubj []string
+		wantErr  error
+	}{
+		{
+			"Any",
+			&shop.MailListConditions{},
+			[]string{"Failed", "Sent", "Pending"},
+			nil,
+		},
+		{
+			"Limit",
+			&shop.MailListConditions{Limit: 1},
+			[]string{"Failed"},
+			nil,
+		},
+		{
+			"Pending",
+			&shop.MailListConditions{Status: shop.MailListConditions_PENDING},
+			[]string{"Pending"},
+			nil,
+		},
+		{
+			"Sent",
+			&shop.MailListConditions{Status: shop.MailListConditions_SENT},
+			[]string{"Sent"},
+			nil,
+		},
+		{
+			"Failed",
+			&shop.MailListConditions{Status: shop.MailListConditions_FAILED},
+			[]string{"Failed"},
+			nil,
+		},
+		{
+			"Enum error",
+			&shop.MailListConditions{Status: 99},
+			nil,
+			status.Errorf(codes.Unimplemented, errEnum, shop.MailListConditions_Status(99)),
+		},
+		{
+			"DB Error",
+			&shop.MailListConditions{},
+			nil,
+			status.Error(codes.Internal, errDB),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			if _, err = insertTestMails(rt); err != nil {
+				t.Fatal(err)
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.listMails(tt.cond)
+			if !errors.Is(tt.wantErr, err) {
+				t.Fatalf("requestTx.listMails() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			var subj []string
+			for _, m := range got.GetList() {
+				subj = append(subj, m.GetSubject())
+			}
+			if strings.Join(subj, ",") != strings.Join(tt.wantSubj, ",") {
+				t.Errorf("requestTx.listMails() = %v, want %v", subj, tt.wantSubj)
+			}
+		})
+	}
+}
+
+func Test_requestTx_resendMail(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int64
+		wantErr error
+	}{
+		{
+			"Success",
+			-1,
+			nil,
+		},
+		{
+			"Missing ID",
+			0,
+			status.Errorf(codes.InvalidArgument, errMissing, "ID"),
+		},
+		{
+			"Not found",
+			9999,
+			status.Errorf(codes.NotFound, errNotFound, "Mail", "ID", 9999),
+		},
+		{
+			"DB Error",
+			-1,
+			status.Error(codes.Internal, errDB),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			mails, err := insertTestMails(rt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			id := tt.id
+			if id < 0 {
+				id = mails[2].ID
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.resendMail(id)
+			if !errors.Is(tt.wantErr, err) {
+				t.Fatalf("requestTx.resendMail() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.GetStatus() != shop.Mail_PENDING || got.GetAttempts() != 0 || got.GetLastError() != "" || got.GetNextAttempt() == nil {
+				t.Errorf("requestTx.resendMail() = %v", got)
+			}
+		})
+	}
+}
+
+func Test_shopServer_localTmpl(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale string
+		want   string
+	}{
+		{"Default", "", "order_received.customer.en"},
+		{"Localized", "ro", "order_received.customer.ro"},
+		{"Unknown", "xx", "order_received.customer.en"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tss.localTmpl(mailOrderReceived, mailCustomer, tt.locale); got != tt.want {
+				t.Errorf("shopServer.localTmpl() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_customerTmpl(t *testing.T) {
+	tests := []struct {
+		tmpl string
+		want bool
+	}{
+		{"order_received.customer.en", true},
+		{"newsletter_confirm.customer.ro", true},
+		{MessageReplyTmpl, true},
+		{"order_received.admin.en", false},
+		{MessageMailTmpl, false},
+		{LiveMailTmpl, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tmpl, func(t *testing.T) {
+			if got := customerTmpl(tt.tmpl); got != tt.want {
+				t.Errorf("customerTmpl() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_requestTx_queueOrderMails(t *testing.T) {
+	roOrder := *testOrders[0]
+	roOrder.Locale = "ro"
+
+	tests := []struct {
+		name      string
+		event     string
+		order     *models.Order
+		wantTmpls []string
+		wantErr   bool
+	}{
+		{
+			"Received",
+			mailOrderReceived,
+			testOrders[0],
+			[]string{"order_received.customer.en", "order_received.admin.en"},
+			false,
+		},
+		{
+			"Localized",
+			mailOrderShipped,
+			&roOrder,
+			[]string{"order_shipped.customer.ro", "order_shipped.admin.en"},
+			false,
+		},
+		{
+			"Order error",
+			mailOrderReceived,
+			testOrders[1],
+			nil,
+			true,
+		},
+		{
+			"Template error",
+			"foo",
+			testOrders[0],
+			nil,
+			true,
+		},
+		{
+			"DB Error",
+			mailOrderReceived,
+			testOrders[0],
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			if err = rt.queueOrderMails(tt.event, tt.order); (err != nil) != tt.wantErr {
+				t.Fatalf("requestTx.queueOrderMails() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			mails, err := models.Mails(qm.OrderBy(models.MailColumns.ID)).All(rt.Ctx, rt.Tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(mails) != len(tt.wantTmpls) {
+				t.Fatalf("requestTx.queueOrderMails() mails = %d, want %d", len(mails), len(tt.wantTmpls))
+			}
+			for i, m := range mails {
+				if m.Template != tt.wantTmpls[i] {
+					t.Errorf("requestTx.queueOrderMails() template = %s, want %s", m.Template, tt.wantTmpls[i])
+				}
+				if !strings.Contains(m.Subject, "100") || !strings.Contains(m.TextBody, "100") || !strings.Contains(m.Body, "100") {
+					t.Errorf("requestTx.queueOrderMails() mail = %+v", m)
+				}
+			}
+			if to := []string(mails[0].Recipients); len(to) != 1 || to[0] != tt.order.Email {
+				t.Errorf("requestTx.queueOrderMails() customer recipients = %v", to)
+			}
+		})
+	}
+}
+
+func Test_requestTx_queueStatusMails(t *testing.T) {
+	tests := []struct {
+		status   string
+		wantTmpl string
+	}{
+		{models.StatusOPEN, ""},
+		{models.StatusSENT, "order_shipped.customer.en"},
+		{models.StatusCOMPLETED, ""},
+		{models.StatusCANCELLED, "order_cancelled.customer.en"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			order := *testOrders[0]
+			order.Status = tt.status
+			if err = rt.queueStatusMails(&order); err != nil {
+				t.Fatal(err)
+			}
+
+			mail, err := models.Mails(qm.OrderBy(models.MailColumns.ID)).One(rt.Ctx, rt.Tx)
+			switch {
+			case tt.wantTmpl == "" && err == nil:
+				t.Errorf("requestTx.queueStatusMails() queued %s", mail.Template)
+			case tt.wantTmpl == "":
+			case err != nil:
+				t.Fatal(err)
+			case mail.Template != tt.wantTmpl:
+				t.Errorf("requestTx.queueStatusMails() template = %s, want %s", mail.Template, tt.wantTmpl)
+			}
+		})
+	}
+}
+
+func Test_alternativeMessage(t *testing.T) {
+	headers := []mailer.Header{
+		{Key: "subject", Values: []string{"Hello"}},
+		{Key: "to", Values: []string{"foo@bar.com"}},
+	}
+	html := "<p>Bună ziua</p>"
+	text := "Bună ziua " + strings.Repeat("x", 100)
+
+	b, err := alternativeMessage(headers, html, text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := mail.ReadMessage(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := msg.Header.Get("Subject"); got != "Hello" {
+		t.Errorf("alternativeMessage() Subject = %s", got)
+	}
+	mt, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mt != "multipart/alternative" {
+		t.Fatalf("alternativeMessage() Content-Type = %s, %v", mt, err)
+	}
+
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for _, want := range []struct{ ctype, body string }{
+		{"text/plain", text},
+		{"text/html", html},
+	} {
+		part, err := mr.NextPart()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(part.Header.Get("Content-Type"), want.ctype) {
+			t.Errorf("alternativeMessage() part Content-Type = %s, want %s", part.Header.Get("Content-Type"), want.ctype)
+		}
+		// NextPart decodes quoted-printable transparently
+		body, err := ioutil.ReadAll(part)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(body) != want.body {
+			t.Errorf("alternativeMessage() part = %q, want %q", body, want.body)
+		}
+	}
+	if _, err = mr.NextPart(); err != io.EOF {
+		t.Errorf("alternativeMessage() extra part, err = %v", err)
+	}
+}
+
+func Test_orderMailTemplates(t *testing.T) {
+	msg, err := orderModelToMsg(testOrders[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg.Articles = []*shop.Order_ArticleAmount{{ArticleId: 11, Amount: 1, Title: "ID 11", Price: "30000.99", Total: "30000.99"}}
+	msg.Sum = "30000.99"
+	msg.TrackingNumber = "AWB123"
+	msg.TrackingUrl = "https://example.com/track?awb=AWB123&lang=ro"
+	data := orderMailData{msg, testOrders[0].CreatedAt, "RON", "Shop & Co"}
+
+	for _, event := range []string{mailOrderReceived, mailPaymentConfirmed, mailOrderShipped, mailOrderCancelled} {
+		for _, audience := range []string{mailCustomer, mailAdmin} {
+			for _, locale := range []string{"en", "ro"} {
+				name := tss.localTmpl(event, audience, locale)
+				t.Run(name, func(t *testing.T) {
+					var html, text, subject bytes.Buffer
+					if err := tss.tmpl.ExecuteTemplate(&html, name, data); err != nil {
+						t.Fatal(err)
+					}
+					if err := tss.text.ExecuteTemplate(&text, name, data); err != nil {
+						t.Fatal(err)
+					}
+					if err := tss.text.ExecuteTemplate(&subject, subjectTmpl(name), data); err != nil {
+						t.Fatal(err)
+					}
+					for _, s := range []string{html.String(), text.String(), subject.String()} {
+						if !strings.Contains(s, "100") {
+							t.Errorf("%s does not contain the order ID:\n%s", name, s)
+						}
+					}
+					if event == mailOrderShipped && audience == mailCustomer && !strings.Contains(text.String(), msg.TrackingUrl) {
+						t.Errorf("%s does not contain the tracking URL:\n%s", name, text.String())
+					}
+				})
+			}
+		}
+	}
+}
@@ -26,16 +26,21 @@ func main() {
 	sc := make(chan os.Signal, 1)
 	signal.Notify(sc, os.Interrupt)
 	mobilpay.SetMobilpayVars(c.HTTPServer.MobilpayEndpoint, c.Mobilpay.Signature, c.Mobilpay.PrivateKeyFile, c.Mobilpay.CertificateFile, c.Mobilpay.ConfirmURL, c.Mobilpay.ReturnURL)
-	httpServer, err := c.httpServerStart()
+	httpServer, err := c.httpServerStart(s)
 	mpkeys := &mobilpay.CB{}
 	mpkeys.ParseKeys()
 	if err != nil {
 		log.WithError(err).Fatal("httpServer")
 	}
+	jobCtx, cancelJobs := context.WithCancel(context.Background())
+	s.startJobs(jobCtx)
+	s.startWatch(jobCtx)
+
 	gs, ec := c.listenAndServe(s, opts...)
 	select {
 	case sig := <-sc:
 		log.WithField("signal", sig).Info("Shutdown")
+		cancelJobs()
 		gs.GracefulStop()
 		httpServer.Shutdown(context.Background())
 	case err = <-ec:
@@ -320,9 +320,10 @@ var (
 			Details: null.NewJSON(
 				[]byte(`{
 					"base_price":{"label":"Cheap material", "price":"44.55"},
-					"variant":{"labels": ["hello", "world"], "multiplier":"3.33"}
+					"variant":{"id": 41, "labels": ["hello", "world"], "multiplier":"3.33"}
 				}`), true,
 			),
+			VariantID: null.Int64From(41),
 		},
 		{
 			OrderID:   101,
@@ -0,0 +1,323 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/moapis/shop"
+	"github.com/moapis/shop/models"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// MessagesConfig sets the spam protection of SendMessage.
+type MessagesConfig struct {
+	CaptchaURL     string        `json:"captcha_url"` // Verification endpoint of a reCAPTCHA compatible service. Empty disables
+	CaptchaSecret  string        `json:"captcha_secret"`
+	CaptchaTimeout time.Duration `json:"captcha_timeout"`
+}
+
+const (
+	errCaptcha     = "Captcha verification failed"
+	errCaptchaDown = "Captcha verification unavailable"
+)
+
+// MessageReplyTmpl is the template name for replies on contact messages.
+const MessageReplyTmpl = "message_reply"
+
+// forwardedAddr returns the x-forwarded-for entry appended by the first of trustedProxies,
+// counted from the right. Entries further left are set by the client and can't be trusted.
+// If the header has less entries, the first one is returned.
+func forwardedAddr(ctx context.Context, trustedProxies int) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	var addrs []string
+	for _, fwd := range md.Get("x-forwarded-for") {
+		for _, addr := range strings.Split(fwd, ",") {
+			if addr = strings.TrimSpace(addr); addr != "" {
+				addrs = append(addrs, addr)
+			}
+		}
+	}
+	if len(addrs) == 0 {
+		return ""
+	}
+	if trustedProxies > len(addrs) {
+		trustedProxies = len(addrs)
+	}
+	return addrs[len(addrs)-trustedProxies]
+}
+
+// clientAddr returns the address of the client, without port.
+// Behind trustedProxies, the address is taken from the x-forwarded-for metadata.
+func clientAddr(ctx context.Context, trustedProxies int) string {
+	if trustedProxies > 0 {
+		if addr := forwardedAddr(ctx, trustedProxies); addr != "" {
+			return addr
+		}
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// verifyCaptcha checks token with the configured captcha service.
+// It is a no-op when no CaptchaURL is configured.
+func (s *shopServer) verifyCaptcha(ctx context.Context, token, addr string) error {
+	conf := s.conf.Messages
+	if conf.CaptchaURL == "" {
+		return nil
+	}
+	if token == "" {
+		return status.Errorf(codes.InvalidArgument, errMissing, "CaptchaToken")
+	}
+	log := s.log.WithField("remote_addr", addr)
+
+	form := url.Values{
+		"secret":   {conf.CaptchaSecret},
+		"response": {token},
+	}
+	if addr != "" {
+		form.Set("remoteip", addr)
+	}
+	req, err := http.NewRequest(http.MethodPost, conf.CaptchaURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		log.WithError(err).Error("verifyCaptcha: NewRequest")
+		return status.Error(codes.Internal, errCaptchaDown)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	client := &http.Client{Timeout: conf.CaptchaTimeout}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		log.WithError(err).Error("verifyCaptcha: Do")
+		return status.Error(codes.Unavailable, errCaptchaDown)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Success    bool     `json:"success"`
+		ErrorCodes []string `json:"error-codes"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		log.WithError(err).Error("verifyCaptcha: Decode")
+		return status.Error(codes.Unavailable, errCaptchaDown)
+	}
+	if !result.Success {
+		log.WithField("error_codes", result.ErrorCodes).Warn("verifyCaptcha")
+		return status.Error(codes.PermissionDenied, errCaptcha)
+	}
+	return nil
+}
+
+func messageReplyModelToMsg(r *models.MessageReply) (*shop.MessageReply, error) {
+	created, _, err := timeModelToMsg(r.CreatedAt, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	return &shop.MessageReply{
+		Id:        int32(r.ID),
+		MessageId: int32(r.MessageID),
+		Created:   created,
+		Author:    r.Author,
+		Body:      r.Body,
+	}, nil
+}
+
+func messageModelToMsg(msg *models.Message) (*shop.Message, error) {
+	created, _, err := timeModelToMsg(msg.CreatedAt, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	sm := &shop.Message{
+		Id:       int32(msg.ID),
+		Name:     msg.Name,
+		Email:    msg.Email,
+		Phone:    msg.Phone,
+		Subject:  msg.Subject,
+		Message:  msg.Message,
+		Created:  created,
+		Read:     msg.ReadAt.Valid,
+		Archived: msg.ArchivedAt.Valid,
+	}
+	if msg.R == nil {
+		return sm, nil
+	}
+	sm.Replies = make([]*shop.MessageReply, len(msg.R.MessageReplies))
+	for i, r := range msg.R.MessageReplies {
+		if sm.Replies[i], err = messageReplyModelToMsg(r); err != nil {
+			return nil, err
+		}
+	}
+	return sm, nil
+}
+
+func (rt *requestTx) listMessages(cond *shop.MessageListConditions) (*shop.MessageList, error) {
+	limit := int(cond.GetLimit())
+	if limit <= 0 {
+		limit = int(rt.s.conf.ListLimit)
+	}
+	qms := []qm.QueryMod{
+		qm.OrderBy(models.MessageColumns.ID + " desc"),
+		qm.Limit(limit),
+	}
+
+	switch cond.GetStatus() {
+	case shop.MessageListConditions_INBOX:
+		qms = append(qms, models.MessageWhere.ArchivedAt.IsNull())
+	case shop.MessageListConditions_UNREAD:
+		qms = append(qms, models.MessageWhere.ArchivedAt.IsNull(), models.MessageWhere.ReadAt.IsNull())
+	case shop.MessageListConditions_ARCHIVED:
+		qms = append(qms, models.MessageWhere.ArchivedAt.IsNotNull())
+	case shop.MessageListConditions_ALL:
+	default:
+		return nil, status.Errorf(codes.Unimplemented, errEnum, cond.GetStatus())
+	}
+
+	msgs, err := models.Messages(qms...).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("listMessages")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	list := make([]*shop.Message, len(msgs))
+	for i, m := range msgs {
+		if list[i], err = messageModelToMsg(m); err != nil {
+			return nil, err
+		}
+	}
+	return &shop.MessageList{List: list}, nil
+}
+
+// findMessage returns the message with its replies, oldest first.
+func (rt *requestTx) findMessage(id int) (*models.Message, error) {
+	if id == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, errMissing, "ID")
+	}
+
+	msg, err := models.Messages(
+		models.MessageWhere.ID.EQ(id),
+		qm.Load(models.MessageRels.MessageReplies, qm.OrderBy(models.MessageReplyColumns.ID)),
+	).One(rt.Ctx, rt.Tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			rt.Log.Warnf(errNotFound, "Message", "ID", id)
+			return nil, status.Errorf(codes.NotFound, errNotFound, "Message", "ID", id)
+		}
+		rt.Log.WithError(err).Error("findMessage")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	return msg, nil
+}
+
+func (rt *requestTx) getMessage(id int) (*shop.Message, error) {
+	msg, err := rt.findMessage(id)
+	if err != nil {
+		return nil, err
+	}
+	return messageModelToMsg(msg)
+}
+
+// markTime returns a valid time if set, keeping the previous time if it was already set.
+func markTime(prev null.Time, set bool) null.Time {
+	switch {
+	case !set:
+		return null.Time{}
+	case prev.Valid:
+		return prev
+	default:
+		return null.TimeFrom(time.Now())
+	}
+}
+
+func (rt *requestTx) markMessage(mark *shop.MessageMark) (*shop.Message, error) {
+	msg, err := rt.findMessage(int(mark.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	msg.ReadAt = markTime(msg.ReadAt, mark.GetRead())
+	msg.ArchivedAt = markTime(msg.ArchivedAt, mark.GetArchived())
+	if _, err = msg.Update(rt.Ctx, rt.Tx, boil.Whitelist(
+		models.MessageColumns.UpdatedAt,
+		models.MessageColumns.ReadAt,
+		models.MessageColumns.ArchivedAt,
+	)); err != nil {
+		rt.Log.WithError(err).Error("markMessage: Update")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	return messageModelToMsg(msg)
+}
+
+// replyMessage stores the reply in the thread of the message,
+// and queues it as mail to the sender.
+func (rt *requestTx) replyMessage(sr *shop.MessageReply) (*shop.Message, error) {
+	if strings.TrimSpace(sr.GetBody()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, errMissing, "Body")
+	}
+	msg, err := rt.findMessage(int(sr.GetMessageId()))
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &models.MessageReply{
+		Author: rt.subject(),
+		Body:   sr.GetBody(),
+	}
+	if err = msg.AddMessageReplies(rt.Ctx, rt.Tx, true, reply); err != nil {
+		rt.Log.WithError(err).Error("replyMessage: AddMessageReplies")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	msg.ReadAt = markTime(msg.ReadAt, true)
+	if _, err = msg.Update(rt.Ctx, rt.Tx, boil.Whitelist(
+		models.MessageColumns.UpdatedAt,
+		models.MessageColumns.ReadAt,
+	)); err != nil {
+		rt.Log.WithError(err).Error("replyMessage: Update")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	data := struct {
+		Message  *models.Message
+		Reply    *models.MessageReply
+		ShopName string
+	}{msg, reply, rt.s.conf.Mail.ShopName}
+
+	subject := fmt.Sprintf("Re: %s", msg.Subject)
+	if msg.Subject == "" {
+		subject = fmt.Sprintf("Re: your message to %s", rt.s.conf.Mail.ShopName)
+	}
+	if err = rt.queueMail(MessageReplyTmpl, subject, []string{msg.Email}, data); err != nil {
+		return nil, err
+	}
+
+	rt.Log.WithFields(logrus.Fields{"event": "message.replied", "message_id": msg.ID, "reply_id": reply.ID}).Info("Message replied")
+	return messageModelToMsg(msg)
+}
@@ -0,0 +1,369 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moapis/shop"
+	"github.com/moapis/shop/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func Test_clientAddr(t *testing.T) {
+	pctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234},
+	})
+	fctx := metadata.NewIncomingContext(pctx, metadata.Pairs("x-forwarded-for", "192.0.2.1"))
+	// The client sent its own header, which the proxies appended to
+	sctx := metadata.NewIncomingContext(pctx, metadata.Pairs("x-forwarded-for", "203.0.113.66, 192.0.2.1, 10.0.0.2"))
+	mctx := metadata.NewIncomingContext(pctx, metadata.Pairs("x-forwarded-for", "203.0.113.66", "x-forwarded-for", "192.0.2.1, 10.0.0.2"))
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		trustedProxies int
+		want           string
+	}{
+		{"No peer", context.Background(), 0, ""},
+		{"Peer", pctx, 0, "10.0.0.1"},
+		{"Untrusted forward", fctx, 0, "10.0.0.1"},
+		{"Trusted forward", fctx, 1, "192.0.2.1"},
+		{"Trusted without forward", pctx, 1, "10.0.0.1"},
+		{"Spoofed forward", sctx, 2, "192.0.2.1"},
+		{"Spoofed forward, one proxy", sctx, 1, "10.0.0.2"},
+		{"Spoofed multiple headers", mctx, 2, "192.0.2.1"},
+		{"More proxies than entries", fctx, 3, "192.0.2.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clientAddr(tt.ctx, tt.trustedProxies); got != tt.want {
+				t.Errorf("clientAddr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func testCaptchaServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch {
+		case r.PostForm.Get("secret") != "secret":
+			fmt.Fprint(w, `{"success": false, "error-codes": ["invalid-input-secret"]}`)
+		case r.PostForm.Get("response") == "good":
+			fmt.Fprint(w, `{"success": true}`)
+		case r.PostForm.Get("response") == "garbage":
+			fmt.Fprint(w, `not json`)
+		default:
+			fmt.Fprint(w, `{"success": false, "error-codes": ["invalid-input-response"]}`)
+		}
+	}))
+}
+
+func Test_shopServer_verifyCaptcha(t *testing.T) {
+	srv := testCaptchaServer()
+	defer srv.Close()
+
+	tests := []struct {
+		name    string
+		url     string
+		token   string
+		wantErr error
+	}{
+		{"Disabled", "", "", nil},
+		{"Missing token", srv.URL, "", status.Errorf(codes.InvalidArgument, errMissing, "CaptchaToken")},
+		{"Success", srv.URL, "good", nil},
+		{"Failed", srv.URL, "bad", status.Error(codes.PermissionDenied, errCaptcha)},
+		{"Decode error", srv.URL, "garbage", status.Error(codes.Unavailable, errCaptchaDown)},
+		{"Request error", "http://127.0.0.1:1/verify", "good", status.Error(codes.Unavailable, errCaptchaDown)},
+		{"URL error", "://foo", "good", status.Error(codes.Internal, errCaptchaDown)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &shopServer{
+				log: log.WithField("server", "Shop"),
+				conf: &ServerConfig{Messages: MessagesConfig{
+					CaptchaURL:     tt.url,
+					CaptchaSecret:  "secret",
+					CaptchaTimeout: time.Second,
+				}},
+			}
+			if err := s.verifyCaptcha(context.Background(), tt.token, "10.0.0.1"); !errors.Is(tt.wantErr, err) {
+				t.Errorf("shopServer.verifyCaptcha() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_markTime(t *testing.T) {
+	prev := null.TimeFrom(time.Unix(12, 0))
+
+	if got := markTime(prev, false); got.Valid {
+		t.Errorf("markTime() = %v, want invalid", got)
+	}
+	if got := markTime(prev, true); got != prev {
+		t.Errorf("markTime() = %v, want %v", got, prev)
+	}
+	if got := markTime(null.Time{}, true); !got.Valid || got.Time.Before(prev.Time) {
+		t.Errorf("markTime() = %v, want now", got)
+	}
+}
+
+func Test_messageModelToMsg(t *testing.T) {
+	msg := &models.Message{
+		ID:        1,
+		CreatedAt: time.Unix(12, 0),
+		Name:      "Muhlemmer",
+		Email:     "foo@bar.com",
+		Message:   "Spanac!",
+		ReadAt:    null.TimeFrom(time.Unix(34, 0)),
+	}
+	got, err := messageModelToMsg(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetId() != 1 || !got.GetRead() || got.GetArchived() || got.GetReplies() != nil || got.GetCreated().GetSeconds() != 12 {
+		t.Errorf("messageModelToMsg() = %v", got)
+	}
+
+	msg.R = msg.R.NewStruct()
+	msg.R.MessageReplies = models.MessageReplySlice{
+		{ID: 2, MessageID: 1, CreatedAt: time.Unix(56, 0), Author: "admin", Body: "Hello"},
+	}
+	if got, err = messageModelToMsg(msg); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.GetReplies()) != 1 || got.GetReplies()[0].GetBody() != "Hello" || got.GetReplies()[0].GetAuthor() != "admin" {
+		t.Errorf("messageModelToMsg() replies = %v", got.GetReplies())
+	}
+
+	msg.R.MessageReplies[0].CreatedAt = time.Unix(-62135596801, 0)
+	if _, err = messageModelToMsg(msg); err == nil {
+		t.Errorf("messageModelToMsg() expected time conversion error")
+	}
+}
+
+// insertTestMessages inserts an unread, read and archived message.
+func insertTestMessages(rt *requestTx) ([]*models.Message, error) {
+	now := time.Now()
+	msgs := []*models.Message{
+		{Name: "Unread", Email: "foo@bar.com", Subject: "Unread", Message: "Spanac!", RemoteAddr: "10.0.0.1"},
+		{Name: "Read", Email: "foo@bar.com", Subject: "Read", Message: "Spanac!", RemoteAddr: "10.0.0.1", ReadAt: null.TimeFrom(now)},
+		{Name: "Archived", Email: "foo@bar.com", Subject: "Archived", Message: "Spanac!", RemoteAddr: "10.0.0.2", ReadAt: null.TimeFrom(now), ArchivedAt: null.TimeFrom(now)},
+	}
+	for _, m := range msgs {
+		if err := m.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+			return nil, err
+		}
+	}
+	return msgs, nil
+}
+
+func Test_requestTx_listMessages(t *testing.T) {
+	tests := []struct {
+		name     string
+		cond     *shop.MessageListConditions
+		wantSubj []string
+		wantErr  error
+	}{
+		{
+			"Inbox",
+			&shop.MessageListConditions{},
+			[]string{"Read", "Unread"},
+			nil,
+		},
+		{
+			"Unread",
+			&shop.MessageListConditions{Status: shop.MessageListConditions_UNREAD},
+			[]string{"Unread"},
+			nil,
+		},
+		{
+			"Archived",
+			&shop.MessageListConditions{Status: shop.MessageListConditions_ARCHIVED},
+			[]string{"Archived"},
+			nil,
+		},
+		{
+			"All with limit",
+			&shop.MessageListConditions{Status: shop.MessageListConditions_ALL, Limit: 2},
+			[]string{"Archived", "Read"},
+			nil,
+		},
+		{
+			"Enum error",
+			&shop.MessageListConditions{Status: 99},
+			nil,
+			status.Errorf(codes.Unimplemented, errEnum, shop.MessageListConditions_Status(99)),
+		},
+		{
+			"DB Error",
+			&shop.MessageListConditions{},
+			nil,
+			status.Error(codes.Internal, errDB),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			if _, err = insertTestMessages(rt); err != nil {
+				t.Fatal(err)
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.listMessages(tt.cond)
+			if !errors.Is(tt.wantErr, err) {
+				t.Fatalf("requestTx.listMessages() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			var subj []string
+			for _, m := range got.GetList() {
+				subj = append(subj, m.GetSubject())
+			}
+			if strings.Join(subj, ",") != strings.Join(tt.wantSubj, ",") {
+				t.Errorf("requestTx.listMessages() = %v, want %v", subj, tt.wantSubj)
+			}
+		})
+	}
+}
+
+func Test_requestTx_markMessage(t *testing.T) {
+	tests := []struct {
+		name         string
+		read         bool
+		archived     bool
+		id           int32
+		wantErr      error
+		wantRead     bool
+		wantArchived bool
+	}{
+		{"Read", true, false, -1, nil, true, false},
+		{"Archive", true, true, -1, nil, true, true},
+		{"Unread", false, false, -1, nil, false, false},
+		{"Missing ID", true, false, 0, status.Errorf(codes.InvalidArgument, errMissing, "ID"), false, false},
+		{"Not found", true, false, 9999, status.Errorf(codes.NotFound, errNotFound, "Message", "ID", 9999), false, false},
+		{"DB Error", true, false, -1, status.Error(codes.Internal, errDB), false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			msgs, err := insertTestMessages(rt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			id := tt.id
+			if id < 0 {
+				id = int32(msgs[0].ID)
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.markMessage(&shop.MessageMark{Id: id, Read: tt.read, Archived: tt.archived})
+			if !errors.Is(tt.wantErr, err) {
+				t.Fatalf("requestTx.markMessage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.GetRead() != tt.wantRead || got.GetArchived() != tt.wantArchived {
+				t.Errorf("requestTx.markMessage() = %v", got)
+			}
+		})
+	}
+}
+
+func Test_requestTx_replyMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int32
+		body    string
+		wantErr error
+	}{
+		{"Success", -1, "Thanks for your message", nil},
+		{"Missing body", -1, " ", status.Errorf(codes.InvalidArgument, errMissing, "Body")},
+		{"Not found", 9999, "Hello", status.Errorf(codes.NotFound, errNotFound, "Message", "ID", 9999)},
+		{"DB Error", -1, "Hello", status.Error(codes.Internal, errDB)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			msgs, err := insertTestMessages(rt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			id := tt.id
+			if id < 0 {
+				id = int32(msgs[0].ID)
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.replyMessage(&shop.MessageReply{MessageId: id, Body: tt.body})
+			if !errors.Is(tt.wantErr, err) {
+				t.Fatalf("requestTx.replyMessage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if !got.GetRead() || len(got.GetReplies()) != 1 || got.GetReplies()[0].GetBody() != tt.body {
+				t.Errorf("requestTx.replyMessage() = %v", got)
+			}
+
+			// Thread is returned by getMessage
+			thread, err := rt.getMessage(int(id))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(thread.GetReplies()) != 1 {
+				t.Errorf("requestTx.getMessage() replies = %v", thread.GetReplies())
+			}
+
+			mail, err := models.Mails(models.MailWhere.Template.EQ(MessageReplyTmpl)).One(rt.Ctx, rt.Tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if mail.Subject != "Re: Unread" || mail.Recipients[0] != "foo@bar.com" || !strings.Contains(mail.TextBody, tt.body) {
+				t.Errorf("requestTx.replyMessage() mail = %+v", mail)
+			}
+		})
+	}
+}
@@ -0,0 +1,453 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/moapis/shop"
+	"github.com/moapis/shop/models"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewsletterConfig for subscriptions with double opt-in.
+type NewsletterConfig struct {
+	Secret         string        `json:"secret"`          // HMAC key for confirmation and unsubscribe tokens. Empty disables the newsletter
+	ConfirmURL     string        `json:"confirm_url"`     // Link in the confirmation mail; the token is added as "token" query parameter
+	UnsubscribeURL string        `json:"unsubscribe_url"` // Link in the footer of outgoing mails; the token is added as "token" query parameter
+	ConfirmTTL     time.Duration `json:"confirm_ttl"`     // Validity of confirmation tokens
+	ConsentText    string        `json:"consent_text"`    // Recorded as consent, when the client does not send one
+}
+
+const (
+	errNewsletter        = "Newsletter is not configured"
+	errSubscriptionToken = "Invalid or expired subscription token"
+	errUnsubscribed      = "Subscription was cancelled, subscribe again"
+)
+
+// Newsletter mail events, used as template name prefix.
+const (
+	mailNewsletterConfirm = "newsletter_confirm"
+	mailUnsubscribe       = "unsubscribe"
+	mailFooter            = "footer"
+)
+
+// Subscription token actions, also recorded in the consent log.
+const (
+	actionSubscribe   = "subscribe"
+	actionConfirm     = "confirm"
+	actionUnsubscribe = "unsubscribe"
+)
+
+// Subscription sources, for subscriptions which are not made through Subscribe.
+const (
+	sourceCheckout = "checkout"
+	sourceMessage  = "message"
+)
+
+type subscriptionClaims struct {
+	Action  string `json:"a"`
+	Email   string `json:"e"`
+	Expires int64  `json:"x,omitempty"` // Unix time, zero never expires
+}
+
+// signSubscriptionToken returns a signed token holding claims.
+func signSubscriptionToken(secret string, claims subscriptionClaims) (string, error) {
+	return signToken(secret, claims)
+}
+
+// parseSubscriptionToken verifies the signature, action and expiry of token.
+// It returns the normalized email from the claims.
+func parseSubscriptionToken(secret, action, token string, now time.Time) (string, error) {
+	var claims subscriptionClaims
+	if err := parseToken(secret, token, &claims); err != nil {
+		return "", err
+	}
+	if claims.Action != action {
+		return "", fmt.Errorf("token action %q, want %q", claims.Action, action)
+	}
+	if claims.Expires != 0 && now.Unix() > claims.Expires {
+		return "", errTokenExpired
+	}
+	return normalizeEmail(claims.Email), nil
+}
+
+// unsubscribeURL returns the unsubscribe link for email.
+// The link does not expire.
+// It returns an empty string if the newsletter or unsubscribe URL is not configured.
+func (s *shopServer) unsubscribeURL(email string) (string, error) {
+	conf := s.conf.Newsletter
+	if conf.Secret == "" || conf.UnsubscribeURL == "" {
+		return "", nil
+	}
+	token, err := signSubscriptionToken(conf.Secret, subscriptionClaims{Action: actionUnsubscribe, Email: normalizeEmail(email)})
+	if err != nil {
+		return "", err
+	}
+	return tokenURL(conf.UnsubscribeURL, token)
+}
+
+// appendFooter inserts footer before the closing body tag of html,
+// or at the end when there is none.
+func appendFooter(html, footer string) string {
+	i := strings.LastIndex(html, "</body>")
+	if i < 0 {
+		return html + footer
+	}
+	return html[:i] + footer + html[i:]
+}
+
+// unsubscribeFooter renders the HTML and plain-text footers with the unsubscribe link for a single recipient.
+// Empty strings are returned when the newsletter is not configured.
+func (rt *requestTx) unsubscribeFooter(to string) (link, html, text string, err error) {
+	if link, err = rt.s.unsubscribeURL(to); err != nil {
+		rt.Log.WithError(err).Error("unsubscribeFooter: unsubscribeURL")
+		return "", "", "", status.Error(codes.Internal, errMailTmpl)
+	}
+	if link == "" {
+		return "", "", "", nil
+	}
+
+	tmpl := rt.s.localTmpl(mailUnsubscribe, mailFooter, rt.s.conf.Mail.Locale)
+	data := struct {
+		URL      string
+		ShopName string
+	}{link, rt.s.conf.Mail.ShopName}
+
+	var hb, tb bytes.Buffer
+	if t := rt.s.tmpl.Lookup(tmpl); t != nil {
+		if err = t.Execute(&hb, data); err != nil {
+			rt.Log.WithError(err).Error("unsubscribeFooter: html Execute")
+			return "", "", "", status.Error(codes.Internal, errMailTmpl)
+		}
+	}
+	if t := rt.s.text.Lookup(tmpl); t != nil {
+		if err = t.Execute(&tb, data); err != nil {
+			rt.Log.WithError(err).Error("unsubscribeFooter: text Execute")
+			return "", "", "", status.Error(codes.Internal, errMailTmpl)
+		}
+	}
+	return link, hb.String(), tb.String(), nil
+}
+
+func subscriberModelToMsg(sub *models.Subscriber) (*shop.Subscriber, error) {
+	ss := &shop.Subscriber{
+		Id:     int32(sub.ID),
+		Email:  sub.Email,
+		Name:   sub.Name,
+		Locale: sub.Locale,
+		Status: shop.Subscriber_Status(shop.Subscriber_Status_value[sub.Status]),
+	}
+
+	var err error
+	if ss.Created, ss.Updated, err = timeModelToMsg(sub.CreatedAt, sub.UpdatedAt); err != nil {
+		return nil, err
+	}
+	errs := make([]error, 2)
+	ss.Confirmed, errs[0] = nullTimeToMsg(sub.ConfirmedAt)
+	ss.Unsubscribed, errs[1] = nullTimeToMsg(sub.UnsubscribedAt)
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ss, nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// findSubscriber returns the subscriber with the normalized email, or nil if there is none.
+// Case is ignored, so subscribers stored before normalization are found as well.
+func (rt *requestTx) findSubscriber(email string) (*models.Subscriber, error) {
+	sub, err := models.Subscribers(whereEmail(models.SubscriberColumns.Email, email)).One(rt.Ctx, rt.Tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		rt.Log.WithError(err).Error("findSubscriber")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	return sub, nil
+}
+
+// recordConsent adds an entry to the consent log of the subscriber.
+func (rt *requestTx) recordConsent(sub *models.Subscriber, action, source, text, addr string) error {
+	consent := &models.SubscriberConsent{
+		Action:      action,
+		Source:      source,
+		ConsentText: text,
+		RemoteAddr:  addr,
+	}
+	if err := sub.AddSubscriberConsents(rt.Ctx, rt.Tx, true, consent); err != nil {
+		rt.Log.WithError(err).Error("recordConsent")
+		return status.Error(codes.Internal, errDB)
+	}
+	return nil
+}
+
+// subscribe registers a pending subscription, records the consent and queues the confirmation mail.
+// Confirmed subscriptions are left untouched.
+func (rt *requestTx) subscribe(req *shop.Subscription, addr string) error {
+	conf := rt.s.conf.Newsletter
+	if conf.Secret == "" {
+		return status.Error(codes.FailedPrecondition, errNewsletter)
+	}
+	email := normalizeEmail(req.GetEmail())
+	if email == "" {
+		return status.Errorf(codes.InvalidArgument, errMissing, "Email")
+	}
+	log := rt.Log.WithField("email", email)
+
+	sub, err := rt.findSubscriber(email)
+	if err != nil {
+		return err
+	}
+	if sub != nil && sub.Status == models.SubscriberStatusCONFIRMED {
+		log.Info("subscribe: already confirmed")
+		return nil
+	}
+	if sub == nil {
+		sub = &models.Subscriber{Email: email}
+	}
+	if name := req.GetName(); name != "" {
+		sub.Name = name
+	}
+	if locale := req.GetLocale(); locale != "" {
+		sub.Locale = locale
+	}
+	if sub.Locale == "" {
+		sub.Locale = rt.s.conf.Mail.Locale
+	}
+	sub.Status, sub.UnsubscribedAt = models.SubscriberStatusPENDING, null.Time{}
+
+	if err = sub.Upsert(rt.Ctx, rt.Tx, true,
+		[]string{models.SubscriberColumns.Email},
+		boil.Whitelist(
+			models.SubscriberColumns.UpdatedAt,
+			models.SubscriberColumns.Name,
+			models.SubscriberColumns.Locale,
+			models.SubscriberColumns.Status,
+			models.SubscriberColumns.UnsubscribedAt,
+		),
+		boil.Infer(),
+	); err != nil {
+		log.WithError(err).Error("subscribe: Upsert")
+		return status.Error(codes.Internal, errDB)
+	}
+
+	text := req.GetConsentText()
+	if text == "" {
+		text = conf.ConsentText
+	}
+	if err = rt.recordConsent(sub, actionSubscribe, req.GetSource(), text, addr); err != nil {
+		return err
+	}
+
+	token, err := signSubscriptionToken(conf.Secret, subscriptionClaims{
+		Action:  actionConfirm,
+		Email:   email,
+		Expires: time.Now().Add(conf.ConfirmTTL).Unix(),
+	})
+	if err != nil {
+		log.WithError(err).Error("subscribe: signSubscriptionToken")
+		return status.Error(codes.Internal, errFatal)
+	}
+	link, err := tokenURL(conf.ConfirmURL, token)
+	if err != nil {
+		log.WithError(err).Error("subscribe: tokenURL")
+		return status.Error(codes.Internal, errFatal)
+	}
+
+	data := struct {
+		*models.Subscriber
+		URL      string
+		ShopName string
+	}{sub, link, rt.s.conf.Mail.ShopName}
+	if err = rt.queueLocalMail(rt.s.localTmpl(mailNewsletterConfirm, mailCustomer, sub.Locale), []string{email}, data); err != nil {
+		return err
+	}
+
+	log.WithFields(logrus.Fields{"event": "newsletter.subscribed", "subscriber_id": sub.ID}).Info("Subscription pending")
+	return nil
+}
+
+// subscribeFrom subscribes email when the client opted in on another form, like Checkout.
+// It is a no-op when the newsletter is not configured.
+func (rt *requestTx) subscribeFrom(source, email, name, locale, addr string) error {
+	if rt.s.conf.Newsletter.Secret == "" {
+		return nil
+	}
+	return rt.subscribe(&shop.Subscription{
+		Email:  email,
+		Name:   name,
+		Locale: locale,
+		Source: source,
+	}, addr)
+}
+
+// subscriberFromToken verifies token for action and returns the subscriber it belongs to.
+// A nil subscriber is returned when no subscriber exists for a valid token.
+func (rt *requestTx) subscriberFromToken(action, token string) (string, *models.Subscriber, error) {
+	secret := rt.s.conf.Newsletter.Secret
+	if secret == "" {
+		return "", nil, status.Error(codes.FailedPrecondition, errNewsletter)
+	}
+	if token == "" {
+		return "", nil, status.Errorf(codes.InvalidArgument, errMissing, "Token")
+	}
+	email, err := parseSubscriptionToken(secret, action, token, time.Now())
+	if err != nil {
+		rt.Log.WithError(err).Warn("subscriberFromToken")
+		return "", nil, status.Error(codes.InvalidArgument, errSubscriptionToken)
+	}
+	sub, err := rt.findSubscriber(email)
+	return email, sub, err
+}
+
+// confirmSubscription confirms the pending subscription of the token.
+// Confirming twice is not an error.
+func (rt *requestTx) confirmSubscription(token, addr string) (*shop.Subscriber, error) {
+	email, sub, err := rt.subscriberFromToken(actionConfirm, token)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case sub == nil:
+		rt.Log.Warnf(errNotFound, "Subscriber", "Email", email)
+		return nil, status.Errorf(codes.NotFound, errNotFound, "Subscriber", "Email", email)
+	case sub.Status == models.SubscriberStatusUNSUBSCRIBED:
+		return nil, status.Error(codes.FailedPrecondition, errUnsubscribed)
+	case sub.Status == models.SubscriberStatusCONFIRMED:
+		return subscriberModelToMsg(sub)
+	}
+
+	sub.Status, sub.ConfirmedAt = models.SubscriberStatusCONFIRMED, null.TimeFrom(time.Now())
+	if _, err = sub.Update(rt.Ctx, rt.Tx, boil.Whitelist(
+		models.SubscriberColumns.UpdatedAt,
+		models.SubscriberColumns.Status,
+		models.SubscriberColumns.ConfirmedAt,
+	)); err != nil {
+		rt.Log.WithError(err).Error("confirmSubscription: Update")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	if err = rt.recordConsent(sub, actionConfirm, "", "", addr); err != nil {
+		return nil, err
+	}
+
+	rt.Log.WithFields(logrus.Fields{"event": "newsletter.confirmed", "subscriber_id": sub.ID}).Info("Subscription confirmed")
+	return subscriberModelToMsg(sub)
+}
+
+// unsubscribe cancels the subscription of the token.
+// Unsubscribe links are sent in all mails, so recipients without subscription are recorded as unsubscribed.
+func (rt *requestTx) unsubscribe(token, addr string) (*shop.Subscriber, error) {
+	email, sub, err := rt.subscriberFromToken(actionUnsubscribe, token)
+	if err != nil {
+		return nil, err
+	}
+	if sub != nil && sub.Status == models.SubscriberStatusUNSUBSCRIBED {
+		return subscriberModelToMsg(sub)
+	}
+	if sub == nil {
+		sub = &models.Subscriber{Email: email, Locale: rt.s.conf.Mail.Locale}
+	}
+	sub.Status, sub.UnsubscribedAt = models.SubscriberStatusUNSUBSCRIBED, null.TimeFrom(time.Now())
+
+	if err = sub.Upsert(rt.Ctx, rt.Tx, true,
+		[]string{models.SubscriberColumns.Email},
+		boil.Whitelist(
+			models.SubscriberColumns.UpdatedAt,
+			models.SubscriberColumns.Status,
+			models.SubscriberColumns.UnsubscribedAt,
+		),
+		boil.Infer(),
+	); err != nil {
+		rt.Log.WithError(err).Error("unsubscribe: Upsert")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	if err = rt.recordConsent(sub, actionUnsubscribe, "", "", addr); err != nil {
+		return nil, err
+	}
+
+	rt.Log.WithFields(logrus.Fields{"event": "newsletter.unsubscribed", "subscriber_id": sub.ID}).Info("Unsubscribed")
+	return subscriberModelToMsg(sub)
+}
+
+// lastConsent returns the most recent consent with action, or an empty consent.
+func lastConsent(consents models.SubscriberConsentSlice, action string) *models.SubscriberConsent {
+	for i := len(consents) - 1; i >= 0; i-- {
+		if consents[i].Action == action {
+			return consents[i]
+		}
+	}
+	return &models.SubscriberConsent{}
+}
+
+var subscribersCSVHeader = []string{
+	"email", "name", "locale", "subscribed", "confirmed",
+	"source", "consent_text", "subscribe_addr", "confirm_addr",
+}
+
+func subscribersCSV(subs models.SubscriberSlice) [][]string {
+	recs := make([][]string, 0, len(subs)+1)
+	recs = append(recs, subscribersCSVHeader)
+
+	for _, sub := range subs {
+		var consents models.SubscriberConsentSlice
+		if sub.R != nil {
+			consents = sub.R.SubscriberConsents
+		}
+		subscribed, confirmed := lastConsent(consents, actionSubscribe), lastConsent(consents, actionConfirm)
+
+		recs = append(recs, []string{
+			sub.Email,
+			sub.Name,
+			sub.Locale,
+			sub.CreatedAt.UTC().Format(time.RFC3339),
+			sub.ConfirmedAt.Time.UTC().Format(time.RFC3339),
+			subscribed.Source,
+			subscribed.ConsentText,
+			subscribed.RemoteAddr,
+			confirmed.RemoteAddr,
+		})
+	}
+	return recs
+}
+
+// exportSubscribers returns the confirmed subscribers and their consent record as CSV.
+func (rt *requestTx) exportSubscribers() (*shop.ReportCSV, error) {
+	subs, err := models.Subscribers(
+		models.SubscriberWhere.Status.EQ(models.SubscriberStatusCONFIRMED),
+		qm.Load(models.SubscriberRels.SubscriberConsents, qm.OrderBy(models.SubscriberConsentColumns.ID)),
+		qm.OrderBy(models.SubscriberColumns.ID),
+	).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("exportSubscribers")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	var buf bytes.Buffer
+	if err := csv.NewWriter(&buf).WriteAll(subscribersCSV(subs)); err != nil {
+		rt.Log.WithError(err).Error("csv WriteAll")
+		return nil, status.Error(codes.Internal, errFatal)
+	}
+	return &shop.ReportCSV{
+		Filename: fmt.Sprintf("subscribers_%s.csv", time.Now().Format("20060102")),
+		Data:     buf.Bytes(),
+	}, nil
+}
@@ -0,0 +1,479 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moapis/shop"
+	"github.com/moapis/shop/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testNewsletterSecret = "newsletter-secret"
+
+func Test_parseSubscriptionToken(t *testing.T) {
+	now := time.Now()
+	sign := func(secret string, claims subscriptionClaims) string {
+		token, err := signSubscriptionToken(secret, claims)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+	valid := sign(testNewsletterSecret, subscriptionClaims{actionConfirm, "foo@bar.com", now.Add(time.Hour).Unix()})
+
+	tests := []struct {
+		name    string
+		action  string
+		token   string
+		want    string
+		wantErr bool
+	}{
+		{"Valid", actionConfirm, valid, "foo@bar.com", false},
+		{"No expiry", actionUnsubscribe, sign(testNewsletterSecret, subscriptionClaims{Action: actionUnsubscribe, Email: "foo@bar.com"}), "foo@bar.com", false},
+		{"Mixed case", actionUnsubscribe, sign(testNewsletterSecret, subscriptionClaims{Action: actionUnsubscribe, Email: " Foo@Bar.COM"}), "foo@bar.com", false},
+		{"Wrong action", actionUnsubscribe, valid, "", true},
+		{"Expired", actionConfirm, sign(testNewsletterSecret, subscriptionClaims{actionConfirm, "foo@bar.com", now.Add(-time.Hour).Unix()}), "", true},
+		{"Wrong secret", actionConfirm, sign("other", subscriptionClaims{actionConfirm, "foo@bar.com", now.Add(time.Hour).Unix()}), "", true},
+		{"Tampered", actionConfirm, "e30" + valid[strings.Index(valid, "."):], "", true},
+		{"Malformed", actionConfirm, "foo", "", true},
+		{"Signature encoding", actionConfirm, "foo.!!!", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSubscriptionToken(testNewsletterSecret, tt.action, tt.token, now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseSubscriptionToken() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parseSubscriptionToken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_appendFooter(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{"Body", "<html><body><p>Hello</p></body></html>", "<html><body><p>Hello</p><p>Footer</p></body></html>"},
+		{"No body", "<p>Hello</p>", "<p>Hello</p><p>Footer</p>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := appendFooter(tt.html, "<p>Footer</p>"); got != tt.want {
+				t.Errorf("appendFooter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_shopServer_unsubscribeURL(t *testing.T) {
+	s := &shopServer{conf: &ServerConfig{Newsletter: Default.Newsletter}}
+	got, err := s.unsubscribeURL("foo@bar.com")
+	if err != nil || got != "" {
+		t.Fatalf("shopServer.unsubscribeURL() = %v, %v; want disabled", got, err)
+	}
+
+	s.conf.Newsletter.Secret = testNewsletterSecret
+	if got, err = s.unsubscribeURL("Foo@Bar.com"); err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	email, err := parseSubscriptionToken(testNewsletterSecret, actionUnsubscribe, u.Query().Get("token"), time.Now().Add(365*24*time.Hour))
+	if err != nil || email != "foo@bar.com" {
+		t.Errorf("shopServer.unsubscribeURL() token = %v, %v", email, err)
+	}
+}
+
+func Test_subscribersCSV(t *testing.T) {
+	sub := &models.Subscriber{
+		Email:       "foo@bar.com",
+		Name:        "Muhlemmer",
+		Locale:      "ro",
+		CreatedAt:   time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		ConfirmedAt: null.TimeFrom(time.Date(2020, 1, 2, 4, 4, 5, 0, time.UTC)),
+	}
+	sub.R = sub.R.NewStruct()
+	sub.R.SubscriberConsents = models.SubscriberConsentSlice{
+		{Action: actionSubscribe, Source: "footer", ConsentText: "Yes please", RemoteAddr: "10.0.0.1"},
+		{Action: actionConfirm, RemoteAddr: "10.0.0.2"},
+	}
+
+	got := subscribersCSV(models.SubscriberSlice{sub})
+	want := []string{"foo@bar.com", "Muhlemmer", "ro", "2020-01-02T03:04:05Z", "2020-01-02T04:04:05Z", "footer", "Yes please", "10.0.0.1", "10.0.0.2"}
+	if len(got) != 2 || strings.Join(got[0], ",") != strings.Join(subscribersCSVHeader, ",") || strings.Join(got[1], ",") != strings.Join(want, ",") {
+		t.Errorf("subscribersCSV() = %v, want %v", got, want)
+	}
+}
+
+func Test_newsletterTemplates(t *testing.T) {
+	data := struct {
+		*models.Subscriber
+		URL      string
+		ShopName string
+	}{&models.Subscriber{Name: "Muhlemmer"}, "https://kreativio.ro/confirm?token=abc.def", "Shop & Co"}
+
+	for _, locale := range []string{"en", "ro"} {
+		for _, name := range []string{
+			tss.localTmpl(mailNewsletterConfirm, mailCustomer, locale),
+			tss.localTmpl(mailUnsubscribe, mailFooter, locale),
+		} {
+			t.Run(name, func(t *testing.T) {
+				var html, text bytes.Buffer
+				if err := tss.tmpl.ExecuteTemplate(&html, name, data); err != nil {
+					t.Fatal(err)
+				}
+				if err := tss.text.ExecuteTemplate(&text, name, data); err != nil {
+					t.Fatal(err)
+				}
+				if !strings.Contains(text.String(), data.URL) || !strings.Contains(html.String(), "token=abc.def") {
+					t.Errorf("%s does not contain the URL:\n%s\n%s", name, html.String(), text.String())
+				}
+			})
+		}
+	}
+}
+
+// newsletterServer returns a shop server with the newsletter enabled.
+func newsletterServer(t *testing.T) *shopServer {
+	cc := *testConfig
+	cc.Newsletter.Secret = testNewsletterSecret
+
+	s, err := cc.newShopServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func Test_requestTx_subscribe(t *testing.T) {
+	nts := newsletterServer(t)
+
+	tests := []struct {
+		name     string
+		server   *shopServer
+		existing string
+		req      *shop.Subscription
+		wantMail bool
+		wantErr  error
+	}{
+		{
+			"Disabled",
+			tss,
+			"",
+			&shop.Subscription{Email: "foo@bar.com"},
+			false,
+			status.Error(codes.FailedPrecondition, errNewsletter),
+		},
+		{
+			"Missing email",
+			nts,
+			"",
+			&shop.Subscription{Email: " "},
+			false,
+			status.Errorf(codes.InvalidArgument, errMissing, "Email"),
+		},
+		{
+			"New",
+			nts,
+			"",
+			&shop.Subscription{Email: " Foo@Bar.com", Name: "Muhlemmer", Locale: "ro", Source: "footer"},
+			true,
+			nil,
+		},
+		{
+			"Resubscribe",
+			nts,
+			models.SubscriberStatusUNSUBSCRIBED,
+			&shop.Subscription{Email: "foo@bar.com"},
+			true,
+			nil,
+		},
+		{
+			"Already confirmed",
+			nts,
+			models.SubscriberStatusCONFIRMED,
+			&shop.Subscription{Email: "foo@bar.com"},
+			false,
+			nil,
+		},
+		{
+			"DB Error",
+			nts,
+			"",
+			&shop.Subscription{Email: "foo@bar.com"},
+			false,
+			status.Error(codes.Internal, errDB),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tt.server.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			if tt.existing != "" {
+				if err = (&models.Subscriber{Email: "foo@bar.com", Status: tt.existing}).Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			if err = rt.subscribe(tt.req, "10.0.0.1"); !errors.Is(tt.wantErr, err) {
+				t.Fatalf("requestTx.subscribe() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			sub, err := rt.findSubscriber("foo@bar.com")
+			if err != nil {
+				t.Fatal(err)
+			}
+			n, err := models.Mails(models.MailWhere.Template.IN([]string{"newsletter_confirm.customer.en", "newsletter_confirm.customer.ro"})).Count(rt.Ctx, rt.Tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (n == 1) != tt.wantMail {
+				t.Errorf("requestTx.subscribe() mails = %d, wantMail %v", n, tt.wantMail)
+			}
+			if !tt.wantMail {
+				return
+			}
+			if sub.Status != models.SubscriberStatusPENDING || sub.UnsubscribedAt.Valid {
+				t.Errorf("requestTx.subscribe() subscriber = %+v", sub)
+			}
+			consent, err := sub.SubscriberConsents().One(rt.Ctx, rt.Tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if consent.Action != actionSubscribe || consent.RemoteAddr != "10.0.0.1" || consent.ConsentText != Default.Newsletter.ConsentText {
+				t.Errorf("requestTx.subscribe() consent = %+v", consent)
+			}
+		})
+	}
+}
+
+func Test_requestTx_confirmSubscription(t *testing.T) {
+	nts := newsletterServer(t)
+	token := func(action, email string, exp time.Duration) string {
+		tkn, err := signSubscriptionToken(testNewsletterSecret, subscriptionClaims{action, email, time.Now().Add(exp).Unix()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tkn
+	}
+
+	tests := []struct {
+		name     string
+		server   *shopServer
+		existing string
+		token    string
+		wantErr  error
+	}{
+		{"Disabled", tss, "", "foo", status.Error(codes.FailedPrecondition, errNewsletter)},
+		{"Missing token", nts, "", "", status.Errorf(codes.InvalidArgument, errMissing, "Token")},
+		{"Invalid token", nts, "", token(actionUnsubscribe, "foo@bar.com", time.Hour), status.Error(codes.InvalidArgument, errSubscriptionToken)},
+		{"Expired token", nts, models.SubscriberStatusPENDING, token(actionConfirm, "foo@bar.com", -time.Hour), status.Error(codes.InvalidArgument, errSubscriptionToken)},
+		{"Not found", nts, "", token(actionConfirm, "foo@bar.com", time.Hour), status.Errorf(codes.NotFound, errNotFound, "Subscriber", "Email", "foo@bar.com")},
+		{"Unsubscribed", nts, models.SubscriberStatusUNSUBSCRIBED, token(actionConfirm, "foo@bar.com", time.Hour), status.Error(codes.FailedPrecondition, errUnsubscribed)},
+		{"Pending", nts, models.SubscriberStatusPENDING, token(actionConfirm, "foo@bar.com", time.Hour), nil},
+		{"Confirmed", nts, models.SubscriberStatusCONFIRMED, token(actionConfirm, "foo@bar.com", time.Hour), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tt.server.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			if tt.existing != "" {
+				if err = (&models.Subscriber{Email: "foo@bar.com", Status: tt.existing}).Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := rt.confirmSubscription(tt.token, "10.0.0.2")
+			if !errors.Is(tt.wantErr, err) {
+				t.Fatalf("requestTx.confirmSubscription() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.GetStatus() != shop.Subscriber_CONFIRMED || got.GetEmail() != "foo@bar.com" {
+				t.Errorf("requestTx.confirmSubscription() = %v", got)
+			}
+		})
+	}
+}
+
+func Test_requestTx_unsubscribe(t *testing.T) {
+	nts := newsletterServer(t)
+	unsubURL, err := nts.unsubscribeURL("foo@bar.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(unsubURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := u.Query().Get("token")
+	mixed, err := signSubscriptionToken(testNewsletterSecret, subscriptionClaims{Action: actionUnsubscribe, Email: "Foo@Bar.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		existing string
+		token    string
+		wantErr  error
+	}{
+		{"Invalid token", "", "foo.bar", status.Error(codes.InvalidArgument, errSubscriptionToken)},
+		{"No subscriber", "", token, nil},
+		{"Mixed case", models.SubscriberStatusCONFIRMED, mixed, nil},
+		{"Confirmed", models.SubscriberStatusCONFIRMED, token, nil},
+		{"Unsubscribed", models.SubscriberStatusUNSUBSCRIBED, token, nil},
+		{"DB Error", models.SubscriberStatusCONFIRMED, token, status.Error(codes.Internal, errDB)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := nts.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			if tt.existing != "" {
+				if err = (&models.Subscriber{Email: "foo@bar.com", Status: tt.existing}).Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.unsubscribe(tt.token, "10.0.0.3")
+			if !errors.Is(tt.wantErr, err) {
+				t.Fatalf("requestTx.unsubscribe() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.GetStatus() != shop.Subscriber_UNSUBSCRIBED || got.GetEmail() != "foo@bar.com" {
+				t.Errorf("requestTx.unsubscribe() = %v", got)
+			}
+		})
+	}
+}
+
+func Test_requestTx_queueMail_unsubscribe(t *testing.T) {
+	nts := newsletterServer(t)
+
+	msg := &models.Message{ID: 1, Name: "Muhlemmer", Subject: "Hello world!", Message: "Spanac!"}
+	reply := struct {
+		Message  *models.Message
+		Reply    *models.MessageReply
+		ShopName string
+	}{msg, &models.MessageReply{Body: "Hi!"}, "Shop"}
+
+	tests := []struct {
+		name     string
+		tmpl     string
+		to       []string
+		data     interface{}
+		wantLink bool
+	}{
+		{"Single recipient", MessageReplyTmpl, []string{"foo@bar.com"}, reply, true},
+		{"Multiple recipients", MessageReplyTmpl, []string{"foo@bar.com", "bar@foo.com"}, reply, false},
+		{"Admin mail", MessageMailTmpl, []string{"foo@bar.com"}, msg, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := nts.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			if err = rt.queueMail(tt.tmpl, "Subject", tt.to, tt.data); err != nil {
+				t.Fatal(err)
+			}
+			mail, err := models.Mails().One(rt.Ctx, rt.Tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (mail.UnsubscribeURL != "") != tt.wantLink {
+				t.Errorf("requestTx.queueMail() UnsubscribeURL = %v, wantLink %v", mail.UnsubscribeURL, tt.wantLink)
+			}
+			if tt.wantLink && !strings.Contains(mail.Body, "Unsubscribe") {
+				t.Errorf("requestTx.queueMail() body without footer:\n%s", mail.Body)
+			}
+		})
+	}
+}
+
+func Test_requestTx_exportSubscribers(t *testing.T) {
+	rt, err := tss.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Done()
+
+	for _, sub := range []*models.Subscriber{
+		{Email: "pending@bar.com", Status: models.SubscriberStatusPENDING},
+		{Email: "confirmed@bar.com", Status: models.SubscriberStatusCONFIRMED, ConfirmedAt: null.TimeFrom(time.Now())},
+		{Email: "unsubscribed@bar.com", Status: models.SubscriberStatusUNSUBSCRIBED},
+	} {
+		if err = sub.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+		if err = rt.recordConsent(sub, actionSubscribe, "footer", "Yes", "10.0.0.1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := rt.exportSubscribers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	recs, err := csv.NewReader(bytes.NewReader(got.GetData())).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recs) != 2 || recs[1][0] != "confirmed@bar.com" || recs[1][5] != "footer" {
+		t.Errorf("requestTx.exportSubscribers() = %v", recs)
+	}
+	if !strings.HasPrefix(got.GetFilename(), "subscribers_") {
+		t.Errorf("requestTx.exportSubscribers() filename = %v", got.GetFilename())
+	}
+
+	rt.Done()
+	if _, err = rt.exportSubscribers(); !errors.Is(status.Error(codes.Internal, errDB), err) {
+		t.Errorf("requestTx.exportSubscribers() error = %v", err)
+	}
+}
@@ -0,0 +1,539 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	authmodels "github.com/moapis/authenticator/models"
+	"github.com/moapis/multidb"
+	pg "github.com/moapis/multidb/drivers/postgresql"
+	"github.com/moapis/shop"
+	"github.com/moapis/shop/mobilpay"
+	"github.com/moapis/shop/models"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PrivacyConfig for personal data export, erasure and retention.
+// A zero retention keeps the data forever.
+type PrivacyConfig struct {
+	AuthPG           *pg.Config    `json:"auth_pg"`           // Authenticator database. Accounts are not exported or erased when nil
+	OrderRetention   time.Duration `json:"order_retention"`   // Age of closed orders, before they are anonymized
+	MessageRetention time.Duration `json:"message_retention"` // Age of messages, before they are deleted
+	OutboxRetention  time.Duration `json:"outbox_retention"`  // Age of sent or failed mails and webhook deliveries, before they are deleted
+}
+
+// erasedName replaces the name of anonymized orders and accounts.
+// Anonymized orders are recognized by their empty email.
+const erasedName = "Erased"
+
+// erasedAccountEmail replaces the email of anonymized accounts, which must stay unique.
+const erasedAccountEmail = "erased-%d@invalid"
+
+// orderData is an order in a personal data archive.
+type orderData struct {
+	*models.Order
+	Articles models.OrderArticleSlice  `json:"articles"`
+	Payments models.PaymentStatusSlice `json:"payments"`
+}
+
+// messageData is a message in a personal data archive.
+type messageData struct {
+	*models.Message
+	Replies models.MessageReplySlice `json:"replies"`
+}
+
+// subscriberData is a newsletter subscription in a personal data archive.
+type subscriberData struct {
+	*models.Subscriber
+	Consents models.SubscriberConsentSlice `json:"consents"`
+}
+
+// passkeyData is a WebAuthn credential of an account in a personal data archive.
+// The credential ID and public key are not exported.
+type passkeyData struct {
+	Name       string    `boil:"name" json:"name"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at"`
+	LastUsedAt null.Time `boil:"last_used_at" json:"last_used_at"`
+}
+
+// loginAttemptData is a login attempt of an account in a personal data archive.
+type loginAttemptData struct {
+	CreatedAt  time.Time `boil:"created_at" json:"created_at"`
+	RemoteAddr string    `boil:"remote_addr" json:"remote_addr"`
+	Success    bool      `boil:"success" json:"success"`
+}
+
+// accountData is an authenticator account in a personal data archive.
+type accountData struct {
+	*authmodels.User
+	Groups        []string           `json:"groups"`
+	Audiences     []string           `json:"audiences"`
+	Password      bool               `json:"password"` // Whether a password is set. The hash is never exported.
+	Passkeys      []passkeyData      `json:"passkeys"`
+	TOTP          bool               `json:"totp"` // Whether TOTP is enabled. The secret and recovery codes are never exported.
+	LoginAttempts []loginAttemptData `json:"login_attempts"`
+}
+
+// personalData is the JSON archive returned by ExportPersonalData.
+type personalData struct {
+	Email      string           `json:"email"`
+	Exported   time.Time        `json:"exported"`
+	Orders     []orderData      `json:"orders"`
+	Messages   []messageData    `json:"messages"`
+	Subscriber *subscriberData  `json:"subscriber"`
+	Mails      models.MailSlice `json:"mails"`
+	Account    *accountData     `json:"account"`
+}
+
+// whereEmail selects rows with email in column, ignoring case.
+func whereEmail(column, email string) qm.QueryMod {
+	return qm.Where(fmt.Sprintf("lower(%s) = ?", column), email)
+}
+
+// whereRecipient selects mails with email in their recipients, ignoring case.
+func whereRecipient(email string) qm.QueryMod {
+	return qm.Where("exists (select 1 from unnest(recipients) r where lower(r) = ?)", email)
+}
+
+// whereDeliveryEmail selects webhook deliveries with email in their order or message payload, ignoring case.
+func whereDeliveryEmail(email string) qm.QueryMod {
+	return qm.Where("lower(payload->'data'->>'email') = ?", email)
+}
+
+// authTx begins a transaction on the authenticator database.
+// It returns nil if the database is not configured.
+func (rt *requestTx) authTx(readOnly bool) (*multidb.Tx, error) {
+	if rt.s.authDB == nil {
+		return nil, nil
+	}
+	tx, err := rt.s.authDB.MasterTx(rt.Ctx, &sql.TxOptions{ReadOnly: readOnly})
+	if err != nil {
+		rt.Log.WithError(err).Error("authTx")
+		return nil, status.Error(codes.Unavailable, errDB)
+	}
+	return tx, nil
+}
+
+// accountPasskeysQuery selects the WebAuthn credentials of a user.
+// The authenticator models in use do not cover them yet.
+const accountPasskeysQuery = `select name, created_at, last_used_at from auth.webauthn_credentials
+	where user_id = $1 order by id;`
+
+// accountTOTPQuery selects whether a user has TOTP enabled.
+const accountTOTPQuery = `select exists (select 1 from auth.totp_secrets
+	where user_id = $1 and enabled_at is not null);`
+
+// accountLoginAttemptsQuery selects the recorded login attempts of a user.
+const accountLoginAttemptsQuery = `select created_at, remote_addr, success from auth.login_attempts
+	where user_id = $1 order by id;`
+
+// eraseAccountQueries delete the rows of a user, which are not covered by the authenticator models in use.
+var eraseAccountQueries = []string{
+	`delete from auth.webauthn_credentials where user_id = $1;`,
+	`delete from auth.webauthn_challenges where user_id = $1;`,
+	`delete from auth.totp_secrets where user_id = $1;`,
+	`delete from auth.recovery_codes where user_id = $1;`,
+	`delete from auth.login_attempts where user_id = $1;`,
+	`delete from auth.oidc_codes where user_id = $1;`,
+}
+
+// findAccount returns the authenticator account with email and its relations, or nil if there is none.
+func findAccount(ctx context.Context, exec boil.ContextExecutor, email string) (*authmodels.User, error) {
+	user, err := authmodels.Users(
+		whereEmail(authmodels.UserColumns.Email, email),
+		qm.Load(authmodels.UserRels.Groups),
+		qm.Load(authmodels.UserRels.Audiences),
+		qm.Load(authmodels.UserRels.Password),
+	).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return user, err
+}
+
+func accountModelToData(user *authmodels.User) *accountData {
+	ad := &accountData{
+		User:          user,
+		Groups:        []string{},
+		Audiences:     []string{},
+		Passkeys:      []passkeyData{},
+		LoginAttempts: []loginAttemptData{},
+	}
+	if user.R == nil {
+		return ad
+	}
+	for _, g := range user.R.Groups {
+		ad.Groups = append(ad.Groups, g.Name)
+	}
+	for _, a := range user.R.Audiences {
+		ad.Audiences = append(ad.Audiences, a.Name)
+	}
+	ad.Password = user.R.Password != nil
+	return ad
+}
+
+// exportAccount returns the authenticator account with email, or nil if there is none.
+func (rt *requestTx) exportAccount(email string) (*accountData, error) {
+	tx, err := rt.authTx(true)
+	if tx == nil || err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	user, err := findAccount(rt.Ctx, tx, email)
+	if err != nil {
+		rt.Log.WithError(err).Error("exportAccount")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	if user == nil {
+		return nil, nil
+	}
+
+	ad := accountModelToData(user)
+	if err = queries.Raw(accountPasskeysQuery, user.ID).Bind(rt.Ctx, tx, &ad.Passkeys); err != nil {
+		rt.Log.WithError(err).Error("exportAccount: accountPasskeysQuery")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	if err = queries.Raw(accountTOTPQuery, user.ID).QueryRowContext(rt.Ctx, tx).Scan(&ad.TOTP); err != nil {
+		rt.Log.WithError(err).Error("exportAccount: accountTOTPQuery")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	if err = queries.Raw(accountLoginAttemptsQuery, user.ID).Bind(rt.Ctx, tx, &ad.LoginAttempts); err != nil {
+		rt.Log.WithError(err).Error("exportAccount: accountLoginAttemptsQuery")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	return ad, nil
+}
+
+// collectPersonalData gathers everything held about email.
+func (rt *requestTx) collectPersonalData(email string) (*personalData, error) {
+	pd := &personalData{
+		Email:    email,
+		Exported: time.Now(),
+		Orders:   []orderData{},
+		Messages: []messageData{},
+	}
+
+	orders, err := models.Orders(
+		whereEmail(models.OrderColumns.Email, email),
+		qm.Load(models.OrderRels.OrderArticles, qm.OrderBy(models.OrderArticleColumns.ID)),
+		qm.OrderBy(models.OrderColumns.ID),
+	).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("collectPersonalData: models.Orders")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	for _, o := range orders {
+		od := orderData{Order: o, Articles: models.OrderArticleSlice{}}
+		if o.R != nil && o.R.OrderArticles != nil {
+			od.Articles = o.R.OrderArticles
+		}
+		if od.Payments, err = models.PaymentStatuses(
+			models.PaymentStatusWhere.OrderID.EQ(o.ID),
+			qm.OrderBy(models.PaymentStatusColumns.ID),
+		).All(rt.Ctx, rt.Tx); err != nil {
+			rt.Log.WithError(err).Error("collectPersonalData: models.PaymentStatuses")
+			return nil, status.Error(codes.Internal, errDB)
+		}
+		if od.Payments == nil {
+			od.Payments = models.PaymentStatusSlice{}
+		}
+		pd.Orders = append(pd.Orders, od)
+	}
+
+	msgs, err := models.Messages(
+		whereEmail(models.MessageColumns.Email, email),
+		qm.Load(models.MessageRels.MessageReplies, qm.OrderBy(models.MessageReplyColumns.ID)),
+		qm.OrderBy(models.MessageColumns.ID),
+	).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("collectPersonalData: models.Messages")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	for _, m := range msgs {
+		md := messageData{Message: m, Replies: models.MessageReplySlice{}}
+		if m.R != nil && m.R.MessageReplies != nil {
+			md.Replies = m.R.MessageReplies
+		}
+		pd.Messages = append(pd.Messages, md)
+	}
+
+	sub, err := models.Subscribers(
+		whereEmail(models.SubscriberColumns.Email, email),
+		qm.Load(models.SubscriberRels.SubscriberConsents, qm.OrderBy(models.SubscriberConsentColumns.ID)),
+	).One(rt.Ctx, rt.Tx)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		rt.Log.WithError(err).Error("collectPersonalData: models.Subscribers")
+		return nil, status.Error(codes.Internal, errDB)
+	default:
+		pd.Subscriber = &subscriberData{Subscriber: sub, Consents: models.SubscriberConsentSlice{}}
+		if sub.R != nil && sub.R.SubscriberConsents != nil {
+			pd.Subscriber.Consents = sub.R.SubscriberConsents
+		}
+	}
+
+	if pd.Mails, err = models.Mails(
+		whereRecipient(email),
+		qm.OrderBy(models.MailColumns.ID),
+	).All(rt.Ctx, rt.Tx); err != nil {
+		rt.Log.WithError(err).Error("collectPersonalData: models.Mails")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	if pd.Mails == nil {
+		pd.Mails = models.MailSlice{}
+	}
+
+	if pd.Account, err = rt.exportAccount(email); err != nil {
+		return nil, err
+	}
+	return pd, nil
+}
+
+// exportPersonalData returns the personal data archive of email as JSON.
+func (rt *requestTx) exportPersonalData(email string) (*shop.PersonalDataArchive, error) {
+	email = normalizeEmail(email)
+	if email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, errMissing, "Email")
+	}
+
+	pd, err := rt.collectPersonalData(email)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.MarshalIndent(pd, "", "  ")
+	if err != nil {
+		rt.Log.WithError(err).Error("exportPersonalData: json.MarshalIndent")
+		return nil, status.Error(codes.Internal, errFatal)
+	}
+	rt.Log.WithFields(logrus.Fields{"event": "personal_data.exported", "email": email, "orders": len(pd.Orders), "messages": len(pd.Messages)}).Info("Personal data exported")
+
+	return &shop.PersonalDataArchive{
+		Filename: fmt.Sprintf("personal_data_%s.json", pd.Exported.Format("20060102")),
+		Data:     data,
+	}, nil
+}
+
+// anonymizeOrders removes the personal data from the selected orders and their payment confirmations.
+// The articles, amounts, status and dates are kept for accounting.
+func (rt *requestTx) anonymizeOrders(qms ...qm.QueryMod) (orders, payments int, err error) {
+	sel, err := models.Orders(append(qms,
+		qm.Select(models.OrderColumns.ID),
+		models.OrderWhere.Email.NEQ(""),
+		qm.For("update"),
+	)...).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("anonymizeOrders: models.Orders")
+		return 0, 0, status.Error(codes.Internal, errDB)
+	}
+	if len(sel) == 0 {
+		return 0, 0, nil
+	}
+
+	ids := make([]int, len(sel))
+	for i, o := range sel {
+		ids[i] = o.ID
+	}
+	n, err := sel.UpdateAll(rt.Ctx, rt.Tx, models.M{
+		models.OrderColumns.UpdatedAt:      time.Now(),
+		models.OrderColumns.FullName:       erasedName,
+		models.OrderColumns.Email:          "",
+		models.OrderColumns.Phone:          "",
+		models.OrderColumns.FullAddress:    "",
+		models.OrderColumns.Message:        "",
+		models.OrderColumns.TrackingNumber: "",
+		models.OrderColumns.TrackingURL:    "",
+	})
+	if err != nil {
+		rt.Log.WithError(err).Error("anonymizeOrders: UpdateAll")
+		return 0, 0, status.Error(codes.Internal, errDB)
+	}
+
+	pss, err := models.PaymentStatuses(models.PaymentStatusWhere.OrderID.IN(ids)).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("anonymizeOrders: models.PaymentStatuses")
+		return 0, 0, status.Error(codes.Internal, errDB)
+	}
+	for _, ps := range pss {
+		ps.ConfirmationXML = mobilpay.RedactConfirmation(ps.ConfirmationXML)
+		if _, err = ps.Update(rt.Ctx, rt.Tx, boil.Whitelist(models.PaymentStatusColumns.ConfirmationXML)); err != nil {
+			rt.Log.WithError(err).Error("anonymizeOrders: Update payment status")
+			return 0, 0, status.Error(codes.Internal, errDB)
+		}
+	}
+
+	for _, id := range ids {
+		rt.Log.WithFields(logrus.Fields{"event": "order.anonymized", "order_id": id}).Info("Order anonymized")
+	}
+	return int(n), len(pss), nil
+}
+
+// eraseAccount anonymizes the authenticator account with email, in its own transaction.
+// Its password, passkeys, TOTP secret, recovery codes, login attempts, OpenID Connect codes, groups and audiences are removed,
+// so it can no longer be used.
+func (rt *requestTx) eraseAccount(email string) (bool, error) {
+	tx, err := rt.authTx(false)
+	if tx == nil || err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	user, err := findAccount(rt.Ctx, tx, email)
+	if err == nil && user == nil {
+		return false, nil
+	}
+	if err == nil && user.R.Password != nil {
+		_, err = user.R.Password.Delete(rt.Ctx, tx)
+	}
+	for _, q := range eraseAccountQueries {
+		if err == nil {
+			_, err = queries.Raw(q, user.ID).ExecContext(rt.Ctx, tx)
+		}
+	}
+	if err == nil {
+		err = user.RemoveGroups(rt.Ctx, tx, user.R.Groups...)
+	}
+	if err == nil {
+		err = user.RemoveAudiences(rt.Ctx, tx, user.R.Audiences...)
+	}
+	if err == nil {
+		user.Email, user.Name = fmt.Sprintf(erasedAccountEmail, user.ID), erasedName
+		_, err = user.Update(rt.Ctx, tx, boil.Whitelist(
+			authmodels.UserColumns.UpdatedAt,
+			authmodels.UserColumns.Email,
+			authmodels.UserColumns.Name,
+		))
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		rt.Log.WithError(err).Error("eraseAccount")
+		return false, status.Error(codes.Internal, errDB)
+	}
+
+	rt.Log.WithFields(logrus.Fields{"event": "account.anonymized", "user_id": user.ID}).Info("Account anonymized")
+	return true, nil
+}
+
+// erasePersonalData anonymizes the orders and account of email,
+// and deletes its messages, mails, webhook deliveries and newsletter subscription.
+// The account is erased in its own transaction, which is committed first.
+func (rt *requestTx) erasePersonalData(email string) (*shop.ErasureReport, error) {
+	email = normalizeEmail(email)
+	if email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, errMissing, "Email")
+	}
+	report := &shop.ErasureReport{Email: email}
+
+	account, err := rt.eraseAccount(email)
+	if err != nil {
+		return nil, err
+	}
+	report.Account = account
+
+	orders, payments, err := rt.anonymizeOrders(whereEmail(models.OrderColumns.Email, email))
+	if err != nil {
+		return nil, err
+	}
+	report.Orders, report.Payments = int32(orders), int32(payments)
+
+	deletes := []struct {
+		table string
+		del   deleteAllFunc
+		n     *int32
+	}{
+		{"messages", models.Messages(whereEmail(models.MessageColumns.Email, email)).DeleteAll, &report.Messages},
+		{"mails", models.Mails(whereRecipient(email)).DeleteAll, &report.Mails},
+		{"webhook_deliveries", models.WebhookDeliveries(whereDeliveryEmail(email)).DeleteAll, &report.WebhookDeliveries},
+	}
+	for _, d := range deletes {
+		n, err := rt.deleteRows(d.table, d.del)
+		if err != nil {
+			return nil, err
+		}
+		*d.n = int32(n)
+	}
+
+	n, err := rt.deleteRows("subscribers", models.Subscribers(whereEmail(models.SubscriberColumns.Email, email)).DeleteAll)
+	if err != nil {
+		return nil, err
+	}
+	report.Subscriber = n > 0
+
+	rt.Log.WithFields(logrus.Fields{"event": "personal_data.erased", "email": email, "report": report}).Info("Personal data erased")
+	return report, nil
+}
+
+// applyRetention anonymizes closed orders and deletes messages, mails and webhook deliveries,
+// which are older than their configured retention.
+func (rt *requestTx) applyRetention() error {
+	conf := rt.s.conf.Privacy
+	now := time.Now()
+
+	if conf.OrderRetention > 0 {
+		orders, _, err := rt.anonymizeOrders(
+			models.OrderWhere.Status.NEQ(models.StatusOPEN),
+			models.OrderWhere.CreatedAt.LT(now.Add(-conf.OrderRetention)),
+		)
+		if err != nil {
+			return err
+		}
+		rt.Log.WithField("orders", orders).Debug("applyRetention: orders")
+	}
+
+	if conf.MessageRetention > 0 {
+		if _, err := rt.deleteRows("messages", models.Messages(
+			models.MessageWhere.CreatedAt.LT(now.Add(-conf.MessageRetention)),
+		).DeleteAll); err != nil {
+			return err
+		}
+	}
+	if conf.OutboxRetention > 0 {
+		before := now.Add(-conf.OutboxRetention)
+		if _, err := rt.deleteRows("mails", models.Mails(
+			models.MailWhere.NextAttemptAt.IsNull(),
+			models.MailWhere.CreatedAt.LT(before),
+		).DeleteAll); err != nil {
+			return err
+		}
+		if _, err := rt.deleteRows("webhook_deliveries", models.WebhookDeliveries(
+			models.WebhookDeliveryWhere.NextAttemptAt.IsNull(),
+			models.WebhookDeliveryWhere.CreatedAt.LT(before),
+		).DeleteAll); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteAllFunc is the DeleteAll method of a models query.
+type deleteAllFunc func(context.Context, boil.ContextExecutor) (int64, error)
+
+// deleteRows calls del and logs the amount of rows deleted from table.
+func (rt *requestTx) deleteRows(table string, del deleteAllFunc) (int64, error) {
+	n, err := del(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).WithField("table", table).Error("deleteRows")
+		return 0, status.Error(codes.Internal, errDB)
+	}
+	if n > 0 {
+		rt.Log.WithFields(logrus.Fields{"event": "rows.deleted", "table": table, "rows": n}).Info("Personal data deleted")
+	}
+	return n, nil
+}
@@ -0,0 +1,323 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moapis/shop"
+	"github.com/moapis/shop/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	testPersonalEmail = "gdpr@example.com"
+	testConfirmation  = `<mobilpay timestamp="20200801120000" crc="abc"><action>confirmed</action>` +
+		`<customer type="person"><first_name>Foo</first_name><last_name>Bar</last_name><address>Long Street 7</address>` +
+		`<email>gdpr@example.com</email><mobile_phone>0700000000</mobile_phone></customer>` +
+		`<original_amount>10.00</original_amount><processed_amount>10.00</processed_amount><pan_masked>4****1234</pan_masked></mobilpay>`
+)
+
+// insertPersonalData inserts an order, message, subscriber, mail and webhook delivery for email,
+// all created at the given time.
+func insertPersonalData(rt *requestTx, email string, created time.Time) (*models.Order, error) {
+	order := &models.Order{
+		CreatedAt:      created,
+		FullName:       "Foo Bar",
+		Email:          strings.ToUpper(email),
+		Phone:          "0700000000",
+		FullAddress:    "Long Street 7",
+		Message:        "Ring twice",
+		PaymentMethod:  models.PaymentONLINE,
+		Status:         models.StatusCOMPLETED,
+		Locale:         "en",
+		TrackingNumber: "TN1",
+	}
+	if err := order.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+	if err := order.AddOrderArticles(rt.Ctx, rt.Tx, true, &models.OrderArticle{
+		ArticleID: 13,
+		Amount:    2,
+		Title:     "ID 13",
+		Price:     testOrderArticles[2].Price,
+	}); err != nil {
+		return nil, err
+	}
+	ps := &models.PaymentStatus{OrderID: order.ID, ConfirmationXML: testConfirmation, Status: paymentConfirmed}
+	if err := ps.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	msg := &models.Message{CreatedAt: created, Name: "Foo Bar", Email: email, Subject: "Hello", Message: "Spanac!", RemoteAddr: "10.0.0.1"}
+	if err := msg.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+	if err := msg.AddMessageReplies(rt.Ctx, rt.Tx, true, &models.MessageReply{Author: "admin", Body: "Hi!"}); err != nil {
+		return nil, err
+	}
+
+	sub := &models.Subscriber{Email: email, Name: "Foo Bar", Status: models.SubscriberStatusCONFIRMED}
+	if err := sub.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+	if err := rt.recordConsent(sub, actionSubscribe, sourceCheckout, "Yes please", "10.0.0.1"); err != nil {
+		return nil, err
+	}
+
+	mail := &models.Mail{CreatedAt: created, Template: "message_reply", Subject: "Hi", Recipients: types.StringArray{email}, Body: "Hi!", SentAt: null.TimeFrom(created)}
+	if err := mail.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	hook := &models.Webhook{URL: "https://hooks.example.com", Secret: "secret", Events: types.StringArray{eventOrderCreated}, Active: true}
+	if err := hook.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+	payload, _ := json.Marshal(map[string]interface{}{
+		"event": eventOrderCreated,
+		"data":  map[string]interface{}{"id": order.ID, "email": email},
+	})
+	del := &models.WebhookDelivery{CreatedAt: created, WebhookID: hook.ID, Event: eventOrderCreated, Payload: payload, DeliveredAt: null.TimeFrom(created)}
+	if err := del.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func Test_requestTx_exportPersonalData(t *testing.T) {
+	tests := []struct {
+		name         string
+		email        string
+		wantOrders   int
+		wantMessages int
+		wantErr      error
+	}{
+		{"Missing email", " ", 0, 0, status.Errorf(codes.InvalidArgument, errMissing, "Email")},
+		{"Unknown", "nobody@example.com", 0, 0, nil},
+		{"Success", " GDPR@Example.com", 1, 1, nil},
+		{"DB Error", testPersonalEmail, 0, 0, status.Error(codes.Internal, errDB)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			if _, err = insertPersonalData(rt, testPersonalEmail, time.Now()); err != nil {
+				t.Fatal(err)
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.exportPersonalData(tt.email)
+			if !errors.Is(tt.wantErr, err) {
+				t.Fatalf("requestTx.exportPersonalData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			var pd struct {
+				Email      string
+				Orders     []map[string]interface{}
+				Messages   []map[string]interface{}
+				Subscriber map[string]interface{}
+				Mails      []map[string]interface{}
+				Account    map[string]interface{}
+			}
+			if err = json.Unmarshal(got.GetData(), &pd); err != nil {
+				t.Fatal(err)
+			}
+			if len(pd.Orders) != tt.wantOrders || len(pd.Messages) != tt.wantMessages || pd.Account != nil {
+				t.Errorf("requestTx.exportPersonalData() =\n%s", got.GetData())
+			}
+			if tt.wantOrders > 0 {
+				if pd.Email != testPersonalEmail || pd.Subscriber == nil || len(pd.Mails) != 1 {
+					t.Errorf("requestTx.exportPersonalData() =\n%s", got.GetData())
+				}
+				for _, key := range []string{"full_address", "articles", "payments"} {
+					if _, ok := pd.Orders[0][key]; !ok {
+						t.Errorf("requestTx.exportPersonalData() order without %s", key)
+					}
+				}
+				if _, ok := pd.Subscriber["consents"]; !ok {
+					t.Errorf("requestTx.exportPersonalData() subscriber without consents")
+				}
+			}
+		})
+	}
+}
+
+func Test_requestTx_erasePersonalData(t *testing.T) {
+	tests := []struct {
+		name    string
+		email   string
+		want    *shop.ErasureReport
+		wantErr error
+	}{
+		{"Missing email", "", nil, status.Errorf(codes.InvalidArgument, errMissing, "Email")},
+		{"Unknown", "nobody@example.com", &shop.ErasureReport{Email: "nobody@example.com"}, nil},
+		{
+			"Success",
+			"GDPR@example.com",
+			&shop.ErasureReport{
+				Email:             testPersonalEmail,
+				Orders:            1,
+				Payments:          1,
+				Messages:          1,
+				Mails:             1,
+				WebhookDeliveries: 1,
+				Subscriber:        true,
+			},
+			nil,
+		},
+		{"DB Error", testPersonalEmail, nil, status.Error(codes.Internal, errDB)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			order, err := insertPersonalData(rt, testPersonalEmail, time.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.erasePersonalData(tt.email)
+			if !errors.Is(tt.wantErr, err) {
+				t.Fatalf("requestTx.erasePersonalData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("requestTx.erasePersonalData() = %v, want %v", got, tt.want)
+			}
+			if tt.name != "Success" {
+				return
+			}
+
+			if err = order.Reload(rt.Ctx, rt.Tx); err != nil {
+				t.Fatal(err)
+			}
+			if order.Email != "" || order.FullName != erasedName || order.FullAddress != "" || order.Phone != "" || order.Message != "" || order.TrackingNumber != "" {
+				t.Errorf("requestTx.erasePersonalData() order = %+v", order)
+			}
+			if n, err := order.OrderArticles().Count(rt.Ctx, rt.Tx); err != nil || n != 1 {
+				t.Errorf("requestTx.erasePersonalData() order articles = %d, %v", n, err)
+			}
+			ps, err := models.PaymentStatuses(models.PaymentStatusWhere.OrderID.EQ(order.ID)).One(rt.Ctx, rt.Tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Contains(ps.ConfirmationXML, "Foo") || strings.Contains(ps.ConfirmationXML, testPersonalEmail) || !strings.Contains(ps.ConfirmationXML, "10.00") {
+				t.Errorf("requestTx.erasePersonalData() confirmation = %s", ps.ConfirmationXML)
+			}
+
+			// Erasure is idempotent
+			again, err := rt.erasePersonalData(tt.email)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := (&shop.ErasureReport{Email: testPersonalEmail}); !proto.Equal(again, want) {
+				t.Errorf("requestTx.erasePersonalData() again = %v, want %v", again, want)
+			}
+		})
+	}
+}
+
+func Test_requestTx_applyRetention(t *testing.T) {
+	enabled := *tss.conf
+	enabled.Privacy = PrivacyConfig{
+		OrderRetention:   365 * 24 * time.Hour,
+		MessageRetention: 365 * 24 * time.Hour,
+		OutboxRetention:  30 * 24 * time.Hour,
+	}
+	disabled := *tss.conf
+	disabled.Privacy = PrivacyConfig{}
+
+	tests := []struct {
+		name       string
+		conf       *ServerConfig
+		age        time.Duration
+		status     string
+		wantErased bool // Order anonymized
+		wantDelete bool // Message and mail deleted
+		wantErr    error
+	}{
+		{"Disabled", &disabled, 2 * 365 * 24 * time.Hour, models.StatusCOMPLETED, false, false, nil},
+		{"Recent", &enabled, 24 * time.Hour, models.StatusCOMPLETED, false, false, nil},
+		{"Open", &enabled, 2 * 365 * 24 * time.Hour, models.StatusOPEN, false, true, nil},
+		{"Expired", &enabled, 2 * 365 * 24 * time.Hour, models.StatusCOMPLETED, true, true, nil},
+		{"DB Error", &enabled, 2 * 365 * 24 * time.Hour, models.StatusCOMPLETED, false, false, status.Error(codes.Internal, errDB)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := tss.conf
+			tss.conf = tt.conf
+			defer func() { tss.conf = conf }()
+
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			order, err := insertPersonalData(rt, testPersonalEmail, time.Now().Add(-tt.age))
+			if err != nil {
+				t.Fatal(err)
+			}
+			order.Status = tt.status
+			if _, err = order.Update(rt.Ctx, rt.Tx, boil.Whitelist(models.OrderColumns.Status)); err != nil {
+				t.Fatal(err)
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			if err = rt.applyRetention(); !errors.Is(tt.wantErr, err) {
+				t.Fatalf("requestTx.applyRetention() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if err = order.Reload(rt.Ctx, rt.Tx); err != nil {
+				t.Fatal(err)
+			}
+			if (order.Email == "") != tt.wantErased {
+				t.Errorf("requestTx.applyRetention() order = %+v, wantErased %v", order, tt.wantErased)
+			}
+			msgs, err := models.Messages(whereEmail(models.MessageColumns.Email, testPersonalEmail)).Count(rt.Ctx, rt.Tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			mails, err := models.Mails(whereRecipient(testPersonalEmail)).Count(rt.Ctx, rt.Tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (msgs == 0) != tt.wantDelete || (mails == 0) != tt.wantDelete {
+				t.Errorf("requestTx.applyRetention() messages = %d, mails = %d, wantDelete %v", msgs, mails, tt.wantDelete)
+			}
+		})
+	}
+}
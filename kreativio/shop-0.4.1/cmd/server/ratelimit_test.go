@@ -0,0 +1,167 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/moapis/shop"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func Test_rateSubject(t *testing.T) {
+	tests := []struct {
+		name string
+		req  interface{}
+		want string
+	}{
+		{"Order", &shop.Order{Email: " Foo@Bar.com"}, "foo@bar.com"},
+		{"Message", &shop.Message{Email: "foo@bar.com"}, "foo@bar.com"},
+		{"No subject", &shop.ArticleID{Id: 1}, ""},
+		{"Nil", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rateSubject(tt.req); got != tt.want {
+				t.Errorf("rateSubject() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_rateKey(t *testing.T) {
+	got := rateKey("Checkout", "peer", "10.0.0.1")
+	if !strings.HasPrefix(got, "Checkout:peer:") {
+		t.Errorf("rateKey() = %v, want prefix %v", got, "Checkout:peer:")
+	}
+	if strings.Contains(got, "10.0.0.1") {
+		t.Errorf("rateKey() = %v, contains the plain value", got)
+	}
+	if other := rateKey("Checkout", "peer", "10.0.0.2"); other == got {
+		t.Errorf("rateKey() = %v, same for different values", other)
+	}
+}
+
+func Test_rateLimitError(t *testing.T) {
+	err := rateLimitError(90 * time.Second)
+	st := status.Convert(err)
+	if st.Code() != codes.ResourceExhausted {
+		t.Fatalf("rateLimitError() code = %v, want %v", st.Code(), codes.ResourceExhausted)
+	}
+	details := st.Details()
+	if len(details) != 1 {
+		t.Fatalf("rateLimitError() details = %v, want 1 RetryInfo", details)
+	}
+	ri, ok := details[0].(*errdetails.RetryInfo)
+	if !ok {
+		t.Fatalf("rateLimitError() details = %T, want %T", details[0], ri)
+	}
+	if d, _ := ptypes.Duration(ri.GetRetryDelay()); d != 90*time.Second {
+		t.Errorf("rateLimitError() RetryDelay = %v, want %v", d, 90*time.Second)
+	}
+}
+
+func Test_shopServer_checkRateLimit(t *testing.T) {
+	cc := *tss.conf
+	cc.RateLimits = RateLimitConfig{
+		Methods: map[string]MethodRateLimits{
+			"Checkout": {
+				Peer:    TokenBucket{Burst: 4, Interval: time.Hour},
+				Subject: TokenBucket{Burst: 2, Interval: time.Hour},
+			},
+			"SendMessage": {
+				Peer: TokenBucket{Burst: 1, Interval: time.Hour},
+			},
+		},
+	}
+	conf := tss.conf
+	tss.conf = &cc
+	defer func() { tss.conf = conf }()
+
+	// Unique per test run, as the buckets are committed.
+	addr := net.IPv4(10, 1, byte(time.Now().UnixNano()>>8), byte(time.Now().UnixNano()))
+	pctx := peer.NewContext(testCtx, &peer.Peer{Addr: &net.TCPAddr{IP: addr, Port: 1234}})
+	email := time.Now().Format(time.RFC3339Nano) + "@ratelimit.test"
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		method   string
+		req      interface{}
+		wantCode codes.Code
+	}{
+		{"Unlisted method", pctx, "ListArticles", &shop.ListConditions{}, codes.OK},
+		{"First", pctx, "Checkout", &shop.Order{Email: email}, codes.OK},
+		{"Second", pctx, "Checkout", &shop.Order{Email: email}, codes.OK},
+		{"Subject exhausted", pctx, "Checkout", &shop.Order{Email: email}, codes.ResourceExhausted},
+		{"Other subject", pctx, "Checkout", &shop.Order{Email: "other." + email}, codes.OK},
+		{"Peer exhausted", pctx, "Checkout", &shop.Order{Email: "another." + email}, codes.ResourceExhausted},
+		{"Other method", pctx, "SendMessage", &shop.Message{Email: email}, codes.OK},
+		{"Other method exhausted", pctx, "SendMessage", &shop.Message{Email: email}, codes.ResourceExhausted},
+		{"No peer", testCtx, "SendMessage", &shop.Message{Email: email}, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tss.checkRateLimit(tt.ctx, tt.method, tt.req)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("shopServer.checkRateLimit() error = %v, wantCode %v", err, tt.wantCode)
+			}
+		})
+	}
+
+	t.Run("Interceptor", func(t *testing.T) {
+		info := &grpc.UnaryServerInfo{FullMethod: "/shop.Shop/SendMessage"}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) { return req, nil }
+		if _, err := tss.unaryRateLimitInterceptor(pctx, &shop.Message{}, info, handler); status.Code(err) != codes.ResourceExhausted {
+			t.Errorf("shopServer.unaryRateLimitInterceptor() error = %v, wantCode %v", err, codes.ResourceExhausted)
+		}
+	})
+
+	t.Run("DB error", func(t *testing.T) {
+		ectx, cancel := context.WithCancel(pctx)
+		cancel()
+		if err := tss.checkRateLimit(ectx, "SendMessage", &shop.Message{}); err != nil {
+			t.Errorf("shopServer.checkRateLimit() error = %v, want fail open", err)
+		}
+	})
+}
+
+func Test_requestTx_purgeRateLimits(t *testing.T) {
+	rt, err := tss.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Done()
+
+	key := rateKey("testing", "purge", time.Now().String())
+	if _, err = rt.Tx.ExecContext(rt.Ctx, "insert into shop.rate_limits (key, tokens, full_at) values ($1, 1, now() - interval '1 second');", key); err != nil {
+		t.Fatal(err)
+	}
+	if err = rt.purgeRateLimits(); err != nil {
+		t.Errorf("requestTx.purgeRateLimits() error = %v", err)
+	}
+
+	var n int
+	if err = rt.Tx.QueryRowContext(rt.Ctx, "select count(*) from shop.rate_limits where key = $1;", key).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Errorf("requestTx.purgeRateLimits() left %d rows", n)
+	}
+
+	rt.Done()
+	if err = rt.purgeRateLimits(); status.Code(err) != codes.Internal {
+		t.Errorf("requestTx.purgeRateLimits() error = %v, wantCode %v", err, codes.Internal)
+	}
+}
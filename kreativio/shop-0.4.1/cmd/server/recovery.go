@@ -0,0 +1,303 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"html/template"
+	"net/http"
+	"time"
+
+	"github.com/moapis/shop/mobilpay"
+	"github.com/moapis/shop/models"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecoveryConfig for unpaid online orders.
+type RecoveryConfig struct {
+	Secret        string        `json:"secret"`         // HMAC key for payment links. Empty disables the recovery job
+	PaymentURL    string        `json:"payment_url"`    // Public URL of PaymentPath on the HTTP server
+	ReminderAfter time.Duration `json:"reminder_after"` // Time after checkout, before the payment reminder is sent
+	CancelAfter   time.Duration `json:"cancel_after"`   // Time after checkout, before the unpaid order is cancelled
+}
+
+// PaymentPath on the HTTP server, which sends the customer to Mobilpay with a freshly encrypted order.
+const PaymentPath = "/pay/order"
+
+const mailPaymentReminder = "payment_reminder"
+
+const (
+	errPaymentLink    = "Invalid or expired payment link"
+	errNotPayable     = "This order can no longer be paid"
+	errPaymentMissing = "Payment is not available, try again later"
+)
+
+// paidStatuses of payment_status, for which an order is not abandoned.
+// The pending statuses are still being reviewed by Mobilpay.
+var paidStatuses = types.StringArray{"paid", "confirmed", "paid_pending", "confirmed_pending"}
+
+type paymentClaims struct {
+	Order   int   `json:"o"`
+	Expires int64 `json:"x"` // Unix time
+}
+
+// paymentURL returns the payment link for order, valid until the order is cancelled.
+func (s *shopServer) paymentURL(order *models.Order) (string, error) {
+	conf := s.conf.Recovery
+	token, err := signToken(conf.Secret, paymentClaims{
+		Order:   order.ID,
+		Expires: order.CreatedAt.Add(conf.CancelAfter).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return tokenURL(conf.PaymentURL, token)
+}
+
+// parsePaymentToken verifies token and returns the order ID from the claims.
+func parsePaymentToken(secret, token string, now time.Time) (int, error) {
+	var claims paymentClaims
+	if err := parseToken(secret, token, &claims); err != nil {
+		return 0, err
+	}
+	if now.Unix() > claims.Expires {
+		return 0, errTokenExpired
+	}
+	return claims.Order, nil
+}
+
+// unpaidOrders returns the qms selecting open online orders without paid status, created before the given time.
+func unpaidOrders(before time.Time, qms ...qm.QueryMod) []qm.QueryMod {
+	return append([]qm.QueryMod{
+		models.OrderWhere.PaymentMethod.EQ(models.PaymentONLINE),
+		models.OrderWhere.Status.EQ(models.StatusOPEN),
+		models.OrderWhere.CreatedAt.LT(before),
+		qm.Where("not exists (select 1 from shop.payment_status ps where ps.order_id = orders.id and ps.status = any(?))", paidStatuses),
+	}, qms...)
+}
+
+// recoverCheckouts reminds customers of unpaid online orders with a payment link,
+// and cancels those orders when they remain unpaid.
+func (rt *requestTx) recoverCheckouts() error {
+	if rt.s.conf.Recovery.Secret == "" {
+		rt.Log.Debug("recoverCheckouts: not configured")
+		return nil
+	}
+	if err := rt.cancelUnpaidOrders(); err != nil {
+		return err
+	}
+	return rt.remindUnpaidOrders()
+}
+
+// paymentReminderData is passed to the payment reminder mail and subject templates.
+type paymentReminderData struct {
+	orderMailData
+	PaymentURL string
+}
+
+// remindUnpaidOrders queues a payment reminder for unpaid orders past ReminderAfter, once.
+func (rt *requestTx) remindUnpaidOrders() error {
+	conf := rt.s.conf.Recovery
+
+	orders, err := models.Orders(unpaidOrders(time.Now().Add(-conf.ReminderAfter),
+		models.OrderWhere.PaymentRemindedAt.IsNull(),
+		qm.OrderBy(models.OrderColumns.ID),
+		qm.For("update skip locked"),
+	)...).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("remindUnpaidOrders: models.Orders")
+		return status.Error(codes.Internal, errDB)
+	}
+	if len(orders) == 0 {
+		rt.Log.Debug("remindUnpaidOrders: nothing to do")
+		return nil
+	}
+
+	for _, order := range orders {
+		link, err := rt.s.paymentURL(order)
+		if err != nil {
+			rt.Log.WithError(err).WithField("order_id", order.ID).Error("remindUnpaidOrders: paymentURL")
+			return status.Error(codes.Internal, errFatal)
+		}
+		data, err := rt.newOrderMailData(order)
+		if err != nil {
+			return err
+		}
+		tmpl := rt.s.localTmpl(mailPaymentReminder, mailCustomer, order.Locale)
+		if err = rt.queueLocalMail(tmpl, []string{order.Email}, paymentReminderData{data, link}); err != nil {
+			return err
+		}
+
+		order.PaymentRemindedAt = null.TimeFrom(time.Now())
+		if _, err = order.Update(rt.Ctx, rt.Tx, boil.Whitelist(
+			models.OrderColumns.UpdatedAt,
+			models.OrderColumns.PaymentRemindedAt,
+		)); err != nil {
+			rt.Log.WithError(err).Error("remindUnpaidOrders: Update")
+			return status.Error(codes.Internal, errDB)
+		}
+		rt.Log.WithFields(logrus.Fields{"event": "order.payment_reminded", "order_id": order.ID}).Info("Payment reminder queued")
+	}
+	return nil
+}
+
+// releaseStock adds the amounts of the order articles back to the stock of tracked variants.
+const releaseStock = `update shop.variants v set stock = v.stock + oa.amount
+from (
+	select variant_id, sum(amount) as amount from shop.order_articles
+	where order_id = $1 and variant_id is not null group by variant_id
+) oa
+where v.id = oa.variant_id and v.stock is not null;`
+
+// releaseOrderStock returns the stock reserved on Checkout.
+func (rt *requestTx) releaseOrderStock(orderID int) error {
+	res, err := queries.Raw(releaseStock, orderID).ExecContext(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).WithField("order_id", orderID).Error("releaseOrderStock")
+		return status.Error(codes.Internal, errDB)
+	}
+	n, _ := res.RowsAffected()
+	rt.Log.WithFields(logrus.Fields{"order_id": orderID, "variants": n}).Debug("releaseOrderStock")
+	return nil
+}
+
+// orderStock sums the amounts of the order articles per variant, ordered by variant.
+const orderStock = `select variant_id, sum(amount) as amount from shop.order_articles
+where order_id = $1 and variant_id is not null
+group by variant_id order by variant_id;`
+
+// reserveOrderStock reserves the stock of a cancelled order again, when it is reopened.
+// A FailedPrecondition error is returned if a tracked variant has not enough stock left.
+func (rt *requestTx) reserveOrderStock(orderID int) error {
+	var amounts []struct {
+		VariantID int64 `boil:"variant_id"`
+		Amount    int   `boil:"amount"`
+	}
+	if err := queries.Raw(orderStock, orderID).Bind(rt.Ctx, rt.Tx, &amounts); err != nil {
+		rt.Log.WithError(err).WithField("order_id", orderID).Error("reserveOrderStock")
+		return status.Error(codes.Internal, errDB)
+	}
+	for _, a := range amounts {
+		if err := rt.reserveVariantStock(a.VariantID, a.Amount); err != nil {
+			return err
+		}
+	}
+	rt.Log.WithFields(logrus.Fields{"order_id": orderID, "variants": len(amounts)}).Debug("reserveOrderStock")
+	return nil
+}
+
+// cancelUnpaidOrders cancels unpaid orders past CancelAfter and releases their stock.
+func (rt *requestTx) cancelUnpaidOrders() error {
+	orders, err := models.Orders(unpaidOrders(time.Now().Add(-rt.s.conf.Recovery.CancelAfter),
+		qm.OrderBy(models.OrderColumns.ID),
+		qm.For("update skip locked"),
+	)...).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("cancelUnpaidOrders: models.Orders")
+		return status.Error(codes.Internal, errDB)
+	}
+	if len(orders) == 0 {
+		rt.Log.Debug("cancelUnpaidOrders: nothing to do")
+		return nil
+	}
+
+	for _, order := range orders {
+		order.Status = models.StatusCANCELLED
+		if _, err = order.Update(rt.Ctx, rt.Tx, boil.Whitelist(
+			models.OrderColumns.UpdatedAt,
+			models.OrderColumns.Status,
+		)); err != nil {
+			rt.Log.WithError(err).Error("cancelUnpaidOrders: Update")
+			return status.Error(codes.Internal, errDB)
+		}
+		if err = rt.releaseOrderStock(order.ID); err != nil {
+			return err
+		}
+		if err = rt.queueOrderWebhooks(eventOrderStatusChanged, order.ID); err != nil {
+			return err
+		}
+		if err = rt.queueStatusMails(order); err != nil {
+			return err
+		}
+		rt.Log.WithFields(logrus.Fields{"event": "order.abandoned", "order_id": order.ID}).Info("Unpaid order cancelled")
+	}
+	return nil
+}
+
+// payableOrder returns the order if it is an open online order without paid status.
+func (rt *requestTx) payableOrder(id int) (*models.Order, error) {
+	order, err := models.Orders(unpaidOrders(time.Now(), models.OrderWhere.ID.EQ(id))...).One(rt.Ctx, rt.Tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			rt.Log.WithField("order_id", id).Warn(errNotPayable)
+			return nil, status.Error(codes.FailedPrecondition, errNotPayable)
+		}
+		rt.Log.WithError(err).Error("payableOrder")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	return order, nil
+}
+
+// paymentForm posts the encrypted order to Mobilpay, as the Checkout client would.
+var paymentForm = template.Must(template.New("payment").Parse(`<!DOCTYPE html>
+<html>
+<body onload="document.forms[0].submit()">
+	<form method="post" action="{{ .Endpoint }}">
+		<input type="hidden" name="env_key" value="{{ .EnvKey }}">
+		<input type="hidden" name="data" value="{{ .Data }}">
+		<noscript><input type="submit" value="Pay"></noscript>
+	</form>
+</body>
+</html>
+`))
+
+// paymentHandler serves the payment links from the reminder mails.
+// The order is encrypted again, so the customer gets a fresh Mobilpay request.
+func (s *shopServer) paymentHandler(w http.ResponseWriter, r *http.Request) {
+	log := s.log.WithFields(logrus.Fields{"path": r.URL.Path, "remote": r.RemoteAddr})
+
+	id, err := parsePaymentToken(s.conf.Recovery.Secret, r.URL.Query().Get("token"), time.Now())
+	if s.conf.Recovery.Secret == "" || err != nil {
+		log.WithError(err).Warn("paymentHandler: parsePaymentToken")
+		http.Error(w, errPaymentLink, http.StatusForbidden)
+		return
+	}
+
+	rt, err := s.newTx(r.Context(), "paymentHandler", true)
+	if err != nil {
+		http.Error(w, errPaymentMissing, http.StatusServiceUnavailable)
+		return
+	}
+	defer rt.Done()
+
+	order, err := rt.payableOrder(id)
+	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			http.Error(w, errNotPayable, http.StatusGone)
+			return
+		}
+		http.Error(w, errPaymentMissing, http.StatusServiceUnavailable)
+		return
+	}
+	data, key, err := rt.encryptOrder(order)
+	if err != nil {
+		http.Error(w, errPaymentMissing, http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err = paymentForm.Execute(w, struct{ Endpoint, EnvKey, Data string }{mobilpay.MobilpayEndpoint, key, data}); err != nil {
+		log.WithError(err).Warn("paymentHandler: Execute")
+	}
+	rt.Log.WithFields(logrus.Fields{"event": "order.payment_link", "order_id": order.ID}).Info("Payment link opened")
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moapis/shop/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const testRecoverySecret = "recovery-secret"
+
+func Test_parsePaymentToken(t *testing.T) {
+	now := time.Now()
+	sign := func(claims paymentClaims) string {
+		token, err := signToken(testRecoverySecret, claims)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		want    int
+		wantErr bool
+	}{
+		{"Valid", sign(paymentClaims{100, now.Add(time.Hour).Unix()}), 100, false},
+		{"Expired", sign(paymentClaims{100, now.Add(-time.Hour).Unix()}), 0, true},
+		{"Malformed", "foo", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePaymentToken(testRecoverySecret, tt.token, now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parsePaymentToken() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parsePaymentToken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_shopServer_paymentURL(t *testing.T) {
+	conf := Default
+	conf.Recovery.Secret = testRecoverySecret
+	s := &shopServer{conf: &conf}
+
+	order := &models.Order{ID: 100, CreatedAt: time.Now()}
+	got, err := s.paymentURL(order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(got, conf.Recovery.PaymentURL) {
+		t.Errorf("shopServer.paymentURL() = %v", got)
+	}
+
+	// Valid until the order is cancelled
+	if id, err := parsePaymentToken(testRecoverySecret, u.Query().Get("token"), time.Now().Add(conf.Recovery.CancelAfter-time.Minute)); err != nil || id != 100 {
+		t.Errorf("shopServer.paymentURL() token = %v, %v", id, err)
+	}
+	if _, err := parsePaymentToken(testRecoverySecret, u.Query().Get("token"), time.Now().Add(conf.Recovery.CancelAfter+time.Minute)); err == nil {
+		t.Errorf("shopServer.paymentURL() token valid after cancellation")
+	}
+}
+
+func Test_paymentReminderTemplates(t *testing.T) {
+	msg, err := orderModelToMsg(testOrders[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	link := "https://pay.kreativio.ro/pay/order?token=abc.def"
+	data := paymentReminderData{orderMailData{msg, testOrders[0].CreatedAt, "RON", "Shop & Co"}, link}
+
+	for _, locale := range []string{"en", "ro"} {
+		name := tss.localTmpl(mailPaymentReminder, mailCustomer, locale)
+		t.Run(name, func(t *testing.T) {
+			var html, text, subject bytes.Buffer
+			if err := tss.tmpl.ExecuteTemplate(&html, name, data); err != nil {
+				t.Fatal(err)
+			}
+			if err := tss.text.ExecuteTemplate(&text, name, data); err != nil {
+				t.Fatal(err)
+			}
+			if err := tss.text.ExecuteTemplate(&subject, subjectTmpl(name), data); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(text.String(), link) || !strings.Contains(html.String(), "token=abc.def") || !strings.Contains(subject.String(), "100") {
+				t.Errorf("%s:\n%s\n%s\n%s", name, subject.String(), html.String(), text.String())
+			}
+		})
+	}
+}
+
+// recoveryServer returns a shop server with checkout recovery enabled.
+func recoveryServer(t *testing.T) *shopServer {
+	cc := *testConfig
+	cc.Recovery.Secret = testRecoverySecret
+
+	s, err := cc.newShopServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// insertUnpaidOrder inserts an online order created age ago,
+// with 2 pieces of variant 41.
+func insertUnpaidOrder(rt *requestTx, age time.Duration, paymentStatus string) (*models.Order, error) {
+	order := &models.Order{
+		CreatedAt:     time.Now().Add(-age),
+		FullName:      "What Is My Name",
+		Email:         "me@example.com",
+		FullAddress:   "No. 7, Long Street, Somewhere",
+		PaymentMethod: models.PaymentONLINE,
+		Status:        models.StatusOPEN,
+		Locale:        "en",
+	}
+	if err := order.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+	if err := order.AddOrderArticles(rt.Ctx, rt.Tx, true, &models.OrderArticle{
+		ArticleID: 13,
+		Amount:    2,
+		Title:     "ID 13",
+		Price:     testOrderArticles[2].Price,
+		VariantID: null.Int64From(41),
+	}); err != nil {
+		return nil, err
+	}
+	if paymentStatus != "" {
+		ps := &models.PaymentStatus{OrderID: order.ID, ConfirmationXML: "<order/>", Status: paymentStatus}
+		if err := ps.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+func Test_requestTx_recoverCheckouts(t *testing.T) {
+	rts := recoveryServer(t)
+	conf := rts.conf.Recovery
+
+	tests := []struct {
+		name       string
+		server     *shopServer
+		age        time.Duration
+		payment    string
+		wantStatus string
+		wantRemind bool
+		wantStock  int
+		wantErr    bool
+	}{
+		{"Disabled", tss, conf.CancelAfter + time.Hour, "", models.StatusOPEN, false, 10, false},
+		{"Recent", rts, conf.ReminderAfter / 2, "", models.StatusOPEN, false, 10, false},
+		{"Remind", rts, conf.ReminderAfter + time.Minute, "", models.StatusOPEN, true, 10, false},
+		{"Paid", rts, conf.CancelAfter + time.Hour, "confirmed", models.StatusOPEN, false, 10, false},
+		{"Pending", rts, conf.CancelAfter + time.Hour, "paid_pending", models.StatusOPEN, false, 10, false},
+		{"Cancel", rts, conf.CancelAfter + time.Hour, "canceled", models.StatusCANCELLED, false, 12, false},
+		{"DB error", rts, conf.CancelAfter + time.Hour, "", models.StatusOPEN, false, 10, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tt.server.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			if _, err = models.Variants(models.VariantWhere.ID.EQ(41)).UpdateAll(rt.Ctx, rt.Tx, models.M{models.VariantColumns.Stock: 10}); err != nil {
+				t.Fatal(err)
+			}
+			order, err := insertUnpaidOrder(rt, tt.age, tt.payment)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.name == "DB error" {
+				rt.Done()
+			}
+
+			if err = rt.recoverCheckouts(); (err != nil) != tt.wantErr {
+				t.Fatalf("requestTx.recoverCheckouts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if err = order.Reload(rt.Ctx, rt.Tx); err != nil {
+				t.Fatal(err)
+			}
+			if order.Status != tt.wantStatus || order.PaymentRemindedAt.Valid != tt.wantRemind {
+				t.Errorf("requestTx.recoverCheckouts() order = %+v", order)
+			}
+			vrt, err := models.FindVariant(rt.Ctx, rt.Tx, 41)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if vrt.Stock.Int != tt.wantStock {
+				t.Errorf("requestTx.recoverCheckouts() stock = %d, want %d", vrt.Stock.Int, tt.wantStock)
+			}
+
+			mails, err := models.Mails(models.MailWhere.Template.IN([]string{
+				"payment_reminder.customer.en",
+				"order_cancelled.customer.en",
+			})).All(rt.Ctx, rt.Tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantMail := tt.wantRemind || tt.wantStatus == models.StatusCANCELLED
+			if (len(mails) == 1) != wantMail {
+				t.Errorf("requestTx.recoverCheckouts() mails = %d, wantMail %v", len(mails), wantMail)
+			}
+			if tt.wantRemind && !strings.Contains(mails[0].TextBody, conf.PaymentURL) {
+				t.Errorf("requestTx.recoverCheckouts() reminder without payment link:\n%s", mails[0].TextBody)
+			}
+
+			// Only reminded once
+			if err = rt.remindUnpaidOrders(); err != nil {
+				t.Fatal(err)
+			}
+			if n, _ := models.Mails(models.MailWhere.Template.EQ("payment_reminder.customer.en")).Count(rt.Ctx, rt.Tx); tt.wantRemind && n != 1 {
+				t.Errorf("requestTx.remindUnpaidOrders() reminded %d times", n)
+			}
+		})
+	}
+}
+
+func Test_shopServer_paymentHandler(t *testing.T) {
+	rts := recoveryServer(t)
+	valid, err := rts.paymentURL(&models.Order{ID: 100, CreatedAt: time.Now()}) // Not an online order
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		server *shopServer
+		target string
+		want   int
+	}{
+		{"Disabled", tss, PaymentPath + "?token=foo.bar", http.StatusForbidden},
+		{"Bad token", rts, PaymentPath + "?token=foo.bar", http.StatusForbidden},
+		{"Not payable", rts, valid, http.StatusGone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			tt.server.paymentHandler(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+			if w.Code != tt.want {
+				t.Errorf("shopServer.paymentHandler() = %d, want %d: %s", w.Code, tt.want, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,378 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/moapis/shop"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultReportRange = 30 * 24 * time.Hour
+	defaultReportLimit = 10
+
+	errReportRange = "Report range from %v is not before to %v"
+	errReportType  = "Unknown report %v"
+)
+
+var reportIntervals = map[shop.ReportConditions_Interval]string{
+	shop.ReportConditions_DAY:   "day",
+	shop.ReportConditions_WEEK:  "week",
+	shop.ReportConditions_MONTH: "month",
+}
+
+// reportRange returns the from and to times from the conditions, or their defaults.
+func reportRange(cond *shop.ReportConditions) (from, to time.Time, err error) {
+	to = time.Now()
+	if ts := cond.GetTo(); ts != nil {
+		if to, err = ptypes.Timestamp(ts); err != nil {
+			return from, to, status.Error(codes.InvalidArgument, errTS)
+		}
+	}
+	from = to.Add(-defaultReportRange)
+	if ts := cond.GetFrom(); ts != nil {
+		if from, err = ptypes.Timestamp(ts); err != nil {
+			return from, to, status.Error(codes.InvalidArgument, errTS)
+		}
+	}
+	if !from.Before(to) {
+		return from, to, status.Errorf(codes.InvalidArgument, errReportRange, from, to)
+	}
+	return from, to, nil
+}
+
+// averageValue returns total divided by n, rounded to 2 decimals.
+func averageValue(total *decimal.Big, n int) string {
+	if n == 0 || total == nil {
+		return "0"
+	}
+	return new(decimal.Big).Quo(total, decimal.New(int64(n), 0)).Quantize(2).String()
+}
+
+// Cancelled orders are left out of the reports, except for the per-status breakdown.
+const revenueReportQuery = `select date_trunc($1, o.created_at) as period, count(distinct o.id) as orders, coalesce(sum(oa.price * oa.amount), 0) as revenue
+	from shop.orders o
+	left join shop.order_articles oa on oa.order_id = o.id
+	where o.created_at >= $2 and o.created_at < $3 and o.status <> 'CANCELLED'
+	group by period
+	order by period;`
+
+type revenueRow struct {
+	Period  time.Time     `boil:"period"`
+	Orders  int           `boil:"orders"`
+	Revenue types.Decimal `boil:"revenue"`
+}
+
+func (rt *requestTx) revenueReport(cond *shop.ReportConditions) (*shop.RevenueReport, error) {
+	rt.Log = rt.Log.WithField("cond", logCond(cond))
+
+	from, to, err := reportRange(cond)
+	if err != nil {
+		rt.Log.WithError(err).Warn("reportRange")
+		return nil, err
+	}
+
+	var rows []revenueRow
+	if err = queries.Raw(revenueReportQuery, reportIntervals[cond.GetInterval()], from, to).Bind(rt.Ctx, rt.Tx, &rows); err != nil {
+		rt.Log.WithError(err).Error("revenueReportQuery")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	var (
+		report = &shop.RevenueReport{Periods: make([]*shop.RevenueReport_Period, len(rows))}
+		total  = new(decimal.Big)
+	)
+	for i, r := range rows {
+		start, err := ptypes.TimestampProto(r.Period)
+		if err != nil {
+			rt.Log.WithError(err).Error("TimestampProto")
+			return nil, status.Error(codes.Internal, errTS)
+		}
+		report.Periods[i] = &shop.RevenueReport_Period{
+			Start:             start,
+			Orders:            int32(r.Orders),
+			Revenue:           r.Revenue.String(),
+			AverageOrderValue: averageValue(r.Revenue.Big, r.Orders),
+		}
+		report.Orders += int32(r.Orders)
+		total.Add(total, r.Revenue.Big)
+	}
+	report.Revenue = total.String()
+	report.AverageOrderValue = averageValue(total, int(report.Orders))
+
+	return report, nil
+}
+
+const topArticlesQuery = `select oa.article_id, (array_agg(oa.title order by oa.order_id desc))[1] as title, sum(oa.amount) as quantity, sum(oa.price * oa.amount) as revenue
+	from shop.order_articles oa
+	join shop.orders o on o.id = oa.order_id
+	where o.created_at >= $1 and o.created_at < $2 and o.status <> 'CANCELLED'
+	group by oa.article_id
+	order by %s desc, oa.article_id
+	limit $3;`
+
+type topArticleRow struct {
+	ArticleID int           `boil:"article_id"`
+	Title     string        `boil:"title"`
+	Quantity  int           `boil:"quantity"`
+	Revenue   types.Decimal `boil:"revenue"`
+}
+
+func (rt *requestTx) topArticles(orderBy string, from, to time.Time, limit int32) ([]*shop.TopArticlesReport_Article, error) {
+	var rows []topArticleRow
+	if err := queries.Raw(fmt.Sprintf(topArticlesQuery, orderBy), from, to, limit).Bind(rt.Ctx, rt.Tx, &rows); err != nil {
+		rt.Log.WithError(err).WithField("order_by", orderBy).Error("topArticlesQuery")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	list := make([]*shop.TopArticlesReport_Article, len(rows))
+	for i, r := range rows {
+		list[i] = &shop.TopArticlesReport_Article{
+			ArticleId: int32(r.ArticleID),
+			Title:     r.Title,
+			Quantity:  int32(r.Quantity),
+			Revenue:   r.Revenue.String(),
+		}
+	}
+	return list, nil
+}
+
+func (rt *requestTx) topArticlesReport(cond *shop.ReportConditions) (*shop.TopArticlesReport, error) {
+	rt.Log = rt.Log.WithField("cond", logCond(cond))
+
+	from, to, err := reportRange(cond)
+	if err != nil {
+		rt.Log.WithError(err).Warn("reportRange")
+		return nil, err
+	}
+	limit := cond.GetLimit()
+	if limit <= 0 {
+		limit = defaultReportLimit
+	}
+
+	report := new(shop.TopArticlesReport)
+	if report.ByQuantity, err = rt.topArticles("quantity", from, to, limit); err != nil {
+		return nil, err
+	}
+	if report.ByRevenue, err = rt.topArticles("revenue", from, to, limit); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+const (
+	paymentMethodsReportQuery = `select o.payment_method::text as payment_method, count(distinct o.id) as orders, coalesce(sum(oa.price * oa.amount), 0) as revenue
+	from shop.orders o
+	left join shop.order_articles oa on oa.order_id = o.id
+	where o.created_at >= $1 and o.created_at < $2 and o.status <> 'CANCELLED'
+	group by o.payment_method
+	order by o.payment_method;`
+
+	statusesReportQuery = `select o.status::text as status, count(*) as orders
+	from shop.orders o
+	where o.created_at >= $1 and o.created_at < $2
+	group by o.status
+	order by o.status;`
+
+	// Mobilpay reports captured payments with the "confirmed" action.
+	onlineReportQuery = `select count(*) as orders, count(*) filter (
+		where exists (select 1 from shop.payment_status ps where ps.order_id = o.id and ps.status = 'confirmed')
+	) as paid
+	from shop.orders o
+	where o.payment_method = 'ONLINE' and o.created_at >= $1 and o.created_at < $2 and o.status <> 'CANCELLED';`
+)
+
+type paymentMethodRow struct {
+	PaymentMethod string        `boil:"payment_method"`
+	Orders        int           `boil:"orders"`
+	Revenue       types.Decimal `boil:"revenue"`
+}
+
+type statusRow struct {
+	Status string `boil:"status"`
+	Orders int    `boil:"orders"`
+}
+
+type onlineRow struct {
+	Orders int `boil:"orders"`
+	Paid   int `boil:"paid"`
+}
+
+func (rt *requestTx) ordersReport(cond *shop.ReportConditions) (*shop.OrdersReport, error) {
+	rt.Log = rt.Log.WithField("cond", logCond(cond))
+
+	from, to, err := reportRange(cond)
+	if err != nil {
+		rt.Log.WithError(err).Warn("reportRange")
+		return nil, err
+	}
+
+	var (
+		pmRows     []paymentMethodRow
+		statusRows []statusRow
+		online     onlineRow
+		errs       = make([]error, 3)
+	)
+	errs[0] = queries.Raw(paymentMethodsReportQuery, from, to).Bind(rt.Ctx, rt.Tx, &pmRows)
+	errs[1] = queries.Raw(statusesReportQuery, from, to).Bind(rt.Ctx, rt.Tx, &statusRows)
+	errs[2] = queries.Raw(onlineReportQuery, from, to).Bind(rt.Ctx, rt.Tx, &online)
+	if err = rt.checkDBErrors("ordersReport", errs, false); err != nil {
+		return nil, err
+	}
+
+	report := &shop.OrdersReport{
+		PaymentMethods:   make([]*shop.OrdersReport_PaymentMethod, len(pmRows)),
+		Statuses:         make([]*shop.OrdersReport_Status, len(statusRows)),
+		OnlineOrders:     int32(online.Orders),
+		OnlinePaid:       int32(online.Paid),
+		OnlineConversion: "0",
+	}
+	if online.Orders > 0 {
+		report.OnlineConversion = new(decimal.Big).Quo(
+			decimal.New(int64(online.Paid), 0),
+			decimal.New(int64(online.Orders), 0),
+		).Quantize(4).String()
+	}
+
+	for i, r := range pmRows {
+		pm, ok := shop.Order_PaymentMethod_value[r.PaymentMethod]
+		if !ok {
+			rt.Log.Errorf(errEnum, r.PaymentMethod)
+			return nil, status.Errorf(codes.Unimplemented, errEnum, r.PaymentMethod)
+		}
+		report.PaymentMethods[i] = &shop.OrdersReport_PaymentMethod{
+			PaymentMethod: shop.Order_PaymentMethod(pm),
+			Orders:        int32(r.Orders),
+			Revenue:       r.Revenue.String(),
+		}
+	}
+	for i, r := range statusRows {
+		os, ok := shop.Order_Status_value[r.Status]
+		if !ok {
+			rt.Log.Errorf(errEnum, r.Status)
+			return nil, status.Errorf(codes.Unimplemented, errEnum, r.Status)
+		}
+		report.Statuses[i] = &shop.OrdersReport_Status{
+			Status: shop.Order_Status(os),
+			Orders: int32(r.Orders),
+		}
+	}
+	return report, nil
+}
+
+func formatReportTime(ts *timestamp.Timestamp) string {
+	return time.Unix(ts.GetSeconds(), int64(ts.GetNanos())).UTC().Format(time.RFC3339)
+}
+
+func revenueReportCSV(report *shop.RevenueReport) [][]string {
+	recs := [][]string{{"period", "orders", "revenue", "average_order_value"}}
+	for _, p := range report.GetPeriods() {
+		recs = append(recs, []string{
+			formatReportTime(p.GetStart()),
+			strconv.Itoa(int(p.GetOrders())),
+			p.GetRevenue(),
+			p.GetAverageOrderValue(),
+		})
+	}
+	return append(recs, []string{
+		"total",
+		strconv.Itoa(int(report.GetOrders())),
+		report.GetRevenue(),
+		report.GetAverageOrderValue(),
+	})
+}
+
+func topArticlesReportCSV(report *shop.TopArticlesReport) [][]string {
+	recs := [][]string{{"ranking", "position", "article_id", "title", "quantity", "revenue"}}
+	for _, ranking := range []struct {
+		name string
+		list []*shop.TopArticlesReport_Article
+	}{
+		{"quantity", report.GetByQuantity()},
+		{"revenue", report.GetByRevenue()},
+	} {
+		for i, a := range ranking.list {
+			recs = append(recs, []string{
+				ranking.name,
+				strconv.Itoa(i + 1),
+				strconv.Itoa(int(a.GetArticleId())),
+				a.GetTitle(),
+				strconv.Itoa(int(a.GetQuantity())),
+				a.GetRevenue(),
+			})
+		}
+	}
+	return recs
+}
+
+func ordersReportCSV(report *shop.OrdersReport) [][]string {
+	recs := [][]string{{"group", "value", "orders", "revenue"}}
+	for _, pm := range report.GetPaymentMethods() {
+		recs = append(recs, []string{"payment_method", pm.GetPaymentMethod().String(), strconv.Itoa(int(pm.GetOrders())), pm.GetRevenue()})
+	}
+	for _, st := range report.GetStatuses() {
+		recs = append(recs, []string{"status", st.GetStatus().String(), strconv.Itoa(int(st.GetOrders())), ""})
+	}
+	return append(recs,
+		[]string{"online", "orders", strconv.Itoa(int(report.GetOnlineOrders())), ""},
+		[]string{"online", "paid", strconv.Itoa(int(report.GetOnlinePaid())), ""},
+		[]string{"online", "conversion", report.GetOnlineConversion(), ""},
+	)
+}
+
+// exportReport generates the requested report as CSV.
+func (rt *requestTx) exportReport(req *shop.ExportReportRequest) (*shop.ReportCSV, error) {
+	cond := req.GetConditions()
+
+	var recs [][]string
+	switch req.GetReport() {
+	case shop.ExportReportRequest_REVENUE:
+		report, err := rt.revenueReport(cond)
+		if err != nil {
+			return nil, err
+		}
+		recs = revenueReportCSV(report)
+	case shop.ExportReportRequest_TOP_ARTICLES:
+		report, err := rt.topArticlesReport(cond)
+		if err != nil {
+			return nil, err
+		}
+		recs = topArticlesReportCSV(report)
+	case shop.ExportReportRequest_ORDERS:
+		report, err := rt.ordersReport(cond)
+		if err != nil {
+			return nil, err
+		}
+		recs = ordersReportCSV(report)
+	default:
+		rt.Log.Warnf(errReportType, req.GetReport())
+		return nil, status.Errorf(codes.InvalidArgument, errReportType, req.GetReport())
+	}
+
+	var buf bytes.Buffer
+	if err := csv.NewWriter(&buf).WriteAll(recs); err != nil {
+		rt.Log.WithError(err).Error("csv WriteAll")
+		return nil, status.Error(codes.Internal, errFatal)
+	}
+
+	from, to, _ := reportRange(cond) // Already checked by the report
+	return &shop.ReportCSV{
+		Filename: fmt.Sprintf("%s_%s_%s.csv", strings.ToLower(req.GetReport().String()), from.Format("20060102"), to.Format("20060102")),
+		Data:     buf.Bytes(),
+	}, nil
+}
@@ -0,0 +1,533 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/moapis/shop"
+	"github.com/moapis/shop/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// testReportConditions only include order 100
+var testReportConditions = &shop.ReportConditions{
+	From: &timestamp.Timestamp{Seconds: 0},
+	To:   &timestamp.Timestamp{Seconds: 50},
+}
+
+func Test_reportRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		cond     *shop.ReportConditions
+		wantFrom time.Time
+		wantTo   time.Time
+		wantErr  bool
+	}{
+		{
+			"Set",
+			testReportConditions,
+			time.Unix(0, 0),
+			time.Unix(50, 0),
+			false,
+		},
+		{
+			"Default from",
+			&shop.ReportConditions{To: &timestamp.Timestamp{Seconds: 1600000000}},
+			time.Unix(1600000000, 0).Add(-defaultReportRange),
+			time.Unix(1600000000, 0),
+			false,
+		},
+		{
+			"From after to",
+			&shop.ReportConditions{
+				From: &timestamp.Timestamp{Seconds: 50},
+				To:   &timestamp.Timestamp{Seconds: 50},
+			},
+			time.Unix(50, 0),
+			time.Unix(50, 0),
+			true,
+		},
+		{
+			"Invalid from",
+			&shop.ReportConditions{From: &timestamp.Timestamp{Nanos: -1}},
+			time.Time{},
+			time.Time{},
+			true,
+		},
+		{
+			"Invalid to",
+			&shop.ReportConditions{To: &timestamp.Timestamp{Nanos: -1}},
+			time.Time{},
+			time.Time{},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotFrom, gotTo, err := reportRange(tt.cond)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("reportRange() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && tt.wantFrom.IsZero() {
+				return
+			}
+			if !gotFrom.Equal(tt.wantFrom) || !gotTo.Equal(tt.wantTo) {
+				t.Errorf("reportRange() = %v, %v, want %v, %v", gotFrom, gotTo, tt.wantFrom, tt.wantTo)
+			}
+		})
+	}
+
+	from, to, err := reportRange(nil)
+	if err != nil || to.Sub(from) != defaultReportRange || time.Since(to) > time.Minute {
+		t.Errorf("reportRange(nil) = %v, %v, %v", from, to, err)
+	}
+}
+
+func Test_averageValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		total *decimal.Big
+		n     int
+		want  string
+	}{
+		{"Zero orders", decimal.New(10, 0), 0, "0"},
+		{"Nil total", nil, 1, "0"},
+		{"Rounded", decimal.New(10, 0), 3, "3.33"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := averageValue(tt.total, tt.n); got != tt.want {
+				t.Errorf("averageValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_revenueReportCSV(t *testing.T) {
+	report := &shop.RevenueReport{
+		Periods: []*shop.RevenueReport_Period{
+			{
+				Start:             &timestamp.Timestamp{Seconds: 86400},
+				Orders:            2,
+				Revenue:           "20",
+				AverageOrderValue: "10.00",
+			},
+		},
+		Orders:            2,
+		Revenue:           "20",
+		AverageOrderValue: "10.00",
+	}
+	want := [][]string{
+		{"period", "orders", "revenue", "average_order_value"},
+		{"1970-01-02T00:00:00Z", "2", "20", "10.00"},
+		{"total", "2", "20", "10.00"},
+	}
+	if got := revenueReportCSV(report); !reflect.DeepEqual(got, want) {
+		t.Errorf("revenueReportCSV() = %v, want %v", got, want)
+	}
+}
+
+func Test_requestTx_revenueReport(t *testing.T) {
+	tests := []struct {
+		name    string
+		cond    *shop.ReportConditions
+		want    *shop.RevenueReport
+		wantErr bool
+	}{
+		{
+			"DB Error",
+			testReportConditions,
+			nil,
+			true,
+		},
+		{
+			"Range error",
+			&shop.ReportConditions{
+				From: &timestamp.Timestamp{Seconds: 50},
+				To:   &timestamp.Timestamp{Seconds: 0},
+			},
+			nil,
+			true,
+		},
+		{
+			"No orders",
+			&shop.ReportConditions{
+				From: &timestamp.Timestamp{Seconds: 1000},
+				To:   &timestamp.Timestamp{Seconds: 2000},
+			},
+			&shop.RevenueReport{
+				Periods:           []*shop.RevenueReport_Period{},
+				Revenue:           "0",
+				AverageOrderValue: "0",
+			},
+			false,
+		},
+		{
+			"Order 100",
+			testReportConditions,
+			&shop.RevenueReport{
+				Periods: []*shop.RevenueReport_Period{
+					{
+						Orders:            1,
+						Revenue:           "30779.1075",
+						AverageOrderValue: "30779.11",
+					},
+				},
+				Orders:            1,
+				Revenue:           "30779.1075",
+				AverageOrderValue: "30779.11",
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.revenueReport(tt.cond)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.revenueReport() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			// Period start depends on the database time zone
+			for _, p := range got.GetPeriods() {
+				if p.GetStart() == nil {
+					t.Errorf("requestTx.revenueReport() Period without start")
+				}
+				p.Start = nil
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("requestTx.revenueReport() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_requestTx_topArticlesReport(t *testing.T) {
+	tests := []struct {
+		name    string
+		cond    *shop.ReportConditions
+		want    *shop.TopArticlesReport
+		wantErr bool
+	}{
+		{
+			"DB Error",
+			testReportConditions,
+			nil,
+			true,
+		},
+		{
+			"Range error",
+			&shop.ReportConditions{From: &timestamp.Timestamp{Nanos: -1}},
+			nil,
+			true,
+		},
+		{
+			"Limit 2",
+			&shop.ReportConditions{
+				From:  testReportConditions.From,
+				To:    testReportConditions.To,
+				Limit: 2,
+			},
+			&shop.TopArticlesReport{
+				ByQuantity: []*shop.TopArticlesReport_Article{
+					{ArticleId: 13, Title: "ID 13", Quantity: 5, Revenue: "741.7575"},
+					{ArticleId: 12, Title: "ID 12", Quantity: 3, Revenue: "36.36"},
+				},
+				ByRevenue: []*shop.TopArticlesReport_Article{
+					{ArticleId: 11, Title: "ID 11", Quantity: 1, Revenue: "30000.99"},
+					{ArticleId: 13, Title: "ID 13", Quantity: 5, Revenue: "741.7575"},
+				},
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.topArticlesReport(tt.cond)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.topArticlesReport() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("requestTx.topArticlesReport() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_requestTx_ordersReport(t *testing.T) {
+	onlineCond := &shop.ReportConditions{
+		From: &timestamp.Timestamp{Seconds: 100},
+		To:   &timestamp.Timestamp{Seconds: 300},
+	}
+
+	tests := []struct {
+		name    string
+		cond    *shop.ReportConditions
+		want    *shop.OrdersReport
+		wantErr bool
+	}{
+		{
+			"DB Error",
+			testReportConditions,
+			nil,
+			true,
+		},
+		{
+			"Range error",
+			&shop.ReportConditions{From: &timestamp.Timestamp{Nanos: -1}},
+			nil,
+			true,
+		},
+		{
+			"Undefined status",
+			&shop.ReportConditions{
+				From: &timestamp.Timestamp{Seconds: 0},
+				To:   &timestamp.Timestamp{Seconds: 100}, // Includes order 101
+			},
+			nil,
+			true,
+		},
+		{
+			"Order 100",
+			testReportConditions,
+			&shop.OrdersReport{
+				PaymentMethods: []*shop.OrdersReport_PaymentMethod{
+					{PaymentMethod: shop.Order_CASH_ON_DELIVERY, Orders: 1, Revenue: "30779.1075"},
+				},
+				Statuses: []*shop.OrdersReport_Status{
+					{Status: shop.Order_SENT, Orders: 1},
+				},
+				OnlineConversion: "0",
+			},
+			false,
+		},
+		{
+			"Online",
+			onlineCond,
+			&shop.OrdersReport{
+				PaymentMethods: []*shop.OrdersReport_PaymentMethod{
+					{PaymentMethod: shop.Order_ONLINE, Orders: 2, Revenue: "0"},
+				},
+				Statuses: []*shop.OrdersReport_Status{
+					{Status: shop.Order_OPEN, Orders: 2},
+				},
+				OnlineOrders:     2,
+				OnlinePaid:       1,
+				OnlineConversion: "0.5000",
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			for i, ps := range []string{"confirmed", "canceled"} {
+				order := &models.Order{
+					CreatedAt:     time.Unix(int64(200+i), 0),
+					FullName:      "Online",
+					Email:         "online@example.com",
+					PaymentMethod: models.PaymentONLINE,
+					Status:        models.StatusOPEN,
+				}
+				if err = order.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+					t.Fatal(err)
+				}
+				pst := &models.PaymentStatus{OrderID: order.ID, ConfirmationXML: "<order/>", Status: ps}
+				if err = pst.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.ordersReport(tt.cond)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.ordersReport() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("requestTx.ordersReport() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_requestTx_reports_cancelled(t *testing.T) {
+	rt, err := tss.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Done()
+
+	order := &models.Order{
+		CreatedAt:     time.Unix(20, 0),
+		FullName:      "Cancelled",
+		Email:         "cancelled@example.com",
+		PaymentMethod: models.PaymentONLINE,
+		Status:        models.StatusCANCELLED,
+	}
+	if err = order.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = order.AddOrderArticles(rt.Ctx, rt.Tx, true, &models.OrderArticle{
+		ArticleID: 12,
+		Amount:    100,
+		Title:     "ID 12",
+		Price:     testOrderArticles[1].Price,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	revenue, err := rt.revenueReport(testReportConditions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revenue.GetOrders() != 1 || revenue.GetRevenue() != "30779.1075" || revenue.GetAverageOrderValue() != "30779.11" {
+		t.Errorf("requestTx.revenueReport() = %v, want order 100 only", revenue)
+	}
+
+	top, err := rt.topArticlesReport(testReportConditions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, a := range top.GetByQuantity() {
+		if a.GetArticleId() == 12 && a.GetQuantity() != 3 {
+			t.Errorf("requestTx.topArticlesReport() = %v, want cancelled order left out", a)
+		}
+	}
+
+	orders, err := rt.ordersReport(testReportConditions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &shop.OrdersReport{
+		PaymentMethods: []*shop.OrdersReport_PaymentMethod{
+			{PaymentMethod: shop.Order_CASH_ON_DELIVERY, Orders: 1, Revenue: "30779.1075"},
+		},
+		Statuses: []*shop.OrdersReport_Status{
+			{Status: shop.Order_SENT, Orders: 1},
+			{Status: shop.Order_CANCELLED, Orders: 1},
+		},
+		OnlineConversion: "0",
+	}
+	if !reflect.DeepEqual(orders, want) {
+		t.Errorf("requestTx.ordersReport() = %v, want %v", orders, want)
+	}
+}
+
+func Test_requestTx_exportReport(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          *shop.ExportReportRequest
+		wantFilename string
+		wantHeader   string
+		wantErr      bool
+	}{
+		{
+			"Unknown report",
+			&shop.ExportReportRequest{Report: 99, Conditions: testReportConditions},
+			"",
+			"",
+			true,
+		},
+		{
+			"Revenue",
+			&shop.ExportReportRequest{Report: shop.ExportReportRequest_REVENUE, Conditions: testReportConditions},
+			"revenue_",
+			"period,orders,revenue,average_order_value\n",
+			false,
+		},
+		{
+			"Revenue error",
+			&shop.ExportReportRequest{Report: shop.ExportReportRequest_REVENUE, Conditions: &shop.ReportConditions{From: &timestamp.Timestamp{Nanos: -1}}},
+			"",
+			"",
+			true,
+		},
+		{
+			"Top articles",
+			&shop.ExportReportRequest{Report: shop.ExportReportRequest_TOP_ARTICLES, Conditions: testReportConditions},
+			"top_articles_",
+			"ranking,position,article_id,title,quantity,revenue\nquantity,1,13,ID 13,5,741.7575\n",
+			false,
+		},
+		{
+			"Top articles error",
+			&shop.ExportReportRequest{Report: shop.ExportReportRequest_TOP_ARTICLES, Conditions: &shop.ReportConditions{From: &timestamp.Timestamp{Nanos: -1}}},
+			"",
+			"",
+			true,
+		},
+		{
+			"Orders",
+			&shop.ExportReportRequest{Report: shop.ExportReportRequest_ORDERS, Conditions: testReportConditions},
+			"orders_",
+			"group,value,orders,revenue\npayment_method,CASH_ON_DELIVERY,1,30779.1075\nstatus,SENT,1,\n",
+			false,
+		},
+		{
+			"Orders error",
+			&shop.ExportReportRequest{Report: shop.ExportReportRequest_ORDERS, Conditions: &shop.ReportConditions{From: &timestamp.Timestamp{Nanos: -1}}},
+			"",
+			"",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			got, err := rt.exportReport(tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.exportReport() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if !strings.HasPrefix(got.GetFilename(), tt.wantFilename) || !strings.HasSuffix(got.GetFilename(), ".csv") {
+				t.Errorf("requestTx.exportReport() Filename = %v, want %v...", got.GetFilename(), tt.wantFilename)
+			}
+			if !strings.HasPrefix(string(got.GetData()), tt.wantHeader) {
+				t.Errorf("requestTx.exportReport() Data = %s, want %s...", got.GetData(), tt.wantHeader)
+			}
+		})
+	}
+}
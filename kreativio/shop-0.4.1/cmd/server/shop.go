@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
-	"fmt"
+	"html/template"
+	"io"
+	texttemplate "text/template"
 
 	"github.com/moapis/mailer"
 	"github.com/moapis/multidb"
@@ -11,21 +13,29 @@ import (
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
 	errInternal  = "Internal error"
 	errMissingID = "Missing ID"
+	errNoImport  = "Import stream is empty"
+	errWatchSlow = "Watch stream too slow, reconnect with last_order_id"
 )
 
 type shopServer struct {
 	shop.UnimplementedShopServer
 
-	mdb  *multidb.MultiDB
-	log  *logrus.Entry
-	conf *ServerConfig
-	tv   *transaction.Verificator
-	mail *mailer.Mailer
+	mdb    *multidb.MultiDB
+	authDB *multidb.MultiDB // Authenticator database, nil when not configured
+	log    *logrus.Entry
+	conf   *ServerConfig
+	tv     *transaction.Verificator
+	mail   *mailer.Mailer
+	tmpl   *template.Template
+	text   *texttemplate.Template
+	feed   feedCache
+	orders orderWatcher
 }
 
 func (s *shopServer) SaveArticle(ctx context.Context, req *shop.Article) (*shop.ArticleID, error) {
@@ -35,28 +45,17 @@ func (s *shopServer) SaveArticle(ctx context.Context, req *shop.Article) (*shop.
 	}
 	defer rt.Done()
 
-	art, err := rt.upsertArticle(req)
+	art, err := rt.saveArticle(req)
 	if err != nil {
 		return nil, err
 	}
-	if err = rt.setArticleCategories(art, req.GetCategories()); err != nil {
-		return nil, err
-	}
-	if err := rt.setArticleBasePrices(art, req.GetBaseprices()); err != nil {
-		return nil, err
-	}
-	if err := rt.updateVariants(art.ID, req.GetVariants()); err != nil {
-		return nil, err
-	}
-	if err := rt.updateImages(art.ID, req.GetImages()); err != nil {
-		return nil, err
-	}
-	if err := rt.updateVideos(art.ID, req.GetVideos()); err != nil {
+	if err = rt.queueArticleWebhooks(art.ID); err != nil {
 		return nil, err
 	}
 	if err = rt.Commit(); err != nil {
 		return nil, err
 	}
+	s.feed.invalidate()
 	return &shop.ArticleID{Id: int32(art.ID)}, nil
 }
 
@@ -107,14 +106,187 @@ func (s *shopServer) DeleteArticle(ctx context.Context, req *shop.ArticleID) (*s
 	if err != nil {
 		return nil, err
 	}
+	if ra > 0 {
+		if err = rt.queueWebhooks(eventArticleDeleted, &shop.ArticleID{Id: aid}); err != nil {
+			return nil, err
+		}
+	}
 
 	if err = rt.Commit(); err != nil {
 		return nil, err
 	}
+	s.feed.invalidate()
 
 	return &shop.Deleted{Rows: ra}, nil
 }
 
+func (s *shopServer) RestoreArticle(ctx context.Context, req *shop.ArticleID) (*shop.ArticleID, error) {
+	rt, err := s.newAuthTx(ctx, "RestoreArticle", false, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	aid := req.GetId()
+	if aid == 0 {
+		rt.Log.Warn(errMissingID)
+		return nil, status.Error(codes.InvalidArgument, errMissingID)
+	}
+	rt.Log = rt.Log.WithField("aid", aid)
+
+	if err = rt.restoreArticle(int(aid)); err != nil {
+		return nil, err
+	}
+	if err = rt.queueArticleWebhooks(int(aid)); err != nil {
+		return nil, err
+	}
+
+	if err = rt.Commit(); err != nil {
+		return nil, err
+	}
+	s.feed.invalidate()
+
+	return &shop.ArticleID{Id: aid}, nil
+}
+
+func (s *shopServer) ListDeletedArticles(ctx context.Context, req *shop.DeletedListConditions) (*shop.ArticleList, error) {
+	rt, err := s.newAuthTx(ctx, "ListDeletedArticles", true, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	list, err := rt.listDeletedArticles(req)
+	if err != nil {
+		return nil, err
+	}
+	return &shop.ArticleList{List: list}, nil
+}
+
+func (s *shopServer) ListArticleRevisions(ctx context.Context, req *shop.RevisionListConditions) (*shop.ArticleRevisionList, error) {
+	rt, err := s.newAuthTx(ctx, "ListArticleRevisions", true, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	list, err := rt.listArticleRevisions(req)
+	if err != nil {
+		return nil, err
+	}
+	return &shop.ArticleRevisionList{List: list}, nil
+}
+
+func (s *shopServer) RevertArticle(ctx context.Context, req *shop.RevisionID) (*shop.ArticleID, error) {
+	rt, err := s.newAuthTx(ctx, "RevertArticle", false, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	rid := req.GetId()
+	if rid == 0 {
+		rt.Log.Warn(errMissingID)
+		return nil, status.Error(codes.InvalidArgument, errMissingID)
+	}
+
+	art, err := rt.revertArticle(int(rid))
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.queueArticleWebhooks(art.ID); err != nil {
+		return nil, err
+	}
+
+	if err = rt.Commit(); err != nil {
+		return nil, err
+	}
+	s.feed.invalidate()
+
+	return &shop.ArticleID{Id: int32(art.ID)}, nil
+}
+
+func (s *shopServer) ImportArticles(stream shop.Shop_ImportArticlesServer) error {
+	req, err := stream.Recv()
+	if err == io.EOF {
+		s.log.WithField("method", "ImportArticles").Warn(errNoImport)
+		return status.Error(codes.InvalidArgument, errNoImport)
+	}
+	if err != nil {
+		return err
+	}
+
+	rt, err := s.newAuthTx(stream.Context(), "ImportArticles", false, req.GetToken())
+	if err != nil {
+		return err
+	}
+	defer rt.Done()
+
+	dryRun := req.GetDryRun()
+	var saved, failed int
+	for row := int32(1); ; row++ {
+		res, err := rt.importArticle(req.GetArticle())
+		if err != nil {
+			return err
+		}
+		if res.GetError() == "" {
+			saved++
+		} else {
+			failed++
+		}
+
+		res.Row = row
+		if err = stream.Send(res); err != nil {
+			rt.Log.WithError(err).Warn("stream.Send")
+			return err
+		}
+
+		if req, err = stream.Recv(); err == io.EOF {
+			break
+		}
+		if err != nil {
+			rt.Log.WithError(err).Warn("stream.Recv")
+			return err
+		}
+	}
+
+	log := rt.Log.WithFields(logrus.Fields{"event": "article.imported", "saved": saved, "failed": failed, "dry_run": dryRun})
+	if dryRun {
+		log.Info("Import dry run done, rolling back")
+		return nil
+	}
+	if err = rt.Commit(); err != nil {
+		return err
+	}
+	s.feed.invalidate()
+	log.Info("Articles imported")
+	return nil
+}
+
+func (s *shopServer) ExportArticles(req *shop.ExportConditions, stream shop.Shop_ExportArticlesServer) error {
+	rt, err := s.newAuthTx(stream.Context(), "ExportArticles", true, req.GetToken())
+	if err != nil {
+		return err
+	}
+	defer rt.Done()
+
+	ids, err := rt.exportArticleIDs(req)
+	if err != nil {
+		return err
+	}
+	for _, aid := range ids {
+		sa, err := rt.viewArticle(aid)
+		if err != nil {
+			return err
+		}
+		if err = stream.Send(sa); err != nil {
+			rt.Log.WithError(err).Warn("stream.Send")
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *shopServer) Checkout(ctx context.Context, req *shop.Order) (*shop.OrderID, error) {
 	rt, err := s.newTx(ctx, "Checkout", false)
 	if err != nil {
@@ -126,10 +298,19 @@ func (s *shopServer) Checkout(ctx context.Context, req *shop.Order) (*shop.Order
 	if err != nil {
 		return nil, err
 	}
+	if err = rt.queueOrderWebhooks(eventOrderCreated, order.ID); err != nil {
+		return nil, err
+	}
 
-	if err = rt.sendOrderMail(OrderMailTmpl, order, fmt.Sprintf("New order #%d at %s", order.ID, rt.s.conf.Mail.ShopName)); err != nil {
+	if err = rt.queueOrderMails(mailOrderReceived, order); err != nil {
 		return nil, err
 	}
+	if req.GetNewsletter() {
+		addr := clientAddr(ctx, s.conf.Proxies)
+		if err = rt.subscribeFrom(sourceCheckout, order.Email, order.FullName, order.Locale, addr); err != nil {
+			return nil, err
+		}
+	}
 	encText, encKey, err := rt.encryptOrder(order)
 	if err != nil {
 		return nil, err
@@ -137,6 +318,7 @@ func (s *shopServer) Checkout(ctx context.Context, req *shop.Order) (*shop.Order
 	if err = rt.Commit(); err != nil {
 		return nil, err
 	}
+	s.feed.invalidate()
 	return &shop.OrderID{Id: int32(order.ID), EnvKey: encKey, Data: encText}, nil
 }
 
@@ -162,15 +344,172 @@ func (s *shopServer) SaveOrder(ctx context.Context, req *shop.Order) (*shop.Orde
 		return nil, err
 	}
 
-	if err = rt.sendOrderMail(OrderMailTmpl, order, fmt.Sprintf("Update on your order #%d at %s", order.ID, rt.s.conf.Mail.ShopName)); err != nil {
+	if err = rt.Commit(); err != nil {
 		return nil, err
 	}
 
+	return &shop.OrderID{Id: int32(order.ID)}, nil
+}
+
+func (s *shopServer) WatchOrders(req *shop.WatchOrdersRequest, stream shop.Shop_WatchOrdersServer) error {
+	ctx := stream.Context()
+
+	lastID, log, err := s.watchStart(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	// Subscribe before loading missed orders, so no notification gets lost in between.
+	ids := s.orders.subscribe()
+	defer s.orders.unsubscribe(ids)
+
+	send := func(id int) error {
+		orders, err := s.watchOrders(ctx, lastID, id)
+		if err != nil {
+			return err
+		}
+		for _, o := range orders {
+			if err = stream.Send(o); err != nil {
+				log.WithError(err).Warn("stream.Send")
+				return err
+			}
+			if int(o.GetId()) > lastID {
+				lastID = int(o.GetId())
+			}
+		}
+		return nil
+	}
+
+	if err = send(resyncOrders); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.WithError(ctx.Err()).Info("Watch ended")
+			return nil
+		case id, ok := <-ids:
+			if !ok {
+				log.WithField("last_order_id", lastID).Warn(errWatchSlow)
+				return status.Error(codes.ResourceExhausted, errWatchSlow)
+			}
+			if err = send(id); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *shopServer) SaveWebhook(ctx context.Context, req *shop.Webhook) (*shop.Webhook, error) {
+	rt, err := s.newAuthTx(ctx, "SaveWebhook", false, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	sw, err := rt.saveWebhook(req)
+	if err != nil {
+		return nil, err
+	}
 	if err = rt.Commit(); err != nil {
 		return nil, err
 	}
+	return sw, nil
+}
 
-	return &shop.OrderID{Id: int32(order.ID)}, nil
+func (s *shopServer) DeleteWebhook(ctx context.Context, req *shop.WebhookID) (*shop.Deleted, error) {
+	rt, err := s.newAuthTx(ctx, "DeleteWebhook", false, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	del, err := rt.deleteWebhook(int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.Commit(); err != nil {
+		return nil, err
+	}
+	return del, nil
+}
+
+func (s *shopServer) ListWebhooks(ctx context.Context, req *shop.WebhookListConditions) (*shop.WebhookList, error) {
+	rt, err := s.newAuthTx(ctx, "ListWebhooks", true, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	return rt.listWebhooks()
+}
+
+func (s *shopServer) ListMails(ctx context.Context, req *shop.MailListConditions) (*shop.MailList, error) {
+	rt, err := s.newAuthTx(ctx, "ListMails", true, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	return rt.listMails(req)
+}
+
+func (s *shopServer) ResendMail(ctx context.Context, req *shop.MailID) (*shop.Mail, error) {
+	rt, err := s.newAuthTx(ctx, "ResendMail", false, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	mail, err := rt.resendMail(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.Commit(); err != nil {
+		return nil, err
+	}
+	return mail, nil
+}
+
+func (s *shopServer) RevenueReport(ctx context.Context, req *shop.ReportConditions) (*shop.RevenueReport, error) {
+	rt, err := s.newAuthTx(ctx, "RevenueReport", true, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	return rt.revenueReport(req)
+}
+
+func (s *shopServer) TopArticlesReport(ctx context.Context, req *shop.ReportConditions) (*shop.TopArticlesReport, error) {
+	rt, err := s.newAuthTx(ctx, "TopArticlesReport", true, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	return rt.topArticlesReport(req)
+}
+
+func (s *shopServer) OrdersReport(ctx context.Context, req *shop.ReportConditions) (*shop.OrdersReport, error) {
+	rt, err := s.newAuthTx(ctx, "OrdersReport", true, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	return rt.ordersReport(req)
+}
+
+func (s *shopServer) ExportReport(ctx context.Context, req *shop.ExportReportRequest) (*shop.ReportCSV, error) {
+	rt, err := s.newAuthTx(ctx, "ExportReport", true, req.GetConditions().GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	return rt.exportReport(req)
 }
 
 func (s *shopServer) SaveCategories(ctx context.Context, req *shop.CategoryList) (*shop.CategoryList, error) {
@@ -192,6 +531,7 @@ func (s *shopServer) SaveCategories(ctx context.Context, req *shop.CategoryList)
 	if err = rt.Commit(); err != nil {
 		return nil, err
 	}
+	s.feed.invalidate()
 
 	return &shop.CategoryList{List: list}, nil
 }
@@ -211,6 +551,44 @@ func (s *shopServer) ListCategories(ctx context.Context, req *shop.CategoryListC
 	return &shop.CategoryList{List: list}, nil
 }
 
+func (s *shopServer) SaveAttributes(ctx context.Context, req *shop.AttributeList) (*shop.AttributeList, error) {
+	rt, err := s.newAuthTx(ctx, "SaveAttributes", false, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	if err = rt.saveAttributes(req.GetList()); err != nil {
+		return nil, err
+	}
+
+	list, err := rt.listAttributes(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rt.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &shop.AttributeList{List: list}, nil
+}
+
+func (s *shopServer) ListAttributes(ctx context.Context, req *shop.AttributeListConditions) (*shop.AttributeList, error) {
+	rt, err := s.newTx(ctx, "ListAttributes", true)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	list, err := rt.listAttributes(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &shop.AttributeList{List: list}, nil
+}
+
 func (s *shopServer) SearchArticles(ctx context.Context, req *shop.TextSearch) (*shop.ArticleList, error) {
 	rt, err := s.newTx(ctx, "SearchArticles", true)
 	if err != nil {
@@ -251,6 +629,7 @@ func (s *shopServer) SaveBasePrice(ctx context.Context, sbp *shop.BasePrice) (*s
 	if err = rt.Commit(); err != nil {
 		return nil, err
 	}
+	s.feed.invalidate()
 
 	return sbp, nil
 }
@@ -270,6 +649,7 @@ func (s *shopServer) DeleteBasePrice(ctx context.Context, sbp *shop.BasePrice) (
 	if err = rt.Commit(); err != nil {
 		return nil, err
 	}
+	s.feed.invalidate()
 
 	return del, nil
 }
@@ -296,16 +676,183 @@ func (s *shopServer) SendMessage(ctx context.Context, sm *shop.Message) (*shop.M
 	}
 	defer rt.Done()
 
-	msg, err := rt.newMessage(sm)
+	addr := clientAddr(ctx, s.conf.Proxies)
+	if sm.GetHoneypot() != "" {
+		rt.Log.WithFields(logrus.Fields{"event": "message.spam", "remote_addr": addr}).Warn("Honeypot filled, message dropped")
+		return &shop.MessageID{}, nil
+	}
+	if err = s.verifyCaptcha(ctx, sm.GetCaptchaToken(), addr); err != nil {
+		return nil, err
+	}
+
+	msg, err := rt.newMessage(sm, addr)
 	if err != nil {
 		return nil, err
 	}
+	received := proto.Clone(sm).(*shop.Message)
+	received.Id = int32(msg.ID)
+	received.CaptchaToken = ""
+	if err = rt.queueWebhooks(eventMessageReceived, received); err != nil {
+		return nil, err
+	}
 
 	if err = rt.sendMail(MessageMailTmpl, msg); err != nil {
 		return nil, err
 	}
+	if sm.GetNewsletter() {
+		if err = rt.subscribeFrom(sourceMessage, msg.Email, msg.Name, "", addr); err != nil {
+			return nil, err
+		}
+	}
 
-	rt.Commit()
+	if err = rt.Commit(); err != nil {
+		return nil, err
+	}
 
 	return &shop.MessageID{Id: int32(msg.ID)}, nil
 }
+
+func (s *shopServer) ListMessages(ctx context.Context, req *shop.MessageListConditions) (*shop.MessageList, error) {
+	rt, err := s.newAuthTx(ctx, "ListMessages", true, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	return rt.listMessages(req)
+}
+
+func (s *shopServer) GetMessage(ctx context.Context, req *shop.MessageID) (*shop.Message, error) {
+	rt, err := s.newAuthTx(ctx, "GetMessage", true, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	return rt.getMessage(int(req.GetId()))
+}
+
+func (s *shopServer) MarkMessage(ctx context.Context, req *shop.MessageMark) (*shop.Message, error) {
+	rt, err := s.newAuthTx(ctx, "MarkMessage", false, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	msg, err := rt.markMessage(req)
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.Commit(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (s *shopServer) ReplyMessage(ctx context.Context, req *shop.MessageReply) (*shop.Message, error) {
+	rt, err := s.newAuthTx(ctx, "ReplyMessage", false, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	msg, err := rt.replyMessage(req)
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.Commit(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (s *shopServer) Subscribe(ctx context.Context, req *shop.Subscription) (*shop.SubscriptionReply, error) {
+	rt, err := s.newTx(ctx, "Subscribe", false)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	addr := clientAddr(ctx, s.conf.Proxies)
+	if err = s.verifyCaptcha(ctx, req.GetCaptchaToken(), addr); err != nil {
+		return nil, err
+	}
+	if err = rt.subscribe(req, addr); err != nil {
+		return nil, err
+	}
+	if err = rt.Commit(); err != nil {
+		return nil, err
+	}
+	return &shop.SubscriptionReply{}, nil
+}
+
+func (s *shopServer) ConfirmSubscription(ctx context.Context, req *shop.SubscriptionToken) (*shop.Subscriber, error) {
+	rt, err := s.newTx(ctx, "ConfirmSubscription", false)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	sub, err := rt.confirmSubscription(req.GetToken(), clientAddr(ctx, s.conf.Proxies))
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.Commit(); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (s *shopServer) Unsubscribe(ctx context.Context, req *shop.SubscriptionToken) (*shop.Subscriber, error) {
+	rt, err := s.newTx(ctx, "Unsubscribe", false)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	sub, err := rt.unsubscribe(req.GetToken(), clientAddr(ctx, s.conf.Proxies))
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.Commit(); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (s *shopServer) ExportSubscribers(ctx context.Context, req *shop.SubscriberExportRequest) (*shop.ReportCSV, error) {
+	rt, err := s.newAuthTx(ctx, "ExportSubscribers", true, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	return rt.exportSubscribers()
+}
+
+func (s *shopServer) ExportPersonalData(ctx context.Context, req *shop.PersonalDataRequest) (*shop.PersonalDataArchive, error) {
+	rt, err := s.newAuthTx(ctx, "ExportPersonalData", true, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	return rt.exportPersonalData(req.GetEmail())
+}
+
+func (s *shopServer) ErasePersonalData(ctx context.Context, req *shop.PersonalDataRequest) (*shop.ErasureReport, error) {
+	rt, err := s.newAuthTx(ctx, "ErasePersonalData", false, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	report, err := rt.erasePersonalData(req.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.Commit(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"reflect"
 	"testing"
 	"time"
@@ -9,6 +11,7 @@ import (
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/moapis/shop"
 	"github.com/moapis/shop/models"
+	"google.golang.org/grpc"
 )
 
 var testToken string
@@ -298,10 +301,11 @@ func Test_shopServer_ListArticles(t *testing.T) {
 				},
 			},
 			&shop.ArticleList{List: []*shop.Article{{
-				Created:  &timestamp.Timestamp{Seconds: 3000},
-				Title:    "ID 13",
-				Price:    "22.99",
-				Promoted: true,
+				Created:      &timestamp.Timestamp{Seconds: 3000},
+				Title:        "ID 13",
+				Price:        "22.99",
+				Promoted:     true,
+				CurrentPrice: "22.99",
 			}}},
 			false,
 		},
@@ -391,7 +395,7 @@ func Test_shopServer_DeleteArticle(t *testing.T) {
 					Token: testToken,
 				},
 			},
-			&shop.Deleted{Rows: 13},
+			&shop.Deleted{Rows: 1},
 			false,
 		},
 	}
@@ -415,101 +419,180 @@ func Test_shopServer_DeleteArticle(t *testing.T) {
 	}
 }
 
-func Test_shopServer_Checkout(t *testing.T) {
+func Test_shopServer_RestoreArticle(t *testing.T) {
 	ectx, cancel := context.WithCancel(context.Background())
 	cancel()
 
-	cc := *testConfig
-	cc.Mail.Host = "foobar"
-
-	ets, err := cc.newShopServer()
-	if err != nil {
-		t.Fatal(err)
-	}
-
 	type args struct {
 		ctx context.Context
-		req *shop.Order
+		req *shop.ArticleID
 	}
 	tests := []struct {
 		name    string
-		server  *shopServer
 		args    args
-		want    *shop.OrderID
+		want    *shop.ArticleID
 		wantErr bool
 	}{
 		{
 			"Context error",
-			tss,
 			args{
 				ectx,
-				&shop.Order{},
+				&shop.ArticleID{
+					Id:    13,
+					Token: testToken,
+				},
 			},
 			nil,
 			true,
 		},
 		{
-			"Order",
-			tss,
+			"Missing ID",
 			args{
 				testCtx,
-				testShopOrders[0],
+				&shop.ArticleID{
+					Id:    0,
+					Token: testToken,
+				},
 			},
-			&shop.OrderID{Id: 1},
-			false,
+			nil,
+			true,
 		},
 		{
-			"Mail error",
-			ets,
+			"Bad token",
 			args{
 				testCtx,
-				testShopOrders[0],
+				&shop.ArticleID{
+					Id:    13,
+					Token: "foobar",
+				},
+			},
+			nil,
+			true,
+		},
+		{
+			"Not deleted",
+			args{
+				testCtx,
+				&shop.ArticleID{
+					Id:    12,
+					Token: testToken,
+				},
 			},
 			nil,
 			true,
 		},
 	}
-	for k, tt := range tests {
+	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := tt.server.Checkout(tt.args.ctx, tt.args.req)
+			got, err := tss.RestoreArticle(tt.args.ctx, tt.args.req)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("shopServer.Checkout() #%d error = %v, wantErr %v", k, err, tt.wantErr)
+				t.Errorf("shopServer.RestoreArticle() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("shopServer.Checkout() = %v, want %v", got, tt.want)
+				t.Errorf("shopServer.RestoreArticle() = %v, want %v", got, tt.want)
 			}
 		})
 	}
-	migrateDown()
-	migrations()
-	if err := testData(); err != nil {
-		t.Fatal(err)
+}
+
+func Test_shopServer_ListDeletedArticles(t *testing.T) {
+	ectx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	type args struct {
+		ctx context.Context
+		req *shop.DeletedListConditions
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []int32
+		wantErr bool
+	}{
+		{
+			"Context error",
+			args{
+				ectx,
+				&shop.DeletedListConditions{Token: testToken},
+			},
+			nil,
+			true,
+		},
+		{
+			"Bad token",
+			args{
+				testCtx,
+				&shop.DeletedListConditions{Token: "foobar"},
+			},
+			nil,
+			true,
+		},
+		{
+			"Success",
+			args{
+				testCtx,
+				&shop.DeletedListConditions{Token: testToken},
+			},
+			[]int32{13}, // Deleted by Test_shopServer_DeleteArticle
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tss.ListDeletedArticles(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.ListDeletedArticles() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var ids []int32
+			for _, a := range got.GetList() {
+				ids = append(ids, a.GetId())
+			}
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("shopServer.ListDeletedArticles() = %v, want %v", ids, tt.want)
+			}
+		})
 	}
 }
 
-func Test_shopServer_ListOrders(t *testing.T) {
+func Test_shopServer_ListArticleRevisions(t *testing.T) {
 	ectx, cancel := context.WithCancel(context.Background())
 	cancel()
 
 	type args struct {
 		ctx context.Context
-		req *shop.ListOrderConditions
+		req *shop.RevisionListConditions
 	}
 	tests := []struct {
 		name    string
 		args    args
-		want    *shop.OrderList
+		want    []string
 		wantErr bool
 	}{
 		{
 			"Context error",
 			args{
 				ectx,
-				&shop.ListOrderConditions{
-					Status: shop.ListOrderConditions_SENT,
-					Token:  testToken,
-				},
+				&shop.RevisionListConditions{ArticleId: 13, Token: testToken},
+			},
+			nil,
+			true,
+		},
+		{
+			"Bad token",
+			args{
+				testCtx,
+				&shop.RevisionListConditions{ArticleId: 13, Token: "foobar"},
+			},
+			nil,
+			true,
+		},
+		{
+			"Missing ID",
+			args{
+				testCtx,
+				&shop.RevisionListConditions{Token: testToken},
 			},
 			nil,
 			true,
@@ -518,222 +601,900 @@ func Test_shopServer_ListOrders(t *testing.T) {
 			"Success",
 			args{
 				testCtx,
-				&shop.ListOrderConditions{
-					Status: shop.ListOrderConditions_SENT,
-					Token:  testToken,
-				},
+				&shop.RevisionListConditions{ArticleId: 13, Token: testToken},
 			},
-			&shop.OrderList{List: []*shop.Order{{
-				Id:            100,
-				Created:       &timestamp.Timestamp{Seconds: 12},
-				Updated:       &timestamp.Timestamp{Seconds: 34},
-				FullName:      "What Is My Name",
-				Email:         "me@example.com",
-				Phone:         "0123456789",
-				FullAddress:   "No. 7, Long Street, Somewhere",
-				Message:       "My awesome order",
-				PaymentMethod: shop.Order_CASH_ON_DELIVERY,
-				Status:        shop.Order_SENT,
-				Articles:      testShopOrderArticles,
-				Sum:           "30779.1075",
-			}}},
+			[]string{"ID 13"}, // Saved by Test_shopServer_SaveArticle
 			false,
 		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tss.ListArticleRevisions(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.ListArticleRevisions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var titles []string
+			for _, r := range got.GetList() {
+				titles = append(titles, r.GetArticle().GetTitle())
+			}
+			if !reflect.DeepEqual(titles, tt.want) {
+				t.Errorf("shopServer.ListArticleRevisions() = %v, want %v", titles, tt.want)
+			}
+		})
+	}
+}
+
+func Test_shopServer_RevertArticle(t *testing.T) {
+	ectx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	type args struct {
+		ctx context.Context
+		req *shop.RevisionID
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *shop.ArticleID
+		wantErr bool
+	}{
+		{
+			"Context error",
+			args{
+				ectx,
+				&shop.RevisionID{Id: 1, Token: testToken},
+			},
+			nil,
+			true,
+		},
 		{
 			"Bad token",
 			args{
 				testCtx,
-				&shop.ListOrderConditions{
-					Status: shop.ListOrderConditions_SENT,
-					Token:  "fooBar",
-				},
+				&shop.RevisionID{Id: 1, Token: "foobar"},
+			},
+			nil,
+			true,
+		},
+		{
+			"Missing ID",
+			args{
+				testCtx,
+				&shop.RevisionID{Token: testToken},
+			},
+			nil,
+			true,
+		},
+		{
+			"Non-existing revision",
+			args{
+				testCtx,
+				&shop.RevisionID{Id: 9999, Token: testToken},
+			},
+			nil,
+			true,
+		},
+		{
+			"Deleted article",
+			args{
+				testCtx,
+				&shop.RevisionID{Id: 1, Token: testToken}, // Article 13, deleted by Test_shopServer_DeleteArticle
+			},
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tss.RevertArticle(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.RevertArticle() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("shopServer.RevertArticle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// testImportStream implements shop.Shop_ImportArticlesServer
+type testImportStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	reqs    []*shop.ImportRequest
+	recvErr error // Returned after all reqs
+	results []*shop.ImportResult
+}
+
+func (s *testImportStream) Context() context.Context { return s.ctx }
+
+func (s *testImportStream) Recv() (*shop.ImportRequest, error) {
+	if len(s.reqs) == 0 {
+		if s.recvErr != nil {
+			return nil, s.recvErr
+		}
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *testImportStream) Send(res *shop.ImportResult) error {
+	s.results = append(s.results, res)
+	return nil
+}
+
+func Test_shopServer_ImportArticles(t *testing.T) {
+	ectx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	arts := []*shop.ImportRequest{
+		{
+			Article: &shop.Article{
+				Title:       "Imported",
+				Description: "Imported article",
+				Price:       "10",
+			},
+			DryRun: true,
+			Token:  testToken,
+		},
+		{
+			Article: &shop.Article{
+				Title:       "Bad price",
+				Description: "Imported article",
+				Price:       "spanac",
 			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		stream  *testImportStream
+		want    []bool // Saved or not
+		wantErr bool
+	}{
+		{
+			"Empty stream",
+			&testImportStream{ctx: testCtx},
+			nil,
+			true,
+		},
+		{
+			"Recv error",
+			&testImportStream{ctx: testCtx, recvErr: io.ErrUnexpectedEOF},
+			nil,
+			true,
+		},
+		{
+			"Context error",
+			&testImportStream{ctx: ectx, reqs: arts},
 			nil,
 			true,
 		},
+		{
+			"Bad token",
+			&testImportStream{
+				ctx:  testCtx,
+				reqs: []*shop.ImportRequest{{Article: arts[0].Article, Token: "foobar"}},
+			},
+			nil,
+			true,
+		},
+		{
+			"Recv error after first",
+			&testImportStream{ctx: testCtx, reqs: arts[:1], recvErr: io.ErrUnexpectedEOF},
+			[]bool{true},
+			true,
+		},
+		{
+			"Dry run",
+			&testImportStream{ctx: testCtx, reqs: arts},
+			[]bool{true, false},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tss.ImportArticles(tt.stream)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.ImportArticles() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var got []bool
+			for i, res := range tt.stream.results {
+				if res.GetRow() != int32(i+1) {
+					t.Errorf("shopServer.ImportArticles() Row = %d, want %d", res.GetRow(), i+1)
+				}
+				got = append(got, res.GetArticleId() != 0)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("shopServer.ImportArticles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Dry run must not have saved anything
+	rt, err := tss.newTx(testCtx, "testing", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Done()
+	if exists, err := models.Articles(models.ArticleWhere.Title.EQ("Imported")).Exists(rt.Ctx, rt.Tx); err != nil || exists {
+		t.Errorf("shopServer.ImportArticles() dry run saved = %v, err %v", exists, err)
+	}
+}
+
+// testExportStream implements shop.Shop_ExportArticlesServer
+type testExportStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	arts []*shop.Article
+}
+
+func (s *testExportStream) Context() context.Context { return s.ctx }
+
+func (s *testExportStream) Send(sa *shop.Article) error {
+	s.arts = append(s.arts, sa)
+	return nil
+}
+
+func Test_shopServer_ExportArticles(t *testing.T) {
+	ectx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		req     *shop.ExportConditions
+		want    []int32
+		wantErr bool
+	}{
+		{
+			"Context error",
+			ectx,
+			&shop.ExportConditions{Token: testToken},
+			nil,
+			true,
+		},
+		{
+			"Bad token",
+			testCtx,
+			&shop.ExportConditions{Token: "foobar"},
+			nil,
+			true,
+		},
+		{
+			"Published",
+			testCtx,
+			&shop.ExportConditions{OnlyPublished: true, Token: testToken},
+			[]int32{12},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &testExportStream{ctx: tt.ctx}
+			if err := tss.ExportArticles(tt.req, stream); (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.ExportArticles() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			var got []int32
+			for _, sa := range stream.arts {
+				got = append(got, sa.GetId())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("shopServer.ExportArticles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_shopServer_Checkout(t *testing.T) {
+	ectx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cc := *testConfig
+	cc.Mail.Host = "foobar"
+
+	ets, err := cc.newShopServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type args struct {
+		ctx context.Context
+		req *shop.Order
+	}
+	tests := []struct {
+		name    string
+		server  *shopServer
+		args    args
+		want    *shop.OrderID
+		wantErr bool
+	}{
+		{
+			"Context error",
+			tss,
+			args{
+				ectx,
+				&shop.Order{},
+			},
+			nil,
+			true,
+		},
+		{
+			"Order",
+			tss,
+			args{
+				testCtx,
+				testShopOrders[0],
+			},
+			&shop.OrderID{Id: 1},
+			false,
+		},
+		{
+			"SMTP error",
+			ets,
+			args{
+				testCtx,
+				testShopOrders[0],
+			},
+			&shop.OrderID{Id: 2},
+			false,
+		},
+	}
+	for k, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.server.Checkout(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.Checkout() #%d error = %v, wantErr %v", k, err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("shopServer.Checkout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	migrateDown()
+	migrations()
+	if err := testData(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_shopServer_ListOrders(t *testing.T) {
+	ectx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	type args struct {
+		ctx context.Context
+		req *shop.ListOrderConditions
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *shop.OrderList
+		wantErr bool
+	}{
+		{
+			"Context error",
+			args{
+				ectx,
+				&shop.ListOrderConditions{
+					Status: shop.ListOrderConditions_SENT,
+					Token:  testToken,
+				},
+			},
+			nil,
+			true,
+		},
+		{
+			"Success",
+			args{
+				testCtx,
+				&shop.ListOrderConditions{
+					Status: shop.ListOrderConditions_SENT,
+					Token:  testToken,
+				},
+			},
+			&shop.OrderList{List: []*shop.Order{{
+				Id:            100,
+				Created:       &timestamp.Timestamp{Seconds: 12},
+				Updated:       &timestamp.Timestamp{Seconds: 34},
+				FullName:      "What Is My Name",
+				Email:         "me@example.com",
+				Phone:         "0123456789",
+				FullAddress:   "No. 7, Long Street, Somewhere",
+				Message:       "My awesome order",
+				PaymentMethod: shop.Order_CASH_ON_DELIVERY,
+				Status:        shop.Order_SENT,
+				Articles:      testShopOrderArticles,
+				Sum:           "30779.1075",
+			}}},
+			false,
+		},
+		{
+			"Bad token",
+			args{
+				testCtx,
+				&shop.ListOrderConditions{
+					Status: shop.ListOrderConditions_SENT,
+					Token:  "fooBar",
+				},
+			},
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tss.ListOrders(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.ListOrders() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("shopServer.ListOrders() = \n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_shopServer_SaveOrder(t *testing.T) {
+	ectx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cc := *testConfig
+	cc.Mail.Host = "foobar"
+
+	ets, err := cc.newShopServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type args struct {
+		ctx context.Context
+		req *shop.Order
+	}
+	tests := []struct {
+		name    string
+		server  *shopServer
+		args    args
+		want    *shop.OrderID
+		wantErr bool
+	}{
+		{
+			"Context error",
+			tss,
+			args{
+				ectx,
+				&shop.Order{
+					Id:            101,
+					FullName:      "What Is My Name",
+					Email:         "me@example.com",
+					Phone:         "0123456789",
+					FullAddress:   "No. 7, Long Street, Somewhere",
+					Message:       "Better status",
+					PaymentMethod: shop.Order_CASH_ON_DELIVERY,
+					Status:        shop.Order_OPEN,
+					Token:         testToken,
+				},
+			},
+			nil,
+			true,
+		},
+		{
+			"Success",
+			tss,
+			args{
+				testCtx,
+				&shop.Order{
+					Id:            101,
+					FullName:      "What Is My Name",
+					Email:         "me@example.com",
+					Phone:         "0123456789",
+					FullAddress:   "No. 7, Long Street, Somewhere",
+					Message:       "Better status",
+					PaymentMethod: shop.Order_CASH_ON_DELIVERY,
+					Status:        shop.Order_OPEN,
+					Token:         testToken,
+				},
+			},
+			&shop.OrderID{Id: 101},
+			false,
+		},
+		{
+			"Invalid token",
+			tss,
+			args{
+				testCtx,
+				&shop.Order{
+					Id:            101,
+					FullName:      "What Is My Name",
+					Email:         "me@example.com",
+					Phone:         "0123456789",
+					FullAddress:   "No. 7, Long Street, Somewhere",
+					Message:       "Better status",
+					PaymentMethod: shop.Order_CASH_ON_DELIVERY,
+					Status:        shop.Order_OPEN,
+					Token:         "FOO",
+				},
+			},
+			nil,
+			true,
+		},
+		{
+			"Unknown ID",
+			tss,
+			args{
+				testCtx,
+				&shop.Order{
+					Id:            9999,
+					FullName:      "What Is My Name",
+					Email:         "me@example.com",
+					Phone:         "0123456789",
+					FullAddress:   "No. 7, Long Street, Somewhere",
+					Message:       "Better status",
+					PaymentMethod: shop.Order_CASH_ON_DELIVERY,
+					Status:        shop.Order_OPEN,
+					Token:         testToken,
+				},
+			},
+			nil,
+			true,
+		},
+		{
+			"SMTP error",
+			ets,
+			args{
+				testCtx,
+				&shop.Order{
+					Id:            101,
+					FullName:      "What Is My Name",
+					Email:         "me@example.com",
+					Phone:         "0123456789",
+					FullAddress:   "No. 7, Long Street, Somewhere",
+					Message:       "Better status",
+					PaymentMethod: shop.Order_CASH_ON_DELIVERY,
+					Status:        shop.Order_OPEN,
+					Token:         testToken,
+				},
+			},
+			&shop.OrderID{Id: 101},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.server.SaveOrder(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.SaveOrder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("shopServer.SaveOrder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	migrateDown()
+	migrations()
+	if err := testData(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func insertECat() (*models.Category, error) {
+	rt, err := tss.newTx(testCtx, "insterECat", false)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	ecat, err := insertECatTx(rt)
+	if err != nil {
+		return nil, err
+	}
+	return ecat, rt.Commit()
+}
+
+func deleteECat(ecat *models.Category) error {
+	rt, err := tss.newTx(testCtx, "insterECat", false)
+	if err != nil {
+		return err
+	}
+	defer rt.Done()
+
+	if _, err = ecat.Delete(rt.Ctx, rt.Tx); err != nil {
+		return err
+	}
+	return rt.Commit()
+}
+
+func Test_shopServer_RevenueReport(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *shop.ReportConditions
+		wantErr bool
+	}{
+		{
+			"Bad token",
+			&shop.ReportConditions{From: testReportConditions.From, To: testReportConditions.To, Token: "foobar"},
+			true,
+		},
+		{
+			"Success",
+			&shop.ReportConditions{From: testReportConditions.From, To: testReportConditions.To, Token: testToken},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tss.RevenueReport(testCtx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.RevenueReport() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("shopServer.RevenueReport() = nil")
+			}
+		})
+	}
+}
+
+func Test_shopServer_TopArticlesReport(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *shop.ReportConditions
+		wantErr bool
+	}{
+		{
+			"Bad token",
+			&shop.ReportConditions{From: testReportConditions.From, To: testReportConditions.To, Token: "foobar"},
+			true,
+		},
+		{
+			"Success",
+			&shop.ReportConditions{From: testReportConditions.From, To: testReportConditions.To, Token: testToken},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tss.TopArticlesReport(testCtx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.TopArticlesReport() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("shopServer.TopArticlesReport() = nil")
+			}
+		})
+	}
+}
+
+func Test_shopServer_OrdersReport(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *shop.ReportConditions
+		wantErr bool
+	}{
+		{
+			"Bad token",
+			&shop.ReportConditions{From: testReportConditions.From, To: testReportConditions.To, Token: "foobar"},
+			true,
+		},
+		{
+			"Success",
+			&shop.ReportConditions{From: testReportConditions.From, To: testReportConditions.To, Token: testToken},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tss.OrdersReport(testCtx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.OrdersReport() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("shopServer.OrdersReport() = nil")
+			}
+		})
+	}
+}
+
+func Test_shopServer_ExportReport(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *shop.ExportReportRequest
+		wantErr bool
+	}{
+		{
+			"Bad token",
+			&shop.ExportReportRequest{Report: shop.ExportReportRequest_ORDERS, Conditions: &shop.ReportConditions{From: testReportConditions.From, To: testReportConditions.To, Token: "foobar"}},
+			true,
+		},
+		{
+			"Success",
+			&shop.ExportReportRequest{Report: shop.ExportReportRequest_ORDERS, Conditions: &shop.ReportConditions{From: testReportConditions.From, To: testReportConditions.To, Token: testToken}},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tss.ExportReport(testCtx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.ExportReport() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("shopServer.ExportReport() = nil")
+			}
+		})
+	}
+}
+
+func Test_shopServer_Subscribe(t *testing.T) {
+	nts := newsletterServer(t)
+
+	tests := []struct {
+		name    string
+		server  *shopServer
+		req     *shop.Subscription
+		wantErr bool
+	}{
+		{"Disabled", tss, &shop.Subscription{Email: "subscriber@bar.com"}, true},
+		{"Missing email", nts, &shop.Subscription{}, true},
+		{"Success", nts, &shop.Subscription{Email: "subscriber@bar.com", Source: "footer"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.server.Subscribe(testCtx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.Subscribe() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("shopServer.Subscribe() = nil")
+			}
+		})
+	}
+}
+
+func Test_shopServer_ConfirmSubscription(t *testing.T) {
+	nts := newsletterServer(t)
+	token, err := signSubscriptionToken(testNewsletterSecret, subscriptionClaims{actionConfirm, "subscriber@bar.com", time.Now().Add(time.Hour).Unix()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		req     *shop.SubscriptionToken
+		want    shop.Subscriber_Status
+		wantErr bool
+	}{
+		{"Missing token", &shop.SubscriptionToken{}, 0, true},
+		{"Success", &shop.SubscriptionToken{Token: token}, shop.Subscriber_CONFIRMED, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := nts.ConfirmSubscription(testCtx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.ConfirmSubscription() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got.GetStatus() != tt.want {
+				t.Errorf("shopServer.ConfirmSubscription() = %v, want %v", got.GetStatus(), tt.want)
+			}
+		})
+	}
+}
+
+func Test_shopServer_ExportSubscribers(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *shop.SubscriberExportRequest
+		wantErr bool
+	}{
+		{"Bad token", &shop.SubscriberExportRequest{Token: "foobar"}, true},
+		{"Success", &shop.SubscriberExportRequest{Token: testToken}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tss.ExportSubscribers(testCtx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.ExportSubscribers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !bytes.Contains(got.GetData(), []byte("subscriber@bar.com")) {
+				t.Errorf("shopServer.ExportSubscribers() = %s", got.GetData())
+			}
+		})
+	}
+}
+
+func Test_shopServer_ExportPersonalData(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *shop.PersonalDataRequest
+		wantErr bool
+	}{
+		{"Bad token", &shop.PersonalDataRequest{Token: "foobar", Email: "me@example.com"}, true},
+		{"Missing email", &shop.PersonalDataRequest{Token: testToken}, true},
+		{"Success", &shop.PersonalDataRequest{Token: testToken, Email: "me@example.com"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tss.ExportPersonalData(testCtx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.ExportPersonalData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !bytes.Contains(got.GetData(), []byte("No. 7, Long Street, Somewhere")) {
+				t.Errorf("shopServer.ExportPersonalData() = %s", got.GetData())
+			}
+		})
+	}
+}
+
+func Test_shopServer_ErasePersonalData(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *shop.PersonalDataRequest
+		want    *shop.ErasureReport
+		wantErr bool
+	}{
+		{"Bad token", &shop.PersonalDataRequest{Token: "foobar", Email: "nobody@example.com"}, nil, true},
+		{"Missing email", &shop.PersonalDataRequest{Token: testToken}, nil, true},
+		{"Success", &shop.PersonalDataRequest{Token: testToken, Email: "nobody@example.com"}, &shop.ErasureReport{Email: "nobody@example.com"}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := tss.ListOrders(tt.args.ctx, tt.args.req)
+			got, err := tss.ErasePersonalData(testCtx, tt.req)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("shopServer.ListOrders() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("shopServer.ErasePersonalData() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("shopServer.ListOrders() = \n%v\nwant\n%v", got, tt.want)
+				t.Errorf("shopServer.ErasePersonalData() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func Test_shopServer_SaveOrder(t *testing.T) {
-	ectx, cancel := context.WithCancel(context.Background())
-	cancel()
-
-	cc := *testConfig
-	cc.Mail.Host = "foobar"
-
-	ets, err := cc.newShopServer()
+func Test_shopServer_Unsubscribe(t *testing.T) {
+	nts := newsletterServer(t)
+	token, err := signSubscriptionToken(testNewsletterSecret, subscriptionClaims{Action: actionUnsubscribe, Email: "subscriber@bar.com"})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	type args struct {
-		ctx context.Context
-		req *shop.Order
-	}
 	tests := []struct {
 		name    string
-		server  *shopServer
-		args    args
-		want    *shop.OrderID
+		req     *shop.SubscriptionToken
+		want    shop.Subscriber_Status
 		wantErr bool
 	}{
-		{
-			"Context error",
-			tss,
-			args{
-				ectx,
-				&shop.Order{
-					Id:            101,
-					FullName:      "What Is My Name",
-					Email:         "me@example.com",
-					Phone:         "0123456789",
-					FullAddress:   "No. 7, Long Street, Somewhere",
-					Message:       "Better status",
-					PaymentMethod: shop.Order_CASH_ON_DELIVERY,
-					Status:        shop.Order_OPEN,
-					Token:         testToken,
-				},
-			},
-			nil,
-			true,
-		},
-		{
-			"Success",
-			tss,
-			args{
-				testCtx,
-				&shop.Order{
-					Id:            101,
-					FullName:      "What Is My Name",
-					Email:         "me@example.com",
-					Phone:         "0123456789",
-					FullAddress:   "No. 7, Long Street, Somewhere",
-					Message:       "Better status",
-					PaymentMethod: shop.Order_CASH_ON_DELIVERY,
-					Status:        shop.Order_OPEN,
-					Token:         testToken,
-				},
-			},
-			&shop.OrderID{Id: 101},
-			false,
-		},
-		{
-			"Invalid token",
-			tss,
-			args{
-				testCtx,
-				&shop.Order{
-					Id:            101,
-					FullName:      "What Is My Name",
-					Email:         "me@example.com",
-					Phone:         "0123456789",
-					FullAddress:   "No. 7, Long Street, Somewhere",
-					Message:       "Better status",
-					PaymentMethod: shop.Order_CASH_ON_DELIVERY,
-					Status:        shop.Order_OPEN,
-					Token:         "FOO",
-				},
-			},
-			nil,
-			true,
-		},
-		{
-			"Unknown ID",
-			tss,
-			args{
-				testCtx,
-				&shop.Order{
-					Id:            9999,
-					FullName:      "What Is My Name",
-					Email:         "me@example.com",
-					Phone:         "0123456789",
-					FullAddress:   "No. 7, Long Street, Somewhere",
-					Message:       "Better status",
-					PaymentMethod: shop.Order_CASH_ON_DELIVERY,
-					Status:        shop.Order_OPEN,
-					Token:         testToken,
-				},
-			},
-			nil,
-			true,
-		},
-		{
-			"Mail error",
-			ets,
-			args{
-				testCtx,
-				&shop.Order{
-					Id:            101,
-					FullName:      "What Is My Name",
-					Email:         "me@example.com",
-					Phone:         "0123456789",
-					FullAddress:   "No. 7, Long Street, Somewhere",
-					Message:       "Better status",
-					PaymentMethod: shop.Order_CASH_ON_DELIVERY,
-					Status:        shop.Order_OPEN,
-					Token:         testToken,
-				},
-			},
-			nil,
-			true,
-		},
+		{"Missing token", &shop.SubscriptionToken{}, 0, true},
+		{"Success", &shop.SubscriptionToken{Token: token}, shop.Subscriber_UNSUBSCRIBED, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := tt.server.SaveOrder(tt.args.ctx, tt.args.req)
+			got, err := nts.Unsubscribe(testCtx, tt.req)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("shopServer.SaveOrder() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("shopServer.Unsubscribe() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("shopServer.SaveOrder() = %v, want %v", got, tt.want)
+			if got.GetStatus() != tt.want {
+				t.Errorf("shopServer.Unsubscribe() = %v, want %v", got.GetStatus(), tt.want)
 			}
 		})
 	}
-	migrateDown()
-	migrations()
-	if err := testData(); err != nil {
-		t.Fatal(err)
-	}
-}
-
-func insertECat() (*models.Category, error) {
-	rt, err := tss.newTx(testCtx, "insterECat", false)
-	if err != nil {
-		return nil, err
-	}
-	defer rt.Done()
-
-	ecat, err := insertECatTx(rt)
-	if err != nil {
-		return nil, err
-	}
-	return ecat, rt.Commit()
-}
-
-func deleteECat(ecat *models.Category) error {
-	rt, err := tss.newTx(testCtx, "insterECat", false)
-	if err != nil {
-		return err
-	}
-	defer rt.Done()
-
-	if _, err = ecat.Delete(rt.Ctx, rt.Tx); err != nil {
-		return err
-	}
-	return rt.Commit()
 }
 
 func Test_shopServer_SaveCategories(t *testing.T) {
@@ -950,6 +1711,102 @@ func Test_shopServer_ListCategories(t *testing.T) {
 	}
 }
 
+func Test_shopServer_SaveAttributes(t *testing.T) {
+	ectx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	type args struct {
+		ctx context.Context
+		req *shop.AttributeList
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantLabel []string
+		wantErr   bool
+	}{
+		{
+			"Context error",
+			args{
+				ectx,
+				&shop.AttributeList{
+					List:  testShopAttributes,
+					Token: testToken,
+				},
+			},
+			nil,
+			true,
+		},
+		{
+			"Bad token",
+			args{
+				testCtx,
+				&shop.AttributeList{
+					List:  testShopAttributes,
+					Token: "fooBar",
+				},
+			},
+			nil,
+			true,
+		},
+		{
+			"Empty attribute",
+			args{
+				testCtx,
+				&shop.AttributeList{
+					List:  []*shop.Attribute{{}},
+					Token: testToken,
+				},
+			},
+			nil,
+			true,
+		},
+		{
+			"Success",
+			args{
+				testCtx,
+				&shop.AttributeList{
+					List:  testShopAttributes,
+					Token: testToken,
+				},
+			},
+			[]string{"Material", "Lungime", "Culoare"},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tss.SaveAttributes(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.SaveAttributes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got.GetList()) != len(tt.wantLabel) {
+				t.Fatalf("shopServer.SaveAttributes() = %v, want %v", got, tt.wantLabel)
+			}
+			for i, w := range tt.wantLabel {
+				if got.GetList()[i].GetLabel() != w || got.GetList()[i].GetId() == 0 {
+					t.Errorf("shopServer.SaveAttributes() = \n%v\nwant\n%v", got.GetList()[i], w)
+				}
+			}
+		})
+	}
+
+	got, err := tss.ListAttributes(testCtx, &shop.AttributeListConditions{OnlyCategoryId: 21})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.GetList()) != 1 || got.GetList()[0].GetLabel() != "Culoare" {
+		t.Errorf("shopServer.ListAttributes() = %v, want %v", got, "Culoare")
+	}
+
+	migrateDown()
+	migrations()
+	if err := testData(); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func Test_shopServer_SearchArticles(t *testing.T) {
 	ectx, cfn := context.WithDeadline(context.TODO(), time.Now().Add(time.Second*3))
 	cfn()
@@ -998,14 +1855,15 @@ func Test_shopServer_SearchArticles(t *testing.T) {
 
 func Test_shopServer_Suggest(t *testing.T) {
 	firstArticle := &shop.Article{
-		Id:          11,
-		Created:     &timestamp.Timestamp{Seconds: 8000},
-		Updated:     &timestamp.Timestamp{Seconds: 9000},
-		Published:   false,
-		Title:       "ID 11",
-		Description: "This is the first article",
-		Price:       "30000.99",
-		Promoted:    false,
+		Id:           11,
+		Created:      &timestamp.Timestamp{Seconds: 8000},
+		Updated:      &timestamp.Timestamp{Seconds: 9000},
+		Published:    false,
+		Title:        "ID 11",
+		Description:  "This is the first article",
+		Price:        "30000.99",
+		Promoted:     false,
+		CurrentPrice: "30000.99",
 	}
 	ectx, cfn := context.WithDeadline(context.TODO(), time.Now().Add(time.Second*3))
 	cfn()
@@ -1248,6 +2106,47 @@ func Test_shopServer_ListBasesPrices(t *testing.T) {
 	}
 }
 
+func Test_shopServer_Webhooks(t *testing.T) {
+	if _, err := tss.SaveWebhook(testCtx, &shop.Webhook{Token: "foo"}); err == nil {
+		t.Error("shopServer.SaveWebhook() expected auth error")
+	}
+	if _, err := tss.ListWebhooks(testCtx, &shop.WebhookListConditions{Token: "foo"}); err == nil {
+		t.Error("shopServer.ListWebhooks() expected auth error")
+	}
+	if _, err := tss.DeleteWebhook(testCtx, &shop.WebhookID{Token: "foo"}); err == nil {
+		t.Error("shopServer.DeleteWebhook() expected auth error")
+	}
+	if _, err := tss.DeleteWebhook(testCtx, &shop.WebhookID{Token: testToken}); err == nil {
+		t.Error("shopServer.DeleteWebhook() expected missing ID error")
+	}
+
+	sw, err := tss.SaveWebhook(testCtx, &shop.Webhook{
+		Url:    "https://example.com/hook",
+		Secret: "secret",
+		Events: []shop.Webhook_Event{shop.Webhook_ORDER_CREATED},
+		Token:  testToken,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := tss.ListWebhooks(testCtx, &shop.WebhookListConditions{Token: testToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.GetList()) != 1 || list.GetList()[0].GetUrl() != sw.GetUrl() {
+		t.Errorf("shopServer.ListWebhooks() = %v", list)
+	}
+
+	del, err := tss.DeleteWebhook(testCtx, &shop.WebhookID{Id: sw.GetId(), Token: testToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if del.GetRows() != 1 {
+		t.Errorf("shopServer.DeleteWebhook() = %v, want 1 row", del)
+	}
+}
+
 func Test_shopServer_SendMessage(t *testing.T) {
 	ectx, cancel := context.WithCancel(context.Background())
 	cancel()
@@ -1295,6 +2194,20 @@ func Test_shopServer_SendMessage(t *testing.T) {
 			&shop.MessageID{Id: 1},
 			false,
 		},
+		{
+			"Honeypot",
+			args{
+				testCtx,
+				&shop.Message{
+					Name:     "Spambot",
+					Email:    "spam@bar.com",
+					Message:  "Buy now!",
+					Honeypot: "http://spam.example.com",
+				},
+			},
+			&shop.MessageID{},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
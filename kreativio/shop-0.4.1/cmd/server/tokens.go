@@ -0,0 +1,71 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// Errors returned by parseToken and its callers.
+var (
+	errTokenMalformed = errors.New("malformed token")
+	errTokenSignature = errors.New("invalid token signature")
+	errTokenExpired   = errors.New("token expired")
+)
+
+// signToken returns the base64 encoded JSON of claims and its HMAC-SHA256 signature, separated by a dot.
+// Such tokens are used in links sent by mail, where the shop cannot issue a JWT.
+func signToken(secret string, claims interface{}) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	enc := base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(enc))
+	return enc + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// parseToken verifies the signature of token and decodes its claims into v.
+func parseToken(secret, token string, v interface{}) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return errTokenMalformed
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return errTokenMalformed
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(parts[0]))
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return errTokenSignature
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return errTokenMalformed
+	}
+	return json.Unmarshal(payload, v)
+}
+
+// tokenURL sets token as query parameter on base.
+func tokenURL(base, token string) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
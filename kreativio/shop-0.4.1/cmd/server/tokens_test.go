@@ -0,0 +1,69 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_parseToken(t *testing.T) {
+	type claims struct {
+		Foo string `json:"foo"`
+	}
+	token, err := signToken("secret", claims{"bar"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		secret  string
+		token   string
+		want    string
+		wantErr error
+	}{
+		{"Valid", "secret", token, "bar", nil},
+		{"Wrong secret", "other", token, "", errTokenSignature},
+		{"Malformed", "secret", "foo", "", errTokenMalformed},
+		{"Signature encoding", "secret", "foo.!!!", "", errTokenMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got claims
+			if err := parseToken(tt.secret, tt.token, &got); !errors.Is(err, tt.wantErr) {
+				t.Errorf("parseToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.Foo != tt.want {
+				t.Errorf("parseToken() = %v, want %v", got.Foo, tt.want)
+			}
+		})
+	}
+}
+
+func Test_tokenURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    string
+		want    string
+		wantErr bool
+	}{
+		{"Plain", "https://kreativio.ro/confirm", "https://kreativio.ro/confirm?token=abc.def", false},
+		{"Query", "https://kreativio.ro/confirm?lang=ro", "https://kreativio.ro/confirm?lang=ro&token=abc.def", false},
+		{"Error", "://foo", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tokenURL(tt.base, "abc.def")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("tokenURL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("tokenURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
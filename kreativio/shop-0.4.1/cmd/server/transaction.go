@@ -16,7 +16,6 @@ import (
 	"time"
 
 	"github.com/ericlagergren/decimal"
-	"github.com/moapis/mailer"
 	"github.com/moapis/shop"
 	"github.com/moapis/shop/builder"
 	"github.com/moapis/shop/mobilpay"
@@ -31,6 +30,7 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -40,6 +40,8 @@ const (
 	errTS           = "Timestamp conversion error"
 	errNotFound     = "%s with %s %v not found"
 	errVrtPrice     = "Article ID %d missing BasePrice or Variant ID"
+	errDeleted      = "Article ID %d is deleted, restore it first"
+	errTitleTaken   = "Article with title %q already exists"
 )
 
 // requestTx holds a transaction.Request and the shopServer
@@ -56,16 +58,41 @@ func (s *shopServer) newTx(ctx context.Context, method string, readOnly bool) (*
 	return &requestTx{rt, s}, nil
 }
 
+// newAuthTx opens a transaction for a user in the groups, and with the permission, configured for method.
+// The user is identified by the claims from the authorization metadata.
+// Without them, token is verified instead. This fallback for tokens in message fields is deprecated.
 func (s *shopServer) newAuthTx(ctx context.Context, method string, readOnly bool, token string) (*requestTx, error) {
 	entry := s.log.WithField("method", method)
-	if token == "" {
-		entry.Warn(errMissingToken)
-		return nil, status.Error(codes.Unauthenticated, errMissingToken)
-	}
 
-	rt, err := s.tv.NewAuth(ctx, entry, s.mdb, readOnly, s.conf.SQLRoutines, token, s.conf.Groups[method]...)
-	if err != nil {
-		return nil, err
+	var (
+		rt  *transaction.Request
+		err error
+	)
+	if claims, ok := claimsFromContext(ctx); ok {
+		if err = checkGroups(claims, s.conf.Groups[method]); err != nil {
+			entry.WithFields(logrus.Fields{"user": claims.Subject, "required": s.conf.Groups[method]}).WithError(err).Error("checkGroups")
+			return nil, err
+		}
+		if rt, err = transaction.New(ctx, entry, s.mdb, readOnly, s.conf.SQLRoutines); err != nil {
+			return nil, err
+		}
+		rt.Claims = claims
+	} else {
+		if token == "" {
+			entry.Warn(errMissingToken)
+			return nil, status.Error(codes.Unauthenticated, errMissingToken)
+		}
+		entry.Info(msgTokenField)
+
+		if rt, err = s.tv.NewAuth(ctx, entry, s.mdb, readOnly, s.conf.SQLRoutines, token, s.conf.Groups[method]...); err != nil {
+			return nil, err
+		}
+		// Bearer tokens are checked by the auth interceptors
+		if err = s.checkPermission(rt.Claims, method); err != nil {
+			entry.WithField("user", rt.Claims.Subject).WithError(err).Warn("checkPermission")
+			rt.Done()
+			return nil, err
+		}
 	}
 	rt.Log = rt.Log.WithField("user", rt.Claims.Subject)
 	rt.Log.Info("Authenticated request")
@@ -73,15 +100,41 @@ func (s *shopServer) newAuthTx(ctx context.Context, method string, readOnly bool
 	return &requestTx{rt, s}, nil
 }
 
+// logCond returns a copy of cond for logging, without the deprecated token field.
+func logCond(cond proto.Message) proto.Message {
+	c := proto.Clone(cond)
+	m := c.ProtoReflect()
+	if fd := m.Descriptor().Fields().ByName("token"); fd != nil {
+		m.Clear(fd)
+	}
+	return c
+}
+
 func (rt *requestTx) upsertArticle(sa *shop.Article) (*models.Article, error) {
 	art, err := articleMsgToModel(sa)
 	if err != nil {
 		rt.Log.WithError(err).Warn("articleMsgToModel")
+		return nil, err
 	}
 
 	idc := models.ArticleColumns.ID
 	entry := rt.Log.WithField("article", art)
-	if err := art.Upsert(rt.Ctx, rt.Tx, true, []string{idc}, boil.Blacklist(idc), boil.Infer()); err != nil {
+
+	if art.ID != 0 {
+		deleted, err := models.Articles(
+			models.ArticleWhere.ID.EQ(art.ID),
+			models.ArticleWhere.DeletedAt.IsNotNull(),
+		).Exists(rt.Ctx, rt.Tx)
+		if err = rt.checkDBErrors("rt.upsertArticle deleted", []error{err}, false); err != nil {
+			return nil, err
+		}
+		if deleted {
+			entry.Warnf(errDeleted, art.ID)
+			return nil, status.Errorf(codes.FailedPrecondition, errDeleted, art.ID)
+		}
+	}
+
+	if err := art.Upsert(rt.Ctx, rt.Tx, true, []string{idc}, boil.Blacklist(idc, models.ArticleColumns.LiveNotifiedAt, models.ArticleColumns.DeletedAt), boil.Infer()); err != nil {
 		entry.WithError(err).Error("rt.upsertArticle")
 		return nil, status.Error(codes.Internal, errDB)
 	}
@@ -125,6 +178,9 @@ func (rt *requestTx) setArticleBasePrices(art *models.Article, sbp []*shop.BaseP
 	return nil
 }
 
+// updateVariants updates existing variants in place and inserts new ones.
+// Variants missing from sv are archived when referenced by orders, deleted otherwise.
+// Archived variants present in sv are restored.
 func (rt *requestTx) updateVariants(aid int, sv []*shop.Variant) error {
 	vars, err := variantsMsgToModel(aid, sv)
 	if err != nil {
@@ -132,17 +188,52 @@ func (rt *requestTx) updateVariants(aid int, sv []*shop.Variant) error {
 		return err
 	}
 
-	// Cleanup old variants
-	ra, err := models.Variants(models.VariantWhere.ArticleID.EQ(aid)).DeleteAll(rt.Ctx, rt.Tx)
+	keep := make(map[int64]bool, len(vars))
+	ids := make([]int64, 0, len(vars))
+	for _, v := range vars {
+		if v.ID != 0 {
+			keep[v.ID] = false
+			ids = append(ids, v.ID)
+		}
+	}
+
+	current := models.VariantWhere.Archived.EQ(false)
+	if len(ids) > 0 {
+		current = qm.Expr(current, qm.Or2(models.VariantWhere.ID.IN(ids)))
+	}
+
+	existing, err := models.Variants(
+		models.VariantWhere.ArticleID.EQ(aid),
+		current,
+	).All(rt.Ctx, rt.Tx)
 	if err != nil {
-		rt.Log.WithError(err).Error("updateVariants: cleanup")
+		rt.Log.WithError(err).Error("updateVariants: existing")
 		return status.Error(codes.Internal, errDB)
 	}
-	rt.Log.WithField("rows", ra).Debug("updateVariants: cleanup")
+
+	for _, e := range existing {
+		if _, ok := keep[e.ID]; ok {
+			keep[e.ID] = true
+			continue
+		}
+		if err = rt.removeVariant(e); err != nil {
+			return err
+		}
+	}
+	for id, found := range keep {
+		if !found {
+			rt.Log.WithField("vrtID", id).Warnf(errNotFound, "Variant", "ID", id)
+			return status.Errorf(codes.NotFound, errNotFound, "Variant", "ID", id)
+		}
+	}
 
 	for _, v := range vars {
+		idc := models.VariantColumns.ID
 		entry := rt.Log.WithField("variant", v)
-		if err := v.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+		if err := v.Upsert(rt.Ctx, rt.Tx, true, []string{idc},
+			boil.Blacklist(idc, models.VariantColumns.CreatedAt),
+			boil.Infer(),
+		); err != nil {
 			entry.WithError(err).Error("rt.updateVariants")
 			return status.Error(codes.Internal, errDB)
 		}
@@ -151,6 +242,35 @@ func (rt *requestTx) updateVariants(aid int, sv []*shop.Variant) error {
 	return nil
 }
 
+// removeVariant archives the variant if it is referenced by any order,
+// so that order lines keep a valid reference. Otherwise it is deleted.
+func (rt *requestTx) removeVariant(vrt *models.Variant) error {
+	entry := rt.Log.WithField("variant", vrt)
+
+	ordered, err := vrt.OrderArticles().Exists(rt.Ctx, rt.Tx)
+	if err != nil {
+		entry.WithError(err).Error("removeVariant: ordered")
+		return status.Error(codes.Internal, errDB)
+	}
+
+	if ordered {
+		vrt.Archived = true
+		_, err = vrt.Update(rt.Ctx, rt.Tx, boil.Whitelist(
+			models.VariantColumns.Archived,
+			models.VariantColumns.UpdatedAt,
+		))
+	} else {
+		_, err = vrt.Delete(rt.Ctx, rt.Tx)
+	}
+	if err != nil {
+		entry.WithError(err).Error("removeVariant")
+		return status.Error(codes.Internal, errDB)
+	}
+
+	entry.WithField("archived", ordered).Debug("removeVariant")
+	return nil
+}
+
 func (rt *requestTx) updateImages(aid int, mds []*shop.Media) error {
 	imgs, err := imagesMsgToModel(aid, mds)
 	if err != nil {
@@ -204,15 +324,225 @@ func (rt *requestTx) updateVideos(aid int, mds []*shop.Media) error {
 	return nil
 }
 
+func (rt *requestTx) updateAttributes(aid int, sav []*shop.AttributeValue) error {
+	ids := make([]interface{}, len(sav))
+	for i, v := range sav {
+		ids[i] = v.GetAttributeId()
+	}
+
+	defs := make(map[int]*models.Attribute, len(sav))
+	if len(ids) > 0 {
+		attrs, err := models.Attributes(qm.WhereIn("id in ?", ids...)).All(rt.Ctx, rt.Tx)
+		if err != nil {
+			rt.Log.WithError(err).Error("updateAttributes: definitions")
+			return status.Error(codes.Internal, errDB)
+		}
+		for _, a := range attrs {
+			defs[a.ID] = a
+		}
+	}
+
+	vals, err := attributeValuesMsgToModel(aid, sav, defs)
+	if err != nil {
+		rt.Log.WithError(err).Warn("attributeValuesMsgToModel")
+		return err
+	}
+
+	// Cleanup old values
+	ra, err := models.ArticleAttributes(models.ArticleAttributeWhere.ArticleID.EQ(aid)).DeleteAll(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("updateAttributes: cleanup")
+		return status.Error(codes.Internal, errDB)
+	}
+	rt.Log.WithField("rows", ra).Debug("updateAttributes: cleanup")
+
+	for _, v := range vals {
+		entry := rt.Log.WithField("attribute", v)
+		if err := v.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+			entry.WithError(err).Error("rt.updateAttributes")
+			return status.Error(codes.Internal, errDB)
+		}
+		entry.Debug("rt.updateAttributes")
+	}
+	return nil
+}
+
+// saveArticle upserts the article with all its relations,
+// and stores a revision of the result.
+func (rt *requestTx) saveArticle(sa *shop.Article) (*models.Article, error) {
+	art, err := rt.upsertArticle(sa)
+	if err != nil {
+		return nil, err
+	}
+	if err = rt.setArticleCategories(art, sa.GetCategories()); err != nil {
+		return nil, err
+	}
+	if err = rt.setArticleBasePrices(art, sa.GetBaseprices()); err != nil {
+		return nil, err
+	}
+	if err = rt.updateVariants(art.ID, sa.GetVariants()); err != nil {
+		return nil, err
+	}
+	if err = rt.updateImages(art.ID, sa.GetImages()); err != nil {
+		return nil, err
+	}
+	if err = rt.updateVideos(art.ID, sa.GetVideos()); err != nil {
+		return nil, err
+	}
+	if err = rt.updateAttributes(art.ID, sa.GetAttributes()); err != nil {
+		return nil, err
+	}
+	if err = rt.saveRevision(art.ID); err != nil {
+		return nil, err
+	}
+	return art, nil
+}
+
+// subject of the JWT used to start the transaction.
+// Empty for un-authenticated transactions.
+func (rt *requestTx) subject() string {
+	if rt.Claims == nil {
+		return ""
+	}
+	return rt.Claims.Subject
+}
+
+// saveRevision stores a snapshot of the article, as returned by viewArticle.
+func (rt *requestTx) saveRevision(aid int) error {
+	sa, err := rt.viewArticle(aid)
+	if err != nil {
+		return err
+	}
+	js, err := json.Marshal(sa)
+	if err != nil {
+		rt.Log.WithError(err).Error("saveRevision Marshal")
+		return status.Error(codes.Internal, errFatal)
+	}
+
+	rev := &models.ArticleRevision{
+		ArticleID: aid,
+		Subject:   rt.subject(),
+		Snapshot:  types.JSON(js),
+	}
+	if err = rev.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+		rt.Log.WithError(err).Error("saveRevision")
+		return status.Error(codes.Internal, errDB)
+	}
+	rt.Log.WithFields(logrus.Fields{"event": "article.revision", "revision": rev.ID, "subject": rev.Subject}).Info("Article saved")
+	return nil
+}
+
+// listArticleRevisions returns the revisions of an article, newest first.
+func (rt *requestTx) listArticleRevisions(cond *shop.RevisionListConditions) ([]*shop.ArticleRevision, error) {
+	aid := int(cond.GetArticleId())
+	if aid == 0 {
+		rt.Log.Warn(errMissingID)
+		return nil, status.Error(codes.InvalidArgument, errMissingID)
+	}
+	rt.Log = rt.Log.WithField("aid", aid)
+
+	limit := cond.GetLimits().GetLimit()
+	if limit == 0 {
+		limit = builder.DefaultLimit
+	}
+	qms := []qm.QueryMod{
+		models.ArticleRevisionWhere.ArticleID.EQ(aid),
+		qm.OrderBy(models.ArticleRevisionColumns.ID + " desc"),
+		qm.Offset(int(cond.GetLimits().GetOffset())),
+	}
+	if limit > 0 {
+		qms = append(qms, qm.Limit(int(limit)))
+	}
+
+	revs, err := models.ArticleRevisions(qms...).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("listArticleRevisions")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	list := make([]*shop.ArticleRevision, len(revs))
+	for i, r := range revs {
+		if list[i], err = revisionModelToMsg(r); err != nil {
+			rt.Log.WithError(err).Error("revisionModelToMsg")
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+// revertArticle saves the article snapshot of a revision.
+// Variants which were archived since are restored by updateVariants,
+// variants which no longer exist are inserted as new.
+// Stock is not reverted, as it is not part of the article's content.
+func (rt *requestTx) revertArticle(rid int) (*models.Article, error) {
+	rt.Log = rt.Log.WithField("revision", rid)
+
+	rev, err := models.FindArticleRevision(rt.Ctx, rt.Tx, rid)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		rt.Log.WithError(err).Warn("revertArticle")
+		return nil, status.Errorf(codes.NotFound, errNotFound, "Revision", "ID", rid)
+	default:
+		rt.Log.WithError(err).Error("revertArticle")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	sr, err := revisionModelToMsg(rev)
+	if err != nil {
+		rt.Log.WithError(err).Error("revisionModelToMsg")
+		return nil, err
+	}
+	sa := sr.GetArticle()
+	sa.Id = int32(rev.ArticleID)
+
+	if len(sa.GetVariants()) == 0 {
+		return rt.saveArticle(sa)
+	}
+
+	vids := make([]int64, len(sa.GetVariants()))
+	for i, v := range sa.GetVariants() {
+		vids[i] = v.GetId()
+	}
+	existing, err := models.Variants(
+		qm.Select(models.VariantColumns.ID, models.VariantColumns.Stock),
+		models.VariantWhere.ArticleID.EQ(rev.ArticleID),
+		models.VariantWhere.ID.IN(vids),
+	).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("revertArticle: existing variants")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	found := make(map[int64]*models.Variant, len(existing))
+	for _, e := range existing {
+		found[e.ID] = e
+	}
+	for _, v := range sa.GetVariants() {
+		e, ok := found[v.GetId()]
+		if !ok {
+			v.Id = 0
+			continue
+		}
+		v.TrackStock, v.Stock = e.Stock.Valid, int32(e.Stock.Int)
+	}
+
+	return rt.saveArticle(sa)
+}
+
 func (rt *requestTx) viewArticle(aid int) (*shop.Article, error) {
 	rt.Log = rt.Log.WithField("aid", aid)
 	art, err := models.Articles(
 		models.ArticleWhere.ID.EQ(aid),
+		models.ArticleWhere.DeletedAt.IsNull(),
 		qm.Load(models.ArticleRels.Images),
 		qm.Load(models.ArticleRels.Videos),
 		qm.Load(models.ArticleRels.Categories),
 		qm.Load(models.ArticleRels.BasePrices),
-		qm.Load(models.ArticleRels.Variants),
+		qm.Load(models.ArticleRels.Variants,
+			models.VariantWhere.Archived.EQ(false),
+			qm.OrderBy(models.VariantColumns.Position),
+		),
+		qm.Load(qm.Rels(models.ArticleRels.ArticleAttributes, models.ArticleAttributeRels.Attribute)),
 	).One(rt.Ctx, rt.Tx)
 	switch err {
 	case nil:
@@ -236,8 +566,47 @@ func (rt *requestTx) viewArticle(aid int) (*shop.Article, error) {
 
 var artJSPool fj.ParserPool
 
+// checkAttributeFilters returns InvalidArgument if min or max is set on a filter
+// of an attribute which is unknown or not a NUMBER.
+func (rt *requestTx) checkAttributeFilters(afs []*shop.AttributeFilter) error {
+	var ids []interface{}
+	for _, af := range afs {
+		if af.GetAttributeId() != 0 && (af.GetMin() != "" || af.GetMax() != "") {
+			ids = append(ids, int(af.GetAttributeId()))
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	attrs, err := models.Attributes(qm.WhereIn("id in ?", ids...)).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("checkAttributeFilters")
+		return status.Error(codes.Internal, errDB)
+	}
+	defs := make(map[int]*models.Attribute, len(attrs))
+	for _, a := range attrs {
+		defs[a.ID] = a
+	}
+
+	for _, id := range ids {
+		def, ok := defs[id.(int)]
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, errAttrUnkn, id)
+		}
+		if def.Type != models.AttributeTypeNUMBER {
+			return status.Errorf(codes.InvalidArgument, errAttrRange, def.Label)
+		}
+	}
+	return nil
+}
+
 func (rt *requestTx) listArticles(cond *shop.ListConditions) ([]*shop.Article, error) {
-	rt.Log = rt.Log.WithField("cond", cond)
+	rt.Log = rt.Log.WithField("cond", logCond(cond))
+
+	if err := rt.checkAttributeFilters(cond.GetAttributes()); err != nil {
+		return nil, err
+	}
 
 	query, args, err := builder.ArticleListQuery(cond, "shop")
 	if err != nil {
@@ -297,7 +666,95 @@ const (
 	delArticleBasePrices = "delete from shop.article_base_prices where article_id = $1;"
 )
 
+// deleteArticle soft deletes an article, by setting deleted_at.
+// The returned amount is 0 if the article does not exist or is already deleted.
 func (rt *requestTx) deleteArticle(aid int) (int64, error) {
+	ra, err := models.Articles(
+		models.ArticleWhere.ID.EQ(aid),
+		models.ArticleWhere.DeletedAt.IsNull(),
+	).UpdateAll(rt.Ctx, rt.Tx, models.M{models.ArticleColumns.DeletedAt: time.Now()})
+	if err != nil {
+		rt.Log.WithError(err).Error("deleteArticle")
+		return 0, status.Error(codes.Internal, errDB)
+	}
+	rt.Log.WithFields(logrus.Fields{"event": "article.deleted", "ra": ra}).Info("Article deleted")
+	return ra, nil
+}
+
+// restoreArticle un-deletes an article.
+func (rt *requestTx) restoreArticle(aid int) error {
+	art, err := models.Articles(
+		models.ArticleWhere.ID.EQ(aid),
+		models.ArticleWhere.DeletedAt.IsNotNull(),
+	).One(rt.Ctx, rt.Tx)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		rt.Log.WithError(err).Warn("restoreArticle")
+		return status.Errorf(codes.NotFound, errNotFound, "Deleted article", "ID", aid)
+	default:
+		rt.Log.WithError(err).Error("restoreArticle")
+		return status.Error(codes.Internal, errDB)
+	}
+
+	// Title is only unique amongst articles which are not deleted.
+	taken, err := models.Articles(
+		models.ArticleWhere.Title.EQ(art.Title),
+		models.ArticleWhere.DeletedAt.IsNull(),
+	).Exists(rt.Ctx, rt.Tx)
+	if err = rt.checkDBErrors("restoreArticle title", []error{err}, false); err != nil {
+		return err
+	}
+	if taken {
+		rt.Log.Warnf(errTitleTaken, art.Title)
+		return status.Errorf(codes.AlreadyExists, errTitleTaken, art.Title)
+	}
+
+	art.DeletedAt = null.Time{}
+	if _, err = art.Update(rt.Ctx, rt.Tx, boil.Whitelist(models.ArticleColumns.DeletedAt)); err != nil {
+		rt.Log.WithError(err).Error("restoreArticle")
+		return status.Error(codes.Internal, errDB)
+	}
+	rt.Log.WithField("event", "article.restored").Info("Article restored")
+	return nil
+}
+
+// listDeletedArticles returns deleted articles, most recently deleted first.
+func (rt *requestTx) listDeletedArticles(cond *shop.DeletedListConditions) ([]*shop.Article, error) {
+	limit := cond.GetLimits().GetLimit()
+	if limit == 0 {
+		limit = builder.DefaultLimit
+	}
+
+	qms := []qm.QueryMod{
+		models.ArticleWhere.DeletedAt.IsNotNull(),
+		qm.OrderBy(models.ArticleColumns.DeletedAt + " desc"),
+		qm.Load(models.ArticleRels.Images),
+		qm.Offset(int(cond.GetLimits().GetOffset())),
+	}
+	if limit > 0 {
+		qms = append(qms, qm.Limit(int(limit)))
+	}
+
+	arts, err := models.Articles(qms...).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("listDeletedArticles")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	list := make([]*shop.Article, len(arts))
+	for i, a := range arts {
+		if list[i], err = articleModelToMsg(a); err != nil {
+			rt.Log.WithError(err).Error("articleModelToMsg")
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+// purgeArticle permanently deletes an article and all its associations.
+// Articles referenced by orders can not be purged.
+func (rt *requestTx) purgeArticle(aid int) (int64, error) {
 	cat, err := queries.Raw(delCategoryArticles, aid).ExecContext(rt.Ctx, rt.Tx)
 	if err != nil {
 		rt.Log.WithError(err).Error("delCategoryArticles")
@@ -309,10 +766,12 @@ func (rt *requestTx) deleteArticle(aid int) (int64, error) {
 		return 0, status.Error(codes.Internal, errDB)
 	}
 
-	ra, errs := make([]int64, 6), make([]error, 6)
+	ra, errs := make([]int64, 8), make([]error, 8)
 	ra[0], errs[0] = models.Videos(models.VideoWhere.ArticleID.EQ(aid)).DeleteAll(rt.Ctx, rt.Tx)
 	ra[1], errs[1] = models.Images(models.ImageWhere.ArticleID.EQ(aid)).DeleteAll(rt.Ctx, rt.Tx)
 	ra[2], errs[2] = models.Variants(models.VariantWhere.ArticleID.EQ(aid)).DeleteAll(rt.Ctx, rt.Tx)
+	ra[6], errs[6] = models.ArticleAttributes(models.ArticleAttributeWhere.ArticleID.EQ(aid)).DeleteAll(rt.Ctx, rt.Tx)
+	ra[7], errs[7] = models.ArticleRevisions(models.ArticleRevisionWhere.ArticleID.EQ(aid)).DeleteAll(rt.Ctx, rt.Tx)
 	ra[3], errs[3] = models.Articles(models.ArticleWhere.ID.EQ(aid)).DeleteAll(rt.Ctx, rt.Tx)
 	ra[4], errs[4] = cat.RowsAffected()
 	ra[5], errs[5] = bp.RowsAffected()
@@ -323,16 +782,79 @@ func (rt *requestTx) deleteArticle(aid int) (int64, error) {
 	}
 	rt.Log = rt.Log.WithFields(logrus.Fields{"ra_vid": ra[0], "ra_img": ra[1], "ra_art": ra[2], "total": total})
 
-	return total, rt.checkDBErrors("deleteArticle", errs, false)
+	return total, rt.checkDBErrors("purgeArticle", errs, false)
+}
+
+const (
+	importSavepoint         = "savepoint import_article;"
+	importRollbackSavepoint = "rollback to savepoint import_article;"
+	importReleaseSavepoint  = "release savepoint import_article;"
+)
+
+// importArticle saves a single article of an import and reports the outcome in the result.
+// Each article is saved inside a savepoint,
+// so that a failing article does not abort the rest of the import transaction.
+// A returned error means the transaction is no longer usable.
+func (rt *requestTx) importArticle(sa *shop.Article) (*shop.ImportResult, error) {
+	if _, err := rt.Tx.ExecContext(rt.Ctx, importSavepoint); err != nil {
+		rt.Log.WithError(err).Error("importArticle savepoint")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	art, err := rt.saveArticle(sa)
+	if err != nil {
+		if _, err := rt.Tx.ExecContext(rt.Ctx, importRollbackSavepoint); err != nil {
+			rt.Log.WithError(err).Error("importArticle rollback savepoint")
+			return nil, status.Error(codes.Internal, errDB)
+		}
+		return &shop.ImportResult{Error: status.Convert(err).Message()}, nil
+	}
+	if err = rt.queueArticleWebhooks(art.ID); err != nil {
+		return nil, err
+	}
+
+	if _, err = rt.Tx.ExecContext(rt.Ctx, importReleaseSavepoint); err != nil {
+		rt.Log.WithError(err).Error("importArticle release savepoint")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	return &shop.ImportResult{ArticleId: int32(art.ID)}, nil
+}
+
+// exportArticleIDs returns the IDs of all articles matching the ExportConditions, ordered by ID.
+func (rt *requestTx) exportArticleIDs(cond *shop.ExportConditions) ([]int, error) {
+	rt.Log = rt.Log.WithField("cond", logCond(cond))
+
+	qms := []qm.QueryMod{
+		qm.Select(models.ArticleColumns.ID),
+		models.ArticleWhere.DeletedAt.IsNull(),
+		qm.OrderBy(models.ArticleColumns.ID),
+	}
+	if cond.GetOnlyPublished() {
+		qms = append(qms, models.ArticleWhere.Published.EQ(true))
+	}
+	if cid := cond.GetOnlyCategoryId(); cid != 0 {
+		qms = append(qms, qm.Where(
+			"exists (select 1 from shop.category_articles ca where ca.article_id = articles.id and ca.category_id = ?)", cid,
+		))
+	}
+
+	arts, err := models.Articles(qms...).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("exportArticleIDs")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	ids := make([]int, len(arts))
+	for i, a := range arts {
+		ids[i] = a.ID
+	}
+	return ids, nil
 }
 
 func (rt *requestTx) shouldCalcPrice(art *models.Article) (bool, error) {
-	var bp, vt bool
-	errs := make([]error, 2)
-	bp, errs[0] = art.BasePrices().Exists(rt.Ctx, rt.Tx)
-	vt, errs[1] = art.Variants().Exists(rt.Ctx, rt.Tx)
+	vt, err := art.Variants(models.VariantWhere.Archived.EQ(false)).Exists(rt.Ctx, rt.Tx)
 
-	return bp && vt, rt.checkDBErrors("shouldCalcPrice", errs, false)
+	return vt, rt.checkDBErrors("shouldCalcPrice", []error{err}, false)
 }
 
 type calculation struct {
@@ -340,57 +862,123 @@ type calculation struct {
 	Details *shop.Details
 }
 
+// calcPrice calculates the price of an article variant.
+// An absolute variant price takes precedence.
+// Otherwise the multiplier is applied to the base price,
+// or the article price if the article has no base prices.
 func (rt *requestTx) calcPrice(art *models.Article, bpID int, vrtID int64) (*calculation, error) {
 	entry := rt.Log.WithFields(logrus.Fields{"article": art, "bpID": bpID, "vrtID": vrtID})
 
-	if bpID == 0 || vrtID == 0 {
+	if vrtID == 0 {
 		entry.Warnf(errVrtPrice, art.ID)
 		return nil, status.Errorf(codes.InvalidArgument, errVrtPrice, art.ID)
 	}
 
-	var (
-		bp  *models.BasePrice
-		vrt *models.Variant
-	)
-	errs := make([]error, 2)
-	bp, errs[0] = models.FindBasePrice(
-		rt.Ctx, rt.Tx, bpID,
-		models.BasePriceColumns.Price,
-		models.BasePriceColumns.Label,
-	)
-	vrt, errs[1] = models.FindVariant(
-		rt.Ctx, rt.Tx, vrtID,
-		models.VariantColumns.Multiplier,
-		models.VariantColumns.Labels,
-	)
-
-	if err := rt.checkDBErrors(
-		fmt.Sprintf("BasePrice and/or Variant for Article ID %d", art.ID),
-		errs, true,
+	vrt, err := models.Variants(
+		models.VariantWhere.ID.EQ(vrtID),
+		models.VariantWhere.ArticleID.EQ(art.ID),
+		models.VariantWhere.Archived.EQ(false),
+	).One(rt.Ctx, rt.Tx)
+	if err = rt.checkDBErrors(
+		fmt.Sprintf("Variant for Article ID %d", art.ID),
+		[]error{err}, true,
 	); err != nil {
 		return nil, err
 	}
 
-	return &calculation{
-		new(decimal.Big).Mul(bp.Price.Big, vrt.Multiplier.Big),
-		&shop.Details{
-			BasePrice: &shop.BasePrice{
-				Label: bp.Label,
-				Price: bp.Price.String(),
-			},
+	calc := &calculation{
+		Details: &shop.Details{
 			Variant: &shop.Variant{
+				Id:         vrt.ID,
 				Labels:     vrt.Labels,
 				Multiplier: vrt.Multiplier.String(),
+				Sku:        vrt.Sku.String,
+				Price:      nullDecimalString(vrt.Price),
 			},
 		},
-	}, nil
+	}
+	if vrt.Price.Big != nil {
+		calc.Price = new(decimal.Big).Copy(vrt.Price.Big)
+		return calc, nil
+	}
+
+	hasBP, err := art.BasePrices().Exists(rt.Ctx, rt.Tx)
+	if err = rt.checkDBErrors("BasePrices exist", []error{err}, false); err != nil {
+		return nil, err
+	}
+	if !hasBP {
+		calc.Price = new(decimal.Big).Mul(currentPrice(art, time.Now()).Big, vrt.Multiplier.Big)
+		return calc, nil
+	}
+
+	if bpID == 0 {
+		entry.Warnf(errVrtPrice, art.ID)
+		return nil, status.Errorf(codes.InvalidArgument, errVrtPrice, art.ID)
+	}
+
+	bp, err := models.FindBasePrice(
+		rt.Ctx, rt.Tx, bpID,
+		models.BasePriceColumns.Price,
+		models.BasePriceColumns.Label,
+	)
+	if err = rt.checkDBErrors(
+		fmt.Sprintf("BasePrice for Article ID %d", art.ID),
+		[]error{err}, true,
+	); err != nil {
+		return nil, err
+	}
+
+	calc.Price = new(decimal.Big).Mul(bp.Price.Big, vrt.Multiplier.Big)
+	calc.Details.BasePrice = &shop.BasePrice{
+		Label: bp.Label,
+		Price: bp.Price.String(),
+	}
+	return calc, nil
+}
+
+const (
+	reserveStock = "update shop.variants set stock = stock - $1 where id = $2 and stock >= $1;"
+	errStockLow  = "Insufficient stock for variant ID %d"
+)
+
+// reserveVariantStock decrements the stock of a variant, if tracked.
+func (rt *requestTx) reserveVariantStock(vrtID int64, amount int) error {
+	entry := rt.Log.WithFields(logrus.Fields{"vrtID": vrtID, "amount": amount})
+
+	res, err := queries.Raw(reserveStock, amount, vrtID).ExecContext(rt.Ctx, rt.Tx)
+	if err != nil {
+		entry.WithError(err).Error("reserveStock")
+		return status.Error(codes.Internal, errDB)
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		entry.Debug("reserveStock")
+		return nil
+	}
+
+	tracked, err := models.Variants(
+		models.VariantWhere.ID.EQ(vrtID),
+		models.VariantWhere.Stock.IsNotNull(),
+	).Exists(rt.Ctx, rt.Tx)
+	if err != nil {
+		entry.WithError(err).Error("reserveStock: tracked")
+		return status.Error(codes.Internal, errDB)
+	}
+	if tracked {
+		entry.Warnf(errStockLow, vrtID)
+		return status.Errorf(codes.FailedPrecondition, errStockLow, vrtID)
+	}
+	return nil
 }
 
 func (rt *requestTx) newOrderArticle(so *shop.Order_ArticleAmount) (*models.OrderArticle, error) {
 	aid := int(so.GetArticleId())
 	entry := rt.Log.WithField("aid", aid)
 
-	art, err := models.FindArticle(rt.Ctx, rt.Tx, aid)
+	art, err := models.Articles(
+		models.ArticleWhere.ID.EQ(aid),
+		models.ArticleWhere.DeletedAt.IsNull(),
+		whereScheduled,
+	).One(rt.Ctx, rt.Tx)
 	switch err {
 	case nil:
 		entry = entry.WithField("article", art)
@@ -406,7 +994,7 @@ func (rt *requestTx) newOrderArticle(so *shop.Order_ArticleAmount) (*models.Orde
 		ArticleID: aid,
 		Amount:    int(so.GetAmount()),
 		Title:     art.Title,
-		Price:     art.Price, // Will be over-written if calculate price != nil
+		Price:     currentPrice(art, time.Now()), // Will be over-written if calculate price != nil
 	}
 
 	if should, err := rt.shouldCalcPrice(art); err != nil {
@@ -421,6 +1009,11 @@ func (rt *requestTx) newOrderArticle(so *shop.Order_ArticleAmount) (*models.Orde
 	}
 	entry = entry.WithField("calc", calc)
 	oa.Price = types.NewDecimal(calc.Price)
+	oa.VariantID = null.Int64From(so.GetVariantId())
+
+	if err = rt.reserveVariantStock(so.GetVariantId(), oa.Amount); err != nil {
+		return nil, err
+	}
 
 	js, err := json.Marshal(calc.Details)
 	if err != nil {
@@ -439,6 +1032,9 @@ func (rt *requestTx) newOrder(so *shop.Order) (*models.Order, error) {
 		rt.Log.WithError(err).Warn("orderMsgToModel")
 		return nil, err
 	}
+	if order.Locale == "" {
+		order.Locale = rt.s.conf.Mail.Locale
+	}
 
 	sa := so.GetArticles()
 	if err = checkOrderArticles(sa); err != nil {
@@ -487,53 +1083,11 @@ func (rt *requestTx) listOrders(cond *shop.ListOrderConditions) (*shop.OrderList
 		rt.Log.WithError(err).Error("models.Orders")
 		return nil, status.Error(codes.Internal, errDB)
 	}
-	list := make([]*shop.Order, len(orders))
-	for i, o := range orders {
-		if list[i], err = orderModelToMsg(o); err != nil {
-			return nil, err
-		}
-		if list[i].Articles, list[i].Sum, err = rt.getOrderArticles(o); err != nil {
-			return nil, err
-		}
-	}
-	return &shop.OrderList{List: list}, nil
-}
-
-func (rt *requestTx) sendOrderMail(tmpl string, order *models.Order, subject string) error {
-	msg, err := orderModelToMsg(order)
+	list, err := rt.orderMsgs(orders)
 	if err != nil {
-		return err
-	}
-	if msg.Articles, msg.Sum, err = rt.getOrderArticles(order); err != nil {
-		return err
-	}
-
-	to := append(rt.s.conf.Mail.To, msg.GetEmail())
-
-	headers := []mailer.Header{
-		{Key: "from", Values: []string{rt.s.conf.Mail.From}},
-		{Key: "subject", Values: []string{subject}},
-		{Key: "to", Values: to},
-	}
-
-	data := struct {
-		*shop.Order
-		Created  time.Time
-		Currency string
-	}{
-		msg,
-		order.CreatedAt,
-		rt.s.conf.Mail.Currency,
-	}
-
-	log := rt.Log.WithFields(logrus.Fields{"headers": headers, "data": data})
-
-	if err := rt.s.mail.Send(headers, tmpl, data, to...); err != nil {
-		log.WithError(err).Error("sendOrderMail")
-		return status.Error(codes.Internal, "Mailer error")
+		return nil, err
 	}
-	log.Debug("sendOrderMail")
-	return nil
+	return &shop.OrderList{List: list}, nil
 }
 
 func (rt *requestTx) saveOrder(so *shop.Order) (*models.Order, error) {
@@ -544,7 +1098,19 @@ func (rt *requestTx) saveOrder(so *shop.Order) (*models.Order, error) {
 	}
 	rt.Log = rt.Log.WithField("order", order)
 
-	if _, err = order.Update(rt.Ctx, rt.Tx, boil.Blacklist(models.OrderColumns.ID)); err != nil {
+	// Previous status, to detect status changes for stock, webhooks and mails.
+	// Locked, so the cancellation job can't release the stock as well.
+	prev, err := models.Orders(
+		qm.Select(models.OrderColumns.Status),
+		models.OrderWhere.ID.EQ(order.ID),
+		qm.For("update"),
+	).One(rt.Ctx, rt.Tx)
+	if err != nil && err != sql.ErrNoRows {
+		rt.Log.WithError(err).Error("saveOrder: models.Orders")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	if _, err = order.Update(rt.Ctx, rt.Tx, boil.Blacklist(models.OrderColumns.ID, models.OrderColumns.Locale, models.OrderColumns.PaymentRemindedAt)); err != nil {
 		rt.Log.WithError(err).Error("order.Update")
 		return nil, status.Error(codes.Internal, errDB)
 	}
@@ -554,6 +1120,24 @@ func (rt *requestTx) saveOrder(so *shop.Order) (*models.Order, error) {
 	switch err {
 	case nil:
 		rt.Log.Debug("order.Reload")
+		if prev != nil && prev.Status != order.Status {
+			// Stock is reserved while an order is not cancelled.
+			switch {
+			case order.Status == models.StatusCANCELLED:
+				err = rt.releaseOrderStock(order.ID)
+			case prev.Status == models.StatusCANCELLED:
+				err = rt.reserveOrderStock(order.ID)
+			}
+			if err != nil {
+				return nil, err
+			}
+			if err = rt.queueOrderWebhooks(eventOrderStatusChanged, order.ID); err != nil {
+				return nil, err
+			}
+			if err = rt.queueStatusMails(order); err != nil {
+				return nil, err
+			}
+		}
 		return order, nil
 	case sql.ErrNoRows:
 		rt.Log.WithError(err).Warn("order.Reload")
@@ -610,6 +1194,9 @@ func (*requestTx) listCategoriesQms(clc *shop.CategoryListConditions) []qm.Query
 			qm.InnerJoin(articlesJoin),
 			qm.GroupBy(categoryGroup),
 			qm.Where("a.published=?", true),
+			qm.Where("a.deleted_at is null"),
+			qm.Where("(a.publish_at is null or a.publish_at <= now())"),
+			qm.Where("(a.unpublish_at is null or a.unpublish_at > now())"),
 		)
 	}
 
@@ -634,6 +1221,80 @@ func (rt *requestTx) listCategories(clc *shop.CategoryListConditions) ([]*shop.C
 	return scs, nil
 }
 
+func (rt *requestTx) saveAttributes(list []*shop.Attribute) error {
+	attrs, err := attributesMsgToModel(list)
+	if err != nil {
+		rt.Log.WithError(err).Warn("attributesMsgToModel")
+		return err
+	}
+	rt.Log = rt.Log.WithField("attributes", attrs)
+
+	for i, a := range attrs {
+		entry := rt.Log.WithField("attribute", a)
+		if err = a.Upsert(
+			rt.Ctx, rt.Tx, true,
+			[]string{models.AttributeColumns.ID},
+			boil.Blacklist(
+				models.AttributeColumns.ID,
+				models.AttributeColumns.CreatedAt,
+			),
+			boil.Infer(),
+		); err != nil {
+			entry.WithError(err).Error("a.Upsert")
+			return status.Error(codes.Internal, errDB)
+		}
+
+		sc := list[i].GetCategories()
+		cats := make([]*models.Category, len(sc))
+		for j, c := range sc {
+			cats[j] = &models.Category{ID: int(c.GetId())}
+		}
+		if err = a.SetCategories(rt.Ctx, rt.Tx, false, cats...); err != nil {
+			entry.WithError(err).Error("a.SetCategories")
+			return status.Error(codes.Internal, errDB)
+		}
+	}
+
+	return nil
+}
+
+const (
+	categoryAttributesJoin = "shop.category_attributes cat on shop.attributes.id = cat.attribute_id"
+)
+
+func (*requestTx) listAttributesQms(alc *shop.AttributeListConditions) []qm.QueryMod {
+	qms := []qm.QueryMod{
+		qm.OrderBy(models.AttributeColumns.Position),
+		qm.Load(models.AttributeRels.Categories),
+	}
+	if cid := alc.GetOnlyCategoryId(); cid != 0 {
+		qms = append(qms,
+			qm.InnerJoin(categoryAttributesJoin),
+			qm.Where("cat.category_id=?", cid),
+		)
+	}
+
+	return qms
+}
+
+func (rt *requestTx) listAttributes(alc *shop.AttributeListConditions) ([]*shop.Attribute, error) {
+	attrs, err := models.Attributes(rt.listAttributesQms(alc)...).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("models.Attributes.All")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	rt.Log = rt.Log.WithField("attributes", attrs)
+	rt.Log.Debug("models.Attributes.All")
+
+	sas, err := attributesModelToMsg(attrs)
+	if err != nil {
+		rt.Log.WithError(err).Error("attributesModelToMsg")
+		return nil, err
+	}
+
+	return sas, nil
+}
+
 const (
 	searchWhere    = "search_index @@ plainto_tsquery(?, ?)"
 	searchLanguage = "romanian"
@@ -646,6 +1307,7 @@ func (rt *requestTx) searchArticles(ts *shop.TextSearch) (*shop.ArticleList, err
 		return nil, status.Error(codes.InvalidArgument, "Invalid")
 	}
 	artQ, err := models.Articles(
+		models.ArticleWhere.DeletedAt.IsNull(),
 		qm.Where(searchWhere, searchLanguage, strings.TrimSpace(ts.GetText())),
 		qm.Load(models.ArticleRels.Images),
 	).All(rt.Ctx, rt.Tx)
@@ -672,7 +1334,10 @@ func (rt *requestTx) suggest(ts *shop.TextSearch) (*shop.SuggestionList, error)
 		rt.Log.Error("suggest invalid argument")
 		return nil, status.Error(codes.InvalidArgument, "Invalid")
 	}
-	art, err[0] = models.Articles(qm.Where(suggestWhere, searchLanguage, strings.TrimSpace(ts.GetText()))).All(rt.Ctx, rt.Tx)
+	art, err[0] = models.Articles(
+		models.ArticleWhere.DeletedAt.IsNull(),
+		qm.Where(suggestWhere, searchLanguage, strings.TrimSpace(ts.GetText())),
+	).All(rt.Ctx, rt.Tx)
 	list := &shop.SuggestionList{
 		Article: make([]*shop.Article, len(art)),
 	}
@@ -786,11 +1451,12 @@ func (rt *requestTx) encryptOrder(order *models.Order) (string, string, error) {
 	return encData, encKey, nil
 }
 
-func (rt *requestTx) newMessage(sm *shop.Message) (*models.Message, error) {
+func (rt *requestTx) newMessage(sm *shop.Message, addr string) (*models.Message, error) {
 	msg, err := messageMsgToModel(sm)
 	if err != nil {
 		return nil, err
 	}
+	msg.RemoteAddr = addr
 
 	if err = msg.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
 		return nil, status.Error(codes.Internal, errDB)
@@ -799,21 +1465,8 @@ func (rt *requestTx) newMessage(sm *shop.Message) (*models.Message, error) {
 	return msg, nil
 }
 
+// sendMail queues a message notification to the shop in the outbox.
 func (rt *requestTx) sendMail(tmpl string, msg *models.Message) error {
 	subject := fmt.Sprintf("%s: message #%d: %s", rt.s.conf.Mail.ShopName, msg.ID, msg.Subject)
-
-	headers := []mailer.Header{
-		{Key: "from", Values: []string{rt.s.conf.Mail.From}},
-		{Key: "subject", Values: []string{subject}},
-		{Key: "to", Values: rt.s.conf.Mail.To},
-	}
-
-	log := rt.Log.WithFields(logrus.Fields{"headers": headers, "msg": msg})
-
-	if err := rt.s.mail.Send(headers, tmpl, msg, rt.s.conf.Mail.To...); err != nil {
-		log.WithError(err).Error("sendMail")
-		return status.Error(codes.Internal, "Mailer error")
-	}
-	log.Debug("sendOrderMail")
-	return nil
+	return rt.queueMail(tmpl, subject, rt.s.conf.Mail.To, msg)
 }
@@ -75,6 +75,20 @@ func Test_shopServer_newTx(t *testing.T) {
 	}
 }
 
+func Test_logCond(t *testing.T) {
+	cond := &shop.ReportConditions{Limit: 5, Token: "secret"}
+	got, ok := logCond(cond).(*shop.ReportConditions)
+	if !ok || got.GetToken() != "" || got.GetLimit() != 5 {
+		t.Errorf("logCond() = %v, want limit without token", got)
+	}
+	if cond.GetToken() != "secret" {
+		t.Errorf("logCond() modified cond: %v", cond)
+	}
+	if got := logCond(&shop.ListConditions{OnlyPromoted: true}); !proto.Equal(got, &shop.ListConditions{OnlyPromoted: true}) {
+		t.Errorf("logCond() = %v, want unchanged", got)
+	}
+}
+
 func Test_requestTx_upsertArticle(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -446,6 +460,40 @@ func Test_requestTx_updateVariants(t *testing.T) {
 			nil,
 			status.Error(codes.Internal, errDB),
 		},
+		{
+			"Variant of other article",
+			args{
+				11,
+				testShopVariants,
+			},
+			nil,
+			status.Errorf(codes.NotFound, errNotFound, "Variant", "ID", 41),
+		},
+		{
+			"Update in place",
+			args{
+				13,
+				[]*shop.Variant{
+					{
+						Id:         42,
+						Labels:     []string{"foo", "bar"},
+						Multiplier: "1.5",
+						Sku:        "FOO-BAR",
+						TrackStock: true,
+						Stock:      7,
+					},
+				},
+			},
+			[]*models.Variant{
+				{
+					ID:         42,
+					ArticleID:  13,
+					Labels:     []string{"foo", "bar"},
+					Multiplier: types.NewDecimal(decimal.New(15, 1)),
+				},
+			},
+			nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -463,7 +511,25 @@ func Test_requestTx_updateVariants(t *testing.T) {
 			}
 
 			if tt.wantErr == nil {
-				got, err := models.Variants(models.VariantWhere.ArticleID.EQ(tt.args.aid)).All(rt.Ctx, rt.Tx)
+				// Variant 41 is referenced by an order and should be archived, not deleted.
+				if len(tt.want) > 0 && tt.want[0].ID != 41 {
+					archived, err := models.Variants(
+						models.VariantWhere.ID.EQ(41),
+						models.VariantWhere.Archived.EQ(true),
+					).Exists(rt.Ctx, rt.Tx)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if !archived {
+						t.Errorf("requestTx.updateVariants() variant 41 not archived")
+					}
+				}
+
+				got, err := models.Variants(
+					models.VariantWhere.ArticleID.EQ(tt.args.aid),
+					models.VariantWhere.Archived.EQ(false),
+					qm.OrderBy(models.VariantColumns.Position),
+				).All(rt.Ctx, rt.Tx)
 				if len(tt.want) != len(got) {
 					t.Errorf("requestTx.updateVariants() = %v, want %v", got, tt.want)
 					return
@@ -986,13 +1052,14 @@ func Test_requestTx_updateVideos(t *testing.T) {
 var (
 	testShopArts = []*shop.Article{
 		{
-			Id:          11,
-			Created:     &timestamp.Timestamp{Seconds: 8000},
-			Updated:     &timestamp.Timestamp{Seconds: 9000},
-			Published:   false,
-			Title:       "ID 11",
-			Description: "This is the first article",
-			Price:       "30000.99",
+			Id:           11,
+			Created:      &timestamp.Timestamp{Seconds: 8000},
+			Updated:      &timestamp.Timestamp{Seconds: 9000},
+			Published:    false,
+			Title:        "ID 11",
+			Description:  "This is the first article",
+			Price:        "30000.99",
+			CurrentPrice: "30000.99",
 			Images: []*shop.Media{
 				{
 					Id:    111,
@@ -1012,13 +1079,14 @@ var (
 			},
 		},
 		{
-			Id:          12,
-			Created:     &timestamp.Timestamp{Seconds: 1000},
-			Updated:     &timestamp.Timestamp{Seconds: 2000},
-			Published:   true,
-			Title:       "ID 12",
-			Description: "This is the second article",
-			Price:       "12.12",
+			Id:           12,
+			Created:      &timestamp.Timestamp{Seconds: 1000},
+			Updated:      &timestamp.Timestamp{Seconds: 2000},
+			Published:    true,
+			Title:        "ID 12",
+			Description:  "This is the second article",
+			Price:        "12.12",
+			CurrentPrice: "12.12",
 			Images: []*shop.Media{
 				{
 					Id:    121,
@@ -1038,13 +1106,14 @@ var (
 			},
 		},
 		{
-			Id:          13,
-			Created:     &timestamp.Timestamp{Seconds: 3000},
-			Updated:     &timestamp.Timestamp{Seconds: 4000},
-			Published:   false,
-			Title:       "ID 13",
-			Description: "This is the third article",
-			Price:       "22.99",
+			Id:           13,
+			Created:      &timestamp.Timestamp{Seconds: 3000},
+			Updated:      &timestamp.Timestamp{Seconds: 4000},
+			Published:    false,
+			Title:        "ID 13",
+			Description:  "This is the third article",
+			Price:        "22.99",
+			CurrentPrice: "22.99",
 			Images: []*shop.Media{
 				{
 					Id:    131,
@@ -1067,13 +1136,14 @@ var (
 	}
 
 	testArticleView = &shop.Article{
-		Id:          13,
-		Created:     &timestamp.Timestamp{Seconds: 3000},
-		Updated:     &timestamp.Timestamp{Seconds: 4000},
-		Published:   false,
-		Title:       "ID 13",
-		Description: "This is the third article",
-		Price:       "22.99",
+		Id:           13,
+		Created:      &timestamp.Timestamp{Seconds: 3000},
+		Updated:      &timestamp.Timestamp{Seconds: 4000},
+		Published:    false,
+		Title:        "ID 13",
+		Description:  "This is the third article",
+		Price:        "22.99",
+		CurrentPrice: "22.99",
 		Images: []*shop.Media{
 			{
 				Id:    131,
@@ -1146,6 +1216,229 @@ var (
 	}
 )
 
+func Test_requestTx_saveRevision(t *testing.T) {
+	tests := []struct {
+		name    string
+		aid     int
+		wantErr bool
+	}{
+		{
+			"Existing article",
+			13,
+			false,
+		},
+		{
+			"Non-existing article",
+			89,
+			true,
+		},
+		{
+			"DB Error",
+			13,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			if err = rt.saveRevision(tt.aid); (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.saveRevision() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			rev, err := models.ArticleRevisions(
+				models.ArticleRevisionWhere.ArticleID.EQ(tt.aid),
+				qm.OrderBy(models.ArticleRevisionColumns.ID+" desc"),
+			).One(rt.Ctx, rt.Tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := revisionModelToMsg(rev)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(got.GetArticle(), testArticleView) {
+				t.Errorf("requestTx.saveRevision() = \n%v\nwant\n%v", got.GetArticle(), testArticleView)
+			}
+		})
+	}
+}
+
+func Test_requestTx_listArticleRevisions(t *testing.T) {
+	tests := []struct {
+		name    string
+		cond    *shop.RevisionListConditions
+		want    []string
+		wantErr bool
+	}{
+		{
+			"Missing ID",
+			&shop.RevisionListConditions{},
+			nil,
+			true,
+		},
+		{
+			"DB Error",
+			&shop.RevisionListConditions{ArticleId: 13},
+			nil,
+			true,
+		},
+		{
+			"All",
+			&shop.RevisionListConditions{ArticleId: 13},
+			[]string{"Second", "ID 13"},
+			false,
+		},
+		{
+			"Limits",
+			&shop.RevisionListConditions{
+				ArticleId: 13,
+				Limits:    &shop.Limits{Limit: 1, Offset: 1},
+			},
+			[]string{"ID 13"},
+			false,
+		},
+		{
+			"No revisions",
+			&shop.RevisionListConditions{ArticleId: 12},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			if err = rt.saveRevision(13); err != nil {
+				t.Fatal(err)
+			}
+			art := &models.Article{ID: 13, Title: "Second"}
+			if _, err = art.Update(rt.Ctx, rt.Tx, boil.Whitelist(models.ArticleColumns.Title)); err != nil {
+				t.Fatal(err)
+			}
+			if err = rt.saveRevision(13); err != nil {
+				t.Fatal(err)
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.listArticleRevisions(tt.cond)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.listArticleRevisions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			var titles []string
+			for _, r := range got {
+				titles = append(titles, r.GetArticle().GetTitle())
+			}
+			if !reflect.DeepEqual(titles, tt.want) {
+				t.Errorf("requestTx.listArticleRevisions() = %v, want %v", titles, tt.want)
+			}
+		})
+	}
+}
+
+func Test_requestTx_revertArticle(t *testing.T) {
+	tests := []struct {
+		name    string
+		rid     int
+		wantErr bool
+	}{
+		{
+			"Non-existing revision",
+			-1,
+			true,
+		},
+		{
+			"DB Error",
+			0,
+			true,
+		},
+		{
+			"Success",
+			0,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			// Revision of the original, followed by a save without variants.
+			if err = rt.saveRevision(13); err != nil {
+				t.Fatal(err)
+			}
+			rev, err := models.ArticleRevisions(
+				models.ArticleRevisionWhere.ArticleID.EQ(13),
+				qm.OrderBy(models.ArticleRevisionColumns.ID+" desc"),
+			).One(rt.Ctx, rt.Tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.rid == 0 {
+				tt.rid = rev.ID
+			}
+
+			changed := proto.Clone(testArticleView).(*shop.Article)
+			changed.Title = "Changed"
+			changed.Variants = nil
+			if _, err = rt.saveArticle(changed); err != nil {
+				t.Fatal(err)
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			art, err := rt.revertArticle(tt.rid)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.revertArticle() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			got, err := rt.viewArticle(art.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.GetTitle() != testArticleView.GetTitle() {
+				t.Errorf("requestTx.revertArticle() Title = %v, want %v", got.GetTitle(), testArticleView.GetTitle())
+			}
+			vrts := got.GetVariants()
+			if len(vrts) != 2 {
+				t.Fatalf("requestTx.revertArticle() Variants = %v, want 2", vrts)
+			}
+			// 41 is ordered, so it was archived and restored with the same ID.
+			// 42 was deleted, so it is inserted as new.
+			if vrts[0].GetId() != 41 || vrts[1].GetId() == 42 {
+				t.Errorf("requestTx.revertArticle() Variant IDs = %d, %d", vrts[0].GetId(), vrts[1].GetId())
+			}
+		})
+	}
+}
+
 func Test_requestTx_viewArticle(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -1333,10 +1626,11 @@ func Test_requestTx_listArticles(t *testing.T) {
 				Relations: &shop.ArticleRelations{},
 			},
 			[]*shop.Article{{
-				Created:  &timestamp.Timestamp{Seconds: 3000},
-				Title:    "ID 13",
-				Price:    "22.99",
-				Promoted: true,
+				Created:      &timestamp.Timestamp{Seconds: 3000},
+				Title:        "ID 13",
+				Price:        "22.99",
+				Promoted:     true,
+				CurrentPrice: "22.99",
 			}},
 			false,
 		},
@@ -1496,7 +1790,7 @@ func Test_requestTx_deleteArticle(t *testing.T) {
 		{
 			"Existing article",
 			13,
-			13,
+			1,
 			false,
 		},
 		{
@@ -1535,30 +1829,48 @@ func Test_requestTx_deleteArticle(t *testing.T) {
 	}
 }
 
-func Test_requestTx_shouldCalcPrice(t *testing.T) {
+func Test_requestTx_restoreArticle(t *testing.T) {
 	tests := []struct {
 		name    string
-		art     *models.Article
-		want    bool
+		aid     int
+		delete  bool
+		title   bool
 		wantErr bool
 	}{
 		{
-			"DB Error",
-			testArticles[0],
+			"Not deleted",
+			13,
+			false,
 			false,
 			true,
 		},
 		{
-			"Should not",
-			testArticles[0],
+			"Non-existing article",
+			112,
 			false,
 			false,
+			true,
 		},
 		{
-			"Should",
-			testArticles[2],
+			"Title taken",
+			13,
+			true,
+			true,
+			true,
+		},
+		{
+			"DB Error",
+			13,
+			true,
+			false,
+			true,
+		},
+		{
+			"Success",
+			13,
 			true,
 			false,
+			false,
 		},
 	}
 	for _, tt := range tests {
@@ -1568,46 +1880,368 @@ func Test_requestTx_shouldCalcPrice(t *testing.T) {
 				t.Fatal(err)
 			}
 			defer rt.Done()
+
+			if tt.delete {
+				if _, err = rt.deleteArticle(tt.aid); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.title {
+				art := &models.Article{ID: 12, Title: "ID 13"}
+				if _, err = art.Update(rt.Ctx, rt.Tx, boil.Whitelist(models.ArticleColumns.Title)); err != nil {
+					t.Fatal(err)
+				}
+			}
 			if tt.name == "DB Error" {
 				rt.Done()
 			}
 
-			got, err := rt.shouldCalcPrice(tt.art)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("requestTx.shouldCalcPrice() error = %v, wantErr %v", err, tt.wantErr)
+			if err = rt.restoreArticle(tt.aid); (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.restoreArticle() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if got != tt.want {
-				t.Errorf("requestTx.shouldCalcPrice() = %v, want %v", got, tt.want)
+			if tt.wantErr {
+				return
+			}
+
+			if _, err = rt.viewArticle(tt.aid); err != nil {
+				t.Errorf("requestTx.restoreArticle() not restored: %v", err)
 			}
 		})
 	}
 }
 
-func Test_requestTx_calcPrice(t *testing.T) {
-	type args struct {
-		art   *models.Article
-		bpID  int
-		vrtID int64
-	}
+func Test_requestTx_listDeletedArticles(t *testing.T) {
 	tests := []struct {
 		name    string
-		args    args
-		want    *calculation
+		cond    *shop.DeletedListConditions
+		want    []int32
 		wantErr bool
 	}{
 		{
-			"Zero bpID",
-			args{
-				testArticles[2],
-				0,
-				41,
-			},
+			"DB Error",
+			nil,
 			nil,
 			true,
 		},
 		{
-			"Zero vrtID",
+			"Defaults",
+			nil,
+			[]int32{13, 11},
+			false,
+		},
+		{
+			"Limits",
+			&shop.DeletedListConditions{
+				Limits: &shop.Limits{Limit: 1, Offset: 1},
+			},
+			[]int32{11},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			for _, aid := range []int{11, 13} {
+				if _, err = rt.deleteArticle(aid); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.listDeletedArticles(tt.cond)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.listDeletedArticles() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			var ids []int32
+			for _, a := range got {
+				if a.GetDeleted() == nil {
+					t.Errorf("requestTx.listDeletedArticles() Deleted not set on %d", a.GetId())
+				}
+				ids = append(ids, a.GetId())
+			}
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("requestTx.listDeletedArticles() = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
+
+func Test_requestTx_purgeArticle(t *testing.T) {
+	tests := []struct {
+		name    string
+		aid     int
+		want    int64
+		wantErr bool
+	}{
+		{
+			"Existing article",
+			13,
+			13,
+			false,
+		},
+		{
+			"Non-Existing article",
+			112,
+			0,
+			false,
+		},
+		{
+			"DB Error",
+			13,
+			0,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.purgeArticle(tt.aid)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.purgeArticle() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("requestTx.purgeArticle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_requestTx_importArticle(t *testing.T) {
+	tests := []struct {
+		name    string
+		arts    []*shop.Article
+		want    []bool // Saved or not
+		wantErr bool
+	}{
+		{
+			"DB Error",
+			[]*shop.Article{
+				{
+					Title:       "Imported",
+					Description: "Imported article",
+					Price:       "10",
+				},
+			},
+			nil,
+			true,
+		},
+		{
+			"Continue after errors",
+			[]*shop.Article{
+				{
+					Title:       "Imported 1",
+					Description: "Imported article",
+					Price:       "10",
+				},
+				{
+					Title:       "Bad sale price",
+					Description: "Imported article",
+					Price:       "10",
+					SalePrice:   "spanac",
+				},
+				{
+					Title:       "ID 12",
+					Description: "Duplicate title",
+					Price:       "10",
+				},
+				{
+					Title:       "Imported 2",
+					Description: "Imported article",
+					Price:       "10",
+					Categories:  []*shop.Category{{Id: 21}},
+				},
+			},
+			[]bool{true, false, false, true},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			var got []bool
+			for i, sa := range tt.arts {
+				res, err := rt.importArticle(sa)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("requestTx.importArticle() error = %v, wantErr %v", err, tt.wantErr)
+					return
+				}
+				if err != nil {
+					return
+				}
+
+				saved := res.GetArticleId() != 0
+				if saved == (res.GetError() != "") {
+					t.Errorf("requestTx.importArticle() row %d = %v", i, res)
+				}
+				if saved {
+					if _, err = rt.viewArticle(int(res.GetArticleId())); err != nil {
+						t.Errorf("requestTx.importArticle() row %d not saved: %v", i, err)
+					}
+				}
+				got = append(got, saved)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("requestTx.importArticle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_requestTx_exportArticleIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		cond    *shop.ExportConditions
+		want    []int
+		wantErr bool
+	}{
+		{
+			"DB Error",
+			nil,
+			nil,
+			true,
+		},
+		{
+			"All",
+			nil,
+			[]int{11, 12}, // 13 is deleted
+			false,
+		},
+		{
+			"Published",
+			&shop.ExportConditions{OnlyPublished: true},
+			[]int{12},
+			false,
+		},
+		{
+			"Category",
+			&shop.ExportConditions{OnlyCategoryId: 22},
+			[]int{11},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.exportArticleIDs(tt.cond)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.exportArticleIDs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("requestTx.exportArticleIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_requestTx_shouldCalcPrice(t *testing.T) {
+	tests := []struct {
+		name    string
+		art     *models.Article
+		want    bool
+		wantErr bool
+	}{
+		{
+			"DB Error",
+			testArticles[0],
+			false,
+			true,
+		},
+		{
+			"Should not",
+			testArticles[0],
+			false,
+			false,
+		},
+		{
+			"Should",
+			testArticles[2],
+			true,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.shouldCalcPrice(tt.art)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.shouldCalcPrice() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("requestTx.shouldCalcPrice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_requestTx_calcPrice(t *testing.T) {
+	type args struct {
+		art   *models.Article
+		bpID  int
+		vrtID int64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *calculation
+		wantErr bool
+	}{
+		{
+			"Zero bpID",
+			args{
+				testArticles[2],
+				0,
+				41,
+			},
+			nil,
+			true,
+		},
+		{
+			"Zero vrtID",
 			args{
 				testArticles[2],
 				31,
@@ -1626,6 +2260,37 @@ func Test_requestTx_calcPrice(t *testing.T) {
 			nil,
 			true,
 		},
+		{
+			"Variant of other article",
+			args{
+				testArticles[0],
+				31,
+				41,
+			},
+			nil,
+			true,
+		},
+		{
+			"Absolute price",
+			args{
+				testArticles[2],
+				0,
+				49,
+			},
+			&calculation{
+				decimal.New(1999, 2),
+				&shop.Details{
+					Variant: &shop.Variant{
+						Id:         49,
+						Labels:     []string{"fixed"},
+						Multiplier: "1",
+						Sku:        "FIX-1",
+						Price:      "19.99",
+					},
+				},
+			},
+			false,
+		},
 		{
 			"Calc 1",
 			args{
@@ -1641,6 +2306,7 @@ func Test_requestTx_calcPrice(t *testing.T) {
 						Price: "44.55",
 					},
 					Variant: &shop.Variant{
+						Id:         41,
 						Labels:     []string{"hello", "world"},
 						Multiplier: "3.33",
 					},
@@ -1663,6 +2329,7 @@ func Test_requestTx_calcPrice(t *testing.T) {
 						Price: "44.55",
 					},
 					Variant: &shop.Variant{
+						Id:         42,
 						Labels:     []string{"foo", "bar"},
 						Multiplier: "9.99",
 					},
@@ -1685,6 +2352,7 @@ func Test_requestTx_calcPrice(t *testing.T) {
 						Price: "99.99",
 					},
 					Variant: &shop.Variant{
+						Id:         41,
 						Labels:     []string{"hello", "world"},
 						Multiplier: "3.33",
 					},
@@ -1707,6 +2375,7 @@ func Test_requestTx_calcPrice(t *testing.T) {
 						Price: "99.99",
 					},
 					Variant: &shop.Variant{
+						Id:         42,
 						Labels:     []string{"foo", "bar"},
 						Multiplier: "9.99",
 					},
@@ -1723,6 +2392,20 @@ func Test_requestTx_calcPrice(t *testing.T) {
 			}
 			defer rt.Done()
 
+			if tt.name == "Absolute price" {
+				vrt := &models.Variant{
+					ID:         49,
+					ArticleID:  13,
+					Labels:     []string{"fixed"},
+					Multiplier: types.NewDecimal(decimal.New(1, 0)),
+					Sku:        null.StringFrom("FIX-1"),
+					Price:      types.NewNullDecimal(decimal.New(1999, 2)),
+				}
+				if err = vrt.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+					t.Fatal(err)
+				}
+			}
+
 			got, err := rt.calcPrice(tt.args.art, tt.args.bpID, tt.args.vrtID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("requestTx.calcPrice() error = %v, wantErr %v", err, tt.wantErr)
@@ -1742,6 +2425,7 @@ func Test_requestTx_newOrderArticle(t *testing.T) {
 			Price: "44.55",
 		},
 		Variant: &shop.Variant{
+			Id:         41,
 			Labels:     []string{"hello", "world"},
 			Multiplier: "3.33",
 		},
@@ -1788,6 +2472,24 @@ func Test_requestTx_newOrderArticle(t *testing.T) {
 			nil,
 			true,
 		},
+		{
+			"Not yet published",
+			&shop.Order_ArticleAmount{
+				ArticleId: 12,
+				Amount:    2,
+			},
+			nil,
+			true,
+		},
+		{
+			"Unpublished",
+			&shop.Order_ArticleAmount{
+				ArticleId: 12,
+				Amount:    2,
+			},
+			nil,
+			true,
+		},
 		{
 			"Calc: unknown base price",
 			&shop.Order_ArticleAmount{
@@ -1813,6 +2515,7 @@ func Test_requestTx_newOrderArticle(t *testing.T) {
 				Title:     "ID 13",
 				Price:     types.NewDecimal(decimal.New(1483515, 4)),
 				Details:   null.NewJSON(js, true),
+				VariantID: null.Int64From(41),
 			},
 			false,
 		},
@@ -1827,6 +2530,19 @@ func Test_requestTx_newOrderArticle(t *testing.T) {
 			if tt.name == "DB Error" {
 				rt.Done()
 			}
+			schedule := map[string]string{
+				"Not yet published": models.ArticleColumns.PublishAt,
+				"Unpublished":       models.ArticleColumns.UnpublishAt,
+			}
+			if col, ok := schedule[tt.name]; ok {
+				at := time.Now().Add(time.Hour)
+				if col == models.ArticleColumns.UnpublishAt {
+					at = time.Now().Add(-time.Hour)
+				}
+				if _, err = models.Articles(models.ArticleWhere.ID.EQ(12)).UpdateAll(rt.Ctx, rt.Tx, models.M{col: at}); err != nil {
+					t.Fatal(err)
+				}
+			}
 
 			got, err := rt.newOrderArticle(tt.so)
 			if (err != nil) != tt.wantErr {
@@ -1874,6 +2590,7 @@ func Test_requestTx_newOrder(t *testing.T) {
 			Price: "44.55",
 		},
 		Variant: &shop.Variant{
+			Id:         41,
 			Labels:     []string{"hello", "world"},
 			Multiplier: "3.33",
 		},
@@ -1919,6 +2636,7 @@ func Test_requestTx_newOrder(t *testing.T) {
 			Price:     types.NewDecimal(decimal.New(1483515, 4)),
 			Title:     "ID 13",
 			Details:   null.NewJSON(js, true),
+			VariantID: null.Int64From(41),
 		},
 	}
 
@@ -2046,12 +2764,14 @@ var testShopOrderArticles = []*shop.Order_ArticleAmount{
 		Title:     "ID 13",
 		Price:     "148.3515",
 		Total:     "741.7575",
+		VariantId: 41,
 		Details: &shop.Details{
 			BasePrice: &shop.BasePrice{
 				Label: "Cheap material",
 				Price: "44.55",
 			},
 			Variant: &shop.Variant{
+				Id:         41,
 				Labels:     []string{"hello", "world"},
 				Multiplier: "3.33",
 			},
@@ -2133,86 +2853,17 @@ func Test_requestTx_getOrderArticles(t *testing.T) {
 	}{
 		{
 			"DB Error",
-			testOrders[0],
-			nil,
-			"",
-			true,
-		},
-		{
-			"Success",
-			testOrders[0],
-			testShopOrderArticles,
-			"30779.1075",
-			false,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			rt, err := tss.newTx(testCtx, "testing", true)
-			if err != nil {
-				t.Fatal(err)
-			}
-			defer rt.Done()
-			if tt.name == "DB Error" {
-				rt.Done()
-			}
-
-			gotOaa, gotSum, err := rt.getOrderArticles(tt.order)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("requestTx.getOrderArticles() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if !reflect.DeepEqual(gotOaa, tt.wantOaa) {
-				t.Errorf("requestTx.getOrderArticles() = %v, want %v", gotOaa, tt.wantOaa)
-			}
-			if gotSum != tt.wantSum {
-				t.Errorf("requestTx.getOrderArticles() = %v, want %v", gotSum, tt.wantSum)
-			}
-		})
-	}
-}
-
-func Test_requestTx_sendOrderMail(t *testing.T) {
-	type args struct {
-		tmpl  string
-		order *models.Order
-	}
-	tests := []struct {
-		name string
-		args
-		wantErr bool
-	}{
-		{
-			"Success",
-			args{
-				OrderMailTmpl,
-				testOrders[0],
-			},
-			false,
-		},
-		{
-			"Order error",
-			args{
-				OrderMailTmpl,
-				testOrders[1],
-			},
-			true,
-		},
-		{
-			"DB Error",
-			args{
-				OrderMailTmpl,
-				testOrders[0],
-			},
+			testOrders[0],
+			nil,
+			"",
 			true,
 		},
 		{
-			"Mailer error",
-			args{
-				"foo",
-				testOrders[0],
-			},
-			true,
+			"Success",
+			testOrders[0],
+			testShopOrderArticles,
+			"30779.1075",
+			false,
 		},
 	}
 	for _, tt := range tests {
@@ -2226,8 +2877,16 @@ func Test_requestTx_sendOrderMail(t *testing.T) {
 				rt.Done()
 			}
 
-			if err := rt.sendOrderMail(tt.args.tmpl, tt.args.order, rt.s.conf.Mail.ShopName); (err != nil) != tt.wantErr {
-				t.Errorf("requestTx.sendOrderMail() error = %v, wantErr %v", err, tt.wantErr)
+			gotOaa, gotSum, err := rt.getOrderArticles(tt.order)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.getOrderArticles() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotOaa, tt.wantOaa) {
+				t.Errorf("requestTx.getOrderArticles() = %v, want %v", gotOaa, tt.wantOaa)
+			}
+			if gotSum != tt.wantSum {
+				t.Errorf("requestTx.getOrderArticles() = %v, want %v", gotSum, tt.wantSum)
 			}
 		})
 	}
@@ -2321,6 +2980,113 @@ func Test_requestTx_saveOrder(t *testing.T) {
 	}
 }
 
+func Test_requestTx_saveOrder_stock(t *testing.T) {
+	tests := []struct {
+		name      string
+		prev      string
+		stock     int
+		status    shop.Order_Status
+		wantStock int
+		wantCode  codes.Code
+	}{
+		{"Cancel open", models.StatusOPEN, 10, shop.Order_CANCELLED, 12, codes.OK},
+		{"Send open", models.StatusOPEN, 10, shop.Order_SENT, 10, codes.OK},
+		{"Cancel cancelled", models.StatusCANCELLED, 10, shop.Order_CANCELLED, 10, codes.OK},
+		{"Cancel sent", models.StatusSENT, 10, shop.Order_CANCELLED, 12, codes.OK},
+		{"Reopen cancelled", models.StatusCANCELLED, 10, shop.Order_OPEN, 8, codes.OK},
+		{"Send cancelled", models.StatusCANCELLED, 10, shop.Order_SENT, 8, codes.OK},
+		{"Reopen short", models.StatusCANCELLED, 1, shop.Order_OPEN, 1, codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			if _, err = models.Variants(models.VariantWhere.ID.EQ(41)).UpdateAll(rt.Ctx, rt.Tx, models.M{models.VariantColumns.Stock: tt.stock}); err != nil {
+				t.Fatal(err)
+			}
+			order, err := insertUnpaidOrder(rt, 0, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			order.Status = tt.prev
+			if _, err = order.Update(rt.Ctx, rt.Tx, boil.Whitelist(models.OrderColumns.Status)); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = rt.saveOrder(&shop.Order{
+				Id:            int32(order.ID),
+				FullName:      order.FullName,
+				Email:         order.Email,
+				FullAddress:   order.FullAddress,
+				PaymentMethod: shop.Order_ONLINE,
+				Status:        tt.status,
+			})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("requestTx.saveOrder() error = %v, wantCode %v", err, tt.wantCode)
+			}
+			if err != nil {
+				return
+			}
+
+			vrt, err := models.FindVariant(rt.Ctx, rt.Tx, 41)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if vrt.Stock.Int != tt.wantStock {
+				t.Errorf("requestTx.saveOrder() stock = %d, want %d", vrt.Stock.Int, tt.wantStock)
+			}
+		})
+	}
+}
+
+func Test_requestTx_saveOrder_stockRoundTrip(t *testing.T) {
+	rt, err := tss.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Done()
+
+	if _, err = models.Variants(models.VariantWhere.ID.EQ(41)).UpdateAll(rt.Ctx, rt.Tx, models.M{models.VariantColumns.Stock: 10}); err != nil {
+		t.Fatal(err)
+	}
+	order, err := insertUnpaidOrder(rt, 0, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	steps := []struct {
+		status    shop.Order_Status
+		wantStock int
+	}{
+		{shop.Order_CANCELLED, 12},
+		{shop.Order_OPEN, 10},
+		{shop.Order_CANCELLED, 12},
+	}
+	for _, st := range steps {
+		if _, err = rt.saveOrder(&shop.Order{
+			Id:            int32(order.ID),
+			FullName:      order.FullName,
+			Email:         order.Email,
+			FullAddress:   order.FullAddress,
+			PaymentMethod: shop.Order_ONLINE,
+			Status:        st.status,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		vrt, err := models.FindVariant(rt.Ctx, rt.Tx, 41)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if vrt.Stock.Int != st.wantStock {
+			t.Errorf("requestTx.saveOrder(%v) stock = %d, want %d", st.status, vrt.Stock.Int, st.wantStock)
+		}
+	}
+}
+
 var testShopCategories = []*shop.Category{
 	{
 		Id:      20,
@@ -2453,6 +3219,266 @@ func Test_requestTx_listCategories(t *testing.T) {
 	}
 }
 
+var testShopAttributes = []*shop.Attribute{
+	{
+		Label: "Material",
+	},
+	{
+		Label: "Lungime",
+		Type:  shop.Attribute_NUMBER,
+		Unit:  "cm",
+	},
+	{
+		Label:      "Culoare",
+		Type:       shop.Attribute_ENUM,
+		Options:    []string{"rosu", "verde"},
+		Categories: []*shop.Category{{Id: 21}},
+	},
+}
+
+func Test_requestTx_saveAttributes(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    []*shop.Attribute
+		wantErr error
+	}{
+		{
+			"Empty attribute",
+			[]*shop.Attribute{{}},
+			status.Errorf(codes.InvalidArgument, errMissing, "Label"),
+		},
+		{
+			"Success",
+			testShopAttributes,
+			nil,
+		},
+		{
+			"Unknown category",
+			[]*shop.Attribute{{Label: "Foo", Categories: []*shop.Category{{Id: 999}}}},
+			status.Error(codes.Internal, errDB),
+		},
+		{
+			"DB Error",
+			testShopAttributes,
+			status.Error(codes.Internal, errDB),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			if err := rt.saveAttributes(tt.list); !errors.Is(err, tt.wantErr) {
+				t.Errorf("requestTx.saveAttributes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_requestTx_listAttributes(t *testing.T) {
+	tests := []struct {
+		name      string
+		alc       *shop.AttributeListConditions
+		wantLabel []string
+		wantErr   bool
+	}{
+		{
+			"All attributes",
+			nil,
+			[]string{"Material", "Lungime", "Culoare"},
+			false,
+		},
+		{
+			"Only category",
+			&shop.AttributeListConditions{OnlyCategoryId: 21},
+			[]string{"Culoare"},
+			false,
+		},
+		{
+			"DB Error",
+			nil,
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+			if err = rt.saveAttributes(testShopAttributes); err != nil {
+				t.Fatal(err)
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.listAttributes(tt.alc)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("requestTx.listAttributes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != len(tt.wantLabel) {
+				t.Fatalf("requestTx.listAttributes() = %v, want %v", got, tt.wantLabel)
+			}
+			for i, w := range tt.wantLabel {
+				if got[i].GetLabel() != w {
+					t.Errorf("requestTx.listAttributes() = %v, want %v", got[i].GetLabel(), w)
+				}
+			}
+		})
+	}
+}
+
+func Test_requestTx_updateAttributes(t *testing.T) {
+	tests := []struct {
+		name    string
+		sav     func([]*shop.Attribute) []*shop.AttributeValue
+		wantErr error
+	}{
+		{
+			"Unknown attribute",
+			func([]*shop.Attribute) []*shop.AttributeValue {
+				return []*shop.AttributeValue{{AttributeId: 999, Value: "foo"}}
+			},
+			status.Errorf(codes.InvalidArgument, errAttrUnkn, 999),
+		},
+		{
+			"Illegal option",
+			func(attrs []*shop.Attribute) []*shop.AttributeValue {
+				return []*shop.AttributeValue{{AttributeId: attrs[2].GetId(), Value: "albastru"}}
+			},
+			status.Errorf(codes.InvalidArgument, errAttrEnum, "Culoare", "albastru", []string{"rosu", "verde"}),
+		},
+		{
+			"Success",
+			func(attrs []*shop.Attribute) []*shop.AttributeValue {
+				return []*shop.AttributeValue{
+					{AttributeId: attrs[0].GetId(), Value: "lemn"},
+					{AttributeId: attrs[1].GetId(), Value: "12.5"},
+					{AttributeId: attrs[2].GetId(), Value: "verde"},
+				}
+			},
+			nil,
+		},
+		{
+			"Clear",
+			func([]*shop.Attribute) []*shop.AttributeValue { return nil },
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+			if err = rt.saveAttributes(testShopAttributes); err != nil {
+				t.Fatal(err)
+			}
+			attrs, err := rt.listAttributes(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sav := tt.sav(attrs)
+			if err := rt.updateAttributes(testArticles[0].ID, sav); !errors.Is(err, tt.wantErr) {
+				t.Errorf("requestTx.updateAttributes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			sa, err := rt.viewArticle(testArticles[0].ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(sa.GetAttributes()) != len(sav) {
+				t.Errorf("requestTx.updateAttributes() = %v, want %v", sa.GetAttributes(), sav)
+			}
+		})
+	}
+}
+
+func Test_requestTx_checkAttributeFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		afs     func([]*shop.Attribute) []*shop.AttributeFilter
+		wantErr error
+	}{
+		{
+			"No range",
+			func(attrs []*shop.Attribute) []*shop.AttributeFilter {
+				return []*shop.AttributeFilter{{AttributeId: attrs[0].GetId(), Value: "lemn"}}
+			},
+			nil,
+		},
+		{
+			"Number",
+			func(attrs []*shop.Attribute) []*shop.AttributeFilter {
+				return []*shop.AttributeFilter{{AttributeId: attrs[1].GetId(), Min: "10", Max: "20"}}
+			},
+			nil,
+		},
+		{
+			"Not a number",
+			func(attrs []*shop.Attribute) []*shop.AttributeFilter {
+				return []*shop.AttributeFilter{
+					{AttributeId: attrs[1].GetId(), Min: "10"},
+					{AttributeId: attrs[2].GetId(), Max: "20"},
+				}
+			},
+			status.Errorf(codes.InvalidArgument, errAttrRange, "Culoare"),
+		},
+		{
+			"Unknown attribute",
+			func([]*shop.Attribute) []*shop.AttributeFilter {
+				return []*shop.AttributeFilter{{AttributeId: 999, Min: "10"}}
+			},
+			status.Errorf(codes.InvalidArgument, errAttrUnkn, 999),
+		},
+		{
+			"DB Error",
+			func(attrs []*shop.Attribute) []*shop.AttributeFilter {
+				return []*shop.AttributeFilter{{AttributeId: attrs[1].GetId(), Min: "10"}}
+			},
+			status.Error(codes.Internal, errDB),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+			if err = rt.saveAttributes(testShopAttributes); err != nil {
+				t.Fatal(err)
+			}
+			attrs, err := rt.listAttributes(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			if err := rt.checkAttributeFilters(tt.afs(attrs)); !errors.Is(err, tt.wantErr) {
+				t.Errorf("requestTx.checkAttributeFilters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func Test_requestTx_searchArticles(t *testing.T) {
 	type args struct {
 		ts *shop.TextSearch
@@ -2798,12 +3824,13 @@ func Test_requestTx_newMessage(t *testing.T) {
 				Message: "Spanac!",
 			},
 			&models.Message{
-				ID:      1,
-				Name:    "Muhlemmer",
-				Email:   "foo@bar.com",
-				Phone:   "+407889924345",
-				Subject: "Hello world!",
-				Message: "Spanac!",
+				ID:         1,
+				Name:       "Muhlemmer",
+				Email:      "foo@bar.com",
+				Phone:      "+407889924345",
+				Subject:    "Hello world!",
+				Message:    "Spanac!",
+				RemoteAddr: "10.0.0.1",
 			},
 			false,
 		},
@@ -2831,7 +3858,7 @@ func Test_requestTx_newMessage(t *testing.T) {
 				rt.Done()
 			}
 
-			got, err := rt.newMessage(tt.sm)
+			got, err := rt.newMessage(tt.sm, "10.0.0.1")
 			if (err != nil) != tt.wantErr {
 				t.Errorf("requestTx.newMessage() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -0,0 +1,241 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/moapis/shop"
+	"github.com/moapis/shop/models"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ordersChannel is notified by the database triggers on order changes,
+// with the order ID as payload.
+const ordersChannel = "shop_orders"
+
+// Listener parameters
+const (
+	listenMinReconnect = time.Second
+	listenMaxReconnect = time.Minute
+	listenPing         = 90 * time.Second
+	watchBuffer        = 100 // Pending order IDs per subscriber
+)
+
+// resyncOrders is broadcasted after a listener (re)connects,
+// as notifications might have been lost in the meantime.
+const resyncOrders = 0
+
+// orderWatcher broadcasts changed order IDs to subscribed streams.
+// The zero value is ready to use.
+type orderWatcher struct {
+	mu   sync.Mutex
+	subs map[chan int]struct{}
+}
+
+func (w *orderWatcher) subscribe() chan int {
+	c := make(chan int, watchBuffer)
+
+	w.mu.Lock()
+	if w.subs == nil {
+		w.subs = make(map[chan int]struct{})
+	}
+	w.subs[c] = struct{}{}
+	w.mu.Unlock()
+
+	return c
+}
+
+// unsubscribe removes and closes c, if it is still subscribed.
+func (w *orderWatcher) unsubscribe(c chan int) {
+	w.mu.Lock()
+	if _, ok := w.subs[c]; ok {
+		delete(w.subs, c)
+		close(c)
+	}
+	w.mu.Unlock()
+}
+
+// broadcast id to all subscribers.
+// Subscribers with a full buffer are unsubscribed, which closes their channel.
+func (w *orderWatcher) broadcast(id int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for c := range w.subs {
+		select {
+		case c <- id:
+		default:
+			delete(w.subs, c)
+			close(c)
+		}
+	}
+}
+
+// listenOrders broadcasts the notifications on ordersChannel of a single database node,
+// until ctx is done.
+func (s *shopServer) listenOrders(ctx context.Context, dsn string, node int) {
+	log := s.log.WithFields(logrus.Fields{"listener": ordersChannel, "node": node})
+
+	l := pq.NewListener(dsn, listenMinReconnect, listenMaxReconnect, func(ev pq.ListenerEventType, err error) {
+		switch ev {
+		case pq.ListenerEventConnected:
+			log.Info("Listener connected")
+		case pq.ListenerEventDisconnected:
+			log.WithError(err).Warn("Listener disconnected")
+		case pq.ListenerEventReconnected:
+			log.Info("Listener reconnected")
+		case pq.ListenerEventConnectionAttemptFailed:
+			log.WithError(err).Debug("Listener connection attempt failed")
+		}
+	})
+	go func() {
+		<-ctx.Done()
+		log.WithError(ctx.Err()).Info("Stopping listener")
+		l.Close()
+	}()
+
+	if err := l.Listen(ordersChannel); err != nil {
+		log.WithError(err).Error("Listen")
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case n, ok := <-l.Notify:
+			if !ok {
+				return
+			}
+			if n == nil {
+				s.orders.broadcast(resyncOrders)
+				continue
+			}
+			id, err := strconv.Atoi(n.Extra)
+			if err != nil {
+				log.WithError(err).WithField("payload", n.Extra).Warn("Invalid notification")
+				continue
+			}
+			s.orders.broadcast(id)
+		case <-time.After(listenPing):
+			go l.Ping()
+		}
+	}
+}
+
+// startWatch starts an order listener for every database node.
+// Only the master emits notifications, but listening on all nodes
+// keeps notifications flowing after a fail-over.
+// The listeners stop when ctx is done.
+func (s *shopServer) startWatch(ctx context.Context) {
+	for i, dsn := range s.conf.MultiDB.DBConf.DataSourceNames() {
+		go s.listenOrders(ctx, dsn, i)
+	}
+}
+
+// orderMsgs converts orders to messages, including their articles.
+func (rt *requestTx) orderMsgs(orders models.OrderSlice) ([]*shop.Order, error) {
+	list := make([]*shop.Order, len(orders))
+	for i, o := range orders {
+		var err error
+		if list[i], err = orderModelToMsg(o); err != nil {
+			return nil, err
+		}
+		if list[i].Articles, list[i].Sum, err = rt.getOrderArticles(o); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+// ordersAfter returns all orders with an ID higher than id, ordered by ID.
+func (rt *requestTx) ordersAfter(id int) ([]*shop.Order, error) {
+	orders, err := models.Orders(
+		models.OrderWhere.ID.GT(id),
+		qm.OrderBy(models.OrderColumns.ID),
+	).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("ordersAfter")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	return rt.orderMsgs(orders)
+}
+
+// lastOrderID returns the highest order ID, or 0 if there are no orders.
+func (rt *requestTx) lastOrderID() (int, error) {
+	var id int
+	if err := rt.Tx.QueryRowContext(rt.Ctx, "select coalesce(max(id), 0) from shop.orders;").Scan(&id); err != nil {
+		rt.Log.WithError(err).Error("lastOrderID")
+		return 0, status.Error(codes.Internal, errDB)
+	}
+	return id, nil
+}
+
+// viewOrder returns the order identified by id.
+func (rt *requestTx) viewOrder(id int) (*shop.Order, error) {
+	order, err := models.FindOrder(rt.Ctx, rt.Tx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			rt.Log.WithField("oid", id).Warnf(errNotFound, "Order", "ID", id)
+			return nil, status.Errorf(codes.NotFound, errNotFound, "Order", "ID", id)
+		}
+		rt.Log.WithError(err).Error("viewOrder")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	list, err := rt.orderMsgs(models.OrderSlice{order})
+	if err != nil {
+		return nil, err
+	}
+	return list[0], nil
+}
+
+// watchStart authenticates a WatchOrders request and returns the order ID to resync from.
+// New watches start at the latest order, so that resyncs don't send the full history.
+func (s *shopServer) watchStart(ctx context.Context, req *shop.WatchOrdersRequest) (int, *logrus.Entry, error) {
+	rt, err := s.newAuthTx(ctx, "WatchOrders", true, req.GetToken())
+	if err != nil {
+		return 0, nil, err
+	}
+	defer rt.Done()
+
+	if lastID := int(req.GetLastOrderId()); lastID != 0 {
+		return lastID, rt.Log, nil
+	}
+	lastID, err := rt.lastOrderID()
+	return lastID, rt.Log, err
+}
+
+// watchOrders loads the orders after lastID, or a single order, for the WatchOrders stream.
+// The orders are loaded on the master, as notified orders may not be replicated yet.
+// A notified order which no longer exists is skipped.
+func (s *shopServer) watchOrders(ctx context.Context, lastID int, id int) ([]*shop.Order, error) {
+	rt, err := s.newTx(ctx, "WatchOrders", false)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Done()
+
+	if id == resyncOrders {
+		return rt.ordersAfter(lastID)
+	}
+	order, err := rt.viewOrder(id)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []*shop.Order{order}, nil
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	pg "github.com/moapis/multidb/drivers/postgresql"
+	"github.com/moapis/shop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_orderWatcher(t *testing.T) {
+	var w orderWatcher
+
+	a, b := w.subscribe(), w.subscribe()
+	w.broadcast(100)
+	if got := <-a; got != 100 {
+		t.Errorf("orderWatcher.broadcast() a = %d, want 100", got)
+	}
+	if got := <-b; got != 100 {
+		t.Errorf("orderWatcher.broadcast() b = %d, want 100", got)
+	}
+
+	w.unsubscribe(a)
+	if _, ok := <-a; ok {
+		t.Error("orderWatcher.unsubscribe() did not close the channel")
+	}
+	w.unsubscribe(a) // Must not panic on double close
+
+	// b is never read and gets dropped when its buffer is full.
+	for i := 1; i <= watchBuffer+1; i++ {
+		w.broadcast(i)
+	}
+	for range b {
+	}
+	if len(w.subs) != 0 {
+		t.Errorf("orderWatcher.broadcast() slow subscriber not removed: %v", w.subs)
+	}
+	w.unsubscribe(b)
+}
+
+func Test_requestTx_ordersAfter(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int
+		want    []int32
+		wantErr error
+	}{
+		{
+			"DB Error",
+			0,
+			nil,
+			status.Error(codes.Internal, errDB),
+		},
+		{
+			"After 99",
+			99,
+			[]int32{100, 101},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			orders, err := rt.ordersAfter(tt.id)
+			if !errors.Is(tt.wantErr, err) {
+				t.Errorf("requestTx.ordersAfter() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var got []int32
+			for _, o := range orders {
+				got = append(got, o.GetId())
+			}
+			// Orders from checkout tests may follow.
+			if len(got) > len(tt.want) {
+				got = got[:len(tt.want)]
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("requestTx.ordersAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_requestTx_viewOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int
+		wantErr error
+	}{
+		{
+			"DB Error",
+			100,
+			status.Error(codes.Internal, errDB),
+		},
+		{
+			"Not found",
+			999,
+			status.Errorf(codes.NotFound, errNotFound, "Order", "ID", 999),
+		},
+		{
+			"Success",
+			100,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.viewOrder(tt.id)
+			if !errors.Is(tt.wantErr, err) {
+				t.Errorf("requestTx.viewOrder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && (got.GetId() != int32(tt.id) || len(got.GetArticles()) == 0) {
+				t.Errorf("requestTx.viewOrder() = %v", got)
+			}
+		})
+	}
+}
+
+func Test_shopServer_watchOrders(t *testing.T) {
+	tests := []struct {
+		name   string
+		lastID int
+		id     int
+		want   []int32
+	}{
+		{"Resync", 99, resyncOrders, []int32{100, 101}},
+		{"Order", 101, 100, []int32{100}},
+		{"Not found", 100, 999, nil},
+	}
+
+	// The replica of lts never catches up: read-only transactions fail on it,
+	// so the orders must be loaded from the master.
+	pc := *testConfig.MultiDB.DBConf.(*pg.Config)
+	pc.Nodes = append([]pg.Node{}, pc.Nodes...)
+	pc.Nodes = append(pc.Nodes, pg.Node{Host: "localhost", Port: 1})
+	lc := testConfig.MultiDB
+	lc.DBConf = &pc
+	lmdb, err := lc.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lmdb.Close()
+	lts := &shopServer{mdb: lmdb, log: tss.log, conf: tss.conf}
+
+	if _, err = lts.newTx(testCtx, "testing", true); err == nil {
+		t.Fatal("lagging replica: read-only transaction succeeded")
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orders, err := lts.watchOrders(testCtx, tt.lastID, tt.id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []int32
+			for _, o := range orders {
+				got = append(got, o.GetId())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("shopServer.watchOrders() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// testWatchStream implements shop.Shop_WatchOrdersServer.
+// The context is canceled after max orders are sent,
+// further orders are ignored.
+type testWatchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	max    int
+	ids    []int32
+}
+
+func (s *testWatchStream) Context() context.Context { return s.ctx }
+
+func (s *testWatchStream) Send(o *shop.Order) error {
+	if len(s.ids) < s.max {
+		s.ids = append(s.ids, o.GetId())
+	}
+	if len(s.ids) >= s.max {
+		s.cancel()
+	}
+	return nil
+}
+
+func Test_shopServer_WatchOrders(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *shop.WatchOrdersRequest
+		want    []int32
+		wantErr bool
+	}{
+		{
+			"Bad token",
+			&shop.WatchOrdersRequest{Token: "foobar"},
+			nil,
+			true,
+		},
+		{
+			"Resume",
+			&shop.WatchOrdersRequest{LastOrderId: 99, Token: testToken},
+			[]int32{100, 101},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(testCtx)
+			defer cancel()
+
+			stream := &testWatchStream{ctx: ctx, cancel: cancel, max: len(tt.want)}
+			if err := tss.WatchOrders(tt.req, stream); (err != nil) != tt.wantErr {
+				t.Errorf("shopServer.WatchOrders() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(stream.ids, tt.want) {
+				t.Errorf("shopServer.WatchOrders() = %v, want %v", stream.ids, tt.want)
+			}
+		})
+	}
+}
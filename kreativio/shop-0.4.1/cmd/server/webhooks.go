@@ -0,0 +1,438 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moapis/shop"
+	"github.com/moapis/shop/models"
+	"github.com/moapis/transaction"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// WebhooksConfig sets the delivery parameters of webhooks.
+// The delivery interval is set in JobsConfig.
+type WebhooksConfig struct {
+	Timeout     time.Duration `json:"timeout"`      // HTTP request timeout of a single delivery
+	Batch       int           `json:"batch"`        // Maximum deliveries per job run
+	MaxAttempts int           `json:"max_attempts"` // Deliveries are given up after this many failed attempts
+	Backoff     time.Duration `json:"backoff"`      // Wait after the first failed attempt, doubled after every next failure
+}
+
+// Webhook event names
+const (
+	eventOrderCreated       = "order.created"
+	eventOrderStatusChanged = "order.status_changed"
+	eventPaymentConfirmed   = "payment.confirmed"
+	eventArticleSaved       = "article.saved"
+	eventArticleDeleted     = "article.deleted"
+	eventMessageReceived    = "message.received"
+)
+
+// Webhook request headers
+const (
+	webhookEventHeader     = "X-Shop-Event"
+	webhookDeliveryHeader  = "X-Shop-Delivery"
+	webhookTimestampHeader = "X-Shop-Timestamp"
+	webhookSignatureHeader = "X-Shop-Signature"
+)
+
+const (
+	errWebhookURL    = "Invalid webhook URL %q"
+	errWebhookStatus = "Unexpected response status %s"
+	errWebhookOff    = "Webhook disabled"
+)
+
+// webhookEventName converts an event enum to its name, like ORDER_STATUS_CHANGED to order.status_changed.
+func webhookEventName(e shop.Webhook_Event) string {
+	return strings.Replace(strings.ToLower(e.String()), "_", ".", 1)
+}
+
+func webhookEventValue(name string) (shop.Webhook_Event, error) {
+	v, ok := shop.Webhook_Event_value[strings.ToUpper(strings.Replace(name, ".", "_", 1))]
+	if !ok {
+		return 0, status.Errorf(codes.Unimplemented, errEnum, name)
+	}
+	return shop.Webhook_Event(v), nil
+}
+
+func webhookMsgToModel(sw *shop.Webhook) (*models.Webhook, error) {
+	vals := map[string]interface{}{
+		"Url":    sw.GetUrl(),
+		"Secret": sw.GetSecret(),
+	}
+	if err := checkRequired(vals); err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(sw.GetUrl())
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, status.Errorf(codes.InvalidArgument, errWebhookURL, sw.GetUrl())
+	}
+
+	events := make(types.StringArray, len(sw.GetEvents()))
+	for i, e := range sw.GetEvents() {
+		if _, ok := shop.Webhook_Event_name[int32(e)]; !ok {
+			return nil, status.Errorf(codes.Unimplemented, errEnum, e)
+		}
+		events[i] = webhookEventName(e)
+	}
+
+	return &models.Webhook{
+		ID:     int(sw.GetId()),
+		URL:    sw.GetUrl(),
+		Secret: sw.GetSecret(),
+		Events: events,
+		Active: sw.GetActive(),
+	}, nil
+}
+
+func webhookModelToMsg(wh *models.Webhook) (*shop.Webhook, error) {
+	created, updated, err := timeModelToMsg(wh.CreatedAt, wh.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]shop.Webhook_Event, len(wh.Events))
+	for i, name := range wh.Events {
+		if events[i], err = webhookEventValue(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return &shop.Webhook{
+		Id:      int32(wh.ID),
+		Created: created,
+		Updated: updated,
+		Url:     wh.URL,
+		Secret:  wh.Secret,
+		Events:  events,
+		Active:  wh.Active,
+	}, nil
+}
+
+func (rt *requestTx) saveWebhook(sw *shop.Webhook) (*shop.Webhook, error) {
+	wh, err := webhookMsgToModel(sw)
+	if err != nil {
+		rt.Log.WithError(err).Warn("webhookMsgToModel")
+		return nil, err
+	}
+	rt.Log = rt.Log.WithFields(logrus.Fields{"webhook_id": wh.ID, "url": wh.URL, "events": wh.Events})
+
+	if wh.ID == 0 {
+		err = wh.Insert(rt.Ctx, rt.Tx, boil.Infer())
+	} else {
+		var ra int64
+		if ra, err = wh.Update(rt.Ctx, rt.Tx, boil.Blacklist(models.WebhookColumns.ID, models.WebhookColumns.CreatedAt)); err == nil && ra == 0 {
+			rt.Log.Warnf(errNotFound, "Webhook", "ID", wh.ID)
+			return nil, status.Errorf(codes.NotFound, errNotFound, "Webhook", "ID", wh.ID)
+		}
+	}
+	if err != nil {
+		rt.Log.WithError(err).Error("saveWebhook")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	if err = wh.Reload(rt.Ctx, rt.Tx); err != nil {
+		rt.Log.WithError(err).Error("saveWebhook: Reload")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	return webhookModelToMsg(wh)
+}
+
+func (rt *requestTx) deleteWebhook(id int) (*shop.Deleted, error) {
+	if id == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, errMissing, "Id")
+	}
+
+	ra, err := models.Webhooks(models.WebhookWhere.ID.EQ(id)).DeleteAll(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("deleteWebhook")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	return &shop.Deleted{Rows: ra}, nil
+}
+
+func (rt *requestTx) listWebhooks() (*shop.WebhookList, error) {
+	whs, err := models.Webhooks(qm.OrderBy(models.WebhookColumns.ID)).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("listWebhooks")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+
+	list := make([]*shop.Webhook, len(whs))
+	for i, wh := range whs {
+		if list[i], err = webhookModelToMsg(wh); err != nil {
+			return nil, err
+		}
+	}
+	return &shop.WebhookList{List: list}, nil
+}
+
+// webhookPayload is the JSON body of a webhook delivery.
+type webhookPayload struct {
+	Event   string          `json:"event"`
+	Created time.Time       `json:"created"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// queueWebhooks writes a delivery of data to the outbox, for every active webhook subscribed to event.
+// The deliveries are only sent if the transaction is committed.
+func (rt *requestTx) queueWebhooks(event string, data proto.Message) error {
+	log := rt.Log.WithField("webhook_event", event)
+
+	whs, err := models.Webhooks(
+		models.WebhookWhere.Active.EQ(true),
+		qm.Where("? = any(events)", event),
+	).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		log.WithError(err).Error("queueWebhooks")
+		return status.Error(codes.Internal, errDB)
+	}
+	if len(whs) == 0 {
+		return nil
+	}
+
+	js, err := protojson.Marshal(data)
+	if err != nil {
+		log.WithError(err).Error("protojson.Marshal")
+		return status.Error(codes.Internal, errInternal)
+	}
+	now := time.Now()
+	payload, err := json.Marshal(webhookPayload{event, now, js})
+	if err != nil {
+		log.WithError(err).Error("json.Marshal")
+		return status.Error(codes.Internal, errInternal)
+	}
+
+	for _, wh := range whs {
+		del := &models.WebhookDelivery{
+			WebhookID:     wh.ID,
+			Event:         event,
+			Payload:       payload,
+			NextAttemptAt: null.TimeFrom(now),
+		}
+		if err = del.Insert(rt.Ctx, rt.Tx, boil.Infer()); err != nil {
+			log.WithError(err).Error("queueWebhooks: Insert")
+			return status.Error(codes.Internal, errDB)
+		}
+	}
+
+	log.WithField("deliveries", len(whs)).Debug("queueWebhooks")
+	return nil
+}
+
+// queueOrderWebhooks queues the order identified by oid for event.
+func (rt *requestTx) queueOrderWebhooks(event string, oid int) error {
+	so, err := rt.viewOrder(oid)
+	if err != nil {
+		return err
+	}
+	return rt.queueWebhooks(event, so)
+}
+
+// queueArticleWebhooks queues the article identified by aid for the article.saved event.
+func (rt *requestTx) queueArticleWebhooks(aid int) error {
+	sa, err := rt.viewArticle(aid)
+	if err != nil {
+		return err
+	}
+	return rt.queueWebhooks(eventArticleSaved, sa)
+}
+
+// Mobilpay payment status, which confirms the payment.
+const paymentConfirmed = "confirmed"
+
+// onPaymentStatus queues the payment.confirmed webhooks and mails for confirmed payments.
+// It is called by the mobilpay package, inside the transaction which inserts the payment status.
+func (s *shopServer) onPaymentStatus(ctx context.Context, tx boil.ContextTransactor, ps *models.PaymentStatus) error {
+	if ps.Status != paymentConfirmed {
+		return nil
+	}
+	rt := &requestTx{
+		&transaction.Request{
+			Ctx: ctx,
+			Tx:  tx,
+			Log: s.log.WithFields(logrus.Fields{"method": "onPaymentStatus", "order_id": ps.OrderID}),
+		},
+		s,
+	}
+	if err := rt.queueOrderWebhooks(eventPaymentConfirmed, ps.OrderID); err != nil {
+		return err
+	}
+
+	order, err := models.FindOrder(rt.Ctx, rt.Tx, ps.OrderID)
+	if err != nil {
+		rt.Log.WithError(err).Error("onPaymentStatus: FindOrder")
+		return status.Error(codes.Internal, errDB)
+	}
+	return rt.queueOrderMails(mailPaymentConfirmed, order)
+}
+
+// signWebhook returns the signature header value of body, sent at the Unix timestamp.
+// The timestamp is signed with the body, so that receivers can reject replayed requests.
+func signWebhook(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// postWebhook sends a single delivery to its webhook.
+func postWebhook(ctx context.Context, client *http.Client, del *models.WebhookDelivery) error {
+	wh := del.R.Webhook
+
+	req, err := http.NewRequest(http.MethodPost, wh.URL, bytes.NewReader(del.Payload))
+	if err != nil {
+		return err
+	}
+	ts := time.Now().Unix()
+
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookEventHeader, del.Event)
+	req.Header.Set(webhookDeliveryHeader, strconv.FormatInt(del.ID, 10))
+	req.Header.Set(webhookTimestampHeader, strconv.FormatInt(ts, 10))
+	req.Header.Set(webhookSignatureHeader, signWebhook(wh.Secret, ts, del.Payload))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf(errWebhookStatus, resp.Status)
+	}
+	return nil
+}
+
+// claimWebhooks returns the pending webhook deliveries which are due, with their webhook.
+// Their next_attempt_at is moved past the time needed to post all of them,
+// so that the deliveries are not claimed again while they are posted outside of this transaction.
+// Deliveries of which no result is recorded, are retried after that time.
+func (rt *requestTx) claimWebhooks() (models.WebhookDeliverySlice, error) {
+	conf := rt.s.conf.Webhooks
+
+	dels, err := models.WebhookDeliveries(
+		models.WebhookDeliveryWhere.DeliveredAt.IsNull(),
+		models.WebhookDeliveryWhere.NextAttemptAt.LTE(null.TimeFrom(time.Now())),
+		qm.OrderBy(models.WebhookDeliveryColumns.NextAttemptAt),
+		qm.Limit(conf.Batch),
+		qm.Load(models.WebhookDeliveryRels.Webhook),
+		qm.For("update skip locked"),
+	).All(rt.Ctx, rt.Tx)
+	if err != nil {
+		rt.Log.WithError(err).Error("claimWebhooks: models.WebhookDeliveries")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	if len(dels) == 0 {
+		return nil, nil
+	}
+
+	claimed := time.Now().Add(conf.Timeout*time.Duration(len(dels)) + conf.Backoff)
+	if _, err = dels.UpdateAll(rt.Ctx, rt.Tx, models.M{
+		models.WebhookDeliveryColumns.NextAttemptAt: claimed,
+	}); err != nil {
+		rt.Log.WithError(err).Error("claimWebhooks: UpdateAll")
+		return nil, status.Error(codes.Internal, errDB)
+	}
+	return dels, nil
+}
+
+// recordDelivery stores the result of posting del.
+// Failed deliveries are rescheduled with a back-off,
+// until the maximum attempts are reached.
+// Given up deliveries keep their last error, with an empty next_attempt_at.
+func (rt *requestTx) recordDelivery(del *models.WebhookDelivery, err error) error {
+	conf := rt.s.conf.Webhooks
+	log := rt.Log.WithFields(logrus.Fields{"delivery": del.ID, "webhook_id": del.WebhookID, "webhook_event": del.Event})
+
+	del.Attempts++
+
+	switch {
+	case err == nil:
+		del.DeliveredAt, del.NextAttemptAt, del.LastError = null.TimeFrom(time.Now()), null.Time{}, null.String{}
+		log.WithFields(logrus.Fields{"event": "webhook.delivered", "attempts": del.Attempts}).Info("Webhook delivered")
+	case del.Attempts >= conf.MaxAttempts || !del.R.Webhook.Active:
+		del.NextAttemptAt, del.LastError = null.Time{}, null.StringFrom(err.Error())
+		log.WithError(err).WithFields(logrus.Fields{"event": "webhook.failed", "attempts": del.Attempts}).Error("Webhook delivery given up")
+	default:
+		next := time.Now().Add(retryBackoff(conf.Backoff, del.Attempts))
+		del.NextAttemptAt, del.LastError = null.TimeFrom(next), null.StringFrom(err.Error())
+		log.WithError(err).WithFields(logrus.Fields{"attempts": del.Attempts, "next_attempt_at": next}).Warn("Webhook delivery failed")
+	}
+
+	if _, err = del.Update(rt.Ctx, rt.Tx, boil.Whitelist(
+		models.WebhookDeliveryColumns.Attempts,
+		models.WebhookDeliveryColumns.NextAttemptAt,
+		models.WebhookDeliveryColumns.DeliveredAt,
+		models.WebhookDeliveryColumns.LastError,
+	)); err != nil {
+		log.WithError(err).Error("recordDelivery: Update")
+		return status.Error(codes.Internal, errDB)
+	}
+	return nil
+}
+
+// deliverWebhooks sends the pending webhook deliveries which are due.
+// The deliveries are claimed in a short transaction and posted outside of it,
+// so that slow receivers do not hold a transaction open.
+// The result of every delivery is recorded in its own transaction.
+func (s *shopServer) deliverWebhooks(ctx context.Context) error {
+	var dels models.WebhookDeliverySlice
+	if err := s.execJob(ctx, "claimWebhooks", func(rt *requestTx) (err error) {
+		dels, err = rt.claimWebhooks()
+		return err
+	}); err != nil {
+		return err
+	}
+	if len(dels) == 0 {
+		s.log.WithField("job", "deliverWebhooks").Debug("deliverWebhooks: nothing to do")
+		return nil
+	}
+
+	client := &http.Client{Timeout: s.conf.Webhooks.Timeout}
+
+	for _, del := range dels {
+		var perr error
+		if del.R.Webhook.Active {
+			perr = postWebhook(ctx, client, del)
+		} else {
+			perr = errors.New(errWebhookOff)
+		}
+
+		if err := s.execJob(ctx, "recordDelivery", func(rt *requestTx) error {
+			return rt.recordDelivery(del, perr)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
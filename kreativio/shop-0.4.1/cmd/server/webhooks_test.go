@@ -0,0 +1,483 @@
+// Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+// Use of this source code is governed by a License that can be found in the LICENSE file.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/moapis/shop"
+	"github.com/moapis/shop/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func Test_webhookEventName(t *testing.T) {
+	for e := range shop.Webhook_Event_name {
+		name := webhookEventName(shop.Webhook_Event(e))
+		got, err := webhookEventValue(name)
+		if err != nil || got != shop.Webhook_Event(e) {
+			t.Errorf("webhookEventValue(%s) = %v, %v, want %v", name, got, err, shop.Webhook_Event(e))
+		}
+	}
+	if got := webhookEventName(shop.Webhook_ORDER_STATUS_CHANGED); got != eventOrderStatusChanged {
+		t.Errorf("webhookEventName() = %s, want %s", got, eventOrderStatusChanged)
+	}
+	if _, err := webhookEventValue("foo.bar"); status.Code(err) != codes.Unimplemented {
+		t.Errorf("webhookEventValue() error = %v, want %v", err, codes.Unimplemented)
+	}
+}
+
+func Test_webhookMsgToModel(t *testing.T) {
+	tests := []struct {
+		name    string
+		sw      *shop.Webhook
+		want    *models.Webhook
+		wantErr error
+	}{
+		{
+			"Missing fields",
+			&shop.Webhook{},
+			nil,
+			status.Errorf(codes.InvalidArgument, errMissing, "Secret, Url"),
+		},
+		{
+			"Invalid URL",
+			&shop.Webhook{Url: "ftp://example.com", Secret: "secret"},
+			nil,
+			status.Errorf(codes.InvalidArgument, errWebhookURL, "ftp://example.com"),
+		},
+		{
+			"Invalid event",
+			&shop.Webhook{Url: "https://example.com/hook", Secret: "secret", Events: []shop.Webhook_Event{99}},
+			nil,
+			status.Errorf(codes.Unimplemented, errEnum, shop.Webhook_Event(99)),
+		},
+		{
+			"Success",
+			&shop.Webhook{
+				Id:     1,
+				Url:    "https://example.com/hook",
+				Secret: "secret",
+				Events: []shop.Webhook_Event{shop.Webhook_ORDER_CREATED, shop.Webhook_MESSAGE_RECEIVED},
+				Active: true,
+			},
+			&models.Webhook{
+				ID:     1,
+				URL:    "https://example.com/hook",
+				Secret: "secret",
+				Events: types.StringArray{eventOrderCreated, eventMessageReceived},
+				Active: true,
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := webhookMsgToModel(tt.sw)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("webhookMsgToModel() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("webhookMsgToModel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_signWebhook(t *testing.T) {
+	want := "sha256=cc500a8e70bf4a9ae4883d009cae43c86612d32ac1155815b4928aa255ca1825"
+	body := []byte(`{"event":"order.created"}`)
+	if got := signWebhook("secret", 1600000000, body); got != want {
+		t.Errorf("signWebhook() = %s, want %s", got, want)
+	}
+	if got := signWebhook("secret", 1600000001, body); got == want {
+		t.Errorf("signWebhook() = %s, want timestamp in signature", got)
+	}
+}
+
+// testWebhookServer records the received requests and responds with code.
+func testWebhookServer(code int) (*httptest.Server, *[]*http.Request, *[][]byte) {
+	var (
+		reqs   []*http.Request
+		bodies [][]byte
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		reqs, bodies = append(reqs, r), append(bodies, body)
+		w.WriteHeader(code)
+	}))
+	return srv, &reqs, &bodies
+}
+
+func Test_postWebhook(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    int
+		wantErr bool
+	}{
+		{"Success", http.StatusNoContent, false},
+		{"Server error", http.StatusInternalServerError, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, reqs, bodies := testWebhookServer(tt.code)
+			defer srv.Close()
+
+			del := &models.WebhookDelivery{
+				ID:      7,
+				Event:   eventOrderCreated,
+				Payload: []byte(`{"event":"order.created"}`),
+			}
+			del.R = del.R.NewStruct()
+			del.R.Webhook = &models.Webhook{URL: srv.URL, Secret: "secret"}
+
+			if err := postWebhook(context.Background(), srv.Client(), del); (err != nil) != tt.wantErr {
+				t.Errorf("postWebhook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(*reqs) != 1 {
+				t.Fatalf("postWebhook() requests = %d, want 1", len(*reqs))
+			}
+
+			h := (*reqs)[0].Header
+			if h.Get(webhookEventHeader) != eventOrderCreated || h.Get(webhookDeliveryHeader) != "7" {
+				t.Errorf("postWebhook() headers = %v", h)
+			}
+			ts, err := strconv.ParseInt(h.Get(webhookTimestampHeader), 10, 64)
+			if err != nil || time.Since(time.Unix(ts, 0)) > time.Minute {
+				t.Errorf("postWebhook() timestamp = %s, %v", h.Get(webhookTimestampHeader), err)
+			}
+			if got, want := h.Get(webhookSignatureHeader), signWebhook("secret", ts, (*bodies)[0]); got != want {
+				t.Errorf("postWebhook() signature = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func Test_requestTx_saveWebhook(t *testing.T) {
+	tests := []struct {
+		name    string
+		sw      *shop.Webhook
+		wantErr error
+	}{
+		{
+			"DB Error",
+			&shop.Webhook{Url: "https://example.com/hook", Secret: "secret"},
+			status.Error(codes.Internal, errDB),
+		},
+		{
+			"Invalid",
+			&shop.Webhook{Url: "https://example.com/hook"},
+			status.Errorf(codes.InvalidArgument, errMissing, "Secret"),
+		},
+		{
+			"Not found",
+			&shop.Webhook{Id: 999, Url: "https://example.com/hook", Secret: "secret"},
+			status.Errorf(codes.NotFound, errNotFound, "Webhook", "ID", 999),
+		},
+		{
+			"Insert",
+			&shop.Webhook{
+				Url:    "https://example.com/hook",
+				Secret: "secret",
+				Events: []shop.Webhook_Event{shop.Webhook_ARTICLE_SAVED},
+				Active: true,
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+			if tt.name == "DB Error" {
+				rt.Done()
+			}
+
+			got, err := rt.saveWebhook(tt.sw)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("requestTx.saveWebhook() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got.GetId() == 0 || got.GetCreated() == nil || !reflect.DeepEqual(got.GetEvents(), tt.sw.GetEvents()) {
+				t.Errorf("requestTx.saveWebhook() = %v", got)
+			}
+
+			list, err := rt.listWebhooks()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(list.GetList()) != 1 || list.GetList()[0].GetId() != got.GetId() {
+				t.Errorf("requestTx.listWebhooks() = %v", list)
+			}
+
+			del, err := rt.deleteWebhook(int(got.GetId()))
+			if err != nil || del.GetRows() != 1 {
+				t.Errorf("requestTx.deleteWebhook() = %v, %v", del, err)
+			}
+		})
+	}
+}
+
+// deleteTestWebhook removes a committed test webhook and its deliveries.
+func deleteTestWebhook(t *testing.T, id int) {
+	rt, err := tss.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Done()
+	if _, err = rt.deleteWebhook(id); err != nil {
+		t.Fatal(err)
+	}
+	if err = rt.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_requestTx_claimWebhooks(t *testing.T) {
+	rt, err := tss.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Done()
+
+	if _, err = rt.saveWebhook(&shop.Webhook{
+		Url:    "https://example.com/hook",
+		Secret: "secret",
+		Events: []shop.Webhook_Event{shop.Webhook_ARTICLE_DELETED},
+		Active: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err = rt.queueWebhooks(eventArticleDeleted, &shop.ArticleID{Id: 11}); err != nil {
+		t.Fatal(err)
+	}
+
+	dels, err := rt.claimWebhooks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dels) != 1 || dels[0].R == nil || dels[0].R.Webhook == nil {
+		t.Fatalf("requestTx.claimWebhooks() = %v, want 1 delivery with webhook", dels)
+	}
+	if err = dels[0].Reload(rt.Ctx, rt.Tx); err != nil {
+		t.Fatal(err)
+	}
+	if !dels[0].NextAttemptAt.Time.After(time.Now().Add(Default.Webhooks.Timeout)) || dels[0].Attempts != 0 {
+		t.Errorf("requestTx.claimWebhooks() delivery = %+v, want claimed", dels[0])
+	}
+
+	// Claimed deliveries are not due
+	if dels, err = rt.claimWebhooks(); err != nil || len(dels) != 0 {
+		t.Errorf("requestTx.claimWebhooks() = %v, %v, want none", dels, err)
+	}
+}
+
+func Test_shopServer_deliverWebhooks(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          int
+		active        bool
+		attempts      int
+		wantDelivered bool
+		wantNext      bool
+	}{
+		{"Delivered", http.StatusOK, true, 0, true, false},
+		{"Retry", http.StatusBadGateway, true, 0, false, true},
+		{"Give up", http.StatusBadGateway, true, Default.Webhooks.MaxAttempts - 1, false, false},
+		{"Disabled", http.StatusOK, false, 0, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, reqs, bodies := testWebhookServer(tt.code)
+			defer srv.Close()
+
+			rt, err := tss.newTx(testCtx, "testing", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			sw, err := rt.saveWebhook(&shop.Webhook{
+				Url:    srv.URL,
+				Secret: "secret",
+				Events: []shop.Webhook_Event{shop.Webhook_ARTICLE_DELETED},
+				Active: true,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = rt.queueWebhooks(eventArticleDeleted, &shop.ArticleID{Id: 11}); err != nil {
+				t.Fatal(err)
+			}
+
+			wh := &models.Webhook{ID: int(sw.GetId())}
+			if _, err = wh.WebhookDeliveries().UpdateAll(rt.Ctx, rt.Tx, models.M{
+				models.WebhookDeliveryColumns.Attempts: tt.attempts,
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if !tt.active {
+				if _, err = models.Webhooks(models.WebhookWhere.ID.EQ(wh.ID)).UpdateAll(rt.Ctx, rt.Tx, models.M{
+					models.WebhookColumns.Active: false,
+				}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err = rt.Commit(); err != nil {
+				t.Fatal(err)
+			}
+			defer deleteTestWebhook(t, wh.ID)
+
+			if err = tss.deliverWebhooks(testCtx); err != nil {
+				t.Fatal(err)
+			}
+
+			rt, err = tss.newTx(testCtx, "testing", true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rt.Done()
+
+			del, err := wh.WebhookDeliveries().One(rt.Ctx, rt.Tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if del.Attempts != tt.attempts+1 || del.DeliveredAt.Valid != tt.wantDelivered || del.NextAttemptAt.Valid != tt.wantNext {
+				t.Errorf("shopServer.deliverWebhooks() delivery = %+v", del)
+			}
+			if del.LastError.Valid == tt.wantDelivered {
+				t.Errorf("shopServer.deliverWebhooks() last error = %v", del.LastError)
+			}
+
+			if !tt.active {
+				if len(*reqs) != 0 {
+					t.Errorf("shopServer.deliverWebhooks() sent %d requests to disabled webhook", len(*reqs))
+				}
+				return
+			}
+			if len(*reqs) != 1 {
+				t.Fatalf("shopServer.deliverWebhooks() requests = %d, want 1", len(*reqs))
+			}
+			var payload struct {
+				Event string
+				Data  struct{ Id int32 }
+			}
+			if err = json.Unmarshal((*bodies)[0], &payload); err != nil {
+				t.Fatal(err)
+			}
+			if payload.Event != eventArticleDeleted || payload.Data.Id != 11 {
+				t.Errorf("shopServer.deliverWebhooks() payload = %s", (*bodies)[0])
+			}
+		})
+	}
+}
+
+func Test_requestTx_queueWebhooks(t *testing.T) {
+	rt, err := tss.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Done()
+
+	if _, err = rt.saveWebhook(&shop.Webhook{
+		Url:    "https://example.com/hook",
+		Secret: "secret",
+		Events: []shop.Webhook_Event{shop.Webhook_ORDER_STATUS_CHANGED},
+		Active: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Not subscribed
+	if err = rt.queueOrderWebhooks(eventOrderCreated, 100); err != nil {
+		t.Fatal(err)
+	}
+	if err = rt.queueOrderWebhooks(eventOrderStatusChanged, 100); err != nil {
+		t.Fatal(err)
+	}
+
+	dels, err := models.WebhookDeliveries().All(rt.Ctx, rt.Tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dels) != 1 || dels[0].Event != eventOrderStatusChanged || !dels[0].NextAttemptAt.Valid {
+		t.Fatalf("requestTx.queueWebhooks() deliveries = %v", dels)
+	}
+
+	var payload webhookPayload
+	if err = json.Unmarshal(dels[0].Payload, &payload); err != nil {
+		t.Fatal(err)
+	}
+	var order shop.Order
+	if err = protojson.Unmarshal(payload.Data, &order); err != nil || order.GetId() != 100 {
+		t.Errorf("requestTx.queueWebhooks() data = %s, %v", payload.Data, err)
+	}
+
+	rt.Done()
+	if err = rt.queueWebhooks(eventOrderCreated, &shop.OrderID{}); !errors.Is(err, status.Error(codes.Internal, errDB)) {
+		t.Errorf("requestTx.queueWebhooks() error = %v, want %v", err, errDB)
+	}
+}
+
+func Test_shopServer_onPaymentStatus(t *testing.T) {
+	rt, err := tss.newTx(testCtx, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Done()
+
+	if _, err = rt.saveWebhook(&shop.Webhook{
+		Url:    "https://example.com/hook",
+		Secret: "secret",
+		Events: []shop.Webhook_Event{shop.Webhook_PAYMENT_CONFIRMED},
+		Active: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, ps := range []*models.PaymentStatus{
+		{OrderID: 100, Status: "paid_pending", CreatedAt: null.TimeFrom(time.Now())},
+		{OrderID: 100, Status: paymentConfirmed, CreatedAt: null.TimeFrom(time.Now())},
+	} {
+		if err = tss.onPaymentStatus(rt.Ctx, rt.Tx, ps); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	n, err := models.WebhookDeliveries(models.WebhookDeliveryWhere.Event.EQ(eventPaymentConfirmed)).Count(rt.Ctx, rt.Tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("shopServer.onPaymentStatus() deliveries = %d, want 1", n)
+	}
+
+	n, err = models.Mails(models.MailWhere.Template.EQ("payment_confirmed.customer.en")).Count(rt.Ctx, rt.Tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("shopServer.onPaymentStatus() mails = %d, want 1", n)
+	}
+}
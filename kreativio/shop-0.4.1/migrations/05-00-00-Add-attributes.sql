@@ -0,0 +1,49 @@
+-- Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+create type shop.attribute_type as enum
+    ('TEXT', 'NUMBER', 'ENUM', 'BOOLEAN');
+
+create table shop.attributes (
+    id serial not null primary key,
+    created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+    label text not null,
+    type shop.attribute_type not null,
+    unit text not null default '',
+    options text[] not null default '{}',
+    position integer not null,
+    unique(label)
+);
+
+create table shop.category_attributes (
+    category_id integer not null references shop.categories (id),
+    attribute_id integer not null references shop.attributes (id),
+    primary key(category_id, attribute_id)
+);
+
+create table shop.article_attributes (
+    id serial not null primary key,
+    created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+    article_id integer not null references shop.articles (id),
+    attribute_id integer not null references shop.attributes (id),
+    value text not null,
+    number numeric null,
+    unique(article_id, attribute_id)
+);
+
+create index article_attributes_value_index on shop.article_attributes (attribute_id, value);
+
+create index article_attributes_number_index on shop.article_attributes (attribute_id, number)
+    where number is not null;
+
+-- +migrate Down
+
+drop table shop.article_attributes;
+drop table shop.category_attributes;
+drop table shop.attributes;
+drop type shop.attribute_type;
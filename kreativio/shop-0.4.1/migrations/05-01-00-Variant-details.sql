@@ -0,0 +1,69 @@
+-- Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+alter table shop.variants
+    drop constraint variants_labels_key;
+
+alter table shop.variants
+    add column sku text null,
+    add column price numeric null,
+    add column stock integer null,
+    add column weight numeric null,
+    add column images text[] not null default '{}',
+    add column position integer not null default 0,
+    add column archived boolean not null default false,
+    add unique(sku);
+
+-- Labels only need to be unique amongst the active variants of one article.
+create unique index variants_labels_index on shop.variants (article_id, labels)
+    where not archived;
+
+alter table shop.order_articles
+    add column variant_id bigint null references shop.variants (id);
+
+-- Best effort recovery of references from the order details.
+update shop.order_articles oa
+    set variant_id = v.id
+    from shop.variants v
+    where v.article_id = oa.article_id
+    and oa.details is not null
+    and v.labels = array(
+        select jsonb_array_elements_text(oa.details->'variant'->'labels')
+    );
+
+-- The same article can be ordered in multiple variants.
+alter table shop.order_articles
+    drop constraint order_articles_order_id_article_id_key;
+
+create unique index order_articles_line_index on shop.order_articles
+    (order_id, article_id, coalesce(variant_id, 0));
+
+-- +migrate Down
+
+drop index shop.order_articles_line_index;
+
+alter table shop.order_articles
+    add unique (order_id, article_id);
+
+alter table shop.order_articles
+    drop column variant_id;
+
+-- Archived variants where only kept for order references.
+delete from shop.variants where archived;
+
+drop index shop.variants_labels_index;
+
+alter table shop.variants
+    drop column sku,
+    drop column price,
+    drop column stock,
+    drop column weight,
+    drop column images,
+    drop column position,
+    drop column archived;
+
+alter table shop.variants
+    add unique(labels);
@@ -0,0 +1,28 @@
+-- Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+alter table shop.articles
+    add column publish_at timestamp with time zone null,
+    add column unpublish_at timestamp with time zone null,
+    add column sale_price numeric null,
+    add column sale_start timestamp with time zone null,
+    add column sale_end timestamp with time zone null,
+    add column live_notified_at timestamp with time zone null;
+
+create index articles_publish_at_index on shop.articles (publish_at)
+    where publish_at is not null;
+
+-- +migrate Down
+
+drop index shop.articles_publish_at_index;
+
+alter table shop.articles
+    drop column publish_at,
+    drop column unpublish_at,
+    drop column sale_price,
+    drop column sale_start,
+    drop column sale_end,
+    drop column live_notified_at;
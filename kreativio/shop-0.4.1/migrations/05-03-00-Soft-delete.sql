@@ -0,0 +1,58 @@
+-- Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+alter table shop.articles
+    add column deleted_at timestamp with time zone null;
+
+create index articles_deleted_at_index on shop.articles (deleted_at)
+    where deleted_at is not null;
+
+-- Titles only need to be unique amongst the articles which are not deleted.
+alter table shop.articles
+    drop constraint articles_title_key;
+
+create unique index articles_title_index on shop.articles (title)
+    where deleted_at is null;
+
+-- Order lines of hard deleted articles get a deleted placeholder article,
+-- so the foreign key dropped in 00-04-01 can be restored.
+insert into shop.articles (id, created_at, updated_at, published, title, description, price, deleted_at)
+    select distinct on (oa.article_id)
+        oa.article_id, now(), now(), false, oa.title, '', oa.price, now()
+    from shop.order_articles oa
+    where not exists (
+        select 1 from shop.articles a where a.id = oa.article_id
+    )
+    order by oa.article_id, oa.order_id desc;
+
+alter table shop.order_articles
+    add constraint order_articles_article_id_fkey
+    foreign key (article_id) references shop.articles (id);
+
+-- +migrate Down
+
+alter table shop.order_articles
+    drop constraint order_articles_article_id_fkey;
+
+-- Deleted articles can not be restored after migrating down.
+delete from shop.images where article_id in (select id from shop.articles where deleted_at is not null);
+delete from shop.videos where article_id in (select id from shop.articles where deleted_at is not null);
+delete from shop.category_articles where article_id in (select id from shop.articles where deleted_at is not null);
+delete from shop.article_base_prices where article_id in (select id from shop.articles where deleted_at is not null);
+delete from shop.article_attributes where article_id in (select id from shop.articles where deleted_at is not null);
+update shop.order_articles set variant_id = null where article_id in (select id from shop.articles where deleted_at is not null);
+delete from shop.variants where article_id in (select id from shop.articles where deleted_at is not null);
+delete from shop.articles where deleted_at is not null;
+
+drop index shop.articles_title_index;
+
+alter table shop.articles
+    add unique(title);
+
+drop index shop.articles_deleted_at_index;
+
+alter table shop.articles
+    drop column deleted_at;
@@ -0,0 +1,19 @@
+-- Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+create table shop.article_revisions (
+    id serial not null primary key,
+    created_at timestamp with time zone not null,
+    article_id integer not null references shop.articles (id),
+    subject text not null,
+    snapshot jsonb not null
+);
+
+create index article_revisions_article_index on shop.article_revisions (article_id, id);
+
+-- +migrate Down
+
+drop table shop.article_revisions;
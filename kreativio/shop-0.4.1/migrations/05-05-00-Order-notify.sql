@@ -0,0 +1,35 @@
+-- Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+-- Notify the shop_orders channel with the order ID,
+-- on new and updated orders and on payment status reports.
+
+-- +migrate StatementBegin
+create function shop.notify_order() returns trigger as $$
+begin
+    if tg_table_name = 'payment_status' then
+        perform pg_notify('shop_orders', new.order_id::text);
+    else
+        perform pg_notify('shop_orders', new.id::text);
+    end if;
+    return null;
+end;
+$$ language plpgsql;
+-- +migrate StatementEnd
+
+create trigger orders_notify
+    after insert or update on shop.orders
+    for each row execute procedure shop.notify_order();
+
+create trigger payment_status_notify
+    after insert on shop.payment_status
+    for each row execute procedure shop.notify_order();
+
+-- +migrate Down
+
+drop trigger payment_status_notify on shop.payment_status;
+drop trigger orders_notify on shop.orders;
+drop function shop.notify_order();
@@ -0,0 +1,38 @@
+-- Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+create table shop.webhooks (
+    id serial not null primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    url text not null,
+    secret text not null,
+    events text[] not null default '{}',
+    active boolean not null default true
+);
+
+-- Deliveries are the outbox of webhook calls.
+-- They are written in the same transaction as the event,
+-- and sent by the deliverWebhooks job.
+create table shop.webhook_deliveries (
+    id bigserial not null primary key,
+    created_at timestamp with time zone not null,
+    webhook_id integer not null references shop.webhooks (id) on delete cascade,
+    event text not null,
+    payload jsonb not null,
+    attempts integer not null default 0,
+    next_attempt_at timestamp with time zone null,
+    delivered_at timestamp with time zone null,
+    last_error text null
+);
+
+create index webhook_deliveries_pending_index on shop.webhook_deliveries (next_attempt_at)
+    where delivered_at is null and next_attempt_at is not null;
+
+-- +migrate Down
+
+drop table shop.webhook_deliveries;
+drop table shop.webhooks;
@@ -0,0 +1,30 @@
+-- Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+-- Outgoing mails are rendered and written in the same transaction as the event,
+-- and sent by the sendMails job.
+-- Mails which fail max_attempts times are dead-lettered by setting failed_at.
+create table shop.mails (
+    id bigserial not null primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    template text not null,
+    subject text not null,
+    recipients text[] not null,
+    body text not null,
+    attempts integer not null default 0,
+    next_attempt_at timestamp with time zone null,
+    sent_at timestamp with time zone null,
+    failed_at timestamp with time zone null,
+    last_error text null
+);
+
+create index mails_pending_index on shop.mails (next_attempt_at)
+    where sent_at is null and failed_at is null;
+
+-- +migrate Down
+
+drop table shop.mails;
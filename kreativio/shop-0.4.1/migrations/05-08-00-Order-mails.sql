@@ -0,0 +1,26 @@
+-- Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up notransaction
+
+alter type shop.status add value if not exists 'CANCELLED';
+
+alter table shop.orders
+    add column locale text not null default '',
+    add column tracking_number text not null default '',
+    add column tracking_url text not null default '';
+
+alter table shop.mails add column text_body text not null default '';
+
+-- +migrate Down
+
+-- Enum values can not be dropped; CANCELLED stays in shop.status.
+update shop.orders set status = 'OPEN' where status = 'CANCELLED';
+
+alter table shop.mails drop column text_body;
+
+alter table shop.orders
+    drop column locale,
+    drop column tracking_number,
+    drop column tracking_url;
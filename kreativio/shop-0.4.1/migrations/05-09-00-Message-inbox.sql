@@ -0,0 +1,34 @@
+-- Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+alter table shop.messages
+    add column read_at timestamp with time zone null,
+    add column archived_at timestamp with time zone null,
+    add column remote_addr text not null default '';
+
+create index messages_remote_addr_index on shop.messages (remote_addr, created_at);
+
+create table shop.message_replies (
+    id serial primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    message_id integer not null references shop.messages (id) on delete cascade,
+    author text not null,
+    body text not null
+);
+
+create index message_replies_message_index on shop.message_replies (message_id);
+
+-- +migrate Down
+
+drop table shop.message_replies;
+
+drop index if exists shop.messages_remote_addr_index;
+
+alter table shop.messages
+    drop column read_at,
+    drop column archived_at,
+    drop column remote_addr;
@@ -0,0 +1,48 @@
+-- Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+create type shop.subscriber_status as enum (
+    'PENDING',
+    'CONFIRMED',
+    'UNSUBSCRIBED'
+);
+
+create table shop.subscribers (
+    id serial primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    email text not null,
+    name text not null default '',
+    locale text not null default '',
+    status shop.subscriber_status not null default 'PENDING',
+    confirmed_at timestamp with time zone null,
+    unsubscribed_at timestamp with time zone null,
+    unique(email)
+);
+
+create table shop.subscriber_consents (
+    id serial primary key,
+    created_at timestamp with time zone not null,
+    subscriber_id integer not null references shop.subscribers (id) on delete cascade,
+    action text not null,
+    source text not null default '',
+    consent_text text not null default '',
+    remote_addr text not null default ''
+);
+
+create index subscriber_consents_subscriber_index on shop.subscriber_consents (subscriber_id);
+
+alter table shop.mails
+    add column unsubscribe_url text not null default '';
+
+-- +migrate Down
+
+alter table shop.mails
+    drop column unsubscribe_url;
+
+drop table shop.subscriber_consents;
+drop table shop.subscribers;
+drop type shop.subscriber_status;
@@ -0,0 +1,21 @@
+-- Copyright (c) 2019, Mohlmann Solutions SRL. All rights reserved.
+-- Use of this source code is governed by a License that can be found in the LICENSE file.
+-- SPDX-License-Identifier: BSD-3-Clause
+
+-- +migrate Up
+
+alter table shop.orders
+    add column payment_reminded_at timestamp with time zone null;
+
+create index orders_unpaid_index on shop.orders (created_at)
+    where payment_method = 'ONLINE' and status = 'OPEN';
+
+create index payment_status_order_index on shop.payment_status (order_id);
+
+-- +migrate Down
+
+drop index if exists shop.payment_status_order_index;
+drop index if exists shop.orders_unpaid_index;
+
+alter table shop.orders
+    drop column payment_reminded_at;
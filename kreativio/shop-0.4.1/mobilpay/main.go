@@ -2,6 +2,7 @@ package mobilpay
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"crypto/rc4"
 	"crypto/rsa"
@@ -65,6 +66,9 @@ func (o *CB) ParseKeys() {
 // CB - contains the http cb method and depends on DBh *multidb.MultiDB handle
 type CB struct {
 	DBh *multidb.MultiDB
+	// OnStatus is called after a payment status is inserted, in the same transaction.
+	// A returned error rolls back the transaction.
+	OnStatus func(ctx context.Context, tx boil.ContextTransactor, ps *models.PaymentStatus) error
 }
 type helper interface {
 	xmlMarshal(rsp interface{}) []byte
@@ -80,6 +84,7 @@ type crc struct {
 
 /*
 MerchantsResponseE - represents the ping back that the confirm endpoint will make with attributes that specify error.
+
 	ErrorType - 1 = temp error ; 2 = perm error ;
 */
 type MerchantsResponseE struct {
@@ -217,6 +222,12 @@ func (o *CB) insertPaymentStatus(r *http.Request, responseXML MResponse) error {
 			tx.Rollback()
 			return e
 		}
+		if o.OnStatus != nil {
+			if e := o.OnStatus(r.Context(), tx, &new); e != nil {
+				tx.Rollback()
+				return e
+			}
+		}
 		return tx.Commit()
 	}
 	return nil
@@ -313,6 +324,25 @@ func xmlMarshal(rsp interface{}) ([]byte, error) {
 	return b, nil
 }
 
+// RedactConfirmation removes the customer details and card data from a confirmation XML,
+// as stored in payment_status. The action, amounts and error code are kept.
+// A confirmation which can not be parsed is replaced by an empty one.
+func RedactConfirmation(confirmation string) string {
+	var m mobilpay
+	if e := xml.Unmarshal([]byte(confirmation), &m); e != nil {
+		log.Printf("RedactConfirmation() unmarshal error: %s", e.Error())
+		m = mobilpay{}
+	}
+	m.Customer = customer{Type: m.Customer.Type}
+	m.PanMasked = ""
+	m.PaymentInstrumentID = ""
+	m.TokenID = ""
+	m.TokenExpirationDate = ""
+
+	b, _ := xmlMarshal(m) // Only strings and ints, can't fail
+	return string(b)
+}
+
 func getPrivateKey(data []byte) (*rsa.PrivateKey, error) {
 	key, e := ssh.ParseRawPrivateKey(data)
 	return key.(*rsa.PrivateKey), e
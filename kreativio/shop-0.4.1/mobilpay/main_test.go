@@ -112,6 +112,39 @@ func Test_xmlMarshal(t *testing.T) {
 	}
 }
 
+func TestRedactConfirmation(t *testing.T) {
+	full := `<mobilpay timestamp="20200801120000" crc="abc"><action>confirmed</action>` +
+		`<customer type="person"><first_name>Foo</first_name><last_name>Bar</last_name><address>Long Street 7</address>` +
+		`<email>foo@bar.com</email><mobile_phone>0700000000</mobile_phone></customer>` +
+		`<purchase>123</purchase><original_amount>10.00</original_amount><processed_amount>10.00</processed_amount>` +
+		`<pan_masked>4****1234</pan_masked><payment_instrument_id>99</payment_instrument_id>` +
+		`<token_id>tkn</token_id><token_expiration_date>2021-01-01</token_expiration_date><error_code>0</error_code></mobilpay>`
+	want := `<mobilpay timestamp="20200801120000" crc="abc"><action>confirmed</action>` +
+		`<customer type="person"><first_name></first_name><last_name></last_name><address></address>` +
+		`<email></email><mobile_phone></mobile_phone></customer>` +
+		`<purchase>123</purchase><original_amount>10.00</original_amount><processed_amount>10.00</processed_amount>` +
+		`<pan_masked></pan_masked><payment_instrument_id></payment_instrument_id>` +
+		`<token_id></token_id><token_expiration_date></token_expiration_date><error_code>0</error_code></mobilpay>`
+	empty, _ := xml.Marshal(mobilpay{})
+
+	tests := []struct {
+		name         string
+		confirmation string
+		want         string
+	}{
+		{"Full", full, want},
+		{"Redacted", want, want},
+		{"Garbage", "<mobilpay><action>", string(empty)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RedactConfirmation(tt.confirmation); got != tt.want {
+				t.Errorf("RedactConfirmation() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestEncrypt(t *testing.T) {
 	data, _ := ioutil.ReadFile("sandbox.LK1F-GMV1-YWRD-7J6T-QD55.public.cer")
 	b, _ := pem.Decode(data)
@@ -0,0 +1,1347 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// ArticleAttribute is an object representing the database table.
+type ArticleAttribute struct {
+	ID          int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt   time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ArticleID   int               `boil:"article_id" json:"article_id" toml:"article_id" yaml:"article_id"`
+	AttributeID int               `boil:"attribute_id" json:"attribute_id" toml:"attribute_id" yaml:"attribute_id"`
+	Value       string            `boil:"value" json:"value" toml:"value" yaml:"value"`
+	Number      types.NullDecimal `boil:"number" json:"number,omitempty" toml:"number" yaml:"number,omitempty"`
+
+	R *articleAttributeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L articleAttributeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ArticleAttributeColumns = struct {
+	ID          string
+	CreatedAt   string
+	UpdatedAt   string
+	ArticleID   string
+	AttributeID string
+	Value       string
+	Number      string
+}{
+	ID:          "id",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+	ArticleID:   "article_id",
+	AttributeID: "attribute_id",
+	Value:       "value",
+	Number:      "number",
+}
+
+// Generated where
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertypes_NullDecimal struct{ field string }
+
+func (w whereHelpertypes_NullDecimal) EQ(x types.NullDecimal) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpertypes_NullDecimal) NEQ(x types.NullDecimal) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpertypes_NullDecimal) IsNull() qm.QueryMod { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpertypes_NullDecimal) IsNotNull() qm.QueryMod {
+	return qmhelper.WhereIsNotNull(w.field)
+}
+func (w whereHelpertypes_NullDecimal) LT(x types.NullDecimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_NullDecimal) LTE(x types.NullDecimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_NullDecimal) GT(x types.NullDecimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_NullDecimal) GTE(x types.NullDecimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var ArticleAttributeWhere = struct {
+	ID          whereHelperint
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+	ArticleID   whereHelperint
+	AttributeID whereHelperint
+	Value       whereHelperstring
+	Number      whereHelpertypes_NullDecimal
+}{
+	ID:          whereHelperint{field: "\"shop\".\"article_attributes\".\"id\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"shop\".\"article_attributes\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"shop\".\"article_attributes\".\"updated_at\""},
+	ArticleID:   whereHelperint{field: "\"shop\".\"article_attributes\".\"article_id\""},
+	AttributeID: whereHelperint{field: "\"shop\".\"article_attributes\".\"attribute_id\""},
+	Value:       whereHelperstring{field: "\"shop\".\"article_attributes\".\"value\""},
+	Number:      whereHelpertypes_NullDecimal{field: "\"shop\".\"article_attributes\".\"number\""},
+}
+
+// ArticleAttributeRels is where relationship names are stored.
+var ArticleAttributeRels = struct {
+	Article   string
+	Attribute string
+}{
+	Article:   "Article",
+	Attribute: "Attribute",
+}
+
+// articleAttributeR is where relationships are stored.
+type articleAttributeR struct {
+	Article   *Article   `boil:"Article" json:"Article" toml:"Article" yaml:"Article"`
+	Attribute *Attribute `boil:"Attribute" json:"Attribute" toml:"Attribute" yaml:"Attribute"`
+}
+
+// NewStruct creates a new relationship struct
+func (*articleAttributeR) NewStruct() *articleAttributeR {
+	return &articleAttributeR{}
+}
+
+// articleAttributeL is where Load methods for each relationship are stored.
+type articleAttributeL struct{}
+
+var (
+	articleAttributeAllColumns            = []string{"id", "created_at", "updated_at", "article_id", "attribute_id", "value", "number"}
+	articleAttributeColumnsWithoutDefault = []string{"created_at", "updated_at", "article_id", "attribute_id", "value", "number"}
+	articleAttributeColumnsWithDefault    = []string{"id"}
+	articleAttributePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// ArticleAttributeSlice is an alias for a slice of pointers to ArticleAttribute.
+	// This should generally be used opposed to []ArticleAttribute.
+	ArticleAttributeSlice []*ArticleAttribute
+	// ArticleAttributeHook is the signature for custom ArticleAttribute hook methods
+	ArticleAttributeHook func(context.Context, boil.ContextExecutor, *ArticleAttribute) error
+
+	articleAttributeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	articleAttributeType                 = reflect.TypeOf(&ArticleAttribute{})
+	articleAttributeMapping              = queries.MakeStructMapping(articleAttributeType)
+	articleAttributePrimaryKeyMapping, _ = queries.BindMapping(articleAttributeType, articleAttributeMapping, articleAttributePrimaryKeyColumns)
+	articleAttributeInsertCacheMut       sync.RWMutex
+	articleAttributeInsertCache          = make(map[string]insertCache)
+	articleAttributeUpdateCacheMut       sync.RWMutex
+	articleAttributeUpdateCache          = make(map[string]updateCache)
+	articleAttributeUpsertCacheMut       sync.RWMutex
+	articleAttributeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var articleAttributeBeforeInsertHooks []ArticleAttributeHook
+var articleAttributeBeforeUpdateHooks []ArticleAttributeHook
+var articleAttributeBeforeDeleteHooks []ArticleAttributeHook
+var articleAttributeBeforeUpsertHooks []ArticleAttributeHook
+
+var articleAttributeAfterInsertHooks []ArticleAttributeHook
+var articleAttributeAfterSelectHooks []ArticleAttributeHook
+var articleAttributeAfterUpdateHooks []ArticleAttributeHook
+var articleAttributeAfterDeleteHooks []ArticleAttributeHook
+var articleAttributeAfterUpsertHooks []ArticleAttributeHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ArticleAttribute) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAttributeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ArticleAttribute) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAttributeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ArticleAttribute) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAttributeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ArticleAttribute) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAttributeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ArticleAttribute) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAttributeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ArticleAttribute) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAttributeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ArticleAttribute) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAttributeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ArticleAttribute) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAttributeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ArticleAttribute) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAttributeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddArticleAttributeHook registers your hook function for all future operations.
+func AddArticleAttributeHook(hookPoint boil.HookPoint, articleAttributeHook ArticleAttributeHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		articleAttributeBeforeInsertHooks = append(articleAttributeBeforeInsertHooks, articleAttributeHook)
+	case boil.BeforeUpdateHook:
+		articleAttributeBeforeUpdateHooks = append(articleAttributeBeforeUpdateHooks, articleAttributeHook)
+	case boil.BeforeDeleteHook:
+		articleAttributeBeforeDeleteHooks = append(articleAttributeBeforeDeleteHooks, articleAttributeHook)
+	case boil.BeforeUpsertHook:
+		articleAttributeBeforeUpsertHooks = append(articleAttributeBeforeUpsertHooks, articleAttributeHook)
+	case boil.AfterInsertHook:
+		articleAttributeAfterInsertHooks = append(articleAttributeAfterInsertHooks, articleAttributeHook)
+	case boil.AfterSelectHook:
+		articleAttributeAfterSelectHooks = append(articleAttributeAfterSelectHooks, articleAttributeHook)
+	case boil.AfterUpdateHook:
+		articleAttributeAfterUpdateHooks = append(articleAttributeAfterUpdateHooks, articleAttributeHook)
+	case boil.AfterDeleteHook:
+		articleAttributeAfterDeleteHooks = append(articleAttributeAfterDeleteHooks, articleAttributeHook)
+	case boil.AfterUpsertHook:
+		articleAttributeAfterUpsertHooks = append(articleAttributeAfterUpsertHooks, articleAttributeHook)
+	}
+}
+
+// One returns a single articleAttribute record from the query.
+func (q articleAttributeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ArticleAttribute, error) {
+	o := &ArticleAttribute{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for article_attributes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ArticleAttribute records from the query.
+func (q articleAttributeQuery) All(ctx context.Context, exec boil.ContextExecutor) (ArticleAttributeSlice, error) {
+	var o []*ArticleAttribute
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ArticleAttribute slice")
+	}
+
+	if len(articleAttributeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ArticleAttribute records in the query.
+func (q articleAttributeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count article_attributes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q articleAttributeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if article_attributes exists")
+	}
+
+	return count > 0, nil
+}
+
+// Article pointed to by the foreign key.
+func (o *ArticleAttribute) Article(mods ...qm.QueryMod) articleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ArticleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Articles(queryMods...)
+	queries.SetFrom(query.Query, "\"shop\".\"articles\"")
+
+	return query
+}
+
+// Attribute pointed to by the foreign key.
+func (o *ArticleAttribute) Attribute(mods ...qm.QueryMod) attributeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AttributeID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Attributes(queryMods...)
+	queries.SetFrom(query.Query, "\"shop\".\"attributes\"")
+
+	return query
+}
+
+// LoadArticle allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (articleAttributeL) LoadArticle(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticleAttribute interface{}, mods queries.Applicator) error {
+	var slice []*ArticleAttribute
+	var object *ArticleAttribute
+
+	if singular {
+		object = maybeArticleAttribute.(*ArticleAttribute)
+	} else {
+		slice = *maybeArticleAttribute.(*[]*ArticleAttribute)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleAttributeR{}
+		}
+		args = append(args, object.ArticleID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleAttributeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ArticleID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ArticleID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`shop.articles`),
+		qm.WhereIn(`shop.articles.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Article")
+	}
+
+	var resultSlice []*Article
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Article")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for articles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for articles")
+	}
+
+	if len(articleAttributeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Article = foreign
+		if foreign.R == nil {
+			foreign.R = &articleR{}
+		}
+		foreign.R.ArticleAttributes = append(foreign.R.ArticleAttributes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ArticleID == foreign.ID {
+				local.R.Article = foreign
+				if foreign.R == nil {
+					foreign.R = &articleR{}
+				}
+				foreign.R.ArticleAttributes = append(foreign.R.ArticleAttributes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAttribute allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (articleAttributeL) LoadAttribute(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticleAttribute interface{}, mods queries.Applicator) error {
+	var slice []*ArticleAttribute
+	var object *ArticleAttribute
+
+	if singular {
+		object = maybeArticleAttribute.(*ArticleAttribute)
+	} else {
+		slice = *maybeArticleAttribute.(*[]*ArticleAttribute)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleAttributeR{}
+		}
+		args = append(args, object.AttributeID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleAttributeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AttributeID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AttributeID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`shop.attributes`),
+		qm.WhereIn(`shop.attributes.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Attribute")
+	}
+
+	var resultSlice []*Attribute
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Attribute")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for attributes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attributes")
+	}
+
+	if len(articleAttributeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Attribute = foreign
+		if foreign.R == nil {
+			foreign.R = &attributeR{}
+		}
+		foreign.R.ArticleAttributes = append(foreign.R.ArticleAttributes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AttributeID == foreign.ID {
+				local.R.Attribute = foreign
+				if foreign.R == nil {
+					foreign.R = &attributeR{}
+				}
+				foreign.R.ArticleAttributes = append(foreign.R.ArticleAttributes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetArticle of the articleAttribute to the related item.
+// Sets o.R.Article to related.
+// Adds o to related.R.ArticleAttributes.
+func (o *ArticleAttribute) SetArticle(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Article) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"shop\".\"article_attributes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"article_id"}),
+		strmangle.WhereClause("\"", "\"", 2, articleAttributePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ArticleID = related.ID
+	if o.R == nil {
+		o.R = &articleAttributeR{
+			Article: related,
+		}
+	} else {
+		o.R.Article = related
+	}
+
+	if related.R == nil {
+		related.R = &articleR{
+			ArticleAttributes: ArticleAttributeSlice{o},
+		}
+	} else {
+		related.R.ArticleAttributes = append(related.R.ArticleAttributes, o)
+	}
+
+	return nil
+}
+
+// SetAttribute of the articleAttribute to the related item.
+// Sets o.R.Attribute to related.
+// Adds o to related.R.ArticleAttributes.
+func (o *ArticleAttribute) SetAttribute(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Attribute) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"shop\".\"article_attributes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"attribute_id"}),
+		strmangle.WhereClause("\"", "\"", 2, articleAttributePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AttributeID = related.ID
+	if o.R == nil {
+		o.R = &articleAttributeR{
+			Attribute: related,
+		}
+	} else {
+		o.R.Attribute = related
+	}
+
+	if related.R == nil {
+		related.R = &attributeR{
+			ArticleAttributes: ArticleAttributeSlice{o},
+		}
+	} else {
+		related.R.ArticleAttributes = append(related.R.ArticleAttributes, o)
+	}
+
+	return nil
+}
+
+// ArticleAttributes retrieves all the records using an executor.
+func ArticleAttributes(mods ...qm.QueryMod) articleAttributeQuery {
+	mods = append(mods, qm.From("\"shop\".\"article_attributes\""))
+	return articleAttributeQuery{NewQuery(mods...)}
+}
+
+// FindArticleAttribute retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindArticleAttribute(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ArticleAttribute, error) {
+	articleAttributeObj := &ArticleAttribute{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"shop\".\"article_attributes\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, articleAttributeObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from article_attributes")
+	}
+
+	return articleAttributeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ArticleAttribute) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no article_attributes provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(articleAttributeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	articleAttributeInsertCacheMut.RLock()
+	cache, cached := articleAttributeInsertCache[key]
+	articleAttributeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			articleAttributeAllColumns,
+			articleAttributeColumnsWithDefault,
+			articleAttributeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(articleAttributeType, articleAttributeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(articleAttributeType, articleAttributeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"shop\".\"article_attributes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"shop\".\"article_attributes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into article_attributes")
+	}
+
+	if !cached {
+		articleAttributeInsertCacheMut.Lock()
+		articleAttributeInsertCache[key] = cache
+		articleAttributeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ArticleAttribute.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ArticleAttribute) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	articleAttributeUpdateCacheMut.RLock()
+	cache, cached := articleAttributeUpdateCache[key]
+	articleAttributeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			articleAttributeAllColumns,
+			articleAttributePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update article_attributes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"shop\".\"article_attributes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, articleAttributePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(articleAttributeType, articleAttributeMapping, append(wl, articleAttributePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update article_attributes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for article_attributes")
+	}
+
+	if !cached {
+		articleAttributeUpdateCacheMut.Lock()
+		articleAttributeUpdateCache[key] = cache
+		articleAttributeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q articleAttributeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for article_attributes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for article_attributes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ArticleAttributeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articleAttributePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"shop\".\"article_attributes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, articleAttributePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in articleAttribute slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all articleAttribute")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ArticleAttribute) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no article_attributes provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(articleAttributeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	articleAttributeUpsertCacheMut.RLock()
+	cache, cached := articleAttributeUpsertCache[key]
+	articleAttributeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			articleAttributeAllColumns,
+			articleAttributeColumnsWithDefault,
+			articleAttributeColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			articleAttributeAllColumns,
+			articleAttributePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert article_attributes, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(articleAttributePrimaryKeyColumns))
+			copy(conflict, articleAttributePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"shop\".\"article_attributes\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(articleAttributeType, articleAttributeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(articleAttributeType, articleAttributeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert article_attributes")
+	}
+
+	if !cached {
+		articleAttributeUpsertCacheMut.Lock()
+		articleAttributeUpsertCache[key] = cache
+		articleAttributeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ArticleAttribute record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ArticleAttribute) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ArticleAttribute provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), articleAttributePrimaryKeyMapping)
+	sql := "DELETE FROM \"shop\".\"article_attributes\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from article_attributes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for article_attributes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q articleAttributeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no articleAttributeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from article_attributes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for article_attributes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ArticleAttributeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(articleAttributeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articleAttributePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"shop\".\"article_attributes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, articleAttributePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from articleAttribute slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for article_attributes")
+	}
+
+	if len(articleAttributeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ArticleAttribute) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindArticleAttribute(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ArticleAttributeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ArticleAttributeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articleAttributePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"shop\".\"article_attributes\".* FROM \"shop\".\"article_attributes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, articleAttributePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ArticleAttributeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ArticleAttributeExists checks if the ArticleAttribute row exists.
+func ArticleAttributeExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"shop\".\"article_attributes\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if article_attributes exists")
+	}
+
+	return exists, nil
+}
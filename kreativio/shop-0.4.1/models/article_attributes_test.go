@@ -0,0 +1,949 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testArticleAttributes(t *testing.T) {
+	t.Parallel()
+
+	query := ArticleAttributes()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testArticleAttributesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleAttribute{}
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, true, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ArticleAttributes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testArticleAttributesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleAttribute{}
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, true, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ArticleAttributes().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ArticleAttributes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testArticleAttributesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleAttribute{}
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, true, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ArticleAttributeSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ArticleAttributes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testArticleAttributesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleAttribute{}
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, true, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ArticleAttributeExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ArticleAttribute exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ArticleAttributeExists to return true, but got false.")
+	}
+}
+
+func testArticleAttributesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleAttribute{}
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, true, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	articleAttributeFound, err := FindArticleAttribute(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if articleAttributeFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testArticleAttributesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleAttribute{}
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, true, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ArticleAttributes().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testArticleAttributesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleAttribute{}
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, true, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ArticleAttributes().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testArticleAttributesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	articleAttributeOne := &ArticleAttribute{}
+	articleAttributeTwo := &ArticleAttribute{}
+	if err = randomize.Struct(seed, articleAttributeOne, articleAttributeDBTypes, false, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+	if err = randomize.Struct(seed, articleAttributeTwo, articleAttributeDBTypes, false, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = articleAttributeOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = articleAttributeTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ArticleAttributes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testArticleAttributesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	articleAttributeOne := &ArticleAttribute{}
+	articleAttributeTwo := &ArticleAttribute{}
+	if err = randomize.Struct(seed, articleAttributeOne, articleAttributeDBTypes, false, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+	if err = randomize.Struct(seed, articleAttributeTwo, articleAttributeDBTypes, false, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = articleAttributeOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = articleAttributeTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ArticleAttributes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func articleAttributeBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ArticleAttribute) error {
+	*o = ArticleAttribute{}
+	return nil
+}
+
+func articleAttributeAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ArticleAttribute) error {
+	*o = ArticleAttribute{}
+	return nil
+}
+
+func articleAttributeAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ArticleAttribute) error {
+	*o = ArticleAttribute{}
+	return nil
+}
+
+func articleAttributeBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ArticleAttribute) error {
+	*o = ArticleAttribute{}
+	return nil
+}
+
+func articleAttributeAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ArticleAttribute) error {
+	*o = ArticleAttribute{}
+	return nil
+}
+
+func articleAttributeBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ArticleAttribute) error {
+	*o = ArticleAttribute{}
+	return nil
+}
+
+func articleAttributeAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ArticleAttribute) error {
+	*o = ArticleAttribute{}
+	return nil
+}
+
+func articleAttributeBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ArticleAttribute) error {
+	*o = ArticleAttribute{}
+	return nil
+}
+
+func articleAttributeAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ArticleAttribute) error {
+	*o = ArticleAttribute{}
+	return nil
+}
+
+func testArticleAttributesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ArticleAttribute{}
+	o := &ArticleAttribute{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute object: %s", err)
+	}
+
+	AddArticleAttributeHook(boil.BeforeInsertHook, articleAttributeBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	articleAttributeBeforeInsertHooks = []ArticleAttributeHook{}
+
+	AddArticleAttributeHook(boil.AfterInsertHook, articleAttributeAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	articleAttributeAfterInsertHooks = []ArticleAttributeHook{}
+
+	AddArticleAttributeHook(boil.AfterSelectHook, articleAttributeAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	articleAttributeAfterSelectHooks = []ArticleAttributeHook{}
+
+	AddArticleAttributeHook(boil.BeforeUpdateHook, articleAttributeBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	articleAttributeBeforeUpdateHooks = []ArticleAttributeHook{}
+
+	AddArticleAttributeHook(boil.AfterUpdateHook, articleAttributeAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	articleAttributeAfterUpdateHooks = []ArticleAttributeHook{}
+
+	AddArticleAttributeHook(boil.BeforeDeleteHook, articleAttributeBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	articleAttributeBeforeDeleteHooks = []ArticleAttributeHook{}
+
+	AddArticleAttributeHook(boil.AfterDeleteHook, articleAttributeAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	articleAttributeAfterDeleteHooks = []ArticleAttributeHook{}
+
+	AddArticleAttributeHook(boil.BeforeUpsertHook, articleAttributeBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	articleAttributeBeforeUpsertHooks = []ArticleAttributeHook{}
+
+	AddArticleAttributeHook(boil.AfterUpsertHook, articleAttributeAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	articleAttributeAfterUpsertHooks = []ArticleAttributeHook{}
+}
+
+func testArticleAttributesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleAttribute{}
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, true, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ArticleAttributes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testArticleAttributesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleAttribute{}
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(articleAttributeColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ArticleAttributes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testArticleAttributeToOneArticleUsingArticle(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ArticleAttribute
+	var foreign Article
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, articleAttributeDBTypes, false, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, articleDBTypes, false, articleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Article struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ArticleID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Article().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ArticleAttributeSlice{&local}
+	if err = local.L.LoadArticle(ctx, tx, false, (*[]*ArticleAttribute)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Article == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Article = nil
+	if err = local.L.LoadArticle(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Article == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testArticleAttributeToOneAttributeUsingAttribute(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ArticleAttribute
+	var foreign Attribute
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, articleAttributeDBTypes, false, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, attributeDBTypes, false, attributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attribute struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.AttributeID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Attribute().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ArticleAttributeSlice{&local}
+	if err = local.L.LoadAttribute(ctx, tx, false, (*[]*ArticleAttribute)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Attribute == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Attribute = nil
+	if err = local.L.LoadAttribute(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Attribute == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testArticleAttributeToOneSetOpArticleUsingArticle(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ArticleAttribute
+	var b, c Article
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, articleAttributeDBTypes, false, strmangle.SetComplement(articleAttributePrimaryKeyColumns, articleAttributeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, articleDBTypes, false, strmangle.SetComplement(articlePrimaryKeyColumns, articleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, articleDBTypes, false, strmangle.SetComplement(articlePrimaryKeyColumns, articleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Article{&b, &c} {
+		err = a.SetArticle(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Article != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ArticleAttributes[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ArticleID != x.ID {
+			t.Error("foreign key was wrong value", a.ArticleID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ArticleID))
+		reflect.Indirect(reflect.ValueOf(&a.ArticleID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ArticleID != x.ID {
+			t.Error("foreign key was wrong value", a.ArticleID, x.ID)
+		}
+	}
+}
+func testArticleAttributeToOneSetOpAttributeUsingAttribute(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ArticleAttribute
+	var b, c Attribute
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, articleAttributeDBTypes, false, strmangle.SetComplement(articleAttributePrimaryKeyColumns, articleAttributeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, attributeDBTypes, false, strmangle.SetComplement(attributePrimaryKeyColumns, attributeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, attributeDBTypes, false, strmangle.SetComplement(attributePrimaryKeyColumns, attributeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Attribute{&b, &c} {
+		err = a.SetAttribute(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Attribute != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ArticleAttributes[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.AttributeID != x.ID {
+			t.Error("foreign key was wrong value", a.AttributeID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AttributeID))
+		reflect.Indirect(reflect.ValueOf(&a.AttributeID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.AttributeID != x.ID {
+			t.Error("foreign key was wrong value", a.AttributeID, x.ID)
+		}
+	}
+}
+
+func testArticleAttributesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleAttribute{}
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, true, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testArticleAttributesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleAttribute{}
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, true, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ArticleAttributeSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testArticleAttributesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleAttribute{}
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, true, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ArticleAttributes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	articleAttributeDBTypes = map[string]string{`ID`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `ArticleID`: `integer`, `AttributeID`: `integer`, `Value`: `text`, `Number`: `numeric`}
+	_                       = bytes.MinRead
+)
+
+func testArticleAttributesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(articleAttributePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(articleAttributeAllColumns) == len(articleAttributePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleAttribute{}
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, true, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ArticleAttributes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, true, articleAttributePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testArticleAttributesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(articleAttributeAllColumns) == len(articleAttributePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleAttribute{}
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, true, articleAttributeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ArticleAttributes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, articleAttributeDBTypes, true, articleAttributePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(articleAttributeAllColumns, articleAttributePrimaryKeyColumns) {
+		fields = articleAttributeAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			articleAttributeAllColumns,
+			articleAttributePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ArticleAttributeSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testArticleAttributesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(articleAttributeAllColumns) == len(articleAttributePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ArticleAttribute{}
+	if err = randomize.Struct(seed, &o, articleAttributeDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ArticleAttribute: %s", err)
+	}
+
+	count, err := ArticleAttributes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, articleAttributeDBTypes, false, articleAttributePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ArticleAttribute struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ArticleAttribute: %s", err)
+	}
+
+	count, err = ArticleAttributes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
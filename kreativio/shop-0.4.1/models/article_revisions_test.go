@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testArticleRevisions(t *testing.T) {
+	t.Parallel()
+
+	query := ArticleRevisions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testArticleRevisionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleRevision{}
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, true, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ArticleRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testArticleRevisionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleRevision{}
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, true, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ArticleRevisions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ArticleRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testArticleRevisionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleRevision{}
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, true, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ArticleRevisionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ArticleRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testArticleRevisionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleRevision{}
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, true, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ArticleRevisionExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ArticleRevision exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ArticleRevisionExists to return true, but got false.")
+	}
+}
+
+func testArticleRevisionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleRevision{}
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, true, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	articleRevisionFound, err := FindArticleRevision(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if articleRevisionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testArticleRevisionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleRevision{}
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, true, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ArticleRevisions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testArticleRevisionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleRevision{}
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, true, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ArticleRevisions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testArticleRevisionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	articleRevisionOne := &ArticleRevision{}
+	articleRevisionTwo := &ArticleRevision{}
+	if err = randomize.Struct(seed, articleRevisionOne, articleRevisionDBTypes, false, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+	if err = randomize.Struct(seed, articleRevisionTwo, articleRevisionDBTypes, false, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = articleRevisionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = articleRevisionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ArticleRevisions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testArticleRevisionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	articleRevisionOne := &ArticleRevision{}
+	articleRevisionTwo := &ArticleRevision{}
+	if err = randomize.Struct(seed, articleRevisionOne, articleRevisionDBTypes, false, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+	if err = randomize.Struct(seed, articleRevisionTwo, articleRevisionDBTypes, false, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = articleRevisionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = articleRevisionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ArticleRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func articleRevisionBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ArticleRevision) error {
+	*o = ArticleRevision{}
+	return nil
+}
+
+func articleRevisionAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ArticleRevision) error {
+	*o = ArticleRevision{}
+	return nil
+}
+
+func articleRevisionAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ArticleRevision) error {
+	*o = ArticleRevision{}
+	return nil
+}
+
+func articleRevisionBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ArticleRevision) error {
+	*o = ArticleRevision{}
+	return nil
+}
+
+func articleRevisionAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ArticleRevision) error {
+	*o = ArticleRevision{}
+	return nil
+}
+
+func articleRevisionBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ArticleRevision) error {
+	*o = ArticleRevision{}
+	return nil
+}
+
+func articleRevisionAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ArticleRevision) error {
+	*o = ArticleRevision{}
+	return nil
+}
+
+func articleRevisionBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ArticleRevision) error {
+	*o = ArticleRevision{}
+	return nil
+}
+
+func articleRevisionAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ArticleRevision) error {
+	*o = ArticleRevision{}
+	return nil
+}
+
+func testArticleRevisionsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ArticleRevision{}
+	o := &ArticleRevision{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision object: %s", err)
+	}
+
+	AddArticleRevisionHook(boil.BeforeInsertHook, articleRevisionBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	articleRevisionBeforeInsertHooks = []ArticleRevisionHook{}
+
+	AddArticleRevisionHook(boil.AfterInsertHook, articleRevisionAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	articleRevisionAfterInsertHooks = []ArticleRevisionHook{}
+
+	AddArticleRevisionHook(boil.AfterSelectHook, articleRevisionAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	articleRevisionAfterSelectHooks = []ArticleRevisionHook{}
+
+	AddArticleRevisionHook(boil.BeforeUpdateHook, articleRevisionBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	articleRevisionBeforeUpdateHooks = []ArticleRevisionHook{}
+
+	AddArticleRevisionHook(boil.AfterUpdateHook, articleRevisionAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	articleRevisionAfterUpdateHooks = []ArticleRevisionHook{}
+
+	AddArticleRevisionHook(boil.BeforeDeleteHook, articleRevisionBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	articleRevisionBeforeDeleteHooks = []ArticleRevisionHook{}
+
+	AddArticleRevisionHook(boil.AfterDeleteHook, articleRevisionAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	articleRevisionAfterDeleteHooks = []ArticleRevisionHook{}
+
+	AddArticleRevisionHook(boil.BeforeUpsertHook, articleRevisionBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	articleRevisionBeforeUpsertHooks = []ArticleRevisionHook{}
+
+	AddArticleRevisionHook(boil.AfterUpsertHook, articleRevisionAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	articleRevisionAfterUpsertHooks = []ArticleRevisionHook{}
+}
+
+func testArticleRevisionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleRevision{}
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, true, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ArticleRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testArticleRevisionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleRevision{}
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(articleRevisionColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ArticleRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testArticleRevisionToOneArticleUsingArticle(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ArticleRevision
+	var foreign Article
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, articleRevisionDBTypes, false, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, articleDBTypes, false, articleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Article struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ArticleID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Article().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ArticleRevisionSlice{&local}
+	if err = local.L.LoadArticle(ctx, tx, false, (*[]*ArticleRevision)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Article == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Article = nil
+	if err = local.L.LoadArticle(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Article == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testArticleRevisionToOneSetOpArticleUsingArticle(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ArticleRevision
+	var b, c Article
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, articleRevisionDBTypes, false, strmangle.SetComplement(articleRevisionPrimaryKeyColumns, articleRevisionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, articleDBTypes, false, strmangle.SetComplement(articlePrimaryKeyColumns, articleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, articleDBTypes, false, strmangle.SetComplement(articlePrimaryKeyColumns, articleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Article{&b, &c} {
+		err = a.SetArticle(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Article != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ArticleRevisions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ArticleID != x.ID {
+			t.Error("foreign key was wrong value", a.ArticleID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ArticleID))
+		reflect.Indirect(reflect.ValueOf(&a.ArticleID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ArticleID != x.ID {
+			t.Error("foreign key was wrong value", a.ArticleID, x.ID)
+		}
+	}
+}
+
+func testArticleRevisionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleRevision{}
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, true, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testArticleRevisionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleRevision{}
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, true, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ArticleRevisionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testArticleRevisionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleRevision{}
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, true, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ArticleRevisions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	articleRevisionDBTypes = map[string]string{`ID`: `integer`, `CreatedAt`: `timestamp with time zone`, `ArticleID`: `integer`, `Subject`: `text`, `Snapshot`: `jsonb`}
+	_                      = bytes.MinRead
+)
+
+func testArticleRevisionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(articleRevisionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(articleRevisionAllColumns) == len(articleRevisionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleRevision{}
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, true, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ArticleRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, true, articleRevisionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testArticleRevisionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(articleRevisionAllColumns) == len(articleRevisionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ArticleRevision{}
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, true, articleRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ArticleRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, articleRevisionDBTypes, true, articleRevisionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(articleRevisionAllColumns, articleRevisionPrimaryKeyColumns) {
+		fields = articleRevisionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			articleRevisionAllColumns,
+			articleRevisionPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ArticleRevisionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testArticleRevisionsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(articleRevisionAllColumns) == len(articleRevisionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ArticleRevision{}
+	if err = randomize.Struct(seed, &o, articleRevisionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ArticleRevision: %s", err)
+	}
+
+	count, err := ArticleRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, articleRevisionDBTypes, false, articleRevisionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ArticleRevision struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ArticleRevision: %s", err)
+	}
+
+	count, err = ArticleRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
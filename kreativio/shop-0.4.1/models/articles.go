@@ -25,88 +25,65 @@ import (
 
 // Article is an object representing the database table.
 type Article struct {
-	ID          int           `boil:"id" json:"id" toml:"id" yaml:"id"`
-	CreatedAt   time.Time     `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt   time.Time     `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	Published   bool          `boil:"published" json:"published" toml:"published" yaml:"published"`
-	Title       string        `boil:"title" json:"title" toml:"title" yaml:"title"`
-	Description string        `boil:"description" json:"description" toml:"description" yaml:"description"`
-	Price       types.Decimal `boil:"price" json:"price" toml:"price" yaml:"price"`
-	Promoted    bool          `boil:"promoted" json:"promoted" toml:"promoted" yaml:"promoted"`
-	SearchIndex null.String   `boil:"search_index" json:"search_index,omitempty" toml:"search_index" yaml:"search_index,omitempty"`
+	ID             int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt      time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Published      bool              `boil:"published" json:"published" toml:"published" yaml:"published"`
+	Title          string            `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Description    string            `boil:"description" json:"description" toml:"description" yaml:"description"`
+	Price          types.Decimal     `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Promoted       bool              `boil:"promoted" json:"promoted" toml:"promoted" yaml:"promoted"`
+	SearchIndex    null.String       `boil:"search_index" json:"search_index,omitempty" toml:"search_index" yaml:"search_index,omitempty"`
+	PublishAt      null.Time         `boil:"publish_at" json:"publish_at,omitempty" toml:"publish_at" yaml:"publish_at,omitempty"`
+	UnpublishAt    null.Time         `boil:"unpublish_at" json:"unpublish_at,omitempty" toml:"unpublish_at" yaml:"unpublish_at,omitempty"`
+	SalePrice      types.NullDecimal `boil:"sale_price" json:"sale_price,omitempty" toml:"sale_price" yaml:"sale_price,omitempty"`
+	SaleStart      null.Time         `boil:"sale_start" json:"sale_start,omitempty" toml:"sale_start" yaml:"sale_start,omitempty"`
+	SaleEnd        null.Time         `boil:"sale_end" json:"sale_end,omitempty" toml:"sale_end" yaml:"sale_end,omitempty"`
+	LiveNotifiedAt null.Time         `boil:"live_notified_at" json:"live_notified_at,omitempty" toml:"live_notified_at" yaml:"live_notified_at,omitempty"`
+	DeletedAt      null.Time         `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
 
 	R *articleR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L articleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ArticleColumns = struct {
-	ID          string
-	CreatedAt   string
-	UpdatedAt   string
-	Published   string
-	Title       string
-	Description string
-	Price       string
-	Promoted    string
-	SearchIndex string
+	ID             string
+	CreatedAt      string
+	UpdatedAt      string
+	Published      string
+	Title          string
+	Description    string
+	Price          string
+	Promoted       string
+	SearchIndex    string
+	PublishAt      string
+	UnpublishAt    string
+	SalePrice      string
+	SaleStart      string
+	SaleEnd        string
+	LiveNotifiedAt string
+	DeletedAt      string
 }{
-	ID:          "id",
-	CreatedAt:   "created_at",
-	UpdatedAt:   "updated_at",
-	Published:   "published",
-	Title:       "title",
-	Description: "description",
-	Price:       "price",
-	Promoted:    "promoted",
-	SearchIndex: "search_index",
+	ID:             "id",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+	Published:      "published",
+	Title:          "title",
+	Description:    "description",
+	Price:          "price",
+	Promoted:       "promoted",
+	SearchIndex:    "search_index",
+	PublishAt:      "publish_at",
+	UnpublishAt:    "unpublish_at",
+	SalePrice:      "sale_price",
+	SaleStart:      "sale_start",
+	SaleEnd:        "sale_end",
+	LiveNotifiedAt: "live_notified_at",
+	DeletedAt:      "deleted_at",
 }
 
 // Generated where
 
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 type whereHelperbool struct{ field string }
 
 func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
@@ -116,29 +93,6 @@ func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field
 func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
 func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelpertypes_Decimal struct{ field string }
 
 func (w whereHelpertypes_Decimal) EQ(x types.Decimal) qm.QueryMod {
@@ -183,50 +137,96 @@ func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
 var ArticleWhere = struct {
-	ID          whereHelperint
-	CreatedAt   whereHelpertime_Time
-	UpdatedAt   whereHelpertime_Time
-	Published   whereHelperbool
-	Title       whereHelperstring
-	Description whereHelperstring
-	Price       whereHelpertypes_Decimal
-	Promoted    whereHelperbool
-	SearchIndex whereHelpernull_String
+	ID             whereHelperint
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+	Published      whereHelperbool
+	Title          whereHelperstring
+	Description    whereHelperstring
+	Price          whereHelpertypes_Decimal
+	Promoted       whereHelperbool
+	SearchIndex    whereHelpernull_String
+	PublishAt      whereHelpernull_Time
+	UnpublishAt    whereHelpernull_Time
+	SalePrice      whereHelpertypes_NullDecimal
+	SaleStart      whereHelpernull_Time
+	SaleEnd        whereHelpernull_Time
+	LiveNotifiedAt whereHelpernull_Time
+	DeletedAt      whereHelpernull_Time
 }{
-	ID:          whereHelperint{field: "\"shop\".\"articles\".\"id\""},
-	CreatedAt:   whereHelpertime_Time{field: "\"shop\".\"articles\".\"created_at\""},
-	UpdatedAt:   whereHelpertime_Time{field: "\"shop\".\"articles\".\"updated_at\""},
-	Published:   whereHelperbool{field: "\"shop\".\"articles\".\"published\""},
-	Title:       whereHelperstring{field: "\"shop\".\"articles\".\"title\""},
-	Description: whereHelperstring{field: "\"shop\".\"articles\".\"description\""},
-	Price:       whereHelpertypes_Decimal{field: "\"shop\".\"articles\".\"price\""},
-	Promoted:    whereHelperbool{field: "\"shop\".\"articles\".\"promoted\""},
-	SearchIndex: whereHelpernull_String{field: "\"shop\".\"articles\".\"search_index\""},
+	ID:             whereHelperint{field: "\"shop\".\"articles\".\"id\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"shop\".\"articles\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"shop\".\"articles\".\"updated_at\""},
+	Published:      whereHelperbool{field: "\"shop\".\"articles\".\"published\""},
+	Title:          whereHelperstring{field: "\"shop\".\"articles\".\"title\""},
+	Description:    whereHelperstring{field: "\"shop\".\"articles\".\"description\""},
+	Price:          whereHelpertypes_Decimal{field: "\"shop\".\"articles\".\"price\""},
+	Promoted:       whereHelperbool{field: "\"shop\".\"articles\".\"promoted\""},
+	SearchIndex:    whereHelpernull_String{field: "\"shop\".\"articles\".\"search_index\""},
+	PublishAt:      whereHelpernull_Time{field: "\"shop\".\"articles\".\"publish_at\""},
+	UnpublishAt:    whereHelpernull_Time{field: "\"shop\".\"articles\".\"unpublish_at\""},
+	SalePrice:      whereHelpertypes_NullDecimal{field: "\"shop\".\"articles\".\"sale_price\""},
+	SaleStart:      whereHelpernull_Time{field: "\"shop\".\"articles\".\"sale_start\""},
+	SaleEnd:        whereHelpernull_Time{field: "\"shop\".\"articles\".\"sale_end\""},
+	LiveNotifiedAt: whereHelpernull_Time{field: "\"shop\".\"articles\".\"live_notified_at\""},
+	DeletedAt:      whereHelpernull_Time{field: "\"shop\".\"articles\".\"deleted_at\""},
 }
 
 // ArticleRels is where relationship names are stored.
 var ArticleRels = struct {
-	BasePrices string
-	Categories string
-	Images     string
-	Variants   string
-	Videos     string
+	ArticleAttributes string
+	BasePrices        string
+	ArticleRevisions  string
+	Categories        string
+	Images            string
+	OrderArticles     string
+	Variants          string
+	Videos            string
 }{
-	BasePrices: "BasePrices",
-	Categories: "Categories",
-	Images:     "Images",
-	Variants:   "Variants",
-	Videos:     "Videos",
+	ArticleAttributes: "ArticleAttributes",
+	BasePrices:        "BasePrices",
+	ArticleRevisions:  "ArticleRevisions",
+	Categories:        "Categories",
+	Images:            "Images",
+	OrderArticles:     "OrderArticles",
+	Variants:          "Variants",
+	Videos:            "Videos",
 }
 
 // articleR is where relationships are stored.
 type articleR struct {
-	BasePrices BasePriceSlice `boil:"BasePrices" json:"BasePrices" toml:"BasePrices" yaml:"BasePrices"`
-	Categories CategorySlice  `boil:"Categories" json:"Categories" toml:"Categories" yaml:"Categories"`
-	Images     ImageSlice     `boil:"Images" json:"Images" toml:"Images" yaml:"Images"`
-	Variants   VariantSlice   `boil:"Variants" json:"Variants" toml:"Variants" yaml:"Variants"`
-	Videos     VideoSlice     `boil:"Videos" json:"Videos" toml:"Videos" yaml:"Videos"`
+	ArticleAttributes ArticleAttributeSlice `boil:"ArticleAttributes" json:"ArticleAttributes" toml:"ArticleAttributes" yaml:"ArticleAttributes"`
+	BasePrices        BasePriceSlice        `boil:"BasePrices" json:"BasePrices" toml:"BasePrices" yaml:"BasePrices"`
+	ArticleRevisions  ArticleRevisionSlice  `boil:"ArticleRevisions" json:"ArticleRevisions" toml:"ArticleRevisions" yaml:"ArticleRevisions"`
+	Categories        CategorySlice         `boil:"Categories" json:"Categories" toml:"Categories" yaml:"Categories"`
+	Images            ImageSlice            `boil:"Images" json:"Images" toml:"Images" yaml:"Images"`
+	OrderArticles     OrderArticleSlice     `boil:"OrderArticles" json:"OrderArticles" toml:"OrderArticles" yaml:"OrderArticles"`
+	Variants          VariantSlice          `boil:"Variants" json:"Variants" toml:"Variants" yaml:"Variants"`
+	Videos            VideoSlice            `boil:"Videos" json:"Videos" toml:"Videos" yaml:"Videos"`
 }
 
 // NewStruct creates a new relationship struct
@@ -238,8 +238,8 @@ func (*articleR) NewStruct() *articleR {
 type articleL struct{}
 
 var (
-	articleAllColumns            = []string{"id", "created_at", "updated_at", "published", "title", "description", "price", "promoted", "search_index"}
-	articleColumnsWithoutDefault = []string{"created_at", "updated_at", "title", "description", "price", "search_index"}
+	articleAllColumns            = []string{"id", "created_at", "updated_at", "published", "title", "description", "price", "promoted", "search_index", "publish_at", "unpublish_at", "sale_price", "sale_start", "sale_end", "live_notified_at", "deleted_at"}
+	articleColumnsWithoutDefault = []string{"created_at", "updated_at", "title", "description", "price", "search_index", "publish_at", "unpublish_at", "sale_price", "sale_start", "sale_end", "live_notified_at", "deleted_at"}
 	articleColumnsWithDefault    = []string{"id", "published", "promoted"}
 	articlePrimaryKeyColumns     = []string{"id"}
 )
@@ -519,6 +519,27 @@ func (q articleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bo
 	return count > 0, nil
 }
 
+// ArticleAttributes retrieves all the article_attribute's ArticleAttributes with an executor.
+func (o *Article) ArticleAttributes(mods ...qm.QueryMod) articleAttributeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"shop\".\"article_attributes\".\"article_id\"=?", o.ID),
+	)
+
+	query := ArticleAttributes(queryMods...)
+	queries.SetFrom(query.Query, "\"shop\".\"article_attributes\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"shop\".\"article_attributes\".*"})
+	}
+
+	return query
+}
+
 // BasePrices retrieves all the base_price's BasePrices with an executor.
 func (o *Article) BasePrices(mods ...qm.QueryMod) basePriceQuery {
 	var queryMods []qm.QueryMod
@@ -541,6 +562,27 @@ func (o *Article) BasePrices(mods ...qm.QueryMod) basePriceQuery {
 	return query
 }
 
+// ArticleRevisions retrieves all the article_revision's ArticleRevisions with an executor.
+func (o *Article) ArticleRevisions(mods ...qm.QueryMod) articleRevisionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"shop\".\"article_revisions\".\"article_id\"=?", o.ID),
+	)
+
+	query := ArticleRevisions(queryMods...)
+	queries.SetFrom(query.Query, "\"shop\".\"article_revisions\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"shop\".\"article_revisions\".*"})
+	}
+
+	return query
+}
+
 // Categories retrieves all the category's Categories with an executor.
 func (o *Article) Categories(mods ...qm.QueryMod) categoryQuery {
 	var queryMods []qm.QueryMod
@@ -584,6 +626,27 @@ func (o *Article) Images(mods ...qm.QueryMod) imageQuery {
 	return query
 }
 
+// OrderArticles retrieves all the order_article's OrderArticles with an executor.
+func (o *Article) OrderArticles(mods ...qm.QueryMod) orderArticleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"shop\".\"order_articles\".\"article_id\"=?", o.ID),
+	)
+
+	query := OrderArticles(queryMods...)
+	queries.SetFrom(query.Query, "\"shop\".\"order_articles\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"shop\".\"order_articles\".*"})
+	}
+
+	return query
+}
+
 // Variants retrieves all the variant's Variants with an executor.
 func (o *Article) Variants(mods ...qm.QueryMod) variantQuery {
 	var queryMods []qm.QueryMod
@@ -626,6 +689,104 @@ func (o *Article) Videos(mods ...qm.QueryMod) videoQuery {
 	return query
 }
 
+// LoadArticleAttributes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (articleL) LoadArticleAttributes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
+	var slice []*Article
+	var object *Article
+
+	if singular {
+		object = maybeArticle.(*Article)
+	} else {
+		slice = *maybeArticle.(*[]*Article)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`shop.article_attributes`),
+		qm.WhereIn(`shop.article_attributes.article_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load article_attributes")
+	}
+
+	var resultSlice []*ArticleAttribute
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice article_attributes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on article_attributes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for article_attributes")
+	}
+
+	if len(articleAttributeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ArticleAttributes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &articleAttributeR{}
+			}
+			foreign.R.Article = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ArticleID {
+				local.R.ArticleAttributes = append(local.R.ArticleAttributes, foreign)
+				if foreign.R == nil {
+					foreign.R = &articleAttributeR{}
+				}
+				foreign.R.Article = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadBasePrices allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (articleL) LoadBasePrices(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
@@ -741,6 +902,104 @@ func (articleL) LoadBasePrices(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
+// LoadArticleRevisions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (articleL) LoadArticleRevisions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
+	var slice []*Article
+	var object *Article
+
+	if singular {
+		object = maybeArticle.(*Article)
+	} else {
+		slice = *maybeArticle.(*[]*Article)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`shop.article_revisions`),
+		qm.WhereIn(`shop.article_revisions.article_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load article_revisions")
+	}
+
+	var resultSlice []*ArticleRevision
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice article_revisions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on article_revisions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for article_revisions")
+	}
+
+	if len(articleRevisionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ArticleRevisions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &articleRevisionR{}
+			}
+			foreign.R.Article = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ArticleID {
+				local.R.ArticleRevisions = append(local.R.ArticleRevisions, foreign)
+				if foreign.R == nil {
+					foreign.R = &articleRevisionR{}
+				}
+				foreign.R.Article = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadCategories allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (articleL) LoadCategories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
@@ -954,6 +1213,104 @@ func (articleL) LoadImages(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
+// LoadOrderArticles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (articleL) LoadOrderArticles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
+	var slice []*Article
+	var object *Article
+
+	if singular {
+		object = maybeArticle.(*Article)
+	} else {
+		slice = *maybeArticle.(*[]*Article)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`shop.order_articles`),
+		qm.WhereIn(`shop.order_articles.article_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load order_articles")
+	}
+
+	var resultSlice []*OrderArticle
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice order_articles")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on order_articles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for order_articles")
+	}
+
+	if len(orderArticleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OrderArticles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &orderArticleR{}
+			}
+			foreign.R.Article = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ArticleID {
+				local.R.OrderArticles = append(local.R.OrderArticles, foreign)
+				if foreign.R == nil {
+					foreign.R = &orderArticleR{}
+				}
+				foreign.R.Article = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadVariants allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (articleL) LoadVariants(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
@@ -1150,6 +1507,59 @@ func (articleL) LoadVideos(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
+// AddArticleAttributes adds the given related objects to the existing relationships
+// of the article, optionally inserting them as new records.
+// Appends related to o.R.ArticleAttributes.
+// Sets related.R.Article appropriately.
+func (o *Article) AddArticleAttributes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArticleAttribute) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ArticleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"shop\".\"article_attributes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"article_id"}),
+				strmangle.WhereClause("\"", "\"", 2, articleAttributePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ArticleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &articleR{
+			ArticleAttributes: related,
+		}
+	} else {
+		o.R.ArticleAttributes = append(o.R.ArticleAttributes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &articleAttributeR{
+				Article: o,
+			}
+		} else {
+			rel.R.Article = o
+		}
+	}
+	return nil
+}
+
 // AddBasePrices adds the given related objects to the existing relationships
 // of the article, optionally inserting them as new records.
 // Appends related to o.R.BasePrices.
@@ -1290,6 +1700,59 @@ func removeBasePricesFromArticlesSlice(o *Article, related []*BasePrice) {
 	}
 }
 
+// AddArticleRevisions adds the given related objects to the existing relationships
+// of the article, optionally inserting them as new records.
+// Appends related to o.R.ArticleRevisions.
+// Sets related.R.Article appropriately.
+func (o *Article) AddArticleRevisions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArticleRevision) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ArticleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"shop\".\"article_revisions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"article_id"}),
+				strmangle.WhereClause("\"", "\"", 2, articleRevisionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ArticleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &articleR{
+			ArticleRevisions: related,
+		}
+	} else {
+		o.R.ArticleRevisions = append(o.R.ArticleRevisions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &articleRevisionR{
+				Article: o,
+			}
+		} else {
+			rel.R.Article = o
+		}
+	}
+	return nil
+}
+
 // AddCategories adds the given related objects to the existing relationships
 // of the article, optionally inserting them as new records.
 // Appends related to o.R.Categories.
@@ -1483,6 +1946,59 @@ func (o *Article) AddImages(ctx context.Context, exec boil.ContextExecutor, inse
 	return nil
 }
 
+// AddOrderArticles adds the given related objects to the existing relationships
+// of the article, optionally inserting them as new records.
+// Appends related to o.R.OrderArticles.
+// Sets related.R.Article appropriately.
+func (o *Article) AddOrderArticles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrderArticle) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ArticleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"shop\".\"order_articles\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"article_id"}),
+				strmangle.WhereClause("\"", "\"", 2, orderArticlePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ArticleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &articleR{
+			OrderArticles: related,
+		}
+	} else {
+		o.R.OrderArticles = append(o.R.OrderArticles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &orderArticleR{
+				Article: o,
+			}
+		} else {
+			rel.R.Article = o
+		}
+	}
+	return nil
+}
+
 // AddVariants adds the given related objects to the existing relationships
 // of the article, optionally inserting them as new records.
 // Appends related to o.R.Variants.
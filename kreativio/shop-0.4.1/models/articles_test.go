@@ -494,6 +494,84 @@ func testArticlesInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testArticleToManyArticleAttributes(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Article
+	var b, c ArticleAttribute
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, articleDBTypes, true, articleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Article struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, articleAttributeDBTypes, false, articleAttributeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, articleAttributeDBTypes, false, articleAttributeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ArticleID = a.ID
+	c.ArticleID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ArticleAttributes().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ArticleID == b.ArticleID {
+			bFound = true
+		}
+		if v.ArticleID == c.ArticleID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ArticleSlice{&a}
+	if err = a.L.LoadArticleAttributes(ctx, tx, false, (*[]*Article)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ArticleAttributes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ArticleAttributes = nil
+	if err = a.L.LoadArticleAttributes(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ArticleAttributes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testArticleToManyBasePrices(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -578,6 +656,84 @@ func testArticleToManyBasePrices(t *testing.T) {
 	}
 }
 
+func testArticleToManyArticleRevisions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Article
+	var b, c ArticleRevision
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, articleDBTypes, true, articleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Article struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, articleRevisionDBTypes, false, articleRevisionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, articleRevisionDBTypes, false, articleRevisionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ArticleID = a.ID
+	c.ArticleID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ArticleRevisions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ArticleID == b.ArticleID {
+			bFound = true
+		}
+		if v.ArticleID == c.ArticleID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ArticleSlice{&a}
+	if err = a.L.LoadArticleRevisions(ctx, tx, false, (*[]*Article)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ArticleRevisions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ArticleRevisions = nil
+	if err = a.L.LoadArticleRevisions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ArticleRevisions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testArticleToManyCategories(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -740,6 +896,84 @@ func testArticleToManyImages(t *testing.T) {
 	}
 }
 
+func testArticleToManyOrderArticles(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Article
+	var b, c OrderArticle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, articleDBTypes, true, articleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Article struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, orderArticleDBTypes, false, orderArticleColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, orderArticleDBTypes, false, orderArticleColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ArticleID = a.ID
+	c.ArticleID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.OrderArticles().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ArticleID == b.ArticleID {
+			bFound = true
+		}
+		if v.ArticleID == c.ArticleID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ArticleSlice{&a}
+	if err = a.L.LoadOrderArticles(ctx, tx, false, (*[]*Article)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OrderArticles); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.OrderArticles = nil
+	if err = a.L.LoadOrderArticles(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OrderArticles); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testArticleToManyVariants(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -896,6 +1130,81 @@ func testArticleToManyVideos(t *testing.T) {
 	}
 }
 
+func testArticleToManyAddOpArticleAttributes(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Article
+	var b, c, d, e ArticleAttribute
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, articleDBTypes, false, strmangle.SetComplement(articlePrimaryKeyColumns, articleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ArticleAttribute{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, articleAttributeDBTypes, false, strmangle.SetComplement(articleAttributePrimaryKeyColumns, articleAttributeColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ArticleAttribute{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddArticleAttributes(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ArticleID {
+			t.Error("foreign key was wrong value", a.ID, first.ArticleID)
+		}
+		if a.ID != second.ArticleID {
+			t.Error("foreign key was wrong value", a.ID, second.ArticleID)
+		}
+
+		if first.R.Article != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Article != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ArticleAttributes[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ArticleAttributes[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ArticleAttributes().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testArticleToManyAddOpBasePrices(t *testing.T) {
 	var err error
 
@@ -1124,6 +1433,81 @@ func testArticleToManyRemoveOpBasePrices(t *testing.T) {
 	}
 }
 
+func testArticleToManyAddOpArticleRevisions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Article
+	var b, c, d, e ArticleRevision
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, articleDBTypes, false, strmangle.SetComplement(articlePrimaryKeyColumns, articleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ArticleRevision{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, articleRevisionDBTypes, false, strmangle.SetComplement(articleRevisionPrimaryKeyColumns, articleRevisionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ArticleRevision{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddArticleRevisions(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ArticleID {
+			t.Error("foreign key was wrong value", a.ID, first.ArticleID)
+		}
+		if a.ID != second.ArticleID {
+			t.Error("foreign key was wrong value", a.ID, second.ArticleID)
+		}
+
+		if first.R.Article != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Article != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ArticleRevisions[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ArticleRevisions[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ArticleRevisions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testArticleToManyAddOpCategories(t *testing.T) {
 	var err error
 
@@ -1427,6 +1811,81 @@ func testArticleToManyAddOpImages(t *testing.T) {
 		}
 	}
 }
+func testArticleToManyAddOpOrderArticles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Article
+	var b, c, d, e OrderArticle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, articleDBTypes, false, strmangle.SetComplement(articlePrimaryKeyColumns, articleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*OrderArticle{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, orderArticleDBTypes, false, strmangle.SetComplement(orderArticlePrimaryKeyColumns, orderArticleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*OrderArticle{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddOrderArticles(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ArticleID {
+			t.Error("foreign key was wrong value", a.ID, first.ArticleID)
+		}
+		if a.ID != second.ArticleID {
+			t.Error("foreign key was wrong value", a.ID, second.ArticleID)
+		}
+
+		if first.R.Article != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Article != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.OrderArticles[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.OrderArticles[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.OrderArticles().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testArticleToManyAddOpVariants(t *testing.T) {
 	var err error
 
@@ -1652,7 +2111,7 @@ func testArticlesSelect(t *testing.T) {
 }
 
 var (
-	articleDBTypes = map[string]string{`ID`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `Published`: `boolean`, `Title`: `text`, `Description`: `text`, `Price`: `numeric`, `Promoted`: `boolean`, `SearchIndex`: `tsvector`}
+	articleDBTypes = map[string]string{`ID`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `Published`: `boolean`, `Title`: `text`, `Description`: `text`, `Price`: `numeric`, `Promoted`: `boolean`, `SearchIndex`: `tsvector`, `PublishAt`: `timestamp with time zone`, `UnpublishAt`: `timestamp with time zone`, `SalePrice`: `numeric`, `SaleStart`: `timestamp with time zone`, `SaleEnd`: `timestamp with time zone`, `LiveNotifiedAt`: `timestamp with time zone`, `DeletedAt`: `timestamp with time zone`}
 	_              = bytes.MinRead
 )
 
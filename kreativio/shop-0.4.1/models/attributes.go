@@ -0,0 +1,1400 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// Attribute is an object representing the database table.
+type Attribute struct {
+	ID        int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Label     string            `boil:"label" json:"label" toml:"label" yaml:"label"`
+	Type      string            `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Unit      string            `boil:"unit" json:"unit" toml:"unit" yaml:"unit"`
+	Options   types.StringArray `boil:"options" json:"options" toml:"options" yaml:"options"`
+	Position  int               `boil:"position" json:"position" toml:"position" yaml:"position"`
+
+	R *attributeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L attributeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AttributeColumns = struct {
+	ID        string
+	CreatedAt string
+	UpdatedAt string
+	Label     string
+	Type      string
+	Unit      string
+	Options   string
+	Position  string
+}{
+	ID:        "id",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+	Label:     "label",
+	Type:      "type",
+	Unit:      "unit",
+	Options:   "options",
+	Position:  "position",
+}
+
+// Generated where
+
+type whereHelpertypes_StringArray struct{ field string }
+
+func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var AttributeWhere = struct {
+	ID        whereHelperint
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+	Label     whereHelperstring
+	Type      whereHelperstring
+	Unit      whereHelperstring
+	Options   whereHelpertypes_StringArray
+	Position  whereHelperint
+}{
+	ID:        whereHelperint{field: "\"shop\".\"attributes\".\"id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"shop\".\"attributes\".\"created_at\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"shop\".\"attributes\".\"updated_at\""},
+	Label:     whereHelperstring{field: "\"shop\".\"attributes\".\"label\""},
+	Type:      whereHelperstring{field: "\"shop\".\"attributes\".\"type\""},
+	Unit:      whereHelperstring{field: "\"shop\".\"attributes\".\"unit\""},
+	Options:   whereHelpertypes_StringArray{field: "\"shop\".\"attributes\".\"options\""},
+	Position:  whereHelperint{field: "\"shop\".\"attributes\".\"position\""},
+}
+
+// AttributeRels is where relationship names are stored.
+var AttributeRels = struct {
+	ArticleAttributes string
+	Categories        string
+}{
+	ArticleAttributes: "ArticleAttributes",
+	Categories:        "Categories",
+}
+
+// attributeR is where relationships are stored.
+type attributeR struct {
+	ArticleAttributes ArticleAttributeSlice `boil:"ArticleAttributes" json:"ArticleAttributes" toml:"ArticleAttributes" yaml:"ArticleAttributes"`
+	Categories        CategorySlice         `boil:"Categories" json:"Categories" toml:"Categories" yaml:"Categories"`
+}
+
+// NewStruct creates a new relationship struct
+func (*attributeR) NewStruct() *attributeR {
+	return &attributeR{}
+}
+
+// attributeL is where Load methods for each relationship are stored.
+type attributeL struct{}
+
+var (
+	attributeAllColumns            = []string{"id", "created_at", "updated_at", "label", "type", "unit", "options", "position"}
+	attributeColumnsWithoutDefault = []string{"created_at", "updated_at", "label", "type", "position"}
+	attributeColumnsWithDefault    = []string{"id", "unit", "options"}
+	attributePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// AttributeSlice is an alias for a slice of pointers to Attribute.
+	// This should generally be used opposed to []Attribute.
+	AttributeSlice []*Attribute
+	// AttributeHook is the signature for custom Attribute hook methods
+	AttributeHook func(context.Context, boil.ContextExecutor, *Attribute) error
+
+	attributeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	attributeType                 = reflect.TypeOf(&Attribute{})
+	attributeMapping              = queries.MakeStructMapping(attributeType)
+	attributePrimaryKeyMapping, _ = queries.BindMapping(attributeType, attributeMapping, attributePrimaryKeyColumns)
+	attributeInsertCacheMut       sync.RWMutex
+	attributeInsertCache          = make(map[string]insertCache)
+	attributeUpdateCacheMut       sync.RWMutex
+	attributeUpdateCache          = make(map[string]updateCache)
+	attributeUpsertCacheMut       sync.RWMutex
+	attributeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var attributeBeforeInsertHooks []AttributeHook
+var attributeBeforeUpdateHooks []AttributeHook
+var attributeBeforeDeleteHooks []AttributeHook
+var attributeBeforeUpsertHooks []AttributeHook
+
+var attributeAfterInsertHooks []AttributeHook
+var attributeAfterSelectHooks []AttributeHook
+var attributeAfterUpdateHooks []AttributeHook
+var attributeAfterDeleteHooks []AttributeHook
+var attributeAfterUpsertHooks []AttributeHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Attribute) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Attribute) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Attribute) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Attribute) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Attribute) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Attribute) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Attribute) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Attribute) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Attribute) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAttributeHook registers your hook function for all future operations.
+func AddAttributeHook(hookPoint boil.HookPoint, attributeHook AttributeHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		attributeBeforeInsertHooks = append(attributeBeforeInsertHooks, attributeHook)
+	case boil.BeforeUpdateHook:
+		attributeBeforeUpdateHooks = append(attributeBeforeUpdateHooks, attributeHook)
+	case boil.BeforeDeleteHook:
+		attributeBeforeDeleteHooks = append(attributeBeforeDeleteHooks, attributeHook)
+	case boil.BeforeUpsertHook:
+		attributeBeforeUpsertHooks = append(attributeBeforeUpsertHooks, attributeHook)
+	case boil.AfterInsertHook:
+		attributeAfterInsertHooks = append(attributeAfterInsertHooks, attributeHook)
+	case boil.AfterSelectHook:
+		attributeAfterSelectHooks = append(attributeAfterSelectHooks, attributeHook)
+	case boil.AfterUpdateHook:
+		attributeAfterUpdateHooks = append(attributeAfterUpdateHooks, attributeHook)
+	case boil.AfterDeleteHook:
+		attributeAfterDeleteHooks = append(attributeAfterDeleteHooks, attributeHook)
+	case boil.AfterUpsertHook:
+		attributeAfterUpsertHooks = append(attributeAfterUpsertHooks, attributeHook)
+	}
+}
+
+// One returns a single attribute record from the query.
+func (q attributeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Attribute, error) {
+	o := &Attribute{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for attributes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Attribute records from the query.
+func (q attributeQuery) All(ctx context.Context, exec boil.ContextExecutor) (AttributeSlice, error) {
+	var o []*Attribute
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Attribute slice")
+	}
+
+	if len(attributeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Attribute records in the query.
+func (q attributeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count attributes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q attributeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if attributes exists")
+	}
+
+	return count > 0, nil
+}
+
+// ArticleAttributes retrieves all the article_attribute's ArticleAttributes with an executor.
+func (o *Attribute) ArticleAttributes(mods ...qm.QueryMod) articleAttributeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"shop\".\"article_attributes\".\"attribute_id\"=?", o.ID),
+	)
+
+	query := ArticleAttributes(queryMods...)
+	queries.SetFrom(query.Query, "\"shop\".\"article_attributes\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"shop\".\"article_attributes\".*"})
+	}
+
+	return query
+}
+
+// Categories retrieves all the category's Categories with an executor.
+func (o *Attribute) Categories(mods ...qm.QueryMod) categoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"shop\".\"category_attributes\" on \"shop\".\"categories\".\"id\" = \"shop\".\"category_attributes\".\"category_id\""),
+		qm.Where("\"shop\".\"category_attributes\".\"attribute_id\"=?", o.ID),
+	)
+
+	query := Categories(queryMods...)
+	queries.SetFrom(query.Query, "\"shop\".\"categories\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"shop\".\"categories\".*"})
+	}
+
+	return query
+}
+
+// LoadArticleAttributes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (attributeL) LoadArticleAttributes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAttribute interface{}, mods queries.Applicator) error {
+	var slice []*Attribute
+	var object *Attribute
+
+	if singular {
+		object = maybeAttribute.(*Attribute)
+	} else {
+		slice = *maybeAttribute.(*[]*Attribute)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &attributeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &attributeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`shop.article_attributes`),
+		qm.WhereIn(`shop.article_attributes.attribute_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load article_attributes")
+	}
+
+	var resultSlice []*ArticleAttribute
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice article_attributes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on article_attributes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for article_attributes")
+	}
+
+	if len(articleAttributeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ArticleAttributes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &articleAttributeR{}
+			}
+			foreign.R.Attribute = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AttributeID {
+				local.R.ArticleAttributes = append(local.R.ArticleAttributes, foreign)
+				if foreign.R == nil {
+					foreign.R = &articleAttributeR{}
+				}
+				foreign.R.Attribute = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCategories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (attributeL) LoadCategories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAttribute interface{}, mods queries.Applicator) error {
+	var slice []*Attribute
+	var object *Attribute
+
+	if singular {
+		object = maybeAttribute.(*Attribute)
+	} else {
+		slice = *maybeAttribute.(*[]*Attribute)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &attributeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &attributeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("\"shop\".\"categories\".*, \"a\".\"attribute_id\""),
+		qm.From("\"shop\".\"categories\""),
+		qm.InnerJoin("\"shop\".\"category_attributes\" as \"a\" on \"shop\".\"categories\".\"id\" = \"a\".\"category_id\""),
+		qm.WhereIn("\"a\".\"attribute_id\" in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load categories")
+	}
+
+	var resultSlice []*Category
+
+	var localJoinCols []int
+	for results.Next() {
+		one := new(Category)
+		var localJoinCol int
+
+		err = results.Scan(&one.ID, &one.CreatedAt, &one.UpdatedAt, &one.Label, &one.Position, &one.SearchIndex, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for categories")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice categories")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on categories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for categories")
+	}
+
+	if len(categoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Categories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &categoryR{}
+			}
+			foreign.R.Attributes = append(foreign.R.Attributes, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Categories = append(local.R.Categories, foreign)
+				if foreign.R == nil {
+					foreign.R = &categoryR{}
+				}
+				foreign.R.Attributes = append(foreign.R.Attributes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddArticleAttributes adds the given related objects to the existing relationships
+// of the attribute, optionally inserting them as new records.
+// Appends related to o.R.ArticleAttributes.
+// Sets related.R.Attribute appropriately.
+func (o *Attribute) AddArticleAttributes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArticleAttribute) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AttributeID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"shop\".\"article_attributes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"attribute_id"}),
+				strmangle.WhereClause("\"", "\"", 2, articleAttributePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AttributeID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &attributeR{
+			ArticleAttributes: related,
+		}
+	} else {
+		o.R.ArticleAttributes = append(o.R.ArticleAttributes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &articleAttributeR{
+				Attribute: o,
+			}
+		} else {
+			rel.R.Attribute = o
+		}
+	}
+	return nil
+}
+
+// AddCategories adds the given related objects to the existing relationships
+// of the attribute, optionally inserting them as new records.
+// Appends related to o.R.Categories.
+// Sets related.R.Attributes appropriately.
+func (o *Attribute) AddCategories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Category) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"shop\".\"category_attributes\" (\"attribute_id\", \"category_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &attributeR{
+			Categories: related,
+		}
+	} else {
+		o.R.Categories = append(o.R.Categories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &categoryR{
+				Attributes: AttributeSlice{o},
+			}
+		} else {
+			rel.R.Attributes = append(rel.R.Attributes, o)
+		}
+	}
+	return nil
+}
+
+// SetCategories removes all previously related items of the
+// attribute replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Attributes's Categories accordingly.
+// Replaces o.R.Categories with related.
+// Sets related.R.Attributes's Categories accordingly.
+func (o *Attribute) SetCategories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Category) error {
+	query := "delete from \"shop\".\"category_attributes\" where \"attribute_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeCategoriesFromAttributesSlice(o, related)
+	if o.R != nil {
+		o.R.Categories = nil
+	}
+	return o.AddCategories(ctx, exec, insert, related...)
+}
+
+// RemoveCategories relationships from objects passed in.
+// Removes related items from R.Categories (uses pointer comparison, removal does not keep order)
+// Sets related.R.Attributes.
+func (o *Attribute) RemoveCategories(ctx context.Context, exec boil.ContextExecutor, related ...*Category) error {
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"shop\".\"category_attributes\" where \"attribute_id\" = $1 and \"category_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeCategoriesFromAttributesSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Categories {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Categories)
+			if ln > 1 && i < ln-1 {
+				o.R.Categories[i] = o.R.Categories[ln-1]
+			}
+			o.R.Categories = o.R.Categories[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeCategoriesFromAttributesSlice(o *Attribute, related []*Category) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Attributes {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Attributes)
+			if ln > 1 && i < ln-1 {
+				rel.R.Attributes[i] = rel.R.Attributes[ln-1]
+			}
+			rel.R.Attributes = rel.R.Attributes[:ln-1]
+			break
+		}
+	}
+}
+
+// Attributes retrieves all the records using an executor.
+func Attributes(mods ...qm.QueryMod) attributeQuery {
+	mods = append(mods, qm.From("\"shop\".\"attributes\""))
+	return attributeQuery{NewQuery(mods...)}
+}
+
+// FindAttribute retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAttribute(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*Attribute, error) {
+	attributeObj := &Attribute{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"shop\".\"attributes\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, attributeObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from attributes")
+	}
+
+	return attributeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Attribute) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no attributes provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(attributeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	attributeInsertCacheMut.RLock()
+	cache, cached := attributeInsertCache[key]
+	attributeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			attributeAllColumns,
+			attributeColumnsWithDefault,
+			attributeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(attributeType, attributeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(attributeType, attributeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"shop\".\"attributes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"shop\".\"attributes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into attributes")
+	}
+
+	if !cached {
+		attributeInsertCacheMut.Lock()
+		attributeInsertCache[key] = cache
+		attributeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Attribute.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Attribute) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	attributeUpdateCacheMut.RLock()
+	cache, cached := attributeUpdateCache[key]
+	attributeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			attributeAllColumns,
+			attributePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update attributes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"shop\".\"attributes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, attributePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(attributeType, attributeMapping, append(wl, attributePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update attributes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for attributes")
+	}
+
+	if !cached {
+		attributeUpdateCacheMut.Lock()
+		attributeUpdateCache[key] = cache
+		attributeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q attributeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for attributes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for attributes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AttributeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), attributePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"shop\".\"attributes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, attributePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in attribute slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all attribute")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Attribute) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no attributes provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(attributeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	attributeUpsertCacheMut.RLock()
+	cache, cached := attributeUpsertCache[key]
+	attributeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			attributeAllColumns,
+			attributeColumnsWithDefault,
+			attributeColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			attributeAllColumns,
+			attributePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert attributes, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(attributePrimaryKeyColumns))
+			copy(conflict, attributePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"shop\".\"attributes\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(attributeType, attributeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(attributeType, attributeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert attributes")
+	}
+
+	if !cached {
+		attributeUpsertCacheMut.Lock()
+		attributeUpsertCache[key] = cache
+		attributeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Attribute record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Attribute) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Attribute provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), attributePrimaryKeyMapping)
+	sql := "DELETE FROM \"shop\".\"attributes\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from attributes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for attributes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q attributeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no attributeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from attributes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for attributes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AttributeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(attributeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), attributePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"shop\".\"attributes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, attributePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from attribute slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for attributes")
+	}
+
+	if len(attributeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Attribute) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAttribute(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AttributeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AttributeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), attributePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"shop\".\"attributes\".* FROM \"shop\".\"attributes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, attributePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AttributeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AttributeExists checks if the Attribute row exists.
+func AttributeExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"shop\".\"attributes\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if attributes exists")
+	}
+
+	return exists, nil
+}
@@ -449,7 +449,7 @@ func (basePriceL) LoadArticles(ctx context.Context, e boil.ContextExecutor, sing
 		one := new(Article)
 		var localJoinCol int
 
-		err = results.Scan(&one.ID, &one.CreatedAt, &one.UpdatedAt, &one.Published, &one.Title, &one.Description, &one.Price, &one.Promoted, &one.SearchIndex, &localJoinCol)
+		err = results.Scan(&one.ID, &one.CreatedAt, &one.UpdatedAt, &one.Published, &one.Title, &one.Description, &one.Price, &one.Promoted, &one.SearchIndex, &one.PublishAt, &one.UnpublishAt, &one.SalePrice, &one.SaleStart, &one.SaleEnd, &one.LiveNotifiedAt, &one.DeletedAt, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for articles")
 		}
@@ -12,157 +12,266 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributes)
+	t.Run("ArticleRevisions", testArticleRevisions)
 	t.Run("Articles", testArticles)
+	t.Run("Attributes", testAttributes)
 	t.Run("BasePrices", testBasePrices)
 	t.Run("Categories", testCategories)
 	t.Run("Images", testImages)
+	t.Run("Mails", testMails)
+	t.Run("MessageReplies", testMessageReplies)
 	t.Run("Messages", testMessages)
 	t.Run("OrderArticles", testOrderArticles)
 	t.Run("Orders", testOrders)
 	t.Run("PaymentStatuses", testPaymentStatuses)
+	t.Run("SubscriberConsents", testSubscriberConsents)
+	t.Run("Subscribers", testSubscribers)
 	t.Run("Variants", testVariants)
 	t.Run("Videos", testVideos)
+	t.Run("WebhookDeliveries", testWebhookDeliveries)
+	t.Run("Webhooks", testWebhooks)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesDelete)
+	t.Run("ArticleRevisions", testArticleRevisionsDelete)
 	t.Run("Articles", testArticlesDelete)
+	t.Run("Attributes", testAttributesDelete)
 	t.Run("BasePrices", testBasePricesDelete)
 	t.Run("Categories", testCategoriesDelete)
 	t.Run("Images", testImagesDelete)
+	t.Run("Mails", testMailsDelete)
+	t.Run("MessageReplies", testMessageRepliesDelete)
 	t.Run("Messages", testMessagesDelete)
 	t.Run("OrderArticles", testOrderArticlesDelete)
 	t.Run("Orders", testOrdersDelete)
 	t.Run("PaymentStatuses", testPaymentStatusesDelete)
+	t.Run("SubscriberConsents", testSubscriberConsentsDelete)
+	t.Run("Subscribers", testSubscribersDelete)
 	t.Run("Variants", testVariantsDelete)
 	t.Run("Videos", testVideosDelete)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesDelete)
+	t.Run("Webhooks", testWebhooksDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesQueryDeleteAll)
+	t.Run("ArticleRevisions", testArticleRevisionsQueryDeleteAll)
 	t.Run("Articles", testArticlesQueryDeleteAll)
+	t.Run("Attributes", testAttributesQueryDeleteAll)
 	t.Run("BasePrices", testBasePricesQueryDeleteAll)
 	t.Run("Categories", testCategoriesQueryDeleteAll)
 	t.Run("Images", testImagesQueryDeleteAll)
+	t.Run("Mails", testMailsQueryDeleteAll)
+	t.Run("MessageReplies", testMessageRepliesQueryDeleteAll)
 	t.Run("Messages", testMessagesQueryDeleteAll)
 	t.Run("OrderArticles", testOrderArticlesQueryDeleteAll)
 	t.Run("Orders", testOrdersQueryDeleteAll)
 	t.Run("PaymentStatuses", testPaymentStatusesQueryDeleteAll)
+	t.Run("SubscriberConsents", testSubscriberConsentsQueryDeleteAll)
+	t.Run("Subscribers", testSubscribersQueryDeleteAll)
 	t.Run("Variants", testVariantsQueryDeleteAll)
 	t.Run("Videos", testVideosQueryDeleteAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesQueryDeleteAll)
+	t.Run("Webhooks", testWebhooksQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesSliceDeleteAll)
+	t.Run("ArticleRevisions", testArticleRevisionsSliceDeleteAll)
 	t.Run("Articles", testArticlesSliceDeleteAll)
+	t.Run("Attributes", testAttributesSliceDeleteAll)
 	t.Run("BasePrices", testBasePricesSliceDeleteAll)
 	t.Run("Categories", testCategoriesSliceDeleteAll)
 	t.Run("Images", testImagesSliceDeleteAll)
+	t.Run("Mails", testMailsSliceDeleteAll)
+	t.Run("MessageReplies", testMessageRepliesSliceDeleteAll)
 	t.Run("Messages", testMessagesSliceDeleteAll)
 	t.Run("OrderArticles", testOrderArticlesSliceDeleteAll)
 	t.Run("Orders", testOrdersSliceDeleteAll)
 	t.Run("PaymentStatuses", testPaymentStatusesSliceDeleteAll)
+	t.Run("SubscriberConsents", testSubscriberConsentsSliceDeleteAll)
+	t.Run("Subscribers", testSubscribersSliceDeleteAll)
 	t.Run("Variants", testVariantsSliceDeleteAll)
 	t.Run("Videos", testVideosSliceDeleteAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSliceDeleteAll)
+	t.Run("Webhooks", testWebhooksSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesExists)
+	t.Run("ArticleRevisions", testArticleRevisionsExists)
 	t.Run("Articles", testArticlesExists)
+	t.Run("Attributes", testAttributesExists)
 	t.Run("BasePrices", testBasePricesExists)
 	t.Run("Categories", testCategoriesExists)
 	t.Run("Images", testImagesExists)
+	t.Run("Mails", testMailsExists)
+	t.Run("MessageReplies", testMessageRepliesExists)
 	t.Run("Messages", testMessagesExists)
 	t.Run("OrderArticles", testOrderArticlesExists)
 	t.Run("Orders", testOrdersExists)
 	t.Run("PaymentStatuses", testPaymentStatusesExists)
+	t.Run("SubscriberConsents", testSubscriberConsentsExists)
+	t.Run("Subscribers", testSubscribersExists)
 	t.Run("Variants", testVariantsExists)
 	t.Run("Videos", testVideosExists)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesExists)
+	t.Run("Webhooks", testWebhooksExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesFind)
+	t.Run("ArticleRevisions", testArticleRevisionsFind)
 	t.Run("Articles", testArticlesFind)
+	t.Run("Attributes", testAttributesFind)
 	t.Run("BasePrices", testBasePricesFind)
 	t.Run("Categories", testCategoriesFind)
 	t.Run("Images", testImagesFind)
+	t.Run("Mails", testMailsFind)
+	t.Run("MessageReplies", testMessageRepliesFind)
 	t.Run("Messages", testMessagesFind)
 	t.Run("OrderArticles", testOrderArticlesFind)
 	t.Run("Orders", testOrdersFind)
 	t.Run("PaymentStatuses", testPaymentStatusesFind)
+	t.Run("SubscriberConsents", testSubscriberConsentsFind)
+	t.Run("Subscribers", testSubscribersFind)
 	t.Run("Variants", testVariantsFind)
 	t.Run("Videos", testVideosFind)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesFind)
+	t.Run("Webhooks", testWebhooksFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesBind)
+	t.Run("ArticleRevisions", testArticleRevisionsBind)
 	t.Run("Articles", testArticlesBind)
+	t.Run("Attributes", testAttributesBind)
 	t.Run("BasePrices", testBasePricesBind)
 	t.Run("Categories", testCategoriesBind)
 	t.Run("Images", testImagesBind)
+	t.Run("Mails", testMailsBind)
+	t.Run("MessageReplies", testMessageRepliesBind)
 	t.Run("Messages", testMessagesBind)
 	t.Run("OrderArticles", testOrderArticlesBind)
 	t.Run("Orders", testOrdersBind)
 	t.Run("PaymentStatuses", testPaymentStatusesBind)
+	t.Run("SubscriberConsents", testSubscriberConsentsBind)
+	t.Run("Subscribers", testSubscribersBind)
 	t.Run("Variants", testVariantsBind)
 	t.Run("Videos", testVideosBind)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesBind)
+	t.Run("Webhooks", testWebhooksBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesOne)
+	t.Run("ArticleRevisions", testArticleRevisionsOne)
 	t.Run("Articles", testArticlesOne)
+	t.Run("Attributes", testAttributesOne)
 	t.Run("BasePrices", testBasePricesOne)
 	t.Run("Categories", testCategoriesOne)
 	t.Run("Images", testImagesOne)
+	t.Run("Mails", testMailsOne)
+	t.Run("MessageReplies", testMessageRepliesOne)
 	t.Run("Messages", testMessagesOne)
 	t.Run("OrderArticles", testOrderArticlesOne)
 	t.Run("Orders", testOrdersOne)
 	t.Run("PaymentStatuses", testPaymentStatusesOne)
+	t.Run("SubscriberConsents", testSubscriberConsentsOne)
+	t.Run("Subscribers", testSubscribersOne)
 	t.Run("Variants", testVariantsOne)
 	t.Run("Videos", testVideosOne)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesOne)
+	t.Run("Webhooks", testWebhooksOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesAll)
+	t.Run("ArticleRevisions", testArticleRevisionsAll)
 	t.Run("Articles", testArticlesAll)
+	t.Run("Attributes", testAttributesAll)
 	t.Run("BasePrices", testBasePricesAll)
 	t.Run("Categories", testCategoriesAll)
 	t.Run("Images", testImagesAll)
+	t.Run("Mails", testMailsAll)
+	t.Run("MessageReplies", testMessageRepliesAll)
 	t.Run("Messages", testMessagesAll)
 	t.Run("OrderArticles", testOrderArticlesAll)
 	t.Run("Orders", testOrdersAll)
 	t.Run("PaymentStatuses", testPaymentStatusesAll)
+	t.Run("SubscriberConsents", testSubscriberConsentsAll)
+	t.Run("Subscribers", testSubscribersAll)
 	t.Run("Variants", testVariantsAll)
 	t.Run("Videos", testVideosAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesAll)
+	t.Run("Webhooks", testWebhooksAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesCount)
+	t.Run("ArticleRevisions", testArticleRevisionsCount)
 	t.Run("Articles", testArticlesCount)
+	t.Run("Attributes", testAttributesCount)
 	t.Run("BasePrices", testBasePricesCount)
 	t.Run("Categories", testCategoriesCount)
 	t.Run("Images", testImagesCount)
+	t.Run("Mails", testMailsCount)
+	t.Run("MessageReplies", testMessageRepliesCount)
 	t.Run("Messages", testMessagesCount)
 	t.Run("OrderArticles", testOrderArticlesCount)
 	t.Run("Orders", testOrdersCount)
 	t.Run("PaymentStatuses", testPaymentStatusesCount)
+	t.Run("SubscriberConsents", testSubscriberConsentsCount)
+	t.Run("Subscribers", testSubscribersCount)
 	t.Run("Variants", testVariantsCount)
 	t.Run("Videos", testVideosCount)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesCount)
+	t.Run("Webhooks", testWebhooksCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesHooks)
+	t.Run("ArticleRevisions", testArticleRevisionsHooks)
 	t.Run("Articles", testArticlesHooks)
+	t.Run("Attributes", testAttributesHooks)
 	t.Run("BasePrices", testBasePricesHooks)
 	t.Run("Categories", testCategoriesHooks)
 	t.Run("Images", testImagesHooks)
+	t.Run("Mails", testMailsHooks)
+	t.Run("MessageReplies", testMessageRepliesHooks)
 	t.Run("Messages", testMessagesHooks)
 	t.Run("OrderArticles", testOrderArticlesHooks)
 	t.Run("Orders", testOrdersHooks)
 	t.Run("PaymentStatuses", testPaymentStatusesHooks)
+	t.Run("SubscriberConsents", testSubscriberConsentsHooks)
+	t.Run("Subscribers", testSubscribersHooks)
 	t.Run("Variants", testVariantsHooks)
 	t.Run("Videos", testVideosHooks)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesHooks)
+	t.Run("Webhooks", testWebhooksHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesInsert)
+	t.Run("ArticleAttributes", testArticleAttributesInsertWhitelist)
+	t.Run("ArticleRevisions", testArticleRevisionsInsert)
+	t.Run("ArticleRevisions", testArticleRevisionsInsertWhitelist)
 	t.Run("Articles", testArticlesInsert)
 	t.Run("Articles", testArticlesInsertWhitelist)
+	t.Run("Attributes", testAttributesInsert)
+	t.Run("Attributes", testAttributesInsertWhitelist)
 	t.Run("BasePrices", testBasePricesInsert)
 	t.Run("BasePrices", testBasePricesInsertWhitelist)
 	t.Run("Categories", testCategoriesInsert)
 	t.Run("Categories", testCategoriesInsertWhitelist)
 	t.Run("Images", testImagesInsert)
 	t.Run("Images", testImagesInsertWhitelist)
+	t.Run("Mails", testMailsInsert)
+	t.Run("Mails", testMailsInsertWhitelist)
+	t.Run("MessageReplies", testMessageRepliesInsert)
+	t.Run("MessageReplies", testMessageRepliesInsertWhitelist)
 	t.Run("Messages", testMessagesInsert)
 	t.Run("Messages", testMessagesInsertWhitelist)
 	t.Run("OrderArticles", testOrderArticlesInsert)
@@ -171,19 +280,35 @@ func TestInsert(t *testing.T) {
 	t.Run("Orders", testOrdersInsertWhitelist)
 	t.Run("PaymentStatuses", testPaymentStatusesInsert)
 	t.Run("PaymentStatuses", testPaymentStatusesInsertWhitelist)
+	t.Run("SubscriberConsents", testSubscriberConsentsInsert)
+	t.Run("SubscriberConsents", testSubscriberConsentsInsertWhitelist)
+	t.Run("Subscribers", testSubscribersInsert)
+	t.Run("Subscribers", testSubscribersInsertWhitelist)
 	t.Run("Variants", testVariantsInsert)
 	t.Run("Variants", testVariantsInsertWhitelist)
 	t.Run("Videos", testVideosInsert)
 	t.Run("Videos", testVideosInsertWhitelist)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesInsert)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesInsertWhitelist)
+	t.Run("Webhooks", testWebhooksInsert)
+	t.Run("Webhooks", testWebhooksInsertWhitelist)
 }
 
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("ArticleAttributeToArticleUsingArticle", testArticleAttributeToOneArticleUsingArticle)
+	t.Run("ArticleAttributeToAttributeUsingAttribute", testArticleAttributeToOneAttributeUsingAttribute)
+	t.Run("ArticleRevisionToArticleUsingArticle", testArticleRevisionToOneArticleUsingArticle)
 	t.Run("ImageToArticleUsingArticle", testImageToOneArticleUsingArticle)
+	t.Run("MessageReplyToMessageUsingMessage", testMessageReplyToOneMessageUsingMessage)
+	t.Run("OrderArticleToArticleUsingArticle", testOrderArticleToOneArticleUsingArticle)
 	t.Run("OrderArticleToOrderUsingOrder", testOrderArticleToOneOrderUsingOrder)
+	t.Run("OrderArticleToVariantUsingVariant", testOrderArticleToOneVariantUsingVariant)
+	t.Run("SubscriberConsentToSubscriberUsingSubscriber", testSubscriberConsentToOneSubscriberUsingSubscriber)
 	t.Run("VariantToArticleUsingArticle", testVariantToOneArticleUsingArticle)
 	t.Run("VideoToArticleUsingArticle", testVideoToOneArticleUsingArticle)
+	t.Run("WebhookDeliveryToWebhookUsingWebhook", testWebhookDeliveryToOneWebhookUsingWebhook)
 }
 
 // TestOneToOne tests cannot be run in parallel
@@ -193,28 +318,48 @@ func TestOneToOne(t *testing.T) {}
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("ArticleToArticleAttributes", testArticleToManyArticleAttributes)
 	t.Run("ArticleToBasePrices", testArticleToManyBasePrices)
+	t.Run("ArticleToArticleRevisions", testArticleToManyArticleRevisions)
 	t.Run("ArticleToCategories", testArticleToManyCategories)
 	t.Run("ArticleToImages", testArticleToManyImages)
+	t.Run("ArticleToOrderArticles", testArticleToManyOrderArticles)
 	t.Run("ArticleToVariants", testArticleToManyVariants)
 	t.Run("ArticleToVideos", testArticleToManyVideos)
+	t.Run("AttributeToArticleAttributes", testAttributeToManyArticleAttributes)
+	t.Run("AttributeToCategories", testAttributeToManyCategories)
 	t.Run("BasePriceToArticles", testBasePriceToManyArticles)
 	t.Run("CategoryToArticles", testCategoryToManyArticles)
+	t.Run("CategoryToAttributes", testCategoryToManyAttributes)
+	t.Run("MessageToMessageReplies", testMessageToManyMessageReplies)
 	t.Run("OrderToOrderArticles", testOrderToManyOrderArticles)
+	t.Run("SubscriberToSubscriberConsents", testSubscriberToManySubscriberConsents)
+	t.Run("VariantToOrderArticles", testVariantToManyOrderArticles)
+	t.Run("WebhookToWebhookDeliveries", testWebhookToManyWebhookDeliveries)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("ArticleAttributeToArticleUsingArticleAttributes", testArticleAttributeToOneSetOpArticleUsingArticle)
+	t.Run("ArticleAttributeToAttributeUsingArticleAttributes", testArticleAttributeToOneSetOpAttributeUsingAttribute)
+	t.Run("ArticleRevisionToArticleUsingArticleRevisions", testArticleRevisionToOneSetOpArticleUsingArticle)
 	t.Run("ImageToArticleUsingImages", testImageToOneSetOpArticleUsingArticle)
+	t.Run("MessageReplyToMessageUsingMessageReplies", testMessageReplyToOneSetOpMessageUsingMessage)
+	t.Run("OrderArticleToArticleUsingOrderArticles", testOrderArticleToOneSetOpArticleUsingArticle)
 	t.Run("OrderArticleToOrderUsingOrderArticles", testOrderArticleToOneSetOpOrderUsingOrder)
+	t.Run("OrderArticleToVariantUsingOrderArticles", testOrderArticleToOneSetOpVariantUsingVariant)
+	t.Run("SubscriberConsentToSubscriberUsingSubscriberConsents", testSubscriberConsentToOneSetOpSubscriberUsingSubscriber)
 	t.Run("VariantToArticleUsingVariants", testVariantToOneSetOpArticleUsingArticle)
 	t.Run("VideoToArticleUsingVideos", testVideoToOneSetOpArticleUsingArticle)
+	t.Run("WebhookDeliveryToWebhookUsingWebhookDeliveries", testWebhookDeliveryToOneSetOpWebhookUsingWebhook)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("OrderArticleToVariantUsingOrderArticles", testOrderArticleToOneRemoveOpVariantUsingVariant)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
@@ -227,14 +372,24 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("ArticleToArticleAttributes", testArticleToManyAddOpArticleAttributes)
 	t.Run("ArticleToBasePrices", testArticleToManyAddOpBasePrices)
+	t.Run("ArticleToArticleRevisions", testArticleToManyAddOpArticleRevisions)
 	t.Run("ArticleToCategories", testArticleToManyAddOpCategories)
 	t.Run("ArticleToImages", testArticleToManyAddOpImages)
+	t.Run("ArticleToOrderArticles", testArticleToManyAddOpOrderArticles)
 	t.Run("ArticleToVariants", testArticleToManyAddOpVariants)
 	t.Run("ArticleToVideos", testArticleToManyAddOpVideos)
+	t.Run("AttributeToArticleAttributes", testAttributeToManyAddOpArticleAttributes)
+	t.Run("AttributeToCategories", testAttributeToManyAddOpCategories)
 	t.Run("BasePriceToArticles", testBasePriceToManyAddOpArticles)
 	t.Run("CategoryToArticles", testCategoryToManyAddOpArticles)
+	t.Run("CategoryToAttributes", testCategoryToManyAddOpAttributes)
+	t.Run("MessageToMessageReplies", testMessageToManyAddOpMessageReplies)
 	t.Run("OrderToOrderArticles", testOrderToManyAddOpOrderArticles)
+	t.Run("SubscriberToSubscriberConsents", testSubscriberToManyAddOpSubscriberConsents)
+	t.Run("VariantToOrderArticles", testVariantToManyAddOpOrderArticles)
+	t.Run("WebhookToWebhookDeliveries", testWebhookToManyAddOpWebhookDeliveries)
 }
 
 // TestToManySet tests cannot be run in parallel
@@ -242,8 +397,11 @@ func TestToManyAdd(t *testing.T) {
 func TestToManySet(t *testing.T) {
 	t.Run("ArticleToBasePrices", testArticleToManySetOpBasePrices)
 	t.Run("ArticleToCategories", testArticleToManySetOpCategories)
+	t.Run("AttributeToCategories", testAttributeToManySetOpCategories)
 	t.Run("BasePriceToArticles", testBasePriceToManySetOpArticles)
 	t.Run("CategoryToArticles", testCategoryToManySetOpArticles)
+	t.Run("CategoryToAttributes", testCategoryToManySetOpAttributes)
+	t.Run("VariantToOrderArticles", testVariantToManySetOpOrderArticles)
 }
 
 // TestToManyRemove tests cannot be run in parallel
@@ -251,71 +409,119 @@ func TestToManySet(t *testing.T) {
 func TestToManyRemove(t *testing.T) {
 	t.Run("ArticleToBasePrices", testArticleToManyRemoveOpBasePrices)
 	t.Run("ArticleToCategories", testArticleToManyRemoveOpCategories)
+	t.Run("AttributeToCategories", testAttributeToManyRemoveOpCategories)
 	t.Run("BasePriceToArticles", testBasePriceToManyRemoveOpArticles)
 	t.Run("CategoryToArticles", testCategoryToManyRemoveOpArticles)
+	t.Run("CategoryToAttributes", testCategoryToManyRemoveOpAttributes)
+	t.Run("VariantToOrderArticles", testVariantToManyRemoveOpOrderArticles)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesReload)
+	t.Run("ArticleRevisions", testArticleRevisionsReload)
 	t.Run("Articles", testArticlesReload)
+	t.Run("Attributes", testAttributesReload)
 	t.Run("BasePrices", testBasePricesReload)
 	t.Run("Categories", testCategoriesReload)
 	t.Run("Images", testImagesReload)
+	t.Run("Mails", testMailsReload)
+	t.Run("MessageReplies", testMessageRepliesReload)
 	t.Run("Messages", testMessagesReload)
 	t.Run("OrderArticles", testOrderArticlesReload)
 	t.Run("Orders", testOrdersReload)
 	t.Run("PaymentStatuses", testPaymentStatusesReload)
+	t.Run("SubscriberConsents", testSubscriberConsentsReload)
+	t.Run("Subscribers", testSubscribersReload)
 	t.Run("Variants", testVariantsReload)
 	t.Run("Videos", testVideosReload)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesReload)
+	t.Run("Webhooks", testWebhooksReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesReloadAll)
+	t.Run("ArticleRevisions", testArticleRevisionsReloadAll)
 	t.Run("Articles", testArticlesReloadAll)
+	t.Run("Attributes", testAttributesReloadAll)
 	t.Run("BasePrices", testBasePricesReloadAll)
 	t.Run("Categories", testCategoriesReloadAll)
 	t.Run("Images", testImagesReloadAll)
+	t.Run("Mails", testMailsReloadAll)
+	t.Run("MessageReplies", testMessageRepliesReloadAll)
 	t.Run("Messages", testMessagesReloadAll)
 	t.Run("OrderArticles", testOrderArticlesReloadAll)
 	t.Run("Orders", testOrdersReloadAll)
 	t.Run("PaymentStatuses", testPaymentStatusesReloadAll)
+	t.Run("SubscriberConsents", testSubscriberConsentsReloadAll)
+	t.Run("Subscribers", testSubscribersReloadAll)
 	t.Run("Variants", testVariantsReloadAll)
 	t.Run("Videos", testVideosReloadAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesReloadAll)
+	t.Run("Webhooks", testWebhooksReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesSelect)
+	t.Run("ArticleRevisions", testArticleRevisionsSelect)
 	t.Run("Articles", testArticlesSelect)
+	t.Run("Attributes", testAttributesSelect)
 	t.Run("BasePrices", testBasePricesSelect)
 	t.Run("Categories", testCategoriesSelect)
 	t.Run("Images", testImagesSelect)
+	t.Run("Mails", testMailsSelect)
+	t.Run("MessageReplies", testMessageRepliesSelect)
 	t.Run("Messages", testMessagesSelect)
 	t.Run("OrderArticles", testOrderArticlesSelect)
 	t.Run("Orders", testOrdersSelect)
 	t.Run("PaymentStatuses", testPaymentStatusesSelect)
+	t.Run("SubscriberConsents", testSubscriberConsentsSelect)
+	t.Run("Subscribers", testSubscribersSelect)
 	t.Run("Variants", testVariantsSelect)
 	t.Run("Videos", testVideosSelect)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSelect)
+	t.Run("Webhooks", testWebhooksSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesUpdate)
+	t.Run("ArticleRevisions", testArticleRevisionsUpdate)
 	t.Run("Articles", testArticlesUpdate)
+	t.Run("Attributes", testAttributesUpdate)
 	t.Run("BasePrices", testBasePricesUpdate)
 	t.Run("Categories", testCategoriesUpdate)
 	t.Run("Images", testImagesUpdate)
+	t.Run("Mails", testMailsUpdate)
+	t.Run("MessageReplies", testMessageRepliesUpdate)
 	t.Run("Messages", testMessagesUpdate)
 	t.Run("OrderArticles", testOrderArticlesUpdate)
 	t.Run("Orders", testOrdersUpdate)
 	t.Run("PaymentStatuses", testPaymentStatusesUpdate)
+	t.Run("SubscriberConsents", testSubscriberConsentsUpdate)
+	t.Run("Subscribers", testSubscribersUpdate)
 	t.Run("Variants", testVariantsUpdate)
 	t.Run("Videos", testVideosUpdate)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesUpdate)
+	t.Run("Webhooks", testWebhooksUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesSliceUpdateAll)
+	t.Run("ArticleRevisions", testArticleRevisionsSliceUpdateAll)
 	t.Run("Articles", testArticlesSliceUpdateAll)
+	t.Run("Attributes", testAttributesSliceUpdateAll)
 	t.Run("BasePrices", testBasePricesSliceUpdateAll)
 	t.Run("Categories", testCategoriesSliceUpdateAll)
 	t.Run("Images", testImagesSliceUpdateAll)
+	t.Run("Mails", testMailsSliceUpdateAll)
+	t.Run("MessageReplies", testMessageRepliesSliceUpdateAll)
 	t.Run("Messages", testMessagesSliceUpdateAll)
 	t.Run("OrderArticles", testOrderArticlesSliceUpdateAll)
 	t.Run("Orders", testOrdersSliceUpdateAll)
 	t.Run("PaymentStatuses", testPaymentStatusesSliceUpdateAll)
+	t.Run("SubscriberConsents", testSubscriberConsentsSliceUpdateAll)
+	t.Run("Subscribers", testSubscribersSliceUpdateAll)
 	t.Run("Variants", testVariantsSliceUpdateAll)
 	t.Run("Videos", testVideosSliceUpdateAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSliceUpdateAll)
+	t.Run("Webhooks", testWebhooksSliceUpdateAll)
 }
@@ -4,29 +4,49 @@
 package models
 
 var TableNames = struct {
-	ArticleBasePrices string
-	Articles          string
-	BasePrices        string
-	Categories        string
-	CategoryArticles  string
-	Images            string
-	Messages          string
-	OrderArticles     string
-	Orders            string
-	PaymentStatus     string
-	Variants          string
-	Videos            string
+	ArticleAttributes  string
+	ArticleBasePrices  string
+	ArticleRevisions   string
+	Articles           string
+	Attributes         string
+	BasePrices         string
+	Categories         string
+	CategoryArticles   string
+	CategoryAttributes string
+	Images             string
+	Mails              string
+	MessageReplies     string
+	Messages           string
+	OrderArticles      string
+	Orders             string
+	PaymentStatus      string
+	SubscriberConsents string
+	Subscribers        string
+	Variants           string
+	Videos             string
+	WebhookDeliveries  string
+	Webhooks           string
 }{
-	ArticleBasePrices: "article_base_prices",
-	Articles:          "articles",
-	BasePrices:        "base_prices",
-	Categories:        "categories",
-	CategoryArticles:  "category_articles",
-	Images:            "images",
-	Messages:          "messages",
-	OrderArticles:     "order_articles",
-	Orders:            "orders",
-	PaymentStatus:     "payment_status",
-	Variants:          "variants",
-	Videos:            "videos",
+	ArticleAttributes:  "article_attributes",
+	ArticleBasePrices:  "article_base_prices",
+	ArticleRevisions:   "article_revisions",
+	Articles:           "articles",
+	Attributes:         "attributes",
+	BasePrices:         "base_prices",
+	Categories:         "categories",
+	CategoryArticles:   "category_articles",
+	CategoryAttributes: "category_attributes",
+	Images:             "images",
+	Mails:              "mails",
+	MessageReplies:     "message_replies",
+	Messages:           "messages",
+	OrderArticles:      "order_articles",
+	Orders:             "orders",
+	PaymentStatus:      "payment_status",
+	SubscriberConsents: "subscriber_consents",
+	Subscribers:        "subscribers",
+	Variants:           "variants",
+	Videos:             "videos",
+	WebhookDeliveries:  "webhook_deliveries",
+	Webhooks:           "webhooks",
 }
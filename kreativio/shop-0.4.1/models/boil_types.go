@@ -51,6 +51,14 @@ func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
 	return str
 }
 
+// Enum values for attribute_type
+const (
+	AttributeTypeTEXT    = "TEXT"
+	AttributeTypeNUMBER  = "NUMBER"
+	AttributeTypeENUM    = "ENUM"
+	AttributeTypeBOOLEAN = "BOOLEAN"
+)
+
 // Enum values for payment
 const (
 	PaymentCASH_ON_DELIVERY = "CASH_ON_DELIVERY"
@@ -64,4 +72,12 @@ const (
 	StatusOPEN      = "OPEN"
 	StatusSENT      = "SENT"
 	StatusCOMPLETED = "COMPLETED"
+	StatusCANCELLED = "CANCELLED"
+)
+
+// Enum values for subscriber_status
+const (
+	SubscriberStatusPENDING      = "PENDING"
+	SubscriberStatusCONFIRMED    = "CONFIRMED"
+	SubscriberStatusUNSUBSCRIBED = "UNSUBSCRIBED"
 )
@@ -71,14 +71,17 @@ var CategoryWhere = struct {
 
 // CategoryRels is where relationship names are stored.
 var CategoryRels = struct {
-	Articles string
+	Articles   string
+	Attributes string
 }{
-	Articles: "Articles",
+	Articles:   "Articles",
+	Attributes: "Attributes",
 }
 
 // categoryR is where relationships are stored.
 type categoryR struct {
-	Articles ArticleSlice `boil:"Articles" json:"Articles" toml:"Articles" yaml:"Articles"`
+	Articles   ArticleSlice   `boil:"Articles" json:"Articles" toml:"Articles" yaml:"Articles"`
+	Attributes AttributeSlice `boil:"Attributes" json:"Attributes" toml:"Attributes" yaml:"Attributes"`
 }
 
 // NewStruct creates a new relationship struct
@@ -393,6 +396,28 @@ func (o *Category) Articles(mods ...qm.QueryMod) articleQuery {
 	return query
 }
 
+// Attributes retrieves all the attribute's Attributes with an executor.
+func (o *Category) Attributes(mods ...qm.QueryMod) attributeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"shop\".\"category_attributes\" on \"shop\".\"attributes\".\"id\" = \"shop\".\"category_attributes\".\"attribute_id\""),
+		qm.Where("\"shop\".\"category_attributes\".\"category_id\"=?", o.ID),
+	)
+
+	query := Attributes(queryMods...)
+	queries.SetFrom(query.Query, "\"shop\".\"attributes\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"shop\".\"attributes\".*"})
+	}
+
+	return query
+}
+
 // LoadArticles allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (categoryL) LoadArticles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCategory interface{}, mods queries.Applicator) error {
@@ -454,7 +479,7 @@ func (categoryL) LoadArticles(ctx context.Context, e boil.ContextExecutor, singu
 		one := new(Article)
 		var localJoinCol int
 
-		err = results.Scan(&one.ID, &one.CreatedAt, &one.UpdatedAt, &one.Published, &one.Title, &one.Description, &one.Price, &one.Promoted, &one.SearchIndex, &localJoinCol)
+		err = results.Scan(&one.ID, &one.CreatedAt, &one.UpdatedAt, &one.Published, &one.Title, &one.Description, &one.Price, &one.Promoted, &one.SearchIndex, &one.PublishAt, &one.UnpublishAt, &one.SalePrice, &one.SaleStart, &one.SaleEnd, &one.LiveNotifiedAt, &one.DeletedAt, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for articles")
 		}
@@ -508,6 +533,121 @@ func (categoryL) LoadArticles(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
+// LoadAttributes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (categoryL) LoadAttributes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCategory interface{}, mods queries.Applicator) error {
+	var slice []*Category
+	var object *Category
+
+	if singular {
+		object = maybeCategory.(*Category)
+	} else {
+		slice = *maybeCategory.(*[]*Category)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &categoryR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &categoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("\"shop\".\"attributes\".*, \"a\".\"category_id\""),
+		qm.From("\"shop\".\"attributes\""),
+		qm.InnerJoin("\"shop\".\"category_attributes\" as \"a\" on \"shop\".\"attributes\".\"id\" = \"a\".\"attribute_id\""),
+		qm.WhereIn("\"a\".\"category_id\" in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load attributes")
+	}
+
+	var resultSlice []*Attribute
+
+	var localJoinCols []int
+	for results.Next() {
+		one := new(Attribute)
+		var localJoinCol int
+
+		err = results.Scan(&one.ID, &one.CreatedAt, &one.UpdatedAt, &one.Label, &one.Type, &one.Unit, &one.Options, &one.Position, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for attributes")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice attributes")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on attributes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attributes")
+	}
+
+	if len(attributeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Attributes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &attributeR{}
+			}
+			foreign.R.Categories = append(foreign.R.Categories, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Attributes = append(local.R.Attributes, foreign)
+				if foreign.R == nil {
+					foreign.R = &attributeR{}
+				}
+				foreign.R.Categories = append(foreign.R.Categories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // AddArticles adds the given related objects to the existing relationships
 // of the category, optionally inserting them as new records.
 // Appends related to o.R.Articles.
@@ -648,6 +788,146 @@ func removeArticlesFromCategoriesSlice(o *Category, related []*Article) {
 	}
 }
 
+// AddAttributes adds the given related objects to the existing relationships
+// of the category, optionally inserting them as new records.
+// Appends related to o.R.Attributes.
+// Sets related.R.Categories appropriately.
+func (o *Category) AddAttributes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Attribute) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"shop\".\"category_attributes\" (\"category_id\", \"attribute_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &categoryR{
+			Attributes: related,
+		}
+	} else {
+		o.R.Attributes = append(o.R.Attributes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &attributeR{
+				Categories: CategorySlice{o},
+			}
+		} else {
+			rel.R.Categories = append(rel.R.Categories, o)
+		}
+	}
+	return nil
+}
+
+// SetAttributes removes all previously related items of the
+// category replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Categories's Attributes accordingly.
+// Replaces o.R.Attributes with related.
+// Sets related.R.Categories's Attributes accordingly.
+func (o *Category) SetAttributes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Attribute) error {
+	query := "delete from \"shop\".\"category_attributes\" where \"category_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeAttributesFromCategoriesSlice(o, related)
+	if o.R != nil {
+		o.R.Attributes = nil
+	}
+	return o.AddAttributes(ctx, exec, insert, related...)
+}
+
+// RemoveAttributes relationships from objects passed in.
+// Removes related items from R.Attributes (uses pointer comparison, removal does not keep order)
+// Sets related.R.Categories.
+func (o *Category) RemoveAttributes(ctx context.Context, exec boil.ContextExecutor, related ...*Attribute) error {
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"shop\".\"category_attributes\" where \"category_id\" = $1 and \"attribute_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeAttributesFromCategoriesSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Attributes {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Attributes)
+			if ln > 1 && i < ln-1 {
+				o.R.Attributes[i] = o.R.Attributes[ln-1]
+			}
+			o.R.Attributes = o.R.Attributes[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeAttributesFromCategoriesSlice(o *Category, related []*Attribute) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Categories {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Categories)
+			if ln > 1 && i < ln-1 {
+				rel.R.Categories[i] = rel.R.Categories[ln-1]
+			}
+			rel.R.Categories = rel.R.Categories[:ln-1]
+			break
+		}
+	}
+}
+
 // Categories retrieves all the records using an executor.
 func Categories(mods ...qm.QueryMod) categoryQuery {
 	mods = append(mods, qm.From("\"shop\".\"categories\""))
@@ -0,0 +1,1081 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MessageReply is an object representing the database table.
+type MessageReply struct {
+	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	MessageID int       `boil:"message_id" json:"message_id" toml:"message_id" yaml:"message_id"`
+	Author    string    `boil:"author" json:"author" toml:"author" yaml:"author"`
+	Body      string    `boil:"body" json:"body" toml:"body" yaml:"body"`
+
+	R *messageReplyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L messageReplyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MessageReplyColumns = struct {
+	ID        string
+	CreatedAt string
+	UpdatedAt string
+	MessageID string
+	Author    string
+	Body      string
+}{
+	ID:        "id",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+	MessageID: "message_id",
+	Author:    "author",
+	Body:      "body",
+}
+
+// Generated where
+
+var MessageReplyWhere = struct {
+	ID        whereHelperint
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+	MessageID whereHelperint
+	Author    whereHelperstring
+	Body      whereHelperstring
+}{
+	ID:        whereHelperint{field: "\"shop\".\"message_replies\".\"id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"shop\".\"message_replies\".\"created_at\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"shop\".\"message_replies\".\"updated_at\""},
+	MessageID: whereHelperint{field: "\"shop\".\"message_replies\".\"message_id\""},
+	Author:    whereHelperstring{field: "\"shop\".\"message_replies\".\"author\""},
+	Body:      whereHelperstring{field: "\"shop\".\"message_replies\".\"body\""},
+}
+
+// MessageReplyRels is where relationship names are stored.
+var MessageReplyRels = struct {
+	Message string
+}{
+	Message: "Message",
+}
+
+// messageReplyR is where relationships are stored.
+type messageReplyR struct {
+	Message *Message `boil:"Message" json:"Message" toml:"Message" yaml:"Message"`
+}
+
+// NewStruct creates a new relationship struct
+func (*messageReplyR) NewStruct() *messageReplyR {
+	return &messageReplyR{}
+}
+
+// messageReplyL is where Load methods for each relationship are stored.
+type messageReplyL struct{}
+
+var (
+	messageReplyAllColumns            = []string{"id", "created_at", "updated_at", "message_id", "author", "body"}
+	messageReplyColumnsWithoutDefault = []string{"created_at", "updated_at", "message_id", "author", "body"}
+	messageReplyColumnsWithDefault    = []string{"id"}
+	messageReplyPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// MessageReplySlice is an alias for a slice of pointers to MessageReply.
+	// This should generally be used opposed to []MessageReply.
+	MessageReplySlice []*MessageReply
+	// MessageReplyHook is the signature for custom MessageReply hook methods
+	MessageReplyHook func(context.Context, boil.ContextExecutor, *MessageReply) error
+
+	messageReplyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	messageReplyType                 = reflect.TypeOf(&MessageReply{})
+	messageReplyMapping              = queries.MakeStructMapping(messageReplyType)
+	messageReplyPrimaryKeyMapping, _ = queries.BindMapping(messageReplyType, messageReplyMapping, messageReplyPrimaryKeyColumns)
+	messageReplyInsertCacheMut       sync.RWMutex
+	messageReplyInsertCache          = make(map[string]insertCache)
+	messageReplyUpdateCacheMut       sync.RWMutex
+	messageReplyUpdateCache          = make(map[string]updateCache)
+	messageReplyUpsertCacheMut       sync.RWMutex
+	messageReplyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var messageReplyBeforeInsertHooks []MessageReplyHook
+var messageReplyBeforeUpdateHooks []MessageReplyHook
+var messageReplyBeforeDeleteHooks []MessageReplyHook
+var messageReplyBeforeUpsertHooks []MessageReplyHook
+
+var messageReplyAfterInsertHooks []MessageReplyHook
+var messageReplyAfterSelectHooks []MessageReplyHook
+var messageReplyAfterUpdateHooks []MessageReplyHook
+var messageReplyAfterDeleteHooks []MessageReplyHook
+var messageReplyAfterUpsertHooks []MessageReplyHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MessageReply) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageReplyBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MessageReply) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageReplyBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MessageReply) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageReplyBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MessageReply) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageReplyBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MessageReply) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageReplyAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MessageReply) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageReplyAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MessageReply) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageReplyAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MessageReply) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageReplyAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MessageReply) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageReplyAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMessageReplyHook registers your hook function for all future operations.
+func AddMessageReplyHook(hookPoint boil.HookPoint, messageReplyHook MessageReplyHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		messageReplyBeforeInsertHooks = append(messageReplyBeforeInsertHooks, messageReplyHook)
+	case boil.BeforeUpdateHook:
+		messageReplyBeforeUpdateHooks = append(messageReplyBeforeUpdateHooks, messageReplyHook)
+	case boil.BeforeDeleteHook:
+		messageReplyBeforeDeleteHooks = append(messageReplyBeforeDeleteHooks, messageReplyHook)
+	case boil.BeforeUpsertHook:
+		messageReplyBeforeUpsertHooks = append(messageReplyBeforeUpsertHooks, messageReplyHook)
+	case boil.AfterInsertHook:
+		messageReplyAfterInsertHooks = append(messageReplyAfterInsertHooks, messageReplyHook)
+	case boil.AfterSelectHook:
+		messageReplyAfterSelectHooks = append(messageReplyAfterSelectHooks, messageReplyHook)
+	case boil.AfterUpdateHook:
+		messageReplyAfterUpdateHooks = append(messageReplyAfterUpdateHooks, messageReplyHook)
+	case boil.AfterDeleteHook:
+		messageReplyAfterDeleteHooks = append(messageReplyAfterDeleteHooks, messageReplyHook)
+	case boil.AfterUpsertHook:
+		messageReplyAfterUpsertHooks = append(messageReplyAfterUpsertHooks, messageReplyHook)
+	}
+}
+
+// One returns a single messageReply record from the query.
+func (q messageReplyQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MessageReply, error) {
+	o := &MessageReply{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for message_replies")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MessageReply records from the query.
+func (q messageReplyQuery) All(ctx context.Context, exec boil.ContextExecutor) (MessageReplySlice, error) {
+	var o []*MessageReply
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to MessageReply slice")
+	}
+
+	if len(messageReplyAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MessageReply records in the query.
+func (q messageReplyQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count message_replies rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q messageReplyQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if message_replies exists")
+	}
+
+	return count > 0, nil
+}
+
+// Message pointed to by the foreign key.
+func (o *MessageReply) Message(mods ...qm.QueryMod) messageQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.MessageID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Messages(queryMods...)
+	queries.SetFrom(query.Query, "\"shop\".\"messages\"")
+
+	return query
+}
+
+// LoadMessage allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (messageReplyL) LoadMessage(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMessageReply interface{}, mods queries.Applicator) error {
+	var slice []*MessageReply
+	var object *MessageReply
+
+	if singular {
+		object = maybeMessageReply.(*MessageReply)
+	} else {
+		slice = *maybeMessageReply.(*[]*MessageReply)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &messageReplyR{}
+		}
+		args = append(args, object.MessageID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &messageReplyR{}
+			}
+
+			for _, a := range args {
+				if a == obj.MessageID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.MessageID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`shop.messages`),
+		qm.WhereIn(`shop.messages.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Message")
+	}
+
+	var resultSlice []*Message
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Message")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for messages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for messages")
+	}
+
+	if len(messageReplyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Message = foreign
+		if foreign.R == nil {
+			foreign.R = &messageR{}
+		}
+		foreign.R.MessageReplies = append(foreign.R.MessageReplies, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.MessageID == foreign.ID {
+				local.R.Message = foreign
+				if foreign.R == nil {
+					foreign.R = &messageR{}
+				}
+				foreign.R.MessageReplies = append(foreign.R.MessageReplies, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetMessage of the messageReply to the related item.
+// Sets o.R.Message to related.
+// Adds o to related.R.MessageReplies.
+func (o *MessageReply) SetMessage(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Message) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"shop\".\"message_replies\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"message_id"}),
+		strmangle.WhereClause("\"", "\"", 2, messageReplyPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.MessageID = related.ID
+	if o.R == nil {
+		o.R = &messageReplyR{
+			Message: related,
+		}
+	} else {
+		o.R.Message = related
+	}
+
+	if related.R == nil {
+		related.R = &messageR{
+			MessageReplies: MessageReplySlice{o},
+		}
+	} else {
+		related.R.MessageReplies = append(related.R.MessageReplies, o)
+	}
+
+	return nil
+}
+
+// MessageReplies retrieves all the records using an executor.
+func MessageReplies(mods ...qm.QueryMod) messageReplyQuery {
+	mods = append(mods, qm.From("\"shop\".\"message_replies\""))
+	return messageReplyQuery{NewQuery(mods...)}
+}
+
+// FindMessageReply retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMessageReply(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*MessageReply, error) {
+	messageReplyObj := &MessageReply{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"shop\".\"message_replies\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, messageReplyObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from message_replies")
+	}
+
+	return messageReplyObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MessageReply) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no message_replies provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(messageReplyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	messageReplyInsertCacheMut.RLock()
+	cache, cached := messageReplyInsertCache[key]
+	messageReplyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			messageReplyAllColumns,
+			messageReplyColumnsWithDefault,
+			messageReplyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(messageReplyType, messageReplyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(messageReplyType, messageReplyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"shop\".\"message_replies\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"shop\".\"message_replies\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into message_replies")
+	}
+
+	if !cached {
+		messageReplyInsertCacheMut.Lock()
+		messageReplyInsertCache[key] = cache
+		messageReplyInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the MessageReply.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MessageReply) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	messageReplyUpdateCacheMut.RLock()
+	cache, cached := messageReplyUpdateCache[key]
+	messageReplyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			messageReplyAllColumns,
+			messageReplyPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update message_replies, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"shop\".\"message_replies\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, messageReplyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(messageReplyType, messageReplyMapping, append(wl, messageReplyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update message_replies row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for message_replies")
+	}
+
+	if !cached {
+		messageReplyUpdateCacheMut.Lock()
+		messageReplyUpdateCache[key] = cache
+		messageReplyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q messageReplyQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for message_replies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for message_replies")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MessageReplySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), messageReplyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"shop\".\"message_replies\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, messageReplyPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in messageReply slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all messageReply")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MessageReply) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no message_replies provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(messageReplyColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	messageReplyUpsertCacheMut.RLock()
+	cache, cached := messageReplyUpsertCache[key]
+	messageReplyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			messageReplyAllColumns,
+			messageReplyColumnsWithDefault,
+			messageReplyColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			messageReplyAllColumns,
+			messageReplyPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert message_replies, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(messageReplyPrimaryKeyColumns))
+			copy(conflict, messageReplyPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"shop\".\"message_replies\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(messageReplyType, messageReplyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(messageReplyType, messageReplyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert message_replies")
+	}
+
+	if !cached {
+		messageReplyUpsertCacheMut.Lock()
+		messageReplyUpsertCache[key] = cache
+		messageReplyUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single MessageReply record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MessageReply) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MessageReply provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), messageReplyPrimaryKeyMapping)
+	sql := "DELETE FROM \"shop\".\"message_replies\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from message_replies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for message_replies")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q messageReplyQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no messageReplyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from message_replies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for message_replies")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MessageReplySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(messageReplyBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), messageReplyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"shop\".\"message_replies\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, messageReplyPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from messageReply slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for message_replies")
+	}
+
+	if len(messageReplyAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MessageReply) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMessageReply(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MessageReplySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MessageReplySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), messageReplyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"shop\".\"message_replies\".* FROM \"shop\".\"message_replies\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, messageReplyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MessageReplySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MessageReplyExists checks if the MessageReply row exists.
+func MessageReplyExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"shop\".\"message_replies\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if message_replies exists")
+	}
+
+	return exists, nil
+}
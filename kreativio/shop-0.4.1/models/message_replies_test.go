@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testMessageReplies(t *testing.T) {
+	t.Parallel()
+
+	query := MessageReplies()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testMessageRepliesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MessageReply{}
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, true, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MessageReplies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMessageRepliesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MessageReply{}
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, true, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := MessageReplies().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MessageReplies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMessageRepliesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MessageReply{}
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, true, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MessageReplySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MessageReplies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMessageRepliesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MessageReply{}
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, true, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := MessageReplyExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if MessageReply exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected MessageReplyExists to return true, but got false.")
+	}
+}
+
+func testMessageRepliesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MessageReply{}
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, true, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	messageReplyFound, err := FindMessageReply(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if messageReplyFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testMessageRepliesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MessageReply{}
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, true, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = MessageReplies().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMessageRepliesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MessageReply{}
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, true, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := MessageReplies().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testMessageRepliesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	messageReplyOne := &MessageReply{}
+	messageReplyTwo := &MessageReply{}
+	if err = randomize.Struct(seed, messageReplyOne, messageReplyDBTypes, false, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+	if err = randomize.Struct(seed, messageReplyTwo, messageReplyDBTypes, false, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = messageReplyOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = messageReplyTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := MessageReplies().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testMessageRepliesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	messageReplyOne := &MessageReply{}
+	messageReplyTwo := &MessageReply{}
+	if err = randomize.Struct(seed, messageReplyOne, messageReplyDBTypes, false, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+	if err = randomize.Struct(seed, messageReplyTwo, messageReplyDBTypes, false, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = messageReplyOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = messageReplyTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MessageReplies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func messageReplyBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *MessageReply) error {
+	*o = MessageReply{}
+	return nil
+}
+
+func messageReplyAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *MessageReply) error {
+	*o = MessageReply{}
+	return nil
+}
+
+func messageReplyAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *MessageReply) error {
+	*o = MessageReply{}
+	return nil
+}
+
+func messageReplyBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *MessageReply) error {
+	*o = MessageReply{}
+	return nil
+}
+
+func messageReplyAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *MessageReply) error {
+	*o = MessageReply{}
+	return nil
+}
+
+func messageReplyBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *MessageReply) error {
+	*o = MessageReply{}
+	return nil
+}
+
+func messageReplyAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *MessageReply) error {
+	*o = MessageReply{}
+	return nil
+}
+
+func messageReplyBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *MessageReply) error {
+	*o = MessageReply{}
+	return nil
+}
+
+func messageReplyAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *MessageReply) error {
+	*o = MessageReply{}
+	return nil
+}
+
+func testMessageRepliesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &MessageReply{}
+	o := &MessageReply{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize MessageReply object: %s", err)
+	}
+
+	AddMessageReplyHook(boil.BeforeInsertHook, messageReplyBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	messageReplyBeforeInsertHooks = []MessageReplyHook{}
+
+	AddMessageReplyHook(boil.AfterInsertHook, messageReplyAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	messageReplyAfterInsertHooks = []MessageReplyHook{}
+
+	AddMessageReplyHook(boil.AfterSelectHook, messageReplyAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	messageReplyAfterSelectHooks = []MessageReplyHook{}
+
+	AddMessageReplyHook(boil.BeforeUpdateHook, messageReplyBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	messageReplyBeforeUpdateHooks = []MessageReplyHook{}
+
+	AddMessageReplyHook(boil.AfterUpdateHook, messageReplyAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	messageReplyAfterUpdateHooks = []MessageReplyHook{}
+
+	AddMessageReplyHook(boil.BeforeDeleteHook, messageReplyBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	messageReplyBeforeDeleteHooks = []MessageReplyHook{}
+
+	AddMessageReplyHook(boil.AfterDeleteHook, messageReplyAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	messageReplyAfterDeleteHooks = []MessageReplyHook{}
+
+	AddMessageReplyHook(boil.BeforeUpsertHook, messageReplyBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	messageReplyBeforeUpsertHooks = []MessageReplyHook{}
+
+	AddMessageReplyHook(boil.AfterUpsertHook, messageReplyAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	messageReplyAfterUpsertHooks = []MessageReplyHook{}
+}
+
+func testMessageRepliesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MessageReply{}
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, true, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MessageReplies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMessageRepliesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MessageReply{}
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(messageReplyColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MessageReplies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMessageReplyToOneMessageUsingMessage(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local MessageReply
+	var foreign Message
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, messageReplyDBTypes, false, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, messageDBTypes, false, messageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Message struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.MessageID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Message().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := MessageReplySlice{&local}
+	if err = local.L.LoadMessage(ctx, tx, false, (*[]*MessageReply)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Message == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Message = nil
+	if err = local.L.LoadMessage(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Message == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testMessageReplyToOneSetOpMessageUsingMessage(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MessageReply
+	var b, c Message
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, messageReplyDBTypes, false, strmangle.SetComplement(messageReplyPrimaryKeyColumns, messageReplyColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, messageDBTypes, false, strmangle.SetComplement(messagePrimaryKeyColumns, messageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, messageDBTypes, false, strmangle.SetComplement(messagePrimaryKeyColumns, messageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Message{&b, &c} {
+		err = a.SetMessage(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Message != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.MessageReplies[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.MessageID != x.ID {
+			t.Error("foreign key was wrong value", a.MessageID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.MessageID))
+		reflect.Indirect(reflect.ValueOf(&a.MessageID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.MessageID != x.ID {
+			t.Error("foreign key was wrong value", a.MessageID, x.ID)
+		}
+	}
+}
+
+func testMessageRepliesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MessageReply{}
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, true, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMessageRepliesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MessageReply{}
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, true, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MessageReplySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMessageRepliesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MessageReply{}
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, true, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := MessageReplies().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	messageReplyDBTypes = map[string]string{`ID`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `MessageID`: `integer`, `Author`: `text`, `Body`: `text`}
+	_                   = bytes.MinRead
+)
+
+func testMessageRepliesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(messageReplyPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(messageReplyAllColumns) == len(messageReplyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MessageReply{}
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, true, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MessageReplies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, true, messageReplyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testMessageRepliesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(messageReplyAllColumns) == len(messageReplyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MessageReply{}
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, true, messageReplyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MessageReplies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, messageReplyDBTypes, true, messageReplyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(messageReplyAllColumns, messageReplyPrimaryKeyColumns) {
+		fields = messageReplyAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			messageReplyAllColumns,
+			messageReplyPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := MessageReplySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testMessageRepliesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(messageReplyAllColumns) == len(messageReplyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := MessageReply{}
+	if err = randomize.Struct(seed, &o, messageReplyDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert MessageReply: %s", err)
+	}
+
+	count, err := MessageReplies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, messageReplyDBTypes, false, messageReplyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MessageReply struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert MessageReply: %s", err)
+	}
+
+	count, err = MessageReplies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
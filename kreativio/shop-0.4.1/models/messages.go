@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,67 +24,86 @@ import (
 
 // Message is an object representing the database table.
 type Message struct {
-	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Email     string    `boil:"email" json:"email" toml:"email" yaml:"email"`
-	Phone     string    `boil:"phone" json:"phone" toml:"phone" yaml:"phone"`
-	Subject   string    `boil:"subject" json:"subject" toml:"subject" yaml:"subject"`
-	Message   string    `boil:"message" json:"message" toml:"message" yaml:"message"`
+	ID         int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Name       string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Email      string    `boil:"email" json:"email" toml:"email" yaml:"email"`
+	Phone      string    `boil:"phone" json:"phone" toml:"phone" yaml:"phone"`
+	Subject    string    `boil:"subject" json:"subject" toml:"subject" yaml:"subject"`
+	Message    string    `boil:"message" json:"message" toml:"message" yaml:"message"`
+	ReadAt     null.Time `boil:"read_at" json:"read_at,omitempty" toml:"read_at" yaml:"read_at,omitempty"`
+	ArchivedAt null.Time `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	RemoteAddr string    `boil:"remote_addr" json:"remote_addr" toml:"remote_addr" yaml:"remote_addr"`
 
 	R *messageR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L messageL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var MessageColumns = struct {
-	ID        string
-	CreatedAt string
-	UpdatedAt string
-	Name      string
-	Email     string
-	Phone     string
-	Subject   string
-	Message   string
+	ID         string
+	CreatedAt  string
+	UpdatedAt  string
+	Name       string
+	Email      string
+	Phone      string
+	Subject    string
+	Message    string
+	ReadAt     string
+	ArchivedAt string
+	RemoteAddr string
 }{
-	ID:        "id",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
-	Name:      "name",
-	Email:     "email",
-	Phone:     "phone",
-	Subject:   "subject",
-	Message:   "message",
+	ID:         "id",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+	Name:       "name",
+	Email:      "email",
+	Phone:      "phone",
+	Subject:    "subject",
+	Message:    "message",
+	ReadAt:     "read_at",
+	ArchivedAt: "archived_at",
+	RemoteAddr: "remote_addr",
 }
 
 // Generated where
 
 var MessageWhere = struct {
-	ID        whereHelperint
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpertime_Time
-	Name      whereHelperstring
-	Email     whereHelperstring
-	Phone     whereHelperstring
-	Subject   whereHelperstring
-	Message   whereHelperstring
+	ID         whereHelperint
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+	Name       whereHelperstring
+	Email      whereHelperstring
+	Phone      whereHelperstring
+	Subject    whereHelperstring
+	Message    whereHelperstring
+	ReadAt     whereHelpernull_Time
+	ArchivedAt whereHelpernull_Time
+	RemoteAddr whereHelperstring
 }{
-	ID:        whereHelperint{field: "\"shop\".\"messages\".\"id\""},
-	CreatedAt: whereHelpertime_Time{field: "\"shop\".\"messages\".\"created_at\""},
-	UpdatedAt: whereHelpertime_Time{field: "\"shop\".\"messages\".\"updated_at\""},
-	Name:      whereHelperstring{field: "\"shop\".\"messages\".\"name\""},
-	Email:     whereHelperstring{field: "\"shop\".\"messages\".\"email\""},
-	Phone:     whereHelperstring{field: "\"shop\".\"messages\".\"phone\""},
-	Subject:   whereHelperstring{field: "\"shop\".\"messages\".\"subject\""},
-	Message:   whereHelperstring{field: "\"shop\".\"messages\".\"message\""},
+	ID:         whereHelperint{field: "\"shop\".\"messages\".\"id\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"shop\".\"messages\".\"created_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"shop\".\"messages\".\"updated_at\""},
+	Name:       whereHelperstring{field: "\"shop\".\"messages\".\"name\""},
+	Email:      whereHelperstring{field: "\"shop\".\"messages\".\"email\""},
+	Phone:      whereHelperstring{field: "\"shop\".\"messages\".\"phone\""},
+	Subject:    whereHelperstring{field: "\"shop\".\"messages\".\"subject\""},
+	Message:    whereHelperstring{field: "\"shop\".\"messages\".\"message\""},
+	ReadAt:     whereHelpernull_Time{field: "\"shop\".\"messages\".\"read_at\""},
+	ArchivedAt: whereHelpernull_Time{field: "\"shop\".\"messages\".\"archived_at\""},
+	RemoteAddr: whereHelperstring{field: "\"shop\".\"messages\".\"remote_addr\""},
 }
 
 // MessageRels is where relationship names are stored.
 var MessageRels = struct {
-}{}
+	MessageReplies string
+}{
+	MessageReplies: "MessageReplies",
+}
 
 // messageR is where relationships are stored.
 type messageR struct {
+	MessageReplies MessageReplySlice `boil:"MessageReplies" json:"MessageReplies" toml:"MessageReplies" yaml:"MessageReplies"`
 }
 
 // NewStruct creates a new relationship struct
@@ -95,9 +115,9 @@ func (*messageR) NewStruct() *messageR {
 type messageL struct{}
 
 var (
-	messageAllColumns            = []string{"id", "created_at", "updated_at", "name", "email", "phone", "subject", "message"}
-	messageColumnsWithoutDefault = []string{"created_at", "updated_at", "name", "email", "phone", "subject", "message"}
-	messageColumnsWithDefault    = []string{"id"}
+	messageAllColumns            = []string{"id", "created_at", "updated_at", "name", "email", "phone", "subject", "message", "read_at", "archived_at", "remote_addr"}
+	messageColumnsWithoutDefault = []string{"created_at", "updated_at", "name", "email", "phone", "subject", "message", "read_at", "archived_at"}
+	messageColumnsWithDefault    = []string{"id", "remote_addr"}
 	messagePrimaryKeyColumns     = []string{"id"}
 )
 
@@ -376,6 +396,178 @@ func (q messageQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bo
 	return count > 0, nil
 }
 
+// MessageReplies retrieves all the message_reply's MessageReplies with an executor.
+func (o *Message) MessageReplies(mods ...qm.QueryMod) messageReplyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"shop\".\"message_replies\".\"message_id\"=?", o.ID),
+	)
+
+	query := MessageReplies(queryMods...)
+	queries.SetFrom(query.Query, "\"shop\".\"message_replies\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"shop\".\"message_replies\".*"})
+	}
+
+	return query
+}
+
+// LoadMessageReplies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (messageL) LoadMessageReplies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMessage interface{}, mods queries.Applicator) error {
+	var slice []*Message
+	var object *Message
+
+	if singular {
+		object = maybeMessage.(*Message)
+	} else {
+		slice = *maybeMessage.(*[]*Message)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &messageR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &messageR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`shop.message_replies`),
+		qm.WhereIn(`shop.message_replies.message_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load message_replies")
+	}
+
+	var resultSlice []*MessageReply
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice message_replies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on message_replies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for message_replies")
+	}
+
+	if len(messageReplyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MessageReplies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &messageReplyR{}
+			}
+			foreign.R.Message = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.MessageID {
+				local.R.MessageReplies = append(local.R.MessageReplies, foreign)
+				if foreign.R == nil {
+					foreign.R = &messageReplyR{}
+				}
+				foreign.R.Message = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddMessageReplies adds the given related objects to the existing relationships
+// of the message, optionally inserting them as new records.
+// Appends related to o.R.MessageReplies.
+// Sets related.R.Message appropriately.
+func (o *Message) AddMessageReplies(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MessageReply) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.MessageID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"shop\".\"message_replies\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"message_id"}),
+				strmangle.WhereClause("\"", "\"", 2, messageReplyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.MessageID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &messageR{
+			MessageReplies: related,
+		}
+	} else {
+		o.R.MessageReplies = append(o.R.MessageReplies, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &messageReplyR{
+				Message: o,
+			}
+		} else {
+			rel.R.Message = o
+		}
+	}
+	return nil
+}
+
 // Messages retrieves all the records using an executor.
 func Messages(mods ...qm.QueryMod) messageQuery {
 	mods = append(mods, qm.From("\"shop\".\"messages\""))
@@ -494,6 +494,160 @@ func testMessagesInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testMessageToManyMessageReplies(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Message
+	var b, c MessageReply
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, messageDBTypes, true, messageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Message struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, messageReplyDBTypes, false, messageReplyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, messageReplyDBTypes, false, messageReplyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.MessageID = a.ID
+	c.MessageID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.MessageReplies().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.MessageID == b.MessageID {
+			bFound = true
+		}
+		if v.MessageID == c.MessageID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := MessageSlice{&a}
+	if err = a.L.LoadMessageReplies(ctx, tx, false, (*[]*Message)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.MessageReplies); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.MessageReplies = nil
+	if err = a.L.LoadMessageReplies(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.MessageReplies); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testMessageToManyAddOpMessageReplies(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Message
+	var b, c, d, e MessageReply
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, messageDBTypes, false, strmangle.SetComplement(messagePrimaryKeyColumns, messageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*MessageReply{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, messageReplyDBTypes, false, strmangle.SetComplement(messageReplyPrimaryKeyColumns, messageReplyColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*MessageReply{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddMessageReplies(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.MessageID {
+			t.Error("foreign key was wrong value", a.ID, first.MessageID)
+		}
+		if a.ID != second.MessageID {
+			t.Error("foreign key was wrong value", a.ID, second.MessageID)
+		}
+
+		if first.R.Message != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Message != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.MessageReplies[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.MessageReplies[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.MessageReplies().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
 func testMessagesReload(t *testing.T) {
 	t.Parallel()
 
@@ -568,7 +722,7 @@ func testMessagesSelect(t *testing.T) {
 }
 
 var (
-	messageDBTypes = map[string]string{`ID`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `Name`: `text`, `Email`: `text`, `Phone`: `text`, `Subject`: `text`, `Message`: `text`}
+	messageDBTypes = map[string]string{`ID`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `Name`: `text`, `Email`: `text`, `Phone`: `text`, `Subject`: `text`, `Message`: `text`, `ReadAt`: `timestamp with time zone`, `ArchivedAt`: `timestamp with time zone`, `RemoteAddr`: `text`}
 	_              = bytes.MinRead
 )
 
@@ -32,6 +32,7 @@ type OrderArticle struct {
 	Title     string        `boil:"title" json:"title" toml:"title" yaml:"title"`
 	Price     types.Decimal `boil:"price" json:"price" toml:"price" yaml:"price"`
 	Details   null.JSON     `boil:"details" json:"details,omitempty" toml:"details" yaml:"details,omitempty"`
+	VariantID null.Int64    `boil:"variant_id" json:"variant_id,omitempty" toml:"variant_id" yaml:"variant_id,omitempty"`
 
 	R *orderArticleR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L orderArticleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -45,6 +46,7 @@ var OrderArticleColumns = struct {
 	Title     string
 	Price     string
 	Details   string
+	VariantID string
 }{
 	OrderID:   "order_id",
 	ArticleID: "article_id",
@@ -53,6 +55,7 @@ var OrderArticleColumns = struct {
 	Title:     "title",
 	Price:     "price",
 	Details:   "details",
+	VariantID: "variant_id",
 }
 
 // Generated where
@@ -80,6 +83,29 @@ func (w whereHelpernull_JSON) GTE(x null.JSON) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
+type whereHelpernull_Int64 struct{ field string }
+
+func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
 var OrderArticleWhere = struct {
 	OrderID   whereHelperint
 	ArticleID whereHelperint
@@ -88,6 +114,7 @@ var OrderArticleWhere = struct {
 	Title     whereHelperstring
 	Price     whereHelpertypes_Decimal
 	Details   whereHelpernull_JSON
+	VariantID whereHelpernull_Int64
 }{
 	OrderID:   whereHelperint{field: "\"shop\".\"order_articles\".\"order_id\""},
 	ArticleID: whereHelperint{field: "\"shop\".\"order_articles\".\"article_id\""},
@@ -96,18 +123,25 @@ var OrderArticleWhere = struct {
 	Title:     whereHelperstring{field: "\"shop\".\"order_articles\".\"title\""},
 	Price:     whereHelpertypes_Decimal{field: "\"shop\".\"order_articles\".\"price\""},
 	Details:   whereHelpernull_JSON{field: "\"shop\".\"order_articles\".\"details\""},
+	VariantID: whereHelpernull_Int64{field: "\"shop\".\"order_articles\".\"variant_id\""},
 }
 
 // OrderArticleRels is where relationship names are stored.
 var OrderArticleRels = struct {
-	Order string
+	Article string
+	Order   string
+	Variant string
 }{
-	Order: "Order",
+	Article: "Article",
+	Order:   "Order",
+	Variant: "Variant",
 }
 
 // orderArticleR is where relationships are stored.
 type orderArticleR struct {
-	Order *Order `boil:"Order" json:"Order" toml:"Order" yaml:"Order"`
+	Article *Article `boil:"Article" json:"Article" toml:"Article" yaml:"Article"`
+	Order   *Order   `boil:"Order" json:"Order" toml:"Order" yaml:"Order"`
+	Variant *Variant `boil:"Variant" json:"Variant" toml:"Variant" yaml:"Variant"`
 }
 
 // NewStruct creates a new relationship struct
@@ -119,8 +153,8 @@ func (*orderArticleR) NewStruct() *orderArticleR {
 type orderArticleL struct{}
 
 var (
-	orderArticleAllColumns            = []string{"order_id", "article_id", "amount", "id", "title", "price", "details"}
-	orderArticleColumnsWithoutDefault = []string{"order_id", "article_id", "amount", "title", "price", "details"}
+	orderArticleAllColumns            = []string{"order_id", "article_id", "amount", "id", "title", "price", "details", "variant_id"}
+	orderArticleColumnsWithoutDefault = []string{"order_id", "article_id", "amount", "title", "price", "details", "variant_id"}
 	orderArticleColumnsWithDefault    = []string{"id"}
 	orderArticlePrimaryKeyColumns     = []string{"id"}
 )
@@ -400,6 +434,20 @@ func (q orderArticleQuery) Exists(ctx context.Context, exec boil.ContextExecutor
 	return count > 0, nil
 }
 
+// Article pointed to by the foreign key.
+func (o *OrderArticle) Article(mods ...qm.QueryMod) articleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ArticleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Articles(queryMods...)
+	queries.SetFrom(query.Query, "\"shop\".\"articles\"")
+
+	return query
+}
+
 // Order pointed to by the foreign key.
 func (o *OrderArticle) Order(mods ...qm.QueryMod) orderQuery {
 	queryMods := []qm.QueryMod{
@@ -414,6 +462,124 @@ func (o *OrderArticle) Order(mods ...qm.QueryMod) orderQuery {
 	return query
 }
 
+// Variant pointed to by the foreign key.
+func (o *OrderArticle) Variant(mods ...qm.QueryMod) variantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.VariantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Variants(queryMods...)
+	queries.SetFrom(query.Query, "\"shop\".\"variants\"")
+
+	return query
+}
+
+// LoadArticle allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (orderArticleL) LoadArticle(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrderArticle interface{}, mods queries.Applicator) error {
+	var slice []*OrderArticle
+	var object *OrderArticle
+
+	if singular {
+		object = maybeOrderArticle.(*OrderArticle)
+	} else {
+		slice = *maybeOrderArticle.(*[]*OrderArticle)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &orderArticleR{}
+		}
+		args = append(args, object.ArticleID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &orderArticleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ArticleID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ArticleID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`shop.articles`),
+		qm.WhereIn(`shop.articles.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Article")
+	}
+
+	var resultSlice []*Article
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Article")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for articles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for articles")
+	}
+
+	if len(orderArticleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Article = foreign
+		if foreign.R == nil {
+			foreign.R = &articleR{}
+		}
+		foreign.R.OrderArticles = append(foreign.R.OrderArticles, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ArticleID == foreign.ID {
+				local.R.Article = foreign
+				if foreign.R == nil {
+					foreign.R = &articleR{}
+				}
+				foreign.R.OrderArticles = append(foreign.R.OrderArticles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadOrder allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (orderArticleL) LoadOrder(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrderArticle interface{}, mods queries.Applicator) error {
@@ -518,6 +684,161 @@ func (orderArticleL) LoadOrder(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
+// LoadVariant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (orderArticleL) LoadVariant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrderArticle interface{}, mods queries.Applicator) error {
+	var slice []*OrderArticle
+	var object *OrderArticle
+
+	if singular {
+		object = maybeOrderArticle.(*OrderArticle)
+	} else {
+		slice = *maybeOrderArticle.(*[]*OrderArticle)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &orderArticleR{}
+		}
+		if !queries.IsNil(object.VariantID) {
+			args = append(args, object.VariantID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &orderArticleR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.VariantID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.VariantID) {
+				args = append(args, obj.VariantID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`shop.variants`),
+		qm.WhereIn(`shop.variants.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Variant")
+	}
+
+	var resultSlice []*Variant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Variant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for variants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for variants")
+	}
+
+	if len(orderArticleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Variant = foreign
+		if foreign.R == nil {
+			foreign.R = &variantR{}
+		}
+		foreign.R.OrderArticles = append(foreign.R.OrderArticles, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.VariantID, foreign.ID) {
+				local.R.Variant = foreign
+				if foreign.R == nil {
+					foreign.R = &variantR{}
+				}
+				foreign.R.OrderArticles = append(foreign.R.OrderArticles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetArticle of the orderArticle to the related item.
+// Sets o.R.Article to related.
+// Adds o to related.R.OrderArticles.
+func (o *OrderArticle) SetArticle(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Article) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"shop\".\"order_articles\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"article_id"}),
+		strmangle.WhereClause("\"", "\"", 2, orderArticlePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ArticleID = related.ID
+	if o.R == nil {
+		o.R = &orderArticleR{
+			Article: related,
+		}
+	} else {
+		o.R.Article = related
+	}
+
+	if related.R == nil {
+		related.R = &articleR{
+			OrderArticles: OrderArticleSlice{o},
+		}
+	} else {
+		related.R.OrderArticles = append(related.R.OrderArticles, o)
+	}
+
+	return nil
+}
+
 // SetOrder of the orderArticle to the related item.
 // Sets o.R.Order to related.
 // Adds o to related.R.OrderArticles.
@@ -565,6 +886,86 @@ func (o *OrderArticle) SetOrder(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
+// SetVariant of the orderArticle to the related item.
+// Sets o.R.Variant to related.
+// Adds o to related.R.OrderArticles.
+func (o *OrderArticle) SetVariant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Variant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"shop\".\"order_articles\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"variant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, orderArticlePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.VariantID, related.ID)
+	if o.R == nil {
+		o.R = &orderArticleR{
+			Variant: related,
+		}
+	} else {
+		o.R.Variant = related
+	}
+
+	if related.R == nil {
+		related.R = &variantR{
+			OrderArticles: OrderArticleSlice{o},
+		}
+	} else {
+		related.R.OrderArticles = append(related.R.OrderArticles, o)
+	}
+
+	return nil
+}
+
+// RemoveVariant relationship.
+// Sets o.R.Variant to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *OrderArticle) RemoveVariant(ctx context.Context, exec boil.ContextExecutor, related *Variant) error {
+	var err error
+
+	queries.SetScanner(&o.VariantID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("variant_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Variant = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.OrderArticles {
+		if queries.Equal(o.VariantID, ri.VariantID) {
+			continue
+		}
+
+		ln := len(related.R.OrderArticles)
+		if ln > 1 && i < ln-1 {
+			related.R.OrderArticles[i] = related.R.OrderArticles[ln-1]
+		}
+		related.R.OrderArticles = related.R.OrderArticles[:ln-1]
+		break
+	}
+	return nil
+}
+
 // OrderArticles retrieves all the records using an executor.
 func OrderArticles(mods ...qm.QueryMod) orderArticleQuery {
 	mods = append(mods, qm.From("\"shop\".\"order_articles\""))
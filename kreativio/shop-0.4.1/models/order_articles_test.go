@@ -494,6 +494,57 @@ func testOrderArticlesInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testOrderArticleToOneArticleUsingArticle(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OrderArticle
+	var foreign Article
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, orderArticleDBTypes, false, orderArticleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderArticle struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, articleDBTypes, false, articleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Article struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ArticleID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Article().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := OrderArticleSlice{&local}
+	if err = local.L.LoadArticle(ctx, tx, false, (*[]*OrderArticle)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Article == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Article = nil
+	if err = local.L.LoadArticle(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Article == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
 func testOrderArticleToOneOrderUsingOrder(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -545,6 +596,114 @@ func testOrderArticleToOneOrderUsingOrder(t *testing.T) {
 	}
 }
 
+func testOrderArticleToOneVariantUsingVariant(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OrderArticle
+	var foreign Variant
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, orderArticleDBTypes, true, orderArticleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderArticle struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, variantDBTypes, false, variantColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Variant struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.VariantID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Variant().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := OrderArticleSlice{&local}
+	if err = local.L.LoadVariant(ctx, tx, false, (*[]*OrderArticle)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Variant == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Variant = nil
+	if err = local.L.LoadVariant(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Variant == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testOrderArticleToOneSetOpArticleUsingArticle(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OrderArticle
+	var b, c Article
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, orderArticleDBTypes, false, strmangle.SetComplement(orderArticlePrimaryKeyColumns, orderArticleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, articleDBTypes, false, strmangle.SetComplement(articlePrimaryKeyColumns, articleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, articleDBTypes, false, strmangle.SetComplement(articlePrimaryKeyColumns, articleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Article{&b, &c} {
+		err = a.SetArticle(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Article != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OrderArticles[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ArticleID != x.ID {
+			t.Error("foreign key was wrong value", a.ArticleID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ArticleID))
+		reflect.Indirect(reflect.ValueOf(&a.ArticleID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ArticleID != x.ID {
+			t.Error("foreign key was wrong value", a.ArticleID, x.ID)
+		}
+	}
+}
 func testOrderArticleToOneSetOpOrderUsingOrder(t *testing.T) {
 	var err error
 
@@ -602,6 +761,114 @@ func testOrderArticleToOneSetOpOrderUsingOrder(t *testing.T) {
 		}
 	}
 }
+func testOrderArticleToOneSetOpVariantUsingVariant(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OrderArticle
+	var b, c Variant
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, orderArticleDBTypes, false, strmangle.SetComplement(orderArticlePrimaryKeyColumns, orderArticleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, variantDBTypes, false, strmangle.SetComplement(variantPrimaryKeyColumns, variantColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, variantDBTypes, false, strmangle.SetComplement(variantPrimaryKeyColumns, variantColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Variant{&b, &c} {
+		err = a.SetVariant(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Variant != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OrderArticles[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.VariantID, x.ID) {
+			t.Error("foreign key was wrong value", a.VariantID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.VariantID))
+		reflect.Indirect(reflect.ValueOf(&a.VariantID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.VariantID, x.ID) {
+			t.Error("foreign key was wrong value", a.VariantID, x.ID)
+		}
+	}
+}
+
+func testOrderArticleToOneRemoveOpVariantUsingVariant(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OrderArticle
+	var b Variant
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, orderArticleDBTypes, false, strmangle.SetComplement(orderArticlePrimaryKeyColumns, orderArticleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, variantDBTypes, false, strmangle.SetComplement(variantPrimaryKeyColumns, variantColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetVariant(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveVariant(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Variant().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Variant != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.VariantID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.OrderArticles) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
 
 func testOrderArticlesReload(t *testing.T) {
 	t.Parallel()
@@ -677,7 +944,7 @@ func testOrderArticlesSelect(t *testing.T) {
 }
 
 var (
-	orderArticleDBTypes = map[string]string{`OrderID`: `integer`, `ArticleID`: `integer`, `Amount`: `integer`, `ID`: `integer`, `Title`: `text`, `Price`: `numeric`, `Details`: `jsonb`}
+	orderArticleDBTypes = map[string]string{`OrderID`: `integer`, `ArticleID`: `integer`, `Amount`: `integer`, `ID`: `integer`, `Title`: `text`, `Price`: `numeric`, `Details`: `jsonb`, `VariantID`: `bigint`}
 	_                   = bytes.MinRead
 )
 
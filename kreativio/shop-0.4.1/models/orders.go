@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,69 +24,89 @@ import (
 
 // Order is an object representing the database table.
 type Order struct {
-	ID            int       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	CreatedAt     time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt     time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	FullName      string    `boil:"full_name" json:"full_name" toml:"full_name" yaml:"full_name"`
-	Email         string    `boil:"email" json:"email" toml:"email" yaml:"email"`
-	Phone         string    `boil:"phone" json:"phone" toml:"phone" yaml:"phone"`
-	FullAddress   string    `boil:"full_address" json:"full_address" toml:"full_address" yaml:"full_address"`
-	Message       string    `boil:"message" json:"message" toml:"message" yaml:"message"`
-	PaymentMethod string    `boil:"payment_method" json:"payment_method" toml:"payment_method" yaml:"payment_method"`
-	Status        string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ID                int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt         time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt         time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	FullName          string    `boil:"full_name" json:"full_name" toml:"full_name" yaml:"full_name"`
+	Email             string    `boil:"email" json:"email" toml:"email" yaml:"email"`
+	Phone             string    `boil:"phone" json:"phone" toml:"phone" yaml:"phone"`
+	FullAddress       string    `boil:"full_address" json:"full_address" toml:"full_address" yaml:"full_address"`
+	Message           string    `boil:"message" json:"message" toml:"message" yaml:"message"`
+	PaymentMethod     string    `boil:"payment_method" json:"payment_method" toml:"payment_method" yaml:"payment_method"`
+	Status            string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Locale            string    `boil:"locale" json:"locale" toml:"locale" yaml:"locale"`
+	TrackingNumber    string    `boil:"tracking_number" json:"tracking_number" toml:"tracking_number" yaml:"tracking_number"`
+	TrackingURL       string    `boil:"tracking_url" json:"tracking_url" toml:"tracking_url" yaml:"tracking_url"`
+	PaymentRemindedAt null.Time `boil:"payment_reminded_at" json:"payment_reminded_at,omitempty" toml:"payment_reminded_at" yaml:"payment_reminded_at,omitempty"`
 
 	R *orderR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L orderL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var OrderColumns = struct {
-	ID            string
-	CreatedAt     string
-	UpdatedAt     string
-	FullName      string
-	Email         string
-	Phone         string
-	FullAddress   string
-	Message       string
-	PaymentMethod string
-	Status        string
+	ID                string
+	CreatedAt         string
+	UpdatedAt         string
+	FullName          string
+	Email             string
+	Phone             string
+	FullAddress       string
+	Message           string
+	PaymentMethod     string
+	Status            string
+	Locale            string
+	TrackingNumber    string
+	TrackingURL       string
+	PaymentRemindedAt string
 }{
-	ID:            "id",
-	CreatedAt:     "created_at",
-	UpdatedAt:     "updated_at",
-	FullName:      "full_name",
-	Email:         "email",
-	Phone:         "phone",
-	FullAddress:   "full_address",
-	Message:       "message",
-	PaymentMethod: "payment_method",
-	Status:        "status",
+	ID:                "id",
+	CreatedAt:         "created_at",
+	UpdatedAt:         "updated_at",
+	FullName:          "full_name",
+	Email:             "email",
+	Phone:             "phone",
+	FullAddress:       "full_address",
+	Message:           "message",
+	PaymentMethod:     "payment_method",
+	Status:            "status",
+	Locale:            "locale",
+	TrackingNumber:    "tracking_number",
+	TrackingURL:       "tracking_url",
+	PaymentRemindedAt: "payment_reminded_at",
 }
 
 // Generated where
 
 var OrderWhere = struct {
-	ID            whereHelperint
-	CreatedAt     whereHelpertime_Time
-	UpdatedAt     whereHelpertime_Time
-	FullName      whereHelperstring
-	Email         whereHelperstring
-	Phone         whereHelperstring
-	FullAddress   whereHelperstring
-	Message       whereHelperstring
-	PaymentMethod whereHelperstring
-	Status        whereHelperstring
+	ID                whereHelperint
+	CreatedAt         whereHelpertime_Time
+	UpdatedAt         whereHelpertime_Time
+	FullName          whereHelperstring
+	Email             whereHelperstring
+	Phone             whereHelperstring
+	FullAddress       whereHelperstring
+	Message           whereHelperstring
+	PaymentMethod     whereHelperstring
+	Status            whereHelperstring
+	Locale            whereHelperstring
+	TrackingNumber    whereHelperstring
+	TrackingURL       whereHelperstring
+	PaymentRemindedAt whereHelpernull_Time
 }{
-	ID:            whereHelperint{field: "\"shop\".\"orders\".\"id\""},
-	CreatedAt:     whereHelpertime_Time{field: "\"shop\".\"orders\".\"created_at\""},
-	UpdatedAt:     whereHelpertime_Time{field: "\"shop\".\"orders\".\"updated_at\""},
-	FullName:      whereHelperstring{field: "\"shop\".\"orders\".\"full_name\""},
-	Email:         whereHelperstring{field: "\"shop\".\"orders\".\"email\""},
-	Phone:         whereHelperstring{field: "\"shop\".\"orders\".\"phone\""},
-	FullAddress:   whereHelperstring{field: "\"shop\".\"orders\".\"full_address\""},
-	Message:       whereHelperstring{field: "\"shop\".\"orders\".\"message\""},
-	PaymentMethod: whereHelperstring{field: "\"shop\".\"orders\".\"payment_method\""},
-	Status:        whereHelperstring{field: "\"shop\".\"orders\".\"status\""},
+	ID:                whereHelperint{field: "\"shop\".\"orders\".\"id\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"shop\".\"orders\".\"created_at\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"shop\".\"orders\".\"updated_at\""},
+	FullName:          whereHelperstring{field: "\"shop\".\"orders\".\"full_name\""},
+	Email:             whereHelperstring{field: "\"shop\".\"orders\".\"email\""},
+	Phone:             whereHelperstring{field: "\"shop\".\"orders\".\"phone\""},
+	FullAddress:       whereHelperstring{field: "\"shop\".\"orders\".\"full_address\""},
+	Message:           whereHelperstring{field: "\"shop\".\"orders\".\"message\""},
+	PaymentMethod:     whereHelperstring{field: "\"shop\".\"orders\".\"payment_method\""},
+	Status:            whereHelperstring{field: "\"shop\".\"orders\".\"status\""},
+	Locale:            whereHelperstring{field: "\"shop\".\"orders\".\"locale\""},
+	TrackingNumber:    whereHelperstring{field: "\"shop\".\"orders\".\"tracking_number\""},
+	TrackingURL:       whereHelperstring{field: "\"shop\".\"orders\".\"tracking_url\""},
+	PaymentRemindedAt: whereHelpernull_Time{field: "\"shop\".\"orders\".\"payment_reminded_at\""},
 }
 
 // OrderRels is where relationship names are stored.
@@ -109,9 +130,9 @@ func (*orderR) NewStruct() *orderR {
 type orderL struct{}
 
 var (
-	orderAllColumns            = []string{"id", "created_at", "updated_at", "full_name", "email", "phone", "full_address", "message", "payment_method", "status"}
-	orderColumnsWithoutDefault = []string{"created_at", "updated_at", "full_name", "email", "phone", "full_address", "message", "payment_method"}
-	orderColumnsWithDefault    = []string{"id", "status"}
+	orderAllColumns            = []string{"id", "created_at", "updated_at", "full_name", "email", "phone", "full_address", "message", "payment_method", "status", "locale", "tracking_number", "tracking_url", "payment_reminded_at"}
+	orderColumnsWithoutDefault = []string{"created_at", "updated_at", "full_name", "email", "phone", "full_address", "message", "payment_method", "payment_reminded_at"}
+	orderColumnsWithDefault    = []string{"id", "status", "locale", "tracking_number", "tracking_url"}
 	orderPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -722,7 +722,7 @@ func testOrdersSelect(t *testing.T) {
 }
 
 var (
-	orderDBTypes = map[string]string{`ID`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `FullName`: `text`, `Email`: `text`, `Phone`: `text`, `FullAddress`: `text`, `Message`: `text`, `PaymentMethod`: `enum.payment('CASH_ON_DELIVERY','BANK_TRANSFER','ONLINE')`, `Status`: `enum.status('UNDEFINED','OPEN','SENT','COMPLETED')`}
+	orderDBTypes = map[string]string{`ID`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `FullName`: `text`, `Email`: `text`, `Phone`: `text`, `FullAddress`: `text`, `Message`: `text`, `PaymentMethod`: `enum.payment('CASH_ON_DELIVERY','BANK_TRANSFER','ONLINE')`, `Status`: `enum.status('UNDEFINED','OPEN','SENT','COMPLETED','CANCELLED')`, `Locale`: `text`, `TrackingNumber`: `text`, `TrackingURL`: `text`, `PaymentRemindedAt`: `timestamp with time zone`}
 	_            = bytes.MinRead
 )
 
@@ -6,14 +6,24 @@ package models
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("ArticleAttributes", testArticleAttributesUpsert)
+
+	t.Run("ArticleRevisions", testArticleRevisionsUpsert)
+
 	t.Run("Articles", testArticlesUpsert)
 
+	t.Run("Attributes", testAttributesUpsert)
+
 	t.Run("BasePrices", testBasePricesUpsert)
 
 	t.Run("Categories", testCategoriesUpsert)
 
 	t.Run("Images", testImagesUpsert)
 
+	t.Run("Mails", testMailsUpsert)
+
+	t.Run("MessageReplies", testMessageRepliesUpsert)
+
 	t.Run("Messages", testMessagesUpsert)
 
 	t.Run("OrderArticles", testOrderArticlesUpsert)
@@ -22,7 +32,15 @@ func TestUpsert(t *testing.T) {
 
 	t.Run("PaymentStatuses", testPaymentStatusesUpsert)
 
+	t.Run("SubscriberConsents", testSubscriberConsentsUpsert)
+
+	t.Run("Subscribers", testSubscribersUpsert)
+
 	t.Run("Variants", testVariantsUpsert)
 
 	t.Run("Videos", testVideosUpsert)
+
+	t.Run("WebhookDeliveries", testWebhookDeliveriesUpsert)
+
+	t.Run("Webhooks", testWebhooksUpsert)
 }
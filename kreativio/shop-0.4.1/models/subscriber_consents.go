@@ -0,0 +1,1076 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SubscriberConsent is an object representing the database table.
+type SubscriberConsent struct {
+	ID           int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	SubscriberID int       `boil:"subscriber_id" json:"subscriber_id" toml:"subscriber_id" yaml:"subscriber_id"`
+	Action       string    `boil:"action" json:"action" toml:"action" yaml:"action"`
+	Source       string    `boil:"source" json:"source" toml:"source" yaml:"source"`
+	ConsentText  string    `boil:"consent_text" json:"consent_text" toml:"consent_text" yaml:"consent_text"`
+	RemoteAddr   string    `boil:"remote_addr" json:"remote_addr" toml:"remote_addr" yaml:"remote_addr"`
+
+	R *subscriberConsentR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L subscriberConsentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SubscriberConsentColumns = struct {
+	ID           string
+	CreatedAt    string
+	SubscriberID string
+	Action       string
+	Source       string
+	ConsentText  string
+	RemoteAddr   string
+}{
+	ID:           "id",
+	CreatedAt:    "created_at",
+	SubscriberID: "subscriber_id",
+	Action:       "action",
+	Source:       "source",
+	ConsentText:  "consent_text",
+	RemoteAddr:   "remote_addr",
+}
+
+// Generated where
+
+var SubscriberConsentWhere = struct {
+	ID           whereHelperint
+	CreatedAt    whereHelpertime_Time
+	SubscriberID whereHelperint
+	Action       whereHelperstring
+	Source       whereHelperstring
+	ConsentText  whereHelperstring
+	RemoteAddr   whereHelperstring
+}{
+	ID:           whereHelperint{field: "\"shop\".\"subscriber_consents\".\"id\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"shop\".\"subscriber_consents\".\"created_at\""},
+	SubscriberID: whereHelperint{field: "\"shop\".\"subscriber_consents\".\"subscriber_id\""},
+	Action:       whereHelperstring{field: "\"shop\".\"subscriber_consents\".\"action\""},
+	Source:       whereHelperstring{field: "\"shop\".\"subscriber_consents\".\"source\""},
+	ConsentText:  whereHelperstring{field: "\"shop\".\"subscriber_consents\".\"consent_text\""},
+	RemoteAddr:   whereHelperstring{field: "\"shop\".\"subscriber_consents\".\"remote_addr\""},
+}
+
+// SubscriberConsentRels is where relationship names are stored.
+var SubscriberConsentRels = struct {
+	Subscriber string
+}{
+	Subscriber: "Subscriber",
+}
+
+// subscriberConsentR is where relationships are stored.
+type subscriberConsentR struct {
+	Subscriber *Subscriber `boil:"Subscriber" json:"Subscriber" toml:"Subscriber" yaml:"Subscriber"`
+}
+
+// NewStruct creates a new relationship struct
+func (*subscriberConsentR) NewStruct() *subscriberConsentR {
+	return &subscriberConsentR{}
+}
+
+// subscriberConsentL is where Load methods for each relationship are stored.
+type subscriberConsentL struct{}
+
+var (
+	subscriberConsentAllColumns            = []string{"id", "created_at", "subscriber_id", "action", "source", "consent_text", "remote_addr"}
+	subscriberConsentColumnsWithoutDefault = []string{"created_at", "subscriber_id", "action"}
+	subscriberConsentColumnsWithDefault    = []string{"id", "source", "consent_text", "remote_addr"}
+	subscriberConsentPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// SubscriberConsentSlice is an alias for a slice of pointers to SubscriberConsent.
+	// This should generally be used opposed to []SubscriberConsent.
+	SubscriberConsentSlice []*SubscriberConsent
+	// SubscriberConsentHook is the signature for custom SubscriberConsent hook methods
+	SubscriberConsentHook func(context.Context, boil.ContextExecutor, *SubscriberConsent) error
+
+	subscriberConsentQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	subscriberConsentType                 = reflect.TypeOf(&SubscriberConsent{})
+	subscriberConsentMapping              = queries.MakeStructMapping(subscriberConsentType)
+	subscriberConsentPrimaryKeyMapping, _ = queries.BindMapping(subscriberConsentType, subscriberConsentMapping, subscriberConsentPrimaryKeyColumns)
+	subscriberConsentInsertCacheMut       sync.RWMutex
+	subscriberConsentInsertCache          = make(map[string]insertCache)
+	subscriberConsentUpdateCacheMut       sync.RWMutex
+	subscriberConsentUpdateCache          = make(map[string]updateCache)
+	subscriberConsentUpsertCacheMut       sync.RWMutex
+	subscriberConsentUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var subscriberConsentBeforeInsertHooks []SubscriberConsentHook
+var subscriberConsentBeforeUpdateHooks []SubscriberConsentHook
+var subscriberConsentBeforeDeleteHooks []SubscriberConsentHook
+var subscriberConsentBeforeUpsertHooks []SubscriberConsentHook
+
+var subscriberConsentAfterInsertHooks []SubscriberConsentHook
+var subscriberConsentAfterSelectHooks []SubscriberConsentHook
+var subscriberConsentAfterUpdateHooks []SubscriberConsentHook
+var subscriberConsentAfterDeleteHooks []SubscriberConsentHook
+var subscriberConsentAfterUpsertHooks []SubscriberConsentHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SubscriberConsent) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberConsentBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SubscriberConsent) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberConsentBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SubscriberConsent) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberConsentBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SubscriberConsent) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberConsentBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SubscriberConsent) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberConsentAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SubscriberConsent) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberConsentAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SubscriberConsent) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberConsentAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SubscriberConsent) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberConsentAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SubscriberConsent) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberConsentAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSubscriberConsentHook registers your hook function for all future operations.
+func AddSubscriberConsentHook(hookPoint boil.HookPoint, subscriberConsentHook SubscriberConsentHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		subscriberConsentBeforeInsertHooks = append(subscriberConsentBeforeInsertHooks, subscriberConsentHook)
+	case boil.BeforeUpdateHook:
+		subscriberConsentBeforeUpdateHooks = append(subscriberConsentBeforeUpdateHooks, subscriberConsentHook)
+	case boil.BeforeDeleteHook:
+		subscriberConsentBeforeDeleteHooks = append(subscriberConsentBeforeDeleteHooks, subscriberConsentHook)
+	case boil.BeforeUpsertHook:
+		subscriberConsentBeforeUpsertHooks = append(subscriberConsentBeforeUpsertHooks, subscriberConsentHook)
+	case boil.AfterInsertHook:
+		subscriberConsentAfterInsertHooks = append(subscriberConsentAfterInsertHooks, subscriberConsentHook)
+	case boil.AfterSelectHook:
+		subscriberConsentAfterSelectHooks = append(subscriberConsentAfterSelectHooks, subscriberConsentHook)
+	case boil.AfterUpdateHook:
+		subscriberConsentAfterUpdateHooks = append(subscriberConsentAfterUpdateHooks, subscriberConsentHook)
+	case boil.AfterDeleteHook:
+		subscriberConsentAfterDeleteHooks = append(subscriberConsentAfterDeleteHooks, subscriberConsentHook)
+	case boil.AfterUpsertHook:
+		subscriberConsentAfterUpsertHooks = append(subscriberConsentAfterUpsertHooks, subscriberConsentHook)
+	}
+}
+
+// One returns a single subscriberConsent record from the query.
+func (q subscriberConsentQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SubscriberConsent, error) {
+	o := &SubscriberConsent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for subscriber_consents")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SubscriberConsent records from the query.
+func (q subscriberConsentQuery) All(ctx context.Context, exec boil.ContextExecutor) (SubscriberConsentSlice, error) {
+	var o []*SubscriberConsent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SubscriberConsent slice")
+	}
+
+	if len(subscriberConsentAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SubscriberConsent records in the query.
+func (q subscriberConsentQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count subscriber_consents rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q subscriberConsentQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if subscriber_consents exists")
+	}
+
+	return count > 0, nil
+}
+
+// Subscriber pointed to by the foreign key.
+func (o *SubscriberConsent) Subscriber(mods ...qm.QueryMod) subscriberQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SubscriberID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Subscribers(queryMods...)
+	queries.SetFrom(query.Query, "\"shop\".\"subscribers\"")
+
+	return query
+}
+
+// LoadSubscriber allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (subscriberConsentL) LoadSubscriber(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubscriberConsent interface{}, mods queries.Applicator) error {
+	var slice []*SubscriberConsent
+	var object *SubscriberConsent
+
+	if singular {
+		object = maybeSubscriberConsent.(*SubscriberConsent)
+	} else {
+		slice = *maybeSubscriberConsent.(*[]*SubscriberConsent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &subscriberConsentR{}
+		}
+		args = append(args, object.SubscriberID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &subscriberConsentR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SubscriberID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SubscriberID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`shop.subscribers`),
+		qm.WhereIn(`shop.subscribers.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Subscriber")
+	}
+
+	var resultSlice []*Subscriber
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Subscriber")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for subscribers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for subscribers")
+	}
+
+	if len(subscriberConsentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Subscriber = foreign
+		if foreign.R == nil {
+			foreign.R = &subscriberR{}
+		}
+		foreign.R.SubscriberConsents = append(foreign.R.SubscriberConsents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SubscriberID == foreign.ID {
+				local.R.Subscriber = foreign
+				if foreign.R == nil {
+					foreign.R = &subscriberR{}
+				}
+				foreign.R.SubscriberConsents = append(foreign.R.SubscriberConsents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSubscriber of the subscriberConsent to the related item.
+// Sets o.R.Subscriber to related.
+// Adds o to related.R.SubscriberConsents.
+func (o *SubscriberConsent) SetSubscriber(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Subscriber) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"shop\".\"subscriber_consents\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"subscriber_id"}),
+		strmangle.WhereClause("\"", "\"", 2, subscriberConsentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SubscriberID = related.ID
+	if o.R == nil {
+		o.R = &subscriberConsentR{
+			Subscriber: related,
+		}
+	} else {
+		o.R.Subscriber = related
+	}
+
+	if related.R == nil {
+		related.R = &subscriberR{
+			SubscriberConsents: SubscriberConsentSlice{o},
+		}
+	} else {
+		related.R.SubscriberConsents = append(related.R.SubscriberConsents, o)
+	}
+
+	return nil
+}
+
+// SubscriberConsents retrieves all the records using an executor.
+func SubscriberConsents(mods ...qm.QueryMod) subscriberConsentQuery {
+	mods = append(mods, qm.From("\"shop\".\"subscriber_consents\""))
+	return subscriberConsentQuery{NewQuery(mods...)}
+}
+
+// FindSubscriberConsent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSubscriberConsent(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*SubscriberConsent, error) {
+	subscriberConsentObj := &SubscriberConsent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"shop\".\"subscriber_consents\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, subscriberConsentObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from subscriber_consents")
+	}
+
+	return subscriberConsentObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SubscriberConsent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no subscriber_consents provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(subscriberConsentColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	subscriberConsentInsertCacheMut.RLock()
+	cache, cached := subscriberConsentInsertCache[key]
+	subscriberConsentInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			subscriberConsentAllColumns,
+			subscriberConsentColumnsWithDefault,
+			subscriberConsentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(subscriberConsentType, subscriberConsentMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(subscriberConsentType, subscriberConsentMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"shop\".\"subscriber_consents\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"shop\".\"subscriber_consents\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into subscriber_consents")
+	}
+
+	if !cached {
+		subscriberConsentInsertCacheMut.Lock()
+		subscriberConsentInsertCache[key] = cache
+		subscriberConsentInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the SubscriberConsent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SubscriberConsent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	subscriberConsentUpdateCacheMut.RLock()
+	cache, cached := subscriberConsentUpdateCache[key]
+	subscriberConsentUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			subscriberConsentAllColumns,
+			subscriberConsentPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update subscriber_consents, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"shop\".\"subscriber_consents\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, subscriberConsentPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(subscriberConsentType, subscriberConsentMapping, append(wl, subscriberConsentPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update subscriber_consents row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for subscriber_consents")
+	}
+
+	if !cached {
+		subscriberConsentUpdateCacheMut.Lock()
+		subscriberConsentUpdateCache[key] = cache
+		subscriberConsentUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q subscriberConsentQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for subscriber_consents")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for subscriber_consents")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SubscriberConsentSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), subscriberConsentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"shop\".\"subscriber_consents\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, subscriberConsentPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in subscriberConsent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all subscriberConsent")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SubscriberConsent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no subscriber_consents provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(subscriberConsentColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	subscriberConsentUpsertCacheMut.RLock()
+	cache, cached := subscriberConsentUpsertCache[key]
+	subscriberConsentUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			subscriberConsentAllColumns,
+			subscriberConsentColumnsWithDefault,
+			subscriberConsentColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			subscriberConsentAllColumns,
+			subscriberConsentPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert subscriber_consents, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(subscriberConsentPrimaryKeyColumns))
+			copy(conflict, subscriberConsentPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"shop\".\"subscriber_consents\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(subscriberConsentType, subscriberConsentMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(subscriberConsentType, subscriberConsentMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert subscriber_consents")
+	}
+
+	if !cached {
+		subscriberConsentUpsertCacheMut.Lock()
+		subscriberConsentUpsertCache[key] = cache
+		subscriberConsentUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single SubscriberConsent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SubscriberConsent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SubscriberConsent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), subscriberConsentPrimaryKeyMapping)
+	sql := "DELETE FROM \"shop\".\"subscriber_consents\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from subscriber_consents")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for subscriber_consents")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q subscriberConsentQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no subscriberConsentQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from subscriber_consents")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for subscriber_consents")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SubscriberConsentSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(subscriberConsentBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), subscriberConsentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"shop\".\"subscriber_consents\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, subscriberConsentPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from subscriberConsent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for subscriber_consents")
+	}
+
+	if len(subscriberConsentAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SubscriberConsent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSubscriberConsent(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SubscriberConsentSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SubscriberConsentSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), subscriberConsentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"shop\".\"subscriber_consents\".* FROM \"shop\".\"subscriber_consents\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, subscriberConsentPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SubscriberConsentSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SubscriberConsentExists checks if the SubscriberConsent row exists.
+func SubscriberConsentExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"shop\".\"subscriber_consents\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if subscriber_consents exists")
+	}
+
+	return exists, nil
+}
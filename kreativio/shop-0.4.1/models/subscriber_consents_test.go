@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testSubscriberConsents(t *testing.T) {
+	t.Parallel()
+
+	query := SubscriberConsents()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testSubscriberConsentsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SubscriberConsent{}
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, true, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SubscriberConsents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSubscriberConsentsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SubscriberConsent{}
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, true, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := SubscriberConsents().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SubscriberConsents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSubscriberConsentsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SubscriberConsent{}
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, true, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SubscriberConsentSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SubscriberConsents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSubscriberConsentsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SubscriberConsent{}
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, true, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := SubscriberConsentExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if SubscriberConsent exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected SubscriberConsentExists to return true, but got false.")
+	}
+}
+
+func testSubscriberConsentsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SubscriberConsent{}
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, true, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	subscriberConsentFound, err := FindSubscriberConsent(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if subscriberConsentFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testSubscriberConsentsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SubscriberConsent{}
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, true, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = SubscriberConsents().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSubscriberConsentsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SubscriberConsent{}
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, true, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := SubscriberConsents().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testSubscriberConsentsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	subscriberConsentOne := &SubscriberConsent{}
+	subscriberConsentTwo := &SubscriberConsent{}
+	if err = randomize.Struct(seed, subscriberConsentOne, subscriberConsentDBTypes, false, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, subscriberConsentTwo, subscriberConsentDBTypes, false, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = subscriberConsentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = subscriberConsentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SubscriberConsents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testSubscriberConsentsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	subscriberConsentOne := &SubscriberConsent{}
+	subscriberConsentTwo := &SubscriberConsent{}
+	if err = randomize.Struct(seed, subscriberConsentOne, subscriberConsentDBTypes, false, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, subscriberConsentTwo, subscriberConsentDBTypes, false, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = subscriberConsentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = subscriberConsentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SubscriberConsents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func subscriberConsentBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *SubscriberConsent) error {
+	*o = SubscriberConsent{}
+	return nil
+}
+
+func subscriberConsentAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *SubscriberConsent) error {
+	*o = SubscriberConsent{}
+	return nil
+}
+
+func subscriberConsentAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *SubscriberConsent) error {
+	*o = SubscriberConsent{}
+	return nil
+}
+
+func subscriberConsentBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SubscriberConsent) error {
+	*o = SubscriberConsent{}
+	return nil
+}
+
+func subscriberConsentAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SubscriberConsent) error {
+	*o = SubscriberConsent{}
+	return nil
+}
+
+func subscriberConsentBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SubscriberConsent) error {
+	*o = SubscriberConsent{}
+	return nil
+}
+
+func subscriberConsentAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SubscriberConsent) error {
+	*o = SubscriberConsent{}
+	return nil
+}
+
+func subscriberConsentBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SubscriberConsent) error {
+	*o = SubscriberConsent{}
+	return nil
+}
+
+func subscriberConsentAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SubscriberConsent) error {
+	*o = SubscriberConsent{}
+	return nil
+}
+
+func testSubscriberConsentsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &SubscriberConsent{}
+	o := &SubscriberConsent{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent object: %s", err)
+	}
+
+	AddSubscriberConsentHook(boil.BeforeInsertHook, subscriberConsentBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	subscriberConsentBeforeInsertHooks = []SubscriberConsentHook{}
+
+	AddSubscriberConsentHook(boil.AfterInsertHook, subscriberConsentAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	subscriberConsentAfterInsertHooks = []SubscriberConsentHook{}
+
+	AddSubscriberConsentHook(boil.AfterSelectHook, subscriberConsentAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	subscriberConsentAfterSelectHooks = []SubscriberConsentHook{}
+
+	AddSubscriberConsentHook(boil.BeforeUpdateHook, subscriberConsentBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	subscriberConsentBeforeUpdateHooks = []SubscriberConsentHook{}
+
+	AddSubscriberConsentHook(boil.AfterUpdateHook, subscriberConsentAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	subscriberConsentAfterUpdateHooks = []SubscriberConsentHook{}
+
+	AddSubscriberConsentHook(boil.BeforeDeleteHook, subscriberConsentBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	subscriberConsentBeforeDeleteHooks = []SubscriberConsentHook{}
+
+	AddSubscriberConsentHook(boil.AfterDeleteHook, subscriberConsentAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	subscriberConsentAfterDeleteHooks = []SubscriberConsentHook{}
+
+	AddSubscriberConsentHook(boil.BeforeUpsertHook, subscriberConsentBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	subscriberConsentBeforeUpsertHooks = []SubscriberConsentHook{}
+
+	AddSubscriberConsentHook(boil.AfterUpsertHook, subscriberConsentAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	subscriberConsentAfterUpsertHooks = []SubscriberConsentHook{}
+}
+
+func testSubscriberConsentsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SubscriberConsent{}
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, true, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SubscriberConsents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSubscriberConsentsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SubscriberConsent{}
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(subscriberConsentColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SubscriberConsents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSubscriberConsentToOneSubscriberUsingSubscriber(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local SubscriberConsent
+	var foreign Subscriber
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, subscriberConsentDBTypes, false, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, subscriberDBTypes, false, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.SubscriberID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Subscriber().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := SubscriberConsentSlice{&local}
+	if err = local.L.LoadSubscriber(ctx, tx, false, (*[]*SubscriberConsent)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Subscriber == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Subscriber = nil
+	if err = local.L.LoadSubscriber(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Subscriber == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testSubscriberConsentToOneSetOpSubscriberUsingSubscriber(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a SubscriberConsent
+	var b, c Subscriber
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, subscriberConsentDBTypes, false, strmangle.SetComplement(subscriberConsentPrimaryKeyColumns, subscriberConsentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, subscriberDBTypes, false, strmangle.SetComplement(subscriberPrimaryKeyColumns, subscriberColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, subscriberDBTypes, false, strmangle.SetComplement(subscriberPrimaryKeyColumns, subscriberColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Subscriber{&b, &c} {
+		err = a.SetSubscriber(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Subscriber != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SubscriberConsents[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.SubscriberID != x.ID {
+			t.Error("foreign key was wrong value", a.SubscriberID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SubscriberID))
+		reflect.Indirect(reflect.ValueOf(&a.SubscriberID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.SubscriberID != x.ID {
+			t.Error("foreign key was wrong value", a.SubscriberID, x.ID)
+		}
+	}
+}
+
+func testSubscriberConsentsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SubscriberConsent{}
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, true, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSubscriberConsentsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SubscriberConsent{}
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, true, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SubscriberConsentSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSubscriberConsentsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SubscriberConsent{}
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, true, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SubscriberConsents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	subscriberConsentDBTypes = map[string]string{`ID`: `integer`, `CreatedAt`: `timestamp with time zone`, `SubscriberID`: `integer`, `Action`: `text`, `Source`: `text`, `ConsentText`: `text`, `RemoteAddr`: `text`}
+	_                        = bytes.MinRead
+)
+
+func testSubscriberConsentsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(subscriberConsentPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(subscriberConsentAllColumns) == len(subscriberConsentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SubscriberConsent{}
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, true, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SubscriberConsents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, true, subscriberConsentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testSubscriberConsentsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(subscriberConsentAllColumns) == len(subscriberConsentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SubscriberConsent{}
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, true, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SubscriberConsents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, subscriberConsentDBTypes, true, subscriberConsentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(subscriberConsentAllColumns, subscriberConsentPrimaryKeyColumns) {
+		fields = subscriberConsentAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			subscriberConsentAllColumns,
+			subscriberConsentPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := SubscriberConsentSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testSubscriberConsentsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(subscriberConsentAllColumns) == len(subscriberConsentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := SubscriberConsent{}
+	if err = randomize.Struct(seed, &o, subscriberConsentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SubscriberConsent: %s", err)
+	}
+
+	count, err := SubscriberConsents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, subscriberConsentDBTypes, false, subscriberConsentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SubscriberConsent struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SubscriberConsent: %s", err)
+	}
+
+	count, err = SubscriberConsents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
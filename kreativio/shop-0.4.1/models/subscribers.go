@@ -0,0 +1,1104 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Subscriber is an object representing the database table.
+type Subscriber struct {
+	ID             int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Email          string    `boil:"email" json:"email" toml:"email" yaml:"email"`
+	Name           string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Locale         string    `boil:"locale" json:"locale" toml:"locale" yaml:"locale"`
+	Status         string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ConfirmedAt    null.Time `boil:"confirmed_at" json:"confirmed_at,omitempty" toml:"confirmed_at" yaml:"confirmed_at,omitempty"`
+	UnsubscribedAt null.Time `boil:"unsubscribed_at" json:"unsubscribed_at,omitempty" toml:"unsubscribed_at" yaml:"unsubscribed_at,omitempty"`
+
+	R *subscriberR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L subscriberL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SubscriberColumns = struct {
+	ID             string
+	CreatedAt      string
+	UpdatedAt      string
+	Email          string
+	Name           string
+	Locale         string
+	Status         string
+	ConfirmedAt    string
+	UnsubscribedAt string
+}{
+	ID:             "id",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+	Email:          "email",
+	Name:           "name",
+	Locale:         "locale",
+	Status:         "status",
+	ConfirmedAt:    "confirmed_at",
+	UnsubscribedAt: "unsubscribed_at",
+}
+
+// Generated where
+
+var SubscriberWhere = struct {
+	ID             whereHelperint
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+	Email          whereHelperstring
+	Name           whereHelperstring
+	Locale         whereHelperstring
+	Status         whereHelperstring
+	ConfirmedAt    whereHelpernull_Time
+	UnsubscribedAt whereHelpernull_Time
+}{
+	ID:             whereHelperint{field: "\"shop\".\"subscribers\".\"id\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"shop\".\"subscribers\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"shop\".\"subscribers\".\"updated_at\""},
+	Email:          whereHelperstring{field: "\"shop\".\"subscribers\".\"email\""},
+	Name:           whereHelperstring{field: "\"shop\".\"subscribers\".\"name\""},
+	Locale:         whereHelperstring{field: "\"shop\".\"subscribers\".\"locale\""},
+	Status:         whereHelperstring{field: "\"shop\".\"subscribers\".\"status\""},
+	ConfirmedAt:    whereHelpernull_Time{field: "\"shop\".\"subscribers\".\"confirmed_at\""},
+	UnsubscribedAt: whereHelpernull_Time{field: "\"shop\".\"subscribers\".\"unsubscribed_at\""},
+}
+
+// SubscriberRels is where relationship names are stored.
+var SubscriberRels = struct {
+	SubscriberConsents string
+}{
+	SubscriberConsents: "SubscriberConsents",
+}
+
+// subscriberR is where relationships are stored.
+type subscriberR struct {
+	SubscriberConsents SubscriberConsentSlice `boil:"SubscriberConsents" json:"SubscriberConsents" toml:"SubscriberConsents" yaml:"SubscriberConsents"`
+}
+
+// NewStruct creates a new relationship struct
+func (*subscriberR) NewStruct() *subscriberR {
+	return &subscriberR{}
+}
+
+// subscriberL is where Load methods for each relationship are stored.
+type subscriberL struct{}
+
+var (
+	subscriberAllColumns            = []string{"id", "created_at", "updated_at", "email", "name", "locale", "status", "confirmed_at", "unsubscribed_at"}
+	subscriberColumnsWithoutDefault = []string{"created_at", "updated_at", "email", "confirmed_at", "unsubscribed_at"}
+	subscriberColumnsWithDefault    = []string{"id", "name", "locale", "status"}
+	subscriberPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// SubscriberSlice is an alias for a slice of pointers to Subscriber.
+	// This should generally be used opposed to []Subscriber.
+	SubscriberSlice []*Subscriber
+	// SubscriberHook is the signature for custom Subscriber hook methods
+	SubscriberHook func(context.Context, boil.ContextExecutor, *Subscriber) error
+
+	subscriberQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	subscriberType                 = reflect.TypeOf(&Subscriber{})
+	subscriberMapping              = queries.MakeStructMapping(subscriberType)
+	subscriberPrimaryKeyMapping, _ = queries.BindMapping(subscriberType, subscriberMapping, subscriberPrimaryKeyColumns)
+	subscriberInsertCacheMut       sync.RWMutex
+	subscriberInsertCache          = make(map[string]insertCache)
+	subscriberUpdateCacheMut       sync.RWMutex
+	subscriberUpdateCache          = make(map[string]updateCache)
+	subscriberUpsertCacheMut       sync.RWMutex
+	subscriberUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var subscriberBeforeInsertHooks []SubscriberHook
+var subscriberBeforeUpdateHooks []SubscriberHook
+var subscriberBeforeDeleteHooks []SubscriberHook
+var subscriberBeforeUpsertHooks []SubscriberHook
+
+var subscriberAfterInsertHooks []SubscriberHook
+var subscriberAfterSelectHooks []SubscriberHook
+var subscriberAfterUpdateHooks []SubscriberHook
+var subscriberAfterDeleteHooks []SubscriberHook
+var subscriberAfterUpsertHooks []SubscriberHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Subscriber) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Subscriber) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Subscriber) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Subscriber) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Subscriber) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Subscriber) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Subscriber) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Subscriber) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Subscriber) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subscriberAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSubscriberHook registers your hook function for all future operations.
+func AddSubscriberHook(hookPoint boil.HookPoint, subscriberHook SubscriberHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		subscriberBeforeInsertHooks = append(subscriberBeforeInsertHooks, subscriberHook)
+	case boil.BeforeUpdateHook:
+		subscriberBeforeUpdateHooks = append(subscriberBeforeUpdateHooks, subscriberHook)
+	case boil.BeforeDeleteHook:
+		subscriberBeforeDeleteHooks = append(subscriberBeforeDeleteHooks, subscriberHook)
+	case boil.BeforeUpsertHook:
+		subscriberBeforeUpsertHooks = append(subscriberBeforeUpsertHooks, subscriberHook)
+	case boil.AfterInsertHook:
+		subscriberAfterInsertHooks = append(subscriberAfterInsertHooks, subscriberHook)
+	case boil.AfterSelectHook:
+		subscriberAfterSelectHooks = append(subscriberAfterSelectHooks, subscriberHook)
+	case boil.AfterUpdateHook:
+		subscriberAfterUpdateHooks = append(subscriberAfterUpdateHooks, subscriberHook)
+	case boil.AfterDeleteHook:
+		subscriberAfterDeleteHooks = append(subscriberAfterDeleteHooks, subscriberHook)
+	case boil.AfterUpsertHook:
+		subscriberAfterUpsertHooks = append(subscriberAfterUpsertHooks, subscriberHook)
+	}
+}
+
+// One returns a single subscriber record from the query.
+func (q subscriberQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Subscriber, error) {
+	o := &Subscriber{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for subscribers")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Subscriber records from the query.
+func (q subscriberQuery) All(ctx context.Context, exec boil.ContextExecutor) (SubscriberSlice, error) {
+	var o []*Subscriber
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Subscriber slice")
+	}
+
+	if len(subscriberAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Subscriber records in the query.
+func (q subscriberQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count subscribers rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q subscriberQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if subscribers exists")
+	}
+
+	return count > 0, nil
+}
+
+// SubscriberConsents retrieves all the subscriber_consent's SubscriberConsents with an executor.
+func (o *Subscriber) SubscriberConsents(mods ...qm.QueryMod) subscriberConsentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"shop\".\"subscriber_consents\".\"subscriber_id\"=?", o.ID),
+	)
+
+	query := SubscriberConsents(queryMods...)
+	queries.SetFrom(query.Query, "\"shop\".\"subscriber_consents\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"shop\".\"subscriber_consents\".*"})
+	}
+
+	return query
+}
+
+// LoadSubscriberConsents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (subscriberL) LoadSubscriberConsents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubscriber interface{}, mods queries.Applicator) error {
+	var slice []*Subscriber
+	var object *Subscriber
+
+	if singular {
+		object = maybeSubscriber.(*Subscriber)
+	} else {
+		slice = *maybeSubscriber.(*[]*Subscriber)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &subscriberR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &subscriberR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`shop.subscriber_consents`),
+		qm.WhereIn(`shop.subscriber_consents.subscriber_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load subscriber_consents")
+	}
+
+	var resultSlice []*SubscriberConsent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice subscriber_consents")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on subscriber_consents")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for subscriber_consents")
+	}
+
+	if len(subscriberConsentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SubscriberConsents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &subscriberConsentR{}
+			}
+			foreign.R.Subscriber = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SubscriberID {
+				local.R.SubscriberConsents = append(local.R.SubscriberConsents, foreign)
+				if foreign.R == nil {
+					foreign.R = &subscriberConsentR{}
+				}
+				foreign.R.Subscriber = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddSubscriberConsents adds the given related objects to the existing relationships
+// of the subscriber, optionally inserting them as new records.
+// Appends related to o.R.SubscriberConsents.
+// Sets related.R.Subscriber appropriately.
+func (o *Subscriber) AddSubscriberConsents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SubscriberConsent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SubscriberID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"shop\".\"subscriber_consents\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"subscriber_id"}),
+				strmangle.WhereClause("\"", "\"", 2, subscriberConsentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SubscriberID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &subscriberR{
+			SubscriberConsents: related,
+		}
+	} else {
+		o.R.SubscriberConsents = append(o.R.SubscriberConsents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &subscriberConsentR{
+				Subscriber: o,
+			}
+		} else {
+			rel.R.Subscriber = o
+		}
+	}
+	return nil
+}
+
+// Subscribers retrieves all the records using an executor.
+func Subscribers(mods ...qm.QueryMod) subscriberQuery {
+	mods = append(mods, qm.From("\"shop\".\"subscribers\""))
+	return subscriberQuery{NewQuery(mods...)}
+}
+
+// FindSubscriber retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSubscriber(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*Subscriber, error) {
+	subscriberObj := &Subscriber{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"shop\".\"subscribers\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, subscriberObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from subscribers")
+	}
+
+	return subscriberObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Subscriber) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no subscribers provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(subscriberColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	subscriberInsertCacheMut.RLock()
+	cache, cached := subscriberInsertCache[key]
+	subscriberInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			subscriberAllColumns,
+			subscriberColumnsWithDefault,
+			subscriberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(subscriberType, subscriberMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(subscriberType, subscriberMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"shop\".\"subscribers\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"shop\".\"subscribers\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into subscribers")
+	}
+
+	if !cached {
+		subscriberInsertCacheMut.Lock()
+		subscriberInsertCache[key] = cache
+		subscriberInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Subscriber.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Subscriber) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	subscriberUpdateCacheMut.RLock()
+	cache, cached := subscriberUpdateCache[key]
+	subscriberUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			subscriberAllColumns,
+			subscriberPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update subscribers, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"shop\".\"subscribers\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, subscriberPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(subscriberType, subscriberMapping, append(wl, subscriberPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update subscribers row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for subscribers")
+	}
+
+	if !cached {
+		subscriberUpdateCacheMut.Lock()
+		subscriberUpdateCache[key] = cache
+		subscriberUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q subscriberQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for subscribers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for subscribers")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SubscriberSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), subscriberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"shop\".\"subscribers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, subscriberPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in subscriber slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all subscriber")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Subscriber) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no subscribers provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(subscriberColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	subscriberUpsertCacheMut.RLock()
+	cache, cached := subscriberUpsertCache[key]
+	subscriberUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			subscriberAllColumns,
+			subscriberColumnsWithDefault,
+			subscriberColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			subscriberAllColumns,
+			subscriberPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert subscribers, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(subscriberPrimaryKeyColumns))
+			copy(conflict, subscriberPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"shop\".\"subscribers\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(subscriberType, subscriberMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(subscriberType, subscriberMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert subscribers")
+	}
+
+	if !cached {
+		subscriberUpsertCacheMut.Lock()
+		subscriberUpsertCache[key] = cache
+		subscriberUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Subscriber record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Subscriber) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Subscriber provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), subscriberPrimaryKeyMapping)
+	sql := "DELETE FROM \"shop\".\"subscribers\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from subscribers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for subscribers")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q subscriberQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no subscriberQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from subscribers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for subscribers")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SubscriberSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(subscriberBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), subscriberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"shop\".\"subscribers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, subscriberPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from subscriber slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for subscribers")
+	}
+
+	if len(subscriberAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Subscriber) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSubscriber(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SubscriberSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SubscriberSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), subscriberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"shop\".\"subscribers\".* FROM \"shop\".\"subscribers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, subscriberPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SubscriberSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SubscriberExists checks if the Subscriber row exists.
+func SubscriberExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"shop\".\"subscribers\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if subscribers exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,886 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testSubscribers(t *testing.T) {
+	t.Parallel()
+
+	query := Subscribers()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testSubscribersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscriber{}
+	if err = randomize.Struct(seed, o, subscriberDBTypes, true, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Subscribers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSubscribersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscriber{}
+	if err = randomize.Struct(seed, o, subscriberDBTypes, true, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Subscribers().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Subscribers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSubscribersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscriber{}
+	if err = randomize.Struct(seed, o, subscriberDBTypes, true, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SubscriberSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Subscribers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSubscribersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscriber{}
+	if err = randomize.Struct(seed, o, subscriberDBTypes, true, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := SubscriberExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Subscriber exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected SubscriberExists to return true, but got false.")
+	}
+}
+
+func testSubscribersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscriber{}
+	if err = randomize.Struct(seed, o, subscriberDBTypes, true, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	subscriberFound, err := FindSubscriber(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if subscriberFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testSubscribersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscriber{}
+	if err = randomize.Struct(seed, o, subscriberDBTypes, true, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Subscribers().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSubscribersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscriber{}
+	if err = randomize.Struct(seed, o, subscriberDBTypes, true, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Subscribers().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testSubscribersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	subscriberOne := &Subscriber{}
+	subscriberTwo := &Subscriber{}
+	if err = randomize.Struct(seed, subscriberOne, subscriberDBTypes, false, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+	if err = randomize.Struct(seed, subscriberTwo, subscriberDBTypes, false, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = subscriberOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = subscriberTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Subscribers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testSubscribersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	subscriberOne := &Subscriber{}
+	subscriberTwo := &Subscriber{}
+	if err = randomize.Struct(seed, subscriberOne, subscriberDBTypes, false, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+	if err = randomize.Struct(seed, subscriberTwo, subscriberDBTypes, false, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = subscriberOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = subscriberTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Subscribers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func subscriberBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Subscriber) error {
+	*o = Subscriber{}
+	return nil
+}
+
+func subscriberAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Subscriber) error {
+	*o = Subscriber{}
+	return nil
+}
+
+func subscriberAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Subscriber) error {
+	*o = Subscriber{}
+	return nil
+}
+
+func subscriberBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Subscriber) error {
+	*o = Subscriber{}
+	return nil
+}
+
+func subscriberAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Subscriber) error {
+	*o = Subscriber{}
+	return nil
+}
+
+func subscriberBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Subscriber) error {
+	*o = Subscriber{}
+	return nil
+}
+
+func subscriberAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Subscriber) error {
+	*o = Subscriber{}
+	return nil
+}
+
+func subscriberBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Subscriber) error {
+	*o = Subscriber{}
+	return nil
+}
+
+func subscriberAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Subscriber) error {
+	*o = Subscriber{}
+	return nil
+}
+
+func testSubscribersHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Subscriber{}
+	o := &Subscriber{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, subscriberDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Subscriber object: %s", err)
+	}
+
+	AddSubscriberHook(boil.BeforeInsertHook, subscriberBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	subscriberBeforeInsertHooks = []SubscriberHook{}
+
+	AddSubscriberHook(boil.AfterInsertHook, subscriberAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	subscriberAfterInsertHooks = []SubscriberHook{}
+
+	AddSubscriberHook(boil.AfterSelectHook, subscriberAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	subscriberAfterSelectHooks = []SubscriberHook{}
+
+	AddSubscriberHook(boil.BeforeUpdateHook, subscriberBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	subscriberBeforeUpdateHooks = []SubscriberHook{}
+
+	AddSubscriberHook(boil.AfterUpdateHook, subscriberAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	subscriberAfterUpdateHooks = []SubscriberHook{}
+
+	AddSubscriberHook(boil.BeforeDeleteHook, subscriberBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	subscriberBeforeDeleteHooks = []SubscriberHook{}
+
+	AddSubscriberHook(boil.AfterDeleteHook, subscriberAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	subscriberAfterDeleteHooks = []SubscriberHook{}
+
+	AddSubscriberHook(boil.BeforeUpsertHook, subscriberBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	subscriberBeforeUpsertHooks = []SubscriberHook{}
+
+	AddSubscriberHook(boil.AfterUpsertHook, subscriberAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	subscriberAfterUpsertHooks = []SubscriberHook{}
+}
+
+func testSubscribersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscriber{}
+	if err = randomize.Struct(seed, o, subscriberDBTypes, true, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Subscribers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSubscribersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscriber{}
+	if err = randomize.Struct(seed, o, subscriberDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(subscriberColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Subscribers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSubscriberToManySubscriberConsents(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Subscriber
+	var b, c SubscriberConsent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, subscriberDBTypes, true, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, subscriberConsentDBTypes, false, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, subscriberConsentDBTypes, false, subscriberConsentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SubscriberID = a.ID
+	c.SubscriberID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SubscriberConsents().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SubscriberID == b.SubscriberID {
+			bFound = true
+		}
+		if v.SubscriberID == c.SubscriberID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := SubscriberSlice{&a}
+	if err = a.L.LoadSubscriberConsents(ctx, tx, false, (*[]*Subscriber)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SubscriberConsents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SubscriberConsents = nil
+	if err = a.L.LoadSubscriberConsents(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SubscriberConsents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testSubscriberToManyAddOpSubscriberConsents(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Subscriber
+	var b, c, d, e SubscriberConsent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, subscriberDBTypes, false, strmangle.SetComplement(subscriberPrimaryKeyColumns, subscriberColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*SubscriberConsent{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, subscriberConsentDBTypes, false, strmangle.SetComplement(subscriberConsentPrimaryKeyColumns, subscriberConsentColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*SubscriberConsent{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSubscriberConsents(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SubscriberID {
+			t.Error("foreign key was wrong value", a.ID, first.SubscriberID)
+		}
+		if a.ID != second.SubscriberID {
+			t.Error("foreign key was wrong value", a.ID, second.SubscriberID)
+		}
+
+		if first.R.Subscriber != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Subscriber != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SubscriberConsents[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SubscriberConsents[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SubscriberConsents().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testSubscribersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscriber{}
+	if err = randomize.Struct(seed, o, subscriberDBTypes, true, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSubscribersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscriber{}
+	if err = randomize.Struct(seed, o, subscriberDBTypes, true, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SubscriberSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSubscribersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscriber{}
+	if err = randomize.Struct(seed, o, subscriberDBTypes, true, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Subscribers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	subscriberDBTypes = map[string]string{`ID`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `Email`: `text`, `Name`: `text`, `Locale`: `text`, `Status`: `enum.subscriber_status('PENDING','CONFIRMED','UNSUBSCRIBED')`, `ConfirmedAt`: `timestamp with time zone`, `UnsubscribedAt`: `timestamp with time zone`}
+	_                 = bytes.MinRead
+)
+
+func testSubscribersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(subscriberPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(subscriberAllColumns) == len(subscriberPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscriber{}
+	if err = randomize.Struct(seed, o, subscriberDBTypes, true, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Subscribers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, subscriberDBTypes, true, subscriberPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testSubscribersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(subscriberAllColumns) == len(subscriberPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscriber{}
+	if err = randomize.Struct(seed, o, subscriberDBTypes, true, subscriberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Subscribers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, subscriberDBTypes, true, subscriberPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(subscriberAllColumns, subscriberPrimaryKeyColumns) {
+		fields = subscriberAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			subscriberAllColumns,
+			subscriberPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := SubscriberSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testSubscribersUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(subscriberAllColumns) == len(subscriberPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Subscriber{}
+	if err = randomize.Struct(seed, &o, subscriberDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Subscriber: %s", err)
+	}
+
+	count, err := Subscribers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, subscriberDBTypes, false, subscriberPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Subscriber struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Subscriber: %s", err)
+	}
+
+	count, err = Subscribers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -30,6 +31,13 @@ type Variant struct {
 	ArticleID  int               `boil:"article_id" json:"article_id" toml:"article_id" yaml:"article_id"`
 	Labels     types.StringArray `boil:"labels" json:"labels" toml:"labels" yaml:"labels"`
 	Multiplier types.Decimal     `boil:"multiplier" json:"multiplier" toml:"multiplier" yaml:"multiplier"`
+	Sku        null.String       `boil:"sku" json:"sku,omitempty" toml:"sku" yaml:"sku,omitempty"`
+	Price      types.NullDecimal `boil:"price" json:"price,omitempty" toml:"price" yaml:"price,omitempty"`
+	Stock      null.Int          `boil:"stock" json:"stock,omitempty" toml:"stock" yaml:"stock,omitempty"`
+	Weight     types.NullDecimal `boil:"weight" json:"weight,omitempty" toml:"weight" yaml:"weight,omitempty"`
+	Images     types.StringArray `boil:"images" json:"images" toml:"images" yaml:"images"`
+	Position   int               `boil:"position" json:"position" toml:"position" yaml:"position"`
+	Archived   bool              `boil:"archived" json:"archived" toml:"archived" yaml:"archived"`
 
 	R *variantR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L variantL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -42,6 +50,13 @@ var VariantColumns = struct {
 	ArticleID  string
 	Labels     string
 	Multiplier string
+	Sku        string
+	Price      string
+	Stock      string
+	Weight     string
+	Images     string
+	Position   string
+	Archived   string
 }{
 	ID:         "id",
 	CreatedAt:  "created_at",
@@ -49,51 +64,37 @@ var VariantColumns = struct {
 	ArticleID:  "article_id",
 	Labels:     "labels",
 	Multiplier: "multiplier",
+	Sku:        "sku",
+	Price:      "price",
+	Stock:      "stock",
+	Weight:     "weight",
+	Images:     "images",
+	Position:   "position",
+	Archived:   "archived",
 }
 
 // Generated where
 
-type whereHelperint64 struct{ field string }
+type whereHelpernull_Int struct{ field string }
 
-func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-
-type whereHelpertypes_StringArray struct{ field string }
-
-func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
@@ -104,6 +105,13 @@ var VariantWhere = struct {
 	ArticleID  whereHelperint
 	Labels     whereHelpertypes_StringArray
 	Multiplier whereHelpertypes_Decimal
+	Sku        whereHelpernull_String
+	Price      whereHelpertypes_NullDecimal
+	Stock      whereHelpernull_Int
+	Weight     whereHelpertypes_NullDecimal
+	Images     whereHelpertypes_StringArray
+	Position   whereHelperint
+	Archived   whereHelperbool
 }{
 	ID:         whereHelperint64{field: "\"shop\".\"variants\".\"id\""},
 	CreatedAt:  whereHelpertime_Time{field: "\"shop\".\"variants\".\"created_at\""},
@@ -111,18 +119,28 @@ var VariantWhere = struct {
 	ArticleID:  whereHelperint{field: "\"shop\".\"variants\".\"article_id\""},
 	Labels:     whereHelpertypes_StringArray{field: "\"shop\".\"variants\".\"labels\""},
 	Multiplier: whereHelpertypes_Decimal{field: "\"shop\".\"variants\".\"multiplier\""},
+	Sku:        whereHelpernull_String{field: "\"shop\".\"variants\".\"sku\""},
+	Price:      whereHelpertypes_NullDecimal{field: "\"shop\".\"variants\".\"price\""},
+	Stock:      whereHelpernull_Int{field: "\"shop\".\"variants\".\"stock\""},
+	Weight:     whereHelpertypes_NullDecimal{field: "\"shop\".\"variants\".\"weight\""},
+	Images:     whereHelpertypes_StringArray{field: "\"shop\".\"variants\".\"images\""},
+	Position:   whereHelperint{field: "\"shop\".\"variants\".\"position\""},
+	Archived:   whereHelperbool{field: "\"shop\".\"variants\".\"archived\""},
 }
 
 // VariantRels is where relationship names are stored.
 var VariantRels = struct {
-	Article string
+	Article       string
+	OrderArticles string
 }{
-	Article: "Article",
+	Article:       "Article",
+	OrderArticles: "OrderArticles",
 }
 
 // variantR is where relationships are stored.
 type variantR struct {
-	Article *Article `boil:"Article" json:"Article" toml:"Article" yaml:"Article"`
+	Article       *Article          `boil:"Article" json:"Article" toml:"Article" yaml:"Article"`
+	OrderArticles OrderArticleSlice `boil:"OrderArticles" json:"OrderArticles" toml:"OrderArticles" yaml:"OrderArticles"`
 }
 
 // NewStruct creates a new relationship struct
@@ -134,9 +152,9 @@ func (*variantR) NewStruct() *variantR {
 type variantL struct{}
 
 var (
-	variantAllColumns            = []string{"id", "created_at", "updated_at", "article_id", "labels", "multiplier"}
-	variantColumnsWithoutDefault = []string{"created_at", "updated_at", "article_id", "labels", "multiplier"}
-	variantColumnsWithDefault    = []string{"id"}
+	variantAllColumns            = []string{"id", "created_at", "updated_at", "article_id", "labels", "multiplier", "sku", "price", "stock", "weight", "images", "position", "archived"}
+	variantColumnsWithoutDefault = []string{"created_at", "updated_at", "article_id", "labels", "multiplier", "sku", "price", "stock", "weight"}
+	variantColumnsWithDefault    = []string{"id", "images", "position", "archived"}
 	variantPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -429,6 +447,27 @@ func (o *Variant) Article(mods ...qm.QueryMod) articleQuery {
 	return query
 }
 
+// OrderArticles retrieves all the order_article's OrderArticles with an executor.
+func (o *Variant) OrderArticles(mods ...qm.QueryMod) orderArticleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"shop\".\"order_articles\".\"variant_id\"=?", o.ID),
+	)
+
+	query := OrderArticles(queryMods...)
+	queries.SetFrom(query.Query, "\"shop\".\"order_articles\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"shop\".\"order_articles\".*"})
+	}
+
+	return query
+}
+
 // LoadArticle allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (variantL) LoadArticle(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVariant interface{}, mods queries.Applicator) error {
@@ -533,6 +572,104 @@ func (variantL) LoadArticle(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
+// LoadOrderArticles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (variantL) LoadOrderArticles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVariant interface{}, mods queries.Applicator) error {
+	var slice []*Variant
+	var object *Variant
+
+	if singular {
+		object = maybeVariant.(*Variant)
+	} else {
+		slice = *maybeVariant.(*[]*Variant)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &variantR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &variantR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`shop.order_articles`),
+		qm.WhereIn(`shop.order_articles.variant_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load order_articles")
+	}
+
+	var resultSlice []*OrderArticle
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice order_articles")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on order_articles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for order_articles")
+	}
+
+	if len(orderArticleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OrderArticles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &orderArticleR{}
+			}
+			foreign.R.Variant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.VariantID) {
+				local.R.OrderArticles = append(local.R.OrderArticles, foreign)
+				if foreign.R == nil {
+					foreign.R = &orderArticleR{}
+				}
+				foreign.R.Variant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetArticle of the variant to the related item.
 // Sets o.R.Article to related.
 // Adds o to related.R.Variants.
@@ -580,6 +717,129 @@ func (o *Variant) SetArticle(ctx context.Context, exec boil.ContextExecutor, ins
 	return nil
 }
 
+// AddOrderArticles adds the given related objects to the existing relationships
+// of the variant, optionally inserting them as new records.
+// Appends related to o.R.OrderArticles.
+// Sets related.R.Variant appropriately.
+func (o *Variant) AddOrderArticles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrderArticle) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.VariantID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"shop\".\"order_articles\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"variant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, orderArticlePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.VariantID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &variantR{
+			OrderArticles: related,
+		}
+	} else {
+		o.R.OrderArticles = append(o.R.OrderArticles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &orderArticleR{
+				Variant: o,
+			}
+		} else {
+			rel.R.Variant = o
+		}
+	}
+	return nil
+}
+
+// SetOrderArticles removes all previously related items of the
+// variant replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Variant's OrderArticles accordingly.
+// Replaces o.R.OrderArticles with related.
+// Sets related.R.Variant's OrderArticles accordingly.
+func (o *Variant) SetOrderArticles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrderArticle) error {
+	query := "update \"shop\".\"order_articles\" set \"variant_id\" = null where \"variant_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.OrderArticles {
+			queries.SetScanner(&rel.VariantID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Variant = nil
+		}
+
+		o.R.OrderArticles = nil
+	}
+	return o.AddOrderArticles(ctx, exec, insert, related...)
+}
+
+// RemoveOrderArticles relationships from objects passed in.
+// Removes related items from R.OrderArticles (uses pointer comparison, removal does not keep order)
+// Sets related.R.Variant.
+func (o *Variant) RemoveOrderArticles(ctx context.Context, exec boil.ContextExecutor, related ...*OrderArticle) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.VariantID, nil)
+		if rel.R != nil {
+			rel.R.Variant = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("variant_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.OrderArticles {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.OrderArticles)
+			if ln > 1 && i < ln-1 {
+				o.R.OrderArticles[i] = o.R.OrderArticles[ln-1]
+			}
+			o.R.OrderArticles = o.R.OrderArticles[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Variants retrieves all the records using an executor.
 func Variants(mods ...qm.QueryMod) variantQuery {
 	mods = append(mods, qm.From("\"shop\".\"variants\""))
@@ -494,6 +494,334 @@ func testVariantsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testVariantToManyOrderArticles(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Variant
+	var b, c OrderArticle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, variantDBTypes, true, variantColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Variant struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, orderArticleDBTypes, false, orderArticleColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, orderArticleDBTypes, false, orderArticleColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.VariantID, a.ID)
+	queries.Assign(&c.VariantID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.OrderArticles().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.VariantID, b.VariantID) {
+			bFound = true
+		}
+		if queries.Equal(v.VariantID, c.VariantID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := VariantSlice{&a}
+	if err = a.L.LoadOrderArticles(ctx, tx, false, (*[]*Variant)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OrderArticles); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.OrderArticles = nil
+	if err = a.L.LoadOrderArticles(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OrderArticles); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testVariantToManyAddOpOrderArticles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Variant
+	var b, c, d, e OrderArticle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, variantDBTypes, false, strmangle.SetComplement(variantPrimaryKeyColumns, variantColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*OrderArticle{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, orderArticleDBTypes, false, strmangle.SetComplement(orderArticlePrimaryKeyColumns, orderArticleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*OrderArticle{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddOrderArticles(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.VariantID) {
+			t.Error("foreign key was wrong value", a.ID, first.VariantID)
+		}
+		if !queries.Equal(a.ID, second.VariantID) {
+			t.Error("foreign key was wrong value", a.ID, second.VariantID)
+		}
+
+		if first.R.Variant != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Variant != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.OrderArticles[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.OrderArticles[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.OrderArticles().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testVariantToManySetOpOrderArticles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Variant
+	var b, c, d, e OrderArticle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, variantDBTypes, false, strmangle.SetComplement(variantPrimaryKeyColumns, variantColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*OrderArticle{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, orderArticleDBTypes, false, strmangle.SetComplement(orderArticlePrimaryKeyColumns, orderArticleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetOrderArticles(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.OrderArticles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetOrderArticles(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.OrderArticles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.VariantID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.VariantID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.VariantID) {
+		t.Error("foreign key was wrong value", a.ID, d.VariantID)
+	}
+	if !queries.Equal(a.ID, e.VariantID) {
+		t.Error("foreign key was wrong value", a.ID, e.VariantID)
+	}
+
+	if b.R.Variant != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Variant != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Variant != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Variant != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.OrderArticles[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.OrderArticles[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testVariantToManyRemoveOpOrderArticles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Variant
+	var b, c, d, e OrderArticle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, variantDBTypes, false, strmangle.SetComplement(variantPrimaryKeyColumns, variantColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*OrderArticle{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, orderArticleDBTypes, false, strmangle.SetComplement(orderArticlePrimaryKeyColumns, orderArticleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddOrderArticles(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.OrderArticles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveOrderArticles(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.OrderArticles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.VariantID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.VariantID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Variant != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Variant != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Variant != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Variant != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.OrderArticles) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.OrderArticles[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.OrderArticles[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
 func testVariantToOneArticleUsingArticle(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -677,7 +1005,7 @@ func testVariantsSelect(t *testing.T) {
 }
 
 var (
-	variantDBTypes = map[string]string{`ID`: `bigint`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `ArticleID`: `integer`, `Labels`: `ARRAYtext`, `Multiplier`: `numeric`}
+	variantDBTypes = map[string]string{`ID`: `bigint`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `ArticleID`: `integer`, `Labels`: `ARRAYtext`, `Multiplier`: `numeric`, `Sku`: `text`, `Price`: `numeric`, `Stock`: `integer`, `Weight`: `numeric`, `Images`: `ARRAYtext`, `Position`: `integer`, `Archived`: `boolean`}
 	_              = bytes.MinRead
 )
 
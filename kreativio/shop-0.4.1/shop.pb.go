@@ -14,6 +14,7 @@ import (
 	context "context"
 	proto "github.com/golang/protobuf/proto"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -156,17 +157,27 @@ const (
 	VariantFields_VRT_UPDATED    VariantFields = 3
 	VariantFields_VRT_LABELS     VariantFields = 4
 	VariantFields_VRT_MULTIPLIER VariantFields = 5
+	VariantFields_VRT_SKU        VariantFields = 6
+	VariantFields_VRT_PRICE      VariantFields = 7
+	VariantFields_VRT_STOCK      VariantFields = 8
+	VariantFields_VRT_WEIGHT     VariantFields = 9
+	VariantFields_VRT_IMAGES     VariantFields = 10
 )
 
 // Enum value maps for VariantFields.
 var (
 	VariantFields_name = map[int32]string{
-		0: "VRT_ALL",
-		1: "VRT_ID",
-		2: "VRT_CREATED",
-		3: "VRT_UPDATED",
-		4: "VRT_LABELS",
-		5: "VRT_MULTIPLIER",
+		0:  "VRT_ALL",
+		1:  "VRT_ID",
+		2:  "VRT_CREATED",
+		3:  "VRT_UPDATED",
+		4:  "VRT_LABELS",
+		5:  "VRT_MULTIPLIER",
+		6:  "VRT_SKU",
+		7:  "VRT_PRICE",
+		8:  "VRT_STOCK",
+		9:  "VRT_WEIGHT",
+		10: "VRT_IMAGES",
 	}
 	VariantFields_value = map[string]int32{
 		"VRT_ALL":        0,
@@ -175,6 +186,11 @@ var (
 		"VRT_UPDATED":    3,
 		"VRT_LABELS":     4,
 		"VRT_MULTIPLIER": 5,
+		"VRT_SKU":        6,
+		"VRT_PRICE":      7,
+		"VRT_STOCK":      8,
+		"VRT_WEIGHT":     9,
+		"VRT_IMAGES":     10,
 	}
 )
 
@@ -205,19 +221,83 @@ func (VariantFields) EnumDescriptor() ([]byte, []int) {
 	return file_shop_proto_rawDescGZIP(), []int{2}
 }
 
+// AttributeValueFields maps fields to database columns for requests.
+type AttributeValueFields int32
+
+const (
+	AttributeValueFields_ATV_ALL          AttributeValueFields = 0
+	AttributeValueFields_ATV_ATTRIBUTE_ID AttributeValueFields = 1
+	AttributeValueFields_ATV_LABEL        AttributeValueFields = 2
+	AttributeValueFields_ATV_TYPE         AttributeValueFields = 3
+	AttributeValueFields_ATV_UNIT         AttributeValueFields = 4
+	AttributeValueFields_ATV_VALUE        AttributeValueFields = 5
+)
+
+// Enum value maps for AttributeValueFields.
+var (
+	AttributeValueFields_name = map[int32]string{
+		0: "ATV_ALL",
+		1: "ATV_ATTRIBUTE_ID",
+		2: "ATV_LABEL",
+		3: "ATV_TYPE",
+		4: "ATV_UNIT",
+		5: "ATV_VALUE",
+	}
+	AttributeValueFields_value = map[string]int32{
+		"ATV_ALL":          0,
+		"ATV_ATTRIBUTE_ID": 1,
+		"ATV_LABEL":        2,
+		"ATV_TYPE":         3,
+		"ATV_UNIT":         4,
+		"ATV_VALUE":        5,
+	}
+)
+
+func (x AttributeValueFields) Enum() *AttributeValueFields {
+	p := new(AttributeValueFields)
+	*p = x
+	return p
+}
+
+func (x AttributeValueFields) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributeValueFields) Descriptor() protoreflect.EnumDescriptor {
+	return file_shop_proto_enumTypes[3].Descriptor()
+}
+
+func (AttributeValueFields) Type() protoreflect.EnumType {
+	return &file_shop_proto_enumTypes[3]
+}
+
+func (x AttributeValueFields) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributeValueFields.Descriptor instead.
+func (AttributeValueFields) EnumDescriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{3}
+}
+
 // ArticleFields maps fields to database columns for requests.
 type ArticleFields int32
 
 const (
-	ArticleFields_ALL         ArticleFields = 0
-	ArticleFields_ID          ArticleFields = 1
-	ArticleFields_CREATED     ArticleFields = 2
-	ArticleFields_UPDATED     ArticleFields = 3
-	ArticleFields_PUBLISHED   ArticleFields = 4
-	ArticleFields_TITLE       ArticleFields = 5
-	ArticleFields_DESCRIPTION ArticleFields = 6
-	ArticleFields_PRICE       ArticleFields = 7
-	ArticleFields_PROMOTED    ArticleFields = 11
+	ArticleFields_ALL          ArticleFields = 0
+	ArticleFields_ID           ArticleFields = 1
+	ArticleFields_CREATED      ArticleFields = 2
+	ArticleFields_UPDATED      ArticleFields = 3
+	ArticleFields_PUBLISHED    ArticleFields = 4
+	ArticleFields_TITLE        ArticleFields = 5
+	ArticleFields_DESCRIPTION  ArticleFields = 6
+	ArticleFields_PRICE        ArticleFields = 7
+	ArticleFields_PROMOTED     ArticleFields = 11
+	ArticleFields_PUBLISH_AT   ArticleFields = 16
+	ArticleFields_UNPUBLISH_AT ArticleFields = 17
+	ArticleFields_SALE_PRICE   ArticleFields = 18
+	ArticleFields_SALE_START   ArticleFields = 19
+	ArticleFields_SALE_END     ArticleFields = 20
 )
 
 // Enum value maps for ArticleFields.
@@ -232,17 +312,27 @@ var (
 		6:  "DESCRIPTION",
 		7:  "PRICE",
 		11: "PROMOTED",
+		16: "PUBLISH_AT",
+		17: "UNPUBLISH_AT",
+		18: "SALE_PRICE",
+		19: "SALE_START",
+		20: "SALE_END",
 	}
 	ArticleFields_value = map[string]int32{
-		"ALL":         0,
-		"ID":          1,
-		"CREATED":     2,
-		"UPDATED":     3,
-		"PUBLISHED":   4,
-		"TITLE":       5,
-		"DESCRIPTION": 6,
-		"PRICE":       7,
-		"PROMOTED":    11,
+		"ALL":          0,
+		"ID":           1,
+		"CREATED":      2,
+		"UPDATED":      3,
+		"PUBLISHED":    4,
+		"TITLE":        5,
+		"DESCRIPTION":  6,
+		"PRICE":        7,
+		"PROMOTED":     11,
+		"PUBLISH_AT":   16,
+		"UNPUBLISH_AT": 17,
+		"SALE_PRICE":   18,
+		"SALE_START":   19,
+		"SALE_END":     20,
 	}
 )
 
@@ -257,11 +347,11 @@ func (x ArticleFields) String() string {
 }
 
 func (ArticleFields) Descriptor() protoreflect.EnumDescriptor {
-	return file_shop_proto_enumTypes[3].Descriptor()
+	return file_shop_proto_enumTypes[4].Descriptor()
 }
 
 func (ArticleFields) Type() protoreflect.EnumType {
-	return &file_shop_proto_enumTypes[3]
+	return &file_shop_proto_enumTypes[4]
 }
 
 func (x ArticleFields) Number() protoreflect.EnumNumber {
@@ -270,7 +360,7 @@ func (x ArticleFields) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ArticleFields.Descriptor instead.
 func (ArticleFields) EnumDescriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{3}
+	return file_shop_proto_rawDescGZIP(), []int{4}
 }
 
 type CategoryFields int32
@@ -312,11 +402,11 @@ func (x CategoryFields) String() string {
 }
 
 func (CategoryFields) Descriptor() protoreflect.EnumDescriptor {
-	return file_shop_proto_enumTypes[4].Descriptor()
+	return file_shop_proto_enumTypes[5].Descriptor()
 }
 
 func (CategoryFields) Type() protoreflect.EnumType {
-	return &file_shop_proto_enumTypes[4]
+	return &file_shop_proto_enumTypes[5]
 }
 
 func (x CategoryFields) Number() protoreflect.EnumNumber {
@@ -325,7 +415,7 @@ func (x CategoryFields) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CategoryFields.Descriptor instead.
 func (CategoryFields) EnumDescriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{4}
+	return file_shop_proto_rawDescGZIP(), []int{5}
 }
 
 type Order_PaymentMethod int32
@@ -361,11 +451,11 @@ func (x Order_PaymentMethod) String() string {
 }
 
 func (Order_PaymentMethod) Descriptor() protoreflect.EnumDescriptor {
-	return file_shop_proto_enumTypes[5].Descriptor()
+	return file_shop_proto_enumTypes[6].Descriptor()
 }
 
 func (Order_PaymentMethod) Type() protoreflect.EnumType {
-	return &file_shop_proto_enumTypes[5]
+	return &file_shop_proto_enumTypes[6]
 }
 
 func (x Order_PaymentMethod) Number() protoreflect.EnumNumber {
@@ -374,7 +464,7 @@ func (x Order_PaymentMethod) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Order_PaymentMethod.Descriptor instead.
 func (Order_PaymentMethod) EnumDescriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{13, 0}
+	return file_shop_proto_rawDescGZIP(), []int{23, 0}
 }
 
 type Order_Status int32
@@ -383,6 +473,7 @@ const (
 	Order_OPEN      Order_Status = 0
 	Order_SENT      Order_Status = 1
 	Order_COMPLETED Order_Status = 2
+	Order_CANCELLED Order_Status = 3
 )
 
 // Enum value maps for Order_Status.
@@ -391,11 +482,13 @@ var (
 		0: "OPEN",
 		1: "SENT",
 		2: "COMPLETED",
+		3: "CANCELLED",
 	}
 	Order_Status_value = map[string]int32{
 		"OPEN":      0,
 		"SENT":      1,
 		"COMPLETED": 2,
+		"CANCELLED": 3,
 	}
 )
 
@@ -410,11 +503,11 @@ func (x Order_Status) String() string {
 }
 
 func (Order_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_shop_proto_enumTypes[6].Descriptor()
+	return file_shop_proto_enumTypes[7].Descriptor()
 }
 
 func (Order_Status) Type() protoreflect.EnumType {
-	return &file_shop_proto_enumTypes[6]
+	return &file_shop_proto_enumTypes[7]
 }
 
 func (x Order_Status) Number() protoreflect.EnumNumber {
@@ -423,7 +516,7 @@ func (x Order_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Order_Status.Descriptor instead.
 func (Order_Status) EnumDescriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{13, 1}
+	return file_shop_proto_rawDescGZIP(), []int{23, 1}
 }
 
 type ListOrderConditions_Status int32
@@ -433,6 +526,7 @@ const (
 	ListOrderConditions_OPEN      ListOrderConditions_Status = 1
 	ListOrderConditions_SENT      ListOrderConditions_Status = 2
 	ListOrderConditions_COMPLETED ListOrderConditions_Status = 3
+	ListOrderConditions_CANCELLED ListOrderConditions_Status = 4
 )
 
 // Enum value maps for ListOrderConditions_Status.
@@ -442,12 +536,14 @@ var (
 		1: "OPEN",
 		2: "SENT",
 		3: "COMPLETED",
+		4: "CANCELLED",
 	}
 	ListOrderConditions_Status_value = map[string]int32{
 		"ANY":       0,
 		"OPEN":      1,
 		"SENT":      2,
 		"COMPLETED": 3,
+		"CANCELLED": 4,
 	}
 )
 
@@ -462,11 +558,11 @@ func (x ListOrderConditions_Status) String() string {
 }
 
 func (ListOrderConditions_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_shop_proto_enumTypes[7].Descriptor()
+	return file_shop_proto_enumTypes[8].Descriptor()
 }
 
 func (ListOrderConditions_Status) Type() protoreflect.EnumType {
-	return &file_shop_proto_enumTypes[7]
+	return &file_shop_proto_enumTypes[8]
 }
 
 func (x ListOrderConditions_Status) Number() protoreflect.EnumNumber {
@@ -475,7 +571,417 @@ func (x ListOrderConditions_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ListOrderConditions_Status.Descriptor instead.
 func (ListOrderConditions_Status) EnumDescriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{15, 0}
+	return file_shop_proto_rawDescGZIP(), []int{25, 0}
+}
+
+type ReportConditions_Interval int32
+
+const (
+	ReportConditions_DAY   ReportConditions_Interval = 0
+	ReportConditions_WEEK  ReportConditions_Interval = 1
+	ReportConditions_MONTH ReportConditions_Interval = 2
+)
+
+// Enum value maps for ReportConditions_Interval.
+var (
+	ReportConditions_Interval_name = map[int32]string{
+		0: "DAY",
+		1: "WEEK",
+		2: "MONTH",
+	}
+	ReportConditions_Interval_value = map[string]int32{
+		"DAY":   0,
+		"WEEK":  1,
+		"MONTH": 2,
+	}
+)
+
+func (x ReportConditions_Interval) Enum() *ReportConditions_Interval {
+	p := new(ReportConditions_Interval)
+	*p = x
+	return p
+}
+
+func (x ReportConditions_Interval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportConditions_Interval) Descriptor() protoreflect.EnumDescriptor {
+	return file_shop_proto_enumTypes[9].Descriptor()
+}
+
+func (ReportConditions_Interval) Type() protoreflect.EnumType {
+	return &file_shop_proto_enumTypes[9]
+}
+
+func (x ReportConditions_Interval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportConditions_Interval.Descriptor instead.
+func (ReportConditions_Interval) EnumDescriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{28, 0}
+}
+
+type ExportReportRequest_Report int32
+
+const (
+	ExportReportRequest_REVENUE      ExportReportRequest_Report = 0
+	ExportReportRequest_TOP_ARTICLES ExportReportRequest_Report = 1
+	ExportReportRequest_ORDERS       ExportReportRequest_Report = 2
+)
+
+// Enum value maps for ExportReportRequest_Report.
+var (
+	ExportReportRequest_Report_name = map[int32]string{
+		0: "REVENUE",
+		1: "TOP_ARTICLES",
+		2: "ORDERS",
+	}
+	ExportReportRequest_Report_value = map[string]int32{
+		"REVENUE":      0,
+		"TOP_ARTICLES": 1,
+		"ORDERS":       2,
+	}
+)
+
+func (x ExportReportRequest_Report) Enum() *ExportReportRequest_Report {
+	p := new(ExportReportRequest_Report)
+	*p = x
+	return p
+}
+
+func (x ExportReportRequest_Report) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportReportRequest_Report) Descriptor() protoreflect.EnumDescriptor {
+	return file_shop_proto_enumTypes[10].Descriptor()
+}
+
+func (ExportReportRequest_Report) Type() protoreflect.EnumType {
+	return &file_shop_proto_enumTypes[10]
+}
+
+func (x ExportReportRequest_Report) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportReportRequest_Report.Descriptor instead.
+func (ExportReportRequest_Report) EnumDescriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{32, 0}
+}
+
+type Attribute_Type int32
+
+const (
+	Attribute_TEXT    Attribute_Type = 0
+	Attribute_NUMBER  Attribute_Type = 1
+	Attribute_ENUM    Attribute_Type = 2
+	Attribute_BOOLEAN Attribute_Type = 3
+)
+
+// Enum value maps for Attribute_Type.
+var (
+	Attribute_Type_name = map[int32]string{
+		0: "TEXT",
+		1: "NUMBER",
+		2: "ENUM",
+		3: "BOOLEAN",
+	}
+	Attribute_Type_value = map[string]int32{
+		"TEXT":    0,
+		"NUMBER":  1,
+		"ENUM":    2,
+		"BOOLEAN": 3,
+	}
+)
+
+func (x Attribute_Type) Enum() *Attribute_Type {
+	p := new(Attribute_Type)
+	*p = x
+	return p
+}
+
+func (x Attribute_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Attribute_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_shop_proto_enumTypes[11].Descriptor()
+}
+
+func (Attribute_Type) Type() protoreflect.EnumType {
+	return &file_shop_proto_enumTypes[11]
+}
+
+func (x Attribute_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Attribute_Type.Descriptor instead.
+func (Attribute_Type) EnumDescriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{36, 0}
+}
+
+type MessageListConditions_Status int32
+
+const (
+	MessageListConditions_INBOX    MessageListConditions_Status = 0 // Not archived
+	MessageListConditions_UNREAD   MessageListConditions_Status = 1 // Not read and not archived
+	MessageListConditions_ARCHIVED MessageListConditions_Status = 2
+	MessageListConditions_ALL      MessageListConditions_Status = 3
+)
+
+// Enum value maps for MessageListConditions_Status.
+var (
+	MessageListConditions_Status_name = map[int32]string{
+		0: "INBOX",
+		1: "UNREAD",
+		2: "ARCHIVED",
+		3: "ALL",
+	}
+	MessageListConditions_Status_value = map[string]int32{
+		"INBOX":    0,
+		"UNREAD":   1,
+		"ARCHIVED": 2,
+		"ALL":      3,
+	}
+)
+
+func (x MessageListConditions_Status) Enum() *MessageListConditions_Status {
+	p := new(MessageListConditions_Status)
+	*p = x
+	return p
+}
+
+func (x MessageListConditions_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageListConditions_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_shop_proto_enumTypes[12].Descriptor()
+}
+
+func (MessageListConditions_Status) Type() protoreflect.EnumType {
+	return &file_shop_proto_enumTypes[12]
+}
+
+func (x MessageListConditions_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageListConditions_Status.Descriptor instead.
+func (MessageListConditions_Status) EnumDescriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{46, 0}
+}
+
+type Webhook_Event int32
+
+const (
+	Webhook_ORDER_CREATED        Webhook_Event = 0 // order.created, data: Order
+	Webhook_ORDER_STATUS_CHANGED Webhook_Event = 1 // order.status_changed, data: Order
+	Webhook_PAYMENT_CONFIRMED    Webhook_Event = 2 // payment.confirmed, data: Order
+	Webhook_ARTICLE_SAVED        Webhook_Event = 3 // article.saved, data: Article
+	Webhook_ARTICLE_DELETED      Webhook_Event = 4 // article.deleted, data: ArticleID
+	Webhook_MESSAGE_RECEIVED     Webhook_Event = 5 // message.received, data: Message
+)
+
+// Enum value maps for Webhook_Event.
+var (
+	Webhook_Event_name = map[int32]string{
+		0: "ORDER_CREATED",
+		1: "ORDER_STATUS_CHANGED",
+		2: "PAYMENT_CONFIRMED",
+		3: "ARTICLE_SAVED",
+		4: "ARTICLE_DELETED",
+		5: "MESSAGE_RECEIVED",
+	}
+	Webhook_Event_value = map[string]int32{
+		"ORDER_CREATED":        0,
+		"ORDER_STATUS_CHANGED": 1,
+		"PAYMENT_CONFIRMED":    2,
+		"ARTICLE_SAVED":        3,
+		"ARTICLE_DELETED":      4,
+		"MESSAGE_RECEIVED":     5,
+	}
+)
+
+func (x Webhook_Event) Enum() *Webhook_Event {
+	p := new(Webhook_Event)
+	*p = x
+	return p
+}
+
+func (x Webhook_Event) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Webhook_Event) Descriptor() protoreflect.EnumDescriptor {
+	return file_shop_proto_enumTypes[13].Descriptor()
+}
+
+func (Webhook_Event) Type() protoreflect.EnumType {
+	return &file_shop_proto_enumTypes[13]
+}
+
+func (x Webhook_Event) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Webhook_Event.Descriptor instead.
+func (Webhook_Event) EnumDescriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{48, 0}
+}
+
+type Mail_Status int32
+
+const (
+	Mail_PENDING Mail_Status = 0
+	Mail_SENT    Mail_Status = 1
+	Mail_FAILED  Mail_Status = 2 // Max attempts reached
+)
+
+// Enum value maps for Mail_Status.
+var (
+	Mail_Status_name = map[int32]string{
+		0: "PENDING",
+		1: "SENT",
+		2: "FAILED",
+	}
+	Mail_Status_value = map[string]int32{
+		"PENDING": 0,
+		"SENT":    1,
+		"FAILED":  2,
+	}
+)
+
+func (x Mail_Status) Enum() *Mail_Status {
+	p := new(Mail_Status)
+	*p = x
+	return p
+}
+
+func (x Mail_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Mail_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_shop_proto_enumTypes[14].Descriptor()
+}
+
+func (Mail_Status) Type() protoreflect.EnumType {
+	return &file_shop_proto_enumTypes[14]
+}
+
+func (x Mail_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Mail_Status.Descriptor instead.
+func (Mail_Status) EnumDescriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{52, 0}
+}
+
+type MailListConditions_Status int32
+
+const (
+	MailListConditions_ANY     MailListConditions_Status = 0
+	MailListConditions_PENDING MailListConditions_Status = 1
+	MailListConditions_SENT    MailListConditions_Status = 2
+	MailListConditions_FAILED  MailListConditions_Status = 3
+)
+
+// Enum value maps for MailListConditions_Status.
+var (
+	MailListConditions_Status_name = map[int32]string{
+		0: "ANY",
+		1: "PENDING",
+		2: "SENT",
+		3: "FAILED",
+	}
+	MailListConditions_Status_value = map[string]int32{
+		"ANY":     0,
+		"PENDING": 1,
+		"SENT":    2,
+		"FAILED":  3,
+	}
+)
+
+func (x MailListConditions_Status) Enum() *MailListConditions_Status {
+	p := new(MailListConditions_Status)
+	*p = x
+	return p
+}
+
+func (x MailListConditions_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MailListConditions_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_shop_proto_enumTypes[15].Descriptor()
+}
+
+func (MailListConditions_Status) Type() protoreflect.EnumType {
+	return &file_shop_proto_enumTypes[15]
+}
+
+func (x MailListConditions_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MailListConditions_Status.Descriptor instead.
+func (MailListConditions_Status) EnumDescriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{54, 0}
+}
+
+type Subscriber_Status int32
+
+const (
+	Subscriber_PENDING      Subscriber_Status = 0
+	Subscriber_CONFIRMED    Subscriber_Status = 1
+	Subscriber_UNSUBSCRIBED Subscriber_Status = 2
+)
+
+// Enum value maps for Subscriber_Status.
+var (
+	Subscriber_Status_name = map[int32]string{
+		0: "PENDING",
+		1: "CONFIRMED",
+		2: "UNSUBSCRIBED",
+	}
+	Subscriber_Status_value = map[string]int32{
+		"PENDING":      0,
+		"CONFIRMED":    1,
+		"UNSUBSCRIBED": 2,
+	}
+)
+
+func (x Subscriber_Status) Enum() *Subscriber_Status {
+	p := new(Subscriber_Status)
+	*p = x
+	return p
+}
+
+func (x Subscriber_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Subscriber_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_shop_proto_enumTypes[16].Descriptor()
+}
+
+func (Subscriber_Status) Type() protoreflect.EnumType {
+	return &file_shop_proto_enumTypes[16]
+}
+
+func (x Subscriber_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Subscriber_Status.Descriptor instead.
+func (Subscriber_Status) EnumDescriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{59, 0}
 }
 
 type ArticleID struct {
@@ -483,8 +989,9 @@ type ArticleID struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // Delete article requirement
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // Delete and restore article requirement
 }
 
 func (x *ArticleID) Reset() {
@@ -526,6 +1033,7 @@ func (x *ArticleID) GetId() int32 {
 	return 0
 }
 
+// Deprecated: Do not use.
 func (x *ArticleID) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -607,7 +1115,8 @@ type BasePrice struct {
 	Updated *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"` // Read only
 	Label   string               `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
 	Price   string               `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"` // numeric
-	Token   string               `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"` // Admin write access requirement
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"` // Admin write access requirement
 }
 
 func (x *BasePrice) Reset() {
@@ -677,6 +1186,7 @@ func (x *BasePrice) GetPrice() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (x *BasePrice) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -770,17 +1280,30 @@ func (x *BasePriceList) GetList() []*BasePrice {
 }
 
 // Variant is a price variation to an article.
+// Variant of an article, such as size or color.
+// Variants are updated in place by ID, so that order lines keep a valid reference.
+// Variants which are no longer present on a saved article are archived when
+// they are referenced by an order, or deleted otherwise.
 type Variant struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id      int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`          // Upsert identification, do not modify. Leave 0 for new variants.
 	Created *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"` // Read only
 	Updated *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"` // Read only
 	// Labels allow for multi-dimensional price variations.
-	Labels     []string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty"`
-	Multiplier string   `protobuf:"bytes,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"` // numeric
+	// Labels must be unique amongst the variants of one article.
+	Labels []string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty"`
+	// numeric, multiplies the selected base price,
+	// or the article price if the article has no base prices.
+	Multiplier string   `protobuf:"bytes,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	Sku        string   `protobuf:"bytes,6,opt,name=sku,proto3" json:"sku,omitempty"`                                  // Optional stock keeping unit, unique over all variants.
+	Price      string   `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`                              // Optional numeric absolute price, which takes precedence over the multiplier.
+	TrackStock bool     `protobuf:"varint,8,opt,name=track_stock,json=trackStock,proto3" json:"track_stock,omitempty"` // Stock is only tracked and enforced when set.
+	Stock      int32    `protobuf:"varint,9,opt,name=stock,proto3" json:"stock,omitempty"`                             // Available amount, decremented on Checkout.
+	Weight     string   `protobuf:"bytes,10,opt,name=weight,proto3" json:"weight,omitempty"`                           // Optional numeric weight in kg.
+	Images     []string `protobuf:"bytes,11,rep,name=images,proto3" json:"images,omitempty"`                           // Image URLs, typically referencing the article's images.
 }
 
 func (x *Variant) Reset() {
@@ -850,11 +1373,53 @@ func (x *Variant) GetMultiplier() string {
 	return ""
 }
 
-type Details struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+func (x *Variant) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Variant) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *Variant) GetTrackStock() bool {
+	if x != nil {
+		return x.TrackStock
+	}
+	return false
+}
+
+func (x *Variant) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *Variant) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
+func (x *Variant) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type Details struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
 	BasePrice *BasePrice `protobuf:"bytes,1,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
 	Variant   *Variant   `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
 }
@@ -905,6 +1470,89 @@ func (x *Details) GetVariant() *Variant {
 	return nil
 }
 
+// AttributeValue is the value of an attribute on an article.
+type AttributeValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttributeId int32          `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	Label       string         `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`                         // Read only
+	Type        Attribute_Type `protobuf:"varint,3,opt,name=type,proto3,enum=shop.Attribute_Type" json:"type,omitempty"` // Read only
+	Unit        string         `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`                           // Read only
+	// Value in its string representation.
+	// NUMBER values are decimals, BOOLEAN values are "true" or "false"
+	// and ENUM values must be one of the attribute's options.
+	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AttributeValue) Reset() {
+	*x = AttributeValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValue) ProtoMessage() {}
+
+func (x *AttributeValue) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValue.ProtoReflect.Descriptor instead.
+func (*AttributeValue) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AttributeValue) GetAttributeId() int32 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *AttributeValue) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AttributeValue) GetType() Attribute_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Attribute_TEXT
+}
+
+func (x *AttributeValue) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *AttributeValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type Article struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -919,17 +1567,31 @@ type Article struct {
 	Price       string               `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
 	Images      []*Media             `protobuf:"bytes,8,rep,name=images,proto3" json:"images,omitempty"`
 	Videos      []*Media             `protobuf:"bytes,9,rep,name=videos,proto3" json:"videos,omitempty"`
-	Token       string               `protobuf:"bytes,10,opt,name=token,proto3" json:"token,omitempty"` // Write only
-	Promoted    bool                 `protobuf:"varint,11,opt,name=promoted,proto3" json:"promoted,omitempty"`
-	Categories  []*Category          `protobuf:"bytes,12,rep,name=categories,proto3" json:"categories,omitempty"`
-	Baseprices  []*BasePrice         `protobuf:"bytes,13,rep,name=baseprices,proto3" json:"baseprices,omitempty"`
-	Variants    []*Variant           `protobuf:"bytes,14,rep,name=variants,proto3" json:"variants,omitempty"`
+	// Deprecated: Do not use.
+	Token      string            `protobuf:"bytes,10,opt,name=token,proto3" json:"token,omitempty"` // Write only
+	Promoted   bool              `protobuf:"varint,11,opt,name=promoted,proto3" json:"promoted,omitempty"`
+	Categories []*Category       `protobuf:"bytes,12,rep,name=categories,proto3" json:"categories,omitempty"`
+	Baseprices []*BasePrice      `protobuf:"bytes,13,rep,name=baseprices,proto3" json:"baseprices,omitempty"`
+	Variants   []*Variant        `protobuf:"bytes,14,rep,name=variants,proto3" json:"variants,omitempty"`
+	Attributes []*AttributeValue `protobuf:"bytes,15,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	// Scheduled publishing. A published article is only live
+	// from publish_at and until unpublish_at, when set.
+	PublishAt   *timestamp.Timestamp `protobuf:"bytes,16,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	UnpublishAt *timestamp.Timestamp `protobuf:"bytes,17,opt,name=unpublish_at,json=unpublishAt,proto3" json:"unpublish_at,omitempty"`
+	// Scheduled sale price, replacing price from sale_start until sale_end.
+	// Missing start or end leave the sale open at that side.
+	// Not allowed on articles with baseprices or variant prices.
+	SalePrice    string               `protobuf:"bytes,18,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
+	SaleStart    *timestamp.Timestamp `protobuf:"bytes,19,opt,name=sale_start,json=saleStart,proto3" json:"sale_start,omitempty"`
+	SaleEnd      *timestamp.Timestamp `protobuf:"bytes,20,opt,name=sale_end,json=saleEnd,proto3" json:"sale_end,omitempty"`
+	CurrentPrice string               `protobuf:"bytes,21,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"` // Read only; the active sale price or price, when selected.
+	Deleted      *timestamp.Timestamp `protobuf:"bytes,22,opt,name=deleted,proto3" json:"deleted,omitempty"`                               // Read only; only set on deleted articles.
 }
 
 func (x *Article) Reset() {
 	*x = Article{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[7]
+		mi := &file_shop_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -942,7 +1604,7 @@ func (x *Article) String() string {
 func (*Article) ProtoMessage() {}
 
 func (x *Article) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[7]
+	mi := &file_shop_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,7 +1617,7 @@ func (x *Article) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Article.ProtoReflect.Descriptor instead.
 func (*Article) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{7}
+	return file_shop_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Article) GetId() int32 {
@@ -1021,6 +1683,7 @@ func (x *Article) GetVideos() []*Media {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (x *Article) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -1056,6 +1719,62 @@ func (x *Article) GetVariants() []*Variant {
 	return nil
 }
 
+func (x *Article) GetAttributes() []*AttributeValue {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Article) GetPublishAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *Article) GetUnpublishAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UnpublishAt
+	}
+	return nil
+}
+
+func (x *Article) GetSalePrice() string {
+	if x != nil {
+		return x.SalePrice
+	}
+	return ""
+}
+
+func (x *Article) GetSaleStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.SaleStart
+	}
+	return nil
+}
+
+func (x *Article) GetSaleEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.SaleEnd
+	}
+	return nil
+}
+
+func (x *Article) GetCurrentPrice() string {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return ""
+}
+
+func (x *Article) GetDeleted() *timestamp.Timestamp {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
 // ArticleRelations specify which relations should be loaded.
 // Each relation in this message is an array of fields.
 // So for each specified relation, the requested fields will be selected.
@@ -1066,17 +1785,18 @@ type ArticleRelations struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Images     []MediaFields     `protobuf:"varint,1,rep,packed,name=images,proto3,enum=shop.MediaFields" json:"images,omitempty"`
-	Videos     []MediaFields     `protobuf:"varint,2,rep,packed,name=videos,proto3,enum=shop.MediaFields" json:"videos,omitempty"`
-	Categories []CategoryFields  `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=shop.CategoryFields" json:"categories,omitempty"`
-	Baseprices []BasePriceFields `protobuf:"varint,4,rep,packed,name=baseprices,proto3,enum=shop.BasePriceFields" json:"baseprices,omitempty"`
-	Variants   []VariantFields   `protobuf:"varint,5,rep,packed,name=variants,proto3,enum=shop.VariantFields" json:"variants,omitempty"`
+	Images     []MediaFields          `protobuf:"varint,1,rep,packed,name=images,proto3,enum=shop.MediaFields" json:"images,omitempty"`
+	Videos     []MediaFields          `protobuf:"varint,2,rep,packed,name=videos,proto3,enum=shop.MediaFields" json:"videos,omitempty"`
+	Categories []CategoryFields       `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=shop.CategoryFields" json:"categories,omitempty"`
+	Baseprices []BasePriceFields      `protobuf:"varint,4,rep,packed,name=baseprices,proto3,enum=shop.BasePriceFields" json:"baseprices,omitempty"`
+	Variants   []VariantFields        `protobuf:"varint,5,rep,packed,name=variants,proto3,enum=shop.VariantFields" json:"variants,omitempty"`
+	Attributes []AttributeValueFields `protobuf:"varint,6,rep,packed,name=attributes,proto3,enum=shop.AttributeValueFields" json:"attributes,omitempty"`
 }
 
 func (x *ArticleRelations) Reset() {
 	*x = ArticleRelations{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[8]
+		mi := &file_shop_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1089,7 +1809,7 @@ func (x *ArticleRelations) String() string {
 func (*ArticleRelations) ProtoMessage() {}
 
 func (x *ArticleRelations) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[8]
+	mi := &file_shop_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1102,7 +1822,7 @@ func (x *ArticleRelations) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArticleRelations.ProtoReflect.Descriptor instead.
 func (*ArticleRelations) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{8}
+	return file_shop_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ArticleRelations) GetImages() []MediaFields {
@@ -1140,6 +1860,13 @@ func (x *ArticleRelations) GetVariants() []VariantFields {
 	return nil
 }
 
+func (x *ArticleRelations) GetAttributes() []AttributeValueFields {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 // Limits sets limit and offset to any list query
 // If the limit field is set to zero,
 // the server can apply a default limit.
@@ -1155,7 +1882,7 @@ type Limits struct {
 func (x *Limits) Reset() {
 	*x = Limits{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[9]
+		mi := &file_shop_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1168,7 +1895,7 @@ func (x *Limits) String() string {
 func (*Limits) ProtoMessage() {}
 
 func (x *Limits) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[9]
+	mi := &file_shop_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,7 +1908,7 @@ func (x *Limits) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Limits.ProtoReflect.Descriptor instead.
 func (*Limits) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{9}
+	return file_shop_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Limits) GetLimit() int32 {
@@ -1201,10 +1928,12 @@ func (x *Limits) GetOffset() int32 {
 // ListConditions are the conditions against which the article list is filtered.
 // The conitions are aplied as AND.
 // For example:
-//   &ListCondions{
-//      OnlyPublished: true,
-//      OnlyCategoryLabel: "foo",
-//   }
+//
+//	&ListCondions{
+//	   OnlyPublished: true,
+//	   OnlyCategoryLabel: "foo",
+//	}
+//
 // Will result in articles that are published and have a category label of "foo".
 //
 // Conditions are ignored when in their default value.
@@ -1214,19 +1943,20 @@ type ListConditions struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OnlyPublished     bool              `protobuf:"varint,1,opt,name=only_published,json=onlyPublished,proto3" json:"only_published,omitempty"`
-	OnlyPromoted      bool              `protobuf:"varint,2,opt,name=only_promoted,json=onlyPromoted,proto3" json:"only_promoted,omitempty"`
-	OnlyCategoryId    int32             `protobuf:"varint,4,opt,name=only_category_id,json=onlyCategoryId,proto3" json:"only_category_id,omitempty"`
-	OnlyCategoryLabel string            `protobuf:"bytes,5,opt,name=only_category_label,json=onlyCategoryLabel,proto3" json:"only_category_label,omitempty"`
-	Fields            []ArticleFields   `protobuf:"varint,6,rep,packed,name=fields,proto3,enum=shop.ArticleFields" json:"fields,omitempty"` // Which columns to select. Empty selects all columns.
-	Relations         *ArticleRelations `protobuf:"bytes,7,opt,name=relations,proto3" json:"relations,omitempty"`                           // Which relations to load. Empty will load only images.
-	Limits            *Limits           `protobuf:"bytes,8,opt,name=limits,proto3" json:"limits,omitempty"`
+	OnlyPublished     bool               `protobuf:"varint,1,opt,name=only_published,json=onlyPublished,proto3" json:"only_published,omitempty"` // Only live articles, honouring publish_at and unpublish_at.
+	OnlyPromoted      bool               `protobuf:"varint,2,opt,name=only_promoted,json=onlyPromoted,proto3" json:"only_promoted,omitempty"`
+	OnlyCategoryId    int32              `protobuf:"varint,4,opt,name=only_category_id,json=onlyCategoryId,proto3" json:"only_category_id,omitempty"`
+	OnlyCategoryLabel string             `protobuf:"bytes,5,opt,name=only_category_label,json=onlyCategoryLabel,proto3" json:"only_category_label,omitempty"`
+	Fields            []ArticleFields    `protobuf:"varint,6,rep,packed,name=fields,proto3,enum=shop.ArticleFields" json:"fields,omitempty"` // Which columns to select. Empty selects all columns.
+	Relations         *ArticleRelations  `protobuf:"bytes,7,opt,name=relations,proto3" json:"relations,omitempty"`                           // Which relations to load. Empty will load only images.
+	Limits            *Limits            `protobuf:"bytes,8,opt,name=limits,proto3" json:"limits,omitempty"`
+	Attributes        []*AttributeFilter `protobuf:"bytes,9,rep,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
 func (x *ListConditions) Reset() {
 	*x = ListConditions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[10]
+		mi := &file_shop_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1239,7 +1969,7 @@ func (x *ListConditions) String() string {
 func (*ListConditions) ProtoMessage() {}
 
 func (x *ListConditions) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[10]
+	mi := &file_shop_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1252,7 +1982,7 @@ func (x *ListConditions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListConditions.ProtoReflect.Descriptor instead.
 func (*ListConditions) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{10}
+	return file_shop_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListConditions) GetOnlyPublished() bool {
@@ -1304,31 +2034,45 @@ func (x *ListConditions) GetLimits() *Limits {
 	return nil
 }
 
-type ArticleList struct {
+func (x *ListConditions) GetAttributes() []*AttributeFilter {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// AttributeFilter matches articles on the value of an attribute.
+// Articles without the attribute are never matched.
+// All set conditions are applied as AND.
+type AttributeFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	List []*Article `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	AttributeId int32    `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"` // Required, filters without ID are ignored.
+	Value       string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`                                 // Exact match on the value.
+	OneOf       []string `protobuf:"bytes,3,rep,name=one_of,json=oneOf,proto3" json:"one_of,omitempty"`                    // Value must equal any of the entries.
+	Min         string   `protobuf:"bytes,4,opt,name=min,proto3" json:"min,omitempty"`                                     // Minimum NUMBER value, inclusive.
+	Max         string   `protobuf:"bytes,5,opt,name=max,proto3" json:"max,omitempty"`                                     // Maximum NUMBER value, inclusive.
 }
 
-func (x *ArticleList) Reset() {
-	*x = ArticleList{}
+func (x *AttributeFilter) Reset() {
+	*x = AttributeFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[11]
+		mi := &file_shop_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ArticleList) String() string {
+func (x *AttributeFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArticleList) ProtoMessage() {}
+func (*AttributeFilter) ProtoMessage() {}
 
-func (x *ArticleList) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[11]
+func (x *AttributeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1339,43 +2083,71 @@ func (x *ArticleList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArticleList.ProtoReflect.Descriptor instead.
-func (*ArticleList) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AttributeFilter.ProtoReflect.Descriptor instead.
+func (*AttributeFilter) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ArticleList) GetList() []*Article {
+func (x *AttributeFilter) GetAttributeId() int32 {
 	if x != nil {
-		return x.List
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *AttributeFilter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AttributeFilter) GetOneOf() []string {
+	if x != nil {
+		return x.OneOf
 	}
 	return nil
 }
 
-type Deleted struct {
+func (x *AttributeFilter) GetMin() string {
+	if x != nil {
+		return x.Min
+	}
+	return ""
+}
+
+func (x *AttributeFilter) GetMax() string {
+	if x != nil {
+		return x.Max
+	}
+	return ""
+}
+
+type ArticleList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rows int64 `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	List []*Article `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 }
 
-func (x *Deleted) Reset() {
-	*x = Deleted{}
+func (x *ArticleList) Reset() {
+	*x = ArticleList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[12]
+		mi := &file_shop_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Deleted) String() string {
+func (x *ArticleList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Deleted) ProtoMessage() {}
+func (*ArticleList) ProtoMessage() {}
 
-func (x *Deleted) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[12]
+func (x *ArticleList) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,55 +2158,45 @@ func (x *Deleted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Deleted.ProtoReflect.Descriptor instead.
-func (*Deleted) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ArticleList.ProtoReflect.Descriptor instead.
+func (*ArticleList) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Deleted) GetRows() int64 {
+func (x *ArticleList) GetList() []*Article {
 	if x != nil {
-		return x.Rows
+		return x.List
 	}
-	return 0
+	return nil
 }
 
-type Order struct {
+type DeletedListConditions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Created       *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"` // Read only
-	Updated       *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"` // Read only
-	FullName      string                 `protobuf:"bytes,4,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
-	Phone         string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
-	FullAddress   string                 `protobuf:"bytes,7,opt,name=full_address,json=fullAddress,proto3" json:"full_address,omitempty"`
-	Message       string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
-	PaymentMethod Order_PaymentMethod    `protobuf:"varint,9,opt,name=payment_method,json=paymentMethod,proto3,enum=shop.Order_PaymentMethod" json:"payment_method,omitempty"`
-	Status        Order_Status           `protobuf:"varint,10,opt,name=status,proto3,enum=shop.Order_Status" json:"status,omitempty"` // Admin write access only
-	Articles      []*Order_ArticleAmount `protobuf:"bytes,11,rep,name=articles,proto3" json:"articles,omitempty"`
-	Sum           string                 `protobuf:"bytes,12,opt,name=sum,proto3" json:"sum,omitempty"`     // Read Only; sum of all line totals
-	Token         string                 `protobuf:"bytes,13,opt,name=token,proto3" json:"token,omitempty"` // Admin write access requirement
+	Limits *Limits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits,omitempty"`
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *Order) Reset() {
-	*x = Order{}
+func (x *DeletedListConditions) Reset() {
+	*x = DeletedListConditions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[13]
+		mi := &file_shop_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Order) String() string {
+func (x *DeletedListConditions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*DeletedListConditions) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[13]
+func (x *DeletedListConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1445,129 +2207,131 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *Order) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use DeletedListConditions.ProtoReflect.Descriptor instead.
+func (*DeletedListConditions) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Order) GetCreated() *timestamp.Timestamp {
+func (x *DeletedListConditions) GetLimits() *Limits {
 	if x != nil {
-		return x.Created
+		return x.Limits
 	}
 	return nil
 }
 
-func (x *Order) GetUpdated() *timestamp.Timestamp {
+// Deprecated: Do not use.
+func (x *DeletedListConditions) GetToken() string {
 	if x != nil {
-		return x.Updated
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-func (x *Order) GetFullName() string {
-	if x != nil {
-		return x.FullName
-	}
-	return ""
+// ArticleRevision is a snapshot of an article, taken after each save.
+type ArticleRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ArticleId int32                `protobuf:"varint,2,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Created   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
+	Subject   string               `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"` // Subject of the JWT used to save the article
+	Article   *Article             `protobuf:"bytes,5,opt,name=article,proto3" json:"article,omitempty"`
 }
 
-func (x *Order) GetEmail() string {
-	if x != nil {
-		return x.Email
+func (x *ArticleRevision) Reset() {
+	*x = ArticleRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Order) GetPhone() string {
-	if x != nil {
-		return x.Phone
-	}
-	return ""
+func (x *ArticleRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Order) GetFullAddress() string {
-	if x != nil {
-		return x.FullAddress
+func (*ArticleRevision) ProtoMessage() {}
+
+func (x *ArticleRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Order) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use ArticleRevision.ProtoReflect.Descriptor instead.
+func (*ArticleRevision) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Order) GetPaymentMethod() Order_PaymentMethod {
+func (x *ArticleRevision) GetId() int32 {
 	if x != nil {
-		return x.PaymentMethod
+		return x.Id
 	}
-	return Order_CASH_ON_DELIVERY
+	return 0
 }
 
-func (x *Order) GetStatus() Order_Status {
+func (x *ArticleRevision) GetArticleId() int32 {
 	if x != nil {
-		return x.Status
+		return x.ArticleId
 	}
-	return Order_OPEN
+	return 0
 }
 
-func (x *Order) GetArticles() []*Order_ArticleAmount {
+func (x *ArticleRevision) GetCreated() *timestamp.Timestamp {
 	if x != nil {
-		return x.Articles
+		return x.Created
 	}
 	return nil
 }
 
-func (x *Order) GetSum() string {
+func (x *ArticleRevision) GetSubject() string {
 	if x != nil {
-		return x.Sum
+		return x.Subject
 	}
 	return ""
 }
 
-func (x *Order) GetToken() string {
+func (x *ArticleRevision) GetArticle() *Article {
 	if x != nil {
-		return x.Token
+		return x.Article
 	}
-	return ""
+	return nil
 }
 
-type OrderID struct {
+type ArticleRevisionList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	EnvKey string `protobuf:"bytes,2,opt,name=env_key,json=envKey,proto3" json:"env_key,omitempty"`
-	Data   string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	List []*ArticleRevision `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 }
 
-func (x *OrderID) Reset() {
-	*x = OrderID{}
+func (x *ArticleRevisionList) Reset() {
+	*x = ArticleRevisionList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[14]
+		mi := &file_shop_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrderID) String() string {
+func (x *ArticleRevisionList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderID) ProtoMessage() {}
+func (*ArticleRevisionList) ProtoMessage() {}
 
-func (x *OrderID) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[14]
+func (x *ArticleRevisionList) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1578,58 +2342,46 @@ func (x *OrderID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderID.ProtoReflect.Descriptor instead.
-func (*OrderID) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *OrderID) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *OrderID) GetEnvKey() string {
-	if x != nil {
-		return x.EnvKey
-	}
-	return ""
+// Deprecated: Use ArticleRevisionList.ProtoReflect.Descriptor instead.
+func (*ArticleRevisionList) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *OrderID) GetData() string {
+func (x *ArticleRevisionList) GetList() []*ArticleRevision {
 	if x != nil {
-		return x.Data
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-type ListOrderConditions struct {
+type RevisionListConditions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status ListOrderConditions_Status `protobuf:"varint,1,opt,name=status,proto3,enum=shop.ListOrderConditions_Status" json:"status,omitempty"`
-	Token  string                     `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	ArticleId int32   `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"` // Required
+	Limits    *Limits `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *ListOrderConditions) Reset() {
-	*x = ListOrderConditions{}
+func (x *RevisionListConditions) Reset() {
+	*x = RevisionListConditions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[15]
+		mi := &file_shop_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListOrderConditions) String() string {
+func (x *RevisionListConditions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrderConditions) ProtoMessage() {}
+func (*RevisionListConditions) ProtoMessage() {}
 
-func (x *ListOrderConditions) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[15]
+func (x *RevisionListConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1640,50 +2392,60 @@ func (x *ListOrderConditions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrderConditions.ProtoReflect.Descriptor instead.
-func (*ListOrderConditions) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RevisionListConditions.ProtoReflect.Descriptor instead.
+func (*RevisionListConditions) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListOrderConditions) GetStatus() ListOrderConditions_Status {
+func (x *RevisionListConditions) GetArticleId() int32 {
 	if x != nil {
-		return x.Status
+		return x.ArticleId
 	}
-	return ListOrderConditions_ANY
+	return 0
 }
 
-func (x *ListOrderConditions) GetToken() string {
+func (x *RevisionListConditions) GetLimits() *Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *RevisionListConditions) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-type OrderList struct {
+type RevisionID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	List []*Order `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *OrderList) Reset() {
-	*x = OrderList{}
+func (x *RevisionID) Reset() {
+	*x = RevisionID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[16]
+		mi := &file_shop_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrderList) String() string {
+func (x *RevisionID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderList) ProtoMessage() {}
+func (*RevisionID) ProtoMessage() {}
 
-func (x *OrderList) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[16]
+func (x *RevisionID) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1694,46 +2456,54 @@ func (x *OrderList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderList.ProtoReflect.Descriptor instead.
-func (*OrderList) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RevisionID.ProtoReflect.Descriptor instead.
+func (*RevisionID) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *OrderList) GetList() []*Order {
+func (x *RevisionID) GetId() int32 {
 	if x != nil {
-		return x.List
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type Category struct {
+// Deprecated: Do not use.
+func (x *RevisionID) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ImportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      int32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`          // Upsert identification, do not modify. Leave 0 for new categories.
-	Created *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"` // Read only
-	Updated *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"` // Read only
-	Label   string               `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Article *Article `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	DryRun  bool     `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Only read from the first request
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"` // Only read from the first request
 }
 
-func (x *Category) Reset() {
-	*x = Category{}
+func (x *ImportRequest) Reset() {
+	*x = ImportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[17]
+		mi := &file_shop_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Category) String() string {
+func (x *ImportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Category) ProtoMessage() {}
+func (*ImportRequest) ProtoMessage() {}
 
-func (x *Category) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[17]
+func (x *ImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1744,66 +2514,60 @@ func (x *Category) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Category.ProtoReflect.Descriptor instead.
-func (*Category) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *Category) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use ImportRequest.ProtoReflect.Descriptor instead.
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Category) GetCreated() *timestamp.Timestamp {
+func (x *ImportRequest) GetArticle() *Article {
 	if x != nil {
-		return x.Created
+		return x.Article
 	}
 	return nil
 }
 
-func (x *Category) GetUpdated() *timestamp.Timestamp {
+func (x *ImportRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Updated
+		return x.DryRun
 	}
-	return nil
+	return false
 }
 
-func (x *Category) GetLabel() string {
+// Deprecated: Do not use.
+func (x *ImportRequest) GetToken() string {
 	if x != nil {
-		return x.Label
+		return x.Token
 	}
 	return ""
 }
 
-// CategoryList holds an ordered list of Categories.
-type CategoryList struct {
+type ImportResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	List  []*Category `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
-	Token string      `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // Admin write access requirement
+	Row       int32  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`                              // Position of the request in the stream, starting at 1
+	ArticleId int32  `protobuf:"varint,2,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"` // ID of the saved article, unset on error
+	Error     string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`                           // Empty on success
 }
 
-func (x *CategoryList) Reset() {
-	*x = CategoryList{}
+func (x *ImportResult) Reset() {
+	*x = ImportResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[18]
+		mi := &file_shop_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CategoryList) String() string {
+func (x *ImportResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryList) ProtoMessage() {}
+func (*ImportResult) ProtoMessage() {}
 
-func (x *CategoryList) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[18]
+func (x *ImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1814,50 +2578,60 @@ func (x *CategoryList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryList.ProtoReflect.Descriptor instead.
-func (*CategoryList) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ImportResult.ProtoReflect.Descriptor instead.
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CategoryList) GetList() []*Category {
+func (x *ImportResult) GetRow() int32 {
 	if x != nil {
-		return x.List
+		return x.Row
 	}
-	return nil
+	return 0
 }
 
-func (x *CategoryList) GetToken() string {
+func (x *ImportResult) GetArticleId() int32 {
 	if x != nil {
-		return x.Token
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *ImportResult) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-type CategoryListConditions struct {
+type ExportConditions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OnlyPublishedArticles bool `protobuf:"varint,1,opt,name=only_published_articles,json=onlyPublishedArticles,proto3" json:"only_published_articles,omitempty"` // Only return categories that have published articles.
+	OnlyPublished  bool  `protobuf:"varint,1,opt,name=only_published,json=onlyPublished,proto3" json:"only_published,omitempty"`
+	OnlyCategoryId int32 `protobuf:"varint,2,opt,name=only_category_id,json=onlyCategoryId,proto3" json:"only_category_id,omitempty"`
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *CategoryListConditions) Reset() {
-	*x = CategoryListConditions{}
+func (x *ExportConditions) Reset() {
+	*x = ExportConditions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[19]
+		mi := &file_shop_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CategoryListConditions) String() string {
+func (x *ExportConditions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryListConditions) ProtoMessage() {}
+func (*ExportConditions) ProtoMessage() {}
 
-func (x *CategoryListConditions) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[19]
+func (x *ExportConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1868,43 +2642,58 @@ func (x *CategoryListConditions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryListConditions.ProtoReflect.Descriptor instead.
-func (*CategoryListConditions) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ExportConditions.ProtoReflect.Descriptor instead.
+func (*ExportConditions) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CategoryListConditions) GetOnlyPublishedArticles() bool {
+func (x *ExportConditions) GetOnlyPublished() bool {
 	if x != nil {
-		return x.OnlyPublishedArticles
+		return x.OnlyPublished
 	}
 	return false
 }
 
-type TextSearch struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *ExportConditions) GetOnlyCategoryId() int32 {
+	if x != nil {
+		return x.OnlyCategoryId
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *ExportConditions) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type Deleted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"` // Search keyword
+	Rows int64 `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
 }
 
-func (x *TextSearch) Reset() {
-	*x = TextSearch{}
+func (x *Deleted) Reset() {
+	*x = Deleted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[20]
+		mi := &file_shop_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TextSearch) String() string {
+func (x *Deleted) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextSearch) ProtoMessage() {}
+func (*Deleted) ProtoMessage() {}
 
-func (x *TextSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[20]
+func (x *Deleted) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1915,44 +2704,60 @@ func (x *TextSearch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextSearch.ProtoReflect.Descriptor instead.
-func (*TextSearch) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Deleted.ProtoReflect.Descriptor instead.
+func (*Deleted) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *TextSearch) GetText() string {
+func (x *Deleted) GetRows() int64 {
 	if x != nil {
-		return x.Text
+		return x.Rows
 	}
-	return ""
+	return 0
 }
 
-type SuggestionList struct {
+type Order struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Category []*Category `protobuf:"bytes,1,rep,name=Category,proto3" json:"Category,omitempty"`
-	Article  []*Article  `protobuf:"bytes,2,rep,name=Article,proto3" json:"Article,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created       *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"` // Read only
+	Updated       *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"` // Read only
+	FullName      string                 `protobuf:"bytes,4,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
+	FullAddress   string                 `protobuf:"bytes,7,opt,name=full_address,json=fullAddress,proto3" json:"full_address,omitempty"`
+	Message       string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	PaymentMethod Order_PaymentMethod    `protobuf:"varint,9,opt,name=payment_method,json=paymentMethod,proto3,enum=shop.Order_PaymentMethod" json:"payment_method,omitempty"`
+	Status        Order_Status           `protobuf:"varint,10,opt,name=status,proto3,enum=shop.Order_Status" json:"status,omitempty"` // Admin write access only
+	Articles      []*Order_ArticleAmount `protobuf:"bytes,11,rep,name=articles,proto3" json:"articles,omitempty"`
+	Sum           string                 `protobuf:"bytes,12,opt,name=sum,proto3" json:"sum,omitempty"` // Read Only; sum of all line totals
+	// Deprecated: Do not use.
+	Token          string `protobuf:"bytes,13,opt,name=token,proto3" json:"token,omitempty"`                                         // Admin write access requirement
+	Locale         string `protobuf:"bytes,14,opt,name=locale,proto3" json:"locale,omitempty"`                                       // Language of customer mails, set on Checkout. Defaults to the shop locale
+	TrackingNumber string `protobuf:"bytes,15,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"` // Admin write only; included in the shipped mail
+	TrackingUrl    string `protobuf:"bytes,16,opt,name=tracking_url,json=trackingUrl,proto3" json:"tracking_url,omitempty"`          // Admin write only; included in the shipped mail
+	Newsletter     bool   `protobuf:"varint,17,opt,name=newsletter,proto3" json:"newsletter,omitempty"`                              // Checkout write only; subscribe the customer to the newsletter, pending confirmation
 }
 
-func (x *SuggestionList) Reset() {
-	*x = SuggestionList{}
+func (x *Order) Reset() {
+	*x = Order{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[21]
+		mi := &file_shop_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SuggestionList) String() string {
+func (x *Order) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuggestionList) ProtoMessage() {}
+func (*Order) ProtoMessage() {}
 
-func (x *SuggestionList) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[21]
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1963,137 +2768,158 @@ func (x *SuggestionList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuggestionList.ProtoReflect.Descriptor instead.
-func (*SuggestionList) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *SuggestionList) GetCategory() []*Category {
+func (x *Order) GetId() int32 {
 	if x != nil {
-		return x.Category
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Order) GetCreated() *timestamp.Timestamp {
+	if x != nil {
+		return x.Created
 	}
 	return nil
 }
 
-func (x *SuggestionList) GetArticle() []*Article {
+func (x *Order) GetUpdated() *timestamp.Timestamp {
 	if x != nil {
-		return x.Article
+		return x.Updated
 	}
 	return nil
 }
 
-type Message struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Order) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
 
-	Id      int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`          // Read-only
-	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`       // Required
-	Email   string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`     // Required
-	Phone   string `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`     // Optional
-	Subject string `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"` // Optional
-	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"` // Required
+func (x *Order) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
 }
 
-func (x *Message) Reset() {
-	*x = Message{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Order) GetPhone() string {
+	if x != nil {
+		return x.Phone
 	}
+	return ""
 }
 
-func (x *Message) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Order) GetFullAddress() string {
+	if x != nil {
+		return x.FullAddress
+	}
+	return ""
 }
 
-func (*Message) ProtoMessage() {}
+func (x *Order) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
 
-func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Order) GetPaymentMethod() Order_PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
 	}
-	return mi.MessageOf(x)
+	return Order_CASH_ON_DELIVERY
 }
 
-// Deprecated: Use Message.ProtoReflect.Descriptor instead.
-func (*Message) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{22}
+func (x *Order) GetStatus() Order_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Order_OPEN
 }
 
-func (x *Message) GetId() int32 {
+func (x *Order) GetArticles() []*Order_ArticleAmount {
 	if x != nil {
-		return x.Id
+		return x.Articles
 	}
-	return 0
+	return nil
 }
 
-func (x *Message) GetName() string {
+func (x *Order) GetSum() string {
 	if x != nil {
-		return x.Name
+		return x.Sum
 	}
 	return ""
 }
 
-func (x *Message) GetEmail() string {
+// Deprecated: Do not use.
+func (x *Order) GetToken() string {
 	if x != nil {
-		return x.Email
+		return x.Token
 	}
 	return ""
 }
 
-func (x *Message) GetPhone() string {
+func (x *Order) GetLocale() string {
 	if x != nil {
-		return x.Phone
+		return x.Locale
 	}
 	return ""
 }
 
-func (x *Message) GetSubject() string {
+func (x *Order) GetTrackingNumber() string {
 	if x != nil {
-		return x.Subject
+		return x.TrackingNumber
 	}
 	return ""
 }
 
-func (x *Message) GetMessage() string {
+func (x *Order) GetTrackingUrl() string {
 	if x != nil {
-		return x.Message
+		return x.TrackingUrl
 	}
 	return ""
 }
 
-type MessageID struct {
+func (x *Order) GetNewsletter() bool {
+	if x != nil {
+		return x.Newsletter
+	}
+	return false
+}
+
+type OrderID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id     int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EnvKey string `protobuf:"bytes,2,opt,name=env_key,json=envKey,proto3" json:"env_key,omitempty"`
+	Data   string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *MessageID) Reset() {
-	*x = MessageID{}
+func (x *OrderID) Reset() {
+	*x = OrderID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[23]
+		mi := &file_shop_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MessageID) String() string {
+func (x *OrderID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageID) ProtoMessage() {}
+func (*OrderID) ProtoMessage() {}
 
-func (x *MessageID) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[23]
+func (x *OrderID) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2104,50 +2930,59 @@ func (x *MessageID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageID.ProtoReflect.Descriptor instead.
-func (*MessageID) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use OrderID.ProtoReflect.Descriptor instead.
+func (*OrderID) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MessageID) GetId() int32 {
+func (x *OrderID) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type Order_ArticleAmount struct {
+func (x *OrderID) GetEnvKey() string {
+	if x != nil {
+		return x.EnvKey
+	}
+	return ""
+}
+
+func (x *OrderID) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type ListOrderConditions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ArticleId   int32    `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
-	Amount      int32    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Title       string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                                   // Read only
-	Price       string   `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`                                   // Read only
-	Total       string   `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`                                   // Read only; line total of Price*Amount
-	Details     *Details `protobuf:"bytes,6,opt,name=details,proto3" json:"details,omitempty"`                               // Read only
-	BasePriceId int32    `protobuf:"varint,7,opt,name=base_price_id,json=basePriceId,proto3" json:"base_price_id,omitempty"` // Checkout write only
-	VariantId   int64    `protobuf:"varint,8,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`         // Checkout write only
+	Status ListOrderConditions_Status `protobuf:"varint,1,opt,name=status,proto3,enum=shop.ListOrderConditions_Status" json:"status,omitempty"`
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *Order_ArticleAmount) Reset() {
-	*x = Order_ArticleAmount{}
+func (x *ListOrderConditions) Reset() {
+	*x = ListOrderConditions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shop_proto_msgTypes[24]
+		mi := &file_shop_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Order_ArticleAmount) String() string {
+func (x *ListOrderConditions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order_ArticleAmount) ProtoMessage() {}
+func (*ListOrderConditions) ProtoMessage() {}
 
-func (x *Order_ArticleAmount) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_proto_msgTypes[24]
+func (x *ListOrderConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2158,558 +2993,4829 @@ func (x *Order_ArticleAmount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order_ArticleAmount.ProtoReflect.Descriptor instead.
-func (*Order_ArticleAmount) Descriptor() ([]byte, []int) {
-	return file_shop_proto_rawDescGZIP(), []int{13, 0}
+// Deprecated: Use ListOrderConditions.ProtoReflect.Descriptor instead.
+func (*ListOrderConditions) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Order_ArticleAmount) GetArticleId() int32 {
+func (x *ListOrderConditions) GetStatus() ListOrderConditions_Status {
 	if x != nil {
-		return x.ArticleId
+		return x.Status
 	}
-	return 0
+	return ListOrderConditions_ANY
 }
 
-func (x *Order_ArticleAmount) GetAmount() int32 {
+// Deprecated: Do not use.
+func (x *ListOrderConditions) GetToken() string {
 	if x != nil {
-		return x.Amount
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *Order_ArticleAmount) GetTitle() string {
+type OrderList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*Order `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *OrderList) Reset() {
+	*x = OrderList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderList) ProtoMessage() {}
+
+func (x *OrderList) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderList.ProtoReflect.Descriptor instead.
+func (*OrderList) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *OrderList) GetList() []*Order {
 	if x != nil {
-		return x.Title
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-func (x *Order_ArticleAmount) GetPrice() string {
+type WatchOrdersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastOrderId int32 `protobuf:"varint,1,opt,name=last_order_id,json=lastOrderId,proto3" json:"last_order_id,omitempty"` // Resume after this order ID, 0 for new orders only
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *WatchOrdersRequest) Reset() {
+	*x = WatchOrdersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrdersRequest) ProtoMessage() {}
+
+func (x *WatchOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrdersRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WatchOrdersRequest) GetLastOrderId() int32 {
 	if x != nil {
-		return x.Price
+		return x.LastOrderId
 	}
-	return ""
+	return 0
 }
 
-func (x *Order_ArticleAmount) GetTotal() string {
+// Deprecated: Do not use.
+func (x *WatchOrdersRequest) GetToken() string {
 	if x != nil {
-		return x.Total
+		return x.Token
 	}
 	return ""
 }
 
-func (x *Order_ArticleAmount) GetDetails() *Details {
+type ReportConditions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From     *timestamp.Timestamp      `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`                                              // Inclusive, defaults to 30 days before to
+	To       *timestamp.Timestamp      `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`                                                  // Exclusive, defaults to now
+	Interval ReportConditions_Interval `protobuf:"varint,3,opt,name=interval,proto3,enum=shop.ReportConditions_Interval" json:"interval,omitempty"` // RevenueReport only
+	Limit    int32                     `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                           // TopArticlesReport only, defaults to 10
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *ReportConditions) Reset() {
+	*x = ReportConditions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportConditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportConditions) ProtoMessage() {}
+
+func (x *ReportConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportConditions.ProtoReflect.Descriptor instead.
+func (*ReportConditions) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReportConditions) GetFrom() *timestamp.Timestamp {
 	if x != nil {
-		return x.Details
+		return x.From
 	}
 	return nil
 }
 
-func (x *Order_ArticleAmount) GetBasePriceId() int32 {
+func (x *ReportConditions) GetTo() *timestamp.Timestamp {
 	if x != nil {
-		return x.BasePriceId
+		return x.To
 	}
-	return 0
+	return nil
 }
 
-func (x *Order_ArticleAmount) GetVariantId() int64 {
+func (x *ReportConditions) GetInterval() ReportConditions_Interval {
 	if x != nil {
-		return x.VariantId
+		return x.Interval
+	}
+	return ReportConditions_DAY
+}
+
+func (x *ReportConditions) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-var File_shop_proto protoreflect.FileDescriptor
+// Deprecated: Do not use.
+func (x *ReportConditions) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
 
-var file_shop_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x73, 0x68,
-	0x6f, 0x70, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x31, 0x0a, 0x09, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x44,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3f, 0x0a, 0x05, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xc9, 0x01, 0x0a, 0x09, 0x42, 0x61, 0x73, 0x65,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x18, 0x0a, 0x16, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x34, 0x0a,
-	0x0d, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23,
-	0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73,
-	0x68, 0x6f, 0x70, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x04, 0x6c,
-	0x69, 0x73, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x07, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
-	0x69, 0x65, 0x72, 0x22, 0x62, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2e,
-	0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x27,
-	0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x07,
-	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0xf9, 0x03, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12,
-	0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x68,
-	0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73,
-	0x12, 0x23, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x06, 0x76,
-	0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70,
-	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68,
-	0x6f, 0x70, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x68,
-	0x6f, 0x70, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0a, 0x62, 0x61,
-	0x73, 0x65, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69,
-	0x61, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x6f,
-	0x70, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61,
-	0x6e, 0x74, 0x73, 0x22, 0x86, 0x02, 0x0a, 0x10, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e,
-	0x4d, 0x65, 0x64, 0x69, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x34,
-	0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e,
-	0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52,
-	0x0a, 0x62, 0x61, 0x73, 0x65, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x76,
-	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e,
-	0x73, 0x68, 0x6f, 0x70, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x36, 0x0a, 0x06,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x22, 0xbf, 0x02, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x6e, 0x6c, 0x79, 0x5f,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x23,
-	0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
-	0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6f,
-	0x6e, 0x6c, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x2e, 0x0a,
-	0x13, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x6e, 0x6c, 0x79,
-	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x2b, 0x0a,
-	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e,
-	0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x72, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x24, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x30, 0x0a, 0x0b, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x1d, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0xc0, 0x06, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75,
-	0x6c, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x68,
-	0x6f, 0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x35, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x75, 0x6d, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x1a, 0xf4, 0x01, 0x0a, 0x0d, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x07, 0x64,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73,
-	0x68, 0x6f, 0x70, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x61, 0x73,
-	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x72, 0x69,
-	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x61,
-	0x72, 0x69, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x41, 0x53, 0x48,
-	0x5f, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x10, 0x00, 0x12, 0x11,
-	0x0a, 0x0d, 0x42, 0x41, 0x4e, 0x4b, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10,
-	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x02, 0x22, 0x2b, 0x0a,
-	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43,
-	0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x22, 0x46, 0x0a, 0x07, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x9b, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x73, 0x68, 0x6f,
-	0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x34, 0x0a, 0x06, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x08, 0x0a,
-	0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4e, 0x54, 0x10,
-	0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03,
-	0x22, 0x2c, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x68,
-	0x6f, 0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x9c,
-	0x01, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x48, 0x0a,
-	0x0c, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x0a,
-	0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68,
-	0x6f, 0x70, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x04, 0x6c, 0x69, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x50, 0x0a, 0x16, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x36, 0x0a, 0x17, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x15, 0x6f, 0x6e, 0x6c, 0x79, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65,
-	0x64, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x0a, 0x54, 0x65, 0x78,
-	0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x65, 0x0a, 0x0e, 0x53,
-	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a,
-	0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52,
-	0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x07, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x6f,
-	0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x07, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x1b, 0x0a, 0x09, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x2a,
-	0x3e, 0x0a, 0x0b, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x0a,
-	0x0a, 0x06, 0x4d, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x44,
-	0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x44, 0x5f, 0x4c, 0x41, 0x42, 0x45,
-	0x4c, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x44, 0x5f, 0x55, 0x52, 0x4c, 0x10, 0x03, 0x2a,
-	0x64, 0x0a, 0x0f, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x50, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x09,
-	0x0a, 0x05, 0x42, 0x50, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x50, 0x5f,
-	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x50, 0x5f,
-	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x50, 0x5f,
-	0x4c, 0x41, 0x42, 0x45, 0x4c, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x50, 0x5f, 0x50, 0x52,
-	0x49, 0x43, 0x45, 0x10, 0x05, 0x2a, 0x6e, 0x0a, 0x0d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x52, 0x54, 0x5f, 0x41, 0x4c,
-	0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x52, 0x54, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12,
-	0x0f, 0x0a, 0x0b, 0x56, 0x52, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02,
-	0x12, 0x0f, 0x0a, 0x0b, 0x56, 0x52, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10,
-	0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x52, 0x54, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x53, 0x10,
-	0x04, 0x12, 0x12, 0x0a, 0x0e, 0x56, 0x52, 0x54, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4c,
-	0x49, 0x45, 0x52, 0x10, 0x05, 0x2a, 0x8a, 0x01, 0x0a, 0x0d, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
-	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00,
-	0x12, 0x06, 0x0a, 0x02, 0x49, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52, 0x45, 0x41,
-	0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44,
-	0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10,
-	0x04, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b,
-	0x44, 0x45, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x09, 0x0a,
-	0x05, 0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x4d,
-	0x4f, 0x54, 0x45, 0x44, 0x10, 0x0b, 0x22, 0x04, 0x08, 0x08, 0x10, 0x0a, 0x22, 0x04, 0x08, 0x0c,
-	0x10, 0x0e, 0x2a, 0x5a, 0x0a, 0x0e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x41, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x10,
-	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41, 0x54, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a,
-	0x0b, 0x43, 0x41, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0f,
-	0x0a, 0x0b, 0x43, 0x41, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12,
-	0x0d, 0x0a, 0x09, 0x43, 0x41, 0x54, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x10, 0x04, 0x32, 0xba,
-	0x06, 0x0a, 0x04, 0x53, 0x68, 0x6f, 0x70, 0x12, 0x2f, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x41,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72,
-	0x74, 0x69, 0x63, 0x6c, 0x65, 0x1a, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x49, 0x44, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0b, 0x56, 0x69, 0x65, 0x77,
-	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x44, 0x1a, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e,
-	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x68, 0x6f, 0x70,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
-	0x11, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72,
-	0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x49, 0x44, 0x1a, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x6f, 0x75, 0x74, 0x12, 0x0b, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x1a, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x22,
-	0x00, 0x12, 0x3a, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12,
-	0x19, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0f, 0x2e, 0x73, 0x68, 0x6f,
-	0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x29, 0x0a,
-	0x09, 0x53, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0b, 0x2e, 0x73, 0x68, 0x6f,
-	0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x53, 0x61, 0x76, 0x65,
-	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x73, 0x68, 0x6f,
-	0x70, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x12,
-	0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69,
-	0x73, 0x74, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x43, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x12, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x73,
-	0x68, 0x6f, 0x70, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x1a, 0x11,
-	0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x12, 0x10,
-	0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x1a, 0x14, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0d, 0x53, 0x61, 0x76, 0x65,
-	0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70,
-	0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x1a, 0x0f, 0x2e, 0x73, 0x68, 0x6f,
-	0x70, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a,
-	0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x1a, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x42, 0x61, 0x73,
-	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x1a, 0x13, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0b, 0x53, 0x65,
-	0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70,
-	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x22, 0x00, 0x42, 0x18, 0x5a, 0x16, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x6f, 0x61, 0x70, 0x69, 0x73,
-	0x2f, 0x73, 0x68, 0x6f, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type RevenueReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Periods []*RevenueReport_Period `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty"` // Periods without orders are omitted
+	// Totals over the whole date range
+	Orders            int32  `protobuf:"varint,2,opt,name=orders,proto3" json:"orders,omitempty"`
+	Revenue           string `protobuf:"bytes,3,opt,name=revenue,proto3" json:"revenue,omitempty"`                                                // numeric
+	AverageOrderValue string `protobuf:"bytes,4,opt,name=average_order_value,json=averageOrderValue,proto3" json:"average_order_value,omitempty"` // numeric
 }
 
-var (
-	file_shop_proto_rawDescOnce sync.Once
-	file_shop_proto_rawDescData = file_shop_proto_rawDesc
-)
+func (x *RevenueReport) Reset() {
+	*x = RevenueReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_shop_proto_rawDescGZIP() []byte {
-	file_shop_proto_rawDescOnce.Do(func() {
-		file_shop_proto_rawDescData = protoimpl.X.CompressGZIP(file_shop_proto_rawDescData)
-	})
-	return file_shop_proto_rawDescData
+func (x *RevenueReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_shop_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_shop_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
-var file_shop_proto_goTypes = []interface{}{
-	(MediaFields)(0),                // 0: shop.MediaFields
-	(BasePriceFields)(0),            // 1: shop.BasePriceFields
-	(VariantFields)(0),              // 2: shop.VariantFields
-	(ArticleFields)(0),              // 3: shop.ArticleFields
-	(CategoryFields)(0),             // 4: shop.CategoryFields
-	(Order_PaymentMethod)(0),        // 5: shop.Order.PaymentMethod
-	(Order_Status)(0),               // 6: shop.Order.Status
-	(ListOrderConditions_Status)(0), // 7: shop.ListOrderConditions.Status
-	(*ArticleID)(nil),               // 8: shop.ArticleID
-	(*Media)(nil),                   // 9: shop.Media
-	(*BasePrice)(nil),               // 10: shop.BasePrice
-	(*BasePriceListCondtions)(nil),  // 11: shop.BasePriceListCondtions
-	(*BasePriceList)(nil),           // 12: shop.BasePriceList
-	(*Variant)(nil),                 // 13: shop.Variant
-	(*Details)(nil),                 // 14: shop.Details
-	(*Article)(nil),                 // 15: shop.Article
-	(*ArticleRelations)(nil),        // 16: shop.ArticleRelations
-	(*Limits)(nil),                  // 17: shop.Limits
-	(*ListConditions)(nil),          // 18: shop.ListConditions
-	(*ArticleList)(nil),             // 19: shop.ArticleList
-	(*Deleted)(nil),                 // 20: shop.Deleted
-	(*Order)(nil),                   // 21: shop.Order
-	(*OrderID)(nil),                 // 22: shop.OrderID
-	(*ListOrderConditions)(nil),     // 23: shop.ListOrderConditions
-	(*OrderList)(nil),               // 24: shop.OrderList
-	(*Category)(nil),                // 25: shop.Category
-	(*CategoryList)(nil),            // 26: shop.CategoryList
-	(*CategoryListConditions)(nil),  // 27: shop.CategoryListConditions
-	(*TextSearch)(nil),              // 28: shop.TextSearch
-	(*SuggestionList)(nil),          // 29: shop.SuggestionList
-	(*Message)(nil),                 // 30: shop.Message
-	(*MessageID)(nil),               // 31: shop.MessageID
-	(*Order_ArticleAmount)(nil),     // 32: shop.Order.ArticleAmount
-	(*timestamp.Timestamp)(nil),     // 33: google.protobuf.Timestamp
+func (*RevenueReport) ProtoMessage() {}
+
+func (x *RevenueReport) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_shop_proto_depIdxs = []int32{
-	33, // 0: shop.BasePrice.created:type_name -> google.protobuf.Timestamp
-	33, // 1: shop.BasePrice.updated:type_name -> google.protobuf.Timestamp
-	10, // 2: shop.BasePriceList.list:type_name -> shop.BasePrice
-	33, // 3: shop.Variant.created:type_name -> google.protobuf.Timestamp
-	33, // 4: shop.Variant.updated:type_name -> google.protobuf.Timestamp
-	10, // 5: shop.Details.base_price:type_name -> shop.BasePrice
-	13, // 6: shop.Details.variant:type_name -> shop.Variant
-	33, // 7: shop.Article.created:type_name -> google.protobuf.Timestamp
-	33, // 8: shop.Article.updated:type_name -> google.protobuf.Timestamp
-	9,  // 9: shop.Article.images:type_name -> shop.Media
-	9,  // 10: shop.Article.videos:type_name -> shop.Media
-	25, // 11: shop.Article.categories:type_name -> shop.Category
-	10, // 12: shop.Article.baseprices:type_name -> shop.BasePrice
-	13, // 13: shop.Article.variants:type_name -> shop.Variant
-	0,  // 14: shop.ArticleRelations.images:type_name -> shop.MediaFields
-	0,  // 15: shop.ArticleRelations.videos:type_name -> shop.MediaFields
-	4,  // 16: shop.ArticleRelations.categories:type_name -> shop.CategoryFields
-	1,  // 17: shop.ArticleRelations.baseprices:type_name -> shop.BasePriceFields
-	2,  // 18: shop.ArticleRelations.variants:type_name -> shop.VariantFields
-	3,  // 19: shop.ListConditions.fields:type_name -> shop.ArticleFields
-	16, // 20: shop.ListConditions.relations:type_name -> shop.ArticleRelations
-	17, // 21: shop.ListConditions.limits:type_name -> shop.Limits
-	15, // 22: shop.ArticleList.list:type_name -> shop.Article
-	33, // 23: shop.Order.created:type_name -> google.protobuf.Timestamp
-	33, // 24: shop.Order.updated:type_name -> google.protobuf.Timestamp
-	5,  // 25: shop.Order.payment_method:type_name -> shop.Order.PaymentMethod
-	6,  // 26: shop.Order.status:type_name -> shop.Order.Status
-	32, // 27: shop.Order.articles:type_name -> shop.Order.ArticleAmount
-	7,  // 28: shop.ListOrderConditions.status:type_name -> shop.ListOrderConditions.Status
-	21, // 29: shop.OrderList.list:type_name -> shop.Order
-	33, // 30: shop.Category.created:type_name -> google.protobuf.Timestamp
-	33, // 31: shop.Category.updated:type_name -> google.protobuf.Timestamp
-	25, // 32: shop.CategoryList.list:type_name -> shop.Category
-	25, // 33: shop.SuggestionList.Category:type_name -> shop.Category
-	15, // 34: shop.SuggestionList.Article:type_name -> shop.Article
-	14, // 35: shop.Order.ArticleAmount.details:type_name -> shop.Details
-	15, // 36: shop.Shop.SaveArticle:input_type -> shop.Article
-	8,  // 37: shop.Shop.ViewArticle:input_type -> shop.ArticleID
-	18, // 38: shop.Shop.ListArticles:input_type -> shop.ListConditions
-	8,  // 39: shop.Shop.DeleteArticle:input_type -> shop.ArticleID
-	21, // 40: shop.Shop.Checkout:input_type -> shop.Order
-	23, // 41: shop.Shop.ListOrders:input_type -> shop.ListOrderConditions
-	21, // 42: shop.Shop.SaveOrder:input_type -> shop.Order
-	26, // 43: shop.Shop.SaveCategories:input_type -> shop.CategoryList
-	27, // 44: shop.Shop.ListCategories:input_type -> shop.CategoryListConditions
-	28, // 45: shop.Shop.SearchArticles:input_type -> shop.TextSearch
-	28, // 46: shop.Shop.Suggest:input_type -> shop.TextSearch
-	10, // 47: shop.Shop.SaveBasePrice:input_type -> shop.BasePrice
-	10, // 48: shop.Shop.DeleteBasePrice:input_type -> shop.BasePrice
-	11, // 49: shop.Shop.ListBasesPrices:input_type -> shop.BasePriceListCondtions
-	30, // 50: shop.Shop.SendMessage:input_type -> shop.Message
-	8,  // 51: shop.Shop.SaveArticle:output_type -> shop.ArticleID
-	15, // 52: shop.Shop.ViewArticle:output_type -> shop.Article
-	19, // 53: shop.Shop.ListArticles:output_type -> shop.ArticleList
-	20, // 54: shop.Shop.DeleteArticle:output_type -> shop.Deleted
-	22, // 55: shop.Shop.Checkout:output_type -> shop.OrderID
-	24, // 56: shop.Shop.ListOrders:output_type -> shop.OrderList
-	22, // 57: shop.Shop.SaveOrder:output_type -> shop.OrderID
-	26, // 58: shop.Shop.SaveCategories:output_type -> shop.CategoryList
-	26, // 59: shop.Shop.ListCategories:output_type -> shop.CategoryList
-	19, // 60: shop.Shop.SearchArticles:output_type -> shop.ArticleList
-	29, // 61: shop.Shop.Suggest:output_type -> shop.SuggestionList
-	10, // 62: shop.Shop.SaveBasePrice:output_type -> shop.BasePrice
-	20, // 63: shop.Shop.DeleteBasePrice:output_type -> shop.Deleted
-	12, // 64: shop.Shop.ListBasesPrices:output_type -> shop.BasePriceList
-	31, // 65: shop.Shop.SendMessage:output_type -> shop.MessageID
-	51, // [51:66] is the sub-list for method output_type
-	36, // [36:51] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+
+// Deprecated: Use RevenueReport.ProtoReflect.Descriptor instead.
+func (*RevenueReport) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{29}
 }
 
-func init() { file_shop_proto_init() }
-func file_shop_proto_init() {
-	if File_shop_proto != nil {
-		return
+func (x *RevenueReport) GetPeriods() []*RevenueReport_Period {
+	if x != nil {
+		return x.Periods
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_shop_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArticleID); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_shop_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Media); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+func (x *RevenueReport) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *RevenueReport) GetRevenue() string {
+	if x != nil {
+		return x.Revenue
+	}
+	return ""
+}
+
+func (x *RevenueReport) GetAverageOrderValue() string {
+	if x != nil {
+		return x.AverageOrderValue
+	}
+	return ""
+}
+
+type TopArticlesReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ByQuantity []*TopArticlesReport_Article `protobuf:"bytes,1,rep,name=by_quantity,json=byQuantity,proto3" json:"by_quantity,omitempty"`
+	ByRevenue  []*TopArticlesReport_Article `protobuf:"bytes,2,rep,name=by_revenue,json=byRevenue,proto3" json:"by_revenue,omitempty"`
+}
+
+func (x *TopArticlesReport) Reset() {
+	*x = TopArticlesReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopArticlesReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopArticlesReport) ProtoMessage() {}
+
+func (x *TopArticlesReport) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_shop_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BasePrice); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopArticlesReport.ProtoReflect.Descriptor instead.
+func (*TopArticlesReport) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TopArticlesReport) GetByQuantity() []*TopArticlesReport_Article {
+	if x != nil {
+		return x.ByQuantity
+	}
+	return nil
+}
+
+func (x *TopArticlesReport) GetByRevenue() []*TopArticlesReport_Article {
+	if x != nil {
+		return x.ByRevenue
+	}
+	return nil
+}
+
+type OrdersReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentMethods   []*OrdersReport_PaymentMethod `protobuf:"bytes,1,rep,name=payment_methods,json=paymentMethods,proto3" json:"payment_methods,omitempty"`
+	Statuses         []*OrdersReport_Status        `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	OnlineOrders     int32                         `protobuf:"varint,3,opt,name=online_orders,json=onlineOrders,proto3" json:"online_orders,omitempty"`
+	OnlinePaid       int32                         `protobuf:"varint,4,opt,name=online_paid,json=onlinePaid,proto3" json:"online_paid,omitempty"`                  // ONLINE orders with a confirmed payment_status
+	OnlineConversion string                        `protobuf:"bytes,5,opt,name=online_conversion,json=onlineConversion,proto3" json:"online_conversion,omitempty"` // numeric fraction of online_paid over online_orders
+}
+
+func (x *OrdersReport) Reset() {
+	*x = OrdersReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrdersReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrdersReport) ProtoMessage() {}
+
+func (x *OrdersReport) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrdersReport.ProtoReflect.Descriptor instead.
+func (*OrdersReport) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *OrdersReport) GetPaymentMethods() []*OrdersReport_PaymentMethod {
+	if x != nil {
+		return x.PaymentMethods
+	}
+	return nil
+}
+
+func (x *OrdersReport) GetStatuses() []*OrdersReport_Status {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *OrdersReport) GetOnlineOrders() int32 {
+	if x != nil {
+		return x.OnlineOrders
+	}
+	return 0
+}
+
+func (x *OrdersReport) GetOnlinePaid() int32 {
+	if x != nil {
+		return x.OnlinePaid
+	}
+	return 0
+}
+
+func (x *OrdersReport) GetOnlineConversion() string {
+	if x != nil {
+		return x.OnlineConversion
+	}
+	return ""
+}
+
+type ExportReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report     ExportReportRequest_Report `protobuf:"varint,1,opt,name=report,proto3,enum=shop.ExportReportRequest_Report" json:"report,omitempty"`
+	Conditions *ReportConditions          `protobuf:"bytes,2,opt,name=conditions,proto3" json:"conditions,omitempty"` // Includes the token
+}
+
+func (x *ExportReportRequest) Reset() {
+	*x = ExportReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReportRequest) ProtoMessage() {}
+
+func (x *ExportReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReportRequest.ProtoReflect.Descriptor instead.
+func (*ExportReportRequest) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ExportReportRequest) GetReport() ExportReportRequest_Report {
+	if x != nil {
+		return x.Report
+	}
+	return ExportReportRequest_REVENUE
+}
+
+func (x *ExportReportRequest) GetConditions() *ReportConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type ReportCSV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Data     []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ReportCSV) Reset() {
+	*x = ReportCSV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportCSV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportCSV) ProtoMessage() {}
+
+func (x *ReportCSV) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportCSV.ProtoReflect.Descriptor instead.
+func (*ReportCSV) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReportCSV) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ReportCSV) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Category struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`          // Upsert identification, do not modify. Leave 0 for new categories.
+	Created *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"` // Read only
+	Updated *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"` // Read only
+	Label   string               `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Category) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetCreated() *timestamp.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Category) GetUpdated() *timestamp.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *Category) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+// CategoryList holds an ordered list of Categories.
+type CategoryList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*Category `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // Admin write access requirement
+}
+
+func (x *CategoryList) Reset() {
+	*x = CategoryList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CategoryList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryList) ProtoMessage() {}
+
+func (x *CategoryList) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryList.ProtoReflect.Descriptor instead.
+func (*CategoryList) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CategoryList) GetList() []*Category {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *CategoryList) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Attribute is a typed specification which can be set on articles,
+// such as material, dimensions or weight.
+type Attribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`          // Upsert identification, do not modify. Leave 0 for new attributes.
+	Created    *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"` // Read only
+	Updated    *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"` // Read only
+	Label      string               `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Type       Attribute_Type       `protobuf:"varint,5,opt,name=type,proto3,enum=shop.Attribute_Type" json:"type,omitempty"`
+	Unit       string               `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`             // Optional unit for NUMBER attributes, like "cm" or "kg".
+	Options    []string             `protobuf:"bytes,7,rep,name=options,proto3" json:"options,omitempty"`       // Allowed values for ENUM attributes.
+	Categories []*Category          `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty"` // Categories this attribute applies to, identified by ID.
+}
+
+func (x *Attribute) Reset() {
+	*x = Attribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attribute) ProtoMessage() {}
+
+func (x *Attribute) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attribute.ProtoReflect.Descriptor instead.
+func (*Attribute) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Attribute) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Attribute) GetCreated() *timestamp.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Attribute) GetUpdated() *timestamp.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *Attribute) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Attribute) GetType() Attribute_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Attribute_TEXT
+}
+
+func (x *Attribute) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Attribute) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Attribute) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// AttributeList holds an ordered list of Attributes.
+type AttributeList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*Attribute `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // Admin write access requirement
+}
+
+func (x *AttributeList) Reset() {
+	*x = AttributeList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeList) ProtoMessage() {}
+
+func (x *AttributeList) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeList.ProtoReflect.Descriptor instead.
+func (*AttributeList) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AttributeList) GetList() []*Attribute {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *AttributeList) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AttributeListConditions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OnlyCategoryId int32 `protobuf:"varint,1,opt,name=only_category_id,json=onlyCategoryId,proto3" json:"only_category_id,omitempty"` // Only return attributes attached to this category.
+}
+
+func (x *AttributeListConditions) Reset() {
+	*x = AttributeListConditions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeListConditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeListConditions) ProtoMessage() {}
+
+func (x *AttributeListConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeListConditions.ProtoReflect.Descriptor instead.
+func (*AttributeListConditions) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AttributeListConditions) GetOnlyCategoryId() int32 {
+	if x != nil {
+		return x.OnlyCategoryId
+	}
+	return 0
+}
+
+type CategoryListConditions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OnlyPublishedArticles bool `protobuf:"varint,1,opt,name=only_published_articles,json=onlyPublishedArticles,proto3" json:"only_published_articles,omitempty"` // Only return categories that have published articles.
+}
+
+func (x *CategoryListConditions) Reset() {
+	*x = CategoryListConditions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CategoryListConditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryListConditions) ProtoMessage() {}
+
+func (x *CategoryListConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryListConditions.ProtoReflect.Descriptor instead.
+func (*CategoryListConditions) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CategoryListConditions) GetOnlyPublishedArticles() bool {
+	if x != nil {
+		return x.OnlyPublishedArticles
+	}
+	return false
+}
+
+type TextSearch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"` // Search keyword
+}
+
+func (x *TextSearch) Reset() {
+	*x = TextSearch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextSearch) ProtoMessage() {}
+
+func (x *TextSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextSearch.ProtoReflect.Descriptor instead.
+func (*TextSearch) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TextSearch) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SuggestionList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category []*Category `protobuf:"bytes,1,rep,name=Category,proto3" json:"Category,omitempty"`
+	Article  []*Article  `protobuf:"bytes,2,rep,name=Article,proto3" json:"Article,omitempty"`
+}
+
+func (x *SuggestionList) Reset() {
+	*x = SuggestionList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestionList) ProtoMessage() {}
+
+func (x *SuggestionList) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestionList.ProtoReflect.Descriptor instead.
+func (*SuggestionList) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SuggestionList) GetCategory() []*Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *SuggestionList) GetArticle() []*Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                        // Read-only
+	Name         string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                     // Required
+	Email        string               `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                                   // Required
+	Phone        string               `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`                                   // Optional
+	Subject      string               `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`                               // Optional
+	Message      string               `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`                               // Required
+	Honeypot     string               `protobuf:"bytes,7,opt,name=honeypot,proto3" json:"honeypot,omitempty"`                             // Write-only; hidden form field which must be left empty
+	CaptchaToken string               `protobuf:"bytes,8,opt,name=captcha_token,json=captchaToken,proto3" json:"captcha_token,omitempty"` // Write-only; required when the server verifies captchas
+	Created      *timestamp.Timestamp `protobuf:"bytes,9,opt,name=created,proto3" json:"created,omitempty"`                               // Read-only
+	Read         bool                 `protobuf:"varint,10,opt,name=read,proto3" json:"read,omitempty"`                                   // Read-only; set by MarkMessage and ReplyMessage
+	Archived     bool                 `protobuf:"varint,11,opt,name=archived,proto3" json:"archived,omitempty"`                           // Read-only; set by MarkMessage
+	Replies      []*MessageReply      `protobuf:"bytes,12,rep,name=replies,proto3" json:"replies,omitempty"`                              // Read-only; oldest first
+	Newsletter   bool                 `protobuf:"varint,13,opt,name=newsletter,proto3" json:"newsletter,omitempty"`                       // Write-only; subscribe the sender to the newsletter, pending confirmation
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Message) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Message) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Message) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Message) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Message) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Message) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Message) GetHoneypot() string {
+	if x != nil {
+		return x.Honeypot
+	}
+	return ""
+}
+
+func (x *Message) GetCaptchaToken() string {
+	if x != nil {
+		return x.CaptchaToken
+	}
+	return ""
+}
+
+func (x *Message) GetCreated() *timestamp.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Message) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Message) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Message) GetReplies() []*MessageReply {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *Message) GetNewsletter() bool {
+	if x != nil {
+		return x.Newsletter
+	}
+	return false
+}
+
+type MessageID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *MessageID) Reset() {
+	*x = MessageID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageID) ProtoMessage() {}
+
+func (x *MessageID) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageID.ProtoReflect.Descriptor instead.
+func (*MessageID) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *MessageID) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *MessageID) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type MessageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                // Read-only
+	MessageId int32                `protobuf:"varint,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // Required
+	Created   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`                       // Read-only
+	Author    string               `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`                         // Read-only; subject of the replying admin
+	Body      string               `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`                             // Required
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *MessageReply) Reset() {
+	*x = MessageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReply) ProtoMessage() {}
+
+func (x *MessageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReply.ProtoReflect.Descriptor instead.
+func (*MessageReply) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MessageReply) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MessageReply) GetMessageId() int32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *MessageReply) GetCreated() *timestamp.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *MessageReply) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *MessageReply) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *MessageReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type MessageMark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Read     bool  `protobuf:"varint,2,opt,name=read,proto3" json:"read,omitempty"`
+	Archived bool  `protobuf:"varint,3,opt,name=archived,proto3" json:"archived,omitempty"`
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *MessageMark) Reset() {
+	*x = MessageMark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageMark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageMark) ProtoMessage() {}
+
+func (x *MessageMark) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageMark.ProtoReflect.Descriptor instead.
+func (*MessageMark) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MessageMark) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MessageMark) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *MessageMark) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+// Deprecated: Do not use.
+func (x *MessageMark) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type MessageListConditions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status MessageListConditions_Status `protobuf:"varint,1,opt,name=status,proto3,enum=shop.MessageListConditions_Status" json:"status,omitempty"`
+	Limit  int32                        `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *MessageListConditions) Reset() {
+	*x = MessageListConditions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageListConditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageListConditions) ProtoMessage() {}
+
+func (x *MessageListConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageListConditions.ProtoReflect.Descriptor instead.
+func (*MessageListConditions) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MessageListConditions) GetStatus() MessageListConditions_Status {
+	if x != nil {
+		return x.Status
+	}
+	return MessageListConditions_INBOX
+}
+
+func (x *MessageListConditions) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *MessageListConditions) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type MessageList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*Message `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *MessageList) Reset() {
+	*x = MessageList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageList) ProtoMessage() {}
+
+func (x *MessageList) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageList.ProtoReflect.Descriptor instead.
+func (*MessageList) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MessageList) GetList() []*Message {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// Webhook endpoints receive a POST request for every subscribed event.
+// The JSON body holds the event name, creation time and data of the event.
+// The X-Shop-Signature header contains the hex encoded HMAC-SHA256 of the body,
+// keyed by the webhook secret, prefixed by "sha256=".
+// Failed deliveries are retried with an increasing back-off.
+type Webhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"` // Read only
+	Updated *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"` // Read only
+	Url     string               `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Secret  string               `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	Events  []Webhook_Event      `protobuf:"varint,6,rep,packed,name=events,proto3,enum=shop.Webhook_Event" json:"events,omitempty"`
+	Active  bool                 `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,8,opt,name=token,proto3" json:"token,omitempty"` // Admin write access requirement
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Webhook) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Webhook) GetCreated() *timestamp.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Webhook) GetUpdated() *timestamp.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetEvents() []Webhook_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Webhook) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+// Deprecated: Do not use.
+func (x *Webhook) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type WebhookID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *WebhookID) Reset() {
+	*x = WebhookID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookID) ProtoMessage() {}
+
+func (x *WebhookID) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookID.ProtoReflect.Descriptor instead.
+func (*WebhookID) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WebhookID) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *WebhookID) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type WebhookListConditions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *WebhookListConditions) Reset() {
+	*x = WebhookListConditions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookListConditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookListConditions) ProtoMessage() {}
+
+func (x *WebhookListConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookListConditions.ProtoReflect.Descriptor instead.
+func (*WebhookListConditions) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{50}
+}
+
+// Deprecated: Do not use.
+func (x *WebhookListConditions) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type WebhookList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*Webhook `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *WebhookList) Reset() {
+	*x = WebhookList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookList) ProtoMessage() {}
+
+func (x *WebhookList) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookList.ProtoReflect.Descriptor instead.
+func (*WebhookList) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *WebhookList) GetList() []*Webhook {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// Mail in the outbox.
+// Mails are rendered and stored in the same transaction as the event which triggers them,
+// and sent with retries. Failed mails stay in the outbox and can be resent.
+type Mail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created     *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated     *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Template    string               `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"`
+	Subject     string               `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	Recipients  []string             `protobuf:"bytes,6,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	Body        string               `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"` // Rendered HTML
+	Status      Mail_Status          `protobuf:"varint,8,opt,name=status,proto3,enum=shop.Mail_Status" json:"status,omitempty"`
+	Attempts    int32                `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttempt *timestamp.Timestamp `protobuf:"bytes,10,opt,name=next_attempt,json=nextAttempt,proto3" json:"next_attempt,omitempty"` // Unset when sent or failed
+	Sent        *timestamp.Timestamp `protobuf:"bytes,11,opt,name=sent,proto3" json:"sent,omitempty"`
+	LastError   string               `protobuf:"bytes,12,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	TextBody    string               `protobuf:"bytes,13,opt,name=text_body,json=textBody,proto3" json:"text_body,omitempty"` // Rendered plain-text alternative, if any
+}
+
+func (x *Mail) Reset() {
+	*x = Mail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mail) ProtoMessage() {}
+
+func (x *Mail) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mail.ProtoReflect.Descriptor instead.
+func (*Mail) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Mail) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Mail) GetCreated() *timestamp.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Mail) GetUpdated() *timestamp.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *Mail) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *Mail) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Mail) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *Mail) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Mail) GetStatus() Mail_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Mail_PENDING
+}
+
+func (x *Mail) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Mail) GetNextAttempt() *timestamp.Timestamp {
+	if x != nil {
+		return x.NextAttempt
+	}
+	return nil
+}
+
+func (x *Mail) GetSent() *timestamp.Timestamp {
+	if x != nil {
+		return x.Sent
+	}
+	return nil
+}
+
+func (x *Mail) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Mail) GetTextBody() string {
+	if x != nil {
+		return x.TextBody
+	}
+	return ""
+}
+
+type MailID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *MailID) Reset() {
+	*x = MailID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailID) ProtoMessage() {}
+
+func (x *MailID) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailID.ProtoReflect.Descriptor instead.
+func (*MailID) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MailID) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *MailID) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type MailListConditions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status MailListConditions_Status `protobuf:"varint,1,opt,name=status,proto3,enum=shop.MailListConditions_Status" json:"status,omitempty"`
+	Limit  int32                     `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Defaults to list_limit from the server config
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *MailListConditions) Reset() {
+	*x = MailListConditions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailListConditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailListConditions) ProtoMessage() {}
+
+func (x *MailListConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailListConditions.ProtoReflect.Descriptor instead.
+func (*MailListConditions) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MailListConditions) GetStatus() MailListConditions_Status {
+	if x != nil {
+		return x.Status
+	}
+	return MailListConditions_ANY
+}
+
+func (x *MailListConditions) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *MailListConditions) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type MailList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*Mail `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *MailList) Reset() {
+	*x = MailList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailList) ProtoMessage() {}
+
+func (x *MailList) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailList.ProtoReflect.Descriptor instead.
+func (*MailList) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MailList) GetList() []*Mail {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type Subscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email        string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`                                   // Required
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                     // Optional
+	Locale       string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`                                 // Language of the confirmation mail. Defaults to the shop locale
+	Source       string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`                                 // Optional; where the subscription was made, for example "footer"
+	ConsentText  string `protobuf:"bytes,5,opt,name=consent_text,json=consentText,proto3" json:"consent_text,omitempty"`    // The consent text shown to the subscriber. Defaults to the configured text
+	CaptchaToken string `protobuf:"bytes,6,opt,name=captcha_token,json=captchaToken,proto3" json:"captcha_token,omitempty"` // Required when the server verifies captchas
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Subscription) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Subscription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Subscription) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Subscription) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Subscription) GetConsentText() string {
+	if x != nil {
+		return x.ConsentText
+	}
+	return ""
+}
+
+func (x *Subscription) GetCaptchaToken() string {
+	if x != nil {
+		return x.CaptchaToken
+	}
+	return ""
+}
+
+// SubscriptionReply is intentionally empty,
+// so that it does not reveal if an email is already subscribed.
+type SubscriptionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscriptionReply) Reset() {
+	*x = SubscriptionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionReply) ProtoMessage() {}
+
+func (x *SubscriptionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionReply.ProtoReflect.Descriptor instead.
+func (*SubscriptionReply) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{57}
+}
+
+type SubscriptionToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // Signed token from the confirmation or unsubscribe link
+}
+
+func (x *SubscriptionToken) Reset() {
+	*x = SubscriptionToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionToken) ProtoMessage() {}
+
+func (x *SubscriptionToken) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionToken.ProtoReflect.Descriptor instead.
+func (*SubscriptionToken) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SubscriptionToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type Subscriber struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created      *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated      *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Email        string               `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Name         string               `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Locale       string               `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
+	Status       Subscriber_Status    `protobuf:"varint,7,opt,name=status,proto3,enum=shop.Subscriber_Status" json:"status,omitempty"`
+	Confirmed    *timestamp.Timestamp `protobuf:"bytes,8,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Unsubscribed *timestamp.Timestamp `protobuf:"bytes,9,opt,name=unsubscribed,proto3" json:"unsubscribed,omitempty"`
+}
+
+func (x *Subscriber) Reset() {
+	*x = Subscriber{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subscriber) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscriber) ProtoMessage() {}
+
+func (x *Subscriber) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscriber.ProtoReflect.Descriptor instead.
+func (*Subscriber) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Subscriber) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Subscriber) GetCreated() *timestamp.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Subscriber) GetUpdated() *timestamp.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *Subscriber) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Subscriber) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Subscriber) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Subscriber) GetStatus() Subscriber_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Subscriber_PENDING
+}
+
+func (x *Subscriber) GetConfirmed() *timestamp.Timestamp {
+	if x != nil {
+		return x.Confirmed
+	}
+	return nil
+}
+
+func (x *Subscriber) GetUnsubscribed() *timestamp.Timestamp {
+	if x != nil {
+		return x.Unsubscribed
+	}
+	return nil
+}
+
+type SubscriberExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *SubscriberExportRequest) Reset() {
+	*x = SubscriberExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriberExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriberExportRequest) ProtoMessage() {}
+
+func (x *SubscriberExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriberExportRequest.ProtoReflect.Descriptor instead.
+func (*SubscriberExportRequest) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{60}
+}
+
+// Deprecated: Do not use.
+func (x *SubscriberExportRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type PersonalDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: Do not use.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *PersonalDataRequest) Reset() {
+	*x = PersonalDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonalDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonalDataRequest) ProtoMessage() {}
+
+func (x *PersonalDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonalDataRequest.ProtoReflect.Descriptor instead.
+func (*PersonalDataRequest) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{61}
+}
+
+// Deprecated: Do not use.
+func (x *PersonalDataRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PersonalDataRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type PersonalDataArchive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Data     []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"` // JSON document
+}
+
+func (x *PersonalDataArchive) Reset() {
+	*x = PersonalDataArchive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonalDataArchive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonalDataArchive) ProtoMessage() {}
+
+func (x *PersonalDataArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonalDataArchive.ProtoReflect.Descriptor instead.
+func (*PersonalDataArchive) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PersonalDataArchive) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *PersonalDataArchive) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ErasureReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email             string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Orders            int32  `protobuf:"varint,2,opt,name=orders,proto3" json:"orders,omitempty"`                                                // Anonymized orders
+	Payments          int32  `protobuf:"varint,3,opt,name=payments,proto3" json:"payments,omitempty"`                                            // Redacted payment confirmations
+	Messages          int32  `protobuf:"varint,4,opt,name=messages,proto3" json:"messages,omitempty"`                                            // Deleted messages
+	Mails             int32  `protobuf:"varint,5,opt,name=mails,proto3" json:"mails,omitempty"`                                                  // Deleted mails
+	WebhookDeliveries int32  `protobuf:"varint,6,opt,name=webhook_deliveries,json=webhookDeliveries,proto3" json:"webhook_deliveries,omitempty"` // Deleted webhook deliveries
+	Subscriber        bool   `protobuf:"varint,7,opt,name=subscriber,proto3" json:"subscriber,omitempty"`                                        // Newsletter subscription deleted
+	Account           bool   `protobuf:"varint,8,opt,name=account,proto3" json:"account,omitempty"`                                              // Authenticator account anonymized
+}
+
+func (x *ErasureReport) Reset() {
+	*x = ErasureReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErasureReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErasureReport) ProtoMessage() {}
+
+func (x *ErasureReport) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErasureReport.ProtoReflect.Descriptor instead.
+func (*ErasureReport) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ErasureReport) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ErasureReport) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *ErasureReport) GetPayments() int32 {
+	if x != nil {
+		return x.Payments
+	}
+	return 0
+}
+
+func (x *ErasureReport) GetMessages() int32 {
+	if x != nil {
+		return x.Messages
+	}
+	return 0
+}
+
+func (x *ErasureReport) GetMails() int32 {
+	if x != nil {
+		return x.Mails
+	}
+	return 0
+}
+
+func (x *ErasureReport) GetWebhookDeliveries() int32 {
+	if x != nil {
+		return x.WebhookDeliveries
+	}
+	return 0
+}
+
+func (x *ErasureReport) GetSubscriber() bool {
+	if x != nil {
+		return x.Subscriber
+	}
+	return false
+}
+
+func (x *ErasureReport) GetAccount() bool {
+	if x != nil {
+		return x.Account
+	}
+	return false
+}
+
+type Order_ArticleAmount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArticleId   int32    `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Amount      int32    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Title       string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                                   // Read only
+	Price       string   `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`                                   // Read only
+	Total       string   `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`                                   // Read only; line total of Price*Amount
+	Details     *Details `protobuf:"bytes,6,opt,name=details,proto3" json:"details,omitempty"`                               // Read only
+	BasePriceId int32    `protobuf:"varint,7,opt,name=base_price_id,json=basePriceId,proto3" json:"base_price_id,omitempty"` // Checkout write only
+	VariantId   int64    `protobuf:"varint,8,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`         // Required on Checkout for articles with variants
+}
+
+func (x *Order_ArticleAmount) Reset() {
+	*x = Order_ArticleAmount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Order_ArticleAmount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order_ArticleAmount) ProtoMessage() {}
+
+func (x *Order_ArticleAmount) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order_ArticleAmount.ProtoReflect.Descriptor instead.
+func (*Order_ArticleAmount) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *Order_ArticleAmount) GetArticleId() int32 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *Order_ArticleAmount) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Order_ArticleAmount) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Order_ArticleAmount) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *Order_ArticleAmount) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *Order_ArticleAmount) GetDetails() *Details {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *Order_ArticleAmount) GetBasePriceId() int32 {
+	if x != nil {
+		return x.BasePriceId
+	}
+	return 0
+}
+
+func (x *Order_ArticleAmount) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+type RevenueReport_Period struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start             *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Orders            int32                `protobuf:"varint,2,opt,name=orders,proto3" json:"orders,omitempty"`
+	Revenue           string               `protobuf:"bytes,3,opt,name=revenue,proto3" json:"revenue,omitempty"`                                                // numeric
+	AverageOrderValue string               `protobuf:"bytes,4,opt,name=average_order_value,json=averageOrderValue,proto3" json:"average_order_value,omitempty"` // numeric
+}
+
+func (x *RevenueReport_Period) Reset() {
+	*x = RevenueReport_Period{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevenueReport_Period) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueReport_Period) ProtoMessage() {}
+
+func (x *RevenueReport_Period) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueReport_Period.ProtoReflect.Descriptor instead.
+func (*RevenueReport_Period) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *RevenueReport_Period) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *RevenueReport_Period) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *RevenueReport_Period) GetRevenue() string {
+	if x != nil {
+		return x.Revenue
+	}
+	return ""
+}
+
+func (x *RevenueReport_Period) GetAverageOrderValue() string {
+	if x != nil {
+		return x.AverageOrderValue
+	}
+	return ""
+}
+
+type TopArticlesReport_Article struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArticleId int32  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Title     string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"` // Title on the most recent order
+	Quantity  int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Revenue   string `protobuf:"bytes,4,opt,name=revenue,proto3" json:"revenue,omitempty"` // numeric
+}
+
+func (x *TopArticlesReport_Article) Reset() {
+	*x = TopArticlesReport_Article{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopArticlesReport_Article) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopArticlesReport_Article) ProtoMessage() {}
+
+func (x *TopArticlesReport_Article) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopArticlesReport_Article.ProtoReflect.Descriptor instead.
+func (*TopArticlesReport_Article) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{30, 0}
+}
+
+func (x *TopArticlesReport_Article) GetArticleId() int32 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *TopArticlesReport_Article) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TopArticlesReport_Article) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TopArticlesReport_Article) GetRevenue() string {
+	if x != nil {
+		return x.Revenue
+	}
+	return ""
+}
+
+type OrdersReport_PaymentMethod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentMethod Order_PaymentMethod `protobuf:"varint,1,opt,name=payment_method,json=paymentMethod,proto3,enum=shop.Order_PaymentMethod" json:"payment_method,omitempty"`
+	Orders        int32               `protobuf:"varint,2,opt,name=orders,proto3" json:"orders,omitempty"`
+	Revenue       string              `protobuf:"bytes,3,opt,name=revenue,proto3" json:"revenue,omitempty"` // numeric
+}
+
+func (x *OrdersReport_PaymentMethod) Reset() {
+	*x = OrdersReport_PaymentMethod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrdersReport_PaymentMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrdersReport_PaymentMethod) ProtoMessage() {}
+
+func (x *OrdersReport_PaymentMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrdersReport_PaymentMethod.ProtoReflect.Descriptor instead.
+func (*OrdersReport_PaymentMethod) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *OrdersReport_PaymentMethod) GetPaymentMethod() Order_PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return Order_CASH_ON_DELIVERY
+}
+
+func (x *OrdersReport_PaymentMethod) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *OrdersReport_PaymentMethod) GetRevenue() string {
+	if x != nil {
+		return x.Revenue
+	}
+	return ""
+}
+
+type OrdersReport_Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status Order_Status `protobuf:"varint,1,opt,name=status,proto3,enum=shop.Order_Status" json:"status,omitempty"`
+	Orders int32        `protobuf:"varint,2,opt,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (x *OrdersReport_Status) Reset() {
+	*x = OrdersReport_Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shop_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrdersReport_Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrdersReport_Status) ProtoMessage() {}
+
+func (x *OrdersReport_Status) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrdersReport_Status.ProtoReflect.Descriptor instead.
+func (*OrdersReport_Status) Descriptor() ([]byte, []int) {
+	return file_shop_proto_rawDescGZIP(), []int{31, 1}
+}
+
+func (x *OrdersReport_Status) GetStatus() Order_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Order_OPEN
+}
+
+func (x *OrdersReport_Status) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+var File_shop_proto protoreflect.FileDescriptor
+
+var file_shop_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x73, 0x68,
+	0x6f, 0x70, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x35, 0x0a, 0x09, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3f, 0x0a, 0x05, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xcd, 0x01, 0x0a, 0x09, 0x42, 0x61,
+	0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a,
+	0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x18, 0x0a, 0x16, 0x42, 0x61, 0x73,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x34, 0x0a, 0x0d, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xcc, 0x02, 0x0a, 0x07, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x75,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x74, 0x6f, 0x63, 0x6b,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x22, 0x62, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x42,
+	0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x9d, 0x01, 0x0a,
+	0x0e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x99, 0x07, 0x0a,
+	0x07, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34,
+	0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x23, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x06, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x52, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64,
+	0x12, 0x2e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x2f, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x42, 0x61, 0x73, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x29, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x3d, 0x0a,
+	0x0c, 0x75, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0b, 0x75, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x61, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73,
+	0x61, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x61, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x65,
+	0x6e, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x61, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xc2, 0x02, 0x0a, 0x10, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a,
+	0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x0a, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x62, 0x61, 0x73,
+	0x65, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x73, 0x68, 0x6f, 0x70, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x2f, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x36, 0x0a,
+	0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xf6, 0x02, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x6e, 0x6c, 0x79,
+	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x6f, 0x6e, 0x6c, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x6e, 0x6c,
+	0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x2b,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x24, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52,
+	0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x68,
+	0x6f, 0x70, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x85,
+	0x01, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6f,
+	0x6e, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x6e, 0x65,
+	0x4f, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x30, 0x0a, 0x0b, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x24, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52,
+	0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0xb9, 0x01, 0x0a, 0x0f, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x27, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x22, 0x40, 0x0a,
+	0x13, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22,
+	0x77, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x18,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x36, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x6b, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x55, 0x0a,
+	0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x7d, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x6e, 0x6c, 0x79,
+	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12,
+	0x28, 0x0a, 0x10, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6f, 0x6e, 0x6c, 0x79, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x1d, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x6f,
+	0x77, 0x73, 0x22, 0xd7, 0x07, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x40,
+	0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x08,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x41, 0x72, 0x74, 0x69,
+	0x63, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x75, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x73, 0x6c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x73, 0x6c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x1a, 0xf4, 0x01, 0x0a, 0x0d, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x27,
+	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x0d, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x10, 0x43,
+	0x41, 0x53, 0x48, 0x5f, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x10,
+	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x41, 0x4e, 0x4b, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x45, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x02,
+	0x22, 0x3a, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50,
+	0x45, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a,
+	0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x22, 0x46, 0x0a, 0x07,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x76, 0x4b, 0x65, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xae, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x73,
+	0x68, 0x6f, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x43, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e,
+	0x59, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c,
+	0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
+	0x4c, 0x45, 0x44, 0x10, 0x04, 0x22, 0x2c, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x85, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x3b, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x68, 0x6f,
+	0x70, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x28, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x41, 0x59, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x45,
+	0x45, 0x4b, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x02, 0x22,
+	0xc6, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x34, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x07,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x9c, 0x01, 0x0a, 0x06, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8b, 0x02, 0x0a, 0x11, 0x54, 0x6f, 0x70,
+	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x40,
+	0x0a, 0x0b, 0x62, 0x79, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x54, 0x6f, 0x70, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x52, 0x0a, 0x62, 0x79, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x3e, 0x0a, 0x0a, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x54, 0x6f, 0x70, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x09, 0x62, 0x79, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x1a, 0x74, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
+	0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x22, 0xd7, 0x03, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x49, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x69, 0x64, 0x12,
+	0x2b, 0x0a, 0x11, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x83, 0x01, 0x0a,
+	0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x40,
+	0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e,
+	0x75, 0x65, 0x1a, 0x4c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x73,
+	0x68, 0x6f, 0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x22, 0xbc, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x33, 0x0a, 0x06, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x10,
+	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x4f, 0x50, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x43, 0x4c, 0x45,
+	0x53, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x53, 0x10, 0x02, 0x22,
+	0x3b, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x53, 0x56, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9c, 0x01, 0x0a,
+	0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x34, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x4c, 0x0a, 0x0c, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68, 0x6f, 0x70,
+	0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xda, 0x02, 0x0a, 0x09, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a,
+	0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0x33, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58,
+	0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x01, 0x12,
+	0x08, 0x0a, 0x04, 0x45, 0x4e, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x4f, 0x4f,
+	0x4c, 0x45, 0x41, 0x4e, 0x10, 0x03, 0x22, 0x4e, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x43, 0x0a, 0x17, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6f, 0x6e, 0x6c,
+	0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x16, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x6f, 0x6e, 0x6c, 0x79, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x22, 0x20, 0x0a,
+	0x0a, 0x54, 0x65, 0x78, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22,
+	0x65, 0x0a, 0x0e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x2a, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x0a,
+	0x07, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x07, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x22, 0x82, 0x03, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x6e, 0x65, 0x79, 0x70,
+	0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x6e, 0x65, 0x79, 0x70,
+	0x6f, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x74, 0x63,
+	0x68, 0x61, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x65, 0x61, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x72, 0x65, 0x61,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x2c, 0x0a,
+	0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e,
+	0x65, 0x77, 0x73, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x6e, 0x65, 0x77, 0x73, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x09, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xb9, 0x01, 0x0a, 0x0c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x67,
+	0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x72, 0x65, 0x61,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbb, 0x01, 0x0a, 0x15, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x36, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x42, 0x4f, 0x58,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x0c,
+	0x0a, 0x08, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03,
+	0x41, 0x4c, 0x4c, 0x10, 0x03, 0x22, 0x30, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x9a, 0x03, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x68, 0x6f, 0x70,
+	0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x89, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49,
+	0x52, 0x4d, 0x45, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x52, 0x54, 0x49, 0x43, 0x4c,
+	0x45, 0x5f, 0x53, 0x41, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x52, 0x54,
+	0x49, 0x43, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x14,
+	0x0a, 0x10, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56,
+	0x45, 0x44, 0x10, 0x05, 0x22, 0x35, 0x0a, 0x09, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49,
+	0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x31, 0x0a, 0x15, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x30,
+	0x0a, 0x0b, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x68,
+	0x6f, 0x70, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x22, 0x8b, 0x04, 0x0a, 0x04, 0x4d, 0x61, 0x69, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x34, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12,
+	0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x78, 0x74, 0x42, 0x6f, 0x64,
+	0x79, 0x22, 0x2b, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4e, 0x54,
+	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0x32,
+	0x0a, 0x06, 0x4d, 0x61, 0x69, 0x6c, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xb3, 0x01, 0x0a, 0x12, 0x4d, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x68, 0x6f, 0x70,
+	0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x34, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x07, 0x0a, 0x03,
+	0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x22, 0x2a, 0x0a, 0x08, 0x4d, 0x61, 0x69, 0x6c,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x54, 0x65,
+	0x78, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x74, 0x63,
+	0x68, 0x61, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x29, 0x0a, 0x11,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xad, 0x03, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x12,
+	0x3e, 0x0a, 0x0c, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0c, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x22,
+	0x36, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52,
+	0x4d, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x53, 0x55, 0x42, 0x53, 0x43,
+	0x52, 0x49, 0x42, 0x45, 0x44, 0x10, 0x02, 0x22, 0x33, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x72, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x45, 0x0a, 0x13,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x22, 0x45, 0x0a, 0x13, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x44,
+	0x61, 0x74, 0x61, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf4, 0x01, 0x0a, 0x0d, 0x45,
+	0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x2a, 0x3e, 0x0a, 0x0b, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x4d, 0x44, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x44, 0x5f, 0x4c, 0x41,
+	0x42, 0x45, 0x4c, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x44, 0x5f, 0x55, 0x52, 0x4c, 0x10,
+	0x03, 0x2a, 0x64, 0x0a, 0x0f, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x50, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x42, 0x50, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x42,
+	0x50, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x42,
+	0x50, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x42,
+	0x50, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x50, 0x5f,
+	0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x05, 0x2a, 0xb9, 0x01, 0x0a, 0x0d, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x52, 0x54,
+	0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x52, 0x54, 0x5f, 0x49, 0x44,
+	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x56, 0x52, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x56, 0x52, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x52, 0x54, 0x5f, 0x4c, 0x41, 0x42, 0x45,
+	0x4c, 0x53, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x56, 0x52, 0x54, 0x5f, 0x4d, 0x55, 0x4c, 0x54,
+	0x49, 0x50, 0x4c, 0x49, 0x45, 0x52, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x52, 0x54, 0x5f,
+	0x53, 0x4b, 0x55, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x52, 0x54, 0x5f, 0x50, 0x52, 0x49,
+	0x43, 0x45, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x43,
+	0x4b, 0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x52, 0x54, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48,
+	0x54, 0x10, 0x09, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x52, 0x54, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45,
+	0x53, 0x10, 0x0a, 0x2a, 0x73, 0x0a, 0x14, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x41,
+	0x54, 0x56, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x54, 0x56, 0x5f,
+	0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x41, 0x54, 0x56, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x10, 0x02, 0x12, 0x0c, 0x0a,
+	0x08, 0x41, 0x54, 0x56, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x41,
+	0x54, 0x56, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x54, 0x56,
+	0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x05, 0x2a, 0xda, 0x01, 0x0a, 0x0d, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c,
+	0x4c, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x50, 0x44, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x10, 0x05, 0x12,
+	0x0f, 0x0a, 0x0b, 0x44, 0x45, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06,
+	0x12, 0x09, 0x0a, 0x05, 0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x50,
+	0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x0b, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x55, 0x42,
+	0x4c, 0x49, 0x53, 0x48, 0x5f, 0x41, 0x54, 0x10, 0x10, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x50,
+	0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x5f, 0x41, 0x54, 0x10, 0x11, 0x12, 0x0e, 0x0a, 0x0a, 0x53,
+	0x41, 0x4c, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x12, 0x12, 0x0e, 0x0a, 0x0a, 0x53,
+	0x41, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x13, 0x12, 0x0c, 0x0a, 0x08, 0x53,
+	0x41, 0x4c, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0x14, 0x22, 0x04, 0x08, 0x08, 0x10, 0x0a, 0x22,
+	0x04, 0x08, 0x0c, 0x10, 0x0f, 0x2a, 0x5a, 0x0a, 0x0e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x41, 0x54, 0x5f, 0x41,
+	0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41, 0x54, 0x5f, 0x49, 0x44, 0x10, 0x01,
+	0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x54, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x10,
+	0x04, 0x32, 0xa9, 0x1d, 0x0a, 0x04, 0x53, 0x68, 0x6f, 0x70, 0x12, 0x46, 0x0a, 0x0b, 0x53, 0x61,
+	0x76, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70,
+	0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x1a, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e,
+	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x44, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x11, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x3a,
+	0x01, 0x2a, 0x12, 0x48, 0x0a, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x12, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
+	0x49, 0x44, 0x1a, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x65, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x73,
+	0x68, 0x6f, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x1a, 0x11, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x0c, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x5a, 0x16, 0x22, 0x11, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74,
+	0x3a, 0x01, 0x2a, 0x12, 0x4a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69,
+	0x63, 0x6c, 0x65, 0x49, 0x44, 0x1a, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a, 0x11, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0x58, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x12, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
+	0x49, 0x44, 0x1a, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x49, 0x44, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x72,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x63, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73,
+	0x12, 0x1b, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x11, 0x2e,
+	0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x3a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x7c,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x19, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69,
+	0x63, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x58, 0x0a, 0x0d,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x10, 0x2e,
+	0x73, 0x68, 0x6f, 0x70, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x1a,
+	0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x44,
+	0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x72, 0x65, 0x76,
+	0x65, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x5d, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x73, 0x68, 0x6f, 0x70, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x3a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x01,
+	0x2a, 0x28, 0x01, 0x30, 0x01, 0x12, 0x56, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x22, 0x1b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x6c, 0x65, 0x73, 0x3a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x30, 0x01, 0x12, 0x3d, 0x0a,
+	0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x0b, 0x2e, 0x73, 0x68, 0x6f, 0x70,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x22, 0x0a, 0x2f,
+	0x76, 0x31, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x4c, 0x0a, 0x0a,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x68, 0x6f,
+	0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a,
+	0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x43, 0x0a, 0x09, 0x53, 0x61,
+	0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0b, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x1a, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x1a, 0x0f, 0x2f, 0x76, 0x31,
+	0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12,
+	0x50, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18,
+	0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f,
+	0x76, 0x31, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x3a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x30,
+	0x01, 0x12, 0x59, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x13, 0x2e, 0x73, 0x68, 0x6f,
+	0x70, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22,
+	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x66, 0x0a, 0x11,
+	0x54, 0x6f, 0x70, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x16, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x17, 0x2e, 0x73, 0x68, 0x6f, 0x70,
+	0x2e, 0x54, 0x6f, 0x70, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x74, 0x6f, 0x70, 0x2d, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x6c, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x12, 0x2e, 0x73,
+	0x68, 0x6f, 0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x56, 0x0a, 0x0c,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x73,
+	0x68, 0x6f, 0x70, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x53, 0x56, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
+	0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x3a, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x53, 0x0a, 0x0e, 0x53, 0x61, 0x76, 0x65, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x68, 0x6f,
+	0x70, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x19,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x1a, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x5a, 0x0a, 0x0e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x68,
+	0x6f, 0x70, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x12, 0x2e, 0x73, 0x68, 0x6f, 0x70,
+	0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x16, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x54,
+	0x65, 0x78, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x1a, 0x11, 0x2e, 0x73, 0x68, 0x6f, 0x70,
+	0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x12, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x12, 0x46, 0x0a, 0x07, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x12, 0x10, 0x2e, 0x73, 0x68,
+	0x6f, 0x70, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x1a, 0x14, 0x2e,
+	0x73, 0x68, 0x6f, 0x70, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0d, 0x53, 0x61, 0x76, 0x65,
+	0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70,
+	0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x1a, 0x0f, 0x2e, 0x73, 0x68, 0x6f,
+	0x70, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x4e, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70,
+	0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x1a, 0x0d, 0x2e, 0x73, 0x68, 0x6f,
+	0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x15, 0x2a, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x5c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61,
+	0x73, 0x65, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x68, 0x6f, 0x70,
+	0x2e, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x64, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x13, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x42,
+	0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x16, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x1a, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x44, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x76, 0x31,
+	0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x54, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x73,
+	0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x11, 0x2e, 0x73, 0x68, 0x6f, 0x70,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x14, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x44, 0x1a, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x4d, 0x0a, 0x0b, 0x4d,
+	0x61, 0x72, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x11, 0x2e, 0x73, 0x68, 0x6f,
+	0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x1a, 0x0d, 0x2e,
+	0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x1c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x16, 0x32, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x5f, 0x0a, 0x0c, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x2e, 0x73, 0x68, 0x6f,
+	0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x1a, 0x0d,
+	0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x26, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x55, 0x0a, 0x0e, 0x53,
+	0x61, 0x76, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x13, 0x2e,
+	0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x1a,
+	0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x3a,
+	0x01, 0x2a, 0x12, 0x5c, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x13, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10,
+	0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x44, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12,
+	0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x1a, 0x0d,
+	0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x22, 0x17, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x4a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x1a, 0x0d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a,
+	0x11, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0x54, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x11, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x48, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x61, 0x69,
+	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x0e, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x48, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x69, 0x6c,
+	0x12, 0x0c, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x49, 0x44, 0x1a, 0x0a,
+	0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x3a, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x5f, 0x0a, 0x09,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x12, 0x2e, 0x73, 0x68, 0x6f, 0x70,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x17, 0x2e,
+	0x73, 0x68, 0x6f, 0x70, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a,
+	0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x65, 0x77, 0x73, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x63, 0x0a,
+	0x13, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x10, 0x2e,
+	0x73, 0x68, 0x6f, 0x70, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x22,
+	0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x65, 0x77,
+	0x73, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x3a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x3a,
+	0x01, 0x2a, 0x12, 0x5f, 0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x12, 0x17, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x10, 0x2e, 0x73, 0x68, 0x6f,
+	0x70, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x22, 0x25, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x65, 0x77, 0x73, 0x6c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x3a, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x3a, 0x01, 0x2a, 0x12, 0x6e, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x53, 0x56, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
+	0x12, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x65, 0x77, 0x73, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x3a, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x6f, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x19, 0x2e, 0x73, 0x68, 0x6f, 0x70,
+	0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x22,
+	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x3a, 0x01, 0x2a, 0x12, 0x67, 0x0a, 0x11, 0x45, 0x72, 0x61, 0x73, 0x65, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x19, 0x2e, 0x73, 0x68, 0x6f, 0x70,
+	0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x45, 0x72, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x2d,
+	0x64, 0x61, 0x74, 0x61, 0x3a, 0x65, 0x72, 0x61, 0x73, 0x65, 0x3a, 0x01, 0x2a, 0x42, 0x18, 0x5a,
+	0x16, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x6f, 0x61, 0x70,
+	0x69, 0x73, 0x2f, 0x73, 0x68, 0x6f, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_shop_proto_rawDescOnce sync.Once
+	file_shop_proto_rawDescData = file_shop_proto_rawDesc
+)
+
+func file_shop_proto_rawDescGZIP() []byte {
+	file_shop_proto_rawDescOnce.Do(func() {
+		file_shop_proto_rawDescData = protoimpl.X.CompressGZIP(file_shop_proto_rawDescData)
+	})
+	return file_shop_proto_rawDescData
+}
+
+var file_shop_proto_enumTypes = make([]protoimpl.EnumInfo, 17)
+var file_shop_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
+var file_shop_proto_goTypes = []interface{}{
+	(MediaFields)(0),                   // 0: shop.MediaFields
+	(BasePriceFields)(0),               // 1: shop.BasePriceFields
+	(VariantFields)(0),                 // 2: shop.VariantFields
+	(AttributeValueFields)(0),          // 3: shop.AttributeValueFields
+	(ArticleFields)(0),                 // 4: shop.ArticleFields
+	(CategoryFields)(0),                // 5: shop.CategoryFields
+	(Order_PaymentMethod)(0),           // 6: shop.Order.PaymentMethod
+	(Order_Status)(0),                  // 7: shop.Order.Status
+	(ListOrderConditions_Status)(0),    // 8: shop.ListOrderConditions.Status
+	(ReportConditions_Interval)(0),     // 9: shop.ReportConditions.Interval
+	(ExportReportRequest_Report)(0),    // 10: shop.ExportReportRequest.Report
+	(Attribute_Type)(0),                // 11: shop.Attribute.Type
+	(MessageListConditions_Status)(0),  // 12: shop.MessageListConditions.Status
+	(Webhook_Event)(0),                 // 13: shop.Webhook.Event
+	(Mail_Status)(0),                   // 14: shop.Mail.Status
+	(MailListConditions_Status)(0),     // 15: shop.MailListConditions.Status
+	(Subscriber_Status)(0),             // 16: shop.Subscriber.Status
+	(*ArticleID)(nil),                  // 17: shop.ArticleID
+	(*Media)(nil),                      // 18: shop.Media
+	(*BasePrice)(nil),                  // 19: shop.BasePrice
+	(*BasePriceListCondtions)(nil),     // 20: shop.BasePriceListCondtions
+	(*BasePriceList)(nil),              // 21: shop.BasePriceList
+	(*Variant)(nil),                    // 22: shop.Variant
+	(*Details)(nil),                    // 23: shop.Details
+	(*AttributeValue)(nil),             // 24: shop.AttributeValue
+	(*Article)(nil),                    // 25: shop.Article
+	(*ArticleRelations)(nil),           // 26: shop.ArticleRelations
+	(*Limits)(nil),                     // 27: shop.Limits
+	(*ListConditions)(nil),             // 28: shop.ListConditions
+	(*AttributeFilter)(nil),            // 29: shop.AttributeFilter
+	(*ArticleList)(nil),                // 30: shop.ArticleList
+	(*DeletedListConditions)(nil),      // 31: shop.DeletedListConditions
+	(*ArticleRevision)(nil),            // 32: shop.ArticleRevision
+	(*ArticleRevisionList)(nil),        // 33: shop.ArticleRevisionList
+	(*RevisionListConditions)(nil),     // 34: shop.RevisionListConditions
+	(*RevisionID)(nil),                 // 35: shop.RevisionID
+	(*ImportRequest)(nil),              // 36: shop.ImportRequest
+	(*ImportResult)(nil),               // 37: shop.ImportResult
+	(*ExportConditions)(nil),           // 38: shop.ExportConditions
+	(*Deleted)(nil),                    // 39: shop.Deleted
+	(*Order)(nil),                      // 40: shop.Order
+	(*OrderID)(nil),                    // 41: shop.OrderID
+	(*ListOrderConditions)(nil),        // 42: shop.ListOrderConditions
+	(*OrderList)(nil),                  // 43: shop.OrderList
+	(*WatchOrdersRequest)(nil),         // 44: shop.WatchOrdersRequest
+	(*ReportConditions)(nil),           // 45: shop.ReportConditions
+	(*RevenueReport)(nil),              // 46: shop.RevenueReport
+	(*TopArticlesReport)(nil),          // 47: shop.TopArticlesReport
+	(*OrdersReport)(nil),               // 48: shop.OrdersReport
+	(*ExportReportRequest)(nil),        // 49: shop.ExportReportRequest
+	(*ReportCSV)(nil),                  // 50: shop.ReportCSV
+	(*Category)(nil),                   // 51: shop.Category
+	(*CategoryList)(nil),               // 52: shop.CategoryList
+	(*Attribute)(nil),                  // 53: shop.Attribute
+	(*AttributeList)(nil),              // 54: shop.AttributeList
+	(*AttributeListConditions)(nil),    // 55: shop.AttributeListConditions
+	(*CategoryListConditions)(nil),     // 56: shop.CategoryListConditions
+	(*TextSearch)(nil),                 // 57: shop.TextSearch
+	(*SuggestionList)(nil),             // 58: shop.SuggestionList
+	(*Message)(nil),                    // 59: shop.Message
+	(*MessageID)(nil),                  // 60: shop.MessageID
+	(*MessageReply)(nil),               // 61: shop.MessageReply
+	(*MessageMark)(nil),                // 62: shop.MessageMark
+	(*MessageListConditions)(nil),      // 63: shop.MessageListConditions
+	(*MessageList)(nil),                // 64: shop.MessageList
+	(*Webhook)(nil),                    // 65: shop.Webhook
+	(*WebhookID)(nil),                  // 66: shop.WebhookID
+	(*WebhookListConditions)(nil),      // 67: shop.WebhookListConditions
+	(*WebhookList)(nil),                // 68: shop.WebhookList
+	(*Mail)(nil),                       // 69: shop.Mail
+	(*MailID)(nil),                     // 70: shop.MailID
+	(*MailListConditions)(nil),         // 71: shop.MailListConditions
+	(*MailList)(nil),                   // 72: shop.MailList
+	(*Subscription)(nil),               // 73: shop.Subscription
+	(*SubscriptionReply)(nil),          // 74: shop.SubscriptionReply
+	(*SubscriptionToken)(nil),          // 75: shop.SubscriptionToken
+	(*Subscriber)(nil),                 // 76: shop.Subscriber
+	(*SubscriberExportRequest)(nil),    // 77: shop.SubscriberExportRequest
+	(*PersonalDataRequest)(nil),        // 78: shop.PersonalDataRequest
+	(*PersonalDataArchive)(nil),        // 79: shop.PersonalDataArchive
+	(*ErasureReport)(nil),              // 80: shop.ErasureReport
+	(*Order_ArticleAmount)(nil),        // 81: shop.Order.ArticleAmount
+	(*RevenueReport_Period)(nil),       // 82: shop.RevenueReport.Period
+	(*TopArticlesReport_Article)(nil),  // 83: shop.TopArticlesReport.Article
+	(*OrdersReport_PaymentMethod)(nil), // 84: shop.OrdersReport.PaymentMethod
+	(*OrdersReport_Status)(nil),        // 85: shop.OrdersReport.Status
+	(*timestamp.Timestamp)(nil),        // 86: google.protobuf.Timestamp
+}
+var file_shop_proto_depIdxs = []int32{
+	86,  // 0: shop.BasePrice.created:type_name -> google.protobuf.Timestamp
+	86,  // 1: shop.BasePrice.updated:type_name -> google.protobuf.Timestamp
+	19,  // 2: shop.BasePriceList.list:type_name -> shop.BasePrice
+	86,  // 3: shop.Variant.created:type_name -> google.protobuf.Timestamp
+	86,  // 4: shop.Variant.updated:type_name -> google.protobuf.Timestamp
+	19,  // 5: shop.Details.base_price:type_name -> shop.BasePrice
+	22,  // 6: shop.Details.variant:type_name -> shop.Variant
+	11,  // 7: shop.AttributeValue.type:type_name -> shop.Attribute.Type
+	86,  // 8: shop.Article.created:type_name -> google.protobuf.Timestamp
+	86,  // 9: shop.Article.updated:type_name -> google.protobuf.Timestamp
+	18,  // 10: shop.Article.images:type_name -> shop.Media
+	18,  // 11: shop.Article.videos:type_name -> shop.Media
+	51,  // 12: shop.Article.categories:type_name -> shop.Category
+	19,  // 13: shop.Article.baseprices:type_name -> shop.BasePrice
+	22,  // 14: shop.Article.variants:type_name -> shop.Variant
+	24,  // 15: shop.Article.attributes:type_name -> shop.AttributeValue
+	86,  // 16: shop.Article.publish_at:type_name -> google.protobuf.Timestamp
+	86,  // 17: shop.Article.unpublish_at:type_name -> google.protobuf.Timestamp
+	86,  // 18: shop.Article.sale_start:type_name -> google.protobuf.Timestamp
+	86,  // 19: shop.Article.sale_end:type_name -> google.protobuf.Timestamp
+	86,  // 20: shop.Article.deleted:type_name -> google.protobuf.Timestamp
+	0,   // 21: shop.ArticleRelations.images:type_name -> shop.MediaFields
+	0,   // 22: shop.ArticleRelations.videos:type_name -> shop.MediaFields
+	5,   // 23: shop.ArticleRelations.categories:type_name -> shop.CategoryFields
+	1,   // 24: shop.ArticleRelations.baseprices:type_name -> shop.BasePriceFields
+	2,   // 25: shop.ArticleRelations.variants:type_name -> shop.VariantFields
+	3,   // 26: shop.ArticleRelations.attributes:type_name -> shop.AttributeValueFields
+	4,   // 27: shop.ListConditions.fields:type_name -> shop.ArticleFields
+	26,  // 28: shop.ListConditions.relations:type_name -> shop.ArticleRelations
+	27,  // 29: shop.ListConditions.limits:type_name -> shop.Limits
+	29,  // 30: shop.ListConditions.attributes:type_name -> shop.AttributeFilter
+	25,  // 31: shop.ArticleList.list:type_name -> shop.Article
+	27,  // 32: shop.DeletedListConditions.limits:type_name -> shop.Limits
+	86,  // 33: shop.ArticleRevision.created:type_name -> google.protobuf.Timestamp
+	25,  // 34: shop.ArticleRevision.article:type_name -> shop.Article
+	32,  // 35: shop.ArticleRevisionList.list:type_name -> shop.ArticleRevision
+	27,  // 36: shop.RevisionListConditions.limits:type_name -> shop.Limits
+	25,  // 37: shop.ImportRequest.article:type_name -> shop.Article
+	86,  // 38: shop.Order.created:type_name -> google.protobuf.Timestamp
+	86,  // 39: shop.Order.updated:type_name -> google.protobuf.Timestamp
+	6,   // 40: shop.Order.payment_method:type_name -> shop.Order.PaymentMethod
+	7,   // 41: shop.Order.status:type_name -> shop.Order.Status
+	81,  // 42: shop.Order.articles:type_name -> shop.Order.ArticleAmount
+	8,   // 43: shop.ListOrderConditions.status:type_name -> shop.ListOrderConditions.Status
+	40,  // 44: shop.OrderList.list:type_name -> shop.Order
+	86,  // 45: shop.ReportConditions.from:type_name -> google.protobuf.Timestamp
+	86,  // 46: shop.ReportConditions.to:type_name -> google.protobuf.Timestamp
+	9,   // 47: shop.ReportConditions.interval:type_name -> shop.ReportConditions.Interval
+	82,  // 48: shop.RevenueReport.periods:type_name -> shop.RevenueReport.Period
+	83,  // 49: shop.TopArticlesReport.by_quantity:type_name -> shop.TopArticlesReport.Article
+	83,  // 50: shop.TopArticlesReport.by_revenue:type_name -> shop.TopArticlesReport.Article
+	84,  // 51: shop.OrdersReport.payment_methods:type_name -> shop.OrdersReport.PaymentMethod
+	85,  // 52: shop.OrdersReport.statuses:type_name -> shop.OrdersReport.Status
+	10,  // 53: shop.ExportReportRequest.report:type_name -> shop.ExportReportRequest.Report
+	45,  // 54: shop.ExportReportRequest.conditions:type_name -> shop.ReportConditions
+	86,  // 55: shop.Category.created:type_name -> google.protobuf.Timestamp
+	86,  // 56: shop.Category.updated:type_name -> google.protobuf.Timestamp
+	51,  // 57: shop.CategoryList.list:type_name -> shop.Category
+	86,  // 58: shop.Attribute.created:type_name -> google.protobuf.Timestamp
+	86,  // 59: shop.Attribute.updated:type_name -> google.protobuf.Timestamp
+	11,  // 60: shop.Attribute.type:type_name -> shop.Attribute.Type
+	51,  // 61: shop.Attribute.categories:type_name -> shop.Category
+	53,  // 62: shop.AttributeList.list:type_name -> shop.Attribute
+	51,  // 63: shop.SuggestionList.Category:type_name -> shop.Category
+	25,  // 64: shop.SuggestionList.Article:type_name -> shop.Article
+	86,  // 65: shop.Message.created:type_name -> google.protobuf.Timestamp
+	61,  // 66: shop.Message.replies:type_name -> shop.MessageReply
+	86,  // 67: shop.MessageReply.created:type_name -> google.protobuf.Timestamp
+	12,  // 68: shop.MessageListConditions.status:type_name -> shop.MessageListConditions.Status
+	59,  // 69: shop.MessageList.list:type_name -> shop.Message
+	86,  // 70: shop.Webhook.created:type_name -> google.protobuf.Timestamp
+	86,  // 71: shop.Webhook.updated:type_name -> google.protobuf.Timestamp
+	13,  // 72: shop.Webhook.events:type_name -> shop.Webhook.Event
+	65,  // 73: shop.WebhookList.list:type_name -> shop.Webhook
+	86,  // 74: shop.Mail.created:type_name -> google.protobuf.Timestamp
+	86,  // 75: shop.Mail.updated:type_name -> google.protobuf.Timestamp
+	14,  // 76: shop.Mail.status:type_name -> shop.Mail.Status
+	86,  // 77: shop.Mail.next_attempt:type_name -> google.protobuf.Timestamp
+	86,  // 78: shop.Mail.sent:type_name -> google.protobuf.Timestamp
+	15,  // 79: shop.MailListConditions.status:type_name -> shop.MailListConditions.Status
+	69,  // 80: shop.MailList.list:type_name -> shop.Mail
+	86,  // 81: shop.Subscriber.created:type_name -> google.protobuf.Timestamp
+	86,  // 82: shop.Subscriber.updated:type_name -> google.protobuf.Timestamp
+	16,  // 83: shop.Subscriber.status:type_name -> shop.Subscriber.Status
+	86,  // 84: shop.Subscriber.confirmed:type_name -> google.protobuf.Timestamp
+	86,  // 85: shop.Subscriber.unsubscribed:type_name -> google.protobuf.Timestamp
+	23,  // 86: shop.Order.ArticleAmount.details:type_name -> shop.Details
+	86,  // 87: shop.RevenueReport.Period.start:type_name -> google.protobuf.Timestamp
+	6,   // 88: shop.OrdersReport.PaymentMethod.payment_method:type_name -> shop.Order.PaymentMethod
+	7,   // 89: shop.OrdersReport.Status.status:type_name -> shop.Order.Status
+	25,  // 90: shop.Shop.SaveArticle:input_type -> shop.Article
+	17,  // 91: shop.Shop.ViewArticle:input_type -> shop.ArticleID
+	28,  // 92: shop.Shop.ListArticles:input_type -> shop.ListConditions
+	17,  // 93: shop.Shop.DeleteArticle:input_type -> shop.ArticleID
+	17,  // 94: shop.Shop.RestoreArticle:input_type -> shop.ArticleID
+	31,  // 95: shop.Shop.ListDeletedArticles:input_type -> shop.DeletedListConditions
+	34,  // 96: shop.Shop.ListArticleRevisions:input_type -> shop.RevisionListConditions
+	35,  // 97: shop.Shop.RevertArticle:input_type -> shop.RevisionID
+	36,  // 98: shop.Shop.ImportArticles:input_type -> shop.ImportRequest
+	38,  // 99: shop.Shop.ExportArticles:input_type -> shop.ExportConditions
+	40,  // 100: shop.Shop.Checkout:input_type -> shop.Order
+	42,  // 101: shop.Shop.ListOrders:input_type -> shop.ListOrderConditions
+	40,  // 102: shop.Shop.SaveOrder:input_type -> shop.Order
+	44,  // 103: shop.Shop.WatchOrders:input_type -> shop.WatchOrdersRequest
+	45,  // 104: shop.Shop.RevenueReport:input_type -> shop.ReportConditions
+	45,  // 105: shop.Shop.TopArticlesReport:input_type -> shop.ReportConditions
+	45,  // 106: shop.Shop.OrdersReport:input_type -> shop.ReportConditions
+	49,  // 107: shop.Shop.ExportReport:input_type -> shop.ExportReportRequest
+	52,  // 108: shop.Shop.SaveCategories:input_type -> shop.CategoryList
+	56,  // 109: shop.Shop.ListCategories:input_type -> shop.CategoryListConditions
+	57,  // 110: shop.Shop.SearchArticles:input_type -> shop.TextSearch
+	57,  // 111: shop.Shop.Suggest:input_type -> shop.TextSearch
+	19,  // 112: shop.Shop.SaveBasePrice:input_type -> shop.BasePrice
+	19,  // 113: shop.Shop.DeleteBasePrice:input_type -> shop.BasePrice
+	20,  // 114: shop.Shop.ListBasesPrices:input_type -> shop.BasePriceListCondtions
+	59,  // 115: shop.Shop.SendMessage:input_type -> shop.Message
+	63,  // 116: shop.Shop.ListMessages:input_type -> shop.MessageListConditions
+	60,  // 117: shop.Shop.GetMessage:input_type -> shop.MessageID
+	62,  // 118: shop.Shop.MarkMessage:input_type -> shop.MessageMark
+	61,  // 119: shop.Shop.ReplyMessage:input_type -> shop.MessageReply
+	54,  // 120: shop.Shop.SaveAttributes:input_type -> shop.AttributeList
+	55,  // 121: shop.Shop.ListAttributes:input_type -> shop.AttributeListConditions
+	65,  // 122: shop.Shop.SaveWebhook:input_type -> shop.Webhook
+	66,  // 123: shop.Shop.DeleteWebhook:input_type -> shop.WebhookID
+	67,  // 124: shop.Shop.ListWebhooks:input_type -> shop.WebhookListConditions
+	71,  // 125: shop.Shop.ListMails:input_type -> shop.MailListConditions
+	70,  // 126: shop.Shop.ResendMail:input_type -> shop.MailID
+	73,  // 127: shop.Shop.Subscribe:input_type -> shop.Subscription
+	75,  // 128: shop.Shop.ConfirmSubscription:input_type -> shop.SubscriptionToken
+	75,  // 129: shop.Shop.Unsubscribe:input_type -> shop.SubscriptionToken
+	77,  // 130: shop.Shop.ExportSubscribers:input_type -> shop.SubscriberExportRequest
+	78,  // 131: shop.Shop.ExportPersonalData:input_type -> shop.PersonalDataRequest
+	78,  // 132: shop.Shop.ErasePersonalData:input_type -> shop.PersonalDataRequest
+	17,  // 133: shop.Shop.SaveArticle:output_type -> shop.ArticleID
+	25,  // 134: shop.Shop.ViewArticle:output_type -> shop.Article
+	30,  // 135: shop.Shop.ListArticles:output_type -> shop.ArticleList
+	39,  // 136: shop.Shop.DeleteArticle:output_type -> shop.Deleted
+	17,  // 137: shop.Shop.RestoreArticle:output_type -> shop.ArticleID
+	30,  // 138: shop.Shop.ListDeletedArticles:output_type -> shop.ArticleList
+	33,  // 139: shop.Shop.ListArticleRevisions:output_type -> shop.ArticleRevisionList
+	17,  // 140: shop.Shop.RevertArticle:output_type -> shop.ArticleID
+	37,  // 141: shop.Shop.ImportArticles:output_type -> shop.ImportResult
+	25,  // 142: shop.Shop.ExportArticles:output_type -> shop.Article
+	41,  // 143: shop.Shop.Checkout:output_type -> shop.OrderID
+	43,  // 144: shop.Shop.ListOrders:output_type -> shop.OrderList
+	41,  // 145: shop.Shop.SaveOrder:output_type -> shop.OrderID
+	40,  // 146: shop.Shop.WatchOrders:output_type -> shop.Order
+	46,  // 147: shop.Shop.RevenueReport:output_type -> shop.RevenueReport
+	47,  // 148: shop.Shop.TopArticlesReport:output_type -> shop.TopArticlesReport
+	48,  // 149: shop.Shop.OrdersReport:output_type -> shop.OrdersReport
+	50,  // 150: shop.Shop.ExportReport:output_type -> shop.ReportCSV
+	52,  // 151: shop.Shop.SaveCategories:output_type -> shop.CategoryList
+	52,  // 152: shop.Shop.ListCategories:output_type -> shop.CategoryList
+	30,  // 153: shop.Shop.SearchArticles:output_type -> shop.ArticleList
+	58,  // 154: shop.Shop.Suggest:output_type -> shop.SuggestionList
+	19,  // 155: shop.Shop.SaveBasePrice:output_type -> shop.BasePrice
+	39,  // 156: shop.Shop.DeleteBasePrice:output_type -> shop.Deleted
+	21,  // 157: shop.Shop.ListBasesPrices:output_type -> shop.BasePriceList
+	60,  // 158: shop.Shop.SendMessage:output_type -> shop.MessageID
+	64,  // 159: shop.Shop.ListMessages:output_type -> shop.MessageList
+	59,  // 160: shop.Shop.GetMessage:output_type -> shop.Message
+	59,  // 161: shop.Shop.MarkMessage:output_type -> shop.Message
+	59,  // 162: shop.Shop.ReplyMessage:output_type -> shop.Message
+	54,  // 163: shop.Shop.SaveAttributes:output_type -> shop.AttributeList
+	54,  // 164: shop.Shop.ListAttributes:output_type -> shop.AttributeList
+	65,  // 165: shop.Shop.SaveWebhook:output_type -> shop.Webhook
+	39,  // 166: shop.Shop.DeleteWebhook:output_type -> shop.Deleted
+	68,  // 167: shop.Shop.ListWebhooks:output_type -> shop.WebhookList
+	72,  // 168: shop.Shop.ListMails:output_type -> shop.MailList
+	69,  // 169: shop.Shop.ResendMail:output_type -> shop.Mail
+	74,  // 170: shop.Shop.Subscribe:output_type -> shop.SubscriptionReply
+	76,  // 171: shop.Shop.ConfirmSubscription:output_type -> shop.Subscriber
+	76,  // 172: shop.Shop.Unsubscribe:output_type -> shop.Subscriber
+	50,  // 173: shop.Shop.ExportSubscribers:output_type -> shop.ReportCSV
+	79,  // 174: shop.Shop.ExportPersonalData:output_type -> shop.PersonalDataArchive
+	80,  // 175: shop.Shop.ErasePersonalData:output_type -> shop.ErasureReport
+	133, // [133:176] is the sub-list for method output_type
+	90,  // [90:133] is the sub-list for method input_type
+	90,  // [90:90] is the sub-list for extension type_name
+	90,  // [90:90] is the sub-list for extension extendee
+	0,   // [0:90] is the sub-list for field type_name
+}
+
+func init() { file_shop_proto_init() }
+func file_shop_proto_init() {
+	if File_shop_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_shop_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArticleID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Media); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BasePrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BasePriceListCondtions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BasePriceList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Variant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Details); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Article); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArticleRelations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Limits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConditions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArticleList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletedListConditions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArticleRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArticleRevisionList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevisionListConditions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevisionID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportConditions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deleted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Order); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrderConditions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchOrdersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportConditions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevenueReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopArticlesReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrdersReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportCSV); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Category); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CategoryList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeListConditions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CategoryListConditions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextSearch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestionList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageMark); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shop_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageListConditions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
 			default:
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BasePriceListCondtions); i {
+		file_shop_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2720,8 +7826,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BasePriceList); i {
+		file_shop_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Webhook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2732,8 +7838,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Variant); i {
+		file_shop_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2744,8 +7850,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Details); i {
+		file_shop_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookListConditions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2756,8 +7862,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Article); i {
+		file_shop_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2768,8 +7874,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArticleRelations); i {
+		file_shop_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2780,8 +7886,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Limits); i {
+		file_shop_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2792,8 +7898,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListConditions); i {
+		file_shop_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailListConditions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2804,8 +7910,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArticleList); i {
+		file_shop_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2816,8 +7922,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Deleted); i {
+		file_shop_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Subscription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2828,8 +7934,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Order); i {
+		file_shop_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriptionReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2840,8 +7946,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderID); i {
+		file_shop_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriptionToken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2852,8 +7958,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListOrderConditions); i {
+		file_shop_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Subscriber); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2864,8 +7970,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderList); i {
+		file_shop_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriberExportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2876,8 +7982,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Category); i {
+		file_shop_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonalDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2888,8 +7994,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CategoryList); i {
+		file_shop_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonalDataArchive); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2900,8 +8006,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CategoryListConditions); i {
+		file_shop_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErasureReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2912,8 +8018,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TextSearch); i {
+		file_shop_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Order_ArticleAmount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2924,8 +8030,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestionList); i {
+		file_shop_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevenueReport_Period); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2936,8 +8042,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message); i {
+		file_shop_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopArticlesReport_Article); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2948,8 +8054,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageID); i {
+		file_shop_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrdersReport_PaymentMethod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2960,8 +8066,8 @@ func file_shop_proto_init() {
 				return nil
 			}
 		}
-		file_shop_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Order_ArticleAmount); i {
+		file_shop_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrdersReport_Status); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2973,56 +8079,698 @@ func file_shop_proto_init() {
 			}
 		}
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_shop_proto_rawDesc,
-			NumEnums:      8,
-			NumMessages:   25,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_shop_proto_goTypes,
-		DependencyIndexes: file_shop_proto_depIdxs,
-		EnumInfos:         file_shop_proto_enumTypes,
-		MessageInfos:      file_shop_proto_msgTypes,
-	}.Build()
-	File_shop_proto = out.File
-	file_shop_proto_rawDesc = nil
-	file_shop_proto_goTypes = nil
-	file_shop_proto_depIdxs = nil
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_shop_proto_rawDesc,
+			NumEnums:      17,
+			NumMessages:   69,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_shop_proto_goTypes,
+		DependencyIndexes: file_shop_proto_depIdxs,
+		EnumInfos:         file_shop_proto_enumTypes,
+		MessageInfos:      file_shop_proto_msgTypes,
+	}.Build()
+	File_shop_proto = out.File
+	file_shop_proto_rawDesc = nil
+	file_shop_proto_goTypes = nil
+	file_shop_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ShopClient is the client API for Shop service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ShopClient interface {
+	// SaveArticle updates an article identified by ID.
+	// If ID is unset (0) a new article is insered.
+	// On success the affected article ID is returned.
+	// Every save stores a revision of the resulting article.
+	SaveArticle(ctx context.Context, in *Article, opts ...grpc.CallOption) (*ArticleID, error)
+	// ViewArticle identied by ID.
+	ViewArticle(ctx context.Context, in *ArticleID, opts ...grpc.CallOption) (*Article, error)
+	// ListArticles filtered by ListCondtions.
+	ListArticles(ctx context.Context, in *ListConditions, opts ...grpc.CallOption) (*ArticleList, error)
+	// DeleteArticle moves the article to the trash.
+	// It is no longer listed, viewed or ordered, but can be restored
+	// until it is purged after the configured retention period.
+	DeleteArticle(ctx context.Context, in *ArticleID, opts ...grpc.CallOption) (*Deleted, error)
+	// RestoreArticle restores a deleted article, identified by ID.
+	RestoreArticle(ctx context.Context, in *ArticleID, opts ...grpc.CallOption) (*ArticleID, error)
+	// ListDeletedArticles returns deleted articles, most recently deleted first.
+	ListDeletedArticles(ctx context.Context, in *DeletedListConditions, opts ...grpc.CallOption) (*ArticleList, error)
+	// ListArticleRevisions returns the revisions of an article, newest first.
+	ListArticleRevisions(ctx context.Context, in *RevisionListConditions, opts ...grpc.CallOption) (*ArticleRevisionList, error)
+	// RevertArticle saves the article as it was in the identified revision.
+	// This itself creates a new revision.
+	RevertArticle(ctx context.Context, in *RevisionID, opts ...grpc.CallOption) (*ArticleID, error)
+	// ImportArticles saves a stream of articles, like SaveArticle.
+	// The token and dry_run are taken from the first request.
+	// Every request is answered with an ImportResult.
+	// A failing article is reported in its result and does not abort the import.
+	// With dry_run all articles are validated and saved, after which the import is rolled back.
+	// Otherwise the import is committed after the client closes the stream.
+	ImportArticles(ctx context.Context, opts ...grpc.CallOption) (Shop_ImportArticlesClient, error)
+	// ExportArticles streams all articles filtered by ExportConditions, including their relations.
+	ExportArticles(ctx context.Context, in *ExportConditions, opts ...grpc.CallOption) (Shop_ExportArticlesClient, error)
+	// Checkout an order of articles
+	Checkout(ctx context.Context, in *Order, opts ...grpc.CallOption) (*OrderID, error)
+	// ListOrders returns all orders
+	ListOrders(ctx context.Context, in *ListOrderConditions, opts ...grpc.CallOption) (*OrderList, error)
+	// SaveOrder updates the order identified by ID.
+	// It is not (yet) possibile to change the articles on an order.
+	SaveOrder(ctx context.Context, in *Order, opts ...grpc.CallOption) (*OrderID, error)
+	// WatchOrders streams new and updated orders as they happen,
+	// including orders of which the payment status changed.
+	// When last_order_id is set, all orders with a higher ID are sent first,
+	// so a client can resume after reconnecting.
+	// An order may be sent more than once.
+	WatchOrders(ctx context.Context, in *WatchOrdersRequest, opts ...grpc.CallOption) (Shop_WatchOrdersClient, error)
+	// RevenueReport returns the revenue and average order value per interval,
+	// over the orders created in the date range. Cancelled orders are left out.
+	RevenueReport(ctx context.Context, in *ReportConditions, opts ...grpc.CallOption) (*RevenueReport, error)
+	// TopArticlesReport returns the best selling articles by quantity and by revenue,
+	// over the orders created in the date range. Cancelled orders are left out.
+	TopArticlesReport(ctx context.Context, in *ReportConditions, opts ...grpc.CallOption) (*TopArticlesReport, error)
+	// OrdersReport returns the orders per payment method and status,
+	// and the conversion of ONLINE orders to paid, over the orders created in the date range.
+	// Cancelled orders are only counted per status.
+	OrdersReport(ctx context.Context, in *ReportConditions, opts ...grpc.CallOption) (*OrdersReport, error)
+	// ExportReport returns one of the above reports as a CSV file.
+	ExportReport(ctx context.Context, in *ExportReportRequest, opts ...grpc.CallOption) (*ReportCSV, error)
+	// SaveCategories upserts the categories in the provided CategoryList.
+	// Returned CategoryList is always in the same order as the provided one.
+	//
+	// New articles should have their ID field set to 0.
+	// Those IDs will be populated in the reponse.
+	// Existing categories should always keep the same ID
+	// and is used for updates and re-sorting.
+	SaveCategories(ctx context.Context, in *CategoryList, opts ...grpc.CallOption) (*CategoryList, error)
+	// ListCategories returns all categories, filtered by CategoryListConditions.
+	// Returned CategoryList is always in the same order as passed to SaveCategories.
+	ListCategories(ctx context.Context, in *CategoryListConditions, opts ...grpc.CallOption) (*CategoryList, error)
+	// SearchArticles returns ArticleList that matches TextSearch.value.
+	SearchArticles(ctx context.Context, in *TextSearch, opts ...grpc.CallOption) (*ArticleList, error)
+	// Suggest returns a list of matching articles and categories
+	Suggest(ctx context.Context, in *TextSearch, opts ...grpc.CallOption) (*SuggestionList, error)
+	// SaveBasePrice updates a base price identified by ID.
+	// If ID is unset (0) a new base price is insered.
+	// On success the affected base price is returned with its ID populated.
+	SaveBasePrice(ctx context.Context, in *BasePrice, opts ...grpc.CallOption) (*BasePrice, error)
+	// DeleteBasePrice dis-assosiates all articles and deleted the given BasePrice
+	DeleteBasePrice(ctx context.Context, in *BasePrice, opts ...grpc.CallOption) (*Deleted, error)
+	// ListBasePrices returns all base prices.
+	ListBasesPrices(ctx context.Context, in *BasePriceListCondtions, opts ...grpc.CallOption) (*BasePriceList, error)
+	// SendMessage sends an email message to the site admin.
+	// Messages with a filled honeypot are silently dropped.
+	// Clients exceeding the configured rate get ResourceExhausted.
+	SendMessage(ctx context.Context, in *Message, opts ...grpc.CallOption) (*MessageID, error)
+	// ListMessages returns contact messages, newest first, filtered by MessageListConditions.
+	// Replies are not included.
+	ListMessages(ctx context.Context, in *MessageListConditions, opts ...grpc.CallOption) (*MessageList, error)
+	// GetMessage returns a contact message with its reply thread.
+	GetMessage(ctx context.Context, in *MessageID, opts ...grpc.CallOption) (*Message, error)
+	// MarkMessage sets the read and archived flags of a message.
+	MarkMessage(ctx context.Context, in *MessageMark, opts ...grpc.CallOption) (*Message, error)
+	// ReplyMessage mails a reply to the sender of a message and adds it to the thread.
+	// The message is marked read.
+	ReplyMessage(ctx context.Context, in *MessageReply, opts ...grpc.CallOption) (*Message, error)
+	// SaveAttributes upserts the attribute definitions in the provided AttributeList.
+	// Returned AttributeList is always in the same order as the provided one.
+	//
+	// New attributes should have their ID field set to 0.
+	// Those IDs will be populated in the reponse.
+	// The categories of each attribute are replaced by the provided ones.
+	SaveAttributes(ctx context.Context, in *AttributeList, opts ...grpc.CallOption) (*AttributeList, error)
+	// ListAttributes returns all attribute definitions, filtered by AttributeListConditions.
+	// Returned AttributeList is always in the same order as passed to SaveAttributes.
+	ListAttributes(ctx context.Context, in *AttributeListConditions, opts ...grpc.CallOption) (*AttributeList, error)
+	// SaveWebhook updates a webhook identified by ID.
+	// If ID is unset (0) a new webhook is inserted.
+	// On success the affected webhook is returned with its ID populated.
+	SaveWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error)
+	// DeleteWebhook deletes the webhook and all its deliveries.
+	DeleteWebhook(ctx context.Context, in *WebhookID, opts ...grpc.CallOption) (*Deleted, error)
+	// ListWebhooks returns all webhooks.
+	ListWebhooks(ctx context.Context, in *WebhookListConditions, opts ...grpc.CallOption) (*WebhookList, error)
+	// ListMails returns the mails in the outbox, filtered by MailListConditions.
+	// Newest mails are returned first.
+	ListMails(ctx context.Context, in *MailListConditions, opts ...grpc.CallOption) (*MailList, error)
+	// ResendMail schedules a failed or sent mail for immediate delivery.
+	// On success the rescheduled mail is returned.
+	ResendMail(ctx context.Context, in *MailID, opts ...grpc.CallOption) (*Mail, error)
+	// Subscribe registers an email for the newsletter and mails a confirmation link.
+	// The subscription remains pending until it is confirmed with the token from that link.
+	// The reply is the same for new and existing subscribers.
+	Subscribe(ctx context.Context, in *Subscription, opts ...grpc.CallOption) (*SubscriptionReply, error)
+	// ConfirmSubscription confirms a pending subscription, using the token from the confirmation mail.
+	ConfirmSubscription(ctx context.Context, in *SubscriptionToken, opts ...grpc.CallOption) (*Subscriber, error)
+	// Unsubscribe removes an email from the newsletter,
+	// using the token from the unsubscribe link in outgoing mails.
+	Unsubscribe(ctx context.Context, in *SubscriptionToken, opts ...grpc.CallOption) (*Subscriber, error)
+	// ExportSubscribers returns the confirmed subscribers with their consent record as CSV.
+	ExportSubscribers(ctx context.Context, in *SubscriberExportRequest, opts ...grpc.CallOption) (*ReportCSV, error)
+	// ExportPersonalData returns everything held about an email address,
+	// in the shop and authenticator databases, as a JSON archive.
+	ExportPersonalData(ctx context.Context, in *PersonalDataRequest, opts ...grpc.CallOption) (*PersonalDataArchive, error)
+	// ErasePersonalData anonymizes everything held about an email address, in place.
+	// Orders keep their articles, amounts and status for accounting, without personal data.
+	// Messages, mails, webhook deliveries and the newsletter subscription are deleted.
+	// Erasure is idempotent: a failed request can be repeated.
+	ErasePersonalData(ctx context.Context, in *PersonalDataRequest, opts ...grpc.CallOption) (*ErasureReport, error)
+}
+
+type shopClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShopClient(cc grpc.ClientConnInterface) ShopClient {
+	return &shopClient{cc}
+}
+
+func (c *shopClient) SaveArticle(ctx context.Context, in *Article, opts ...grpc.CallOption) (*ArticleID, error) {
+	out := new(ArticleID)
+	err := c.cc.Invoke(ctx, "/shop.Shop/SaveArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ViewArticle(ctx context.Context, in *ArticleID, opts ...grpc.CallOption) (*Article, error) {
+	out := new(Article)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ViewArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ListArticles(ctx context.Context, in *ListConditions, opts ...grpc.CallOption) (*ArticleList, error) {
+	out := new(ArticleList)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ListArticles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) DeleteArticle(ctx context.Context, in *ArticleID, opts ...grpc.CallOption) (*Deleted, error) {
+	out := new(Deleted)
+	err := c.cc.Invoke(ctx, "/shop.Shop/DeleteArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) RestoreArticle(ctx context.Context, in *ArticleID, opts ...grpc.CallOption) (*ArticleID, error) {
+	out := new(ArticleID)
+	err := c.cc.Invoke(ctx, "/shop.Shop/RestoreArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ListDeletedArticles(ctx context.Context, in *DeletedListConditions, opts ...grpc.CallOption) (*ArticleList, error) {
+	out := new(ArticleList)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ListDeletedArticles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ListArticleRevisions(ctx context.Context, in *RevisionListConditions, opts ...grpc.CallOption) (*ArticleRevisionList, error) {
+	out := new(ArticleRevisionList)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ListArticleRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) RevertArticle(ctx context.Context, in *RevisionID, opts ...grpc.CallOption) (*ArticleID, error) {
+	out := new(ArticleID)
+	err := c.cc.Invoke(ctx, "/shop.Shop/RevertArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ImportArticles(ctx context.Context, opts ...grpc.CallOption) (Shop_ImportArticlesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Shop_serviceDesc.Streams[0], "/shop.Shop/ImportArticles", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &shopImportArticlesClient{stream}
+	return x, nil
+}
+
+type Shop_ImportArticlesClient interface {
+	Send(*ImportRequest) error
+	Recv() (*ImportResult, error)
+	grpc.ClientStream
+}
+
+type shopImportArticlesClient struct {
+	grpc.ClientStream
+}
+
+func (x *shopImportArticlesClient) Send(m *ImportRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *shopImportArticlesClient) Recv() (*ImportResult, error) {
+	m := new(ImportResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *shopClient) ExportArticles(ctx context.Context, in *ExportConditions, opts ...grpc.CallOption) (Shop_ExportArticlesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Shop_serviceDesc.Streams[1], "/shop.Shop/ExportArticles", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &shopExportArticlesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Shop_ExportArticlesClient interface {
+	Recv() (*Article, error)
+	grpc.ClientStream
+}
+
+type shopExportArticlesClient struct {
+	grpc.ClientStream
+}
+
+func (x *shopExportArticlesClient) Recv() (*Article, error) {
+	m := new(Article)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *shopClient) Checkout(ctx context.Context, in *Order, opts ...grpc.CallOption) (*OrderID, error) {
+	out := new(OrderID)
+	err := c.cc.Invoke(ctx, "/shop.Shop/Checkout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ListOrders(ctx context.Context, in *ListOrderConditions, opts ...grpc.CallOption) (*OrderList, error) {
+	out := new(OrderList)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ListOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) SaveOrder(ctx context.Context, in *Order, opts ...grpc.CallOption) (*OrderID, error) {
+	out := new(OrderID)
+	err := c.cc.Invoke(ctx, "/shop.Shop/SaveOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) WatchOrders(ctx context.Context, in *WatchOrdersRequest, opts ...grpc.CallOption) (Shop_WatchOrdersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Shop_serviceDesc.Streams[2], "/shop.Shop/WatchOrders", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &shopWatchOrdersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+type Shop_WatchOrdersClient interface {
+	Recv() (*Order, error)
+	grpc.ClientStream
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+type shopWatchOrdersClient struct {
+	grpc.ClientStream
+}
 
-// ShopClient is the client API for Shop service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ShopClient interface {
+func (x *shopWatchOrdersClient) Recv() (*Order, error) {
+	m := new(Order)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *shopClient) RevenueReport(ctx context.Context, in *ReportConditions, opts ...grpc.CallOption) (*RevenueReport, error) {
+	out := new(RevenueReport)
+	err := c.cc.Invoke(ctx, "/shop.Shop/RevenueReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) TopArticlesReport(ctx context.Context, in *ReportConditions, opts ...grpc.CallOption) (*TopArticlesReport, error) {
+	out := new(TopArticlesReport)
+	err := c.cc.Invoke(ctx, "/shop.Shop/TopArticlesReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) OrdersReport(ctx context.Context, in *ReportConditions, opts ...grpc.CallOption) (*OrdersReport, error) {
+	out := new(OrdersReport)
+	err := c.cc.Invoke(ctx, "/shop.Shop/OrdersReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ExportReport(ctx context.Context, in *ExportReportRequest, opts ...grpc.CallOption) (*ReportCSV, error) {
+	out := new(ReportCSV)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ExportReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) SaveCategories(ctx context.Context, in *CategoryList, opts ...grpc.CallOption) (*CategoryList, error) {
+	out := new(CategoryList)
+	err := c.cc.Invoke(ctx, "/shop.Shop/SaveCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ListCategories(ctx context.Context, in *CategoryListConditions, opts ...grpc.CallOption) (*CategoryList, error) {
+	out := new(CategoryList)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ListCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) SearchArticles(ctx context.Context, in *TextSearch, opts ...grpc.CallOption) (*ArticleList, error) {
+	out := new(ArticleList)
+	err := c.cc.Invoke(ctx, "/shop.Shop/SearchArticles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) Suggest(ctx context.Context, in *TextSearch, opts ...grpc.CallOption) (*SuggestionList, error) {
+	out := new(SuggestionList)
+	err := c.cc.Invoke(ctx, "/shop.Shop/Suggest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) SaveBasePrice(ctx context.Context, in *BasePrice, opts ...grpc.CallOption) (*BasePrice, error) {
+	out := new(BasePrice)
+	err := c.cc.Invoke(ctx, "/shop.Shop/SaveBasePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) DeleteBasePrice(ctx context.Context, in *BasePrice, opts ...grpc.CallOption) (*Deleted, error) {
+	out := new(Deleted)
+	err := c.cc.Invoke(ctx, "/shop.Shop/DeleteBasePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ListBasesPrices(ctx context.Context, in *BasePriceListCondtions, opts ...grpc.CallOption) (*BasePriceList, error) {
+	out := new(BasePriceList)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ListBasesPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) SendMessage(ctx context.Context, in *Message, opts ...grpc.CallOption) (*MessageID, error) {
+	out := new(MessageID)
+	err := c.cc.Invoke(ctx, "/shop.Shop/SendMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ListMessages(ctx context.Context, in *MessageListConditions, opts ...grpc.CallOption) (*MessageList, error) {
+	out := new(MessageList)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ListMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) GetMessage(ctx context.Context, in *MessageID, opts ...grpc.CallOption) (*Message, error) {
+	out := new(Message)
+	err := c.cc.Invoke(ctx, "/shop.Shop/GetMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) MarkMessage(ctx context.Context, in *MessageMark, opts ...grpc.CallOption) (*Message, error) {
+	out := new(Message)
+	err := c.cc.Invoke(ctx, "/shop.Shop/MarkMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ReplyMessage(ctx context.Context, in *MessageReply, opts ...grpc.CallOption) (*Message, error) {
+	out := new(Message)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ReplyMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) SaveAttributes(ctx context.Context, in *AttributeList, opts ...grpc.CallOption) (*AttributeList, error) {
+	out := new(AttributeList)
+	err := c.cc.Invoke(ctx, "/shop.Shop/SaveAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ListAttributes(ctx context.Context, in *AttributeListConditions, opts ...grpc.CallOption) (*AttributeList, error) {
+	out := new(AttributeList)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ListAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) SaveWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/shop.Shop/SaveWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) DeleteWebhook(ctx context.Context, in *WebhookID, opts ...grpc.CallOption) (*Deleted, error) {
+	out := new(Deleted)
+	err := c.cc.Invoke(ctx, "/shop.Shop/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ListWebhooks(ctx context.Context, in *WebhookListConditions, opts ...grpc.CallOption) (*WebhookList, error) {
+	out := new(WebhookList)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ListWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ListMails(ctx context.Context, in *MailListConditions, opts ...grpc.CallOption) (*MailList, error) {
+	out := new(MailList)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ListMails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ResendMail(ctx context.Context, in *MailID, opts ...grpc.CallOption) (*Mail, error) {
+	out := new(Mail)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ResendMail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) Subscribe(ctx context.Context, in *Subscription, opts ...grpc.CallOption) (*SubscriptionReply, error) {
+	out := new(SubscriptionReply)
+	err := c.cc.Invoke(ctx, "/shop.Shop/Subscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ConfirmSubscription(ctx context.Context, in *SubscriptionToken, opts ...grpc.CallOption) (*Subscriber, error) {
+	out := new(Subscriber)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ConfirmSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) Unsubscribe(ctx context.Context, in *SubscriptionToken, opts ...grpc.CallOption) (*Subscriber, error) {
+	out := new(Subscriber)
+	err := c.cc.Invoke(ctx, "/shop.Shop/Unsubscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ExportSubscribers(ctx context.Context, in *SubscriberExportRequest, opts ...grpc.CallOption) (*ReportCSV, error) {
+	out := new(ReportCSV)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ExportSubscribers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ExportPersonalData(ctx context.Context, in *PersonalDataRequest, opts ...grpc.CallOption) (*PersonalDataArchive, error) {
+	out := new(PersonalDataArchive)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ExportPersonalData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ErasePersonalData(ctx context.Context, in *PersonalDataRequest, opts ...grpc.CallOption) (*ErasureReport, error) {
+	out := new(ErasureReport)
+	err := c.cc.Invoke(ctx, "/shop.Shop/ErasePersonalData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShopServer is the server API for Shop service.
+type ShopServer interface {
 	// SaveArticle updates an article identified by ID.
 	// If ID is unset (0) a new article is insered.
 	// On success the affected article ID is returned.
-	SaveArticle(ctx context.Context, in *Article, opts ...grpc.CallOption) (*ArticleID, error)
+	// Every save stores a revision of the resulting article.
+	SaveArticle(context.Context, *Article) (*ArticleID, error)
 	// ViewArticle identied by ID.
-	ViewArticle(ctx context.Context, in *ArticleID, opts ...grpc.CallOption) (*Article, error)
+	ViewArticle(context.Context, *ArticleID) (*Article, error)
 	// ListArticles filtered by ListCondtions.
-	ListArticles(ctx context.Context, in *ListConditions, opts ...grpc.CallOption) (*ArticleList, error)
-	// DeleteArticle and all associated images and videos
-	DeleteArticle(ctx context.Context, in *ArticleID, opts ...grpc.CallOption) (*Deleted, error)
+	ListArticles(context.Context, *ListConditions) (*ArticleList, error)
+	// DeleteArticle moves the article to the trash.
+	// It is no longer listed, viewed or ordered, but can be restored
+	// until it is purged after the configured retention period.
+	DeleteArticle(context.Context, *ArticleID) (*Deleted, error)
+	// RestoreArticle restores a deleted article, identified by ID.
+	RestoreArticle(context.Context, *ArticleID) (*ArticleID, error)
+	// ListDeletedArticles returns deleted articles, most recently deleted first.
+	ListDeletedArticles(context.Context, *DeletedListConditions) (*ArticleList, error)
+	// ListArticleRevisions returns the revisions of an article, newest first.
+	ListArticleRevisions(context.Context, *RevisionListConditions) (*ArticleRevisionList, error)
+	// RevertArticle saves the article as it was in the identified revision.
+	// This itself creates a new revision.
+	RevertArticle(context.Context, *RevisionID) (*ArticleID, error)
+	// ImportArticles saves a stream of articles, like SaveArticle.
+	// The token and dry_run are taken from the first request.
+	// Every request is answered with an ImportResult.
+	// A failing article is reported in its result and does not abort the import.
+	// With dry_run all articles are validated and saved, after which the import is rolled back.
+	// Otherwise the import is committed after the client closes the stream.
+	ImportArticles(Shop_ImportArticlesServer) error
+	// ExportArticles streams all articles filtered by ExportConditions, including their relations.
+	ExportArticles(*ExportConditions, Shop_ExportArticlesServer) error
 	// Checkout an order of articles
-	Checkout(ctx context.Context, in *Order, opts ...grpc.CallOption) (*OrderID, error)
+	Checkout(context.Context, *Order) (*OrderID, error)
 	// ListOrders returns all orders
-	ListOrders(ctx context.Context, in *ListOrderConditions, opts ...grpc.CallOption) (*OrderList, error)
+	ListOrders(context.Context, *ListOrderConditions) (*OrderList, error)
 	// SaveOrder updates the order identified by ID.
 	// It is not (yet) possibile to change the articles on an order.
-	SaveOrder(ctx context.Context, in *Order, opts ...grpc.CallOption) (*OrderID, error)
+	SaveOrder(context.Context, *Order) (*OrderID, error)
+	// WatchOrders streams new and updated orders as they happen,
+	// including orders of which the payment status changed.
+	// When last_order_id is set, all orders with a higher ID are sent first,
+	// so a client can resume after reconnecting.
+	// An order may be sent more than once.
+	WatchOrders(*WatchOrdersRequest, Shop_WatchOrdersServer) error
+	// RevenueReport returns the revenue and average order value per interval,
+	// over the orders created in the date range. Cancelled orders are left out.
+	RevenueReport(context.Context, *ReportConditions) (*RevenueReport, error)
+	// TopArticlesReport returns the best selling articles by quantity and by revenue,
+	// over the orders created in the date range. Cancelled orders are left out.
+	TopArticlesReport(context.Context, *ReportConditions) (*TopArticlesReport, error)
+	// OrdersReport returns the orders per payment method and status,
+	// and the conversion of ONLINE orders to paid, over the orders created in the date range.
+	// Cancelled orders are only counted per status.
+	OrdersReport(context.Context, *ReportConditions) (*OrdersReport, error)
+	// ExportReport returns one of the above reports as a CSV file.
+	ExportReport(context.Context, *ExportReportRequest) (*ReportCSV, error)
 	// SaveCategories upserts the categories in the provided CategoryList.
 	// Returned CategoryList is always in the same order as the provided one.
 	//
@@ -3030,535 +8778,1003 @@ type ShopClient interface {
 	// Those IDs will be populated in the reponse.
 	// Existing categories should always keep the same ID
 	// and is used for updates and re-sorting.
-	SaveCategories(ctx context.Context, in *CategoryList, opts ...grpc.CallOption) (*CategoryList, error)
+	SaveCategories(context.Context, *CategoryList) (*CategoryList, error)
 	// ListCategories returns all categories, filtered by CategoryListConditions.
 	// Returned CategoryList is always in the same order as passed to SaveCategories.
-	ListCategories(ctx context.Context, in *CategoryListConditions, opts ...grpc.CallOption) (*CategoryList, error)
+	ListCategories(context.Context, *CategoryListConditions) (*CategoryList, error)
 	// SearchArticles returns ArticleList that matches TextSearch.value.
-	SearchArticles(ctx context.Context, in *TextSearch, opts ...grpc.CallOption) (*ArticleList, error)
+	SearchArticles(context.Context, *TextSearch) (*ArticleList, error)
 	// Suggest returns a list of matching articles and categories
-	Suggest(ctx context.Context, in *TextSearch, opts ...grpc.CallOption) (*SuggestionList, error)
+	Suggest(context.Context, *TextSearch) (*SuggestionList, error)
 	// SaveBasePrice updates a base price identified by ID.
 	// If ID is unset (0) a new base price is insered.
 	// On success the affected base price is returned with its ID populated.
-	SaveBasePrice(ctx context.Context, in *BasePrice, opts ...grpc.CallOption) (*BasePrice, error)
+	SaveBasePrice(context.Context, *BasePrice) (*BasePrice, error)
 	// DeleteBasePrice dis-assosiates all articles and deleted the given BasePrice
-	DeleteBasePrice(ctx context.Context, in *BasePrice, opts ...grpc.CallOption) (*Deleted, error)
+	DeleteBasePrice(context.Context, *BasePrice) (*Deleted, error)
 	// ListBasePrices returns all base prices.
-	ListBasesPrices(ctx context.Context, in *BasePriceListCondtions, opts ...grpc.CallOption) (*BasePriceList, error)
-	// SendMessage sends an email message to the site admin
-	SendMessage(ctx context.Context, in *Message, opts ...grpc.CallOption) (*MessageID, error)
+	ListBasesPrices(context.Context, *BasePriceListCondtions) (*BasePriceList, error)
+	// SendMessage sends an email message to the site admin.
+	// Messages with a filled honeypot are silently dropped.
+	// Clients exceeding the configured rate get ResourceExhausted.
+	SendMessage(context.Context, *Message) (*MessageID, error)
+	// ListMessages returns contact messages, newest first, filtered by MessageListConditions.
+	// Replies are not included.
+	ListMessages(context.Context, *MessageListConditions) (*MessageList, error)
+	// GetMessage returns a contact message with its reply thread.
+	GetMessage(context.Context, *MessageID) (*Message, error)
+	// MarkMessage sets the read and archived flags of a message.
+	MarkMessage(context.Context, *MessageMark) (*Message, error)
+	// ReplyMessage mails a reply to the sender of a message and adds it to the thread.
+	// The message is marked read.
+	ReplyMessage(context.Context, *MessageReply) (*Message, error)
+	// SaveAttributes upserts the attribute definitions in the provided AttributeList.
+	// Returned AttributeList is always in the same order as the provided one.
+	//
+	// New attributes should have their ID field set to 0.
+	// Those IDs will be populated in the reponse.
+	// The categories of each attribute are replaced by the provided ones.
+	SaveAttributes(context.Context, *AttributeList) (*AttributeList, error)
+	// ListAttributes returns all attribute definitions, filtered by AttributeListConditions.
+	// Returned AttributeList is always in the same order as passed to SaveAttributes.
+	ListAttributes(context.Context, *AttributeListConditions) (*AttributeList, error)
+	// SaveWebhook updates a webhook identified by ID.
+	// If ID is unset (0) a new webhook is inserted.
+	// On success the affected webhook is returned with its ID populated.
+	SaveWebhook(context.Context, *Webhook) (*Webhook, error)
+	// DeleteWebhook deletes the webhook and all its deliveries.
+	DeleteWebhook(context.Context, *WebhookID) (*Deleted, error)
+	// ListWebhooks returns all webhooks.
+	ListWebhooks(context.Context, *WebhookListConditions) (*WebhookList, error)
+	// ListMails returns the mails in the outbox, filtered by MailListConditions.
+	// Newest mails are returned first.
+	ListMails(context.Context, *MailListConditions) (*MailList, error)
+	// ResendMail schedules a failed or sent mail for immediate delivery.
+	// On success the rescheduled mail is returned.
+	ResendMail(context.Context, *MailID) (*Mail, error)
+	// Subscribe registers an email for the newsletter and mails a confirmation link.
+	// The subscription remains pending until it is confirmed with the token from that link.
+	// The reply is the same for new and existing subscribers.
+	Subscribe(context.Context, *Subscription) (*SubscriptionReply, error)
+	// ConfirmSubscription confirms a pending subscription, using the token from the confirmation mail.
+	ConfirmSubscription(context.Context, *SubscriptionToken) (*Subscriber, error)
+	// Unsubscribe removes an email from the newsletter,
+	// using the token from the unsubscribe link in outgoing mails.
+	Unsubscribe(context.Context, *SubscriptionToken) (*Subscriber, error)
+	// ExportSubscribers returns the confirmed subscribers with their consent record as CSV.
+	ExportSubscribers(context.Context, *SubscriberExportRequest) (*ReportCSV, error)
+	// ExportPersonalData returns everything held about an email address,
+	// in the shop and authenticator databases, as a JSON archive.
+	ExportPersonalData(context.Context, *PersonalDataRequest) (*PersonalDataArchive, error)
+	// ErasePersonalData anonymizes everything held about an email address, in place.
+	// Orders keep their articles, amounts and status for accounting, without personal data.
+	// Messages, mails, webhook deliveries and the newsletter subscription are deleted.
+	// Erasure is idempotent: a failed request can be repeated.
+	ErasePersonalData(context.Context, *PersonalDataRequest) (*ErasureReport, error)
 }
 
-type shopClient struct {
-	cc grpc.ClientConnInterface
+// UnimplementedShopServer can be embedded to have forward compatible implementations.
+type UnimplementedShopServer struct {
 }
 
-func NewShopClient(cc grpc.ClientConnInterface) ShopClient {
-	return &shopClient{cc}
+func (*UnimplementedShopServer) SaveArticle(context.Context, *Article) (*ArticleID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveArticle not implemented")
+}
+func (*UnimplementedShopServer) ViewArticle(context.Context, *ArticleID) (*Article, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewArticle not implemented")
+}
+func (*UnimplementedShopServer) ListArticles(context.Context, *ListConditions) (*ArticleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArticles not implemented")
+}
+func (*UnimplementedShopServer) DeleteArticle(context.Context, *ArticleID) (*Deleted, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteArticle not implemented")
+}
+func (*UnimplementedShopServer) RestoreArticle(context.Context, *ArticleID) (*ArticleID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreArticle not implemented")
+}
+func (*UnimplementedShopServer) ListDeletedArticles(context.Context, *DeletedListConditions) (*ArticleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedArticles not implemented")
+}
+func (*UnimplementedShopServer) ListArticleRevisions(context.Context, *RevisionListConditions) (*ArticleRevisionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArticleRevisions not implemented")
+}
+func (*UnimplementedShopServer) RevertArticle(context.Context, *RevisionID) (*ArticleID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertArticle not implemented")
+}
+func (*UnimplementedShopServer) ImportArticles(Shop_ImportArticlesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportArticles not implemented")
+}
+func (*UnimplementedShopServer) ExportArticles(*ExportConditions, Shop_ExportArticlesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportArticles not implemented")
+}
+func (*UnimplementedShopServer) Checkout(context.Context, *Order) (*OrderID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkout not implemented")
+}
+func (*UnimplementedShopServer) ListOrders(context.Context, *ListOrderConditions) (*OrderList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (*UnimplementedShopServer) SaveOrder(context.Context, *Order) (*OrderID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveOrder not implemented")
+}
+func (*UnimplementedShopServer) WatchOrders(*WatchOrdersRequest, Shop_WatchOrdersServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrders not implemented")
+}
+func (*UnimplementedShopServer) RevenueReport(context.Context, *ReportConditions) (*RevenueReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevenueReport not implemented")
+}
+func (*UnimplementedShopServer) TopArticlesReport(context.Context, *ReportConditions) (*TopArticlesReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopArticlesReport not implemented")
+}
+func (*UnimplementedShopServer) OrdersReport(context.Context, *ReportConditions) (*OrdersReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrdersReport not implemented")
+}
+func (*UnimplementedShopServer) ExportReport(context.Context, *ExportReportRequest) (*ReportCSV, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportReport not implemented")
+}
+func (*UnimplementedShopServer) SaveCategories(context.Context, *CategoryList) (*CategoryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveCategories not implemented")
+}
+func (*UnimplementedShopServer) ListCategories(context.Context, *CategoryListConditions) (*CategoryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (*UnimplementedShopServer) SearchArticles(context.Context, *TextSearch) (*ArticleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchArticles not implemented")
+}
+func (*UnimplementedShopServer) Suggest(context.Context, *TextSearch) (*SuggestionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+}
+func (*UnimplementedShopServer) SaveBasePrice(context.Context, *BasePrice) (*BasePrice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveBasePrice not implemented")
+}
+func (*UnimplementedShopServer) DeleteBasePrice(context.Context, *BasePrice) (*Deleted, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBasePrice not implemented")
+}
+func (*UnimplementedShopServer) ListBasesPrices(context.Context, *BasePriceListCondtions) (*BasePriceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBasesPrices not implemented")
+}
+func (*UnimplementedShopServer) SendMessage(context.Context, *Message) (*MessageID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (*UnimplementedShopServer) ListMessages(context.Context, *MessageListConditions) (*MessageList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMessages not implemented")
+}
+func (*UnimplementedShopServer) GetMessage(context.Context, *MessageID) (*Message, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessage not implemented")
+}
+func (*UnimplementedShopServer) MarkMessage(context.Context, *MessageMark) (*Message, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkMessage not implemented")
+}
+func (*UnimplementedShopServer) ReplyMessage(context.Context, *MessageReply) (*Message, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyMessage not implemented")
+}
+func (*UnimplementedShopServer) SaveAttributes(context.Context, *AttributeList) (*AttributeList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveAttributes not implemented")
+}
+func (*UnimplementedShopServer) ListAttributes(context.Context, *AttributeListConditions) (*AttributeList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttributes not implemented")
+}
+func (*UnimplementedShopServer) SaveWebhook(context.Context, *Webhook) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveWebhook not implemented")
+}
+func (*UnimplementedShopServer) DeleteWebhook(context.Context, *WebhookID) (*Deleted, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (*UnimplementedShopServer) ListWebhooks(context.Context, *WebhookListConditions) (*WebhookList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (*UnimplementedShopServer) ListMails(context.Context, *MailListConditions) (*MailList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMails not implemented")
+}
+func (*UnimplementedShopServer) ResendMail(context.Context, *MailID) (*Mail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendMail not implemented")
+}
+func (*UnimplementedShopServer) Subscribe(context.Context, *Subscription) (*SubscriptionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (*UnimplementedShopServer) ConfirmSubscription(context.Context, *SubscriptionToken) (*Subscriber, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmSubscription not implemented")
+}
+func (*UnimplementedShopServer) Unsubscribe(context.Context, *SubscriptionToken) (*Subscriber, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
+}
+func (*UnimplementedShopServer) ExportSubscribers(context.Context, *SubscriberExportRequest) (*ReportCSV, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSubscribers not implemented")
+}
+func (*UnimplementedShopServer) ExportPersonalData(context.Context, *PersonalDataRequest) (*PersonalDataArchive, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPersonalData not implemented")
+}
+func (*UnimplementedShopServer) ErasePersonalData(context.Context, *PersonalDataRequest) (*ErasureReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ErasePersonalData not implemented")
 }
 
-func (c *shopClient) SaveArticle(ctx context.Context, in *Article, opts ...grpc.CallOption) (*ArticleID, error) {
-	out := new(ArticleID)
-	err := c.cc.Invoke(ctx, "/shop.Shop/SaveArticle", in, out, opts...)
-	if err != nil {
+func RegisterShopServer(s *grpc.Server, srv ShopServer) {
+	s.RegisterService(&_Shop_serviceDesc, srv)
+}
+
+func _Shop_SaveArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Article)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ShopServer).SaveArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/SaveArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).SaveArticle(ctx, req.(*Article))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *shopClient) ViewArticle(ctx context.Context, in *ArticleID, opts ...grpc.CallOption) (*Article, error) {
-	out := new(Article)
-	err := c.cc.Invoke(ctx, "/shop.Shop/ViewArticle", in, out, opts...)
-	if err != nil {
+func _Shop_ViewArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArticleID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).ViewArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/ViewArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).ViewArticle(ctx, req.(*ArticleID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shop_ListArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConditions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).ListArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/ListArticles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).ListArticles(ctx, req.(*ListConditions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shop_DeleteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArticleID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).DeleteArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/DeleteArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).DeleteArticle(ctx, req.(*ArticleID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shop_RestoreArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArticleID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).RestoreArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/RestoreArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).RestoreArticle(ctx, req.(*ArticleID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shop_ListDeletedArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletedListConditions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).ListDeletedArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/ListDeletedArticles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).ListDeletedArticles(ctx, req.(*DeletedListConditions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shop_ListArticleRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionListConditions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).ListArticleRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/ListArticleRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).ListArticleRevisions(ctx, req.(*RevisionListConditions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shop_RevertArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).RevertArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/RevertArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).RevertArticle(ctx, req.(*RevisionID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shop_ImportArticles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ShopServer).ImportArticles(&shopImportArticlesServer{stream})
+}
+
+type Shop_ImportArticlesServer interface {
+	Send(*ImportResult) error
+	Recv() (*ImportRequest, error)
+	grpc.ServerStream
+}
+
+type shopImportArticlesServer struct {
+	grpc.ServerStream
+}
+
+func (x *shopImportArticlesServer) Send(m *ImportResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *shopImportArticlesServer) Recv() (*ImportRequest, error) {
+	m := new(ImportRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Shop_ExportArticles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportConditions)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ShopServer).ExportArticles(m, &shopExportArticlesServer{stream})
+}
+
+type Shop_ExportArticlesServer interface {
+	Send(*Article) error
+	grpc.ServerStream
+}
+
+type shopExportArticlesServer struct {
+	grpc.ServerStream
+}
+
+func (x *shopExportArticlesServer) Send(m *Article) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Shop_Checkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Order)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ShopServer).Checkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/Checkout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).Checkout(ctx, req.(*Order))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *shopClient) ListArticles(ctx context.Context, in *ListConditions, opts ...grpc.CallOption) (*ArticleList, error) {
-	out := new(ArticleList)
-	err := c.cc.Invoke(ctx, "/shop.Shop/ListArticles", in, out, opts...)
-	if err != nil {
+func _Shop_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrderConditions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ShopServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/ListOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).ListOrders(ctx, req.(*ListOrderConditions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *shopClient) DeleteArticle(ctx context.Context, in *ArticleID, opts ...grpc.CallOption) (*Deleted, error) {
-	out := new(Deleted)
-	err := c.cc.Invoke(ctx, "/shop.Shop/DeleteArticle", in, out, opts...)
-	if err != nil {
+func _Shop_SaveOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Order)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ShopServer).SaveOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/SaveOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).SaveOrder(ctx, req.(*Order))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *shopClient) Checkout(ctx context.Context, in *Order, opts ...grpc.CallOption) (*OrderID, error) {
-	out := new(OrderID)
-	err := c.cc.Invoke(ctx, "/shop.Shop/Checkout", in, out, opts...)
-	if err != nil {
-		return nil, err
+func _Shop_WatchOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrdersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(ShopServer).WatchOrders(m, &shopWatchOrdersServer{stream})
 }
 
-func (c *shopClient) ListOrders(ctx context.Context, in *ListOrderConditions, opts ...grpc.CallOption) (*OrderList, error) {
-	out := new(OrderList)
-	err := c.cc.Invoke(ctx, "/shop.Shop/ListOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type Shop_WatchOrdersServer interface {
+	Send(*Order) error
+	grpc.ServerStream
 }
 
-func (c *shopClient) SaveOrder(ctx context.Context, in *Order, opts ...grpc.CallOption) (*OrderID, error) {
-	out := new(OrderID)
-	err := c.cc.Invoke(ctx, "/shop.Shop/SaveOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type shopWatchOrdersServer struct {
+	grpc.ServerStream
 }
 
-func (c *shopClient) SaveCategories(ctx context.Context, in *CategoryList, opts ...grpc.CallOption) (*CategoryList, error) {
-	out := new(CategoryList)
-	err := c.cc.Invoke(ctx, "/shop.Shop/SaveCategories", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (x *shopWatchOrdersServer) Send(m *Order) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (c *shopClient) ListCategories(ctx context.Context, in *CategoryListConditions, opts ...grpc.CallOption) (*CategoryList, error) {
-	out := new(CategoryList)
-	err := c.cc.Invoke(ctx, "/shop.Shop/ListCategories", in, out, opts...)
-	if err != nil {
+func _Shop_RevenueReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportConditions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ShopServer).RevenueReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/RevenueReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).RevenueReport(ctx, req.(*ReportConditions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *shopClient) SearchArticles(ctx context.Context, in *TextSearch, opts ...grpc.CallOption) (*ArticleList, error) {
-	out := new(ArticleList)
-	err := c.cc.Invoke(ctx, "/shop.Shop/SearchArticles", in, out, opts...)
-	if err != nil {
+func _Shop_TopArticlesReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportConditions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ShopServer).TopArticlesReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/TopArticlesReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).TopArticlesReport(ctx, req.(*ReportConditions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *shopClient) Suggest(ctx context.Context, in *TextSearch, opts ...grpc.CallOption) (*SuggestionList, error) {
-	out := new(SuggestionList)
-	err := c.cc.Invoke(ctx, "/shop.Shop/Suggest", in, out, opts...)
-	if err != nil {
+func _Shop_OrdersReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportConditions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ShopServer).OrdersReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/OrdersReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).OrdersReport(ctx, req.(*ReportConditions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *shopClient) SaveBasePrice(ctx context.Context, in *BasePrice, opts ...grpc.CallOption) (*BasePrice, error) {
-	out := new(BasePrice)
-	err := c.cc.Invoke(ctx, "/shop.Shop/SaveBasePrice", in, out, opts...)
-	if err != nil {
+func _Shop_ExportReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportReportRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ShopServer).ExportReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/ExportReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).ExportReport(ctx, req.(*ExportReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *shopClient) DeleteBasePrice(ctx context.Context, in *BasePrice, opts ...grpc.CallOption) (*Deleted, error) {
-	out := new(Deleted)
-	err := c.cc.Invoke(ctx, "/shop.Shop/DeleteBasePrice", in, out, opts...)
-	if err != nil {
+func _Shop_SaveCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryList)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ShopServer).SaveCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/SaveCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).SaveCategories(ctx, req.(*CategoryList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *shopClient) ListBasesPrices(ctx context.Context, in *BasePriceListCondtions, opts ...grpc.CallOption) (*BasePriceList, error) {
-	out := new(BasePriceList)
-	err := c.cc.Invoke(ctx, "/shop.Shop/ListBasesPrices", in, out, opts...)
-	if err != nil {
+func _Shop_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryListConditions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ShopServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/ListCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).ListCategories(ctx, req.(*CategoryListConditions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *shopClient) SendMessage(ctx context.Context, in *Message, opts ...grpc.CallOption) (*MessageID, error) {
-	out := new(MessageID)
-	err := c.cc.Invoke(ctx, "/shop.Shop/SendMessage", in, out, opts...)
-	if err != nil {
+func _Shop_SearchArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TextSearch)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// ShopServer is the server API for Shop service.
-type ShopServer interface {
-	// SaveArticle updates an article identified by ID.
-	// If ID is unset (0) a new article is insered.
-	// On success the affected article ID is returned.
-	SaveArticle(context.Context, *Article) (*ArticleID, error)
-	// ViewArticle identied by ID.
-	ViewArticle(context.Context, *ArticleID) (*Article, error)
-	// ListArticles filtered by ListCondtions.
-	ListArticles(context.Context, *ListConditions) (*ArticleList, error)
-	// DeleteArticle and all associated images and videos
-	DeleteArticle(context.Context, *ArticleID) (*Deleted, error)
-	// Checkout an order of articles
-	Checkout(context.Context, *Order) (*OrderID, error)
-	// ListOrders returns all orders
-	ListOrders(context.Context, *ListOrderConditions) (*OrderList, error)
-	// SaveOrder updates the order identified by ID.
-	// It is not (yet) possibile to change the articles on an order.
-	SaveOrder(context.Context, *Order) (*OrderID, error)
-	// SaveCategories upserts the categories in the provided CategoryList.
-	// Returned CategoryList is always in the same order as the provided one.
-	//
-	// New articles should have their ID field set to 0.
-	// Those IDs will be populated in the reponse.
-	// Existing categories should always keep the same ID
-	// and is used for updates and re-sorting.
-	SaveCategories(context.Context, *CategoryList) (*CategoryList, error)
-	// ListCategories returns all categories, filtered by CategoryListConditions.
-	// Returned CategoryList is always in the same order as passed to SaveCategories.
-	ListCategories(context.Context, *CategoryListConditions) (*CategoryList, error)
-	// SearchArticles returns ArticleList that matches TextSearch.value.
-	SearchArticles(context.Context, *TextSearch) (*ArticleList, error)
-	// Suggest returns a list of matching articles and categories
-	Suggest(context.Context, *TextSearch) (*SuggestionList, error)
-	// SaveBasePrice updates a base price identified by ID.
-	// If ID is unset (0) a new base price is insered.
-	// On success the affected base price is returned with its ID populated.
-	SaveBasePrice(context.Context, *BasePrice) (*BasePrice, error)
-	// DeleteBasePrice dis-assosiates all articles and deleted the given BasePrice
-	DeleteBasePrice(context.Context, *BasePrice) (*Deleted, error)
-	// ListBasePrices returns all base prices.
-	ListBasesPrices(context.Context, *BasePriceListCondtions) (*BasePriceList, error)
-	// SendMessage sends an email message to the site admin
-	SendMessage(context.Context, *Message) (*MessageID, error)
-}
-
-// UnimplementedShopServer can be embedded to have forward compatible implementations.
-type UnimplementedShopServer struct {
-}
-
-func (*UnimplementedShopServer) SaveArticle(context.Context, *Article) (*ArticleID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveArticle not implemented")
-}
-func (*UnimplementedShopServer) ViewArticle(context.Context, *ArticleID) (*Article, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ViewArticle not implemented")
-}
-func (*UnimplementedShopServer) ListArticles(context.Context, *ListConditions) (*ArticleList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListArticles not implemented")
-}
-func (*UnimplementedShopServer) DeleteArticle(context.Context, *ArticleID) (*Deleted, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteArticle not implemented")
-}
-func (*UnimplementedShopServer) Checkout(context.Context, *Order) (*OrderID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Checkout not implemented")
-}
-func (*UnimplementedShopServer) ListOrders(context.Context, *ListOrderConditions) (*OrderList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
-}
-func (*UnimplementedShopServer) SaveOrder(context.Context, *Order) (*OrderID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveOrder not implemented")
-}
-func (*UnimplementedShopServer) SaveCategories(context.Context, *CategoryList) (*CategoryList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveCategories not implemented")
-}
-func (*UnimplementedShopServer) ListCategories(context.Context, *CategoryListConditions) (*CategoryList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+	if interceptor == nil {
+		return srv.(ShopServer).SearchArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/SearchArticles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).SearchArticles(ctx, req.(*TextSearch))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedShopServer) SearchArticles(context.Context, *TextSearch) (*ArticleList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchArticles not implemented")
+
+func _Shop_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TextSearch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/Suggest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).Suggest(ctx, req.(*TextSearch))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedShopServer) Suggest(context.Context, *TextSearch) (*SuggestionList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+
+func _Shop_SaveBasePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BasePrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).SaveBasePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/SaveBasePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).SaveBasePrice(ctx, req.(*BasePrice))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedShopServer) SaveBasePrice(context.Context, *BasePrice) (*BasePrice, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveBasePrice not implemented")
+
+func _Shop_DeleteBasePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BasePrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).DeleteBasePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/DeleteBasePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).DeleteBasePrice(ctx, req.(*BasePrice))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedShopServer) DeleteBasePrice(context.Context, *BasePrice) (*Deleted, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBasePrice not implemented")
+
+func _Shop_ListBasesPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BasePriceListCondtions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).ListBasesPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/ListBasesPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).ListBasesPrices(ctx, req.(*BasePriceListCondtions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedShopServer) ListBasesPrices(context.Context, *BasePriceListCondtions) (*BasePriceList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBasesPrices not implemented")
+
+func _Shop_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Message)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).SendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/SendMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).SendMessage(ctx, req.(*Message))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedShopServer) SendMessage(context.Context, *Message) (*MessageID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+
+func _Shop_ListMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageListConditions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).ListMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/ListMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).ListMessages(ctx, req.(*MessageListConditions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterShopServer(s *grpc.Server, srv ShopServer) {
-	s.RegisterService(&_Shop_serviceDesc, srv)
+func _Shop_GetMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).GetMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop.Shop/GetMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).GetMessage(ctx, req.(*MessageID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Shop_SaveArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Article)
+func _Shop_MarkMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageMark)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShopServer).SaveArticle(ctx, in)
+		return srv.(ShopServer).MarkMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/shop.Shop/SaveArticle",
+		FullMethod: "/shop.Shop/MarkMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShopServer).SaveArticle(ctx, req.(*Article))
+		return srv.(ShopServer).MarkMessage(ctx, req.(*MessageMark))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Shop_ViewArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ArticleID)
+func _Shop_ReplyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageReply)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShopServer).ViewArticle(ctx, in)
+		return srv.(ShopServer).ReplyMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/shop.Shop/ViewArticle",
+		FullMethod: "/shop.Shop/ReplyMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShopServer).ViewArticle(ctx, req.(*ArticleID))
+		return srv.(ShopServer).ReplyMessage(ctx, req.(*MessageReply))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Shop_ListArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListConditions)
+func _Shop_SaveAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttributeList)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShopServer).ListArticles(ctx, in)
+		return srv.(ShopServer).SaveAttributes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/shop.Shop/ListArticles",
+		FullMethod: "/shop.Shop/SaveAttributes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShopServer).ListArticles(ctx, req.(*ListConditions))
+		return srv.(ShopServer).SaveAttributes(ctx, req.(*AttributeList))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Shop_DeleteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ArticleID)
+func _Shop_ListAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttributeListConditions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShopServer).DeleteArticle(ctx, in)
+		return srv.(ShopServer).ListAttributes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/shop.Shop/DeleteArticle",
+		FullMethod: "/shop.Shop/ListAttributes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShopServer).DeleteArticle(ctx, req.(*ArticleID))
+		return srv.(ShopServer).ListAttributes(ctx, req.(*AttributeListConditions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Shop_Checkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Order)
+func _Shop_SaveWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Webhook)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShopServer).Checkout(ctx, in)
+		return srv.(ShopServer).SaveWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/shop.Shop/Checkout",
+		FullMethod: "/shop.Shop/SaveWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShopServer).Checkout(ctx, req.(*Order))
+		return srv.(ShopServer).SaveWebhook(ctx, req.(*Webhook))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Shop_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListOrderConditions)
+func _Shop_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShopServer).ListOrders(ctx, in)
+		return srv.(ShopServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/shop.Shop/ListOrders",
+		FullMethod: "/shop.Shop/DeleteWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShopServer).ListOrders(ctx, req.(*ListOrderConditions))
+		return srv.(ShopServer).DeleteWebhook(ctx, req.(*WebhookID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Shop_SaveOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Order)
+func _Shop_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookListConditions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShopServer).SaveOrder(ctx, in)
+		return srv.(ShopServer).ListWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/shop.Shop/SaveOrder",
+		FullMethod: "/shop.Shop/ListWebhooks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShopServer).SaveOrder(ctx, req.(*Order))
+		return srv.(ShopServer).ListWebhooks(ctx, req.(*WebhookListConditions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Shop_SaveCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CategoryList)
+func _Shop_ListMails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MailListConditions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShopServer).SaveCategories(ctx, in)
+		return srv.(ShopServer).ListMails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/shop.Shop/SaveCategories",
+		FullMethod: "/shop.Shop/ListMails",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShopServer).SaveCategories(ctx, req.(*CategoryList))
+		return srv.(ShopServer).ListMails(ctx, req.(*MailListConditions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Shop_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CategoryListConditions)
+func _Shop_ResendMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MailID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShopServer).ListCategories(ctx, in)
+		return srv.(ShopServer).ResendMail(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/shop.Shop/ListCategories",
+		FullMethod: "/shop.Shop/ResendMail",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShopServer).ListCategories(ctx, req.(*CategoryListConditions))
+		return srv.(ShopServer).ResendMail(ctx, req.(*MailID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Shop_SearchArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TextSearch)
+func _Shop_Subscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Subscription)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShopServer).SearchArticles(ctx, in)
+		return srv.(ShopServer).Subscribe(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/shop.Shop/SearchArticles",
+		FullMethod: "/shop.Shop/Subscribe",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShopServer).SearchArticles(ctx, req.(*TextSearch))
+		return srv.(ShopServer).Subscribe(ctx, req.(*Subscription))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Shop_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TextSearch)
+func _Shop_ConfirmSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionToken)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShopServer).Suggest(ctx, in)
+		return srv.(ShopServer).ConfirmSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/shop.Shop/Suggest",
+		FullMethod: "/shop.Shop/ConfirmSubscription",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShopServer).Suggest(ctx, req.(*TextSearch))
+		return srv.(ShopServer).ConfirmSubscription(ctx, req.(*SubscriptionToken))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Shop_SaveBasePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BasePrice)
+func _Shop_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionToken)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShopServer).SaveBasePrice(ctx, in)
+		return srv.(ShopServer).Unsubscribe(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/shop.Shop/SaveBasePrice",
+		FullMethod: "/shop.Shop/Unsubscribe",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShopServer).SaveBasePrice(ctx, req.(*BasePrice))
+		return srv.(ShopServer).Unsubscribe(ctx, req.(*SubscriptionToken))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Shop_DeleteBasePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BasePrice)
+func _Shop_ExportSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriberExportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShopServer).DeleteBasePrice(ctx, in)
+		return srv.(ShopServer).ExportSubscribers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/shop.Shop/DeleteBasePrice",
+		FullMethod: "/shop.Shop/ExportSubscribers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShopServer).DeleteBasePrice(ctx, req.(*BasePrice))
+		return srv.(ShopServer).ExportSubscribers(ctx, req.(*SubscriberExportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Shop_ListBasesPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BasePriceListCondtions)
+func _Shop_ExportPersonalData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersonalDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShopServer).ListBasesPrices(ctx, in)
+		return srv.(ShopServer).ExportPersonalData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/shop.Shop/ListBasesPrices",
+		FullMethod: "/shop.Shop/ExportPersonalData",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShopServer).ListBasesPrices(ctx, req.(*BasePriceListCondtions))
+		return srv.(ShopServer).ExportPersonalData(ctx, req.(*PersonalDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Shop_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Message)
+func _Shop_ErasePersonalData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersonalDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShopServer).SendMessage(ctx, in)
+		return srv.(ShopServer).ErasePersonalData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/shop.Shop/SendMessage",
+		FullMethod: "/shop.Shop/ErasePersonalData",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShopServer).SendMessage(ctx, req.(*Message))
+		return srv.(ShopServer).ErasePersonalData(ctx, req.(*PersonalDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3583,6 +9799,22 @@ var _Shop_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteArticle",
 			Handler:    _Shop_DeleteArticle_Handler,
 		},
+		{
+			MethodName: "RestoreArticle",
+			Handler:    _Shop_RestoreArticle_Handler,
+		},
+		{
+			MethodName: "ListDeletedArticles",
+			Handler:    _Shop_ListDeletedArticles_Handler,
+		},
+		{
+			MethodName: "ListArticleRevisions",
+			Handler:    _Shop_ListArticleRevisions_Handler,
+		},
+		{
+			MethodName: "RevertArticle",
+			Handler:    _Shop_RevertArticle_Handler,
+		},
 		{
 			MethodName: "Checkout",
 			Handler:    _Shop_Checkout_Handler,
@@ -3595,6 +9827,22 @@ var _Shop_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SaveOrder",
 			Handler:    _Shop_SaveOrder_Handler,
 		},
+		{
+			MethodName: "RevenueReport",
+			Handler:    _Shop_RevenueReport_Handler,
+		},
+		{
+			MethodName: "TopArticlesReport",
+			Handler:    _Shop_TopArticlesReport_Handler,
+		},
+		{
+			MethodName: "OrdersReport",
+			Handler:    _Shop_OrdersReport_Handler,
+		},
+		{
+			MethodName: "ExportReport",
+			Handler:    _Shop_ExportReport_Handler,
+		},
 		{
 			MethodName: "SaveCategories",
 			Handler:    _Shop_SaveCategories_Handler,
@@ -3627,7 +9875,92 @@ var _Shop_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendMessage",
 			Handler:    _Shop_SendMessage_Handler,
 		},
+		{
+			MethodName: "ListMessages",
+			Handler:    _Shop_ListMessages_Handler,
+		},
+		{
+			MethodName: "GetMessage",
+			Handler:    _Shop_GetMessage_Handler,
+		},
+		{
+			MethodName: "MarkMessage",
+			Handler:    _Shop_MarkMessage_Handler,
+		},
+		{
+			MethodName: "ReplyMessage",
+			Handler:    _Shop_ReplyMessage_Handler,
+		},
+		{
+			MethodName: "SaveAttributes",
+			Handler:    _Shop_SaveAttributes_Handler,
+		},
+		{
+			MethodName: "ListAttributes",
+			Handler:    _Shop_ListAttributes_Handler,
+		},
+		{
+			MethodName: "SaveWebhook",
+			Handler:    _Shop_SaveWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Shop_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _Shop_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "ListMails",
+			Handler:    _Shop_ListMails_Handler,
+		},
+		{
+			MethodName: "ResendMail",
+			Handler:    _Shop_ResendMail_Handler,
+		},
+		{
+			MethodName: "Subscribe",
+			Handler:    _Shop_Subscribe_Handler,
+		},
+		{
+			MethodName: "ConfirmSubscription",
+			Handler:    _Shop_ConfirmSubscription_Handler,
+		},
+		{
+			MethodName: "Unsubscribe",
+			Handler:    _Shop_Unsubscribe_Handler,
+		},
+		{
+			MethodName: "ExportSubscribers",
+			Handler:    _Shop_ExportSubscribers_Handler,
+		},
+		{
+			MethodName: "ExportPersonalData",
+			Handler:    _Shop_ExportPersonalData_Handler,
+		},
+		{
+			MethodName: "ErasePersonalData",
+			Handler:    _Shop_ErasePersonalData_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportArticles",
+			Handler:       _Shop_ImportArticles_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportArticles",
+			Handler:       _Shop_ExportArticles_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchOrders",
+			Handler:       _Shop_WatchOrders_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "shop.proto",
 }